@@ -54,6 +54,27 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	restoreControlPlaneLoadBalancerStatus(&restored.Status.Network.SecondaryAPIServerELB, &dst.Status.Network.SecondaryAPIServerELB)
 
 	dst.Spec.S3Bucket = restored.Spec.S3Bucket
+	dst.Spec.MaintenanceWindow = restored.Spec.MaintenanceWindow
+	dst.Spec.BastionHostRef = restored.Spec.BastionHostRef
+	dst.Spec.InstanceConnectEndpoint = restored.Spec.InstanceConnectEndpoint
+	dst.Spec.Observability = restored.Spec.Observability
+	dst.Spec.FailureDomains = restored.Spec.FailureDomains
+	dst.Spec.ControlPlanePlacement = restored.Spec.ControlPlanePlacement
+	dst.Spec.Adoption = restored.Spec.Adoption
+	dst.Spec.CloudProviderConfig = restored.Spec.CloudProviderConfig
+	dst.Spec.PrivateDNS = restored.Spec.PrivateDNS
+	dst.Spec.SessionManager = restored.Spec.SessionManager
+	dst.Spec.MachineDefaults = restored.Spec.MachineDefaults
+	dst.Spec.GuardDutyRuntimeMonitoring = restored.Spec.GuardDutyRuntimeMonitoring
+	dst.Spec.Budget = restored.Spec.Budget
+	dst.Status.PendingDisruptiveActions = restored.Status.PendingDisruptiveActions
+	dst.Status.InstanceConnectEndpoint = restored.Status.InstanceConnectEndpoint
+	dst.Status.Adoption = restored.Status.Adoption
+	dst.Status.PrivateHostedZoneID = restored.Status.PrivateHostedZoneID
+	dst.Status.SessionManagerDocumentName = restored.Status.SessionManagerDocumentName
+	dst.Status.GuardDutyRuntimeMonitoring = restored.Status.GuardDutyRuntimeMonitoring
+	dst.Status.ResourceLimits = restored.Status.ResourceLimits
+	dst.Status.Budget = restored.Status.Budget
 	if restored.Status.Bastion != nil {
 		dst.Status.Bastion.InstanceMetadataOptions = restored.Status.Bastion.InstanceMetadataOptions
 		dst.Status.Bastion.PlacementGroupName = restored.Status.Bastion.PlacementGroupName
@@ -63,6 +84,7 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Bastion.NetworkInterfaceType = restored.Status.Bastion.NetworkInterfaceType
 		dst.Status.Bastion.CapacityReservationID = restored.Status.Bastion.CapacityReservationID
 		dst.Status.Bastion.MarketType = restored.Status.Bastion.MarketType
+		dst.Status.Bastion.CPUOptions = restored.Status.Bastion.CPUOptions
 	}
 	dst.Spec.Partition = restored.Spec.Partition
 
@@ -70,6 +92,10 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Network.SecurityGroups[role] = sg
 	}
 	dst.Status.Network.NatGatewaysIPs = restored.Status.Network.NatGatewaysIPs
+	dst.Status.Network.VPCIPAMPoolAllocation = restored.Status.Network.VPCIPAMPoolAllocation
+	dst.Status.Network.AdditionalPublicRouteDestinations = restored.Status.Network.AdditionalPublicRouteDestinations
+	dst.Status.Network.AdditionalPrivateRouteDestinations = restored.Status.Network.AdditionalPrivateRouteDestinations
+	dst.Status.FailureDomainCount = restored.Status.FailureDomainCount
 
 	if restored.Spec.NetworkSpec.VPC.IPAMPool != nil {
 		if dst.Spec.NetworkSpec.VPC.IPAMPool == nil {
@@ -87,9 +113,20 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 		restoreIPAMPool(restored.Spec.NetworkSpec.VPC.IPv6.IPAMPool, dst.Spec.NetworkSpec.VPC.IPv6.IPAMPool)
 	}
 
+	if restored.Spec.NetworkSpec.VPC.IsIPv6Enabled() && dst.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
+		dst.Spec.NetworkSpec.VPC.IPv6.PrivateSubnetsIPv6Only = restored.Spec.NetworkSpec.VPC.IPv6.PrivateSubnetsIPv6Only
+	}
+
 	dst.Spec.NetworkSpec.AdditionalControlPlaneIngressRules = restored.Spec.NetworkSpec.AdditionalControlPlaneIngressRules
 	dst.Spec.NetworkSpec.AdditionalNodeIngressRules = restored.Spec.NetworkSpec.AdditionalNodeIngressRules
 	dst.Spec.NetworkSpec.NodePortIngressRuleCidrBlocks = restored.Spec.NetworkSpec.NodePortIngressRuleCidrBlocks
+	dst.Spec.NetworkSpec.AdditionalPublicRoutes = restored.Spec.NetworkSpec.AdditionalPublicRoutes
+	dst.Spec.NetworkSpec.AdditionalPrivateRoutes = restored.Spec.NetworkSpec.AdditionalPrivateRoutes
+	dst.Spec.NetworkSpec.SecurityGroupEgress = restored.Spec.NetworkSpec.SecurityGroupEgress
+
+	if restored.Spec.NetworkSpec.CNI != nil && dst.Spec.NetworkSpec.CNI != nil {
+		dst.Spec.NetworkSpec.CNI.Profile = restored.Spec.NetworkSpec.CNI.Profile
+	}
 
 	if restored.Spec.NetworkSpec.VPC.IPAMPool != nil {
 		if dst.Spec.NetworkSpec.VPC.IPAMPool == nil {
@@ -112,6 +149,11 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.NetworkSpec.VPC.CarrierGatewayID = restored.Spec.NetworkSpec.VPC.CarrierGatewayID
 	dst.Spec.NetworkSpec.VPC.SubnetSchema = restored.Spec.NetworkSpec.VPC.SubnetSchema
 	dst.Spec.NetworkSpec.VPC.SecondaryCidrBlocks = restored.Spec.NetworkSpec.VPC.SecondaryCidrBlocks
+	dst.Spec.NetworkSpec.VPC.NatGateway = restored.Spec.NetworkSpec.VPC.NatGateway
+	dst.Spec.NetworkSpec.VPC.SubnetPlan = restored.Spec.NetworkSpec.VPC.SubnetPlan
+	dst.Spec.NetworkSpec.VPC.EnableDNSSupport = restored.Spec.NetworkSpec.VPC.EnableDNSSupport
+	dst.Spec.NetworkSpec.VPC.EnableDNSHostnames = restored.Spec.NetworkSpec.VPC.EnableDNSHostnames
+	dst.Spec.NetworkSpec.VPC.EnableNetworkAddressUsageMetrics = restored.Spec.NetworkSpec.VPC.EnableNetworkAddressUsageMetrics
 
 	if restored.Spec.NetworkSpec.VPC.ElasticIPPool != nil {
 		if dst.Spec.NetworkSpec.VPC.ElasticIPPool == nil {
@@ -123,9 +165,12 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 		if restored.Spec.NetworkSpec.VPC.ElasticIPPool.PublicIpv4PoolFallBackOrder != nil {
 			dst.Spec.NetworkSpec.VPC.ElasticIPPool.PublicIpv4PoolFallBackOrder = restored.Spec.NetworkSpec.VPC.ElasticIPPool.PublicIpv4PoolFallBackOrder
 		}
+		if restored.Spec.NetworkSpec.VPC.ElasticIPPool.TagSelector != nil {
+			dst.Spec.NetworkSpec.VPC.ElasticIPPool.TagSelector = restored.Spec.NetworkSpec.VPC.ElasticIPPool.TagSelector
+		}
 	}
 
-	// Restore SubnetSpec.ResourceID, SubnetSpec.ParentZoneName, and SubnetSpec.ZoneType fields, if any.
+	// Restore SubnetSpec.ResourceID, SubnetSpec.ParentZoneName, SubnetSpec.ZoneType, SubnetSpec.IPv6Only and SubnetSpec.OutpostArn fields, if any.
 	for _, subnet := range restored.Spec.NetworkSpec.Subnets {
 		for i, dstSubnet := range dst.Spec.NetworkSpec.Subnets {
 			if dstSubnet.ID == subnet.ID {
@@ -138,6 +183,8 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 				if subnet.ZoneType != nil {
 					dstSubnet.ZoneType = subnet.ZoneType
 				}
+				dstSubnet.IPv6Only = subnet.IPv6Only
+				dstSubnet.OutpostArn = subnet.OutpostArn
 				dstSubnet.DeepCopyInto(&dst.Spec.NetworkSpec.Subnets[i])
 			}
 		}
@@ -180,11 +227,13 @@ func restoreControlPlaneLoadBalancer(restored, dst *infrav1.AWSLoadBalancerSpec)
 	dst.HealthCheckProtocol = restored.HealthCheckProtocol
 	dst.HealthCheck = restored.HealthCheck
 	dst.LoadBalancerType = restored.LoadBalancerType
+	dst.CertificateARN = restored.CertificateARN
 	dst.DisableHostsRewrite = restored.DisableHostsRewrite
 	dst.PreserveClientIP = restored.PreserveClientIP
 	dst.IngressRules = restored.IngressRules
 	dst.AdditionalListeners = restored.AdditionalListeners
 	dst.AdditionalSecurityGroups = restored.AdditionalSecurityGroups
+	dst.SecurityGroupIDs = restored.SecurityGroupIDs
 	dst.Scheme = restored.Scheme
 	dst.CrossZoneLoadBalancing = restored.CrossZoneLoadBalancing
 	dst.Subnets = restored.Subnets
@@ -38,6 +38,19 @@ func (r *AWSClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.Template.ObjectMeta = restored.Spec.Template.ObjectMeta
+	dst.Spec.Template.Spec.MaintenanceWindow = restored.Spec.Template.Spec.MaintenanceWindow
+	dst.Spec.Template.Spec.BastionHostRef = restored.Spec.Template.Spec.BastionHostRef
+	dst.Spec.Template.Spec.InstanceConnectEndpoint = restored.Spec.Template.Spec.InstanceConnectEndpoint
+	dst.Spec.Template.Spec.Adoption = restored.Spec.Template.Spec.Adoption
+	dst.Spec.Template.Spec.CloudProviderConfig = restored.Spec.Template.Spec.CloudProviderConfig
+	dst.Spec.Template.Spec.PrivateDNS = restored.Spec.Template.Spec.PrivateDNS
+	dst.Spec.Template.Spec.SessionManager = restored.Spec.Template.Spec.SessionManager
+	dst.Spec.Template.Spec.MachineDefaults = restored.Spec.Template.Spec.MachineDefaults
+	dst.Spec.Template.Spec.GuardDutyRuntimeMonitoring = restored.Spec.Template.Spec.GuardDutyRuntimeMonitoring
+	dst.Spec.Template.Spec.Observability = restored.Spec.Template.Spec.Observability
+	dst.Spec.Template.Spec.FailureDomains = restored.Spec.Template.Spec.FailureDomains
+	dst.Spec.Template.Spec.ControlPlanePlacement = restored.Spec.Template.Spec.ControlPlanePlacement
+	dst.Spec.Template.Spec.Budget = restored.Spec.Template.Spec.Budget
 
 	return nil
 }
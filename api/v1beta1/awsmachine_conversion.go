@@ -44,7 +44,26 @@ func (src *AWSMachine) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.SecurityGroupOverrides = restored.Spec.SecurityGroupOverrides
 	dst.Spec.CapacityReservationID = restored.Spec.CapacityReservationID
 	dst.Spec.MarketType = restored.Spec.MarketType
+	dst.Spec.CPUOptions = restored.Spec.CPUOptions
 	dst.Spec.NetworkInterfaceType = restored.Spec.NetworkInterfaceType
+	dst.Spec.AcceleratorBootstrap = restored.Spec.AcceleratorBootstrap
+	dst.Spec.InstanceRequirements = restored.Spec.InstanceRequirements
+	dst.Spec.SpotFallback = restored.Spec.SpotFallback
+	dst.Spec.PatchManagement = restored.Spec.PatchManagement
+	dst.Spec.AMICopy = restored.Spec.AMICopy
+	dst.Spec.ManagedIAMInstanceProfile = restored.Spec.ManagedIAMInstanceProfile
+	dst.Spec.ScheduledEventRemediation = restored.Spec.ScheduledEventRemediation
+	dst.Spec.CloudWatchLogs = restored.Spec.CloudWatchLogs
+	dst.Status.BootstrapDiagnosis = restored.Status.BootstrapDiagnosis
+	dst.Status.ReachabilityAnalysis = restored.Status.ReachabilityAnalysis
+	dst.Status.Timeline = restored.Status.Timeline
+	dst.Status.Accelerators = restored.Status.Accelerators
+	dst.Status.AcceleratorInventory = restored.Status.AcceleratorInventory
+	dst.Status.InstanceType = restored.Status.InstanceType
+	dst.Status.SpotFallback = restored.Status.SpotFallback
+	dst.Status.PatchManagement = restored.Status.PatchManagement
+	dst.Status.ScheduledEvents = restored.Status.ScheduledEvents
+	dst.Status.CloudWatchLogGroup = restored.Status.CloudWatchLogGroup
 	if restored.Spec.ElasticIPPool != nil {
 		if dst.Spec.ElasticIPPool == nil {
 			dst.Spec.ElasticIPPool = &infrav1.ElasticIPPool{}
@@ -55,6 +74,9 @@ func (src *AWSMachine) ConvertTo(dstRaw conversion.Hub) error {
 		if restored.Spec.ElasticIPPool.PublicIpv4PoolFallBackOrder != nil {
 			dst.Spec.ElasticIPPool.PublicIpv4PoolFallBackOrder = restored.Spec.ElasticIPPool.PublicIpv4PoolFallBackOrder
 		}
+		if restored.Spec.ElasticIPPool.TagSelector != nil {
+			dst.Spec.ElasticIPPool.TagSelector = restored.Spec.ElasticIPPool.TagSelector
+		}
 	}
 
 	return nil
@@ -108,7 +130,18 @@ func (r *AWSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Template.Spec.SecurityGroupOverrides = restored.Spec.Template.Spec.SecurityGroupOverrides
 	dst.Spec.Template.Spec.CapacityReservationID = restored.Spec.Template.Spec.CapacityReservationID
 	dst.Spec.Template.Spec.MarketType = restored.Spec.Template.Spec.MarketType
+	dst.Spec.Template.Spec.CPUOptions = restored.Spec.Template.Spec.CPUOptions
 	dst.Spec.Template.Spec.NetworkInterfaceType = restored.Spec.Template.Spec.NetworkInterfaceType
+	dst.Spec.Template.Spec.AcceleratorBootstrap = restored.Spec.Template.Spec.AcceleratorBootstrap
+	dst.Spec.Template.Spec.InstanceRequirements = restored.Spec.Template.Spec.InstanceRequirements
+	dst.Spec.Template.Spec.SpotFallback = restored.Spec.Template.Spec.SpotFallback
+	dst.Spec.Template.Spec.PatchManagement = restored.Spec.Template.Spec.PatchManagement
+	dst.Spec.Template.Spec.AMICopy = restored.Spec.Template.Spec.AMICopy
+	dst.Spec.Template.Spec.ManagedIAMInstanceProfile = restored.Spec.Template.Spec.ManagedIAMInstanceProfile
+	dst.Spec.Template.Spec.ScheduledEventRemediation = restored.Spec.Template.Spec.ScheduledEventRemediation
+	dst.Spec.Template.Spec.CloudWatchLogs = restored.Spec.Template.Spec.CloudWatchLogs
+	dst.Status.NodeInfo = restored.Status.NodeInfo
+	dst.Status.SpotInterruptions = restored.Status.SpotInterruptions
 	if restored.Spec.Template.Spec.ElasticIPPool != nil {
 		if dst.Spec.Template.Spec.ElasticIPPool == nil {
 			dst.Spec.Template.Spec.ElasticIPPool = &infrav1.ElasticIPPool{}
@@ -119,6 +152,9 @@ func (r *AWSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 		if restored.Spec.Template.Spec.ElasticIPPool.PublicIpv4PoolFallBackOrder != nil {
 			dst.Spec.Template.Spec.ElasticIPPool.PublicIpv4PoolFallBackOrder = restored.Spec.Template.Spec.ElasticIPPool.PublicIpv4PoolFallBackOrder
 		}
+		if restored.Spec.Template.Spec.ElasticIPPool.TagSelector != nil {
+			dst.Spec.Template.Spec.ElasticIPPool.TagSelector = restored.Spec.Template.Spec.ElasticIPPool.TagSelector
+		}
 	}
 
 	return nil
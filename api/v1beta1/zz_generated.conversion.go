@@ -940,6 +940,8 @@ func autoConvert_v1beta2_AWSClusterSpec_To_v1beta1_AWSClusterSpec(in *v1beta2.AW
 	if err := Convert_v1beta2_Bastion_To_v1beta1_Bastion(&in.Bastion, &out.Bastion, s); err != nil {
 		return err
 	}
+	// WARNING: in.BastionHostRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceConnectEndpoint requires manual conversion: does not exist in peer-type
 	out.IdentityRef = (*AWSIdentityReference)(unsafe.Pointer(in.IdentityRef))
 	if in.S3Bucket != nil {
 		in, out := &in.S3Bucket, &out.S3Bucket
@@ -950,6 +952,17 @@ func autoConvert_v1beta2_AWSClusterSpec_To_v1beta1_AWSClusterSpec(in *v1beta2.AW
 	} else {
 		out.S3Bucket = nil
 	}
+	// WARNING: in.MaintenanceWindow requires manual conversion: does not exist in peer-type
+	// WARNING: in.Observability requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailureDomains requires manual conversion: does not exist in peer-type
+	// WARNING: in.ControlPlanePlacement requires manual conversion: does not exist in peer-type
+	// WARNING: in.Adoption requires manual conversion: does not exist in peer-type
+	// WARNING: in.CloudProviderConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrivateDNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.SessionManager requires manual conversion: does not exist in peer-type
+	// WARNING: in.MachineDefaults requires manual conversion: does not exist in peer-type
+	// WARNING: in.GuardDutyRuntimeMonitoring requires manual conversion: does not exist in peer-type
+	// WARNING: in.Budget requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1071,14 +1084,18 @@ func autoConvert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus(in *v1beta
 		out.Bastion = nil
 	}
 	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	// WARNING: in.InstanceConnectEndpoint requires manual conversion: does not exist in peer-type
+	// WARNING: in.GuardDutyRuntimeMonitoring requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailureDomainCount requires manual conversion: does not exist in peer-type
+	// WARNING: in.PendingDisruptiveActions requires manual conversion: does not exist in peer-type
+	// WARNING: in.Adoption requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrivateHostedZoneID requires manual conversion: does not exist in peer-type
+	// WARNING: in.SessionManagerDocumentName requires manual conversion: does not exist in peer-type
+	// WARNING: in.ResourceLimits requires manual conversion: does not exist in peer-type
+	// WARNING: in.Budget requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus is an autogenerated conversion function.
-func Convert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus(in *v1beta2.AWSClusterStatus, out *AWSClusterStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_AWSClusterStatus_To_v1beta1_AWSClusterStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_AWSClusterTemplate_To_v1beta2_AWSClusterTemplate(in *AWSClusterTemplate, out *v1beta2.AWSClusterTemplate, s conversion.Scope) error {
 	out.TypeMeta = in.TypeMeta
 	out.ObjectMeta = in.ObjectMeta
@@ -1246,9 +1263,11 @@ func autoConvert_v1beta2_AWSLoadBalancerSpec_To_v1beta1_AWSLoadBalancerSpec(in *
 	out.HealthCheckProtocol = (*ClassicELBProtocol)(unsafe.Pointer(in.HealthCheckProtocol))
 	// WARNING: in.HealthCheck requires manual conversion: does not exist in peer-type
 	out.AdditionalSecurityGroups = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroups))
+	// WARNING: in.SecurityGroupIDs requires manual conversion: does not exist in peer-type
 	// WARNING: in.AdditionalListeners requires manual conversion: does not exist in peer-type
 	// WARNING: in.IngressRules requires manual conversion: does not exist in peer-type
 	// WARNING: in.LoadBalancerType requires manual conversion: does not exist in peer-type
+	// WARNING: in.CertificateARN requires manual conversion: does not exist in peer-type
 	// WARNING: in.DisableHostsRewrite requires manual conversion: does not exist in peer-type
 	// WARNING: in.PreserveClientIP requires manual conversion: does not exist in peer-type
 	return nil
@@ -1398,9 +1417,12 @@ func autoConvert_v1beta2_AWSMachineSpec_To_v1beta1_AWSMachineSpec(in *v1beta2.AW
 	out.ImageLookupFormat = in.ImageLookupFormat
 	out.ImageLookupOrg = in.ImageLookupOrg
 	out.ImageLookupBaseOS = in.ImageLookupBaseOS
+	// WARNING: in.AMICopy requires manual conversion: does not exist in peer-type
 	out.InstanceType = in.InstanceType
+	// WARNING: in.InstanceRequirements requires manual conversion: does not exist in peer-type
 	out.AdditionalTags = *(*Tags)(unsafe.Pointer(&in.AdditionalTags))
 	out.IAMInstanceProfile = in.IAMInstanceProfile
+	// WARNING: in.ManagedIAMInstanceProfile requires manual conversion: does not exist in peer-type
 	out.PublicIP = (*bool)(unsafe.Pointer(in.PublicIP))
 	// WARNING: in.ElasticIPPool requires manual conversion: does not exist in peer-type
 	if in.AdditionalSecurityGroups != nil {
@@ -1443,12 +1465,18 @@ func autoConvert_v1beta2_AWSMachineSpec_To_v1beta1_AWSMachineSpec(in *v1beta2.AW
 		out.Ignition = nil
 	}
 	out.SpotMarketOptions = (*SpotMarketOptions)(unsafe.Pointer(in.SpotMarketOptions))
+	// WARNING: in.SpotFallback requires manual conversion: does not exist in peer-type
 	// WARNING: in.PlacementGroupName requires manual conversion: does not exist in peer-type
 	// WARNING: in.PlacementGroupPartition requires manual conversion: does not exist in peer-type
 	out.Tenancy = in.Tenancy
 	// WARNING: in.PrivateDNSName requires manual conversion: does not exist in peer-type
 	// WARNING: in.CapacityReservationID requires manual conversion: does not exist in peer-type
 	// WARNING: in.MarketType requires manual conversion: does not exist in peer-type
+	// WARNING: in.CPUOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.AcceleratorBootstrap requires manual conversion: does not exist in peer-type
+	// WARNING: in.PatchManagement requires manual conversion: does not exist in peer-type
+	// WARNING: in.ScheduledEventRemediation requires manual conversion: does not exist in peer-type
+	// WARNING: in.CloudWatchLogs requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1476,14 +1504,19 @@ func autoConvert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(in *v1beta
 	out.FailureReason = (*string)(unsafe.Pointer(in.FailureReason))
 	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
 	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	// WARNING: in.BootstrapDiagnosis requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReachabilityAnalysis requires manual conversion: does not exist in peer-type
+	// WARNING: in.Timeline requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceType requires manual conversion: does not exist in peer-type
+	// WARNING: in.SpotFallback requires manual conversion: does not exist in peer-type
+	// WARNING: in.Accelerators requires manual conversion: does not exist in peer-type
+	// WARNING: in.AcceleratorInventory requires manual conversion: does not exist in peer-type
+	// WARNING: in.PatchManagement requires manual conversion: does not exist in peer-type
+	// WARNING: in.ScheduledEvents requires manual conversion: does not exist in peer-type
+	// WARNING: in.CloudWatchLogGroup requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus is an autogenerated conversion function.
-func Convert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(in *v1beta2.AWSMachineStatus, out *AWSMachineStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_AWSMachineTemplate_To_v1beta2_AWSMachineTemplate(in *AWSMachineTemplate, out *v1beta2.AWSMachineTemplate, s conversion.Scope) error {
 	out.TypeMeta = in.TypeMeta
 	out.ObjectMeta = in.ObjectMeta
@@ -1624,14 +1657,11 @@ func Convert_v1beta1_AWSMachineTemplateStatus_To_v1beta2_AWSMachineTemplateStatu
 
 func autoConvert_v1beta2_AWSMachineTemplateStatus_To_v1beta1_AWSMachineTemplateStatus(in *v1beta2.AWSMachineTemplateStatus, out *AWSMachineTemplateStatus, s conversion.Scope) error {
 	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	// WARNING: in.NodeInfo requires manual conversion: does not exist in peer-type
+	// WARNING: in.SpotInterruptions requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_AWSMachineTemplateStatus_To_v1beta1_AWSMachineTemplateStatus is an autogenerated conversion function.
-func Convert_v1beta2_AWSMachineTemplateStatus_To_v1beta1_AWSMachineTemplateStatus(in *v1beta2.AWSMachineTemplateStatus, out *AWSMachineTemplateStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_AWSMachineTemplateStatus_To_v1beta1_AWSMachineTemplateStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_AWSResourceReference_To_v1beta2_AWSResourceReference(in *AWSResourceReference, out *v1beta2.AWSResourceReference, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	// WARNING: in.ARN requires manual conversion: does not exist in peer-type
@@ -1795,15 +1825,11 @@ func Convert_v1beta1_CNISpec_To_v1beta2_CNISpec(in *CNISpec, out *v1beta2.CNISpe
 }
 
 func autoConvert_v1beta2_CNISpec_To_v1beta1_CNISpec(in *v1beta2.CNISpec, out *CNISpec, s conversion.Scope) error {
+	// WARNING: in.Profile requires manual conversion: does not exist in peer-type
 	out.CNIIngressRules = *(*CNIIngressRules)(unsafe.Pointer(&in.CNIIngressRules))
 	return nil
 }
 
-// Convert_v1beta2_CNISpec_To_v1beta1_CNISpec is an autogenerated conversion function.
-func Convert_v1beta2_CNISpec_To_v1beta1_CNISpec(in *v1beta2.CNISpec, out *CNISpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_CNISpec_To_v1beta1_CNISpec(in, out, s)
-}
-
 func autoConvert_v1beta1_ClassicELBAttributes_To_v1beta2_ClassicELBAttributes(in *ClassicELBAttributes, out *v1beta2.ClassicELBAttributes, s conversion.Scope) error {
 	out.IdleTimeout = time.Duration(in.IdleTimeout)
 	out.CrossZoneLoadBalancing = in.CrossZoneLoadBalancing
@@ -1945,6 +1971,7 @@ func autoConvert_v1beta2_IPv6_To_v1beta1_IPv6(in *v1beta2.IPv6, out *IPv6, s con
 	out.PoolID = in.PoolID
 	out.EgressOnlyInternetGatewayID = (*string)(unsafe.Pointer(in.EgressOnlyInternetGatewayID))
 	// WARNING: in.IPAMPool requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrivateSubnetsIPv6Only requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2057,6 +2084,7 @@ func autoConvert_v1beta2_Instance_To_v1beta1_Instance(in *v1beta2.Instance, out
 	// WARNING: in.PublicIPOnLaunch requires manual conversion: does not exist in peer-type
 	// WARNING: in.CapacityReservationID requires manual conversion: does not exist in peer-type
 	// WARNING: in.MarketType requires manual conversion: does not exist in peer-type
+	// WARNING: in.CPUOptions requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2075,7 +2103,15 @@ func autoConvert_v1beta1_NetworkSpec_To_v1beta2_NetworkSpec(in *NetworkSpec, out
 	} else {
 		out.Subnets = nil
 	}
-	out.CNI = (*v1beta2.CNISpec)(unsafe.Pointer(in.CNI))
+	if in.CNI != nil {
+		in, out := &in.CNI, &out.CNI
+		*out = new(v1beta2.CNISpec)
+		if err := Convert_v1beta1_CNISpec_To_v1beta2_CNISpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CNI = nil
+	}
 	out.SecurityGroupOverrides = *(*map[v1beta2.SecurityGroupRole]string)(unsafe.Pointer(&in.SecurityGroupOverrides))
 	return nil
 }
@@ -2100,11 +2136,22 @@ func autoConvert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(in *v1beta2.NetworkS
 	} else {
 		out.Subnets = nil
 	}
-	out.CNI = (*CNISpec)(unsafe.Pointer(in.CNI))
+	if in.CNI != nil {
+		in, out := &in.CNI, &out.CNI
+		*out = new(CNISpec)
+		if err := Convert_v1beta2_CNISpec_To_v1beta1_CNISpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CNI = nil
+	}
 	out.SecurityGroupOverrides = *(*map[SecurityGroupRole]string)(unsafe.Pointer(&in.SecurityGroupOverrides))
+	// WARNING: in.SecurityGroupEgress requires manual conversion: does not exist in peer-type
 	// WARNING: in.AdditionalControlPlaneIngressRules requires manual conversion: does not exist in peer-type
 	// WARNING: in.AdditionalNodeIngressRules requires manual conversion: does not exist in peer-type
 	// WARNING: in.NodePortIngressRuleCidrBlocks requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalPublicRoutes requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalPrivateRoutes requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2152,6 +2199,9 @@ func autoConvert_v1beta2_NetworkStatus_To_v1beta1_NetworkStatus(in *v1beta2.Netw
 	}
 	// WARNING: in.SecondaryAPIServerELB requires manual conversion: does not exist in peer-type
 	// WARNING: in.NatGatewaysIPs requires manual conversion: does not exist in peer-type
+	// WARNING: in.VPCIPAMPoolAllocation requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalPublicRouteDestinations requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalPrivateRouteDestinations requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2191,8 +2241,10 @@ func autoConvert_v1beta2_S3Bucket_To_v1beta1_S3Bucket(in *v1beta2.S3Bucket, out
 	out.ControlPlaneIAMInstanceProfile = in.ControlPlaneIAMInstanceProfile
 	out.NodesIAMInstanceProfiles = *(*[]string)(unsafe.Pointer(&in.NodesIAMInstanceProfiles))
 	// WARNING: in.PresignedURLDuration requires manual conversion: does not exist in peer-type
+	// WARNING: in.KMSKeyID requires manual conversion: does not exist in peer-type
 	out.Name = in.Name
 	// WARNING: in.BestEffortDeleteObjects requires manual conversion: does not exist in peer-type
+	// WARNING: in.Shared requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2288,11 +2340,13 @@ func autoConvert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(in *v1beta2.SubnetSpec
 	out.AvailabilityZone = in.AvailabilityZone
 	out.IsPublic = in.IsPublic
 	out.IsIPv6 = in.IsIPv6
+	// WARNING: in.IPv6Only requires manual conversion: does not exist in peer-type
 	out.RouteTableID = (*string)(unsafe.Pointer(in.RouteTableID))
 	out.NatGatewayID = (*string)(unsafe.Pointer(in.NatGatewayID))
 	out.Tags = *(*Tags)(unsafe.Pointer(&in.Tags))
 	// WARNING: in.ZoneType requires manual conversion: does not exist in peer-type
 	// WARNING: in.ParentZoneName requires manual conversion: does not exist in peer-type
+	// WARNING: in.OutpostArn requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2340,9 +2394,14 @@ func autoConvert_v1beta2_VPCSpec_To_v1beta1_VPCSpec(in *v1beta2.VPCSpec, out *VP
 	out.AvailabilityZoneUsageLimit = (*int)(unsafe.Pointer(in.AvailabilityZoneUsageLimit))
 	out.AvailabilityZoneSelection = (*AZSelectionScheme)(unsafe.Pointer(in.AvailabilityZoneSelection))
 	// WARNING: in.EmptyRoutesDefaultVPCSecurityGroup requires manual conversion: does not exist in peer-type
+	// WARNING: in.EnableDNSSupport requires manual conversion: does not exist in peer-type
+	// WARNING: in.EnableDNSHostnames requires manual conversion: does not exist in peer-type
+	// WARNING: in.EnableNetworkAddressUsageMetrics requires manual conversion: does not exist in peer-type
 	// WARNING: in.PrivateDNSHostnameTypeOnLaunch requires manual conversion: does not exist in peer-type
 	// WARNING: in.ElasticIPPool requires manual conversion: does not exist in peer-type
 	// WARNING: in.SubnetSchema requires manual conversion: does not exist in peer-type
+	// WARNING: in.NatGateway requires manual conversion: does not exist in peer-type
+	// WARNING: in.SubnetPlan requires manual conversion: does not exist in peer-type
 	return nil
 }
 
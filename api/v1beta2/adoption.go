@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate validates the adoption of an AWSCluster spec.
+func (a *Adoption) Validate() field.ErrorList {
+	var errs field.ErrorList
+
+	if a == nil {
+		return errs
+	}
+
+	path := field.NewPath("spec", "adoption", "tags")
+	for k := range a.Tags {
+		if k == "" {
+			errs = append(errs, field.Invalid(path, k, "key cannot be empty"))
+		}
+	}
+
+	return errs
+}
+
+// ValidateUpdate validates the update of the adoption of an AWSCluster spec.
+func (a *Adoption) ValidateUpdate(old *Adoption) field.ErrorList {
+	var errs field.ErrorList
+
+	// Going back to a dry run would stop reconciling a cluster whose resources may already have been changed.
+	if old != nil && old.Mode == AdoptionModeAdopt && a != nil && a.Mode != AdoptionModeAdopt {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "adoption", "mode"), a.Mode, "field cannot be changed once set to Adopt"))
+	}
+
+	return append(errs, a.Validate()...)
+}
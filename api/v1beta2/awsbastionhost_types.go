@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// AWSBastionHostSpec defines the desired state of AWSBastionHost.
+type AWSBastionHostSpec struct {
+	// ClusterName is the name of the Cluster hosting the bastion host, in the same namespace. The bastion
+	// host is created in a public subnet of the VPC of this cluster, with its identity, SSH key and bastion
+	// security group, and its AWSCluster must reference the bastion host.
+	// Other clusters of the same VPC can reference the bastion host to allow SSH from it.
+	// +kubebuilder:validation:MinLength=1
+	ClusterName string `json:"clusterName"`
+
+	// Replicas is the number of bastion instances, either 0 or 1. Scaling the bastion host to 0
+	// terminates its instance, until it is scaled to 1 again.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// DisableIngressRules will ensure there are no Ingress rules in the bastion host's security group.
+	// Requires AllowedCIDRBlocks to be empty.
+	// +optional
+	DisableIngressRules bool `json:"disableIngressRules,omitempty"`
+
+	// AllowedCIDRBlocks is a list of CIDR blocks allowed to access the bastion host.
+	// They are set as ingress rules for the Bastion host's Security Group (defaults to 0.0.0.0/0).
+	// +optional
+	AllowedCIDRBlocks []string `json:"allowedCIDRBlocks,omitempty"`
+
+	// InstanceType will use the specified instance type for the bastion. If not specified,
+	// Cluster API Provider AWS will use t3.micro for all regions except us-east-1, where t2.micro
+	// will be the default.
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// AMI will use the specified AMI to boot the bastion. If not specified,
+	// the AMI will default to one picked out in public space.
+	// +optional
+	AMI string `json:"ami,omitempty"`
+}
+
+// AWSBastionHostStatus defines the observed state of AWSBastionHost.
+type AWSBastionHostStatus struct {
+	// Ready is true when the bastion instance is running.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// Instance is the bastion instance.
+	// +optional
+	Instance *Instance `json:"instance,omitempty"`
+
+	// VPCID is the ID of the VPC of the bastion host. Only the clusters of this VPC can allow SSH
+	// from the bastion host.
+	// +optional
+	VPCID string `json:"vpcId,omitempty"`
+
+	// SecurityGroupID is the ID of the security group of the bastion host, from which the clusters
+	// referencing the bastion host allow SSH.
+	// +optional
+	SecurityGroupID string `json:"securityGroupId,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=awsbastionhosts,scope=Namespaced,categories=cluster-api,shortName=awsbh
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterName",description="Cluster hosting the bastion host"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="Number of bastion instances"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Bastion instance is running"
+// +kubebuilder:printcolumn:name="Bastion IP",type="string",JSONPath=".status.instance.publicIp",description="Bastion IP address for breakglass access"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".status.vpcId",description="VPC of the bastion host",priority=1
+// +k8s:defaulter-gen=true
+
+// AWSBastionHost is the Schema for the awsbastionhosts API. It defines a bastion host which can be
+// created and deleted independently of the clusters using it, and shared between the clusters of a VPC.
+type AWSBastionHost struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSBastionHostSpec   `json:"spec,omitempty"`
+	Status AWSBastionHostStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AWSBastionHostList contains a list of AWSBastionHost.
+// +k8s:defaulter-gen=true
+type AWSBastionHostList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSBastionHost `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AWSBastionHost{}, &AWSBastionHostList{})
+}
+
+// Bastion returns the bastion of the cluster hosting the bastion host. It is disabled when the bastion
+// host is scaled to 0 or deleted.
+func (r *AWSBastionHost) Bastion() *Bastion {
+	return &Bastion{
+		Enabled:             ptr.Deref(r.Spec.Replicas, 1) > 0 && r.DeletionTimestamp.IsZero(),
+		DisableIngressRules: r.Spec.DisableIngressRules,
+		AllowedCIDRBlocks:   r.Spec.AllowedCIDRBlocks,
+		InstanceType:        r.Spec.InstanceType,
+		AMI:                 r.Spec.AMI,
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func (r *AWSBastionHost) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	w := new(awsBastionHostWebhook)
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(w).
+		WithDefaulter(w).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-awsbastionhost,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=awsbastionhosts,versions=v1beta2,name=validation.awsbastionhost.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta2-awsbastionhost,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=awsbastionhosts,versions=v1beta2,name=default.awsbastionhost.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+type awsBastionHostWebhook struct{}
+
+var _ webhook.CustomDefaulter = &awsBastionHostWebhook{}
+var _ webhook.CustomValidator = &awsBastionHostWebhook{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type.
+func (*awsBastionHostWebhook) Default(_ context.Context, obj runtime.Object) error {
+	r, ok := obj.(*AWSBastionHost)
+	if !ok {
+		return fmt.Errorf("expected an AWSBastionHost object but got %T", r)
+	}
+
+	SetObjectDefaults_AWSBastionHost(r)
+	return nil
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (*awsBastionHostWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	r, ok := obj.(*AWSBastionHost)
+	if !ok {
+		return nil, fmt.Errorf("expected an AWSBastionHost object but got %T", r)
+	}
+
+	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, r.validateSpec())
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (*awsBastionHostWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	r, ok := newObj.(*AWSBastionHost)
+	if !ok {
+		return nil, fmt.Errorf("expected an AWSBastionHost object but got %T", r)
+	}
+
+	old, ok := oldObj.(*AWSBastionHost)
+	if !ok {
+		return nil, fmt.Errorf("expected an AWSBastionHost object but got %T", old)
+	}
+
+	allErrs := r.validateSpec()
+
+	// The bastion host is created in the VPC of the cluster hosting it, it cannot be moved to another cluster.
+	if r.Spec.ClusterName != old.Spec.ClusterName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "clusterName"), r.Spec.ClusterName, "field is immutable"))
+	}
+
+	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (*awsBastionHostWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (r *AWSBastionHost) validateSpec() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.DisableIngressRules && len(r.Spec.AllowedCIDRBlocks) > 0 {
+		allErrs = append(allErrs,
+			field.Forbidden(field.NewPath("spec", "allowedCIDRBlocks"), "cannot be set if spec.disableIngressRules is true"),
+		)
+	}
+
+	for i, cidr := range r.Spec.AllowedCIDRBlocks {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "allowedCIDRBlocks").Index(i), cidr, "must be a valid CIDR block"),
+			)
+		}
+	}
+
+	return allErrs
+}
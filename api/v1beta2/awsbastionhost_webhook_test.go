@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestAWSBastionHostValidateCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    AWSBastionHostSpec
+		wantErr bool
+	}{
+		{
+			name: "allows a bastion host scaled to zero",
+			spec: AWSBastionHostSpec{
+				ClusterName: "cluster",
+				Replicas:    ptr.To[int32](0),
+			},
+		},
+		{
+			name: "allows valid CIDR blocks",
+			spec: AWSBastionHostSpec{
+				ClusterName:       "cluster",
+				AllowedCIDRBlocks: []string{"192.168.0.0/16"},
+			},
+		},
+		{
+			name: "rejects invalid CIDR blocks",
+			spec: AWSBastionHostSpec{
+				ClusterName:       "cluster",
+				AllowedCIDRBlocks: []string{"100.200.300.400/99"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects CIDR blocks when ingress rules are disabled",
+			spec: AWSBastionHostSpec{
+				ClusterName:         "cluster",
+				DisableIngressRules: true,
+				AllowedCIDRBlocks:   []string{"192.168.0.0/16"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects more than one replica",
+			spec: AWSBastionHostSpec{
+				ClusterName: "cluster",
+				Replicas:    ptr.To[int32](2),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bastionHost := &AWSBastionHost{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "bastion-",
+					Namespace:    "default",
+				},
+				Spec: tt.spec,
+			}
+
+			ctx := context.TODO()
+			if err := testEnv.Create(ctx, bastionHost); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			testEnv.Delete(ctx, bastionHost)
+		})
+	}
+}
+
+func TestAWSBastionHostDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	bastionHost := &AWSBastionHost{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "bastion-",
+			Namespace:    "default",
+		},
+		Spec: AWSBastionHostSpec{ClusterName: "cluster"},
+	}
+
+	ctx := context.TODO()
+	g.Expect(testEnv.Create(ctx, bastionHost)).To(Succeed())
+	defer testEnv.Delete(ctx, bastionHost)
+
+	g.Expect(bastionHost.Spec.Replicas).To(Equal(ptr.To[int32](1)))
+	g.Expect(bastionHost.Spec.AllowedCIDRBlocks).To(Equal([]string{"0.0.0.0/0"}))
+}
+
+func TestAWSBastionHostValidateUpdate(t *testing.T) {
+	g := NewWithT(t)
+
+	bastionHost := &AWSBastionHost{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "bastion-",
+			Namespace:    "default",
+		},
+		Spec: AWSBastionHostSpec{ClusterName: "cluster"},
+	}
+
+	ctx := context.TODO()
+	g.Expect(testEnv.Create(ctx, bastionHost)).To(Succeed())
+	defer testEnv.Delete(ctx, bastionHost)
+
+	tests := []struct {
+		name    string
+		update  func(*AWSBastionHost)
+		wantErr bool
+	}{
+		{
+			name: "allows scaling the bastion host to zero",
+			update: func(b *AWSBastionHost) {
+				b.Spec.Replicas = ptr.To[int32](0)
+			},
+		},
+		{
+			name: "rejects changing the cluster hosting the bastion host",
+			update: func(b *AWSBastionHost) {
+				b.Spec.ClusterName = "other-cluster"
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := &AWSBastionHost{}
+			g.Expect(testEnv.Get(ctx, client.ObjectKeyFromObject(bastionHost), updated)).To(Succeed())
+			tt.update(updated)
+
+			if err := testEnv.Update(ctx, updated); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -99,6 +100,24 @@ type AWSClusterSpec struct {
 	// +optional
 	Bastion Bastion `json:"bastion"`
 
+	// BastionHostRef is a reference to an AWSBastionHost of the same namespace, used instead of the bastion
+	// of the cluster. The cluster named by the AWSBastionHost creates its instance, and the other clusters of
+	// its VPC allow SSH from it. Cannot be set when the bastion of the cluster is enabled.
+	// +optional
+	BastionHostRef *corev1.LocalObjectReference `json:"bastionHostRef,omitempty"`
+
+	// InstanceConnectEndpoint contains options to configure an EC2 Instance Connect Endpoint
+	// in the cluster VPC, used to reach the private instances of the cluster over SSH
+	// without a bastion host or public IP addresses.
+	// +optional
+	InstanceConnectEndpoint *InstanceConnectEndpoint `json:"instanceConnectEndpoint,omitempty"`
+
+	// GuardDutyRuntimeMonitoring contains options to prepare the instances of the cluster for
+	// GuardDuty Runtime Monitoring, which needs a VPC endpoint of the GuardDuty data service in
+	// the cluster VPC to report the runtime events collected by its security agent.
+	// +optional
+	GuardDutyRuntimeMonitoring *GuardDutyRuntimeMonitoring `json:"guardDutyRuntimeMonitoring,omitempty"`
+
 	// +optional
 
 	// IdentityRef is a reference to an identity to be used when reconciling the managed control plane.
@@ -111,6 +130,502 @@ type AWSClusterSpec struct {
 	// BootstrapFormatIgnition feature flag to be enabled).
 	// +optional
 	S3Bucket *S3Bucket `json:"s3Bucket,omitempty"`
+
+	// MaintenanceWindow is the recurring window during which disruptive changes are applied, such as the instance
+	// refreshes of the AWSMachinePools of the cluster and the replacement of the rules of its security groups.
+	// Outside of the window, these changes are deferred and listed in the status, while the rest of the
+	// reconciliation continues. When not set, disruptive changes are applied as soon as they are requested.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// Observability configures CloudWatch alarms on the AWS resources of the cluster and a CloudWatch dashboard
+	// showing their metrics. The alarms and the dashboard are deleted with the cluster, or when they are disabled.
+	// +optional
+	Observability *Observability `json:"observability,omitempty"`
+
+	// FailureDomains configures the sources the failure domains of the cluster are derived from, and the
+	// availability zones the control plane machines are not placed in.
+	// When not set, a failure domain is derived from each availability zone of the private subnets.
+	// +optional
+	FailureDomains *FailureDomainsSpec `json:"failureDomains,omitempty"`
+
+	// ControlPlanePlacement constrains the placement of the control plane machines of the cluster, whatever their
+	// AWSMachineTemplate sets. The control plane machines which conflict with it fail to be created.
+	// +optional
+	ControlPlanePlacement *ControlPlanePlacement `json:"controlPlanePlacement,omitempty"`
+
+	// Adoption brings the existing AWS resources of a cluster that was not created by this provider under
+	// its management. The VPC, subnets, control plane load balancer and instances of the cluster are
+	// discovered from their IDs and names in the spec, or from the tags they carry, and reported in the
+	// status along with the changes adopting them makes. Nothing is created nor changed in AWS until the
+	// adoption mode is set to Adopt.
+	// +optional
+	Adoption *Adoption `json:"adoption,omitempty"`
+
+	// CloudProviderConfig maintains the aws-cloud-config ConfigMap in the kube-system namespace of the workload
+	// cluster, holding the configuration of the external AWS cloud-controller-manager and of the CSI drivers
+	// derived from the cluster: its name, region, VPC and subnets. The ConfigMap is written once the control
+	// plane is initialized, and deleted when the configuration is disabled.
+	// +optional
+	CloudProviderConfig *CloudProviderConfig `json:"cloudProviderConfig,omitempty"`
+
+	// PrivateDNS publishes the API server endpoint of the cluster as a record of a Route 53 private hosted zone
+	// associated with the VPC, and uses it as the control plane endpoint. The nodes resolve the API server through
+	// the resolver of the VPC, without depending on public DNS, and the certificates of the API server include the
+	// name as it is the control plane endpoint. It cannot be changed once set.
+	// +optional
+	PrivateDNS *PrivateDNS `json:"privateDNS,omitempty"`
+
+	// SessionManager manages the AWS Systems Manager Session Manager preferences the sessions to the instances
+	// are started with, so that access to the nodes is encrypted and audited. The instances must be managed by
+	// AWS Systems Manager, for example with an instance profile allowing the SSM Agent to register them.
+	// The preferences document is deleted with the cluster, or when the preferences are no longer managed,
+	// if it was created by the controller.
+	// +optional
+	SessionManager *SessionManager `json:"sessionManager,omitempty"`
+
+	// MachineDefaults are the settings inherited by the AWSMachines and AWSMachinePools of the cluster which do
+	// not set them, so that they do not need to be repeated in every template. Changes to the defaults are
+	// applied like changes to the settings of the machines: the metadata options, security groups and tags of
+	// the running instances are updated, while the root volume encryption only applies to new instances.
+	// +optional
+	MachineDefaults *MachineDefaults `json:"machineDefaults,omitempty"`
+
+	// Budget creates an AWS Budget tracking the cost of the AWS resources tagged as owned by the cluster, and
+	// reports in the BudgetWithinLimit condition whether the cost exceeds it. The ownership tag of the cluster
+	// must be activated as a cost allocation tag. The budget is deleted with the cluster, or when it is unset.
+	// +optional
+	Budget *Budget `json:"budget,omitempty"`
+}
+
+// MachineDefaults defines the settings inherited by the machines of a cluster.
+type MachineDefaults struct {
+	// InstanceMetadataOptions are the metadata options of the instances of the machines which do not set
+	// their own.
+	// +optional
+	InstanceMetadataOptions *InstanceMetadataOptions `json:"instanceMetadataOptions,omitempty"`
+
+	// AdditionalSecurityGroups are attached to the instances of all the machines, in addition to the
+	// additional security groups of the machines.
+	// +optional
+	AdditionalSecurityGroups []AWSResourceReference `json:"additionalSecurityGroups,omitempty"`
+
+	// AdditionalTags are added to the instances of all the machines. The additional tags of a machine take
+	// precedence over them, and they take precedence over the additional tags of the cluster.
+	// +optional
+	AdditionalTags Tags `json:"additionalTags,omitempty"`
+
+	// RootVolumeEncryption encrypts the root volumes of the machines which do not set whether their root
+	// volume is encrypted.
+	// +optional
+	RootVolumeEncryption *RootVolumeEncryption `json:"rootVolumeEncryption,omitempty"`
+}
+
+// RootVolumeEncryption defines the encryption of the root volumes of the machines of a cluster.
+type RootVolumeEncryption struct {
+	// EncryptionKey is the KMS key the root volumes are encrypted with. The default KMS key for Amazon EBS
+	// of the account is used when not set.
+	// +optional
+	EncryptionKey string `json:"encryptionKey,omitempty"`
+}
+
+// SessionManagerDefaultDocumentName is the name of the Session Manager preferences document used by the
+// sessions of an account in a region which do not request another one.
+const SessionManagerDefaultDocumentName = "SSM-SessionManagerRunShell"
+
+// SessionManager defines the Session Manager preferences of the sessions to the instances of a cluster.
+type SessionManager struct {
+	// DocumentName is the name of the Session Manager preferences document. It defaults to
+	// SSM-SessionManagerRunShell, which holds the preferences of all the sessions of the account in the region.
+	// Any other document is only used by the sessions requesting it, for example with the --document-name flag
+	// of aws ssm start-session, which an IAM policy can enforce with the ssm:SessionDocumentAccessCheck condition.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_.-]{3,128}$`
+	// +kubebuilder:default=SSM-SessionManagerRunShell
+	// +optional
+	DocumentName string `json:"documentName,omitempty"`
+
+	// KMSKeyID is the ID or ARN of the KMS key the data of the sessions is encrypted with.
+	// +optional
+	KMSKeyID string `json:"kmsKeyID,omitempty"`
+
+	// S3Logs uploads the logs of the sessions to an existing S3 bucket when they end.
+	// +optional
+	S3Logs *SessionManagerS3Logs `json:"s3Logs,omitempty"`
+
+	// CloudWatchLogs sends the logs of the sessions to an existing CloudWatch log group.
+	// +optional
+	CloudWatchLogs *SessionManagerCloudWatchLogs `json:"cloudWatchLogs,omitempty"`
+
+	// IdleSessionTimeoutMinutes is the number of minutes of inactivity after which a session ends.
+	// The Session Manager default of 20 minutes is used when not set.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=60
+	// +optional
+	IdleSessionTimeoutMinutes int32 `json:"idleSessionTimeoutMinutes,omitempty"`
+}
+
+// SessionManagerS3Logs defines the S3 bucket the logs of the sessions are uploaded to.
+type SessionManagerS3Logs struct {
+	// BucketName is the name of the S3 bucket.
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=63
+	BucketName string `json:"bucketName"`
+
+	// KeyPrefix is the prefix of the keys of the logs in the bucket.
+	// +optional
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+
+	// EncryptionEnabled only uploads the logs if the bucket is encrypted.
+	// +optional
+	EncryptionEnabled bool `json:"encryptionEnabled,omitempty"`
+}
+
+// SessionManagerCloudWatchLogs defines the CloudWatch log group the logs of the sessions are sent to.
+type SessionManagerCloudWatchLogs struct {
+	// LogGroupName is the name of the CloudWatch log group.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	LogGroupName string `json:"logGroupName"`
+
+	// EncryptionEnabled only sends the logs if the log group is encrypted.
+	// +optional
+	EncryptionEnabled bool `json:"encryptionEnabled,omitempty"`
+
+	// Streaming sends the logs of the sessions continuously while they run, instead of when they end.
+	// +optional
+	Streaming bool `json:"streaming,omitempty"`
+}
+
+// PrivateDNS configures the private DNS record of the API server endpoint of a cluster.
+type PrivateDNS struct {
+	// Name is the fully qualified domain name of the API server endpoint, for example api.my-cluster.example.internal.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)+[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// HostedZoneID is the ID of an existing private hosted zone to create the record in, for example one shared
+	// with other clusters of the VPC. When not set, the private hosted zone of the parent domain of the name which is
+	// associated with the VPC is used, and created with the cluster if there is none. A hosted zone created with the
+	// cluster is deleted with it.
+	// +optional
+	HostedZoneID string `json:"hostedZoneID,omitempty"`
+}
+
+// CloudProviderConfig configures the cloud provider configuration generated for a cluster.
+type CloudProviderConfig struct {
+	// RoleARN is the ARN of the IAM role the cloud-controller-manager assumes to call the AWS APIs.
+	// When not set, the cloud-controller-manager uses the credentials of the instances it runs on.
+	// +kubebuilder:validation:Pattern=`^arn:[^:]+:iam::[0-9]{12}:role/.+$`
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+}
+
+// AdoptionMode defines how the existing resources of a cluster are adopted.
+// +kubebuilder:validation:Enum=DryRun;Adopt
+type AdoptionMode string
+
+const (
+	// AdoptionModeDryRun discovers the existing resources of the cluster and reports the changes adopting
+	// them makes, without reconciling the cluster.
+	AdoptionModeDryRun = AdoptionMode("DryRun")
+
+	// AdoptionModeAdopt records the discovered resources in the spec of the cluster, and reconciles the
+	// cluster on top of them.
+	AdoptionModeAdopt = AdoptionMode("Adopt")
+)
+
+// Adoption defines the adoption of the existing AWS resources of a cluster.
+type Adoption struct {
+	// Mode is how the existing resources are adopted. Defaults to DryRun.
+	// A cluster can't go back to DryRun once it is adopted.
+	// +kubebuilder:default=DryRun
+	// +optional
+	Mode AdoptionMode `json:"mode,omitempty"`
+
+	// Tags are the tags carried by every existing resource of the cluster. They are used to discover the
+	// VPC, subnets, control plane load balancer and instances which are not referenced in the spec.
+	// +optional
+	Tags Tags `json:"tags,omitempty"`
+}
+
+// AdoptedResourceKind is the kind of an existing resource discovered for the adoption of a cluster.
+// +kubebuilder:validation:Enum=VPC;Subnet;LoadBalancer;Instance
+type AdoptedResourceKind string
+
+const (
+	// AdoptedResourceKindVPC is a VPC.
+	AdoptedResourceKindVPC = AdoptedResourceKind("VPC")
+
+	// AdoptedResourceKindSubnet is a subnet.
+	AdoptedResourceKindSubnet = AdoptedResourceKind("Subnet")
+
+	// AdoptedResourceKindLoadBalancer is a control plane load balancer.
+	AdoptedResourceKindLoadBalancer = AdoptedResourceKind("LoadBalancer")
+
+	// AdoptedResourceKindInstance is an EC2 instance.
+	AdoptedResourceKindInstance = AdoptedResourceKind("Instance")
+)
+
+// AdoptionSource is how an existing resource was discovered.
+// +kubebuilder:validation:Enum=Spec;Tags;VPC
+type AdoptionSource string
+
+const (
+	// AdoptionSourceSpec is a resource referenced by its ID or name in the spec of the cluster.
+	AdoptionSourceSpec = AdoptionSource("Spec")
+
+	// AdoptionSourceTags is a resource carrying the adoption tags.
+	AdoptionSourceTags = AdoptionSource("Tags")
+
+	// AdoptionSourceVPC is a subnet of the adopted VPC, when no adoption tags are set.
+	AdoptionSourceVPC = AdoptionSource("VPC")
+)
+
+// AdoptedResource is an existing resource discovered for the adoption of a cluster.
+type AdoptedResource struct {
+	// Kind is the kind of the resource.
+	Kind AdoptedResourceKind `json:"kind"`
+
+	// ID is the ID of the resource, or its name for a load balancer.
+	ID string `json:"id"`
+
+	// Source is how the resource was discovered.
+	Source AdoptionSource `json:"source"`
+}
+
+// AdoptionStatus defines the observed state of the adoption of the existing resources of a cluster.
+type AdoptionStatus struct {
+	// Resources are the existing resources discovered for the cluster.
+	// +optional
+	Resources []AdoptedResource `json:"resources,omitempty"`
+
+	// Changes are the changes adopting the discovered resources makes, to the spec of the cluster or in AWS.
+	// +optional
+	Changes []string `json:"changes,omitempty"`
+}
+
+// MaintenanceWindowDay is a day of the week of a maintenance window.
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type MaintenanceWindowDay string
+
+// MaintenanceWindow defines a recurring window during which disruptive changes are applied.
+type MaintenanceWindow struct {
+	// Days are the days of the week on which the window opens, in UTC.
+	// Defaults to every day of the week.
+	// +listType=set
+	// +optional
+	Days []MaintenanceWindowDay `json:"days,omitempty"`
+
+	// StartTime is the time of the day at which the window opens, in UTC, in the HH:MM format.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+
+	// Duration is how long the window stays open, at most a week. For example 4h.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// DisruptiveActionType is the type of a disruptive action.
+type DisruptiveActionType string
+
+const (
+	// DisruptiveActionInstanceRefresh is the instance refresh of an Auto Scaling group, replacing its instances.
+	DisruptiveActionInstanceRefresh = DisruptiveActionType("InstanceRefresh")
+
+	// DisruptiveActionSecurityGroupRuleReplacement is the revocation of the rules of a security group
+	// which are no longer desired, including the previous version of updated rules.
+	DisruptiveActionSecurityGroupRuleReplacement = DisruptiveActionType("SecurityGroupRuleReplacement")
+)
+
+// DisruptiveAction is a disruptive action deferred until the next maintenance window.
+type DisruptiveAction struct {
+	// Type is the type of the action.
+	Type DisruptiveActionType `json:"type"`
+
+	// Target is the ID or the name of the AWS resource the action applies to.
+	Target string `json:"target"`
+
+	// Reason is a human readable description of why the action is required.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Since is the time at which the action was first deferred.
+	Since metav1.Time `json:"since"`
+}
+
+// Observability configures the CloudWatch alarms and dashboard of a cluster.
+type Observability struct {
+	// Alarms enables CloudWatch alarms on the port allocation errors of the NAT gateways of the cluster, the
+	// unhealthy targets of its network load balancers and the failed launches of its Auto Scaling groups.
+	// +optional
+	Alarms *CloudWatchAlarms `json:"alarms,omitempty"`
+
+	// Dashboard enables a CloudWatch dashboard showing the metrics of the AWS resources of the cluster.
+	// +optional
+	Dashboard bool `json:"dashboard,omitempty"`
+}
+
+// CloudWatchAlarms configures the CloudWatch alarms of a cluster.
+type CloudWatchAlarms struct {
+	// Actions are the ARNs of the actions executed when an alarm transitions to the ALARM state,
+	// for example SNS topics.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	Actions []string `json:"actions,omitempty"`
+}
+
+// BudgetTimeUnit is the period the limit of a budget applies to.
+// +kubebuilder:validation:Enum=DAILY;MONTHLY;QUARTERLY;ANNUALLY
+type BudgetTimeUnit string
+
+const (
+	// BudgetTimeUnitDaily resets the spend of the budget every day.
+	BudgetTimeUnitDaily = BudgetTimeUnit("DAILY")
+	// BudgetTimeUnitMonthly resets the spend of the budget every month.
+	BudgetTimeUnitMonthly = BudgetTimeUnit("MONTHLY")
+	// BudgetTimeUnitQuarterly resets the spend of the budget every quarter.
+	BudgetTimeUnitQuarterly = BudgetTimeUnit("QUARTERLY")
+	// BudgetTimeUnitAnnually resets the spend of the budget every year.
+	BudgetTimeUnitAnnually = BudgetTimeUnit("ANNUALLY")
+)
+
+// BudgetNotificationType is the spend a budget notification is sent for.
+// +kubebuilder:validation:Enum=ACTUAL;FORECASTED
+type BudgetNotificationType string
+
+const (
+	// BudgetNotificationTypeActual notifies when the actual spend reaches the threshold.
+	BudgetNotificationTypeActual = BudgetNotificationType("ACTUAL")
+	// BudgetNotificationTypeForecasted notifies when the forecasted spend reaches the threshold.
+	BudgetNotificationTypeForecasted = BudgetNotificationType("FORECASTED")
+)
+
+// Budget configures the AWS Budget of a cluster.
+type Budget struct {
+	// LimitAmount is the cost limit of the cluster for each period, in US dollars, e.g. "500" or "1250.50".
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]{1,2})?$`
+	LimitAmount string `json:"limitAmount"`
+
+	// TimeUnit is the period the limit applies to. Defaults to MONTHLY.
+	// +kubebuilder:default=MONTHLY
+	// +optional
+	TimeUnit BudgetTimeUnit `json:"timeUnit,omitempty"`
+
+	// Notifications are the thresholds at which the SNS topic is notified.
+	// Requires snsTopicARN.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	Notifications []BudgetNotification `json:"notifications,omitempty"`
+
+	// SNSTopicARN is the ARN of the SNS topic notified when the spend reaches the threshold of a notification.
+	// The policy of the topic must allow the budgets.amazonaws.com service principal to publish to it.
+	// +optional
+	SNSTopicARN string `json:"snsTopicARN,omitempty"`
+}
+
+// BudgetNotification is a threshold of a budget at which its subscribers are notified.
+type BudgetNotification struct {
+	// Type is the spend compared to the threshold. Defaults to ACTUAL.
+	// +kubebuilder:default=ACTUAL
+	// +optional
+	Type BudgetNotificationType `json:"type,omitempty"`
+
+	// ThresholdPercent is the percentage of the limit of the budget the spend is compared to.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	ThresholdPercent int32 `json:"thresholdPercent"`
+}
+
+// BudgetStatus is the spend of the AWS Budget of a cluster, as last calculated by AWS Budgets.
+type BudgetStatus struct {
+	// Name is the name of the budget.
+	Name string `json:"name"`
+
+	// ActualSpend is the cost of the cluster in the current period, in US dollars.
+	// +optional
+	ActualSpend string `json:"actualSpend,omitempty"`
+
+	// ForecastedSpend is the cost of the cluster forecasted for the current period, in US dollars.
+	// +optional
+	ForecastedSpend string `json:"forecastedSpend,omitempty"`
+
+	// LastUpdated is the time the spend was last calculated by AWS Budgets.
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+}
+
+// FailureDomainSource is a source the failure domains of a cluster are derived from.
+// +kubebuilder:validation:Enum=availability-zone;local-zone;outpost;placement-partition
+type FailureDomainSource string
+
+const (
+	// FailureDomainSourceAvailabilityZone derives a failure domain from each availability zone of the private
+	// subnets, named after the zone.
+	FailureDomainSourceAvailabilityZone = FailureDomainSource("availability-zone")
+
+	// FailureDomainSourceLocalZone derives a failure domain from each Local Zone of the private subnets, named
+	// after the zone. Control plane machines are never placed in Local Zones.
+	FailureDomainSourceLocalZone = FailureDomainSource("local-zone")
+
+	// FailureDomainSourceOutpost derives a failure domain from each Outpost of the private subnets, named after
+	// the availability zone and the ID of the Outpost. Control plane machines are never placed in Outposts.
+	FailureDomainSourceOutpost = FailureDomainSource("outpost")
+
+	// FailureDomainSourcePlacementPartition derives a failure domain from each availability zone of the private
+	// subnets and partition of the placement group, named <zone>-partition-<number>.
+	FailureDomainSourcePlacementPartition = FailureDomainSource("placement-partition")
+)
+
+// FailureDomainsSpec configures the failure domains of a cluster.
+type FailureDomainsSpec struct {
+	// Sources are the sources the failure domains of the cluster are derived from.
+	// Defaults to availability-zone.
+	// +listType=set
+	// +optional
+	Sources []FailureDomainSource `json:"sources,omitempty"`
+
+	// PlacementGroupName is the name of the partition placement group the machines of the placement-partition
+	// failure domains are launched in, unless their AWSMachine sets a placement group.
+	// The placement group must already exist. Required with the placement-partition source.
+	// +optional
+	PlacementGroupName string `json:"placementGroupName,omitempty"`
+
+	// PlacementGroupPartitionCount is the number of partitions of the placement group.
+	// Required with the placement-partition source.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=7
+	// +optional
+	PlacementGroupPartitionCount int64 `json:"placementGroupPartitionCount,omitempty"`
+
+	// ControlPlaneExcludedZones are the availability zones the control plane machines are not placed in.
+	// The subnets of these zones are still used by the worker machines and the load balancers.
+	// +listType=set
+	// +optional
+	ControlPlaneExcludedZones []string `json:"controlPlaneExcludedZones,omitempty"`
+}
+
+// ControlPlanePlacement constrains the placement of the control plane machines of a cluster.
+type ControlPlanePlacement struct {
+	// SubnetIDs are the IDs of the subnets dedicated to the control plane machines. The control plane machines are
+	// only launched in these subnets, and the control plane failure domains are limited to their availability zones.
+	// +listType=set
+	// +optional
+	SubnetIDs []string `json:"subnetIDs,omitempty"`
+
+	// Tenancy is the tenancy of the control plane instances, used when their AWSMachine does not set one.
+	// +kubebuilder:validation:Enum:=default;dedicated;host
+	// +optional
+	Tenancy string `json:"tenancy,omitempty"`
+
+	// PlacementGroupName is the name of the placement group the control plane instances are launched in, used when
+	// their AWSMachine does not set one.
+	// +optional
+	PlacementGroupName string `json:"placementGroupName,omitempty"`
+
+	// AllowedInstanceTypes are the instance types the control plane instances can use.
+	// When not set, any instance type can be used.
+	// +listType=set
+	// +optional
+	AllowedInstanceTypes []string `json:"allowedInstanceTypes,omitempty"`
 }
 
 // AWSIdentityKind defines allowed AWS identity types.
@@ -166,6 +681,122 @@ type Bastion struct {
 	AMI string `json:"ami,omitempty"`
 }
 
+// InstanceConnectEndpoint defines an EC2 Instance Connect Endpoint.
+type InstanceConnectEndpoint struct {
+	// Enabled allows this provider to create an EC2 Instance Connect Endpoint in a private
+	// subnet of the cluster VPC, and to allow SSH from it to the cluster instances.
+	// +optional
+	Enabled bool `json:"enabled"`
+}
+
+// GuardDutyAgentManagement defines how the GuardDuty security agent is installed on the instances of a cluster.
+type GuardDutyAgentManagement string
+
+const (
+	// GuardDutyAgentManagementAutomated lets GuardDuty install and update the security agent on the instances,
+	// when the automated agent configuration of GuardDuty Runtime Monitoring is enabled for the account.
+	GuardDutyAgentManagementAutomated = GuardDutyAgentManagement("Automated")
+
+	// GuardDutyAgentManagementManual excludes the instances from the automated agent configuration of GuardDuty,
+	// the security agent being installed by other means, e.g. in the AMI.
+	GuardDutyAgentManagementManual = GuardDutyAgentManagement("Manual")
+)
+
+// GuardDutyRuntimeMonitoring defines the GuardDuty Runtime Monitoring prerequisites of the instances of a cluster.
+type GuardDutyRuntimeMonitoring struct {
+	// Enabled allows this provider to create a VPC endpoint of the GuardDuty data service in the private
+	// subnets of a managed cluster VPC, and to tag the instances of the cluster for the GuardDuty security agent.
+	// +optional
+	Enabled bool `json:"enabled"`
+
+	// AgentManagement defines how the GuardDuty security agent is installed on the instances of the cluster.
+	// The instances are tagged with GuardDutyManaged=true when set to Automated, and with
+	// GuardDutyManaged=false when set to Manual.
+	// +kubebuilder:validation:Enum=Automated;Manual
+	// +kubebuilder:default=Automated
+	// +optional
+	AgentManagement GuardDutyAgentManagement `json:"agentManagement,omitempty"`
+}
+
+// GuardDutyRuntimeMonitoringStatus defines the observed state of the GuardDuty Runtime Monitoring prerequisites.
+type GuardDutyRuntimeMonitoringStatus struct {
+	// VPCEndpointID is the identifier of the VPC endpoint of the GuardDuty data service.
+	// +optional
+	VPCEndpointID string `json:"vpcEndpointID,omitempty"`
+}
+
+// ResourceLimits reports the usage of the limits of the AWS account relevant to a cluster.
+type ResourceLimits struct {
+	// ElasticIPs is the usage of the quota limiting the number of Elastic IP addresses in the region.
+	// +optional
+	ElasticIPs *QuotaUsage `json:"elasticIPs,omitempty"`
+
+	// VCPUs are the usages of the vCPU quotas applying to the instance types of the cluster.
+	// +optional
+	VCPUs []QuotaUsage `json:"vcpus,omitempty"`
+
+	// InstanceTypes are the network interface limits of the instance types of the cluster.
+	// +optional
+	InstanceTypes []InstanceTypeNetworkLimits `json:"instanceTypes,omitempty"`
+
+	// LastUpdated is the time the limits were last read.
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+}
+
+// QuotaUsage is the usage of a quota of the account.
+type QuotaUsage struct {
+	// QuotaCode is the code of the quota in Service Quotas.
+	QuotaCode string `json:"quotaCode"`
+
+	// QuotaName is the name of the quota.
+	// +optional
+	QuotaName string `json:"quotaName,omitempty"`
+
+	// Limit is the value of the quota.
+	Limit int64 `json:"limit"`
+
+	// Used is the amount of the quota used by the account.
+	Used int64 `json:"used"`
+
+	// Available is the amount of the quota left for new resources.
+	Available int64 `json:"available"`
+
+	// InstanceTypes are the instance types of the cluster the quota applies to.
+	// +optional
+	InstanceTypes []string `json:"instanceTypes,omitempty"`
+}
+
+// InstanceTypeNetworkLimits are the network interface limits of an instance type.
+type InstanceTypeNetworkLimits struct {
+	// InstanceType is the instance type.
+	InstanceType string `json:"instanceType"`
+
+	// MaximumNetworkInterfaces is the maximum number of network interfaces of an instance.
+	MaximumNetworkInterfaces int64 `json:"maximumNetworkInterfaces"`
+
+	// IPv4AddressesPerInterface is the maximum number of IPv4 addresses per network interface.
+	IPv4AddressesPerInterface int64 `json:"ipv4AddressesPerInterface"`
+
+	// IPv6AddressesPerInterface is the maximum number of IPv6 addresses per network interface.
+	// +optional
+	IPv6AddressesPerInterface int64 `json:"ipv6AddressesPerInterface,omitempty"`
+}
+
+// InstanceConnectEndpointStatus defines the observed state of an EC2 Instance Connect Endpoint.
+type InstanceConnectEndpointStatus struct {
+	// ID is the identifier of the endpoint.
+	ID string `json:"id"`
+
+	// SubnetID is the identifier of the subnet the endpoint is created in.
+	// +optional
+	SubnetID string `json:"subnetID,omitempty"`
+
+	// DNSName is the DNS name of the endpoint.
+	// +optional
+	DNSName string `json:"dnsName,omitempty"`
+}
+
 // LoadBalancerType defines the type of load balancer to use.
 type LoadBalancerType string
 
@@ -174,7 +805,8 @@ var (
 	LoadBalancerTypeClassic = LoadBalancerType("classic")
 	// LoadBalancerTypeELB is the ELB type.
 	LoadBalancerTypeELB = LoadBalancerType("elb")
-	// LoadBalancerTypeALB is the ALB type.
+	// LoadBalancerTypeALB is the ALB type. It is only supported by the secondary control plane load balancer:
+	// an Application Load Balancer terminates TLS, so it cannot be the control plane endpoint of a cluster.
 	LoadBalancerTypeALB = LoadBalancerType("alb")
 	// LoadBalancerTypeNLB is the NLB type.
 	LoadBalancerTypeNLB = LoadBalancerType("nlb")
@@ -229,6 +861,16 @@ type AWSLoadBalancerSpec struct {
 	// +optional
 	AdditionalSecurityGroups []string `json:"additionalSecurityGroups,omitempty"`
 
+	// SecurityGroupIDs are the IDs of existing security groups attached to the load balancer instead of
+	// the security group managed by CAPA, for example security groups managed centrally for load balancers.
+	// When set on all the control plane load balancers, the CAPA managed apiserver-lb security group is not
+	// created, and the control plane security group allows the Kubernetes API traffic from these security groups.
+	// The security groups must allow the traffic to the API server, including from the nodes of the cluster.
+	// A Network Load Balancer created without security groups cannot have security groups attached later.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIDs,omitempty"`
+
 	// AdditionalListeners sets the additional listeners for the control plane load balancer.
 	// This is only applicable to Network Load Balancer (NLB) types for the time being.
 	// +listType=map
@@ -241,10 +883,21 @@ type AWSLoadBalancerSpec struct {
 	IngressRules []IngressRule `json:"ingressRules,omitempty"`
 
 	// LoadBalancerType sets the type for a load balancer. The default type is classic.
+	// The alb type is not supported by the primary control plane load balancer, which is the control plane
+	// endpoint: an Application Load Balancer terminates TLS and does not forward the client certificates the
+	// kubelets and the Cluster API kubeconfigs authenticate with. It can only be set on the secondary control
+	// plane load balancer.
 	// +kubebuilder:default=classic
 	// +kubebuilder:validation:Enum:=classic;elb;alb;nlb;disabled
 	LoadBalancerType LoadBalancerType `json:"loadBalancerType,omitempty"`
 
+	// CertificateARN is the ARN of the ACM certificate of the HTTPS listener of an Application Load Balancer.
+	// The Application Load Balancer terminates TLS with this certificate and forwards the requests to the API
+	// servers over HTTPS, so that it does not forward the client certificates: it can only be the secondary
+	// control plane load balancer. Required for, and only supported by, the alb load balancer type.
+	// +optional
+	CertificateARN string `json:"certificateARN,omitempty"`
+
 	// DisableHostsRewrite disabled the hair pinning issue solution that adds the NLB's address as 127.0.0.1 to the hosts
 	// file of each instance. This is by default, false.
 	DisableHostsRewrite bool `json:"disableHostsRewrite,omitempty"`
@@ -281,6 +934,44 @@ type AWSClusterStatus struct {
 	FailureDomains clusterv1.FailureDomains `json:"failureDomains,omitempty"`
 	Bastion        *Instance                `json:"bastion,omitempty"`
 	Conditions     clusterv1.Conditions     `json:"conditions,omitempty"`
+
+	// InstanceConnectEndpoint is the EC2 Instance Connect Endpoint created for the cluster, if any.
+	// +optional
+	InstanceConnectEndpoint *InstanceConnectEndpointStatus `json:"instanceConnectEndpoint,omitempty"`
+
+	// GuardDutyRuntimeMonitoring is the observed state of the GuardDuty Runtime Monitoring prerequisites
+	// created for the cluster, if any.
+	// +optional
+	GuardDutyRuntimeMonitoring *GuardDutyRuntimeMonitoringStatus `json:"guardDutyRuntimeMonitoring,omitempty"`
+
+	// FailureDomainCount is the number of failure domains of the cluster, displayed by kubectl.
+	// +optional
+	FailureDomainCount int32 `json:"failureDomainCount,omitempty"`
+
+	// PendingDisruptiveActions are the disruptive actions deferred until the next maintenance window.
+	// +optional
+	PendingDisruptiveActions DisruptiveActions `json:"pendingDisruptiveActions,omitempty"`
+
+	// Adoption is the outcome of the discovery of the existing resources of the cluster, when it is adopted.
+	// +optional
+	Adoption *AdoptionStatus `json:"adoption,omitempty"`
+
+	// PrivateHostedZoneID is the ID of the private hosted zone created with the cluster for its private DNS record.
+	// +optional
+	PrivateHostedZoneID string `json:"privateHostedZoneID,omitempty"`
+
+	// SessionManagerDocumentName is the name of the Session Manager preferences document managed for the cluster.
+	// +optional
+	SessionManagerDocumentName string `json:"sessionManagerDocumentName,omitempty"`
+
+	// ResourceLimits reports the usage of the limits of the AWS account the cluster can run into. It is only set
+	// when the ResourceLimitsReporting feature gate is enabled.
+	// +optional
+	ResourceLimits *ResourceLimits `json:"resourceLimits,omitempty"`
+
+	// Budget is the spend of the AWS Budget of the cluster, if any.
+	// +optional
+	Budget *BudgetStatus `json:"budget,omitempty"`
 }
 
 // S3Bucket defines a supporting S3 bucket for the cluster, currently can be optionally used for Ignition.
@@ -304,6 +995,12 @@ type S3Bucket struct {
 	// +optional
 	PresignedURLDuration *metav1.Duration `json:"presignedURLDuration,omitempty"`
 
+	// KMSKeyID is the ID, alias or ARN of the customer managed KMS key the bootstrap data objects are
+	// encrypted with. The IAM instance profiles, or the controller when presigned URLs are used, must be
+	// allowed to decrypt with the key. Defaults to the AWS managed key of S3.
+	// +optional
+	KMSKeyID string `json:"kmsKeyID,omitempty"`
+
 	// Name defines name of S3 Bucket to be created.
 	// +kubebuilder:validation:MinLength:=3
 	// +kubebuilder:validation:MaxLength:=63
@@ -313,6 +1010,13 @@ type S3Bucket struct {
 	// BestEffortDeleteObjects defines whether access/permission errors during object deletion should be ignored.
 	// +optional
 	BestEffortDeleteObjects *bool `json:"bestEffortDeleteObjects,omitempty"`
+
+	// Shared defines whether the bucket is shared with other clusters, e.g. a bucket per namespace or per
+	// account. A shared bucket must already exist, and is neither created, tagged nor deleted. The bootstrap
+	// data objects of the cluster are stored under the "<namespace>/<cluster name>/" prefix of the bucket, and
+	// only the statements of the bucket policy granting access to this prefix are managed.
+	// +optional
+	Shared bool `json:"shared,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -321,9 +1025,13 @@ type S3Bucket struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this AWSCluster belongs"
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Cluster infrastructure is ready for EC2 instances"
+// +kubebuilder:printcolumn:name="Infrastructure",type="string",JSONPath=".status.conditions[?(@.type==\"InfrastructureReady\")].status",description="Summary of the readiness of the cluster infrastructure"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",description="Reason the cluster infrastructure is not ready",priority=1
 // +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.network.vpc.id",description="AWS VPC the cluster is using"
 // +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".spec.controlPlaneEndpoint",description="API Endpoint",priority=1
+// +kubebuilder:printcolumn:name="LB DNS",type="string",JSONPath=".status.networkStatus.apiServerElb.dnsName",description="DNS name of the control plane load balancer",priority=1
 // +kubebuilder:printcolumn:name="Bastion IP",type="string",JSONPath=".status.bastion.publicIp",description="Bastion IP address for breakglass access"
+// +kubebuilder:printcolumn:name="Failure Domains",type="integer",JSONPath=".status.failureDomainCount",description="Number of failure domains of the cluster"
 // +k8s:defaulter-gen=true
 
 // AWSCluster is the schema for Amazon EC2 based Kubernetes Cluster API.
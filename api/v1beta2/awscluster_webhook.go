@@ -20,12 +20,15 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/google/go-cmp/cmp"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -72,10 +75,18 @@ func (*awsClusterWebhook) ValidateCreate(_ context.Context, obj runtime.Object)
 	var allWarnings admission.Warnings
 
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
+	allErrs = append(allErrs, validateBastionHostRef(&r.Spec)...)
 	allErrs = append(allErrs, r.validateSSHKeyName()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
+	allErrs = append(allErrs, r.Spec.MaintenanceWindow.Validate()...)
+	allErrs = append(allErrs, r.Spec.MachineDefaults.Validate()...)
+	allErrs = append(allErrs, r.Spec.Budget.Validate()...)
+	allErrs = append(allErrs, r.Spec.FailureDomains.Validate()...)
+	allErrs = append(allErrs, r.Spec.Adoption.Validate()...)
+	allErrs = append(allErrs, r.validatePrivateDNS()...)
 	allErrs = append(allErrs, r.validateNetwork()...)
+	allErrs = append(allErrs, validateWriteFreezeAnnotation(r)...)
 
 	warnings, errs := r.validateControlPlaneLBs()
 	if len(errs) > 0 {
@@ -104,6 +115,7 @@ func (*awsClusterWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runti
 	var allWarnings admission.Warnings
 
 	allErrs = append(allErrs, r.validateGCTasksAnnotation()...)
+	allErrs = append(allErrs, validateWriteFreezeAnnotation(r)...)
 
 	oldC, ok := oldObj.(*AWSCluster)
 	if !ok {
@@ -129,6 +141,12 @@ func (*awsClusterWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runti
 
 		allErrs = append(allErrs, r.validateControlPlaneLoadBalancerUpdate(oldLB, newLB)...)
 	}
+	if r.Spec.ControlPlaneLoadBalancer != nil {
+		allErrs = append(allErrs, validateApplicationLoadBalancer(field.NewPath("spec", "controlPlaneLoadBalancer"), r.Spec.ControlPlaneLoadBalancer, false)...)
+	}
+	if r.Spec.SecondaryControlPlaneLoadBalancer != nil {
+		allErrs = append(allErrs, validateApplicationLoadBalancer(field.NewPath("spec", "secondaryControlPlaneLoadBalancer"), r.Spec.SecondaryControlPlaneLoadBalancer, true)...)
+	}
 
 	if !cmp.Equal(oldC.Spec.ControlPlaneEndpoint, clusterv1.APIEndpoint{}) &&
 		!cmp.Equal(r.Spec.ControlPlaneEndpoint, oldC.Spec.ControlPlaneEndpoint) {
@@ -166,8 +184,22 @@ func (*awsClusterWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runti
 	}
 
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
+	allErrs = append(allErrs, validateBastionHostRef(&r.Spec)...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
+	allErrs = append(allErrs, r.Spec.MaintenanceWindow.Validate()...)
+	allErrs = append(allErrs, r.Spec.MachineDefaults.Validate()...)
+	allErrs = append(allErrs, r.Spec.Budget.Validate()...)
+	allErrs = append(allErrs, r.Spec.FailureDomains.Validate()...)
+	allErrs = append(allErrs, r.Spec.Adoption.ValidateUpdate(oldC.Spec.Adoption)...)
+	allErrs = append(allErrs, r.validateSecondaryCidrBlocks()...)
+	allErrs = append(allErrs, r.validatePrivateDNS()...)
+
+	if !cmp.Equal(oldC.Spec.PrivateDNS, r.Spec.PrivateDNS) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "privateDNS"), r.Spec.PrivateDNS, "field is immutable"),
+		)
+	}
 
 	if r.Spec.ControlPlaneLoadBalancer != nil {
 		if r.Spec.ControlPlaneLoadBalancer.LoadBalancerType == LoadBalancerTypeClassic {
@@ -188,6 +220,18 @@ func (*awsClusterWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runti
 	return allWarnings, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
+// isClassicToV2LoadBalancerTypeChange returns true when the load balancer type changes from classic to a v2 type
+// provisioning a Network Load Balancer, or the other way around. The empty type defaults to classic.
+func isClassicToV2LoadBalancerTypeChange(oldType, newType LoadBalancerType) bool {
+	isClassic := func(t LoadBalancerType) bool {
+		return t == "" || t == LoadBalancerTypeClassic
+	}
+	isV2 := func(t LoadBalancerType) bool {
+		return t == LoadBalancerTypeNLB || t == LoadBalancerTypeELB
+	}
+	return (isClassic(oldType) && isV2(newType)) || (isV2(oldType) && isClassic(newType))
+}
+
 func (r *AWSCluster) validateControlPlaneLoadBalancerUpdate(oldlb, newlb *AWSLoadBalancerSpec) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -238,6 +282,33 @@ func (r *AWSCluster) validateControlPlaneLoadBalancerUpdate(oldlb, newlb *AWSLoa
 				)
 			}
 		}
+
+		// The control plane endpoint is the DNS name of the load balancer and cannot change, so a Classic Load
+		// Balancer cannot be replaced by a Network Load Balancer, or the other way around, once it is created.
+		if isClassicToV2LoadBalancerTypeChange(oldlb.LoadBalancerType, newlb.LoadBalancerType) {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "loadBalancerType"),
+					newlb.LoadBalancerType, "field cannot be changed between classic and nlb or elb once the load balancer is created, "+
+						"see https://cluster-api-aws.sigs.k8s.io/topics/network-load-balancer-with-awscluster#switching-from-a-classic-load-balancer"),
+			)
+		}
+
+		// The listeners of an Application Load Balancer cannot be used by the other types of load balancers.
+		if (oldlb.LoadBalancerType == LoadBalancerTypeALB) != (newlb.LoadBalancerType == LoadBalancerTypeALB) {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "loadBalancerType"),
+					newlb.LoadBalancerType, "field cannot be changed from or to alb once the load balancer is created"),
+			)
+		}
+
+		// Switching between the managed security group and existing security groups would orphan
+		// the managed security group, or leave a Network Load Balancer without security groups.
+		if (len(oldlb.SecurityGroupIDs) == 0) != (len(newlb.SecurityGroupIDs) == 0) {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "securityGroupIDs"),
+					newlb.SecurityGroupIDs, "field cannot be set or unset once the load balancer is created"),
+			)
+		}
 	}
 
 	return allErrs
@@ -295,24 +366,50 @@ func (r *AWSCluster) validateGCTasksAnnotation() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSCluster) validatePrivateDNS() field.ErrorList {
+	if r.Spec.PrivateDNS == nil {
+		return nil
+	}
+
+	if r.Spec.ControlPlaneLoadBalancer != nil && r.Spec.ControlPlaneLoadBalancer.LoadBalancerType == LoadBalancerTypeDisabled {
+		return field.ErrorList{field.Forbidden(field.NewPath("spec", "privateDNS"),
+			"cannot be set when the control plane load balancer is disabled")}
+	}
+	return nil
+}
+
 func (r *AWSCluster) validateSSHKeyName() field.ErrorList {
 	return validateSSHKeyName(r.Spec.SSHKeyName)
 }
 
 func (r *AWSCluster) validateNetwork() field.ErrorList {
 	var allErrs field.ErrorList
-	if r.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
-		allErrs = append(allErrs, field.Invalid(field.NewPath("ipv6"), r.Spec.NetworkSpec.VPC.IPv6, "IPv6 cannot be used with unmanaged clusters at this time."))
-	}
-	for _, subnet := range r.Spec.NetworkSpec.Subnets {
-		if subnet.IsIPv6 || subnet.IPv6CidrBlock != "" {
-			allErrs = append(allErrs, field.Invalid(field.NewPath("subnets"), r.Spec.NetworkSpec.Subnets, "IPv6 cannot be used with unmanaged clusters at this time."))
-		}
+	for i, subnet := range r.Spec.NetworkSpec.Subnets {
 		if subnet.ZoneType != nil && subnet.IsEdge() {
 			if subnet.ParentZoneName == nil {
 				allErrs = append(allErrs, field.Invalid(field.NewPath("subnets"), r.Spec.NetworkSpec.Subnets, "ParentZoneName must be set when ZoneType is 'local-zone'."))
 			}
 		}
+		if subnet.IPv6Only {
+			ipv6OnlyField := field.NewPath("spec", "network", "subnets").Index(i).Child("ipv6Only")
+			if !r.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
+				allErrs = append(allErrs, field.Invalid(ipv6OnlyField, subnet.IPv6Only, "IPv6-only subnets require IPv6 to be enabled on the VPC"))
+			}
+			if subnet.IsPublic {
+				allErrs = append(allErrs, field.Invalid(ipv6OnlyField, subnet.IPv6Only, "IPv6-only subnets cannot be public"))
+			}
+			if subnet.CidrBlock != "" {
+				allErrs = append(allErrs, field.Invalid(ipv6OnlyField, subnet.IPv6Only, "IPv6-only subnets cannot have an IPv4 cidrBlock"))
+			}
+		}
+	}
+
+	if r.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
+		allErrs = append(allErrs, r.validateIPv6()...)
+	}
+
+	if ptr.Deref(r.Spec.NetworkSpec.VPC.EnableDNSHostnames, false) && !ptr.Deref(r.Spec.NetworkSpec.VPC.EnableDNSSupport, true) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "vpc", "enableDnsHostnames"), r.Spec.NetworkSpec.VPC.EnableDNSHostnames, "DNS hostnames can only be enabled along with DNS support"))
 	}
 
 	if r.Spec.NetworkSpec.VPC.CidrBlock != "" && r.Spec.NetworkSpec.VPC.IPAMPool != nil {
@@ -326,14 +423,20 @@ func (r *AWSCluster) validateNetwork() field.ErrorList {
 	allErrs = append(allErrs, r.validateIngressRules(field.NewPath("spec", "network", "additionalControlPlaneIngressRules"), r.Spec.NetworkSpec.AdditionalControlPlaneIngressRules)...)
 	allErrs = append(allErrs, r.validateIngressRules(field.NewPath("spec", "network", "additionalNodeIngressRules"), r.Spec.NetworkSpec.AdditionalNodeIngressRules)...)
 
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateSecurityGroupEgress()...)
+
 	for cidrBlockIndex, cidrBlock := range r.Spec.NetworkSpec.NodePortIngressRuleCidrBlocks {
 		if _, _, err := net.ParseCIDR(cidrBlock); err != nil {
 			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", fmt.Sprintf("nodePortIngressRuleCidrBlocks[%d]", cidrBlockIndex)), r.Spec.NetworkSpec.NodePortIngressRuleCidrBlocks, "CIDR block is invalid"))
 		}
 	}
 
+	allErrs = append(allErrs, validateRoutes(field.NewPath("spec", "network", "additionalPublicRoutes"), r.Spec.NetworkSpec.AdditionalPublicRoutes)...)
+	allErrs = append(allErrs, validateRoutes(field.NewPath("spec", "network", "additionalPrivateRoutes"), r.Spec.NetworkSpec.AdditionalPrivateRoutes)...)
+
 	if r.Spec.NetworkSpec.VPC.ElasticIPPool != nil {
 		eipp := r.Spec.NetworkSpec.VPC.ElasticIPPool
+		allErrs = append(allErrs, validateElasticIPTagSelector(field.NewPath("spec", "network", "vpc", "elasticIpPool", "tagSelector"), eipp.TagSelector)...)
 		if eipp.PublicIpv4Pool != nil {
 			if eipp.PublicIpv4PoolFallBackOrder == nil {
 				return append(allErrs, field.Invalid(field.NewPath("elasticIpPool.publicIpv4PoolFallbackOrder"), r.Spec.NetworkSpec.VPC.ElasticIPPool, "publicIpv4PoolFallbackOrder must be set when publicIpv4Pool is defined."))
@@ -348,11 +451,78 @@ func (r *AWSCluster) validateNetwork() field.ErrorList {
 		}
 	}
 
-	secondaryCidrBlocks := r.Spec.NetworkSpec.VPC.SecondaryCidrBlocks
+	allErrs = append(allErrs, r.validateSecondaryCidrBlocks()...)
+	if r.Spec.NetworkSpec.VPC.ID != "" {
+		// The subnets of a VPC brought by the user are not created by the provider.
+		for i, cidrBlock := range r.Spec.NetworkSpec.VPC.SecondaryCidrBlocks {
+			if cidrBlock.CreateSubnets {
+				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "network", "vpc", "secondaryCidrBlocks").Index(i).Child("createSubnets"), "subnets can only be created in the secondary CIDR blocks of a managed VPC"))
+			}
+		}
+	}
+
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.SubnetPlan.Validate(field.NewPath("spec", "network", "vpc", "subnetPlan"), &r.Spec.NetworkSpec.VPC, nil)...)
+
+	return allErrs
+}
+
+// validateSecondaryCidrBlocks ensures that the secondary CIDR blocks of the VPC are valid and overlap neither the
+// primary CIDR block nor each other, which AWS would only reject when associating them.
+func (r *AWSCluster) validateSecondaryCidrBlocks() field.ErrorList {
+	var allErrs field.ErrorList
+
+	vpc := r.Spec.NetworkSpec.VPC
 	secondaryCidrBlocksField := field.NewPath("spec", "network", "vpc", "secondaryCidrBlocks")
-	for _, cidrBlock := range secondaryCidrBlocks {
-		if r.Spec.NetworkSpec.VPC.CidrBlock != "" && r.Spec.NetworkSpec.VPC.CidrBlock == cidrBlock.IPv4CidrBlock {
-			allErrs = append(allErrs, field.Invalid(secondaryCidrBlocksField, secondaryCidrBlocks, fmt.Sprintf("AWSCluster.spec.network.vpc.secondaryCidrBlocks must not contain the primary AWSCluster.spec.network.vpc.cidrBlock %v", r.Spec.NetworkSpec.VPC.CidrBlock)))
+	var cidrBlocks []*net.IPNet
+	if _, primary, err := net.ParseCIDR(vpc.CidrBlock); err == nil {
+		cidrBlocks = append(cidrBlocks, primary)
+	}
+	for i, cidrBlock := range vpc.SecondaryCidrBlocks {
+		if vpc.CidrBlock != "" && vpc.CidrBlock == cidrBlock.IPv4CidrBlock {
+			allErrs = append(allErrs, field.Invalid(secondaryCidrBlocksField, vpc.SecondaryCidrBlocks, fmt.Sprintf("AWSCluster.spec.network.vpc.secondaryCidrBlocks must not contain the primary AWSCluster.spec.network.vpc.cidrBlock %v", vpc.CidrBlock)))
+			continue
+		}
+
+		cidrField := secondaryCidrBlocksField.Index(i).Child("ipv4CidrBlock")
+		_, ipNet, err := net.ParseCIDR(cidrBlock.IPv4CidrBlock)
+		if err != nil || ipNet.IP.To4() == nil {
+			allErrs = append(allErrs, field.Invalid(cidrField, cidrBlock.IPv4CidrBlock, "must be a valid IPv4 CIDR block"))
+			continue
+		}
+		if ones, _ := ipNet.Mask.Size(); ones < 16 || ones > 28 {
+			allErrs = append(allErrs, field.Invalid(cidrField, cidrBlock.IPv4CidrBlock, "CIDR block sizes must be between a /16 netmask and /28 netmask"))
+		}
+		for _, other := range cidrBlocks {
+			if other.Contains(ipNet.IP) || ipNet.Contains(other.IP) {
+				allErrs = append(allErrs, field.Invalid(cidrField, cidrBlock.IPv4CidrBlock, fmt.Sprintf("must not overlap the CIDR block %s of the VPC", other)))
+				break
+			}
+		}
+		cidrBlocks = append(cidrBlocks, ipNet)
+	}
+
+	return allErrs
+}
+
+// validateIPv6 validates the spec of dual-stack clusters, whose VPC has IPv6 enabled.
+func (r *AWSCluster) validateIPv6() field.ErrorList {
+	var allErrs field.ErrorList
+
+	ipv6 := r.Spec.NetworkSpec.VPC.IPv6
+	if ipv6.IPAMPool != nil && ipv6.IPAMPool.ID == "" && ipv6.IPAMPool.Name == "" {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "vpc", "ipv6", "ipamPool"), ipv6.IPAMPool, "ipamPool must have either id or name"))
+	}
+
+	// Classic load balancers do not support IPv6, the API server is exposed on IPv6 by dual-stack load balancers.
+	for _, lb := range []struct {
+		path *field.Path
+		spec *AWSLoadBalancerSpec
+	}{
+		{path: field.NewPath("spec", "controlPlaneLoadBalancer"), spec: r.Spec.ControlPlaneLoadBalancer},
+		{path: field.NewPath("spec", "secondaryControlPlaneLoadBalancer"), spec: r.Spec.SecondaryControlPlaneLoadBalancer},
+	} {
+		if lb.spec != nil && lb.spec.LoadBalancerType == LoadBalancerTypeClassic {
+			allErrs = append(allErrs, field.Invalid(lb.path.Child("loadBalancerType"), lb.spec.LoadBalancerType, "classic load balancers cannot be used when IPv6 is enabled, use a network or application load balancer"))
 		}
 	}
 
@@ -376,7 +546,7 @@ func (r *AWSCluster) validateControlPlaneLBs() (admission.Warnings, field.ErrorL
 	}
 
 	// If the secondary is defined, check that the name is not empty and different from the primary.
-	// Also, ensure that the secondary load balancer is an NLB
+	// Also, ensure that the secondary load balancer is an NLB or an ALB
 	if r.Spec.SecondaryControlPlaneLoadBalancer != nil {
 		if r.Spec.SecondaryControlPlaneLoadBalancer.Name == nil || *r.Spec.SecondaryControlPlaneLoadBalancer.Name == "" {
 			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "name"), r.Spec.SecondaryControlPlaneLoadBalancer.Name, "secondary controlPlaneLoadBalancer.name cannot be empty"))
@@ -390,8 +560,8 @@ func (r *AWSCluster) validateControlPlaneLBs() (admission.Warnings, field.ErrorL
 			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "scheme"), r.Spec.SecondaryControlPlaneLoadBalancer.Scheme, "control plane load balancers must have different schemes"))
 		}
 
-		if r.Spec.SecondaryControlPlaneLoadBalancer.LoadBalancerType != LoadBalancerTypeNLB {
-			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "loadBalancerType"), r.Spec.SecondaryControlPlaneLoadBalancer.LoadBalancerType, "secondary control plane load balancer must be a Network Load Balancer"))
+		if r.Spec.SecondaryControlPlaneLoadBalancer.LoadBalancerType != LoadBalancerTypeNLB && r.Spec.SecondaryControlPlaneLoadBalancer.LoadBalancerType != LoadBalancerTypeALB {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "loadBalancerType"), r.Spec.SecondaryControlPlaneLoadBalancer.LoadBalancerType, "secondary control plane load balancer must be a Network or Application Load Balancer"))
 		}
 		if r.Spec.SecondaryControlPlaneLoadBalancer.LoadBalancerType == LoadBalancerTypeClassic {
 			allWarnings = append(allWarnings, fmt.Sprintf(warningClassicELB, "secondary control plane"))
@@ -402,9 +572,13 @@ func (r *AWSCluster) validateControlPlaneLBs() (admission.Warnings, field.ErrorL
 	// Validate the control plane load balancers.
 	if r.Spec.ControlPlaneLoadBalancer != nil {
 		allErrs = append(allErrs, r.validateIngressRules(field.NewPath("spec", "controlPlaneLoadBalancer", "ingressRules"), r.Spec.ControlPlaneLoadBalancer.IngressRules)...)
+		allErrs = append(allErrs, validateLoadBalancerSecurityGroupIDs(field.NewPath("spec", "controlPlaneLoadBalancer"), r.Spec.ControlPlaneLoadBalancer)...)
+		allErrs = append(allErrs, validateApplicationLoadBalancer(field.NewPath("spec", "controlPlaneLoadBalancer"), r.Spec.ControlPlaneLoadBalancer, false)...)
 	}
 	if r.Spec.SecondaryControlPlaneLoadBalancer != nil {
 		allErrs = append(allErrs, r.validateIngressRules(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "ingressRules"), r.Spec.SecondaryControlPlaneLoadBalancer.IngressRules)...)
+		allErrs = append(allErrs, validateLoadBalancerSecurityGroupIDs(field.NewPath("spec", "secondaryControlPlaneLoadBalancer"), r.Spec.SecondaryControlPlaneLoadBalancer)...)
+		allErrs = append(allErrs, validateApplicationLoadBalancer(field.NewPath("spec", "secondaryControlPlaneLoadBalancer"), r.Spec.SecondaryControlPlaneLoadBalancer, true)...)
 	}
 
 	if r.Spec.ControlPlaneLoadBalancer.LoadBalancerType == LoadBalancerTypeDisabled {
@@ -428,6 +602,10 @@ func (r *AWSCluster) validateControlPlaneLBs() (admission.Warnings, field.ErrorL
 			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "additionalSecurityGroups"), r.Spec.ControlPlaneLoadBalancer.AdditionalSecurityGroups, "additional Security Groups cannot be set if the LoadBalancer reconciliation is disabled"))
 		}
 
+		if len(r.Spec.ControlPlaneLoadBalancer.SecurityGroupIDs) > 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "securityGroupIDs"), r.Spec.ControlPlaneLoadBalancer.SecurityGroupIDs, "security group IDs cannot be set if the LoadBalancer reconciliation is disabled"))
+		}
+
 		if len(r.Spec.ControlPlaneLoadBalancer.AdditionalListeners) > 0 {
 			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "additionalListeners"), r.Spec.ControlPlaneLoadBalancer.AdditionalListeners, "cannot set additional listeners if the LoadBalancer reconciliation is disabled"))
 		}
@@ -464,3 +642,134 @@ func (r *AWSCluster) validateIngressRules(path *field.Path, rules []IngressRule)
 	}
 	return allErrs
 }
+
+// validateApplicationLoadBalancer validates the HTTPS listener of the load balancer found at path, which is only
+// created for Application Load Balancers. Application Load Balancers only support HTTP and HTTPS, so they terminate
+// TLS and don't forward the client certificates the kubelets and the kubeconfigs of Cluster API authenticate with:
+// they can only be used as the secondary control plane load balancer, not as the control plane endpoint.
+func validateApplicationLoadBalancer(path *field.Path, lb *AWSLoadBalancerSpec, secondary bool) field.ErrorList {
+	var allErrs field.ErrorList
+	if lb.LoadBalancerType != LoadBalancerTypeALB {
+		if lb.CertificateARN != "" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("certificateARN"), "can only be set for the alb load balancer type"))
+		}
+		return allErrs
+	}
+
+	if !secondary {
+		allErrs = append(allErrs, field.Forbidden(path.Child("loadBalancerType"), "an Application Load Balancer does not forward client certificates to the API servers and can only be used as the secondary control plane load balancer"))
+		return allErrs
+	}
+
+	if lb.CertificateARN == "" {
+		allErrs = append(allErrs, field.Required(path.Child("certificateARN"), "the HTTPS listener of an Application Load Balancer requires a certificate"))
+	} else if parsed, err := arn.Parse(lb.CertificateARN); err != nil || parsed.Service != "acm" {
+		allErrs = append(allErrs, field.Invalid(path.Child("certificateARN"), lb.CertificateARN, "must be the ARN of an ACM certificate"))
+	}
+	if lb.HealthCheckProtocol != nil && *lb.HealthCheckProtocol != ELBProtocolHTTPS {
+		allErrs = append(allErrs, field.NotSupported(path.Child("healthCheckProtocol"), *lb.HealthCheckProtocol, []string{ELBProtocolHTTPS.String()}))
+	}
+	if len(lb.AdditionalListeners) > 0 {
+		allErrs = append(allErrs, field.Forbidden(path.Child("additionalListeners"), "additional listeners are only supported by Network Load Balancers"))
+	}
+	if lb.PreserveClientIP {
+		allErrs = append(allErrs, field.Forbidden(path.Child("preserveClientIP"), "Application Load Balancers forward the client IP in the X-Forwarded-For header"))
+	}
+
+	return allErrs
+}
+
+// validateLoadBalancerSecurityGroupIDs validates the existing security groups of the load balancer found at path.
+func validateLoadBalancerSecurityGroupIDs(path *field.Path, lb *AWSLoadBalancerSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(lb.SecurityGroupIDs) == 0 {
+		return allErrs
+	}
+	for i, id := range lb.SecurityGroupIDs {
+		if !strings.HasPrefix(id, "sg-") {
+			allErrs = append(allErrs, field.Invalid(path.Child("securityGroupIDs").Index(i), id, "must be a security group ID"))
+		}
+	}
+	// The ingress rules of the load balancer are applied to the security group managed by CAPA.
+	if len(lb.IngressRules) > 0 {
+		allErrs = append(allErrs, field.Forbidden(path.Child("ingressRules"), "cannot be set if securityGroupIDs is set"))
+	}
+	return allErrs
+}
+
+// ValidateSecurityGroupEgress validates the egress rules of the security groups managed by CAPA. It is shared by the
+// webhooks of the resources embedding a NetworkSpec.
+func (n *NetworkSpec) ValidateSecurityGroupEgress() field.ErrorList {
+	return validateSecurityGroupEgress(field.NewPath("spec", "network", "securityGroupEgress"), n.SecurityGroupEgress)
+}
+
+func validateSecurityGroupEgress(path *field.Path, egress map[SecurityGroupRole]SecurityGroupEgressSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	for role, spec := range egress {
+		rolePath := path.Key(string(role))
+		switch role {
+		case SecurityGroupControlPlane, SecurityGroupNode, SecurityGroupLB:
+		default:
+			allErrs = append(allErrs, field.NotSupported(rolePath, role, []string{string(SecurityGroupControlPlane), string(SecurityGroupNode), string(SecurityGroupLB)}))
+			continue
+		}
+
+		for ruleIndex, rule := range spec.EgressRules {
+			rulePath := rolePath.Child("egressRules").Index(ruleIndex)
+			hasCidrBlocks := rule.CidrBlocks != nil || rule.IPv6CidrBlocks != nil
+			hasSecurityGroups := rule.DestinationSecurityGroupIDs != nil || rule.DestinationSecurityGroupRoles != nil
+			switch {
+			case hasCidrBlocks && hasSecurityGroups:
+				allErrs = append(allErrs, field.Invalid(rulePath, rule, "CIDR blocks and security group IDs or security group roles cannot be used together"))
+			case !hasCidrBlocks && !hasSecurityGroups:
+				allErrs = append(allErrs, field.Invalid(rulePath, rule, "one of CIDR blocks, security group IDs or security group roles must be set"))
+			}
+			for cidrBlockIndex, cidrBlock := range rule.CidrBlocks {
+				if _, _, err := net.ParseCIDR(cidrBlock); err != nil {
+					allErrs = append(allErrs, field.Invalid(rulePath.Child("cidrBlocks").Index(cidrBlockIndex), cidrBlock, "CIDR block is invalid"))
+				}
+			}
+			for cidrBlockIndex, cidrBlock := range rule.IPv6CidrBlocks {
+				if _, _, err := net.ParseCIDR(cidrBlock); err != nil {
+					allErrs = append(allErrs, field.Invalid(rulePath.Child("ipv6CidrBlocks").Index(cidrBlockIndex), cidrBlock, "CIDR block is invalid"))
+				}
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateRoutes(path *field.Path, routes []Route) field.ErrorList {
+	var allErrs field.ErrorList
+	destinations := make(map[string]bool, len(routes))
+	for routeIndex, route := range routes {
+		routePath := path.Index(routeIndex).Child("destinationCidrBlock")
+		if _, _, err := net.ParseCIDR(route.DestinationCidrBlock); err != nil {
+			allErrs = append(allErrs, field.Invalid(routePath, route.DestinationCidrBlock, "CIDR block is invalid"))
+			continue
+		}
+		if destinations[route.DestinationCidrBlock] {
+			allErrs = append(allErrs, field.Duplicate(routePath, route.DestinationCidrBlock))
+		}
+		destinations[route.DestinationCidrBlock] = true
+	}
+	return allErrs
+}
+
+func validateElasticIPTagSelector(path *field.Path, selector map[string]string) field.ErrorList {
+	var allErrs field.ErrorList
+	keys := make([]string, 0, len(selector))
+	for key := range selector {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		switch {
+		case key == "":
+			allErrs = append(allErrs, field.Invalid(path, selector, "tag keys must not be empty"))
+		case strings.HasPrefix(key, NameAWSProviderPrefix), strings.HasPrefix(key, "aws:"):
+			allErrs = append(allErrs, field.Invalid(path.Key(key), selector[key], "tag keys managed by AWS or by the controller cannot be used to select Elastic IPs"))
+		}
+	}
+	return allErrs
+}
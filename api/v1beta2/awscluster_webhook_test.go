@@ -25,6 +25,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilfeature "k8s.io/component-base/featuregate/testing"
@@ -53,6 +54,141 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 		wantErr bool
 		expect  func(g *WithT, res *AWSLoadBalancerSpec)
 	}{
+		{
+			name: "private DNS is allowed with a control plane load balancer",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PrivateDNS: &PrivateDNS{Name: "api.my-cluster.example.internal"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "private DNS is not allowed when the control plane load balancer is disabled",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeDisabled,
+					},
+					PrivateDNS: &PrivateDNS{Name: "api.my-cluster.example.internal"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "bastion host reference is allowed when the bastion is disabled",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					BastionHostRef: &corev1.LocalObjectReference{Name: "bastion"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "bastion host reference is not allowed when the bastion is enabled",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Bastion:        Bastion{Enabled: true},
+					BastionHostRef: &corev1.LocalObjectReference{Name: "bastion"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "application load balancer is not allowed as the control plane endpoint",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeALB,
+						CertificateARN:   "arn:aws:acm:us-east-1:123456789012:certificate/apiserver",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "secondary application load balancer with a certificate is allowed",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:             ptr.To("internal-apiserver"),
+						Scheme:           &ELBSchemeInternal,
+						LoadBalancerType: LoadBalancerTypeALB,
+						CertificateARN:   "arn:aws:acm:us-east-1:123456789012:certificate/apiserver",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "secondary application load balancer requires a certificate",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:             ptr.To("internal-apiserver"),
+						Scheme:           &ELBSchemeInternal,
+						LoadBalancerType: LoadBalancerTypeALB,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "secondary application load balancer requires an ACM certificate",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:             ptr.To("internal-apiserver"),
+						Scheme:           &ELBSchemeInternal,
+						LoadBalancerType: LoadBalancerTypeALB,
+						CertificateARN:   "arn:aws:iam::123456789012:server-certificate/apiserver",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "secondary application load balancer does not support TCP health checks",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:                ptr.To("internal-apiserver"),
+						Scheme:              &ELBSchemeInternal,
+						LoadBalancerType:    LoadBalancerTypeALB,
+						CertificateARN:      "arn:aws:acm:us-east-1:123456789012:certificate/apiserver",
+						HealthCheckProtocol: &ELBProtocolTCP,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "secondary application load balancer does not support additional listeners",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:                ptr.To("internal-apiserver"),
+						Scheme:              &ELBSchemeInternal,
+						LoadBalancerType:    LoadBalancerTypeALB,
+						CertificateARN:      "arn:aws:acm:us-east-1:123456789012:certificate/apiserver",
+						AdditionalListeners: []AdditionalListenerSpec{{Port: 2379, Protocol: ELBProtocolTCP}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "certificate is not allowed for network load balancers",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						CertificateARN:   "arn:aws:acm:us-east-1:123456789012:certificate/apiserver",
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "No options are allowed when LoadBalancer is disabled (name)",
 			cluster: &AWSCluster{
@@ -113,6 +249,63 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "No options are allowed when LoadBalancer is disabled (securityGroupIDs)",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						SecurityGroupIDs: []string{"sg-0123456789abcdef0"},
+						LoadBalancerType: LoadBalancerTypeDisabled,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing security groups can be attached to an NLB",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						SecurityGroupIDs: []string{"sg-0123456789abcdef0"},
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "existing security groups must be security group IDs",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						SecurityGroupIDs: []string{"lb-security-group"},
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ingress rules cannot be set with existing security groups",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						SecurityGroupIDs: []string{"sg-0123456789abcdef0"},
+						LoadBalancerType: LoadBalancerTypeNLB,
+						IngressRules: []IngressRule{
+							{
+								Description: "Kubernetes API",
+								Protocol:    SecurityGroupProtocolTCP,
+								FromPort:    6443,
+								ToPort:      6443,
+								CidrBlocks:  []string{"10.0.0.0/8"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "No options are allowed when LoadBalancer is disabled (additionalListeners)",
 			cluster: &AWSCluster{
@@ -324,23 +517,42 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "rejects ipv6",
+			name: "accepts ipv6 and defaults to a network load balancer",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
 					NetworkSpec: NetworkSpec{
 						VPC: VPCSpec{
 							IPv6: &IPv6{
-								CidrBlock: "2001:2345:5678::/64",
+								CidrBlock: "2001:2345:5678::/56",
 								PoolID:    "pool-id",
 							},
 						},
 					},
 				},
 			},
+			expect: func(g *WithT, res *AWSLoadBalancerSpec) {
+				g.Expect(res.LoadBalancerType).To(Equal(LoadBalancerTypeNLB))
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects ipv6 with a classic load balancer",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeClassic,
+					},
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							IPv6: &IPv6{},
+						},
+					},
+				},
+			},
 			wantErr: true,
 		},
 		{
-			name: "rejects ipv6 enabled subnet",
+			name: "accepts ipv6 enabled subnet",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
 					NetworkSpec: NetworkSpec{
@@ -356,10 +568,10 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
-			name: "rejects ipv6 cidr block for subnets",
+			name: "accepts ipv6 cidr block for subnets",
 			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
 					NetworkSpec: NetworkSpec{
@@ -372,6 +584,64 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 					},
 				},
 			},
+			wantErr: false,
+		},
+		{
+			name: "accepts private ipv6-only subnets with ipv6",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							IPv6: &IPv6{},
+						},
+						Subnets: []SubnetSpec{
+							{
+								ID:       "sub-1",
+								IPv6Only: true,
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects ipv6-only subnets without ipv6",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: []SubnetSpec{
+							{
+								ID:       "sub-1",
+								IPv6Only: true,
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects public ipv6-only subnets",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							IPv6: &IPv6{},
+						},
+						Subnets: []SubnetSpec{
+							{
+								ID:       "sub-1",
+								IPv6Only: true,
+								IsPublic: true,
+							},
+						},
+					},
+				},
+			},
 			wantErr: true,
 		},
 		{
@@ -746,57 +1016,301 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.BootstrapFormatIgnition, true)
-
-			cluster := tt.cluster.DeepCopy()
-			cluster.ObjectMeta = metav1.ObjectMeta{
-				GenerateName: "cluster-",
-				Namespace:    "default",
-			}
-			ctx := context.TODO()
-			if err := testEnv.Create(ctx, cluster); (err != nil) != tt.wantErr {
-				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
-			}
-
-			if tt.wantErr {
-				return
-			}
-
-			c := &AWSCluster{}
-			key := client.ObjectKey{
-				Name:      cluster.Name,
-				Namespace: "default",
-			}
-
-			g := NewWithT(t)
-			g.Eventually(func() bool {
-				err := testEnv.Get(ctx, key, c)
-				return err == nil
-			}, 10*time.Second).Should(BeTrue(), fmt.Sprintf("Eventually failed getting the newly created cluster %q", cluster.Name))
-
-			if tt.expect != nil {
-				tt.expect(g, c.Spec.ControlPlaneLoadBalancer)
-			}
-		})
-	}
-}
-
-func TestAWSClusterValidateUpdate(t *testing.T) {
-	tests := []struct {
-		name       string
-		oldCluster *AWSCluster
-		newCluster *AWSCluster
-		wantErr    bool
-	}{
 		{
-			name: "Control Plane LB type is immutable when switching from disabled to any",
-			oldCluster: &AWSCluster{
+			name: "accepts security group egress rules for the node role",
+			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
-						LoadBalancerType: LoadBalancerTypeDisabled,
+					NetworkSpec: NetworkSpec{
+						SecurityGroupEgress: map[SecurityGroupRole]SecurityGroupEgressSpec{
+							SecurityGroupNode: {
+								RemoveDefaultEgressRule: true,
+								EgressRules: []EgressRule{
+									{
+										Description: "HTTPS",
+										Protocol:    SecurityGroupProtocolTCP,
+										FromPort:    443,
+										ToPort:      443,
+										CidrBlocks:  []string{"10.0.0.0/8"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects security group egress for the bastion role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						SecurityGroupEgress: map[SecurityGroupRole]SecurityGroupEgressSpec{
+							SecurityGroupBastion: {RemoveDefaultEgressRule: true},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects security group egress rules with both cidr blocks and destination security groups",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						SecurityGroupEgress: map[SecurityGroupRole]SecurityGroupEgressSpec{
+							SecurityGroupControlPlane: {
+								EgressRules: []EgressRule{
+									{
+										Description:                   "etcd",
+										Protocol:                      SecurityGroupProtocolTCP,
+										FromPort:                      2379,
+										ToPort:                        2380,
+										CidrBlocks:                    []string{"10.0.0.0/8"},
+										DestinationSecurityGroupRoles: []SecurityGroupRole{SecurityGroupControlPlane},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects security group egress rules without destination",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						SecurityGroupEgress: map[SecurityGroupRole]SecurityGroupEgressSpec{
+							SecurityGroupNode: {
+								EgressRules: []EgressRule{
+									{
+										Description: "DNS",
+										Protocol:    SecurityGroupProtocolUDP,
+										FromPort:    53,
+										ToPort:      53,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts additional private routes with a single target",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalPrivateRoutes: []Route{
+							{
+								DestinationCidrBlock: "10.100.0.0/16",
+								TransitGatewayID:     ptr.To("tgw-0123456789abcdef0"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects additional public routes with an invalid destination cidrBlock",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalPublicRoutes: []Route{
+							{
+								DestinationCidrBlock:   "10.100.0.0",
+								VPCPeeringConnectionID: ptr.To("pcx-0123456789abcdef0"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects additional private routes with duplicated destinations",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalPrivateRoutes: []Route{
+							{
+								DestinationCidrBlock: "10.100.0.0/16",
+								TransitGatewayID:     ptr.To("tgw-0123456789abcdef0"),
+							},
+							{
+								DestinationCidrBlock: "10.100.0.0/16",
+								InstanceID:           ptr.To("i-0123456789abcdef0"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects additional private routes with multiple targets",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalPrivateRoutes: []Route{
+							{
+								DestinationCidrBlock: "10.100.0.0/16",
+								TransitGatewayID:     ptr.To("tgw-0123456789abcdef0"),
+								InstanceID:           ptr.To("i-0123456789abcdef0"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts secondary CIDR blocks with subnets in a managed VPC",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							CidrBlock:           "10.0.0.0/16",
+							SecondaryCidrBlocks: []VpcCidrBlock{{IPv4CidrBlock: "100.64.0.0/16", CreateSubnets: true}},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects invalid secondary CIDR blocks",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							SecondaryCidrBlocks: []VpcCidrBlock{{IPv4CidrBlock: "100.64.0.0"}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects secondary CIDR blocks larger than a /16",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							SecondaryCidrBlocks: []VpcCidrBlock{{IPv4CidrBlock: "100.64.0.0/10"}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects secondary CIDR blocks overlapping the primary CIDR block",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							CidrBlock:           "10.0.0.0/16",
+							SecondaryCidrBlocks: []VpcCidrBlock{{IPv4CidrBlock: "10.0.128.0/20"}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects overlapping secondary CIDR blocks",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							SecondaryCidrBlocks: []VpcCidrBlock{{IPv4CidrBlock: "100.64.0.0/16"}, {IPv4CidrBlock: "100.64.16.0/20"}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects subnets in the secondary CIDR blocks of an unmanaged VPC",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							ID:                  "vpc-0123456789abcdef0",
+							SecondaryCidrBlocks: []VpcCidrBlock{{IPv4CidrBlock: "100.64.0.0/16", CreateSubnets: true}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects empty adoption tag keys",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Adoption: &Adoption{
+						Tags: Tags{"": "legacy"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.BootstrapFormatIgnition, true)
+
+			cluster := tt.cluster.DeepCopy()
+			cluster.ObjectMeta = metav1.ObjectMeta{
+				GenerateName: "cluster-",
+				Namespace:    "default",
+			}
+			ctx := context.TODO()
+			if err := testEnv.Create(ctx, cluster); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			c := &AWSCluster{}
+			key := client.ObjectKey{
+				Name:      cluster.Name,
+				Namespace: "default",
+			}
+
+			g := NewWithT(t)
+			g.Eventually(func() bool {
+				err := testEnv.Get(ctx, key, c)
+				return err == nil
+			}, 10*time.Second).Should(BeTrue(), fmt.Sprintf("Eventually failed getting the newly created cluster %q", cluster.Name))
+
+			if tt.expect != nil {
+				tt.expect(g, c.Spec.ControlPlaneLoadBalancer)
+			}
+		})
+	}
+}
+
+func TestAWSClusterValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		oldCluster *AWSCluster
+		newCluster *AWSCluster
+		wantErr    bool
+	}{
+		{
+			name: "Control Plane LB type is immutable when switching from disabled to any",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeDisabled,
 					},
 				},
 			},
@@ -827,6 +1341,88 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Control Plane LB type cannot be changed to alb",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeALB,
+						CertificateARN:   "arn:aws:acm:us-east-1:123456789012:certificate/apiserver",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Secondary Control Plane LB certificate of an alb can be rotated",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:             ptr.To("internal-apiserver"),
+						Scheme:           &ELBSchemeInternal,
+						LoadBalancerType: LoadBalancerTypeALB,
+						CertificateARN:   "arn:aws:acm:us-east-1:123456789012:certificate/apiserver",
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:             ptr.To("internal-apiserver"),
+						Scheme:           &ELBSchemeInternal,
+						LoadBalancerType: LoadBalancerTypeALB,
+						CertificateARN:   "arn:aws:acm:us-east-1:123456789012:certificate/rotated",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Control Plane LB existing security groups cannot be set after creation",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						SecurityGroupIDs: []string{"sg-0123456789abcdef0"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Control Plane LB existing security groups can be replaced",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						SecurityGroupIDs: []string{"sg-0123456789abcdef0"},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						SecurityGroupIDs: []string{"sg-0123456789abcdef1"},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "region is immutable",
 			oldCluster: &AWSCluster{
@@ -1030,6 +1626,34 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "adoption can be switched from a dry run to adopt",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Adoption: &Adoption{Mode: AdoptionModeDryRun},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Adoption: &Adoption{Mode: AdoptionModeAdopt},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "adoption cannot be switched back to a dry run once adopted",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Adoption: &Adoption{Mode: AdoptionModeAdopt},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Adoption: &Adoption{Mode: AdoptionModeDryRun},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid keys are not accepted during update",
 			oldCluster: &AWSCluster{
@@ -1155,13 +1779,49 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			newCluster: &AWSCluster{
 				Spec: AWSClusterSpec{
 					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
-						LoadBalancerType:    LoadBalancerTypeNLB,
+						LoadBalancerType:    LoadBalancerTypeClassic,
 						HealthCheckProtocol: &ELBProtocolTCP,
 					},
 				},
 			},
 			wantErr: false,
 		},
+		{
+			name: "Should fail if controlPlaneLoadBalancer type is changed from classic to nlb",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeClassic,
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail if controlPlaneLoadBalancer type is changed from nlb to classic",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeClassic,
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "correct GC tasks annotation",
 			oldCluster: &AWSCluster{
@@ -1204,6 +1864,32 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "private DNS cannot be added",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PrivateDNS: &PrivateDNS{Name: "api.my-cluster.example.internal"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "private DNS name is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PrivateDNS: &PrivateDNS{Name: "api.my-cluster.example.internal"},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					PrivateDNS: &PrivateDNS{Name: "api.other-cluster.example.internal"},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
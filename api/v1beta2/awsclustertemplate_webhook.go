@@ -67,6 +67,7 @@ func (*awsClusterTemplateWebhook) ValidateCreate(_ context.Context, obj runtime.
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, r.Spec.Template.Spec.Bastion.Validate()...)
+	allErrs = append(allErrs, validateBastionHostRef(&r.Spec.Template.Spec)...)
 	allErrs = append(allErrs, validateSSHKeyName(r.Spec.Template.Spec.SSHKeyName)...)
 
 	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
@@ -81,10 +82,19 @@ func (*awsClusterTemplateWebhook) ValidateUpdate(_ context.Context, oldObj, newO
 
 	old := oldObj.(*AWSClusterTemplate)
 
-	if !cmp.Equal(r.Spec, old.Spec) {
-		return nil, apierrors.NewBadRequest("AWSClusterTemplate.Spec is immutable")
+	// The additional tags can be changed in place, they are applied to the resources of the AWSClusters
+	// created from the template by the topology controller.
+	oldSpec := old.Spec.DeepCopy()
+	oldSpec.Template.Spec.AdditionalTags = r.Spec.Template.Spec.AdditionalTags
+
+	if !cmp.Equal(r.Spec, *oldSpec) {
+		return nil, apierrors.NewBadRequest("AWSClusterTemplate.Spec is immutable, except for additionalTags")
 	}
-	return nil, nil
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, r.Spec.Template.Spec.AdditionalTags.Validate()...)
+
+	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
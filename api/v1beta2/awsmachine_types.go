@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta2
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -84,6 +86,7 @@ type AWSMachineSpec struct {
 	InstanceID *string `json:"instanceID,omitempty"`
 
 	// InstanceMetadataOptions is the metadata options for the EC2 instance.
+	// It can be changed in place, without replacing the instance.
 	// +optional
 	InstanceMetadataOptions *InstanceMetadataOptions `json:"instanceMetadataOptions,omitempty"`
 
@@ -111,14 +114,27 @@ type AWSMachineSpec struct {
 	// image lookup the AMI is not set.
 	ImageLookupBaseOS string `json:"imageLookupBaseOS,omitempty"`
 
+	// AMICopy copies the AMI referenced by AMI.ID into the account of the cluster when it is owned by
+	// another account, and creates the instance from the copy.
+	// When not set, the AMI must be shared with the account of the cluster.
+	// +optional
+	AMICopy *AMICopy `json:"amiCopy,omitempty"`
+
 	// InstanceType is the type of instance to create. Example: m4.xlarge
-	// +kubebuilder:validation:Required
+	// Exactly one of InstanceType and InstanceRequirements must be set.
 	// +kubebuilder:validation:MinLength:=2
-	InstanceType string `json:"instanceType"`
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// InstanceRequirements are the compute requirements of the instance, used to select
+	// the instance type instead of InstanceType. The smallest instance type satisfying the
+	// requirements and offered in the availability zone of the instance is selected.
+	// +optional
+	InstanceRequirements *InstanceRequirements `json:"instanceRequirements,omitempty"`
 
 	// AdditionalTags is an optional set of tags to add to an instance, in addition to the ones added by default by the
 	// AWS provider. If both the AWSCluster and the AWSMachine specify the same tag name with different values, the
-	// AWSMachine's value takes precedence.
+	// AWSMachine's value takes precedence. It can be changed in place, without replacing the instance.
 	// +optional
 	AdditionalTags Tags `json:"additionalTags,omitempty"`
 
@@ -126,6 +142,13 @@ type AWSMachineSpec struct {
 	// +optional
 	IAMInstanceProfile string `json:"iamInstanceProfile,omitempty"`
 
+	// ManagedIAMInstanceProfile is an IAM instance profile created for the instance with a dedicated role,
+	// instead of the existing IAMInstanceProfile. The instance profile is shared by the AWSMachines of the
+	// cluster declaring the same name, e.g. the AWSMachines of an AWSMachineTemplate, and is deleted with
+	// the last of them. Requires the InstanceProfileCreation feature gate.
+	// +optional
+	ManagedIAMInstanceProfile *ManagedIAMInstanceProfile `json:"managedIAMInstanceProfile,omitempty"`
+
 	// PublicIP specifies whether the instance should get a public IP.
 	// Precedence for this setting is as follows:
 	// 1. This field if set
@@ -200,6 +223,12 @@ type AWSMachineSpec struct {
 	// +optional
 	SpotMarketOptions *SpotMarketOptions `json:"spotMarketOptions,omitempty"`
 
+	// SpotFallback configures the fallback to on-demand instances when Spot instances of the AWSMachine
+	// cannot be launched because of insufficient capacity, or are interrupted repeatedly.
+	// It can only be set with SpotMarketOptions.
+	// +optional
+	SpotFallback *SpotFallback `json:"spotFallback,omitempty"`
+
 	// PlacementGroupName specifies the name of the placement group in which to launch the instance.
 	// +optional
 	PlacementGroupName string `json:"placementGroupName,omitempty"`
@@ -233,6 +262,234 @@ type AWSMachineSpec struct {
 	// If marketType is not specified and spotMarketOptions is provided, the marketType defaults to "Spot".
 	// +optional
 	MarketType MarketType `json:"marketType,omitempty"`
+
+	// CPUOptions sets the number of CPU cores and threads per core of the instance, for instance to disable
+	// simultaneous multithreading or to reduce the number of cores visible to licensing-bound workloads.
+	// When not set, the instance uses the default CPU options of its instance type.
+	// +optional
+	CPUOptions *CPUOptions `json:"cpuOptions,omitempty"`
+
+	// AcceleratorBootstrap prepares the instance for its accelerators, such as AWS Inferentia
+	// and Trainium devices, before the node is bootstrapped.
+	// It is only supported with cloud-init bootstrap data.
+	// +optional
+	AcceleratorBootstrap *AcceleratorBootstrap `json:"acceleratorBootstrap,omitempty"`
+
+	// PatchManagement associates the instance with AWS Systems Manager patching resources, so that
+	// it is patched by the patch baseline of a patch group, during a maintenance window, or by
+	// State Manager associations.
+	// The instance must be managed by AWS Systems Manager: its instance profile must allow the
+	// SSM Agent to register the instance, for example with the AmazonSSMManagedInstanceCore policy.
+	// +optional
+	PatchManagement *PatchManagement `json:"patchManagement,omitempty"`
+
+	// ScheduledEventRemediation, when set, requests the remediation of the Machine by its MachineHealthCheck
+	// ahead of the scheduled reboots, stops and retirements of the instance, so that its node is drained and
+	// the Machine replaced before the instance is disrupted.
+	// Requires the InstanceScheduledEvents feature gate.
+	// +optional
+	ScheduledEventRemediation *ScheduledEventRemediation `json:"scheduledEventRemediation,omitempty"`
+
+	// CloudWatchLogs installs the Amazon CloudWatch agent on the instance, and ships the kubelet,
+	// containerd and cloud-init logs to a CloudWatch log group shared by the machines of the same class.
+	// The log group is created, and deleted along with the cluster, by the controller.
+	// The instance profile must allow the CloudWatch agent to write to the log group, for example
+	// with the CloudWatchAgentServerPolicy policy.
+	// It is only supported with cloud-init bootstrap data, and requires the MachineCloudWatchLogs feature gate.
+	// +optional
+	CloudWatchLogs *CloudWatchLogs `json:"cloudWatchLogs,omitempty"`
+}
+
+// SetInPlaceMutableFields sets the fields of the spec which can be changed without replacing the instance to their
+// values in the given spec: the additional tags and the instance metadata options, when set.
+func (s *AWSMachineSpec) SetInPlaceMutableFields(from *AWSMachineSpec) {
+	s.AdditionalTags = from.AdditionalTags.DeepCopy()
+	if from.InstanceMetadataOptions != nil {
+		s.InstanceMetadataOptions = from.InstanceMetadataOptions.DeepCopy()
+	}
+}
+
+// CloudWatchLogs defines the shipping of the logs of an instance to Amazon CloudWatch Logs.
+// A cloud-init boothook is prepended to the bootstrap data, installing and configuring the CloudWatch agent.
+type CloudWatchLogs struct {
+	// RetentionInDays is the number of days the log events are retained in the log group.
+	// +kubebuilder:validation:Enum=1;3;5;7;14;30;60;90;120;150;180;365;400;545;731;1096;1827;2192;2557;2922;3288;3653
+	// +kubebuilder:default=30
+	// +optional
+	RetentionInDays int32 `json:"retentionInDays,omitempty"`
+}
+
+// PatchManagement defines the AWS Systems Manager patching resources an instance is associated with.
+type PatchManagement struct {
+	// PatchGroup is the patch group of the instance. The instance is tagged with the "Patch Group"
+	// tag, so that it is patched with the patch baseline registered for the patch group.
+	// +kubebuilder:validation:MaxLength=256
+	// +optional
+	PatchGroup string `json:"patchGroup,omitempty"`
+
+	// MaintenanceWindowID is the ID of the maintenance window the instance is registered with as a target.
+	// +kubebuilder:validation:Pattern=`^mw-[0-9a-f]{17}$`
+	// +optional
+	MaintenanceWindowID string `json:"maintenanceWindowID,omitempty"`
+
+	// Associations are the State Manager associations created for the instance.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	Associations []SSMAssociation `json:"associations,omitempty"`
+}
+
+// SSMAssociation defines a State Manager association of an instance with an SSM document.
+type SSMAssociation struct {
+	// Name is the name of the association, unique for the AWSMachine.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_.-]+$`
+	Name string `json:"name"`
+
+	// DocumentName is the name or ARN of the SSM document to apply, for example AWS-RunPatchBaseline.
+	// +kubebuilder:validation:MinLength=1
+	DocumentName string `json:"documentName"`
+
+	// DocumentVersion is the version of the SSM document. The default version is used when not set.
+	// +optional
+	DocumentVersion string `json:"documentVersion,omitempty"`
+
+	// Parameters are the parameters of the SSM document.
+	// +optional
+	Parameters map[string][]string `json:"parameters,omitempty"`
+
+	// ScheduleExpression is the cron or rate expression of the association, for example
+	// cron(0 2 ? * SUN *). The association is applied once when not set.
+	// +optional
+	ScheduleExpression string `json:"scheduleExpression,omitempty"`
+}
+
+// AcceleratorBootstrap defines the node prerequisites prepared for accelerated instances.
+// A cloud-init boothook is prepended to the bootstrap data, loading the Neuron driver
+// required by the Neuron device plugin when it is installed on the AMI.
+type AcceleratorBootstrap struct {
+	// EFA attaches an Elastic Fabric Adapter as the primary network interface of the instance,
+	// unless NetworkInterfaceType is set, loads the EFA driver and lifts the locked memory
+	// limit of the container runtime.
+	// The instance type must support the Elastic Fabric Adapter.
+	// +optional
+	EFA bool `json:"efa,omitempty"`
+
+	// HugePages is the number of 2Mi huge pages to reserve on the instance.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HugePages int32 `json:"hugePages,omitempty"`
+}
+
+// InstanceRequirements defines the compute requirements an instance type must satisfy.
+type InstanceRequirements struct {
+	// VCPUCount is the range of the number of vCPUs.
+	VCPUCount ResourceRange `json:"vCPUCount"`
+
+	// MemoryMiB is the range of the memory, in MiB.
+	MemoryMiB ResourceRange `json:"memoryMiB"`
+
+	// AcceleratorCount is the range of the number of accelerators.
+	// When not set, instance types with and without accelerators are selected.
+	// +optional
+	AcceleratorCount *ResourceRange `json:"acceleratorCount,omitempty"`
+
+	// AcceleratorTypes are the accepted types of accelerators.
+	// +optional
+	AcceleratorTypes []InstanceRequirementsAcceleratorType `json:"acceleratorTypes,omitempty"`
+
+	// Architecture is the processor architecture of the instance type.
+	// +kubebuilder:default=x86_64
+	// +kubebuilder:validation:Enum=x86_64;arm64
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
+
+	// AllowedInstanceTypes are the instance types to select from. The wildcard (*) can be
+	// used, for example m5.*, or m5a.*, r*, *3*. All instance types are allowed when not set.
+	// +optional
+	AllowedInstanceTypes []string `json:"allowedInstanceTypes,omitempty"`
+
+	// ExcludedInstanceTypes are the instance types to exclude. The wildcard (*) can be used.
+	// +optional
+	ExcludedInstanceTypes []string `json:"excludedInstanceTypes,omitempty"`
+}
+
+// InstanceRequirementsAcceleratorType is a type of accelerator of an instance type.
+// +kubebuilder:validation:Enum=gpu;fpga;inference
+type InstanceRequirementsAcceleratorType string
+
+// ResourceRange is a range of a compute resource.
+type ResourceRange struct {
+	// Min is the minimum amount of the resource.
+	// +kubebuilder:validation:Minimum=0
+	Min int32 `json:"min"`
+
+	// Max is the maximum amount of the resource. There is no maximum when not set.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Max *int32 `json:"max,omitempty"`
+}
+
+// SpotFallback defines the fallback to on-demand instances of a Spot AWSMachine.
+// An on-demand instance is launched when there is insufficient Spot capacity.
+type SpotFallback struct {
+	// MaxInterruptions is the number of Spot interruptions of the AWSMachines created from the same
+	// AWSMachineTemplate after which new AWSMachines launch on-demand instances.
+	// When not set, Spot interruptions do not trigger the fallback to on-demand instances.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxInterruptions *int32 `json:"maxInterruptions,omitempty"`
+
+	// InstanceTypes are alternate instance types, tried in order when there is insufficient
+	// on-demand capacity for the instance type of the AWSMachine.
+	// They must have the same architecture as the instance type of the AWSMachine.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	InstanceTypes []string `json:"instanceTypes,omitempty"`
+}
+
+// AMICopy defines how an AMI owned by another account is copied into the account of the cluster.
+type AMICopy struct {
+	// Encrypted encrypts the snapshots of the copy.
+	// +optional
+	Encrypted bool `json:"encrypted,omitempty"`
+
+	// KMSKeyID is the ID, alias or ARN of the KMS key used to encrypt the snapshots of the copy.
+	// Defaults to the encryption key of the root volume of the AWSMachine, then to the default EBS
+	// encryption key of the account. Requires Encrypted.
+	// +optional
+	KMSKeyID string `json:"kmsKeyID,omitempty"`
+}
+
+// ManagedIAMInstanceProfile defines an IAM instance profile and its role created by CAPA.
+type ManagedIAMInstanceProfile struct {
+	// Name is the name of the instance profile and of its role.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[\w+=,.@-]+$`
+	Name string `json:"name"`
+
+	// PolicyARNs are the ARNs of the managed policies attached to the role.
+	// +optional
+	PolicyARNs []string `json:"policyARNs,omitempty"`
+
+	// InlinePolicies are the inline policies embedded in the role.
+	// +optional
+	InlinePolicies []InlinePolicy `json:"inlinePolicies,omitempty"`
+}
+
+// InlinePolicy defines an inline policy of an IAM role.
+type InlinePolicy struct {
+	// Name is the name of the policy.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	Name string `json:"name"`
+
+	// Document is the JSON policy document.
+	// +kubebuilder:validation:MinLength=1
+	Document string `json:"document"`
 }
 
 // CloudInit defines options related to the bootstrapping systems where
@@ -412,8 +669,385 @@ type AWSMachineStatus struct {
 	// Conditions defines current service state of the AWSMachine.
 	// +optional
 	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+
+	// BootstrapDiagnosis is set when the instance did not become a Kubernetes node within the
+	// bootstrap diagnostics timeout, and contains the result of the analysis of the instance.
+	// It is removed once the instance becomes a node.
+	// +optional
+	BootstrapDiagnosis *BootstrapDiagnosis `json:"bootstrapDiagnosis,omitempty"`
+
+	// ReachabilityAnalysis is the last VPC Reachability Analyzer analysis requested with the
+	// aws.cluster.x-k8s.io/reachability-analysis annotation.
+	// +optional
+	ReachabilityAnalysis *ReachabilityAnalysis `json:"reachabilityAnalysis,omitempty"`
+
+	// Timeline is the list of the most recent lifecycle events of the EC2 instances
+	// backing the AWSMachine, ordered from the oldest to the newest.
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Timeline []TimelineEvent `json:"timeline,omitempty"`
+
+	// InstanceType is the instance type of the instance when it differs from the InstanceType of
+	// the AWSMachine: the instance type selected from the InstanceRequirements, or an alternate
+	// instance type of the SpotFallback.
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// SpotFallback is the state of the fallback to on-demand instances of the AWSMachine.
+	// +optional
+	SpotFallback *SpotFallbackStatus `json:"spotFallback,omitempty"`
+
+	// Accelerators is the list of the accelerators of the instance type of the AWSMachine.
+	// It is only set when AcceleratorBootstrap is configured, or when the InstanceAcceleratorInventory
+	// feature gate is enabled.
+	// +optional
+	Accelerators []Accelerator `json:"accelerators,omitempty"`
+
+	// AcceleratorInventory summarizes the accelerators of the instance type of the instance, so that
+	// higher-level controllers and autoscalers can make placement decisions without looking up the
+	// instance type themselves. It is only set when the InstanceAcceleratorInventory feature gate is enabled.
+	// +optional
+	AcceleratorInventory *AcceleratorInventory `json:"acceleratorInventory,omitempty"`
+
+	// PatchManagement is the state of the AWS Systems Manager patching resources of the instance.
+	// +optional
+	PatchManagement *PatchManagementStatus `json:"patchManagement,omitempty"`
+
+	// ScheduledEvents is the list of the upcoming events affecting the instance, reported by EC2
+	// or AWS Health. It is only set when the InstanceScheduledEvents feature gate is enabled.
+	// +optional
+	ScheduledEvents []ScheduledEvent `json:"scheduledEvents,omitempty"`
+
+	// CloudWatchLogGroup is the name of the CloudWatch log group the logs of the instance are shipped to.
+	// It is only set when CloudWatchLogs is configured.
+	// +optional
+	CloudWatchLogGroup string `json:"cloudWatchLogGroup,omitempty"`
 }
 
+// PatchManagementStatus describes the AWS Systems Manager patching resources of an instance.
+type PatchManagementStatus struct {
+	// MaintenanceWindowTargetID is the ID of the target of the maintenance window the instance is registered with.
+	// +optional
+	MaintenanceWindowTargetID string `json:"maintenanceWindowTargetID,omitempty"`
+
+	// Associations are the State Manager associations created for the instance.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Associations []SSMAssociationStatus `json:"associations,omitempty"`
+}
+
+// SSMAssociationStatus describes a State Manager association created for an instance.
+type SSMAssociationStatus struct {
+	// Name is the name of the association in the AWSMachine spec.
+	Name string `json:"name"`
+
+	// AssociationID is the ID of the State Manager association.
+	AssociationID string `json:"associationID"`
+}
+
+// SpotFallbackReason is the reason of the fallback of a Spot AWSMachine to on-demand instances.
+// +kubebuilder:validation:Enum=InsufficientCapacity;Interrupted
+type SpotFallbackReason string
+
+const (
+	// SpotFallbackReasonInsufficientCapacity is used when there was insufficient Spot capacity
+	// to launch the instance.
+	SpotFallbackReasonInsufficientCapacity = SpotFallbackReason("InsufficientCapacity")
+
+	// SpotFallbackReasonInterrupted is used when the Spot instances of the AWSMachineTemplate
+	// of the AWSMachine were interrupted MaxInterruptions times.
+	SpotFallbackReasonInterrupted = SpotFallbackReason("Interrupted")
+)
+
+// SpotFallbackStatus describes the state of the fallback to on-demand instances of a Spot AWSMachine.
+type SpotFallbackStatus struct {
+	// OnDemand is true when the AWSMachine fell back to on-demand instances.
+	// +optional
+	OnDemand bool `json:"onDemand,omitempty"`
+
+	// Reason is the reason of the fallback to on-demand instances.
+	// +optional
+	Reason SpotFallbackReason `json:"reason,omitempty"`
+
+	// InterruptionRecorded is true when the interruption of the Spot instance of the AWSMachine
+	// was counted in the status of its AWSMachineTemplate.
+	// +optional
+	InterruptionRecorded bool `json:"interruptionRecorded,omitempty"`
+}
+
+// AcceleratorType is the type of an accelerator.
+// +kubebuilder:validation:Enum=GPU;Neuron;Inference
+type AcceleratorType string
+
+const (
+	// AcceleratorTypeGPU is a graphics processing unit.
+	AcceleratorTypeGPU = AcceleratorType("GPU")
+
+	// AcceleratorTypeNeuron is an AWS Neuron device, such as AWS Inferentia2 or Trainium.
+	AcceleratorTypeNeuron = AcceleratorType("Neuron")
+
+	// AcceleratorTypeInference is a first generation AWS Inferentia accelerator.
+	AcceleratorTypeInference = AcceleratorType("Inference")
+)
+
+// Accelerator describes the accelerators of a given model attached to an instance.
+type Accelerator struct {
+	// Type is the type of the accelerator.
+	Type AcceleratorType `json:"type"`
+
+	// Manufacturer is the manufacturer of the accelerator.
+	// +optional
+	Manufacturer string `json:"manufacturer,omitempty"`
+
+	// Name is the name of the accelerator model.
+	Name string `json:"name"`
+
+	// Count is the number of accelerators of this model attached to the instance.
+	Count int32 `json:"count"`
+
+	// MemoryMiB is the memory of each accelerator, in MiB.
+	// +optional
+	MemoryMiB int32 `json:"memoryMiB,omitempty"`
+
+	// MIGCapable is true when the accelerator is an NVIDIA GPU supporting Multi-Instance GPU (MIG)
+	// partitioning.
+	// +optional
+	MIGCapable bool `json:"migCapable,omitempty"`
+}
+
+// AcceleratorInventory summarizes the accelerators of an instance type.
+type AcceleratorInventory struct {
+	// InstanceType is the instance type the inventory was resolved for.
+	InstanceType string `json:"instanceType"`
+
+	// GPUCount is the total number of GPUs attached to the instance.
+	// +optional
+	GPUCount int32 `json:"gpuCount,omitempty"`
+
+	// GPUMemoryMiB is the total memory of the GPUs attached to the instance, in MiB.
+	// +optional
+	GPUMemoryMiB int32 `json:"gpuMemoryMiB,omitempty"`
+
+	// GPUTypes are the names of the GPU models attached to the instance.
+	// +optional
+	GPUTypes []string `json:"gpuTypes,omitempty"`
+
+	// MIGCapable is true when the GPUs attached to the instance support Multi-Instance GPU (MIG)
+	// partitioning.
+	// +optional
+	MIGCapable bool `json:"migCapable,omitempty"`
+
+	// AcceleratorCount is the total number of non-GPU accelerators attached to the instance,
+	// such as AWS Inferentia and Trainium devices.
+	// +optional
+	AcceleratorCount int32 `json:"acceleratorCount,omitempty"`
+}
+
+// TimelineEventType is the type of an AWSMachine timeline event.
+// +kubebuilder:validation:Enum=StateTransition;InterruptionNotice;Impairment
+type TimelineEventType string
+
+const (
+	// TimelineEventTypeStateTransition is recorded when the state of the instance changes.
+	TimelineEventTypeStateTransition = TimelineEventType("StateTransition")
+
+	// TimelineEventTypeInterruptionNotice is recorded when a Spot instance is marked for interruption.
+	TimelineEventTypeInterruptionNotice = TimelineEventType("InterruptionNotice")
+
+	// TimelineEventTypeImpairment is recorded when the EC2 status checks of the instance
+	// become impaired, and when they recover.
+	TimelineEventTypeImpairment = TimelineEventType("Impairment")
+)
+
+const (
+	// TimelineReasonStatusChecksImpaired is the reason of the impairment event recorded when the
+	// system or instance status check of the instance is impaired.
+	TimelineReasonStatusChecksImpaired = "StatusChecksImpaired"
+
+	// TimelineReasonStatusChecksRecovered is the reason of the impairment event recorded when the
+	// status checks of a previously impaired instance pass again.
+	TimelineReasonStatusChecksRecovered = "StatusChecksRecovered"
+)
+
+// MaxTimelineEvents is the maximum number of events kept in the AWSMachine timeline.
+const MaxTimelineEvents = 20
+
+// TimelineEvent is a timestamped event of the lifecycle of an EC2 instance.
+type TimelineEvent struct {
+	// Time is the time at which the event occurred, or was observed.
+	Time metav1.Time `json:"time"`
+
+	// Type is the type of the event.
+	Type TimelineEventType `json:"type"`
+
+	// InstanceID is the ID of the instance the event relates to.
+	// +optional
+	InstanceID string `json:"instanceID,omitempty"`
+
+	// Reason is a machine-readable reason for the event, such as the new state of
+	// the instance or the Spot instance request status code.
+	Reason string `json:"reason"`
+
+	// Message is a human-readable description of the event.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ScheduledEventSource is the service reporting a scheduled event.
+// +kubebuilder:validation:Enum=EC2;AWSHealth
+type ScheduledEventSource string
+
+const (
+	// ScheduledEventSourceEC2 is the source of the scheduled events of the instance reported by EC2.
+	ScheduledEventSourceEC2 = ScheduledEventSource("EC2")
+
+	// ScheduledEventSourceAWSHealth is the source of the events affecting the instance reported by AWS Health.
+	ScheduledEventSourceAWSHealth = ScheduledEventSource("AWSHealth")
+)
+
+// ScheduledEvent is an upcoming event affecting the instance of an AWSMachine.
+type ScheduledEvent struct {
+	// Source is the service reporting the event.
+	Source ScheduledEventSource `json:"source"`
+
+	// Code identifies the kind of event, such as instance-retirement for EC2 or
+	// AWS_EC2_INSTANCE_RETIREMENT_SCHEDULED for AWS Health.
+	Code string `json:"code"`
+
+	// Disruptive is true when the instance is rebooted, stopped or retired by the event.
+	// +optional
+	Disruptive bool `json:"disruptive,omitempty"`
+
+	// Description is a human-readable description of the event.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// NotBefore is the earliest time at which the event can start.
+	// +optional
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+
+	// NotAfter is the latest time at which the event can end.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+}
+
+// ScheduledEventRemediation configures the remediation of a Machine ahead of the scheduled events of its instance.
+type ScheduledEventRemediation struct {
+	// LeadTime is how long before the start of a disruptive event the Machine is marked for remediation.
+	// It must leave enough time for the node to be drained and the Machine to be replaced.
+	// Defaults to 24h.
+	// +optional
+	LeadTime *metav1.Duration `json:"leadTime,omitempty"`
+}
+
+// DefaultScheduledEventRemediationLeadTime is the default lead time of the remediation of a Machine ahead
+// of the disruptive scheduled events of its instance.
+const DefaultScheduledEventRemediationLeadTime = 24 * time.Hour
+
+// GetLeadTime returns the lead time of the remediation, or its default.
+func (r *ScheduledEventRemediation) GetLeadTime() time.Duration {
+	if r.LeadTime == nil {
+		return DefaultScheduledEventRemediationLeadTime
+	}
+	return r.LeadTime.Duration
+}
+
+// BootstrapDiagnosis describes the state of an instance that failed to bootstrap.
+type BootstrapDiagnosis struct {
+	// CollectedAt is the time at which the diagnosis was collected.
+	CollectedAt metav1.Time `json:"collectedAt"`
+
+	// SystemStatus is the result of the EC2 system status check of the instance.
+	// +optional
+	SystemStatus string `json:"systemStatus,omitempty"`
+
+	// InstanceStatus is the result of the EC2 instance status check of the instance.
+	// +optional
+	InstanceStatus string `json:"instanceStatus,omitempty"`
+
+	// SystemLog contains the last lines of the system log of the instance.
+	// +optional
+	SystemLog string `json:"systemLog,omitempty"`
+
+	// SSMPingStatus is the connection status of the SSM agent of the instance,
+	// or NotRegistered if the instance is not registered with SSM.
+	// +optional
+	SSMPingStatus string `json:"ssmPingStatus,omitempty"`
+
+	// Reachability is the analysis of the network path between the instance and the
+	// Kubernetes API server endpoint.
+	// +optional
+	Reachability *ReachabilityAnalysis `json:"reachability,omitempty"`
+
+	// Findings is a list of human-readable findings derived from the diagnosis.
+	// +optional
+	Findings []string `json:"findings,omitempty"`
+}
+
+// IsRunning returns true if the reachability analysis of the diagnosis has not completed yet.
+func (d *BootstrapDiagnosis) IsRunning() bool {
+	return d != nil && d.Reachability.IsRunning()
+}
+
+// ReachabilityAnalysisTarget is the network path analyzed by an on-demand reachability analysis.
+// +kubebuilder:validation:Enum=APIServer;Bastion
+type ReachabilityAnalysisTarget string
+
+const (
+	// ReachabilityAnalysisTargetAPIServer analyzes the network path from the instance to the
+	// Kubernetes API server endpoint.
+	ReachabilityAnalysisTargetAPIServer = ReachabilityAnalysisTarget("APIServer")
+
+	// ReachabilityAnalysisTargetBastion analyzes the network path from the bastion host to the
+	// SSH port of the instance.
+	ReachabilityAnalysisTargetBastion = ReachabilityAnalysisTarget("Bastion")
+)
+
+// ReachabilityAnalysis describes a VPC Reachability Analyzer analysis.
+type ReachabilityAnalysis struct {
+	// Target is the analyzed network path, only set for analyses requested with the
+	// aws.cluster.x-k8s.io/reachability-analysis annotation.
+	// +optional
+	Target ReachabilityAnalysisTarget `json:"target,omitempty"`
+
+	// StartedAt is the time at which the analysis was started, only set for analyses requested
+	// with the aws.cluster.x-k8s.io/reachability-analysis annotation.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// NetworkInsightsPathID is the ID of the analyzed network insights path.
+	NetworkInsightsPathID string `json:"networkInsightsPathId"`
+
+	// NetworkInsightsAnalysisID is the ID of the network insights analysis.
+	NetworkInsightsAnalysisID string `json:"networkInsightsAnalysisId"`
+
+	// Status is the status of the analysis, one of running, succeeded or failed.
+	Status string `json:"status"`
+
+	// NetworkPathFound indicates whether the destination is reachable from the instance.
+	// +optional
+	NetworkPathFound *bool `json:"networkPathFound,omitempty"`
+
+	// Explanations describes the components blocking the network path, if any.
+	// +optional
+	Explanations []string `json:"explanations,omitempty"`
+
+	// StatusMessage is the reason why the analysis failed, if any.
+	// +optional
+	StatusMessage string `json:"statusMessage,omitempty"`
+}
+
+// IsRunning returns true if the analysis has not completed yet.
+func (r *ReachabilityAnalysis) IsRunning() bool {
+	return r != nil && r.Status == ReachabilityAnalysisStatusRunning
+}
+
+const (
+	// ReachabilityAnalysisStatusRunning is the status of an analysis in progress.
+	ReachabilityAnalysisStatusRunning = "running"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=awsmachines,scope=Namespaced,categories=cluster-api,shortName=awsm
 // +kubebuilder:storageversion
@@ -19,6 +19,7 @@ package v1beta2
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/url"
@@ -78,6 +79,14 @@ func (*awsMachineWebhook) ValidateCreate(_ context.Context, obj runtime.Object)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validateNetworkElasticIPPool()...)
 	allErrs = append(allErrs, r.validateInstanceMarketType()...)
+	allErrs = append(allErrs, validateAcceleratorBootstrap(r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateInstanceRequirements(r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateSpotFallback(r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validatePatchManagement(r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateAMICopy(r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateManagedIAMInstanceProfile(r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateScheduledEventRemediation(r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateCloudWatchLogs(r.Spec, field.NewPath("spec"))...)
 
 	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -123,6 +132,10 @@ func (*awsMachineWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj run
 	delete(oldAWSMachineSpec, "additionalTags")
 	delete(newAWSMachineSpec, "additionalTags")
 
+	// allow changes to instanceMetadataOptions
+	delete(oldAWSMachineSpec, "instanceMetadataOptions")
+	delete(newAWSMachineSpec, "instanceMetadataOptions")
+
 	// allow changes to additionalSecurityGroups
 	delete(oldAWSMachineSpec, "additionalSecurityGroups")
 	delete(newAWSMachineSpec, "additionalSecurityGroups")
@@ -363,6 +376,7 @@ func (r *AWSMachine) validateNetworkElasticIPPool() field.ErrorList {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec.elasticIpPool"), "publicIp must be set to 'true' to assign custom public IPv4 pools with elasticIpPool"))
 	}
 	eipp := r.Spec.ElasticIPPool
+	allErrs = append(allErrs, validateElasticIPTagSelector(field.NewPath("spec", "elasticIpPool", "tagSelector"), eipp.TagSelector)...)
 	if eipp.PublicIpv4Pool != nil {
 		if eipp.PublicIpv4PoolFallBackOrder == nil {
 			allErrs = append(allErrs, field.Invalid(field.NewPath("spec.elasticIpPool.publicIpv4PoolFallbackOrder"), r.Spec.ElasticIPPool, "publicIpv4PoolFallbackOrder must be set when publicIpv4Pool is defined."))
@@ -392,6 +406,161 @@ func (r *AWSMachine) validateInstanceMarketType() field.ErrorList {
 	return allErrs
 }
 
+// validateInstanceRequirements validates that exactly one of the instance type and the instance
+// requirements of an AWSMachine spec found at specPath is set.
+func validateInstanceRequirements(spec AWSMachineSpec, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	requirements := spec.InstanceRequirements
+	switch {
+	case spec.InstanceType == "" && requirements == nil:
+		allErrs = append(allErrs, field.Required(specPath.Child("instanceType"), "either instanceType or instanceRequirements must be set"))
+	case spec.InstanceType != "" && requirements != nil:
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("instanceRequirements"), "cannot be set if instanceType is set"))
+	}
+	if requirements == nil {
+		return allErrs
+	}
+
+	requirementsPath := specPath.Child("instanceRequirements")
+	ranges := []struct {
+		name  string
+		value *ResourceRange
+	}{
+		{name: "vCPUCount", value: &requirements.VCPUCount},
+		{name: "memoryMiB", value: &requirements.MemoryMiB},
+		{name: "acceleratorCount", value: requirements.AcceleratorCount},
+	}
+	for _, r := range ranges {
+		if r.value != nil && r.value.Max != nil && *r.value.Max < r.value.Min {
+			allErrs = append(allErrs, field.Invalid(requirementsPath.Child(r.name, "max"), *r.value.Max, "must be greater than or equal to min"))
+		}
+	}
+	if requirements.VCPUCount.Min < 1 {
+		allErrs = append(allErrs, field.Invalid(requirementsPath.Child("vCPUCount", "min"), requirements.VCPUCount.Min, "must be greater than 0"))
+	}
+	if spec.MarketType == MarketTypeCapacityBlock {
+		allErrs = append(allErrs, field.Forbidden(requirementsPath, "cannot be set if marketType is CapacityBlock"))
+	}
+	return allErrs
+}
+
+// validateSpotFallback validates that the Spot fallback of an AWSMachine spec found at specPath
+// is only set for Spot instances.
+func validateSpotFallback(spec AWSMachineSpec, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.SpotFallback != nil && spec.SpotMarketOptions == nil && spec.MarketType != MarketTypeSpot {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("spotFallback"), "can only be set for Spot instances"))
+	}
+	return allErrs
+}
+
+// validateAMICopy validates the AMI copy of an AWSMachine spec found at specPath.
+func validateAMICopy(spec AWSMachineSpec, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.AMICopy == nil {
+		return allErrs
+	}
+	if spec.AMI.ID == nil {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("amiCopy"), "can only be set with ami.id"))
+	}
+	if spec.AMICopy.KMSKeyID != "" && !spec.AMICopy.Encrypted {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("amiCopy", "kmsKeyID"), "can only be set if encrypted is true"))
+	}
+	return allErrs
+}
+
+// validateManagedIAMInstanceProfile validates the managed IAM instance profile of an AWSMachine spec found at specPath.
+func validateManagedIAMInstanceProfile(spec AWSMachineSpec, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	profile := spec.ManagedIAMInstanceProfile
+	if profile == nil {
+		return allErrs
+	}
+	profilePath := specPath.Child("managedIAMInstanceProfile")
+	if !feature.Gates.Enabled(feature.InstanceProfileCreation) {
+		allErrs = append(allErrs, field.Forbidden(profilePath, "can be set only if the InstanceProfileCreation feature flag is enabled"))
+	}
+	if spec.IAMInstanceProfile != "" {
+		allErrs = append(allErrs, field.Forbidden(profilePath, "cannot be set if iamInstanceProfile is set"))
+	}
+	names := make(map[string]bool, len(profile.InlinePolicies))
+	for i, policy := range profile.InlinePolicies {
+		policyPath := profilePath.Child("inlinePolicies").Index(i)
+		if names[policy.Name] {
+			allErrs = append(allErrs, field.Duplicate(policyPath.Child("name"), policy.Name))
+		}
+		names[policy.Name] = true
+		if !json.Valid([]byte(policy.Document)) {
+			allErrs = append(allErrs, field.Invalid(policyPath.Child("document"), policy.Document, "must be a JSON policy document"))
+		}
+	}
+	return allErrs
+}
+
+// validateScheduledEventRemediation validates the scheduled event remediation of an AWSMachine spec found at specPath.
+func validateScheduledEventRemediation(spec AWSMachineSpec, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	remediation := spec.ScheduledEventRemediation
+	if remediation == nil {
+		return allErrs
+	}
+	remediationPath := specPath.Child("scheduledEventRemediation")
+	if !feature.Gates.Enabled(feature.InstanceScheduledEvents) {
+		allErrs = append(allErrs, field.Forbidden(remediationPath, "can be set only if the InstanceScheduledEvents feature flag is enabled"))
+	}
+	if remediation.LeadTime != nil && remediation.LeadTime.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(remediationPath.Child("leadTime"), remediation.LeadTime.Duration.String(), "must be positive"))
+	}
+	return allErrs
+}
+
+// validateCloudWatchLogs validates the CloudWatch logs shipping of an AWSMachine spec found at specPath.
+func validateCloudWatchLogs(spec AWSMachineSpec, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.CloudWatchLogs == nil {
+		return allErrs
+	}
+	if !feature.Gates.Enabled(feature.MachineCloudWatchLogs) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("cloudWatchLogs"), "can be set only if the MachineCloudWatchLogs feature flag is enabled"))
+	}
+	if spec.Ignition != nil {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("cloudWatchLogs"), "cannot be set if ignition is set"))
+	}
+	return allErrs
+}
+
+// validatePatchManagement validates the patch management of an AWSMachine spec found at specPath.
+func validatePatchManagement(spec AWSMachineSpec, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	patchManagement := spec.PatchManagement
+	if patchManagement == nil {
+		return allErrs
+	}
+	patchManagementPath := specPath.Child("patchManagement")
+	if patchManagement.PatchGroup == "" && patchManagement.MaintenanceWindowID == "" && len(patchManagement.Associations) == 0 {
+		allErrs = append(allErrs, field.Required(patchManagementPath, "one of patchGroup, maintenanceWindowID or associations must be set"))
+	}
+	if _, ok := spec.AdditionalTags[PatchGroupTagKey]; ok && patchManagement.PatchGroup != "" {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("additionalTags").Key(PatchGroupTagKey), "cannot be set if patchManagement.patchGroup is set"))
+	}
+	return allErrs
+}
+
+// validateAcceleratorBootstrap validates the accelerator bootstrap of an AWSMachine spec found at specPath.
+func validateAcceleratorBootstrap(spec AWSMachineSpec, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.AcceleratorBootstrap == nil {
+		return allErrs
+	}
+	if spec.Ignition != nil {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("acceleratorBootstrap"), "cannot be set if ignition is set"))
+	}
+	if spec.AcceleratorBootstrap.EFA && spec.NetworkInterfaceType == NetworkInterfaceTypeENI {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("acceleratorBootstrap", "efa"), "cannot be enabled if networkInterfaceType is interface"))
+	}
+	return allErrs
+}
+
 func (r *AWSMachine) validateNonRootVolumes() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -447,11 +616,24 @@ func (*awsMachineWebhook) Default(_ context.Context, obj runtime.Object) error {
 func (r *AWSMachine) validateAdditionalSecurityGroups() field.ErrorList {
 	var allErrs field.ErrorList
 
-	for _, additionalSecurityGroup := range r.Spec.AdditionalSecurityGroups {
+	fldPath := field.NewPath("spec.additionalSecurityGroups")
+	ids := make(map[string]struct{}, len(r.Spec.AdditionalSecurityGroups))
+	for i, additionalSecurityGroup := range r.Spec.AdditionalSecurityGroups {
 		if len(additionalSecurityGroup.Filters) > 0 && additionalSecurityGroup.ID != nil {
-			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.additionalSecurityGroups"), "only one of ID or Filters may be specified, specifying both is forbidden"))
+			allErrs = append(allErrs, field.Forbidden(fldPath, "only one of ID or Filters may be specified, specifying both is forbidden"))
+		}
+		// Security groups are swapped in place on the network interfaces of running instances,
+		// where a duplicate ID makes the ModifyNetworkInterfaceAttribute call fail.
+		if id := additionalSecurityGroup.ID; id != nil {
+			if _, ok := ids[*id]; ok {
+				allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("id"), *id))
+			}
+			ids[*id] = struct{}{}
 		}
 	}
+	if len(r.Spec.AdditionalSecurityGroups) > MaxNetworkInterfaceSecurityGroups {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(r.Spec.AdditionalSecurityGroups), MaxNetworkInterfaceSecurityGroups))
+	}
 	return allErrs
 }
 
@@ -18,8 +18,10 @@ package v1beta2
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/gomega"
@@ -205,6 +207,39 @@ func TestAWSMachineCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "additional security groups can't have duplicate ids",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AdditionalSecurityGroups: []AWSResourceReference{
+						{
+							ID: aws.String("sg-1"),
+						},
+						{
+							ID: aws.String("sg-1"),
+						},
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additional security groups can't exceed the network interface limit",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AdditionalSecurityGroups: func() []AWSResourceReference {
+						refs := make([]AWSResourceReference, 0, MaxNetworkInterfaceSecurityGroups+1)
+						for i := 0; i <= MaxNetworkInterfaceSecurityGroups; i++ {
+							refs = append(refs, AWSResourceReference{ID: aws.String(fmt.Sprintf("sg-%d", i))})
+						}
+						return refs
+					}(),
+					InstanceType: "test",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "valid additional tags are accepted",
 			machine: &AWSMachine{
@@ -261,6 +296,59 @@ func TestAWSMachineCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid accelerator bootstrap with EFA",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "trn1.32xlarge",
+					AcceleratorBootstrap: &AcceleratorBootstrap{
+						EFA:       true,
+						HugePages: 1024,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid case, accelerator bootstrap with EFA and ENI network interface type",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:         "trn1.32xlarge",
+					NetworkInterfaceType: NetworkInterfaceTypeENI,
+					AcceleratorBootstrap: &AcceleratorBootstrap{
+						EFA: true,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid CloudWatch logs",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					CloudWatchLogs: &CloudWatchLogs{
+						RetentionInDays: 30,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid case, CloudWatch logs with ignition",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					Ignition: &Ignition{
+						Version: "3.1",
+					},
+					CloudWatchLogs: &CloudWatchLogs{
+						RetentionInDays: 30,
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "empty instance type not allowed",
 			machine: &AWSMachine{
@@ -270,6 +358,195 @@ func TestAWSMachineCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "instance requirements can be set instead of the instance type",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceRequirements: &InstanceRequirements{
+						VCPUCount: ResourceRange{Min: 2, Max: aws.Int32(4)},
+						MemoryMiB: ResourceRange{Min: 4096},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "instance type and instance requirements cannot be set together",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					InstanceRequirements: &InstanceRequirements{
+						VCPUCount: ResourceRange{Min: 2},
+						MemoryMiB: ResourceRange{Min: 4096},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "instance requirements maximum cannot be lower than the minimum",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceRequirements: &InstanceRequirements{
+						VCPUCount: ResourceRange{Min: 4, Max: aws.Int32(2)},
+						MemoryMiB: ResourceRange{Min: 4096},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "spot fallback can be set for Spot instances",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:      "m5.large",
+					SpotMarketOptions: &SpotMarketOptions{},
+					SpotFallback: &SpotFallback{
+						MaxInterruptions: aws.Int32(3),
+						InstanceTypes:    []string{"m5a.large"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "spot fallback cannot be set for on-demand instances",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					SpotFallback: &SpotFallback{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "patch management with a patch group and an association is accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					PatchManagement: &PatchManagement{
+						PatchGroup: "production",
+						Associations: []SSMAssociation{
+							{Name: "patch", DocumentName: "AWS-RunPatchBaseline", ScheduleExpression: "cron(0 2 ? * SUN *)"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty patch management is rejected",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:    "m5.large",
+					PatchManagement: &PatchManagement{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "patch group cannot be set with a Patch Group additional tag",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:    "m5.large",
+					AdditionalTags:  Tags{PatchGroupTagKey: "staging"},
+					PatchManagement: &PatchManagement{PatchGroup: "production"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "AMI copy with an encryption key is accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					AMI:          AMIReference{ID: aws.String("ami-0123456789abcdef0")},
+					AMICopy:      &AMICopy{Encrypted: true, KMSKeyID: "alias/cluster"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "AMI copy cannot be set without an AMI ID",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					AMICopy:      &AMICopy{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "AMI copy encryption key cannot be set without encryption",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					AMI:          AMIReference{ID: aws.String("ami-0123456789abcdef0")},
+					AMICopy:      &AMICopy{KMSKeyID: "alias/cluster"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "managed IAM instance profile is accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					ManagedIAMInstanceProfile: &ManagedIAMInstanceProfile{
+						Name:       "gpu-workers",
+						PolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonSSMManagedInstanceCore"},
+						InlinePolicies: []InlinePolicy{
+							{Name: "s3", Document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "managed IAM instance profile cannot be set with an IAM instance profile",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:              "m5.large",
+					IAMInstanceProfile:        "nodes.cluster-api-provider-aws.sigs.k8s.io",
+					ManagedIAMInstanceProfile: &ManagedIAMInstanceProfile{Name: "gpu-workers"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "managed IAM instance profile inline policy must be a JSON document",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "m5.large",
+					ManagedIAMInstanceProfile: &ManagedIAMInstanceProfile{
+						Name:           "gpu-workers",
+						InlinePolicies: []InlinePolicy{{Name: "s3", Document: "s3:GetObject"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "scheduled event remediation is accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:              "m5.large",
+					ScheduledEventRemediation: &ScheduledEventRemediation{LeadTime: &metav1.Duration{Duration: 48 * time.Hour}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "scheduled event remediation lead time must be positive",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:              "m5.large",
+					ScheduledEventRemediation: &ScheduledEventRemediation{LeadTime: &metav1.Duration{}},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "instance type minimum length is 2",
 			machine: &AWSMachine{
@@ -484,6 +761,9 @@ func TestAWSMachineCreate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.BootstrapFormatIgnition, true)
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.InstanceProfileCreation, true)
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.InstanceScheduledEvents, true)
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachineCloudWatchLogs, true)
 
 			machine := tt.machine.DeepCopy()
 			machine.ObjectMeta = metav1.ObjectMeta{
@@ -567,6 +847,26 @@ func TestAWSMachineUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "change in instance metadata options",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					InstanceMetadataOptions: &InstanceMetadataOptions{
+						HTTPEndpoint:            InstanceMetadataEndpointStateEnabled,
+						HTTPPutResponseHopLimit: 2,
+						HTTPTokens:              HTTPTokensStateRequired,
+						InstanceMetadataTags:    InstanceMetadataEndpointStateDisabled,
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "change in tags adding invalid ones",
 			oldMachine: &AWSMachine{
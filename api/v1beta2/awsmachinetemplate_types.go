@@ -30,6 +30,46 @@ type AWSMachineTemplateStatus struct {
 	// https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20210310-opt-in-autoscaling-from-zero.md
 	// +optional
 	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
+	// NodeInfo contains information about the nodes of the machines created from this template.
+	// This value is used for autoscaling from zero operations as defined in:
+	// https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20210310-opt-in-autoscaling-from-zero.md
+	// +optional
+	NodeInfo *NodeInfo `json:"nodeInfo,omitempty"`
+
+	// SpotInterruptions is the number of Spot interruptions of the AWSMachines created from this
+	// template with a SpotFallback MaxInterruptions.
+	// +optional
+	SpotInterruptions int32 `json:"spotInterruptions,omitempty"`
+}
+
+// Architecture is the CPU architecture of a node.
+type Architecture string
+
+const (
+	// ArchitectureAmd64 is the amd64 architecture.
+	ArchitectureAmd64 Architecture = "amd64"
+	// ArchitectureArm64 is the arm64 architecture.
+	ArchitectureArm64 Architecture = "arm64"
+)
+
+// OperatingSystem is the operating system of a node.
+type OperatingSystem string
+
+// OperatingSystemLinux is the Linux operating system.
+const OperatingSystemLinux OperatingSystem = "linux"
+
+// NodeInfo contains information about the architecture and the operating system of a node.
+type NodeInfo struct {
+	// Architecture is the CPU architecture of the node.
+	// +kubebuilder:validation:Enum=amd64;arm64
+	// +optional
+	Architecture Architecture `json:"architecture,omitempty"`
+
+	// OperatingSystem is the operating system of the node.
+	// +kubebuilder:validation:Enum=linux;windows
+	// +optional
+	OperatingSystem OperatingSystem `json:"operatingSystem,omitempty"`
 }
 
 // AWSMachineTemplateSpec defines the desired state of AWSMachineTemplate.
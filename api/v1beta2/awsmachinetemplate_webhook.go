@@ -205,6 +205,14 @@ func (r *AWSMachineTemplateWebhook) ValidateCreate(_ context.Context, raw runtim
 	allErrs = append(allErrs, obj.validateSSHKeyName()...)
 	allErrs = append(allErrs, obj.validateAdditionalSecurityGroups()...)
 	allErrs = append(allErrs, obj.Spec.Template.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, validateAcceleratorBootstrap(spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateInstanceRequirements(spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateSpotFallback(spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validatePatchManagement(spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateAMICopy(spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateManagedIAMInstanceProfile(spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateScheduledEventRemediation(spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateCloudWatchLogs(spec, field.NewPath("spec", "template", "spec"))...)
 
 	return nil, aggregateObjErrors(obj.GroupVersionKind().GroupKind(), obj.Name, allErrs)
 }
@@ -232,13 +240,19 @@ func (r *AWSMachineTemplateWebhook) ValidateUpdate(ctx context.Context, oldRaw r
 			oldAWSMachineTemplate.Spec.Template.Spec.InstanceMetadataOptions = newAWSMachineTemplate.Spec.Template.Spec.InstanceMetadataOptions
 		}
 
-		if !cmp.Equal(newAWSMachineTemplate.Spec.Template.Spec, oldAWSMachineTemplate.Spec.Template.Spec) {
+		// The fields which can be changed in place are propagated to the AWSMachines created from the template.
+		oldSpec := oldAWSMachineTemplate.Spec.Template.Spec.DeepCopy()
+		oldSpec.SetInPlaceMutableFields(&newAWSMachineTemplate.Spec.Template.Spec)
+
+		if !cmp.Equal(newAWSMachineTemplate.Spec.Template.Spec, *oldSpec) {
 			allErrs = append(allErrs,
-				field.Invalid(field.NewPath("spec", "template", "spec"), newAWSMachineTemplate, "AWSMachineTemplate.Spec is immutable"),
+				field.Invalid(field.NewPath("spec", "template", "spec"), newAWSMachineTemplate, "AWSMachineTemplate.Spec is immutable, except for additionalTags and instanceMetadataOptions"),
 			)
 		}
 	}
 
+	allErrs = append(allErrs, newAWSMachineTemplate.Spec.Template.Spec.AdditionalTags.Validate()...)
+
 	return nil, aggregateObjErrors(newAWSMachineTemplate.GroupVersionKind().GroupKind(), newAWSMachineTemplate.Name, allErrs)
 }
 
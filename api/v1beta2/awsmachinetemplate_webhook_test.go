@@ -137,6 +137,44 @@ func TestAWSMachineTemplateValidateUpdate(t *testing.T) {
 			},
 			wantError: false,
 		},
+		{
+			name: "allow updates of the fields which can be changed in place",
+			modifiedTemplate: &AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: AWSMachineTemplateSpec{
+					Template: AWSMachineTemplateResource{
+						Spec: AWSMachineSpec{
+							CloudInit:      CloudInit{},
+							InstanceType:   "test",
+							AdditionalTags: Tags{"team": "platform"},
+							InstanceMetadataOptions: &InstanceMetadataOptions{
+								HTTPEndpoint:            InstanceMetadataEndpointStateEnabled,
+								HTTPPutResponseHopLimit: 2,
+								HTTPTokens:              HTTPTokensStateRequired,
+								InstanceMetadataTags:    InstanceMetadataEndpointStateDisabled,
+							},
+						},
+					},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "don't allow invalid tags",
+			modifiedTemplate: &AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: AWSMachineTemplateSpec{
+					Template: AWSMachineTemplateResource{
+						Spec: AWSMachineSpec{
+							CloudInit:      CloudInit{},
+							InstanceType:   "test",
+							AdditionalTags: Tags{"": "platform"},
+						},
+					},
+				},
+			},
+			wantError: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -49,6 +49,23 @@ func (b *Bastion) Validate() []*field.Error {
 	return errs
 }
 
+func validateBastionHostRef(spec *AWSClusterSpec) field.ErrorList {
+	if spec.BastionHostRef == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	if spec.BastionHostRef.Name == "" {
+		errs = append(errs, field.Required(field.NewPath("spec", "bastionHostRef", "name"), "must be set"))
+	}
+	if spec.Bastion.Enabled {
+		errs = append(errs,
+			field.Forbidden(field.NewPath("spec", "bastionHostRef"), "cannot be set if spec.bastion.enabled is true"),
+		)
+	}
+	return errs
+}
+
 func validateSSHKeyName(sshKeyName *string) field.ErrorList {
 	var allErrs field.ErrorList
 	switch {
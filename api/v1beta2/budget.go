@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate validates the budget of an AWSCluster spec.
+func (b *Budget) Validate() field.ErrorList {
+	var errs field.ErrorList
+
+	if b == nil {
+		return errs
+	}
+
+	path := field.NewPath("spec", "budget")
+	if b.SNSTopicARN == "" {
+		if len(b.Notifications) > 0 {
+			errs = append(errs, field.Required(path.Child("snsTopicARN"), "snsTopicARN is required to send notifications"))
+		}
+		return errs
+	}
+	if parsed, err := arn.Parse(b.SNSTopicARN); err != nil || parsed.Service != "sns" {
+		errs = append(errs, field.Invalid(path.Child("snsTopicARN"), b.SNSTopicARN, "must be the ARN of an SNS topic"))
+	}
+
+	return errs
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestBudgetValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		budget  *Budget
+		wantErr bool
+	}{
+		{
+			name: "nil budget",
+		},
+		{
+			name:   "budget without notifications",
+			budget: &Budget{LimitAmount: "500"},
+		},
+		{
+			name: "budget with notifications",
+			budget: &Budget{
+				LimitAmount:   "500",
+				Notifications: []BudgetNotification{{Type: BudgetNotificationTypeActual, ThresholdPercent: 80}},
+				SNSTopicARN:   "arn:aws:sns:us-east-1:123456789012:budgets",
+			},
+		},
+		{
+			name: "notifications without an SNS topic",
+			budget: &Budget{
+				LimitAmount:   "500",
+				Notifications: []BudgetNotification{{Type: BudgetNotificationTypeActual, ThresholdPercent: 80}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "invalid SNS topic ARN",
+			budget:  &Budget{LimitAmount: "500", SNSTopicARN: "budgets"},
+			wantErr: true,
+		},
+		{
+			name:    "ARN of another service",
+			budget:  &Budget{LimitAmount: "500", SNSTopicARN: "arn:aws:sqs:us-east-1:123456789012:budgets"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(len(tt.budget.Validate()) > 0).To(Equal(tt.wantErr))
+		})
+	}
+}
@@ -111,6 +111,13 @@ const (
 	SecondaryCidrReconciliationFailedReason = "SecondaryCidrReconciliationFailed"
 )
 
+const (
+	// InfrastructureReadyCondition summarizes the readiness of the AWS infrastructure of a cluster: its network,
+	// security groups, bastion host and, when managed by the provider, control plane load balancers.
+	// Unlike the Ready condition, it does not depend on the state of a managed control plane.
+	InfrastructureReadyCondition clusterv1.ConditionType = "InfrastructureReady"
+)
+
 const (
 	// ClusterSecurityGroupsReadyCondition reports successful reconciliation of security groups.
 	ClusterSecurityGroupsReadyCondition clusterv1.ConditionType = "ClusterSecurityGroupsReady"
@@ -128,6 +135,36 @@ const (
 	BastionHostFailedReason = "BastionHostFailed"
 )
 
+const (
+	// ClusterAdoptedCondition reports whether the existing resources of a cluster were adopted. It is only set on
+	// the clusters which are adopted.
+	ClusterAdoptedCondition clusterv1.ConditionType = "ClusterAdopted"
+	// AdoptionDryRunReason used while the adoption of the existing resources of a cluster is a dry run.
+	AdoptionDryRunReason = "AdoptionDryRun"
+	// AdoptionFailedReason used when the existing resources of a cluster could not be discovered.
+	AdoptionFailedReason = "AdoptionFailed"
+)
+
+const (
+	// InstanceConnectEndpointReadyCondition reports whether the EC2 Instance Connect Endpoint of the cluster is ready.
+	// Depending on the configuration, a cluster may not require an endpoint and this condition will be skipped.
+	InstanceConnectEndpointReadyCondition clusterv1.ConditionType = "InstanceConnectEndpointReady"
+	// InstanceConnectEndpointCreatingReason used while the EC2 Instance Connect Endpoint is being created.
+	InstanceConnectEndpointCreatingReason = "InstanceConnectEndpointCreating"
+	// InstanceConnectEndpointFailedReason used when an error occurs while reconciling the EC2 Instance Connect Endpoint.
+	InstanceConnectEndpointFailedReason = "InstanceConnectEndpointFailed"
+)
+
+const (
+	// GuardDutyRuntimeMonitoringReadyCondition reports whether the GuardDuty Runtime Monitoring prerequisites of the
+	// cluster are ready. Depending on the configuration, a cluster may not require them and this condition will be skipped.
+	GuardDutyRuntimeMonitoringReadyCondition clusterv1.ConditionType = "GuardDutyRuntimeMonitoringReady"
+	// GuardDutyEndpointCreatingReason used while the VPC endpoint of the GuardDuty data service is being created.
+	GuardDutyEndpointCreatingReason = "GuardDutyEndpointCreating"
+	// GuardDutyEndpointFailedReason used when an error occurs while reconciling the VPC endpoint of the GuardDuty data service.
+	GuardDutyEndpointFailedReason = "GuardDutyEndpointFailed"
+)
+
 const (
 	// LoadBalancerReadyCondition reports on whether a control plane load balancer was successfully reconciled.
 	LoadBalancerReadyCondition clusterv1.ConditionType = "LoadBalancerReady"
@@ -162,6 +199,43 @@ const (
 	WaitingForClusterInfrastructureReason = "WaitingForClusterInfrastructure"
 	// WaitingForBootstrapDataReason used when machine is waiting for bootstrap data to be ready before proceeding.
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
+	// InstanceBootstrapFailedReason used when the instance did not become a node within the bootstrap diagnostics timeout.
+	InstanceBootstrapFailedReason = "InstanceBootstrapFailed"
+	// CapacityBlockNotActiveReason used when the capacity block the instances are launched into is not active yet.
+	CapacityBlockNotActiveReason = "CapacityBlockNotActive"
+	// AMICopyPendingReason used when the copy of the AMI of the instance into the account of the cluster is not available yet.
+	AMICopyPendingReason = "AMICopyPending"
+
+	// NoScheduledEventsCondition reports on whether the instance is free of upcoming scheduled events, such as
+	// reboots and retirements, and of AWS Health events.
+	NoScheduledEventsCondition clusterv1.ConditionType = "NoScheduledEvents"
+	// InstanceEventScheduledReason is used when events affecting the instance are scheduled.
+	InstanceEventScheduledReason = "InstanceEventScheduled"
+	// RemediationRequestedReason is used when the remediation of the Machine was requested ahead of a disruptive
+	// scheduled event of its instance.
+	RemediationRequestedReason = "RemediationRequested"
+
+	// VCPUQuotaAvailableCondition reports on whether the vCPU quota of the account leaves room for the instances
+	// to launch. It is only set when the VCPUQuotaCheck feature gate is enabled.
+	VCPUQuotaAvailableCondition clusterv1.ConditionType = "VCPUQuotaAvailable"
+	// QuotaExceededReason is used when launching the instances would exceed the vCPU quota of the account.
+	QuotaExceededReason = "QuotaExceeded"
+	// QuotaCheckFailedReason is used when the vCPU quota of the account could not be checked.
+	QuotaCheckFailedReason = "QuotaCheckFailed"
+
+	// PreflightChecksPassedCondition reports on whether the account passed the preflight checks of the cluster, run
+	// before its resources are created. It is only set when the PreflightChecks feature gate is enabled.
+	PreflightChecksPassedCondition clusterv1.ConditionType = "PreflightChecksPassed"
+	// PreflightChecksFailedReason is used when preflight checks failed, the condition message listing the failures.
+	PreflightChecksFailedReason = "PreflightChecksFailed"
+	// PreflightChecksErrorReason is used when preflight checks could not be run.
+	PreflightChecksErrorReason = "PreflightChecksError"
+
+	// CloudWatchLogsReadyCondition reports on whether the CloudWatch log group the logs of the instance are
+	// shipped to is ready. It is only set when CloudWatchLogs is configured.
+	CloudWatchLogsReadyCondition clusterv1.ConditionType = "CloudWatchLogsReady"
+	// CloudWatchLogsReconciliationFailedReason is used when the CloudWatch log group could not be reconciled.
+	CloudWatchLogsReconciliationFailedReason = "CloudWatchLogsReconciliationFailed"
 )
 
 const (
@@ -191,4 +265,38 @@ const (
 
 	// S3BucketFailedReason is used when any errors occur during reconciliation of an S3 bucket.
 	S3BucketFailedReason = "S3BucketCreationFailed"
+
+	// ObservabilityReadyCondition reports on whether the CloudWatch alarms and dashboard of the cluster are up to date.
+	ObservabilityReadyCondition clusterv1.ConditionType = "ObservabilityReady"
+	// ObservabilityReconciliationFailedReason is used when the CloudWatch alarms or dashboard could not be reconciled.
+	ObservabilityReconciliationFailedReason = "ObservabilityReconciliationFailed"
+
+	// CloudProviderConfigReadyCondition reports on whether the cloud provider configuration of the workload cluster
+	// is up to date.
+	CloudProviderConfigReadyCondition clusterv1.ConditionType = "CloudProviderConfigReady"
+	// WaitingForControlPlaneInitializationReason is used when the cloud provider configuration is not written
+	// until the control plane of the workload cluster is initialized.
+	WaitingForControlPlaneInitializationReason = "WaitingForControlPlaneInitialization"
+	// CloudProviderConfigReconciliationFailedReason is used when the cloud provider configuration could not be
+	// written to the workload cluster.
+	CloudProviderConfigReconciliationFailedReason = "CloudProviderConfigReconciliationFailed"
+
+	// PrivateDNSReadyCondition reports on whether the private DNS record of the API server endpoint is up to date.
+	PrivateDNSReadyCondition clusterv1.ConditionType = "PrivateDNSReady"
+	// PrivateDNSFailedReason is used when the private hosted zone or the private DNS record could not be reconciled.
+	PrivateDNSFailedReason = "PrivateDNSFailed"
+
+	// SessionManagerReadyCondition reports on whether the Session Manager preferences of the cluster are up to date.
+	SessionManagerReadyCondition clusterv1.ConditionType = "SessionManagerReady"
+	// SessionManagerReconciliationFailedReason is used when the Session Manager preferences document could not be reconciled.
+	SessionManagerReconciliationFailedReason = "SessionManagerReconciliationFailed"
+
+	// BudgetWithinLimitCondition reports on whether the cost of the cluster is within the limit of its AWS Budget.
+	BudgetWithinLimitCondition clusterv1.ConditionType = "BudgetWithinLimit"
+	// BudgetExceededReason is used when the actual cost of the cluster exceeds the limit of its budget.
+	BudgetExceededReason = "BudgetExceeded"
+	// BudgetForecastExceededReason is used when the forecasted cost of the cluster exceeds the limit of its budget.
+	BudgetForecastExceededReason = "BudgetForecastExceeded"
+	// BudgetReconciliationFailedReason is used when the AWS Budget of the cluster could not be reconciled.
+	BudgetReconciliationFailedReason = "BudgetReconciliationFailed"
 )
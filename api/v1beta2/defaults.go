@@ -30,25 +30,20 @@ func SetDefaults_Bastion(obj *Bastion) { //nolint:golint,stylecheck
 	}
 }
 
+// SetDefaults_AWSBastionHostSpec is used by defaulter-gen.
+func SetDefaults_AWSBastionHostSpec(obj *AWSBastionHostSpec) { //nolint:golint,stylecheck
+	// Default to allow open access to the bastion host if no CIDR Blocks have been set
+	if len(obj.AllowedCIDRBlocks) == 0 && !obj.DisableIngressRules {
+		obj.AllowedCIDRBlocks = []string{"0.0.0.0/0"}
+	}
+}
+
 // SetDefaults_NetworkSpec is used by defaulter-gen.
 func SetDefaults_NetworkSpec(obj *NetworkSpec) { //nolint:golint,stylecheck
 	// Default to Calico ingress rules if no rules have been set
 	if obj.CNI == nil {
 		obj.CNI = &CNISpec{
-			CNIIngressRules: CNIIngressRules{
-				{
-					Description: "bgp (calico)",
-					Protocol:    SecurityGroupProtocolTCP,
-					FromPort:    179,
-					ToPort:      179,
-				},
-				{
-					Description: "IP-in-IP (calico)",
-					Protocol:    SecurityGroupProtocolIPinIP,
-					FromPort:    -1,
-					ToPort:      65535,
-				},
-			},
+			CNIIngressRules: CNIProfileCalico.IngressRules(),
 		}
 	}
 }
@@ -68,6 +63,10 @@ func SetDefaults_AWSClusterSpec(s *AWSClusterSpec) { //nolint:golint,stylecheck
 	}
 	if s.ControlPlaneLoadBalancer.LoadBalancerType == "" {
 		s.ControlPlaneLoadBalancer.LoadBalancerType = LoadBalancerTypeClassic
+		// Classic load balancers do not support IPv6.
+		if s.NetworkSpec.VPC.IsIPv6Enabled() {
+			s.ControlPlaneLoadBalancer.LoadBalancerType = LoadBalancerTypeNLB
+		}
 	}
 	if s.SecondaryControlPlaneLoadBalancer != nil {
 		if s.SecondaryControlPlaneLoadBalancer.LoadBalancerType == "" {
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	// FailureDomainAttributeZone is the attribute holding the zone of the subnets of a failure domain which is
+	// not named after its zone.
+	FailureDomainAttributeZone = "zone"
+
+	// FailureDomainAttributeZoneType is the attribute holding the type of the zone of the failure domains
+	// derived from Local Zones.
+	FailureDomainAttributeZoneType = "zone-type"
+
+	// FailureDomainAttributeOutpostArn is the attribute holding the ARN of the Outpost of a failure domain.
+	FailureDomainAttributeOutpostArn = "outpost-arn"
+
+	// FailureDomainAttributePlacementGroupName is the attribute holding the name of the placement group of a
+	// failure domain.
+	FailureDomainAttributePlacementGroupName = "placement-group-name"
+
+	// FailureDomainAttributePlacementGroupPartition is the attribute holding the partition number of a failure
+	// domain within its placement group.
+	FailureDomainAttributePlacementGroupPartition = "placement-group-partition"
+)
+
+// Validate validates the failure domains of an AWSCluster spec.
+func (f *FailureDomainsSpec) Validate() field.ErrorList {
+	var errs field.ErrorList
+
+	if f == nil {
+		return errs
+	}
+
+	path := field.NewPath("spec", "failureDomains")
+	if slices.Contains(f.Sources, FailureDomainSourcePlacementPartition) {
+		if f.PlacementGroupName == "" {
+			errs = append(errs, field.Required(path.Child("placementGroupName"), "is required with the placement-partition source"))
+		}
+		if f.PlacementGroupPartitionCount == 0 {
+			errs = append(errs, field.Required(path.Child("placementGroupPartitionCount"), "is required with the placement-partition source"))
+		}
+	}
+
+	return errs
+}
+
+// Zones returns the availability zones among the given ones the control plane machines can be placed in, which
+// are the zones of the subnets dedicated to them, if any.
+func (p *ControlPlanePlacement) Zones(subnets Subnets, zones []string) []string {
+	if p == nil || len(p.SubnetIDs) == 0 {
+		return zones
+	}
+	dedicated := subnets.FilterByIDs(p.SubnetIDs).GetUniqueZones()
+	return slices.DeleteFunc(slices.Clone(zones), func(zone string) bool {
+		return !slices.Contains(dedicated, zone)
+	})
+}
+
+// HasSource returns whether the failure domains are derived from the given source.
+func (f *FailureDomainsSpec) HasSource(source FailureDomainSource) bool {
+	if f == nil || len(f.Sources) == 0 {
+		return source == FailureDomainSourceAvailabilityZone
+	}
+	return slices.Contains(f.Sources, source)
+}
+
+// FailureDomains returns the failure domains derived from the subnets of a cluster. The control plane machines
+// can be placed in the failure domains of the availability zones the control plane load balancer is in,
+// unless they are excluded.
+func (f *FailureDomainsSpec) FailureDomains(subnets Subnets, loadBalancerZones []string) clusterv1.FailureDomains {
+	domains := clusterv1.FailureDomains{}
+	controlPlane := func(zone string) bool {
+		return slices.Contains(loadBalancerZones, zone) && (f == nil || !slices.Contains(f.ControlPlaneExcludedZones, zone))
+	}
+
+	private := subnets.FilterPrivate()
+	for _, zone := range private.GetUniqueZones() {
+		if f.HasSource(FailureDomainSourceAvailabilityZone) {
+			domains[zone] = clusterv1.FailureDomainSpec{ControlPlane: controlPlane(zone)}
+		}
+		if f.HasSource(FailureDomainSourcePlacementPartition) {
+			for partition := int64(1); partition <= f.PlacementGroupPartitionCount; partition++ {
+				domains[fmt.Sprintf("%s-partition-%d", zone, partition)] = clusterv1.FailureDomainSpec{
+					ControlPlane: controlPlane(zone),
+					Attributes: map[string]string{
+						FailureDomainAttributeZone:                    zone,
+						FailureDomainAttributePlacementGroupName:      f.PlacementGroupName,
+						FailureDomainAttributePlacementGroupPartition: strconv.FormatInt(partition, 10),
+					},
+				}
+			}
+		}
+	}
+
+	if f.HasSource(FailureDomainSourceOutpost) {
+		for _, subnet := range private {
+			outpostArn := ptr.Deref(subnet.OutpostArn, "")
+			if outpostArn == "" {
+				continue
+			}
+			// The ARN of an Outpost ends with outpost/<outpost ID>.
+			outpostID := outpostArn[strings.LastIndex(outpostArn, "/")+1:]
+			domains[subnet.AvailabilityZone+"-"+outpostID] = clusterv1.FailureDomainSpec{
+				Attributes: map[string]string{
+					FailureDomainAttributeZone:       subnet.AvailabilityZone,
+					FailureDomainAttributeOutpostArn: outpostArn,
+				},
+			}
+		}
+	}
+
+	if f.HasSource(FailureDomainSourceLocalZone) {
+		for _, subnet := range subnets.FilterPrivateEdge() {
+			if subnet.ZoneType == nil || *subnet.ZoneType != ZoneTypeLocalZone {
+				continue
+			}
+			domains[subnet.AvailabilityZone] = clusterv1.FailureDomainSpec{
+				Attributes: map[string]string{
+					FailureDomainAttributeZoneType: string(ZoneTypeLocalZone),
+				},
+			}
+		}
+	}
+
+	return domains
+}
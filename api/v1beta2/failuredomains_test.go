@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestFailureDomainsSpecFailureDomains(t *testing.T) {
+	outpostArn := "arn:aws:outposts:us-east-1:123456789012:outpost/op-0123456789abcdef0"
+	subnets := Subnets{
+		{ID: "subnet-private-1a", AvailabilityZone: "us-east-1a"},
+		{ID: "subnet-public-1a", AvailabilityZone: "us-east-1a", IsPublic: true},
+		{ID: "subnet-private-1b", AvailabilityZone: "us-east-1b"},
+		{ID: "subnet-private-1c", AvailabilityZone: "us-east-1c"},
+		{ID: "subnet-outpost-1a", AvailabilityZone: "us-east-1a", OutpostArn: ptr.To(outpostArn)},
+		{ID: "subnet-private-nyc", AvailabilityZone: "us-east-1-nyc-1a", ZoneType: ptr.To(ZoneTypeLocalZone)},
+		{ID: "subnet-private-wl", AvailabilityZone: "us-east-1-wl1-bos-wlz-1", ZoneType: ptr.To(ZoneTypeWavelengthZone)},
+	}
+	loadBalancerZones := []string{"us-east-1a", "us-east-1b"}
+
+	tests := []struct {
+		name   string
+		spec   *FailureDomainsSpec
+		expect clusterv1.FailureDomains
+	}{
+		{
+			name: "defaults to the availability zones of the private subnets",
+			expect: clusterv1.FailureDomains{
+				"us-east-1a": {ControlPlane: true},
+				"us-east-1b": {ControlPlane: true},
+				"us-east-1c": {ControlPlane: false},
+			},
+		},
+		{
+			name: "excludes availability zones from the control plane",
+			spec: &FailureDomainsSpec{ControlPlaneExcludedZones: []string{"us-east-1b"}},
+			expect: clusterv1.FailureDomains{
+				"us-east-1a": {ControlPlane: true},
+				"us-east-1b": {ControlPlane: false},
+				"us-east-1c": {ControlPlane: false},
+			},
+		},
+		{
+			name: "derives worker failure domains from Local Zones and Outposts",
+			spec: &FailureDomainsSpec{Sources: []FailureDomainSource{FailureDomainSourceAvailabilityZone, FailureDomainSourceLocalZone, FailureDomainSourceOutpost}},
+			expect: clusterv1.FailureDomains{
+				"us-east-1a": {ControlPlane: true},
+				"us-east-1b": {ControlPlane: true},
+				"us-east-1c": {ControlPlane: false},
+				"us-east-1a-op-0123456789abcdef0": {Attributes: map[string]string{
+					FailureDomainAttributeZone:       "us-east-1a",
+					FailureDomainAttributeOutpostArn: outpostArn,
+				}},
+				"us-east-1-nyc-1a": {Attributes: map[string]string{
+					FailureDomainAttributeZoneType: "local-zone",
+				}},
+			},
+		},
+		{
+			name: "derives failure domains from the partitions of a placement group",
+			spec: &FailureDomainsSpec{
+				Sources:                      []FailureDomainSource{FailureDomainSourcePlacementPartition},
+				PlacementGroupName:           "partitions",
+				PlacementGroupPartitionCount: 2,
+				ControlPlaneExcludedZones:    []string{"us-east-1a"},
+			},
+			expect: clusterv1.FailureDomains{
+				"us-east-1a-partition-1": {ControlPlane: false, Attributes: map[string]string{"zone": "us-east-1a", "placement-group-name": "partitions", "placement-group-partition": "1"}},
+				"us-east-1a-partition-2": {ControlPlane: false, Attributes: map[string]string{"zone": "us-east-1a", "placement-group-name": "partitions", "placement-group-partition": "2"}},
+				"us-east-1b-partition-1": {ControlPlane: true, Attributes: map[string]string{"zone": "us-east-1b", "placement-group-name": "partitions", "placement-group-partition": "1"}},
+				"us-east-1b-partition-2": {ControlPlane: true, Attributes: map[string]string{"zone": "us-east-1b", "placement-group-name": "partitions", "placement-group-partition": "2"}},
+				"us-east-1c-partition-1": {ControlPlane: false, Attributes: map[string]string{"zone": "us-east-1c", "placement-group-name": "partitions", "placement-group-partition": "1"}},
+				"us-east-1c-partition-2": {ControlPlane: false, Attributes: map[string]string{"zone": "us-east-1c", "placement-group-name": "partitions", "placement-group-partition": "2"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.spec.FailureDomains(subnets, loadBalancerZones)).To(Equal(tt.expect))
+		})
+	}
+}
+
+func TestFailureDomainsSpecValidate(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect((*FailureDomainsSpec)(nil).Validate()).To(BeEmpty())
+	g.Expect((&FailureDomainsSpec{Sources: []FailureDomainSource{FailureDomainSourceLocalZone}}).Validate()).To(BeEmpty())
+	g.Expect((&FailureDomainsSpec{Sources: []FailureDomainSource{FailureDomainSourcePlacementPartition}}).Validate()).To(HaveLen(2))
+	g.Expect((&FailureDomainsSpec{
+		Sources:                      []FailureDomainSource{FailureDomainSourcePlacementPartition},
+		PlacementGroupName:           "partitions",
+		PlacementGroupPartitionCount: 3,
+	}).Validate()).To(BeEmpty())
+}
+
+func TestControlPlanePlacementZones(t *testing.T) {
+	g := NewWithT(t)
+	subnets := Subnets{
+		{ID: "subnet-1a", AvailabilityZone: "us-east-1a"},
+		{ID: "control-plane-1b", ResourceID: "subnet-1b", AvailabilityZone: "us-east-1b"},
+		{ID: "subnet-1c", AvailabilityZone: "us-east-1c"},
+	}
+	zones := []string{"us-east-1a", "us-east-1b"}
+
+	g.Expect((*ControlPlanePlacement)(nil).Zones(subnets, zones)).To(Equal(zones))
+	g.Expect((&ControlPlanePlacement{Tenancy: "dedicated"}).Zones(subnets, zones)).To(Equal(zones))
+	g.Expect((&ControlPlanePlacement{SubnetIDs: []string{"subnet-1b", "subnet-1c"}}).Zones(subnets, zones)).To(Equal([]string{"us-east-1b"}))
+}
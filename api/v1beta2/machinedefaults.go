@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate validates the machine defaults of an AWSCluster spec.
+func (d *MachineDefaults) Validate() field.ErrorList {
+	var errs field.ErrorList
+
+	if d == nil {
+		return errs
+	}
+
+	path := field.NewPath("spec", "machineDefaults", "additionalSecurityGroups")
+	ids := make(map[string]struct{}, len(d.AdditionalSecurityGroups))
+	for i, group := range d.AdditionalSecurityGroups {
+		if len(group.Filters) > 0 && group.ID != nil {
+			errs = append(errs, field.Forbidden(path.Index(i), "only one of ID or Filters may be specified, specifying both is forbidden"))
+		}
+		if id := group.ID; id != nil {
+			if _, ok := ids[*id]; ok {
+				errs = append(errs, field.Duplicate(path.Index(i).Child("id"), *id))
+			}
+			ids[*id] = struct{}{}
+		}
+	}
+	if len(d.AdditionalSecurityGroups) > MaxNetworkInterfaceSecurityGroups {
+		errs = append(errs, field.TooMany(path, len(d.AdditionalSecurityGroups), MaxNetworkInterfaceSecurityGroups))
+	}
+	errs = append(errs, d.AdditionalTags.Validate()...)
+
+	return errs
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func TestMachineDefaultsValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults *MachineDefaults
+		wantErr  bool
+	}{
+		{
+			name: "nil defaults",
+		},
+		{
+			name: "valid defaults",
+			defaults: &MachineDefaults{
+				InstanceMetadataOptions:  &InstanceMetadataOptions{HTTPTokens: HTTPTokensStateRequired},
+				AdditionalSecurityGroups: []AWSResourceReference{{ID: ptr.To("sg-1")}, {Filters: []Filter{{Name: "tag:Name", Values: []string{"nodes"}}}}},
+				AdditionalTags:           Tags{"team": "platform"},
+				RootVolumeEncryption:     &RootVolumeEncryption{},
+			},
+		},
+		{
+			name:     "security group with both an ID and filters",
+			defaults: &MachineDefaults{AdditionalSecurityGroups: []AWSResourceReference{{ID: ptr.To("sg-1"), Filters: []Filter{{Name: "tag:Name", Values: []string{"nodes"}}}}}},
+			wantErr:  true,
+		},
+		{
+			name:     "duplicate security group",
+			defaults: &MachineDefaults{AdditionalSecurityGroups: []AWSResourceReference{{ID: ptr.To("sg-1")}, {ID: ptr.To("sg-1")}}},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid tag",
+			defaults: &MachineDefaults{AdditionalTags: Tags{"aws:team": "platform"}},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(len(tt.defaults.Validate()) > 0).To(Equal(tt.wantErr))
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	maintenanceWindowStartTimeLayout = "15:04"
+	maintenanceWindowMaxDuration     = 7 * 24 * time.Hour
+)
+
+// Validate validates the maintenance window of an AWSCluster spec.
+func (w *MaintenanceWindow) Validate() field.ErrorList {
+	var errs field.ErrorList
+
+	if w == nil {
+		return errs
+	}
+
+	path := field.NewPath("spec", "maintenanceWindow")
+	if _, err := time.Parse(maintenanceWindowStartTimeLayout, w.StartTime); err != nil {
+		errs = append(errs, field.Invalid(path.Child("startTime"), w.StartTime, "must be a time of the day in the HH:MM format"))
+	}
+	if w.Duration.Duration <= 0 || w.Duration.Duration > maintenanceWindowMaxDuration {
+		errs = append(errs, field.Invalid(path.Child("duration"), w.Duration.String(), "must be greater than 0 and at most 168h"))
+	}
+
+	return errs
+}
+
+// IsOpen returns whether the maintenance window is open at the given time.
+// A nil maintenance window is always open.
+func (w *MaintenanceWindow) IsOpen(now time.Time) bool {
+	if w == nil {
+		return true
+	}
+
+	now = now.UTC()
+	// The window may have opened on one of the previous days, as it stays open for up to a week.
+	for i := 0; i <= 7; i++ {
+		start, ok := w.openingOn(now.AddDate(0, 0, -i))
+		if ok && !start.After(now) && now.Before(start.Add(w.Duration.Duration)) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOpening returns the time at which the maintenance window opens next after the given time.
+// It returns the given time if the maintenance window is nil.
+func (w *MaintenanceWindow) NextOpening(now time.Time) time.Time {
+	if w == nil {
+		return now
+	}
+
+	now = now.UTC()
+	for i := 0; i <= 7; i++ {
+		if start, ok := w.openingOn(now.AddDate(0, 0, i)); ok && start.After(now) {
+			return start
+		}
+	}
+	return now
+}
+
+// openingOn returns the time at which the maintenance window opens on the day of t, and false if it does not
+// open on that day.
+func (w *MaintenanceWindow) openingOn(t time.Time) (time.Time, bool) {
+	startTime, err := time.Parse(maintenanceWindowStartTimeLayout, w.StartTime)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if len(w.Days) > 0 && !slices.Contains(w.Days, MaintenanceWindowDay(t.Weekday().String())) {
+		return time.Time{}, false
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), startTime.Hour(), startTime.Minute(), 0, 0, time.UTC), true
+}
+
+// DisruptiveActions is a list of disruptive actions deferred until the next maintenance window.
+type DisruptiveActions []DisruptiveAction
+
+// Set records an action of the given type for the given target as pending. The time at which the action was
+// first deferred is kept if it is already pending.
+func (a *DisruptiveActions) Set(actionType DisruptiveActionType, target, reason string) {
+	for i := range *a {
+		if action := &(*a)[i]; action.Type == actionType && action.Target == target {
+			action.Reason = reason
+			return
+		}
+	}
+	*a = append(*a, DisruptiveAction{
+		Type:   actionType,
+		Target: target,
+		Reason: reason,
+		Since:  metav1.Now(),
+	})
+}
+
+// Remove removes the pending action of the given type for the given target, if any.
+func (a *DisruptiveActions) Remove(actionType DisruptiveActionType, target string) {
+	*a = slices.DeleteFunc(*a, func(action DisruptiveAction) bool {
+		return action.Type == actionType && action.Target == target
+	})
+	if len(*a) == 0 {
+		*a = nil
+	}
+}
+
+// Has returns whether an action of the given type for the given target is pending.
+func (a DisruptiveActions) Has(actionType DisruptiveActionType, target string) bool {
+	return slices.ContainsFunc(a, func(action DisruptiveAction) bool {
+		return action.Type == actionType && action.Target == target
+	})
+}
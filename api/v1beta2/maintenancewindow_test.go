@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMaintenanceWindowIsOpen(t *testing.T) {
+	// Saturday night to Sunday morning.
+	window := &MaintenanceWindow{
+		Days:      []MaintenanceWindowDay{"Saturday"},
+		StartTime: "22:00",
+		Duration:  metav1.Duration{Duration: 8 * time.Hour},
+	}
+
+	tests := []struct {
+		name        string
+		window      *MaintenanceWindow
+		now         time.Time
+		wantOpen    bool
+		wantOpening time.Time
+	}{
+		{
+			name:        "nil window is always open",
+			now:         time.Date(2026, time.October, 14, 12, 0, 0, 0, time.UTC),
+			wantOpen:    true,
+			wantOpening: time.Date(2026, time.October, 14, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "closed before the start of the window",
+			window:      window,
+			now:         time.Date(2026, time.October, 17, 21, 59, 0, 0, time.UTC),
+			wantOpening: time.Date(2026, time.October, 17, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "open at the start of the window",
+			window:      window,
+			now:         time.Date(2026, time.October, 17, 22, 0, 0, 0, time.UTC),
+			wantOpen:    true,
+			wantOpening: time.Date(2026, time.October, 24, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "open on the next day of the start of the window",
+			window:      window,
+			now:         time.Date(2026, time.October, 18, 5, 59, 0, 0, time.UTC),
+			wantOpen:    true,
+			wantOpening: time.Date(2026, time.October, 24, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "closed at the end of the window",
+			window:      window,
+			now:         time.Date(2026, time.October, 18, 6, 0, 0, 0, time.UTC),
+			wantOpening: time.Date(2026, time.October, 24, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "times are compared in UTC",
+			window:      window,
+			now:         time.Date(2026, time.October, 17, 23, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)),
+			wantOpening: time.Date(2026, time.October, 17, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "window without days opens every day",
+			window: &MaintenanceWindow{
+				StartTime: "02:30",
+				Duration:  metav1.Duration{Duration: time.Hour},
+			},
+			now:         time.Date(2026, time.October, 14, 12, 0, 0, 0, time.UTC),
+			wantOpening: time.Date(2026, time.October, 15, 2, 30, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.window.IsOpen(tt.now)).To(Equal(tt.wantOpen))
+			g.Expect(tt.window.NextOpening(tt.now)).To(BeTemporally("==", tt.wantOpening))
+		})
+	}
+}
+
+func TestMaintenanceWindowValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  *MaintenanceWindow
+		wantErr bool
+	}{
+		{
+			name: "nil window",
+		},
+		{
+			name:   "valid window",
+			window: &MaintenanceWindow{StartTime: "23:15", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+		},
+		{
+			name:    "invalid start time",
+			window:  &MaintenanceWindow{StartTime: "25:00", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+			wantErr: true,
+		},
+		{
+			name:    "zero duration",
+			window:  &MaintenanceWindow{StartTime: "23:15"},
+			wantErr: true,
+		},
+		{
+			name:    "duration longer than a week",
+			window:  &MaintenanceWindow{StartTime: "23:15", Duration: metav1.Duration{Duration: 169 * time.Hour}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(len(tt.window.Validate()) > 0).To(Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestDisruptiveActions(t *testing.T) {
+	g := NewWithT(t)
+
+	var actions DisruptiveActions
+	actions.Set(DisruptiveActionInstanceRefresh, "asg-1", "launch template was updated")
+	since := actions[0].Since
+	actions.Set(DisruptiveActionInstanceRefresh, "asg-1", "launch template was updated again")
+	actions.Set(DisruptiveActionSecurityGroupRuleReplacement, "sg-1", "ingress rules to revoke")
+
+	g.Expect(actions).To(HaveLen(2))
+	g.Expect(actions[0].Since).To(Equal(since))
+	g.Expect(actions[0].Reason).To(Equal("launch template was updated again"))
+	g.Expect(actions.Has(DisruptiveActionInstanceRefresh, "asg-1")).To(BeTrue())
+	g.Expect(actions.Has(DisruptiveActionInstanceRefresh, "sg-1")).To(BeFalse())
+
+	actions.Remove(DisruptiveActionInstanceRefresh, "asg-1")
+	actions.Remove(DisruptiveActionSecurityGroupRuleReplacement, "sg-1")
+	g.Expect(actions).To(BeNil())
+}
@@ -18,6 +18,7 @@ package v1beta2
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"time"
 
@@ -61,8 +62,24 @@ type NetworkStatus struct {
 	// SecondaryAPIServerELB is the secondary Kubernetes api server load balancer.
 	SecondaryAPIServerELB LoadBalancer `json:"secondaryAPIServerELB,omitempty"`
 
-	// NatGatewaysIPs contains the public IPs of the NAT Gateways
+	// NatGatewaysIPs contains the public IPs of the NAT Gateways, or their private IPs
+	// when the NAT Gateways have private connectivity.
 	NatGatewaysIPs []string `json:"natGatewaysIPs,omitempty"`
+
+	// VPCIPAMPoolAllocation is the CIDR block allocated to the managed VPC from the IPAM pool of
+	// spec.network.vpc.ipamPool. It is released when the VPC is deleted.
+	// +optional
+	VPCIPAMPoolAllocation *IPAMPoolAllocation `json:"vpcIpamPoolAllocation,omitempty"`
+
+	// AdditionalPublicRouteDestinations are the destination CIDR blocks of the additional routes created in the
+	// route tables of the public subnets. The routes removed from spec.network.additionalPublicRoutes are deleted.
+	// +optional
+	AdditionalPublicRouteDestinations []string `json:"additionalPublicRouteDestinations,omitempty"`
+
+	// AdditionalPrivateRouteDestinations are the destination CIDR blocks of the additional routes created in the
+	// route tables of the private subnets. The routes removed from spec.network.additionalPrivateRoutes are deleted.
+	// +optional
+	AdditionalPrivateRouteDestinations []string `json:"additionalPrivateRouteDestinations,omitempty"`
 }
 
 // ELBScheme defines the scheme of a load balancer.
@@ -348,6 +365,13 @@ type NetworkSpec struct {
 	// +optional
 	SecurityGroupOverrides map[SecurityGroupRole]string `json:"securityGroupOverrides,omitempty"`
 
+	// SecurityGroupEgress is an optional set of egress configurations, keyed by role, for the security groups
+	// managed by the provider. Supported roles are controlplane, node and lb.
+	// When an egress configuration is set for a role, the egress rules of its security group are fully
+	// reconciled, and any rule not declared here is revoked.
+	// +optional
+	SecurityGroupEgress map[SecurityGroupRole]SecurityGroupEgressSpec `json:"securityGroupEgress,omitempty"`
+
 	// AdditionalControlPlaneIngressRules is an optional set of ingress rules to add to the control plane
 	// +optional
 	AdditionalControlPlaneIngressRules []IngressRule `json:"additionalControlPlaneIngressRules,omitempty"`
@@ -360,6 +384,67 @@ type NetworkSpec struct {
 	// If none are specified here, all IPs are allowed to connect.
 	// +optional
 	NodePortIngressRuleCidrBlocks []string `json:"nodePortIngressRuleCidrBlocks,omitempty"`
+
+	// AdditionalPublicRoutes is an optional set of routes to maintain in the route tables of the
+	// public subnets, in addition to the default routes created by the provider.
+	// NOTE: This only applies when the VPC is managed by the Cluster API AWS controller.
+	// +optional
+	AdditionalPublicRoutes []Route `json:"additionalPublicRoutes,omitempty"`
+
+	// AdditionalPrivateRoutes is an optional set of routes to maintain in the route tables of the
+	// private subnets, in addition to the default routes created by the provider.
+	// NOTE: This only applies when the VPC is managed by the Cluster API AWS controller.
+	// +optional
+	AdditionalPrivateRoutes []Route `json:"additionalPrivateRoutes,omitempty"`
+}
+
+// Route defines a static route in a route table managed by the provider.
+// Exactly one target must be set.
+// +kubebuilder:validation:XValidation:rule="[has(self.transitGatewayId), has(self.vpcPeeringConnectionId), has(self.instanceId), has(self.networkInterfaceId)].filter(x, x).size() == 1",message="exactly one of transitGatewayId, vpcPeeringConnectionId, instanceId or networkInterfaceId must be set"
+type Route struct {
+	// DestinationCidrBlock is the IPv4 CIDR block used for the destination match.
+	// +kubebuilder:validation:MinLength=1
+	DestinationCidrBlock string `json:"destinationCidrBlock"`
+
+	// TransitGatewayID is the id of the transit gateway to route the traffic to.
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('tgw-')",message="Transit Gateway ID must start with 'tgw-'"
+	// +optional
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// VPCPeeringConnectionID is the id of the VPC peering connection to route the traffic to.
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('pcx-')",message="VPC Peering Connection ID must start with 'pcx-'"
+	// +optional
+	VPCPeeringConnectionID *string `json:"vpcPeeringConnectionId,omitempty"`
+
+	// InstanceID is the id of the NAT or appliance instance to route the traffic to.
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('i-')",message="Instance ID must start with 'i-'"
+	// +optional
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// NetworkInterfaceID is the id of the network interface to route the traffic to.
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('eni-')",message="Network Interface ID must start with 'eni-'"
+	// +optional
+	NetworkInterfaceID *string `json:"networkInterfaceId,omitempty"`
+}
+
+// String returns a string representation of the route.
+func (r Route) String() string {
+	return fmt.Sprintf("destination=%s/target=%s", r.DestinationCidrBlock, r.Target())
+}
+
+// Target returns the id of the route target, or an empty string if none is set.
+func (r Route) Target() string {
+	switch {
+	case r.TransitGatewayID != nil:
+		return *r.TransitGatewayID
+	case r.VPCPeeringConnectionID != nil:
+		return *r.VPCPeeringConnectionID
+	case r.InstanceID != nil:
+		return *r.InstanceID
+	case r.NetworkInterfaceID != nil:
+		return *r.NetworkInterfaceID
+	}
+	return ""
 }
 
 // IPv6 contains ipv6 specific settings for the network.
@@ -383,6 +468,12 @@ type IPv6 struct {
 	// Mutually exclusive with CidrBlock.
 	// +optional
 	IPAMPool *IPAMPool `json:"ipamPool,omitempty"`
+
+	// PrivateSubnetsIPv6Only defines whether the private subnets created by default are IPv6-only subnets,
+	// to avoid allocating IPv4 addresses to the nodes and pods of the cluster. The public subnets keep their
+	// IPv4 CIDR block for the NAT gateways and load balancers.
+	// +optional
+	PrivateSubnetsIPv6Only bool `json:"privateSubnetsIPv6Only,omitempty"`
 }
 
 // IPAMPool defines the IPAM pool to be used for VPC.
@@ -397,11 +488,28 @@ type IPAMPool struct {
 	NetmaskLength int64 `json:"netmaskLength,omitempty"`
 }
 
+// IPAMPoolAllocation is a CIDR block allocated from an IPAM pool.
+type IPAMPoolAllocation struct {
+	// ID is the ID of the allocation.
+	ID string `json:"id"`
+	// PoolID is the ID of the IPAM pool the CIDR block is allocated from.
+	PoolID string `json:"poolId"`
+	// CidrBlock is the allocated CIDR block.
+	CidrBlock string `json:"cidrBlock"`
+}
+
 // VpcCidrBlock defines the CIDR block and settings to associate with the managed VPC. Currently, only IPv4 is supported.
 type VpcCidrBlock struct {
 	// IPv4CidrBlock is the IPv4 CIDR block to associate with the managed VPC.
 	// +kubebuilder:validation:MinLength=1
 	IPv4CidrBlock string `json:"ipv4CidrBlock"`
+
+	// CreateSubnets creates a private subnet in the CIDR block in each availability zone of the cluster, e.g. for
+	// the pods of the Amazon VPC CNI custom networking. The subnets are tagged with
+	// sigs.k8s.io/cluster-api-provider-aws/association=secondary, so that no machines are launched in them.
+	// Only supported by AWSClusters with a managed VPC.
+	// +optional
+	CreateSubnets bool `json:"createSubnets,omitempty"`
 }
 
 // VPCSpec configures an AWS VPC.
@@ -471,6 +579,22 @@ type VPCSpec struct {
 	// +optional
 	EmptyRoutesDefaultVPCSecurityGroup bool `json:"emptyRoutesDefaultVPCSecurityGroup,omitempty"`
 
+	// EnableDNSSupport enables the resolution of DNS names through the Amazon DNS server of the VPC.
+	// Defaults to true for managed VPCs. The attribute of an unmanaged VPC is only changed when set.
+	// +optional
+	EnableDNSSupport *bool `json:"enableDnsSupport,omitempty"`
+
+	// EnableDNSHostnames enables the public DNS hostnames of the instances of the VPC, and the resolution of the
+	// private DNS names of its VPC endpoints. It can only be enabled along with EnableDNSSupport.
+	// Defaults to true for managed VPCs. The attribute of an unmanaged VPC is only changed when set.
+	// +optional
+	EnableDNSHostnames *bool `json:"enableDnsHostnames,omitempty"`
+
+	// EnableNetworkAddressUsageMetrics enables the network address usage metrics of the VPC in CloudWatch.
+	// The attribute is only changed when set.
+	// +optional
+	EnableNetworkAddressUsageMetrics *bool `json:"enableNetworkAddressUsageMetrics,omitempty"`
+
 	// PrivateDNSHostnameTypeOnLaunch is the type of hostname to assign to instances in the subnet at launch.
 	// For IPv4-only and dual-stack (IPv4 and IPv6) subnets, an instance DNS name can be based on the instance IPv4 address (ip-name)
 	// or the instance ID (resource-name). For IPv6 only subnets, an instance DNS name must be based on the instance ID (resource-name).
@@ -493,6 +617,108 @@ type VPCSpec struct {
 	// +kubebuilder:default=PreferPrivate
 	// +kubebuilder:validation:Enum=PreferPrivate;PreferPublic
 	SubnetSchema *SubnetSchemaType `json:"subnetSchema,omitempty"`
+
+	// NatGateway configures the NAT gateways created by the provider for the egress traffic
+	// of private subnets.
+	//
+	// NOTE: This only applies when the VPC is managed by the Cluster API AWS controller.
+	//
+	// +optional
+	NatGateway *NatGatewaySpec `json:"natGateway,omitempty"`
+
+	// SubnetPlan configures the prefix lengths and the number of the subnets created in each
+	// availability zone when no subnets are specified, instead of dividing CidrBlock according to SubnetSchema.
+	//
+	// NOTE: This only applies when the VPC is managed by the Cluster API AWS controller.
+	//
+	// +optional
+	SubnetPlan *SubnetPlan `json:"subnetPlan,omitempty"`
+}
+
+// SubnetPlan configures the subnets of each tier created in each availability zone.
+// The subnets are allocated from the start of the CIDR block, the largest first, so that no address
+// range is left unused between them.
+type SubnetPlan struct {
+	// Public configures the public subnets, allocated from the VPC CidrBlock.
+	// +kubebuilder:validation:Required
+	Public SubnetTierPlan `json:"public"`
+
+	// Private configures the private subnets, allocated from the VPC CidrBlock.
+	// +kubebuilder:validation:Required
+	Private SubnetTierPlan `json:"private"`
+
+	// Pod configures the subnets dedicated to pods, allocated from the secondary CIDR block of the
+	// AWSManagedControlPlane. Defaults to one subnet per availability zone, dividing the secondary CIDR block evenly.
+	// +optional
+	Pod *SubnetTierPlan `json:"pod,omitempty"`
+}
+
+// SubnetTierPlan configures the subnets of a tier.
+type SubnetTierPlan struct {
+	// PrefixLength is the prefix length of the subnets of the tier, e.g. 24 for /24 subnets.
+	// +kubebuilder:validation:Minimum=16
+	// +kubebuilder:validation:Maximum=28
+	PrefixLength int `json:"prefixLength"`
+
+	// SubnetsPerZone is the number of subnets of the tier created in each availability zone.
+	// Defaults to 1.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=8
+	// +optional
+	SubnetsPerZone int `json:"subnetsPerZone,omitempty"`
+}
+
+// GetSubnetsPerZone returns the number of subnets of the tier created in each availability zone.
+func (p *SubnetTierPlan) GetSubnetsPerZone() int {
+	if p.SubnetsPerZone < 1 {
+		return 1
+	}
+	return p.SubnetsPerZone
+}
+
+// NatGatewaySpec configures the NAT gateways created by the provider.
+// +kubebuilder:validation:XValidation:rule="!has(self.connectivityType) || self.connectivityType != 'private' || has(self.transitGatewayId)",message="transitGatewayId must be set when connectivityType is 'private'"
+type NatGatewaySpec struct {
+	// ConnectivityType defines whether the NAT gateways provide public or private connectivity.
+	//
+	// When set to 'public' (default), a NAT gateway with an Elastic IP address is created in each
+	// public subnet, and the public subnets route their default traffic through the internet gateway.
+	//
+	// When set to 'private', a private NAT gateway without Elastic IP address is created in each
+	// public subnet instead. No internet gateway is created, and the default route of those subnets
+	// targets the TransitGatewayID, so that all the egress traffic of the cluster flows through a
+	// centralized egress or inspection VPC.
+	//
+	// +kubebuilder:validation:Enum=public;private
+	// +kubebuilder:default=public
+	// +optional
+	ConnectivityType NatGatewayConnectivityType `json:"connectivityType,omitempty"`
+
+	// TransitGatewayID is the id of an existing transit gateway attached to the VPC, used as the
+	// default route target of the subnets hosting private NAT gateways.
+	// Required when ConnectivityType is 'private'.
+	//
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('tgw-')",message="Transit Gateway ID must start with 'tgw-'"
+	// +optional
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+}
+
+// NatGatewayConnectivityType defines the connectivity type of a NAT gateway.
+type NatGatewayConnectivityType string
+
+const (
+	// NatGatewayConnectivityTypePublic defines a NAT gateway that allows instances in private
+	// subnets to connect to the internet through an Elastic IP address.
+	NatGatewayConnectivityTypePublic = NatGatewayConnectivityType("public")
+
+	// NatGatewayConnectivityTypePrivate defines a NAT gateway that allows instances in private
+	// subnets to connect to other VPCs or on-premises networks, e.g. through a transit gateway.
+	NatGatewayConnectivityTypePrivate = NatGatewayConnectivityType("private")
+)
+
+func (n NatGatewayConnectivityType) String() string {
+	return string(n)
 }
 
 // String returns a string representation of the VPC.
@@ -515,6 +741,19 @@ func (v *VPCSpec) IsIPv6Enabled() bool {
 	return v.IPv6 != nil
 }
 
+// IsPrivateNatGateway returns true when the NAT gateways are configured with private connectivity.
+func (v *VPCSpec) IsPrivateNatGateway() bool {
+	return v.NatGateway != nil && v.NatGateway.ConnectivityType == NatGatewayConnectivityTypePrivate
+}
+
+// GetTransitGatewayID returns the transit gateway used as the egress target of private NAT gateways, if any.
+func (v *VPCSpec) GetTransitGatewayID() *string {
+	if v.NatGateway == nil {
+		return nil
+	}
+	return v.NatGateway.TransitGatewayID
+}
+
 // GetElasticIPPool returns the custom Elastic IP Pool configuration when present.
 func (v *VPCSpec) GetElasticIPPool() *ElasticIPPool {
 	return v.ElasticIPPool
@@ -569,6 +808,14 @@ type SubnetSpec struct {
 	// +optional
 	IsIPv6 bool `json:"isIpv6,omitempty"`
 
+	// IPv6Only defines the subnet as an IPv6-only subnet, which has no IPv4 CIDR block. DNS64 is enabled on
+	// IPv6-only subnets, and the traffic of IPv6-only private subnets to the NAT64 prefix is routed through the
+	// NAT gateway of their availability zone, so that their instances can reach IPv4 destinations.
+	// Only private subnets can be IPv6-only, and only Nitro instance types can be launched in them.
+	// IPv6 is only supported in managed clusters, this field cannot be set on AWSCluster object.
+	// +optional
+	IPv6Only bool `json:"ipv6Only,omitempty"`
+
 	// RouteTableID is the routing table id associated with the subnet.
 	// +optional
 	RouteTableID *string `json:"routeTableId,omitempty"`
@@ -616,6 +863,11 @@ type SubnetSpec struct {
 	//
 	// +optional
 	ParentZoneName *string `json:"parentZoneName,omitempty"`
+
+	// OutpostArn is the ARN of the Outpost the subnet is in, if any.
+	// It is discovered from AWS and cannot be set.
+	// +optional
+	OutpostArn *string `json:"outpostArn,omitempty"`
 }
 
 // GetResourceID returns the identifier for this subnet,
@@ -747,7 +999,8 @@ func (s Subnets) FindEqual(spec *SubnetSpec) *SubnetSpec {
 	for i := range s {
 		x := &(s[i]) // pointer to original structure
 		if (spec.GetResourceID() != "" && x.GetResourceID() == spec.GetResourceID()) ||
-			(spec.CidrBlock == x.CidrBlock) ||
+			// IPv6-only subnets have no IPv4 CIDR block.
+			(spec.CidrBlock != "" && spec.CidrBlock == x.CidrBlock) ||
 			(spec.IPv6CidrBlock != "" && spec.IPv6CidrBlock == x.IPv6CidrBlock) {
 			return x
 		}
@@ -794,6 +1047,36 @@ func (s Subnets) FilterPublic() (res Subnets) {
 	return
 }
 
+// FilterPrivateEdge returns a slice containing all private subnets in AWS Local Zones or Wavelength.
+func (s Subnets) FilterPrivateEdge() (res Subnets) {
+	for _, x := range s {
+		if x.IsEdge() && !x.IsPublic {
+			res = append(res, x)
+		}
+	}
+	return
+}
+
+// FilterByOutpost returns a slice containing all subnets in the Outpost specified.
+func (s Subnets) FilterByOutpost(outpostArn string) (res Subnets) {
+	for _, x := range s {
+		if ptr.Deref(x.OutpostArn, "") == outpostArn {
+			res = append(res, x)
+		}
+	}
+	return
+}
+
+// FilterByIDs returns a slice containing all subnets whose ID or resource ID is one of the IDs specified.
+func (s Subnets) FilterByIDs(ids []string) (res Subnets) {
+	for _, x := range s {
+		if slices.Contains(ids, x.ID) || slices.Contains(ids, x.ResourceID) {
+			res = append(res, x)
+		}
+	}
+	return
+}
+
 // FilterByZone returns a slice containing all subnets that live in the availability zone specified.
 func (s Subnets) FilterByZone(zone string) (res Subnets) {
 	for _, x := range s {
@@ -842,14 +1125,129 @@ func (s Subnets) HasPublicSubnetWavelength() bool {
 
 // CNISpec defines configuration for CNI.
 type CNISpec struct {
+	// Profile is a named set of ingress rules required by a well-known CNI, which are applied
+	// to the control plane and worker node security groups in addition to CNIIngressRules.
+	// +kubebuilder:validation:Enum=calico;cilium-vxlan;cilium-eni;flannel;none
+	// +optional
+	Profile CNIProfile `json:"profile,omitempty"`
+
 	// CNIIngressRules specify rules to apply to control plane and worker node security groups.
 	// The source for the rule will be set to control plane and worker security group IDs.
 	CNIIngressRules CNIIngressRules `json:"cniIngressRules,omitempty"`
 }
 
+// IngressRules returns the ingress rules of the profile followed by the custom ingress rules,
+// skipping custom rules that are already part of the profile.
+func (c *CNISpec) IngressRules() CNIIngressRules {
+	rules := c.Profile.IngressRules()
+	for _, rule := range c.CNIIngressRules {
+		if !rules.Contains(rule) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// CNIProfile is the name of a set of ingress rules required by a CNI.
+type CNIProfile string
+
+const (
+	// CNIProfileCalico allows BGP and IP-in-IP traffic, as used by Calico.
+	CNIProfileCalico = CNIProfile("calico")
+
+	// CNIProfileCiliumVXLAN allows VXLAN, health checks and Hubble traffic, as used by Cilium in tunneling mode.
+	CNIProfileCiliumVXLAN = CNIProfile("cilium-vxlan")
+
+	// CNIProfileCiliumENI allows health checks and Hubble traffic, as used by Cilium in ENI mode.
+	CNIProfileCiliumENI = CNIProfile("cilium-eni")
+
+	// CNIProfileFlannel allows VXLAN traffic, as used by Flannel.
+	CNIProfileFlannel = CNIProfile("flannel")
+
+	// CNIProfileNone does not add any ingress rule.
+	CNIProfileNone = CNIProfile("none")
+)
+
+// IngressRules returns the ingress rules required by the CNI profile.
+func (p CNIProfile) IngressRules() CNIIngressRules {
+	switch p {
+	case CNIProfileCalico:
+		return CNIIngressRules{
+			{
+				Description: "bgp (calico)",
+				Protocol:    SecurityGroupProtocolTCP,
+				FromPort:    179,
+				ToPort:      179,
+			},
+			{
+				Description: "IP-in-IP (calico)",
+				Protocol:    SecurityGroupProtocolIPinIP,
+				FromPort:    -1,
+				ToPort:      65535,
+			},
+		}
+	case CNIProfileCiliumVXLAN:
+		return append(CNIIngressRules{
+			{
+				Description: "VXLAN (cilium)",
+				Protocol:    SecurityGroupProtocolUDP,
+				FromPort:    8472,
+				ToPort:      8472,
+			},
+		}, ciliumIngressRules()...)
+	case CNIProfileCiliumENI:
+		return ciliumIngressRules()
+	case CNIProfileFlannel:
+		return CNIIngressRules{
+			{
+				Description: "VXLAN (flannel)",
+				Protocol:    SecurityGroupProtocolUDP,
+				FromPort:    8472,
+				ToPort:      8472,
+			},
+		}
+	default:
+		return CNIIngressRules{}
+	}
+}
+
+// ciliumIngressRules returns the ingress rules required by Cilium regardless of its routing mode.
+func ciliumIngressRules() CNIIngressRules {
+	return CNIIngressRules{
+		{
+			Description: "health checks (cilium)",
+			Protocol:    SecurityGroupProtocolTCP,
+			FromPort:    4240,
+			ToPort:      4240,
+		},
+		{
+			Description: "ICMP health checks (cilium)",
+			Protocol:    SecurityGroupProtocolICMP,
+			FromPort:    8,
+			ToPort:      0,
+		},
+		{
+			Description: "hubble peer (cilium)",
+			Protocol:    SecurityGroupProtocolTCP,
+			FromPort:    4244,
+			ToPort:      4244,
+		},
+	}
+}
+
 // CNIIngressRules is a slice of CNIIngressRule.
 type CNIIngressRules []CNIIngressRule
 
+// Contains returns true if the slice contains the given rule.
+func (c CNIIngressRules) Contains(rule CNIIngressRule) bool {
+	for _, r := range c {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
 // CNIIngressRule defines an AWS ingress rule for CNI requirements.
 type CNIIngressRule struct {
 	Description string                `json:"description"`
@@ -864,7 +1262,7 @@ type RouteTable struct {
 }
 
 // SecurityGroupRole defines the unique role of a security group.
-// +kubebuilder:validation:Enum=bastion;node;controlplane;apiserver-lb;lb;node-eks-additional
+// +kubebuilder:validation:Enum=bastion;node;controlplane;apiserver-lb;lb;node-eks-additional;instance-connect-endpoint;guardduty-endpoint
 type SecurityGroupRole string
 
 var (
@@ -885,6 +1283,12 @@ var (
 
 	// SecurityGroupLB defines a container for the cloud provider to inject its load balancer ingress rules.
 	SecurityGroupLB = SecurityGroupRole("lb")
+
+	// SecurityGroupInstanceConnectEndpoint defines an EC2 Instance Connect Endpoint role.
+	SecurityGroupInstanceConnectEndpoint = SecurityGroupRole("instance-connect-endpoint")
+
+	// SecurityGroupGuardDutyEndpoint defines a GuardDuty data service VPC endpoint role.
+	SecurityGroupGuardDutyEndpoint = SecurityGroupRole("guardduty-endpoint")
 )
 
 // SecurityGroup defines an AWS security group.
@@ -968,6 +1372,53 @@ type IngressRule struct {
 	NatGatewaysIPsSource bool `json:"natGatewaysIPsSource,omitempty"`
 }
 
+// SecurityGroupEgressSpec defines the egress configuration of a security group managed by the provider.
+type SecurityGroupEgressSpec struct {
+	// RemoveDefaultEgressRule removes the allow-all egress rule that AWS adds to every new security group,
+	// so that only the rules declared in EgressRules are allowed.
+	// +optional
+	RemoveDefaultEgressRule bool `json:"removeDefaultEgressRule,omitempty"`
+
+	// EgressRules is the set of egress rules to add to the security group.
+	// +optional
+	EgressRules []EgressRule `json:"egressRules,omitempty"`
+}
+
+// EgressRule defines an AWS egress rule for security groups.
+type EgressRule struct {
+	// Description provides extended information about the egress rule.
+	Description string `json:"description"`
+	// Protocol is the protocol for the egress rule. Accepted values are "-1" (all), "4" (IP in IP),"tcp", "udp", "icmp", and "58" (ICMPv6), "50" (ESP).
+	// +kubebuilder:validation:Enum="-1";"4";tcp;udp;icmp;"58";"50"
+	Protocol SecurityGroupProtocol `json:"protocol"`
+	// FromPort is the start of port range.
+	FromPort int64 `json:"fromPort"`
+	// ToPort is the end of port range.
+	ToPort int64 `json:"toPort"`
+
+	// List of CIDR blocks to allow access to. Cannot be specified with DestinationSecurityGroupIDs.
+	// +optional
+	CidrBlocks []string `json:"cidrBlocks,omitempty"`
+
+	// List of IPv6 CIDR blocks to allow access to. Cannot be specified with DestinationSecurityGroupIDs.
+	// +optional
+	IPv6CidrBlocks []string `json:"ipv6CidrBlocks,omitempty"`
+
+	// The security group ids to allow access to. Cannot be specified with CidrBlocks.
+	// +optional
+	DestinationSecurityGroupIDs []string `json:"destinationSecurityGroupIds,omitempty"`
+
+	// The security group roles to allow access to. Cannot be specified with CidrBlocks.
+	// The field will be combined with destination security group IDs if specified.
+	// +optional
+	DestinationSecurityGroupRoles []SecurityGroupRole `json:"destinationSecurityGroupRoles,omitempty"`
+}
+
+// String returns a string representation of the egress rule.
+func (e EgressRule) String() string {
+	return fmt.Sprintf("protocol=%s/range=[%d-%d]/description=%s", e.Protocol, e.FromPort, e.ToPort, e.Description)
+}
+
 // String returns a string representation of the ingress rule.
 func (i IngressRule) String() string {
 	return fmt.Sprintf("protocol=%s/range=[%d-%d]/description=%s", i.Protocol, i.FromPort, i.ToPort, i.Description)
@@ -1096,6 +1547,15 @@ type ElasticIPPool struct {
 	// +optional
 	PublicIpv4PoolFallBackOrder *PublicIpv4PoolFallbackOrder `json:"publicIpv4PoolFallbackOrder,omitempty"`
 
+	// TagSelector makes the controller adopt existing Elastic IPs carrying all of the given tags
+	// before allocating new ones. Only addresses which are not associated and not in use by another
+	// cluster are adopted. Adopted addresses are tagged as shared with the cluster and are not
+	// released when the cluster is deleted, their cluster tags are removed instead so they can be
+	// reused by a later cluster.
+	//
+	// +optional
+	TagSelector map[string]string `json:"tagSelector,omitempty"`
+
 	// TODO(mtulio): add future support of user-defined Elastic IP to allow users to assign BYO Public IP from
 	// 'static'/preallocated amazon-provided IPsstrucute currently holds only 'BYO Public IP from Public IPv4 Pool' (user brought to AWS),
 	// although a dedicated structure would help to hold 'BYO Elastic IP' variants like:
@@ -1103,6 +1563,11 @@ type ElasticIPPool struct {
 	// - AllocationIdPoolNatGateways: an user-defined (static) IP address to allocate to NAT Gateways (egress traffic).
 }
 
+// HasTagSelector returns true when pre-tagged Elastic IPs should be adopted from the pool.
+func (p *ElasticIPPool) HasTagSelector() bool {
+	return p != nil && len(p.TagSelector) > 0
+}
+
 // PublicIpv4PoolFallbackOrder defines the list of available fallback action when the PublicIpv4Pool is exhausted.
 // 'none' let the controllers return failures when the PublicIpv4Pool is exhausted - no more IPv4 available.
 // 'amazon-pool' let the controllers to skip the PublicIpv4Pool and use the Amazon pool, the default.
@@ -848,3 +848,81 @@ func TestSubnets_HasPublicSubnetWavelength(t *testing.T) {
 		})
 	}
 }
+
+func TestCNISpec_IngressRules(t *testing.T) {
+	customRule := CNIIngressRule{
+		Description: "custom",
+		Protocol:    SecurityGroupProtocolTCP,
+		FromPort:    9000,
+		ToPort:      9000,
+	}
+	tests := []struct {
+		name string
+		cni  CNISpec
+		want CNIIngressRules
+	}{
+		{
+			name: "no profile and no custom rules",
+			cni:  CNISpec{},
+			want: CNIIngressRules{},
+		},
+		{
+			name: "no profile returns the custom rules",
+			cni:  CNISpec{CNIIngressRules: CNIIngressRules{customRule}},
+			want: CNIIngressRules{customRule},
+		},
+		{
+			name: "none profile returns the custom rules",
+			cni:  CNISpec{Profile: CNIProfileNone, CNIIngressRules: CNIIngressRules{customRule}},
+			want: CNIIngressRules{customRule},
+		},
+		{
+			name: "flannel profile is combined with the custom rules",
+			cni:  CNISpec{Profile: CNIProfileFlannel, CNIIngressRules: CNIIngressRules{customRule}},
+			want: CNIIngressRules{
+				{
+					Description: "VXLAN (flannel)",
+					Protocol:    SecurityGroupProtocolUDP,
+					FromPort:    8472,
+					ToPort:      8472,
+				},
+				customRule,
+			},
+		},
+		{
+			name: "calico profile does not duplicate defaulted calico rules",
+			cni:  CNISpec{Profile: CNIProfileCalico, CNIIngressRules: CNIProfileCalico.IngressRules()},
+			want: CNIProfileCalico.IngressRules(),
+		},
+		{
+			name: "cilium-eni profile does not allow VXLAN",
+			cni:  CNISpec{Profile: CNIProfileCiliumENI},
+			want: CNIIngressRules{
+				{
+					Description: "health checks (cilium)",
+					Protocol:    SecurityGroupProtocolTCP,
+					FromPort:    4240,
+					ToPort:      4240,
+				},
+				{
+					Description: "ICMP health checks (cilium)",
+					Protocol:    SecurityGroupProtocolICMP,
+					FromPort:    8,
+					ToPort:      0,
+				},
+				{
+					Description: "hubble peer (cilium)",
+					Protocol:    SecurityGroupProtocolTCP,
+					FromPort:    4244,
+					ToPort:      4244,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.cni.IngressRules()).To(Equal(tt.want))
+		})
+	}
+}
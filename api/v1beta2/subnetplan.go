@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// defaultAvailabilityZoneUsageLimit is the number of availability zones used when AvailabilityZoneUsageLimit is not set.
+const defaultAvailabilityZoneUsageLimit = 3
+
+// Validate validates the subnet plan of a VPC spec. The pod subnets can only be planned when podCidrBlock, the
+// secondary CIDR block dedicated to pods, is set.
+func (p *SubnetPlan) Validate(path *field.Path, vpc *VPCSpec, podCidrBlock *string) field.ErrorList {
+	var errs field.ErrorList
+
+	if p == nil {
+		return errs
+	}
+
+	zones := defaultAvailabilityZoneUsageLimit
+	if vpc.AvailabilityZoneUsageLimit != nil {
+		zones = *vpc.AvailabilityZoneUsageLimit
+	}
+
+	if vpc.CidrBlock != "" {
+		errs = append(errs, validateSubnetTierPlans(path, vpc.CidrBlock, zones, []string{"public", "private"}, []SubnetTierPlan{p.Public, p.Private})...)
+	}
+
+	if p.Pod != nil {
+		if podCidrBlock == nil {
+			return append(errs, field.Forbidden(path.Child("pod"), "pod subnets require a secondary CIDR block dedicated to pods"))
+		}
+		errs = append(errs, validateSubnetTierPlans(path, *podCidrBlock, zones, []string{"pod"}, []SubnetTierPlan{*p.Pod})...)
+	}
+
+	return errs
+}
+
+// validateSubnetTierPlans ensures that the subnets of the tiers of all the availability zones fit in the CIDR block.
+// Since the subnets are allocated largest first, they fit as long as their total size does not exceed the size of the block.
+func validateSubnetTierPlans(path *field.Path, cidrBlock string, zones int, names []string, tiers []SubnetTierPlan) field.ErrorList {
+	var errs field.ErrorList
+
+	_, ipNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		// The CIDR block is validated on its own.
+		return errs
+	}
+	networkLen, addrLen := ipNet.Mask.Size()
+
+	var total uint64
+	for i, tier := range tiers {
+		if tier.PrefixLength < networkLen {
+			errs = append(errs, field.Invalid(path.Child(names[i], "prefixLength"), tier.PrefixLength, "must not be smaller than the prefix length of CIDR block "+cidrBlock))
+			continue
+		}
+		total += uint64(zones*tier.GetSubnetsPerZone()) << uint(addrLen-tier.PrefixLength) //#nosec G115
+	}
+	if len(errs) == 0 && total > uint64(1)<<uint(addrLen-networkLen) { //#nosec G115
+		errs = append(errs, field.Invalid(path, total, "the subnets of all the availability zones do not fit in CIDR block "+cidrBlock))
+	}
+
+	return errs
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+func TestSubnetPlanValidate(t *testing.T) {
+	tests := []struct {
+		name         string
+		plan         *SubnetPlan
+		vpc          VPCSpec
+		podCidrBlock *string
+		wantErr      bool
+	}{
+		{
+			name: "nil plan is valid",
+		},
+		{
+			name: "subnets fitting in the VPC CIDR block",
+			plan: &SubnetPlan{
+				Public:  SubnetTierPlan{PrefixLength: 24},
+				Private: SubnetTierPlan{PrefixLength: 20, SubnetsPerZone: 2},
+			},
+			vpc: VPCSpec{CidrBlock: "10.0.0.0/16"},
+		},
+		{
+			name: "subnets exceeding the VPC CIDR block",
+			plan: &SubnetPlan{
+				Public:  SubnetTierPlan{PrefixLength: 24},
+				Private: SubnetTierPlan{PrefixLength: 18},
+			},
+			vpc:     VPCSpec{CidrBlock: "10.0.0.0/16", AvailabilityZoneUsageLimit: ptr.To(4)},
+			wantErr: true,
+		},
+		{
+			name: "subnet larger than the VPC CIDR block",
+			plan: &SubnetPlan{
+				Public:  SubnetTierPlan{PrefixLength: 16},
+				Private: SubnetTierPlan{PrefixLength: 20},
+			},
+			vpc:     VPCSpec{CidrBlock: "10.0.0.0/18"},
+			wantErr: true,
+		},
+		{
+			name: "pod subnets fitting in the secondary CIDR block",
+			plan: &SubnetPlan{
+				Public:  SubnetTierPlan{PrefixLength: 24},
+				Private: SubnetTierPlan{PrefixLength: 20},
+				Pod:     &SubnetTierPlan{PrefixLength: 18},
+			},
+			vpc:          VPCSpec{CidrBlock: "10.0.0.0/16"},
+			podCidrBlock: ptr.To("100.64.0.0/16"),
+		},
+		{
+			name: "pod subnets without secondary CIDR block",
+			plan: &SubnetPlan{
+				Public:  SubnetTierPlan{PrefixLength: 24},
+				Private: SubnetTierPlan{PrefixLength: 20},
+				Pod:     &SubnetTierPlan{PrefixLength: 18},
+			},
+			vpc:     VPCSpec{CidrBlock: "10.0.0.0/16"},
+			wantErr: true,
+		},
+		{
+			name: "pod subnets exceeding the secondary CIDR block",
+			plan: &SubnetPlan{
+				Public:  SubnetTierPlan{PrefixLength: 24},
+				Private: SubnetTierPlan{PrefixLength: 20},
+				Pod:     &SubnetTierPlan{PrefixLength: 17},
+			},
+			vpc:          VPCSpec{CidrBlock: "10.0.0.0/16"},
+			podCidrBlock: ptr.To("100.64.0.0/16"),
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := tt.plan.Validate(field.NewPath("spec", "network", "vpc", "subnetPlan"), &tt.vpc, tt.podCidrBlock)
+			if tt.wantErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
@@ -173,12 +173,22 @@ const (
 	// SecondarySubnetTagValue is the secondary subnet tag constant value.
 	SecondarySubnetTagValue = "secondary"
 
+	// NameKubernetesCNISubnetRole is the tag name the Amazon VPC CNI uses to discover the subnets
+	// it can place pods in.
+	NameKubernetesCNISubnetRole = "kubernetes.io/role/cni"
+
 	// APIServerRoleTagValue describes the value for the apiserver role.
 	APIServerRoleTagValue = "apiserver"
 
 	// BastionRoleTagValue describes the value for the bastion role.
 	BastionRoleTagValue = "bastion"
 
+	// InstanceConnectEndpointRoleTagValue describes the value for the EC2 Instance Connect Endpoint role.
+	InstanceConnectEndpointRoleTagValue = "instance-connect-endpoint"
+
+	// GuardDutyEndpointRoleTagValue describes the value for the GuardDuty data service VPC endpoint role.
+	GuardDutyEndpointRoleTagValue = "guardduty-endpoint"
+
 	// CommonRoleTagValue describes the value for the common role.
 	CommonRoleTagValue = "common"
 
@@ -191,6 +201,13 @@ const (
 	// MachineNameTagKey is the key for machine name.
 	MachineNameTagKey = "MachineName"
 
+	// PatchGroupTagKey is the key of the tag AWS Systems Manager uses to find the patch group of an instance.
+	PatchGroupTagKey = "Patch Group"
+
+	// GuardDutyManagedTagKey is the key of the tag GuardDuty uses to include or exclude an instance from the
+	// automated configuration of its security agent.
+	GuardDutyManagedTagKey = "GuardDutyManaged"
+
 	// LaunchTemplateBootstrapDataSecret is the tag we use to store the `<namespace>/<name>`
 	// of the bootstrap secret that was used to create the user data for the latest launch
 	// template version.
@@ -28,6 +28,10 @@ const (
 	// PreventDeletionLabel can be used in situations where preventing delation is allowed. The docs
 	// and the CRD will call this out where its allowed.
 	PreventDeletionLabel = "aws.cluster.x-k8s.io/prevent-deletion"
+
+	// MaxNetworkInterfaceSecurityGroups is the maximum number of security groups that
+	// can be associated with a single network interface.
+	MaxNetworkInterfaceSecurityGroups = 16
 )
 
 // AWSResourceReference is a reference to a specific AWS resource by ID or filters.
@@ -54,7 +58,8 @@ type AMIReference struct {
 	ID *string `json:"id,omitempty"`
 
 	// EKSOptimizedLookupType If specified, will look up an EKS Optimized image in SSM Parameter store
-	// +kubebuilder:validation:Enum:=AmazonLinux;AmazonLinuxGPU
+	// for the Kubernetes version of the machine, or of the EKS control plane when the machine pool has no version.
+	// +kubebuilder:validation:Enum:=AmazonLinux;AmazonLinuxGPU;AmazonLinux2023;AmazonLinux2023GPU;Bottlerocket;BottlerocketGPU;Ubuntu
 	// +optional
 	EKSOptimizedLookupType *EKSAMILookupType `json:"eksLookupType,omitempty"`
 }
@@ -86,6 +91,11 @@ const (
 	// ExternalResourceGCTasksAnnotation is the name of an annotation that indicates what
 	// external resources tasks should be executed by garbage collector for the cluster.
 	ExternalResourceGCTasksAnnotation = "aws.cluster.x-k8s.io/external-resource-tasks-gc"
+
+	// ReachabilityAnalysisAnnotation is the name of an annotation that requests a VPC Reachability Analyzer
+	// analysis of the network path of the instance of an AWSMachine. Its value is the ReachabilityAnalysisTarget
+	// to analyze, APIServer when empty. The annotation is removed once the analysis is started or failed to start.
+	ReachabilityAnalysisAnnotation = "aws.cluster.x-k8s.io/reachability-analysis"
 )
 
 // GCTask defines a task to be executed by the garbage collector.
@@ -273,6 +283,23 @@ type Instance struct {
 	// If marketType is not specified and spotMarketOptions is provided, the marketType defaults to "Spot".
 	// +optional
 	MarketType MarketType `json:"marketType,omitempty"`
+
+	// CPUOptions is the number of CPU cores and threads per core of the instance.
+	// +optional
+	CPUOptions *CPUOptions `json:"cpuOptions,omitempty"`
+}
+
+// CPUOptions defines the number of CPU cores and threads per core of an instance.
+// The valid values depend on the instance type.
+type CPUOptions struct {
+	// CoreCount is the number of CPU cores of the instance.
+	// +kubebuilder:validation:Minimum=1
+	CoreCount int64 `json:"coreCount"`
+
+	// ThreadsPerCore is the number of threads per CPU core. Set it to 1 to disable simultaneous multithreading.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2
+	ThreadsPerCore int64 `json:"threadsPerCore"`
 }
 
 // MarketType describes the market type of an Instance
@@ -467,6 +494,12 @@ const (
 	AmazonLinux2023 EKSAMILookupType = "AmazonLinux2023"
 	// AmazonLinux2023GPU is the AmazonLinux 2023 GPU AMI type.
 	AmazonLinux2023GPU EKSAMILookupType = "AmazonLinux2023GPU"
+	// Bottlerocket is the Bottlerocket AMI type.
+	Bottlerocket EKSAMILookupType = "Bottlerocket"
+	// BottlerocketGPU is the Bottlerocket NVIDIA GPU AMI type.
+	BottlerocketGPU EKSAMILookupType = "BottlerocketGPU"
+	// Ubuntu is the Ubuntu EKS AMI type published by Canonical.
+	Ubuntu EKSAMILookupType = "Ubuntu"
 )
 
 // PrivateDNSName is the options for the instance hostname.
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// WriteFreezeUntilAnnotation is the name of an annotation that freezes every mutating AWS API
+// call made for the cluster until the given RFC 3339 timestamp. Read-only calls keep being made
+// so that the status of the cluster stays up to date. It is set on the AWSCluster or the
+// AWSManagedControlPlane.
+const WriteFreezeUntilAnnotation = "aws.cluster.x-k8s.io/write-freeze-until"
+
+// WriteFreezeUntil returns the end of the AWS write freeze requested on the object, and whether
+// the object is frozen at the given time.
+func WriteFreezeUntil(obj metav1.Object, now time.Time) (time.Time, bool, error) {
+	value, ok := obj.GetAnnotations()[WriteFreezeUntilAnnotation]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("annotation %s must be an RFC 3339 timestamp: %w", WriteFreezeUntilAnnotation, err)
+	}
+	return until, now.Before(until), nil
+}
+
+func validateWriteFreezeAnnotation(obj metav1.Object) field.ErrorList {
+	if _, _, err := WriteFreezeUntil(obj, time.Now()); err != nil {
+		return field.ErrorList{field.Invalid(field.NewPath("metadata", "annotations", WriteFreezeUntilAnnotation), obj.GetAnnotations()[WriteFreezeUntilAnnotation], err.Error())}
+	}
+	return nil
+}
@@ -21,12 +21,27 @@ limitations under the License.
 package v1beta2
 
 import (
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMICopy) DeepCopyInto(out *AMICopy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMICopy.
+func (in *AMICopy) DeepCopy() *AMICopy {
+	if in == nil {
+		return nil
+	}
+	out := new(AMICopy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AMIReference) DeepCopyInto(out *AMIReference) {
 	*out = *in
@@ -52,6 +67,110 @@ func (in *AMIReference) DeepCopy() *AMIReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSBastionHost) DeepCopyInto(out *AWSBastionHost) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSBastionHost.
+func (in *AWSBastionHost) DeepCopy() *AWSBastionHost {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSBastionHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSBastionHost) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSBastionHostList) DeepCopyInto(out *AWSBastionHostList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSBastionHost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSBastionHostList.
+func (in *AWSBastionHostList) DeepCopy() *AWSBastionHostList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSBastionHostList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSBastionHostList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSBastionHostSpec) DeepCopyInto(out *AWSBastionHostSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllowedCIDRBlocks != nil {
+		in, out := &in.AllowedCIDRBlocks, &out.AllowedCIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSBastionHostSpec.
+func (in *AWSBastionHostSpec) DeepCopy() *AWSBastionHostSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSBastionHostSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSBastionHostStatus) DeepCopyInto(out *AWSBastionHostStatus) {
+	*out = *in
+	if in.Instance != nil {
+		in, out := &in.Instance, &out.Instance
+		*out = new(Instance)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSBastionHostStatus.
+func (in *AWSBastionHostStatus) DeepCopy() *AWSBastionHostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSBastionHostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSCluster) DeepCopyInto(out *AWSCluster) {
 	*out = *in
@@ -313,6 +432,21 @@ func (in *AWSClusterSpec) DeepCopyInto(out *AWSClusterSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Bastion.DeepCopyInto(&out.Bastion)
+	if in.BastionHostRef != nil {
+		in, out := &in.BastionHostRef, &out.BastionHostRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.InstanceConnectEndpoint != nil {
+		in, out := &in.InstanceConnectEndpoint, &out.InstanceConnectEndpoint
+		*out = new(InstanceConnectEndpoint)
+		**out = **in
+	}
+	if in.GuardDutyRuntimeMonitoring != nil {
+		in, out := &in.GuardDutyRuntimeMonitoring, &out.GuardDutyRuntimeMonitoring
+		*out = new(GuardDutyRuntimeMonitoring)
+		**out = **in
+	}
 	if in.IdentityRef != nil {
 		in, out := &in.IdentityRef, &out.IdentityRef
 		*out = new(AWSIdentityReference)
@@ -323,6 +457,56 @@ func (in *AWSClusterSpec) DeepCopyInto(out *AWSClusterSpec) {
 		*out = new(S3Bucket)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(Observability)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = new(FailureDomainsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlanePlacement != nil {
+		in, out := &in.ControlPlanePlacement, &out.ControlPlanePlacement
+		*out = new(ControlPlanePlacement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Adoption != nil {
+		in, out := &in.Adoption, &out.Adoption
+		*out = new(Adoption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudProviderConfig != nil {
+		in, out := &in.CloudProviderConfig, &out.CloudProviderConfig
+		*out = new(CloudProviderConfig)
+		**out = **in
+	}
+	if in.PrivateDNS != nil {
+		in, out := &in.PrivateDNS, &out.PrivateDNS
+		*out = new(PrivateDNS)
+		**out = **in
+	}
+	if in.SessionManager != nil {
+		in, out := &in.SessionManager, &out.SessionManager
+		*out = new(SessionManager)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineDefaults != nil {
+		in, out := &in.MachineDefaults, &out.MachineDefaults
+		*out = new(MachineDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(Budget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterSpec.
@@ -432,6 +616,38 @@ func (in *AWSClusterStatus) DeepCopyInto(out *AWSClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.InstanceConnectEndpoint != nil {
+		in, out := &in.InstanceConnectEndpoint, &out.InstanceConnectEndpoint
+		*out = new(InstanceConnectEndpointStatus)
+		**out = **in
+	}
+	if in.GuardDutyRuntimeMonitoring != nil {
+		in, out := &in.GuardDutyRuntimeMonitoring, &out.GuardDutyRuntimeMonitoring
+		*out = new(GuardDutyRuntimeMonitoringStatus)
+		**out = **in
+	}
+	if in.PendingDisruptiveActions != nil {
+		in, out := &in.PendingDisruptiveActions, &out.PendingDisruptiveActions
+		*out = make(DisruptiveActions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Adoption != nil {
+		in, out := &in.Adoption, &out.Adoption
+		*out = new(AdoptionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceLimits != nil {
+		in, out := &in.ResourceLimits, &out.ResourceLimits
+		*out = new(ResourceLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(BudgetStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterStatus.
@@ -583,6 +799,11 @@ func (in *AWSLoadBalancerSpec) DeepCopyInto(out *AWSLoadBalancerSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.AdditionalListeners != nil {
 		in, out := &in.AdditionalListeners, &out.AdditionalListeners
 		*out = make([]AdditionalListenerSpec, len(*in))
@@ -687,6 +908,16 @@ func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 		**out = **in
 	}
 	in.AMI.DeepCopyInto(&out.AMI)
+	if in.AMICopy != nil {
+		in, out := &in.AMICopy, &out.AMICopy
+		*out = new(AMICopy)
+		**out = **in
+	}
+	if in.InstanceRequirements != nil {
+		in, out := &in.InstanceRequirements, &out.InstanceRequirements
+		*out = new(InstanceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdditionalTags != nil {
 		in, out := &in.AdditionalTags, &out.AdditionalTags
 		*out = make(Tags, len(*in))
@@ -694,6 +925,11 @@ func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ManagedIAMInstanceProfile != nil {
+		in, out := &in.ManagedIAMInstanceProfile, &out.ManagedIAMInstanceProfile
+		*out = new(ManagedIAMInstanceProfile)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PublicIP != nil {
 		in, out := &in.PublicIP, &out.PublicIP
 		*out = new(bool)
@@ -761,6 +997,11 @@ func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 		*out = new(SpotMarketOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SpotFallback != nil {
+		in, out := &in.SpotFallback, &out.SpotFallback
+		*out = new(SpotFallback)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PrivateDNSName != nil {
 		in, out := &in.PrivateDNSName, &out.PrivateDNSName
 		*out = new(PrivateDNSName)
@@ -771,6 +1012,31 @@ func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptions)
+		**out = **in
+	}
+	if in.AcceleratorBootstrap != nil {
+		in, out := &in.AcceleratorBootstrap, &out.AcceleratorBootstrap
+		*out = new(AcceleratorBootstrap)
+		**out = **in
+	}
+	if in.PatchManagement != nil {
+		in, out := &in.PatchManagement, &out.PatchManagement
+		*out = new(PatchManagement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScheduledEventRemediation != nil {
+		in, out := &in.ScheduledEventRemediation, &out.ScheduledEventRemediation
+		*out = new(ScheduledEventRemediation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudWatchLogs != nil {
+		in, out := &in.CloudWatchLogs, &out.CloudWatchLogs
+		*out = new(CloudWatchLogs)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineSpec.
@@ -813,6 +1079,50 @@ func (in *AWSMachineStatus) DeepCopyInto(out *AWSMachineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BootstrapDiagnosis != nil {
+		in, out := &in.BootstrapDiagnosis, &out.BootstrapDiagnosis
+		*out = new(BootstrapDiagnosis)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReachabilityAnalysis != nil {
+		in, out := &in.ReachabilityAnalysis, &out.ReachabilityAnalysis
+		*out = new(ReachabilityAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeline != nil {
+		in, out := &in.Timeline, &out.Timeline
+		*out = make([]TimelineEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SpotFallback != nil {
+		in, out := &in.SpotFallback, &out.SpotFallback
+		*out = new(SpotFallbackStatus)
+		**out = **in
+	}
+	if in.Accelerators != nil {
+		in, out := &in.Accelerators, &out.Accelerators
+		*out = make([]Accelerator, len(*in))
+		copy(*out, *in)
+	}
+	if in.AcceleratorInventory != nil {
+		in, out := &in.AcceleratorInventory, &out.AcceleratorInventory
+		*out = new(AcceleratorInventory)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PatchManagement != nil {
+		in, out := &in.PatchManagement, &out.PatchManagement
+		*out = new(PatchManagementStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScheduledEvents != nil {
+		in, out := &in.ScheduledEvents, &out.ScheduledEvents
+		*out = make([]ScheduledEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineStatus.
@@ -922,11 +1232,16 @@ func (in *AWSMachineTemplateStatus) DeepCopyInto(out *AWSMachineTemplateStatus)
 	*out = *in
 	if in.Capacity != nil {
 		in, out := &in.Capacity, &out.Capacity
-		*out = make(corev1.ResourceList, len(*in))
+		*out = make(v1.ResourceList, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.NodeInfo != nil {
+		in, out := &in.NodeInfo, &out.NodeInfo
+		*out = new(NodeInfo)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineTemplateStatus.
@@ -1091,822 +1406,1949 @@ func (in *AWSRoleSpec) DeepCopy() *AWSRoleSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AdditionalListenerSpec) DeepCopyInto(out *AdditionalListenerSpec) {
+func (in *Accelerator) DeepCopyInto(out *Accelerator) {
 	*out = *in
-	if in.HealthCheck != nil {
-		in, out := &in.HealthCheck, &out.HealthCheck
-		*out = new(TargetGroupHealthCheckAdditionalSpec)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalListenerSpec.
-func (in *AdditionalListenerSpec) DeepCopy() *AdditionalListenerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Accelerator.
+func (in *Accelerator) DeepCopy() *Accelerator {
 	if in == nil {
 		return nil
 	}
-	out := new(AdditionalListenerSpec)
+	out := new(Accelerator)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AllowedNamespaces) DeepCopyInto(out *AllowedNamespaces) {
+func (in *AcceleratorBootstrap) DeepCopyInto(out *AcceleratorBootstrap) {
 	*out = *in
-	if in.NamespaceList != nil {
-		in, out := &in.NamespaceList, &out.NamespaceList
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	in.Selector.DeepCopyInto(&out.Selector)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedNamespaces.
-func (in *AllowedNamespaces) DeepCopy() *AllowedNamespaces {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcceleratorBootstrap.
+func (in *AcceleratorBootstrap) DeepCopy() *AcceleratorBootstrap {
 	if in == nil {
 		return nil
 	}
-	out := new(AllowedNamespaces)
+	out := new(AcceleratorBootstrap)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Bastion) DeepCopyInto(out *Bastion) {
+func (in *AcceleratorInventory) DeepCopyInto(out *AcceleratorInventory) {
 	*out = *in
-	if in.AllowedCIDRBlocks != nil {
-		in, out := &in.AllowedCIDRBlocks, &out.AllowedCIDRBlocks
+	if in.GPUTypes != nil {
+		in, out := &in.GPUTypes, &out.GPUTypes
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bastion.
-func (in *Bastion) DeepCopy() *Bastion {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcceleratorInventory.
+func (in *AcceleratorInventory) DeepCopy() *AcceleratorInventory {
 	if in == nil {
 		return nil
 	}
-	out := new(Bastion)
+	out := new(AcceleratorInventory)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildParams) DeepCopyInto(out *BuildParams) {
+func (in *AdditionalListenerSpec) DeepCopyInto(out *AdditionalListenerSpec) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.Role != nil {
-		in, out := &in.Role, &out.Role
-		*out = new(string)
-		**out = **in
-	}
-	if in.Additional != nil {
-		in, out := &in.Additional, &out.Additional
-		*out = make(Tags, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(TargetGroupHealthCheckAdditionalSpec)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildParams.
-func (in *BuildParams) DeepCopy() *BuildParams {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalListenerSpec.
+func (in *AdditionalListenerSpec) DeepCopy() *AdditionalListenerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BuildParams)
+	out := new(AdditionalListenerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CNIIngressRule) DeepCopyInto(out *CNIIngressRule) {
+func (in *AdoptedResource) DeepCopyInto(out *AdoptedResource) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIIngressRule.
-func (in *CNIIngressRule) DeepCopy() *CNIIngressRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdoptedResource.
+func (in *AdoptedResource) DeepCopy() *AdoptedResource {
 	if in == nil {
 		return nil
 	}
-	out := new(CNIIngressRule)
+	out := new(AdoptedResource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in CNIIngressRules) DeepCopyInto(out *CNIIngressRules) {
-	{
-		in := &in
-		*out = make(CNIIngressRules, len(*in))
-		copy(*out, *in)
+func (in *Adoption) DeepCopyInto(out *Adoption) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(Tags, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIIngressRules.
-func (in CNIIngressRules) DeepCopy() CNIIngressRules {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Adoption.
+func (in *Adoption) DeepCopy() *Adoption {
 	if in == nil {
 		return nil
 	}
-	out := new(CNIIngressRules)
+	out := new(Adoption)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CNISpec) DeepCopyInto(out *CNISpec) {
+func (in *AdoptionStatus) DeepCopyInto(out *AdoptionStatus) {
 	*out = *in
-	if in.CNIIngressRules != nil {
-		in, out := &in.CNIIngressRules, &out.CNIIngressRules
-		*out = make(CNIIngressRules, len(*in))
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]AdoptedResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNISpec.
-func (in *CNISpec) DeepCopy() *CNISpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdoptionStatus.
+func (in *AdoptionStatus) DeepCopy() *AdoptionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CNISpec)
+	out := new(AdoptionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClassicELBAttributes) DeepCopyInto(out *ClassicELBAttributes) {
+func (in *AllowedNamespaces) DeepCopyInto(out *AllowedNamespaces) {
 	*out = *in
+	if in.NamespaceList != nil {
+		in, out := &in.NamespaceList, &out.NamespaceList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Selector.DeepCopyInto(&out.Selector)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBAttributes.
-func (in *ClassicELBAttributes) DeepCopy() *ClassicELBAttributes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedNamespaces.
+func (in *AllowedNamespaces) DeepCopy() *AllowedNamespaces {
 	if in == nil {
 		return nil
 	}
-	out := new(ClassicELBAttributes)
+	out := new(AllowedNamespaces)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClassicELBHealthCheck) DeepCopyInto(out *ClassicELBHealthCheck) {
+func (in *Bastion) DeepCopyInto(out *Bastion) {
 	*out = *in
+	if in.AllowedCIDRBlocks != nil {
+		in, out := &in.AllowedCIDRBlocks, &out.AllowedCIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBHealthCheck.
-func (in *ClassicELBHealthCheck) DeepCopy() *ClassicELBHealthCheck {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bastion.
+func (in *Bastion) DeepCopy() *Bastion {
 	if in == nil {
 		return nil
 	}
-	out := new(ClassicELBHealthCheck)
+	out := new(Bastion)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClassicELBListener) DeepCopyInto(out *ClassicELBListener) {
+func (in *BootstrapDiagnosis) DeepCopyInto(out *BootstrapDiagnosis) {
 	*out = *in
+	in.CollectedAt.DeepCopyInto(&out.CollectedAt)
+	if in.Reachability != nil {
+		in, out := &in.Reachability, &out.Reachability
+		*out = new(ReachabilityAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Findings != nil {
+		in, out := &in.Findings, &out.Findings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBListener.
-func (in *ClassicELBListener) DeepCopy() *ClassicELBListener {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapDiagnosis.
+func (in *BootstrapDiagnosis) DeepCopy() *BootstrapDiagnosis {
 	if in == nil {
 		return nil
 	}
-	out := new(ClassicELBListener)
+	out := new(BootstrapDiagnosis)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudInit) DeepCopyInto(out *CloudInit) {
+func (in *Budget) DeepCopyInto(out *Budget) {
 	*out = *in
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]BudgetNotification, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInit.
-func (in *CloudInit) DeepCopy() *CloudInit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Budget.
+func (in *Budget) DeepCopy() *Budget {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudInit)
+	out := new(Budget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ElasticIPPool) DeepCopyInto(out *ElasticIPPool) {
+func (in *BudgetNotification) DeepCopyInto(out *BudgetNotification) {
 	*out = *in
-	if in.PublicIpv4Pool != nil {
-		in, out := &in.PublicIpv4Pool, &out.PublicIpv4Pool
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetNotification.
+func (in *BudgetNotification) DeepCopy() *BudgetNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(BudgetNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BudgetStatus) DeepCopyInto(out *BudgetStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetStatus.
+func (in *BudgetStatus) DeepCopy() *BudgetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BudgetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildParams) DeepCopyInto(out *BuildParams) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.PublicIpv4PoolFallBackOrder != nil {
-		in, out := &in.PublicIpv4PoolFallBackOrder, &out.PublicIpv4PoolFallBackOrder
-		*out = new(PublicIpv4PoolFallbackOrder)
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
 		**out = **in
 	}
+	if in.Additional != nil {
+		in, out := &in.Additional, &out.Additional
+		*out = make(Tags, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPPool.
-func (in *ElasticIPPool) DeepCopy() *ElasticIPPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildParams.
+func (in *BuildParams) DeepCopy() *BuildParams {
 	if in == nil {
 		return nil
 	}
-	out := new(ElasticIPPool)
+	out := new(BuildParams)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Filter) DeepCopyInto(out *Filter) {
+func (in *CNIIngressRule) DeepCopyInto(out *CNIIngressRule) {
 	*out = *in
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = make([]string, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIIngressRule.
+func (in *CNIIngressRule) DeepCopy() *CNIIngressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CNIIngressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in CNIIngressRules) DeepCopyInto(out *CNIIngressRules) {
+	{
+		in := &in
+		*out = make(CNIIngressRules, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
-func (in *Filter) DeepCopy() *Filter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIIngressRules.
+func (in CNIIngressRules) DeepCopy() CNIIngressRules {
 	if in == nil {
 		return nil
 	}
-	out := new(Filter)
+	out := new(CNIIngressRules)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMPool) DeepCopyInto(out *IPAMPool) {
+func (in *CNISpec) DeepCopyInto(out *CNISpec) {
 	*out = *in
+	if in.CNIIngressRules != nil {
+		in, out := &in.CNIIngressRules, &out.CNIIngressRules
+		*out = make(CNIIngressRules, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPool.
-func (in *IPAMPool) DeepCopy() *IPAMPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNISpec.
+func (in *CNISpec) DeepCopy() *CNISpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAMPool)
+	out := new(CNISpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPv6) DeepCopyInto(out *IPv6) {
+func (in *CPUOptions) DeepCopyInto(out *CPUOptions) {
 	*out = *in
-	if in.EgressOnlyInternetGatewayID != nil {
-		in, out := &in.EgressOnlyInternetGatewayID, &out.EgressOnlyInternetGatewayID
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAMPool != nil {
-		in, out := &in.IPAMPool, &out.IPAMPool
-		*out = new(IPAMPool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6.
-func (in *IPv6) DeepCopy() *IPv6 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUOptions.
+func (in *CPUOptions) DeepCopy() *CPUOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(IPv6)
+	out := new(CPUOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Ignition) DeepCopyInto(out *Ignition) {
+func (in *ClassicELBAttributes) DeepCopyInto(out *ClassicELBAttributes) {
 	*out = *in
-	if in.Proxy != nil {
-		in, out := &in.Proxy, &out.Proxy
-		*out = new(IgnitionProxy)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(IgnitionTLS)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ignition.
-func (in *Ignition) DeepCopy() *Ignition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBAttributes.
+func (in *ClassicELBAttributes) DeepCopy() *ClassicELBAttributes {
 	if in == nil {
 		return nil
 	}
-	out := new(Ignition)
+	out := new(ClassicELBAttributes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IgnitionProxy) DeepCopyInto(out *IgnitionProxy) {
+func (in *ClassicELBHealthCheck) DeepCopyInto(out *ClassicELBHealthCheck) {
 	*out = *in
-	if in.HTTPProxy != nil {
-		in, out := &in.HTTPProxy, &out.HTTPProxy
-		*out = new(string)
-		**out = **in
-	}
-	if in.HTTPSProxy != nil {
-		in, out := &in.HTTPSProxy, &out.HTTPSProxy
-		*out = new(string)
-		**out = **in
-	}
-	if in.NoProxy != nil {
-		in, out := &in.NoProxy, &out.NoProxy
-		*out = make([]IgnitionNoProxy, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionProxy.
-func (in *IgnitionProxy) DeepCopy() *IgnitionProxy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBHealthCheck.
+func (in *ClassicELBHealthCheck) DeepCopy() *ClassicELBHealthCheck {
 	if in == nil {
 		return nil
 	}
-	out := new(IgnitionProxy)
+	out := new(ClassicELBHealthCheck)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IgnitionTLS) DeepCopyInto(out *IgnitionTLS) {
+func (in *ClassicELBListener) DeepCopyInto(out *ClassicELBListener) {
 	*out = *in
-	if in.CASources != nil {
-		in, out := &in.CASources, &out.CASources
-		*out = make([]IgnitionCASource, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionTLS.
-func (in *IgnitionTLS) DeepCopy() *IgnitionTLS {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBListener.
+func (in *ClassicELBListener) DeepCopy() *ClassicELBListener {
 	if in == nil {
 		return nil
 	}
-	out := new(IgnitionTLS)
+	out := new(ClassicELBListener)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressRule) DeepCopyInto(out *IngressRule) {
+func (in *CloudInit) DeepCopyInto(out *CloudInit) {
 	*out = *in
-	if in.CidrBlocks != nil {
-		in, out := &in.CidrBlocks, &out.CidrBlocks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.IPv6CidrBlocks != nil {
-		in, out := &in.IPv6CidrBlocks, &out.IPv6CidrBlocks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SourceSecurityGroupIDs != nil {
-		in, out := &in.SourceSecurityGroupIDs, &out.SourceSecurityGroupIDs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SourceSecurityGroupRoles != nil {
-		in, out := &in.SourceSecurityGroupRoles, &out.SourceSecurityGroupRoles
-		*out = make([]SecurityGroupRole, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRule.
-func (in *IngressRule) DeepCopy() *IngressRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInit.
+func (in *CloudInit) DeepCopy() *CloudInit {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressRule)
+	out := new(CloudInit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in IngressRules) DeepCopyInto(out *IngressRules) {
-	{
-		in := &in
-		*out = make(IngressRules, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+func (in *CloudProviderConfig) DeepCopyInto(out *CloudProviderConfig) {
+	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRules.
-func (in IngressRules) DeepCopy() IngressRules {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProviderConfig.
+func (in *CloudProviderConfig) DeepCopy() *CloudProviderConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressRules)
+	out := new(CloudProviderConfig)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Instance) DeepCopyInto(out *Instance) {
+func (in *CloudWatchAlarms) DeepCopyInto(out *CloudWatchAlarms) {
 	*out = *in
-	if in.SSHKeyName != nil {
-		in, out := &in.SSHKeyName, &out.SSHKeyName
-		*out = new(string)
-		**out = **in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudWatchAlarms.
+func (in *CloudWatchAlarms) DeepCopy() *CloudWatchAlarms {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudWatchAlarms)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudWatchLogs) DeepCopyInto(out *CloudWatchLogs) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudWatchLogs.
+func (in *CloudWatchLogs) DeepCopy() *CloudWatchLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudWatchLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlanePlacement) DeepCopyInto(out *ControlPlanePlacement) {
+	*out = *in
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedInstanceTypes != nil {
+		in, out := &in.AllowedInstanceTypes, &out.AllowedInstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlanePlacement.
+func (in *ControlPlanePlacement) DeepCopy() *ControlPlanePlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlanePlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisruptiveAction) DeepCopyInto(out *DisruptiveAction) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisruptiveAction.
+func (in *DisruptiveAction) DeepCopy() *DisruptiveAction {
+	if in == nil {
+		return nil
+	}
+	out := new(DisruptiveAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in DisruptiveActions) DeepCopyInto(out *DisruptiveActions) {
+	{
+		in := &in
+		*out = make(DisruptiveActions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisruptiveActions.
+func (in DisruptiveActions) DeepCopy() DisruptiveActions {
+	if in == nil {
+		return nil
+	}
+	out := new(DisruptiveActions)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressRule) DeepCopyInto(out *EgressRule) {
+	*out = *in
+	if in.CidrBlocks != nil {
+		in, out := &in.CidrBlocks, &out.CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6CidrBlocks != nil {
+		in, out := &in.IPv6CidrBlocks, &out.IPv6CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationSecurityGroupIDs != nil {
+		in, out := &in.DestinationSecurityGroupIDs, &out.DestinationSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationSecurityGroupRoles != nil {
+		in, out := &in.DestinationSecurityGroupRoles, &out.DestinationSecurityGroupRoles
+		*out = make([]SecurityGroupRole, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRule.
+func (in *EgressRule) DeepCopy() *EgressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIPPool) DeepCopyInto(out *ElasticIPPool) {
+	*out = *in
+	if in.PublicIpv4Pool != nil {
+		in, out := &in.PublicIpv4Pool, &out.PublicIpv4Pool
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIpv4PoolFallBackOrder != nil {
+		in, out := &in.PublicIpv4PoolFallBackOrder, &out.PublicIpv4PoolFallBackOrder
+		*out = new(PublicIpv4PoolFallbackOrder)
+		**out = **in
+	}
+	if in.TagSelector != nil {
+		in, out := &in.TagSelector, &out.TagSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPPool.
+func (in *ElasticIPPool) DeepCopy() *ElasticIPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureDomainsSpec) DeepCopyInto(out *FailureDomainsSpec) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]FailureDomainSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.ControlPlaneExcludedZones != nil {
+		in, out := &in.ControlPlaneExcludedZones, &out.ControlPlaneExcludedZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureDomainsSpec.
+func (in *FailureDomainsSpec) DeepCopy() *FailureDomainsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureDomainsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Filter) DeepCopyInto(out *Filter) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
+func (in *Filter) DeepCopy() *Filter {
+	if in == nil {
+		return nil
+	}
+	out := new(Filter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardDutyRuntimeMonitoring) DeepCopyInto(out *GuardDutyRuntimeMonitoring) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardDutyRuntimeMonitoring.
+func (in *GuardDutyRuntimeMonitoring) DeepCopy() *GuardDutyRuntimeMonitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardDutyRuntimeMonitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardDutyRuntimeMonitoringStatus) DeepCopyInto(out *GuardDutyRuntimeMonitoringStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardDutyRuntimeMonitoringStatus.
+func (in *GuardDutyRuntimeMonitoringStatus) DeepCopy() *GuardDutyRuntimeMonitoringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardDutyRuntimeMonitoringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPool) DeepCopyInto(out *IPAMPool) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPool.
+func (in *IPAMPool) DeepCopy() *IPAMPool {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolAllocation) DeepCopyInto(out *IPAMPoolAllocation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolAllocation.
+func (in *IPAMPoolAllocation) DeepCopy() *IPAMPoolAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6) DeepCopyInto(out *IPv6) {
+	*out = *in
+	if in.EgressOnlyInternetGatewayID != nil {
+		in, out := &in.EgressOnlyInternetGatewayID, &out.EgressOnlyInternetGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMPool != nil {
+		in, out := &in.IPAMPool, &out.IPAMPool
+		*out = new(IPAMPool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6.
+func (in *IPv6) DeepCopy() *IPv6 {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ignition) DeepCopyInto(out *Ignition) {
+	*out = *in
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(IgnitionProxy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(IgnitionTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ignition.
+func (in *Ignition) DeepCopy() *Ignition {
+	if in == nil {
+		return nil
+	}
+	out := new(Ignition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnitionProxy) DeepCopyInto(out *IgnitionProxy) {
+	*out = *in
+	if in.HTTPProxy != nil {
+		in, out := &in.HTTPProxy, &out.HTTPProxy
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPSProxy != nil {
+		in, out := &in.HTTPSProxy, &out.HTTPSProxy
+		*out = new(string)
+		**out = **in
+	}
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = make([]IgnitionNoProxy, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionProxy.
+func (in *IgnitionProxy) DeepCopy() *IgnitionProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnitionProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnitionTLS) DeepCopyInto(out *IgnitionTLS) {
+	*out = *in
+	if in.CASources != nil {
+		in, out := &in.CASources, &out.CASources
+		*out = make([]IgnitionCASource, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionTLS.
+func (in *IgnitionTLS) DeepCopy() *IgnitionTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnitionTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressRule) DeepCopyInto(out *IngressRule) {
+	*out = *in
+	if in.CidrBlocks != nil {
+		in, out := &in.CidrBlocks, &out.CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6CidrBlocks != nil {
+		in, out := &in.IPv6CidrBlocks, &out.IPv6CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceSecurityGroupIDs != nil {
+		in, out := &in.SourceSecurityGroupIDs, &out.SourceSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceSecurityGroupRoles != nil {
+		in, out := &in.SourceSecurityGroupRoles, &out.SourceSecurityGroupRoles
+		*out = make([]SecurityGroupRole, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRule.
+func (in *IngressRule) DeepCopy() *IngressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in IngressRules) DeepCopyInto(out *IngressRules) {
+	{
+		in := &in
+		*out = make(IngressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRules.
+func (in IngressRules) DeepCopy() IngressRules {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressRules)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InlinePolicy) DeepCopyInto(out *InlinePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InlinePolicy.
+func (in *InlinePolicy) DeepCopy() *InlinePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(InlinePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Instance) DeepCopyInto(out *Instance) {
+	*out = *in
+	if in.SSHKeyName != nil {
+		in, out := &in.SSHKeyName, &out.SSHKeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserData != nil {
+		in, out := &in.UserData, &out.UserData
+		*out = new(string)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]v1beta1.MachineAddress, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateIP != nil {
+		in, out := &in.PrivateIP, &out.PrivateIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.ENASupport != nil {
+		in, out := &in.ENASupport, &out.ENASupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EBSOptimized != nil {
+		in, out := &in.EBSOptimized, &out.EBSOptimized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RootVolume != nil {
+		in, out := &in.RootVolume, &out.RootVolume
+		*out = new(Volume)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NonRootVolumes != nil {
+		in, out := &in.NonRootVolumes, &out.NonRootVolumes
+		*out = make([]Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SpotMarketOptions != nil {
+		in, out := &in.SpotMarketOptions, &out.SpotMarketOptions
+		*out = new(SpotMarketOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeIDs != nil {
+		in, out := &in.VolumeIDs, &out.VolumeIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceMetadataOptions != nil {
+		in, out := &in.InstanceMetadataOptions, &out.InstanceMetadataOptions
+		*out = new(InstanceMetadataOptions)
+		**out = **in
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(PrivateDNSName)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicIPOnLaunch != nil {
+		in, out := &in.PublicIPOnLaunch, &out.PublicIPOnLaunch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CapacityReservationID != nil {
+		in, out := &in.CapacityReservationID, &out.CapacityReservationID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptions)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
+func (in *Instance) DeepCopy() *Instance {
+	if in == nil {
+		return nil
+	}
+	out := new(Instance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceConnectEndpoint) DeepCopyInto(out *InstanceConnectEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceConnectEndpoint.
+func (in *InstanceConnectEndpoint) DeepCopy() *InstanceConnectEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceConnectEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceConnectEndpointStatus) DeepCopyInto(out *InstanceConnectEndpointStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceConnectEndpointStatus.
+func (in *InstanceConnectEndpointStatus) DeepCopy() *InstanceConnectEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceConnectEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMetadataOptions) DeepCopyInto(out *InstanceMetadataOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMetadataOptions.
+func (in *InstanceMetadataOptions) DeepCopy() *InstanceMetadataOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMetadataOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceRequirements) DeepCopyInto(out *InstanceRequirements) {
+	*out = *in
+	in.VCPUCount.DeepCopyInto(&out.VCPUCount)
+	in.MemoryMiB.DeepCopyInto(&out.MemoryMiB)
+	if in.AcceleratorCount != nil {
+		in, out := &in.AcceleratorCount, &out.AcceleratorCount
+		*out = new(ResourceRange)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AcceleratorTypes != nil {
+		in, out := &in.AcceleratorTypes, &out.AcceleratorTypes
+		*out = make([]InstanceRequirementsAcceleratorType, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedInstanceTypes != nil {
+		in, out := &in.AllowedInstanceTypes, &out.AllowedInstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedInstanceTypes != nil {
+		in, out := &in.ExcludedInstanceTypes, &out.ExcludedInstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRequirements.
+func (in *InstanceRequirements) DeepCopy() *InstanceRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTypeNetworkLimits) DeepCopyInto(out *InstanceTypeNetworkLimits) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTypeNetworkLimits.
+func (in *InstanceTypeNetworkLimits) DeepCopy() *InstanceTypeNetworkLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTypeNetworkLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Listener) DeepCopyInto(out *Listener) {
+	*out = *in
+	in.TargetGroup.DeepCopyInto(&out.TargetGroup)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Listener.
+func (in *Listener) DeepCopy() *Listener {
+	if in == nil {
+		return nil
+	}
+	out := new(Listener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
+	*out = *in
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	if in.SecurityGroupIDs != nil {
 		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.UserData != nil {
-		in, out := &in.UserData, &out.UserData
-		*out = new(string)
-		**out = **in
+	if in.ClassicELBListeners != nil {
+		in, out := &in.ClassicELBListeners, &out.ClassicELBListeners
+		*out = make([]ClassicELBListener, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(ClassicELBHealthCheck)
+		**out = **in
+	}
+	out.ClassicElbAttributes = in.ClassicElbAttributes
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ELBListeners != nil {
+		in, out := &in.ELBListeners, &out.ELBListeners
+		*out = make([]Listener, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ELBAttributes != nil {
+		in, out := &in.ELBAttributes, &out.ELBAttributes
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
+func (in *LoadBalancer) DeepCopy() *LoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDefaults) DeepCopyInto(out *MachineDefaults) {
+	*out = *in
+	if in.InstanceMetadataOptions != nil {
+		in, out := &in.InstanceMetadataOptions, &out.InstanceMetadataOptions
+		*out = new(InstanceMetadataOptions)
+		**out = **in
+	}
+	if in.AdditionalSecurityGroups != nil {
+		in, out := &in.AdditionalSecurityGroups, &out.AdditionalSecurityGroups
+		*out = make([]AWSResourceReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalTags != nil {
+		in, out := &in.AdditionalTags, &out.AdditionalTags
+		*out = make(Tags, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RootVolumeEncryption != nil {
+		in, out := &in.RootVolumeEncryption, &out.RootVolumeEncryption
+		*out = new(RootVolumeEncryption)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDefaults.
+func (in *MachineDefaults) DeepCopy() *MachineDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]MaintenanceWindowDay, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedIAMInstanceProfile) DeepCopyInto(out *ManagedIAMInstanceProfile) {
+	*out = *in
+	if in.PolicyARNs != nil {
+		in, out := &in.PolicyARNs, &out.PolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InlinePolicies != nil {
+		in, out := &in.InlinePolicies, &out.InlinePolicies
+		*out = make([]InlinePolicy, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedIAMInstanceProfile.
+func (in *ManagedIAMInstanceProfile) DeepCopy() *ManagedIAMInstanceProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedIAMInstanceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatGatewaySpec) DeepCopyInto(out *NatGatewaySpec) {
+	*out = *in
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewaySpec.
+func (in *NatGatewaySpec) DeepCopy() *NatGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NatGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
+	*out = *in
+	in.VPC.DeepCopyInto(&out.VPC)
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make(Subnets, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CNI != nil {
+		in, out := &in.CNI, &out.CNI
+		*out = new(CNISpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroupOverrides != nil {
+		in, out := &in.SecurityGroupOverrides, &out.SecurityGroupOverrides
+		*out = make(map[SecurityGroupRole]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecurityGroupEgress != nil {
+		in, out := &in.SecurityGroupEgress, &out.SecurityGroupEgress
+		*out = make(map[SecurityGroupRole]SecurityGroupEgressSpec, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.AdditionalControlPlaneIngressRules != nil {
+		in, out := &in.AdditionalControlPlaneIngressRules, &out.AdditionalControlPlaneIngressRules
+		*out = make([]IngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalNodeIngressRules != nil {
+		in, out := &in.AdditionalNodeIngressRules, &out.AdditionalNodeIngressRules
+		*out = make([]IngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodePortIngressRuleCidrBlocks != nil {
+		in, out := &in.NodePortIngressRuleCidrBlocks, &out.NodePortIngressRuleCidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalPublicRoutes != nil {
+		in, out := &in.AdditionalPublicRoutes, &out.AdditionalPublicRoutes
+		*out = make([]Route, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalPrivateRoutes != nil {
+		in, out := &in.AdditionalPrivateRoutes, &out.AdditionalPrivateRoutes
+		*out = make([]Route, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
+func (in *NetworkSpec) DeepCopy() *NetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+	*out = *in
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make(map[SecurityGroupRole]SecurityGroup, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	in.APIServerELB.DeepCopyInto(&out.APIServerELB)
+	in.SecondaryAPIServerELB.DeepCopyInto(&out.SecondaryAPIServerELB)
+	if in.NatGatewaysIPs != nil {
+		in, out := &in.NatGatewaysIPs, &out.NatGatewaysIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCIPAMPoolAllocation != nil {
+		in, out := &in.VPCIPAMPoolAllocation, &out.VPCIPAMPoolAllocation
+		*out = new(IPAMPoolAllocation)
+		**out = **in
+	}
+	if in.AdditionalPublicRouteDestinations != nil {
+		in, out := &in.AdditionalPublicRouteDestinations, &out.AdditionalPublicRouteDestinations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalPrivateRouteDestinations != nil {
+		in, out := &in.AdditionalPrivateRouteDestinations, &out.AdditionalPrivateRouteDestinations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
+func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeInfo) DeepCopyInto(out *NodeInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeInfo.
+func (in *NodeInfo) DeepCopy() *NodeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Observability) DeepCopyInto(out *Observability) {
+	*out = *in
+	if in.Alarms != nil {
+		in, out := &in.Alarms, &out.Alarms
+		*out = new(CloudWatchAlarms)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Observability.
+func (in *Observability) DeepCopy() *Observability {
+	if in == nil {
+		return nil
 	}
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make([]v1beta1.MachineAddress, len(*in))
+	out := new(Observability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PatchManagement) DeepCopyInto(out *PatchManagement) {
+	*out = *in
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]SSMAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchManagement.
+func (in *PatchManagement) DeepCopy() *PatchManagement {
+	if in == nil {
+		return nil
+	}
+	out := new(PatchManagement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PatchManagementStatus) DeepCopyInto(out *PatchManagementStatus) {
+	*out = *in
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]SSMAssociationStatus, len(*in))
 		copy(*out, *in)
 	}
-	if in.PrivateIP != nil {
-		in, out := &in.PrivateIP, &out.PrivateIP
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchManagementStatus.
+func (in *PatchManagementStatus) DeepCopy() *PatchManagementStatus {
+	if in == nil {
+		return nil
 	}
-	if in.PublicIP != nil {
-		in, out := &in.PublicIP, &out.PublicIP
-		*out = new(string)
+	out := new(PatchManagementStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateDNS) DeepCopyInto(out *PrivateDNS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNS.
+func (in *PrivateDNS) DeepCopy() *PrivateDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateDNSName) DeepCopyInto(out *PrivateDNSName) {
+	*out = *in
+	if in.EnableResourceNameDNSAAAARecord != nil {
+		in, out := &in.EnableResourceNameDNSAAAARecord, &out.EnableResourceNameDNSAAAARecord
+		*out = new(bool)
 		**out = **in
 	}
-	if in.ENASupport != nil {
-		in, out := &in.ENASupport, &out.ENASupport
+	if in.EnableResourceNameDNSARecord != nil {
+		in, out := &in.EnableResourceNameDNSARecord, &out.EnableResourceNameDNSARecord
 		*out = new(bool)
 		**out = **in
 	}
-	if in.EBSOptimized != nil {
-		in, out := &in.EBSOptimized, &out.EBSOptimized
+	if in.HostnameType != nil {
+		in, out := &in.HostnameType, &out.HostnameType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSName.
+func (in *PrivateDNSName) DeepCopy() *PrivateDNSName {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateDNSName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaUsage) DeepCopyInto(out *QuotaUsage) {
+	*out = *in
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaUsage.
+func (in *QuotaUsage) DeepCopy() *QuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReachabilityAnalysis) DeepCopyInto(out *ReachabilityAnalysis) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.NetworkPathFound != nil {
+		in, out := &in.NetworkPathFound, &out.NetworkPathFound
 		*out = new(bool)
 		**out = **in
 	}
-	if in.RootVolume != nil {
-		in, out := &in.RootVolume, &out.RootVolume
-		*out = new(Volume)
+	if in.Explanations != nil {
+		in, out := &in.Explanations, &out.Explanations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReachabilityAnalysis.
+func (in *ReachabilityAnalysis) DeepCopy() *ReachabilityAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ReachabilityAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceLimits) DeepCopyInto(out *ResourceLimits) {
+	*out = *in
+	if in.ElasticIPs != nil {
+		in, out := &in.ElasticIPs, &out.ElasticIPs
+		*out = new(QuotaUsage)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.NonRootVolumes != nil {
-		in, out := &in.NonRootVolumes, &out.NonRootVolumes
-		*out = make([]Volume, len(*in))
+	if in.VCPUs != nil {
+		in, out := &in.VCPUs, &out.VCPUs
+		*out = make([]QuotaUsage, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.NetworkInterfaces != nil {
-		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
-		*out = make([]string, len(*in))
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]InstanceTypeNetworkLimits, len(*in))
 		copy(*out, *in)
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
 	}
-	if in.SpotMarketOptions != nil {
-		in, out := &in.SpotMarketOptions, &out.SpotMarketOptions
-		*out = new(SpotMarketOptions)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceLimits.
+func (in *ResourceLimits) DeepCopy() *ResourceLimits {
+	if in == nil {
+		return nil
 	}
-	if in.VolumeIDs != nil {
-		in, out := &in.VolumeIDs, &out.VolumeIDs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out := new(ResourceLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRange) DeepCopyInto(out *ResourceRange) {
+	*out = *in
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(int32)
+		**out = **in
 	}
-	if in.InstanceMetadataOptions != nil {
-		in, out := &in.InstanceMetadataOptions, &out.InstanceMetadataOptions
-		*out = new(InstanceMetadataOptions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRange.
+func (in *ResourceRange) DeepCopy() *ResourceRange {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RootVolumeEncryption) DeepCopyInto(out *RootVolumeEncryption) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RootVolumeEncryption.
+func (in *RootVolumeEncryption) DeepCopy() *RootVolumeEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(RootVolumeEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
 		**out = **in
 	}
-	if in.PrivateDNSName != nil {
-		in, out := &in.PrivateDNSName, &out.PrivateDNSName
-		*out = new(PrivateDNSName)
-		(*in).DeepCopyInto(*out)
+	if in.VPCPeeringConnectionID != nil {
+		in, out := &in.VPCPeeringConnectionID, &out.VPCPeeringConnectionID
+		*out = new(string)
+		**out = **in
 	}
-	if in.PublicIPOnLaunch != nil {
-		in, out := &in.PublicIPOnLaunch, &out.PublicIPOnLaunch
-		*out = new(bool)
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(string)
 		**out = **in
 	}
-	if in.CapacityReservationID != nil {
-		in, out := &in.CapacityReservationID, &out.CapacityReservationID
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
-func (in *Instance) DeepCopy() *Instance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTable) DeepCopyInto(out *RouteTable) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
+func (in *RouteTable) DeepCopy() *RouteTable {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3Bucket) DeepCopyInto(out *S3Bucket) {
+	*out = *in
+	if in.NodesIAMInstanceProfiles != nil {
+		in, out := &in.NodesIAMInstanceProfiles, &out.NodesIAMInstanceProfiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PresignedURLDuration != nil {
+		in, out := &in.PresignedURLDuration, &out.PresignedURLDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BestEffortDeleteObjects != nil {
+		in, out := &in.BestEffortDeleteObjects, &out.BestEffortDeleteObjects
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Bucket.
+func (in *S3Bucket) DeepCopy() *S3Bucket {
+	if in == nil {
+		return nil
+	}
+	out := new(S3Bucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSMAssociation) DeepCopyInto(out *SSMAssociation) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSMAssociation.
+func (in *SSMAssociation) DeepCopy() *SSMAssociation {
 	if in == nil {
 		return nil
 	}
-	out := new(Instance)
+	out := new(SSMAssociation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceMetadataOptions) DeepCopyInto(out *InstanceMetadataOptions) {
+func (in *SSMAssociationStatus) DeepCopyInto(out *SSMAssociationStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMetadataOptions.
-func (in *InstanceMetadataOptions) DeepCopy() *InstanceMetadataOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSMAssociationStatus.
+func (in *SSMAssociationStatus) DeepCopy() *SSMAssociationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceMetadataOptions)
+	out := new(SSMAssociationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Listener) DeepCopyInto(out *Listener) {
+func (in *ScheduledEvent) DeepCopyInto(out *ScheduledEvent) {
 	*out = *in
-	in.TargetGroup.DeepCopyInto(&out.TargetGroup)
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Listener.
-func (in *Listener) DeepCopy() *Listener {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledEvent.
+func (in *ScheduledEvent) DeepCopy() *ScheduledEvent {
 	if in == nil {
 		return nil
 	}
-	out := new(Listener)
+	out := new(ScheduledEvent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
+func (in *ScheduledEventRemediation) DeepCopyInto(out *ScheduledEventRemediation) {
 	*out = *in
-	if in.AvailabilityZones != nil {
-		in, out := &in.AvailabilityZones, &out.AvailabilityZones
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SubnetIDs != nil {
-		in, out := &in.SubnetIDs, &out.SubnetIDs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SecurityGroupIDs != nil {
-		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ClassicELBListeners != nil {
-		in, out := &in.ClassicELBListeners, &out.ClassicELBListeners
-		*out = make([]ClassicELBListener, len(*in))
-		copy(*out, *in)
-	}
-	if in.HealthCheck != nil {
-		in, out := &in.HealthCheck, &out.HealthCheck
-		*out = new(ClassicELBHealthCheck)
+	if in.LeadTime != nil {
+		in, out := &in.LeadTime, &out.LeadTime
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	out.ClassicElbAttributes = in.ClassicElbAttributes
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.ELBListeners != nil {
-		in, out := &in.ELBListeners, &out.ELBListeners
-		*out = make([]Listener, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ELBAttributes != nil {
-		in, out := &in.ELBAttributes, &out.ELBAttributes
-		*out = make(map[string]*string, len(*in))
-		for key, val := range *in {
-			var outVal *string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = new(string)
-				**out = **in
-			}
-			(*out)[key] = outVal
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
-func (in *LoadBalancer) DeepCopy() *LoadBalancer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledEventRemediation.
+func (in *ScheduledEventRemediation) DeepCopy() *ScheduledEventRemediation {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancer)
+	out := new(ScheduledEventRemediation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
+func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
-	in.VPC.DeepCopyInto(&out.VPC)
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = make(Subnets, len(*in))
+	if in.IngressRules != nil {
+		in, out := &in.IngressRules, &out.IngressRules
+		*out = make(IngressRules, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.CNI != nil {
-		in, out := &in.CNI, &out.CNI
-		*out = new(CNISpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SecurityGroupOverrides != nil {
-		in, out := &in.SecurityGroupOverrides, &out.SecurityGroupOverrides
-		*out = make(map[SecurityGroupRole]string, len(*in))
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(Tags, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.AdditionalControlPlaneIngressRules != nil {
-		in, out := &in.AdditionalControlPlaneIngressRules, &out.AdditionalControlPlaneIngressRules
-		*out = make([]IngressRule, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.AdditionalNodeIngressRules != nil {
-		in, out := &in.AdditionalNodeIngressRules, &out.AdditionalNodeIngressRules
-		*out = make([]IngressRule, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.NodePortIngressRuleCidrBlocks != nil {
-		in, out := &in.NodePortIngressRuleCidrBlocks, &out.NodePortIngressRuleCidrBlocks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
-func (in *NetworkSpec) DeepCopy() *NetworkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroup.
+func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkSpec)
+	out := new(SecurityGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+func (in *SecurityGroupEgressSpec) DeepCopyInto(out *SecurityGroupEgressSpec) {
 	*out = *in
-	if in.SecurityGroups != nil {
-		in, out := &in.SecurityGroups, &out.SecurityGroups
-		*out = make(map[SecurityGroupRole]SecurityGroup, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
+	if in.EgressRules != nil {
+		in, out := &in.EgressRules, &out.EgressRules
+		*out = make([]EgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	in.APIServerELB.DeepCopyInto(&out.APIServerELB)
-	in.SecondaryAPIServerELB.DeepCopyInto(&out.SecondaryAPIServerELB)
-	if in.NatGatewaysIPs != nil {
-		in, out := &in.NatGatewaysIPs, &out.NatGatewaysIPs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
-func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupEgressSpec.
+func (in *SecurityGroupEgressSpec) DeepCopy() *SecurityGroupEgressSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkStatus)
+	out := new(SecurityGroupEgressSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrivateDNSName) DeepCopyInto(out *PrivateDNSName) {
+func (in *SessionManager) DeepCopyInto(out *SessionManager) {
 	*out = *in
-	if in.EnableResourceNameDNSAAAARecord != nil {
-		in, out := &in.EnableResourceNameDNSAAAARecord, &out.EnableResourceNameDNSAAAARecord
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EnableResourceNameDNSARecord != nil {
-		in, out := &in.EnableResourceNameDNSARecord, &out.EnableResourceNameDNSARecord
-		*out = new(bool)
+	if in.S3Logs != nil {
+		in, out := &in.S3Logs, &out.S3Logs
+		*out = new(SessionManagerS3Logs)
 		**out = **in
 	}
-	if in.HostnameType != nil {
-		in, out := &in.HostnameType, &out.HostnameType
-		*out = new(string)
+	if in.CloudWatchLogs != nil {
+		in, out := &in.CloudWatchLogs, &out.CloudWatchLogs
+		*out = new(SessionManagerCloudWatchLogs)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSName.
-func (in *PrivateDNSName) DeepCopy() *PrivateDNSName {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionManager.
+func (in *SessionManager) DeepCopy() *SessionManager {
 	if in == nil {
 		return nil
 	}
-	out := new(PrivateDNSName)
+	out := new(SessionManager)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTable) DeepCopyInto(out *RouteTable) {
+func (in *SessionManagerCloudWatchLogs) DeepCopyInto(out *SessionManagerCloudWatchLogs) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
-func (in *RouteTable) DeepCopy() *RouteTable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionManagerCloudWatchLogs.
+func (in *SessionManagerCloudWatchLogs) DeepCopy() *SessionManagerCloudWatchLogs {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTable)
+	out := new(SessionManagerCloudWatchLogs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *S3Bucket) DeepCopyInto(out *S3Bucket) {
+func (in *SessionManagerS3Logs) DeepCopyInto(out *SessionManagerS3Logs) {
 	*out = *in
-	if in.NodesIAMInstanceProfiles != nil {
-		in, out := &in.NodesIAMInstanceProfiles, &out.NodesIAMInstanceProfiles
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionManagerS3Logs.
+func (in *SessionManagerS3Logs) DeepCopy() *SessionManagerS3Logs {
+	if in == nil {
+		return nil
 	}
-	if in.PresignedURLDuration != nil {
-		in, out := &in.PresignedURLDuration, &out.PresignedURLDuration
-		*out = new(v1.Duration)
+	out := new(SessionManagerS3Logs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotFallback) DeepCopyInto(out *SpotFallback) {
+	*out = *in
+	if in.MaxInterruptions != nil {
+		in, out := &in.MaxInterruptions, &out.MaxInterruptions
+		*out = new(int32)
 		**out = **in
 	}
-	if in.BestEffortDeleteObjects != nil {
-		in, out := &in.BestEffortDeleteObjects, &out.BestEffortDeleteObjects
-		*out = new(bool)
-		**out = **in
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Bucket.
-func (in *S3Bucket) DeepCopy() *S3Bucket {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotFallback.
+func (in *SpotFallback) DeepCopy() *SpotFallback {
 	if in == nil {
 		return nil
 	}
-	out := new(S3Bucket)
+	out := new(SpotFallback)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
+func (in *SpotFallbackStatus) DeepCopyInto(out *SpotFallbackStatus) {
 	*out = *in
-	if in.IngressRules != nil {
-		in, out := &in.IngressRules, &out.IngressRules
-		*out = make(IngressRules, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(Tags, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroup.
-func (in *SecurityGroup) DeepCopy() *SecurityGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotFallbackStatus.
+func (in *SpotFallbackStatus) DeepCopy() *SpotFallbackStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroup)
+	out := new(SpotFallbackStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1931,6 +3373,28 @@ func (in *SpotMarketOptions) DeepCopy() *SpotMarketOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetPlan) DeepCopyInto(out *SubnetPlan) {
+	*out = *in
+	out.Public = in.Public
+	out.Private = in.Private
+	if in.Pod != nil {
+		in, out := &in.Pod, &out.Pod
+		*out = new(SubnetTierPlan)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetPlan.
+func (in *SubnetPlan) DeepCopy() *SubnetPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 	*out = *in
@@ -1961,6 +3425,11 @@ func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OutpostArn != nil {
+		in, out := &in.OutpostArn, &out.OutpostArn
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
@@ -1973,6 +3442,21 @@ func (in *SubnetSpec) DeepCopy() *SubnetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetTierPlan) DeepCopyInto(out *SubnetTierPlan) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetTierPlan.
+func (in *SubnetTierPlan) DeepCopy() *SubnetTierPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetTierPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Subnets) DeepCopyInto(out *Subnets) {
 	{
@@ -2170,6 +3654,22 @@ func (in *TargetGroupSpec) DeepCopy() *TargetGroupSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimelineEvent) DeepCopyInto(out *TimelineEvent) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimelineEvent.
+func (in *TimelineEvent) DeepCopy() *TimelineEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(TimelineEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 	*out = *in
@@ -2215,6 +3715,21 @@ func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 		*out = new(AZSelectionScheme)
 		**out = **in
 	}
+	if in.EnableDNSSupport != nil {
+		in, out := &in.EnableDNSSupport, &out.EnableDNSSupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableDNSHostnames != nil {
+		in, out := &in.EnableDNSHostnames, &out.EnableDNSHostnames
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableNetworkAddressUsageMetrics != nil {
+		in, out := &in.EnableNetworkAddressUsageMetrics, &out.EnableNetworkAddressUsageMetrics
+		*out = new(bool)
+		**out = **in
+	}
 	if in.PrivateDNSHostnameTypeOnLaunch != nil {
 		in, out := &in.PrivateDNSHostnameTypeOnLaunch, &out.PrivateDNSHostnameTypeOnLaunch
 		*out = new(string)
@@ -2230,6 +3745,16 @@ func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 		*out = new(SubnetSchemaType)
 		**out = **in
 	}
+	if in.NatGateway != nil {
+		in, out := &in.NatGateway, &out.NatGateway
+		*out = new(NatGatewaySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetPlan != nil {
+		in, out := &in.SubnetPlan, &out.SubnetPlan
+		*out = new(SubnetPlan)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCSpec.
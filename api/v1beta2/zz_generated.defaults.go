@@ -29,6 +29,7 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&AWSBastionHost{}, func(obj interface{}) { SetObjectDefaults_AWSBastionHost(obj.(*AWSBastionHost)) })
 	scheme.AddTypeDefaultingFunc(&AWSCluster{}, func(obj interface{}) { SetObjectDefaults_AWSCluster(obj.(*AWSCluster)) })
 	scheme.AddTypeDefaultingFunc(&AWSClusterTemplate{}, func(obj interface{}) { SetObjectDefaults_AWSClusterTemplate(obj.(*AWSClusterTemplate)) })
 	scheme.AddTypeDefaultingFunc(&AWSMachine{}, func(obj interface{}) { SetObjectDefaults_AWSMachine(obj.(*AWSMachine)) })
@@ -36,6 +37,10 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	return nil
 }
 
+func SetObjectDefaults_AWSBastionHost(in *AWSBastionHost) {
+	SetDefaults_AWSBastionHostSpec(&in.Spec)
+}
+
 func SetObjectDefaults_AWSCluster(in *AWSCluster) {
 	SetDefaults_AWSClusterSpec(&in.Spec)
 	SetDefaults_NetworkSpec(&in.Spec.NetworkSpec)
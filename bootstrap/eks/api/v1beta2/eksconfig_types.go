@@ -23,7 +23,14 @@ import (
 )
 
 // EKSConfigSpec defines the desired state of Amazon EKS Bootstrap Configuration.
+// +kubebuilder:validation:XValidation:rule="!has(self.bottlerocket) || (has(self.format) && self.format == 'bottlerocket')",message="bottlerocket can only be set with the bottlerocket format"
 type EKSConfigSpec struct {
+	// Format specifies the output format of the bootstrap data. Defaults to cloud-config, run by cloud-init
+	// on the Amazon Linux and Ubuntu EKS-optimized AMIs. The bottlerocket format generates the settings of the
+	// Bottlerocket EKS-optimized AMIs and only supports the KubeletExtraArgs, DNSClusterIP, PauseContainer and
+	// Bottlerocket fields.
+	// +optional
+	Format Format `json:"format,omitempty"`
 	// KubeletExtraArgs passes the specified kubelet args into the Amazon EKS machine bootstrap script
 	// +optional
 	KubeletExtraArgs map[string]string `json:"kubeletExtraArgs,omitempty"`
@@ -74,6 +81,45 @@ type EKSConfigSpec struct {
 	// NTP specifies NTP configuration
 	// +optional
 	NTP *NTP `json:"ntp,omitempty"`
+	// Bottlerocket specifies the settings of Bottlerocket nodes, used with the bottlerocket format.
+	// +optional
+	Bottlerocket *BottlerocketSettings `json:"bottlerocket,omitempty"`
+}
+
+// Format specifies the output format of the bootstrap data.
+// +kubebuilder:validation:Enum=cloud-config;bottlerocket
+type Format string
+
+const (
+	// CloudConfig make the bootstrap data to be of cloud-config format.
+	CloudConfig Format = "cloud-config"
+	// Bottlerocket make the bootstrap data to be the TOML settings of Bottlerocket.
+	Bottlerocket Format = "bottlerocket"
+)
+
+// BottlerocketSettings defines the settings of Bottlerocket nodes.
+type BottlerocketSettings struct {
+	// AdminContainer configures the admin host container, used to troubleshoot the nodes over SSH.
+	// It is disabled unless configured.
+	// +optional
+	AdminContainer *BottlerocketHostContainer `json:"adminContainer,omitempty"`
+	// ControlContainer configures the control host container, used to reach the nodes with AWS Systems Manager.
+	// It is enabled unless configured.
+	// +optional
+	ControlContainer *BottlerocketHostContainer `json:"controlContainer,omitempty"`
+}
+
+// BottlerocketHostContainer defines a host container of Bottlerocket nodes.
+type BottlerocketHostContainer struct {
+	// Enabled specifies whether the host container runs.
+	Enabled bool `json:"enabled"`
+	// Source is the image of the host container. Defaults to the image of the Bottlerocket release.
+	// +optional
+	Source string `json:"source,omitempty"`
+	// UserData is the base64 encoded user data of the host container, e.g. the SSH public keys of the
+	// admin container.
+	// +optional
+	UserData string `json:"userData,omitempty"`
 }
 
 // PauseContainer contains details of pause container.
@@ -20,6 +20,10 @@ package controllers
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -28,6 +32,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -49,6 +54,7 @@ import (
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/secret"
 )
 
 // EKSConfigReconciler reconciles a EKSConfig object.
@@ -257,6 +263,19 @@ func (r *EKSConfigReconciler) joinWorker(ctx context.Context, cluster *clusterv1
 		nodeInput.PauseContainerVersion = &config.Spec.PauseContainer.Version
 	}
 
+	// With prefix delegation, the nodes run more pods than their network interfaces have IP addresses, unless the
+	// configuration sets their maximum number of pods itself.
+	if podNetworking := controlPlane.Spec.VpcCni.PodNetworking; podNetworking != nil && podNetworking.PrefixDelegation != nil &&
+		podNetworking.PrefixDelegation.MaxPods > 0 && config.Spec.UseMaxPods == nil && config.Spec.KubeletExtraArgs["max-pods"] == "" {
+		kubeletExtraArgs := make(map[string]string, len(config.Spec.KubeletExtraArgs)+1)
+		for k, v := range config.Spec.KubeletExtraArgs {
+			kubeletExtraArgs[k] = v
+		}
+		kubeletExtraArgs["max-pods"] = strconv.Itoa(int(podNetworking.PrefixDelegation.MaxPods))
+		nodeInput.KubeletExtraArgs = kubeletExtraArgs
+		nodeInput.UseMaxPods = ptr.To[bool](false)
+	}
+
 	// Check if IPv6 was provided to the user configuration first
 	// If not, we also check if the cluster is ipv6 based.
 	if config.Spec.ServiceIPV6Cidr != nil && *config.Spec.ServiceIPV6Cidr != "" {
@@ -272,7 +291,12 @@ func (r *EKSConfigReconciler) joinWorker(ctx context.Context, cluster *clusterv1
 	}
 
 	// generate userdata
-	userDataScript, err := userdata.NewNode(nodeInput)
+	var userDataScript []byte
+	if config.Spec.Format == eksbootstrapv1.Bottlerocket {
+		userDataScript, err = r.bottlerocketUserData(ctx, cluster, config, controlPlane, nodeInput.KubeletExtraArgs)
+	} else {
+		userDataScript, err = userdata.NewNode(nodeInput)
+	}
 	if err != nil {
 		log.Error(err, "Failed to create a worker join configuration")
 		conditions.MarkFalse(config, eksbootstrapv1.DataSecretAvailableCondition, eksbootstrapv1.DataSecretGenerationFailedReason, clusterv1.ConditionSeverityWarning, "")
@@ -289,6 +313,76 @@ func (r *EKSConfigReconciler) joinWorker(ctx context.Context, cluster *clusterv1
 	return nil
 }
 
+// bottlerocketUserData returns the settings of a Bottlerocket node joining the EKS cluster. Bottlerocket nodes do not
+// look up the cluster themselves, so its endpoint and certificate authority are read from the cluster kubeconfig.
+func (r *EKSConfigReconciler) bottlerocketUserData(ctx context.Context, cluster *clusterv1.Cluster, config *eksbootstrapv1.EKSConfig, controlPlane *ekscontrolplanev1.AWSManagedControlPlane, kubeletExtraArgs map[string]string) ([]byte, error) {
+	if fields := unsupportedBottlerocketFields(&config.Spec); len(fields) > 0 {
+		return nil, errors.Errorf("the bottlerocket format does not support %s", strings.Join(fields, ", "))
+	}
+
+	kubeconfigSecret, err := secret.GetFromNamespacedName(ctx, r.Client, util.ObjectKey(cluster), secret.Kubeconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get kubeconfig secret")
+	}
+	kubeconfig, err := clientcmd.Load(kubeconfigSecret.Data[secret.KubeconfigDataName])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse kubeconfig")
+	}
+	kubeconfigContext, ok := kubeconfig.Contexts[kubeconfig.CurrentContext]
+	if !ok {
+		return nil, errors.Errorf("kubeconfig has no context %q", kubeconfig.CurrentContext)
+	}
+	kubeconfigCluster, ok := kubeconfig.Clusters[kubeconfigContext.Cluster]
+	if !ok {
+		return nil, errors.Errorf("kubeconfig has no cluster %q", kubeconfigContext.Cluster)
+	}
+
+	input := &userdata.BottlerocketInput{
+		// AWSManagedControlPlane webhooks default and validate EKSClusterName
+		ClusterName:       controlPlane.Spec.EKSClusterName,
+		APIServerEndpoint: kubeconfigCluster.Server,
+		B64CACert:         base64.StdEncoding.EncodeToString(kubeconfigCluster.CertificateAuthorityData),
+		Region:            controlPlane.Spec.Region,
+		KubeletExtraArgs:  kubeletExtraArgs,
+		DNSClusterIP:      config.Spec.DNSClusterIP,
+	}
+	if config.Spec.PauseContainer != nil {
+		input.PauseContainerAccount = &config.Spec.PauseContainer.AccountNumber
+		input.PauseContainerVersion = &config.Spec.PauseContainer.Version
+	}
+	if config.Spec.Bottlerocket != nil {
+		input.AdminContainer = config.Spec.Bottlerocket.AdminContainer
+		input.ControlContainer = config.Spec.Bottlerocket.ControlContainer
+	}
+	return userdata.NewBottlerocketNode(input)
+}
+
+// unsupportedBottlerocketFields returns the fields of the EKSConfig which have no equivalent Bottlerocket setting.
+func unsupportedBottlerocketFields(spec *eksbootstrapv1.EKSConfigSpec) []string {
+	var fields []string
+	for field, set := range map[string]bool{
+		"containerRuntime":        spec.ContainerRuntime != nil,
+		"dockerConfigJson":        spec.DockerConfigJSON != nil,
+		"apiRetryAttempts":        spec.APIRetryAttempts != nil,
+		"useMaxPods":              spec.UseMaxPods != nil,
+		"serviceIPV6Cidr":         spec.ServiceIPV6Cidr != nil,
+		"preBootstrapCommands":    len(spec.PreBootstrapCommands) > 0,
+		"postBootstrapCommands":   len(spec.PostBootstrapCommands) > 0,
+		"boostrapCommandOverride": spec.BootstrapCommandOverride != nil,
+		"files":                   len(spec.Files) > 0,
+		"diskSetup":               spec.DiskSetup != nil,
+		"mounts":                  len(spec.Mounts) > 0,
+		"users":                   len(spec.Users) > 0,
+		"ntp":                     spec.NTP != nil,
+	} {
+		if set {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
 func (r *EKSConfigReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, option controller.Options) error {
 	b := ctrl.NewControllerManagedBy(mgr).
 		For(&eksbootstrapv1.EKSConfig{}).
@@ -344,7 +438,7 @@ func (r *EKSConfigReconciler) storeBootstrapData(ctx context.Context, cluster *c
 			return errors.Wrap(err, "failed to get data secret for EKSConfig")
 		}
 	} else {
-		updated, err := r.updateBootstrapSecret(ctx, secret, data)
+		updated, err := r.updateBootstrapSecret(ctx, secret, data, config.Spec.Format)
 		if err != nil {
 			return errors.Wrap(err, "failed to update data secret for EKSConfig")
 		}
@@ -452,16 +546,24 @@ func (r *EKSConfigReconciler) createBootstrapSecret(ctx context.Context, cluster
 		},
 		Type: clusterv1.ClusterSecretType,
 	}
+	if config.Spec.Format != "" {
+		secret.Data["format"] = []byte(config.Spec.Format)
+	}
 	return secret, r.Client.Create(ctx, secret)
 }
 
 // Update the userdata in the bootstrap Secret.
-func (r *EKSConfigReconciler) updateBootstrapSecret(ctx context.Context, secret *corev1.Secret, data []byte) (bool, error) {
+func (r *EKSConfigReconciler) updateBootstrapSecret(ctx context.Context, secret *corev1.Secret, data []byte, format eksbootstrapv1.Format) (bool, error) {
 	if secret.Data == nil {
 		secret.Data = make(map[string][]byte)
 	}
-	if !bytes.Equal(secret.Data["value"], data) {
+	if !bytes.Equal(secret.Data["value"], data) || string(secret.Data["format"]) != string(format) {
 		secret.Data["value"] = data
+		if format != "" {
+			secret.Data["format"] = []byte(format)
+		} else {
+			delete(secret.Data, "format")
+		}
 		return true, r.Client.Update(ctx, secret)
 	}
 	return false, nil
@@ -24,6 +24,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
@@ -34,6 +37,7 @@ import (
 	"sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/secret"
 )
 
 func TestEKSConfigReconciler(t *testing.T) {
@@ -199,6 +203,130 @@ func TestEKSConfigReconciler(t *testing.T) {
 			gomega.Expect(string(secret.Data["value"])).To(Not(Equal(string(expectedUserData))))
 		}).Should(Succeed())
 	})
+	t.Run("Should reconcile an EKSConfig and set the max pods of the nodes with prefix delegation", func(t *testing.T) {
+		g := NewWithT(t)
+		amcp := newAMCP("test-cluster")
+		amcp.Spec.VpcCni.PodNetworking = &ekscontrolplanev1.VpcCniPodNetworking{
+			PrefixDelegation: &ekscontrolplanev1.VpcCniPrefixDelegation{WarmPrefixTarget: 1, MaxPods: 110},
+		}
+		cluster := newCluster(amcp.Name)
+		machine := newMachine(cluster, "test-machine")
+		config := newEKSConfig(machine)
+		t.Log(dump("amcp", amcp))
+		t.Log(dump("config", config))
+		g.Expect(testEnv.Client.Create(ctx, amcp)).To(Succeed())
+
+		expectedUserData, err := userdata.NewNode(&userdata.NodeInput{
+			ClusterName: amcp.Name,
+			KubeletExtraArgs: map[string]string{
+				"test-arg": "test-value",
+				"max-pods": "110",
+			},
+			UseMaxPods: ptr.To[bool](false),
+		})
+		g.Expect(err).To(BeNil())
+		reconciler := EKSConfigReconciler{
+			Client: testEnv.Client,
+		}
+		g.Eventually(func(gomega Gomega) {
+			err := reconciler.joinWorker(ctx, cluster, config, configOwner("Machine"))
+			gomega.Expect(err).NotTo(HaveOccurred())
+		}).Should(Succeed())
+
+		secret := &corev1.Secret{}
+		g.Eventually(func(gomega Gomega) {
+			gomega.Expect(testEnv.Client.Get(ctx, client.ObjectKey{
+				Name:      config.Name,
+				Namespace: "default",
+			}, secret)).To(Succeed())
+		}).Should(Succeed())
+		g.Expect(string(secret.Data["value"])).To(Equal(string(expectedUserData)))
+		g.Expect(config.Spec.KubeletExtraArgs).NotTo(HaveKey("max-pods"))
+	})
+
+	t.Run("Should reconcile an EKSConfig with the bottlerocket format", func(t *testing.T) {
+		g := NewWithT(t)
+		amcp := newAMCP("test-cluster")
+		amcp.Spec.Region = "eu-west-1"
+		cluster := newCluster(amcp.Name)
+		machine := newMachine(cluster, "test-machine")
+		config := newEKSConfig(machine)
+		config.Spec.Format = eksbootstrapv1.Bottlerocket
+		config.Spec.KubeletExtraArgs = map[string]string{"node-labels": "role=worker"}
+		config.Spec.Bottlerocket = &eksbootstrapv1.BottlerocketSettings{
+			AdminContainer: &eksbootstrapv1.BottlerocketHostContainer{Enabled: true},
+		}
+		kubeconfig, err := clientcmd.Write(clientcmdapi.Config{
+			Clusters: map[string]*clientcmdapi.Cluster{
+				amcp.Name: {Server: "https://test.eks.amazonaws.com", CertificateAuthorityData: []byte("CA")},
+			},
+			Contexts: map[string]*clientcmdapi.Context{
+				amcp.Name: {Cluster: amcp.Name},
+			},
+			CurrentContext: amcp.Name,
+		})
+		g.Expect(err).To(BeNil())
+		kubeconfigSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      secret.Name(cluster.Name, secret.Kubeconfig),
+				Namespace: "default",
+			},
+			Data: map[string][]byte{secret.KubeconfigDataName: kubeconfig},
+		}
+		t.Log(dump("amcp", amcp))
+		t.Log(dump("config", config))
+		g.Expect(testEnv.Client.Create(ctx, amcp)).To(Succeed())
+		g.Expect(testEnv.Client.Create(ctx, kubeconfigSecret)).To(Succeed())
+
+		expectedUserData, err := userdata.NewBottlerocketNode(&userdata.BottlerocketInput{
+			ClusterName:       amcp.Name,
+			APIServerEndpoint: "https://test.eks.amazonaws.com",
+			B64CACert:         "Q0E=",
+			Region:            "eu-west-1",
+			KubeletExtraArgs:  map[string]string{"node-labels": "role=worker"},
+			AdminContainer:    &eksbootstrapv1.BottlerocketHostContainer{Enabled: true},
+		})
+		g.Expect(err).To(BeNil())
+		reconciler := EKSConfigReconciler{
+			Client: testEnv.Client,
+		}
+		g.Eventually(func(gomega Gomega) {
+			err := reconciler.joinWorker(ctx, cluster, config, configOwner("Machine"))
+			gomega.Expect(err).NotTo(HaveOccurred())
+		}).Should(Succeed())
+
+		dataSecret := &corev1.Secret{}
+		g.Eventually(func(gomega Gomega) {
+			gomega.Expect(testEnv.Client.Get(ctx, client.ObjectKey{
+				Name:      config.Name,
+				Namespace: "default",
+			}, dataSecret)).To(Succeed())
+		}).Should(Succeed())
+		g.Expect(string(dataSecret.Data["value"])).To(Equal(string(expectedUserData)))
+		g.Expect(string(dataSecret.Data["format"])).To(Equal("bottlerocket"))
+	})
+
+	t.Run("Should fail to reconcile an EKSConfig with the bottlerocket format and bootstrap commands", func(t *testing.T) {
+		g := NewWithT(t)
+		amcp := newAMCP("test-cluster")
+		cluster := newCluster(amcp.Name)
+		machine := newMachine(cluster, "test-machine")
+		config := newEKSConfig(machine)
+		config.Spec.Format = eksbootstrapv1.Bottlerocket
+		config.Spec.KubeletExtraArgs = nil
+		config.Spec.PreBootstrapCommands = []string{"echo hello"}
+		g.Expect(testEnv.Client.Create(ctx, amcp)).To(Succeed())
+
+		reconciler := EKSConfigReconciler{
+			Client: testEnv.Client,
+		}
+		g.Eventually(func(gomega Gomega) {
+			err := reconciler.joinWorker(ctx, cluster, config, configOwner("Machine"))
+			gomega.Expect(err).To(MatchError(ContainSubstring("the bottlerocket format does not support preBootstrapCommands")))
+		}).Should(Succeed())
+		g.Expect(conditions.IsFalse(config, eksbootstrapv1.DataSecretAvailableCondition)).To(BeTrue())
+	})
+
 	t.Run("Should Reconcile an EKSConfig with a secret file reference", func(t *testing.T) {
 		g := NewWithT(t)
 		amcp := newAMCP("test-cluster")
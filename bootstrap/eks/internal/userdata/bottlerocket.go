@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+)
+
+const (
+	bottlerocketUserData = `[settings.kubernetes]
+cluster-name = {{ printf "%q" .ClusterName }}
+api-server = {{ printf "%q" .APIServerEndpoint }}
+cluster-certificate = {{ printf "%q" .B64CACert }}
+{{- if .DNSClusterIP }}
+cluster-dns-ip = {{ printf "%q" .DNSClusterIP }}
+{{- end }}
+{{- if .MaxPods }}
+max-pods = {{ .MaxPods }}
+{{- end }}
+{{- if .PauseContainerImage }}
+pod-infra-container-image = {{ printf "%q" .PauseContainerImage }}
+{{- end }}
+{{- if .NodeLabels }}
+
+[settings.kubernetes.node-labels]
+{{- range $key, $value := .NodeLabels }}
+{{ printf "%q" $key }} = {{ printf "%q" $value }}
+{{- end }}
+{{- end }}
+{{- if .NodeTaints }}
+
+[settings.kubernetes.node-taints]
+{{- range $key, $values := .NodeTaints }}
+{{ printf "%q" $key }} = [{{ range $i, $value := $values }}{{ if $i }}, {{ end }}{{ printf "%q" $value }}{{ end }}]
+{{- end }}
+{{- end }}
+{{- range .HostContainers }}
+
+[settings.host-containers.{{ .Name }}]
+enabled = {{ .Enabled }}
+{{- if .Source }}
+source = {{ printf "%q" .Source }}
+{{- end }}
+{{- if .UserData }}
+user-data = {{ printf "%q" .UserData }}
+{{- end }}
+{{- end }}
+`
+)
+
+// BottlerocketInput defines the context to generate the settings of a Bottlerocket node.
+type BottlerocketInput struct {
+	ClusterName       string
+	APIServerEndpoint string
+	// B64CACert is the base64 encoded certificate authority of the API server.
+	B64CACert             string
+	Region                string
+	KubeletExtraArgs      map[string]string
+	DNSClusterIP          *string
+	PauseContainerAccount *string
+	PauseContainerVersion *string
+	AdminContainer        *eksbootstrapv1.BottlerocketHostContainer
+	ControlContainer      *eksbootstrapv1.BottlerocketHostContainer
+}
+
+type bottlerocketHostContainer struct {
+	Name string
+	eksbootstrapv1.BottlerocketHostContainer
+}
+
+type bottlerocketSettings struct {
+	ClusterName         string
+	APIServerEndpoint   string
+	B64CACert           string
+	DNSClusterIP        string
+	MaxPods             int
+	PauseContainerImage string
+	NodeLabels          map[string]string
+	NodeTaints          map[string][]string
+	HostContainers      []bottlerocketHostContainer
+}
+
+// NewBottlerocketNode returns the TOML settings to be used as the user data of a Bottlerocket node instance.
+// Bottlerocket does not run a bootstrap script, so only the kubelet arguments with an equivalent setting are
+// supported.
+func NewBottlerocketNode(input *BottlerocketInput) ([]byte, error) {
+	settings := &bottlerocketSettings{
+		ClusterName:       input.ClusterName,
+		APIServerEndpoint: input.APIServerEndpoint,
+		B64CACert:         input.B64CACert,
+	}
+	if input.DNSClusterIP != nil {
+		settings.DNSClusterIP = *input.DNSClusterIP
+	}
+	if input.PauseContainerAccount != nil && input.PauseContainerVersion != nil {
+		domain := "amazonaws.com"
+		if strings.HasPrefix(input.Region, "cn-") {
+			domain = "amazonaws.com.cn"
+		}
+		settings.PauseContainerImage = fmt.Sprintf("%s.dkr.ecr.%s.%s/eks/pause:%s", *input.PauseContainerAccount, input.Region, domain, *input.PauseContainerVersion)
+	}
+
+	for arg, value := range input.KubeletExtraArgs {
+		switch arg {
+		case "max-pods":
+			maxPods, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid max-pods kubelet argument %q: %w", value, err)
+			}
+			settings.MaxPods = maxPods
+		case "node-labels":
+			settings.NodeLabels = map[string]string{}
+			for _, label := range strings.Split(value, ",") {
+				key, labelValue, ok := strings.Cut(label, "=")
+				if !ok {
+					return nil, fmt.Errorf("invalid node label %q, expected key=value", label)
+				}
+				settings.NodeLabels[key] = labelValue
+			}
+		case "register-with-taints":
+			settings.NodeTaints = map[string][]string{}
+			for _, taint := range strings.Split(value, ",") {
+				keyAndValue, effect, ok := strings.Cut(taint, ":")
+				if !ok {
+					return nil, fmt.Errorf("invalid node taint %q, expected key=value:effect", taint)
+				}
+				key, taintValue, _ := strings.Cut(keyAndValue, "=")
+				settings.NodeTaints[key] = append(settings.NodeTaints[key], taintValue+":"+effect)
+			}
+		default:
+			return nil, fmt.Errorf("kubelet argument %q is not supported by Bottlerocket", arg)
+		}
+	}
+
+	if input.AdminContainer != nil {
+		settings.HostContainers = append(settings.HostContainers, bottlerocketHostContainer{Name: "admin", BottlerocketHostContainer: *input.AdminContainer})
+	}
+	if input.ControlContainer != nil {
+		settings.HostContainers = append(settings.HostContainers, bottlerocketHostContainer{Name: "control", BottlerocketHostContainer: *input.ControlContainer})
+	}
+
+	t, err := template.New("Bottlerocket").Parse(bottlerocketUserData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Bottlerocket template: %w", err)
+	}
+
+	var out bytes.Buffer
+	if err := t.Execute(&out, settings); err != nil {
+		return nil, fmt.Errorf("failed to generate Bottlerocket template: %w", err)
+	}
+
+	return out.Bytes(), nil
+}
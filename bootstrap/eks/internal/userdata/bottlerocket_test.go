@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+)
+
+func TestNewBottlerocketNode(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         *BottlerocketInput
+		expectedBytes string
+		expectErr     bool
+	}{
+		{
+			name: "only cluster connection",
+			input: &BottlerocketInput{
+				ClusterName:       "test-cluster",
+				APIServerEndpoint: "https://test.eks.amazonaws.com",
+				B64CACert:         "Q0E=",
+			},
+			expectedBytes: `[settings.kubernetes]
+cluster-name = "test-cluster"
+api-server = "https://test.eks.amazonaws.com"
+cluster-certificate = "Q0E="
+`,
+		},
+		{
+			name: "with kubelet arguments, pause container and host containers",
+			input: &BottlerocketInput{
+				ClusterName:       "test-cluster",
+				APIServerEndpoint: "https://test.eks.amazonaws.com",
+				B64CACert:         "Q0E=",
+				Region:            "eu-west-1",
+				KubeletExtraArgs: map[string]string{
+					"max-pods":             "110",
+					"node-labels":          "role=worker,tier=system",
+					"register-with-taints": "dedicated=system:NoSchedule,dedicated=system:NoExecute,spot:PreferNoSchedule",
+				},
+				DNSClusterIP:          ptr.To[string]("10.100.0.10"),
+				PauseContainerAccount: ptr.To[string]("602401143452"),
+				PauseContainerVersion: ptr.To[string]("3.9"),
+				AdminContainer:        &eksbootstrapv1.BottlerocketHostContainer{Enabled: true, UserData: "e30="},
+				ControlContainer:      &eksbootstrapv1.BottlerocketHostContainer{Enabled: false, Source: "example.com/control:v1"},
+			},
+			expectedBytes: `[settings.kubernetes]
+cluster-name = "test-cluster"
+api-server = "https://test.eks.amazonaws.com"
+cluster-certificate = "Q0E="
+cluster-dns-ip = "10.100.0.10"
+max-pods = 110
+pod-infra-container-image = "602401143452.dkr.ecr.eu-west-1.amazonaws.com/eks/pause:3.9"
+
+[settings.kubernetes.node-labels]
+"role" = "worker"
+"tier" = "system"
+
+[settings.kubernetes.node-taints]
+"dedicated" = ["system:NoSchedule", "system:NoExecute"]
+"spot" = [":PreferNoSchedule"]
+
+[settings.host-containers.admin]
+enabled = true
+user-data = "e30="
+
+[settings.host-containers.control]
+enabled = false
+source = "example.com/control:v1"
+`,
+		},
+		{
+			name: "pause container in a China region",
+			input: &BottlerocketInput{
+				ClusterName:           "test-cluster",
+				APIServerEndpoint:     "https://test.eks.amazonaws.com.cn",
+				B64CACert:             "Q0E=",
+				Region:                "cn-north-1",
+				PauseContainerAccount: ptr.To[string]("918309763551"),
+				PauseContainerVersion: ptr.To[string]("3.9"),
+			},
+			expectedBytes: `[settings.kubernetes]
+cluster-name = "test-cluster"
+api-server = "https://test.eks.amazonaws.com.cn"
+cluster-certificate = "Q0E="
+pod-infra-container-image = "918309763551.dkr.ecr.cn-north-1.amazonaws.com.cn/eks/pause:3.9"
+`,
+		},
+		{
+			name: "unsupported kubelet argument",
+			input: &BottlerocketInput{
+				ClusterName:      "test-cluster",
+				KubeletExtraArgs: map[string]string{"eviction-hard": "memory.available<100Mi"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid node label",
+			input: &BottlerocketInput{
+				ClusterName:      "test-cluster",
+				KubeletExtraArgs: map[string]string{"node-labels": "role"},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			bytes, err := NewBottlerocketNode(tt.input)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(string(bytes)).To(Equal(tt.expectedBytes))
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package ami
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -24,11 +25,13 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/blang/semver"
 	"github.com/pkg/errors"
 
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/credentials"
 	ec2service "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
 )
 
@@ -61,6 +64,36 @@ func getimageRegionList() []string {
 	}
 }
 
+// getEnabledRegions returns the regions enabled for the account, discovered from the region of the shared
+// configuration or from the default region.
+func getEnabledRegions() ([]string, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if aws.StringValue(sess.Config.Region) == "" {
+		sess = sess.Copy(&aws.Config{Region: aws.String(credentials.AWSDefaultRegion)})
+	}
+	return ec2service.EnabledRegions(context.TODO(), ec2.New(sess))
+}
+
+// intersectRegions returns the regions of imageRegions that are in enabledRegions, in the order of imageRegions.
+func intersectRegions(imageRegions, enabledRegions []string) []string {
+	enabled := make(map[string]struct{}, len(enabledRegions))
+	for _, region := range enabledRegions {
+		enabled[region] = struct{}{}
+	}
+	regions := []string{}
+	for _, region := range imageRegions {
+		if _, ok := enabled[region]; ok {
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
 // LatestPatchRelease returns the latest patch release matching.
 func LatestPatchRelease(searchVersion string) (string, error) {
 	searchSemVer, err := semver.Make(strings.TrimPrefix(searchVersion, tagPrefix))
@@ -51,3 +51,33 @@ func TestAllPatchVersions(t *testing.T) {
 		})
 	}
 }
+
+func TestIntersectRegions(t *testing.T) {
+	tests := []struct {
+		name           string
+		imageRegions   []string
+		enabledRegions []string
+		want           []string
+	}{
+		{
+			name:           "Should keep the published regions enabled for the account",
+			imageRegions:   []string{"eu-west-1", "us-east-1", "us-west-2"},
+			enabledRegions: []string{"af-south-1", "us-east-1", "us-west-2"},
+			want:           []string{"us-east-1", "us-west-2"},
+		},
+		{
+			name:           "Should return no regions when no published region is enabled",
+			imageRegions:   []string{"eu-west-1"},
+			enabledRegions: []string{"us-east-1"},
+			want:           []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := intersectRegions(tt.imageRegions, tt.enabledRegions)
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("intersectRegions() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -49,7 +49,11 @@ func List(input ListInput) (*amiv1.AWSAMIList, error) {
 	}
 	imageRegionList := []string{}
 	if input.Region == "" {
-		imageRegionList = getimageRegionList()
+		enabledRegions, err := getEnabledRegions()
+		if err != nil {
+			return nil, err
+		}
+		imageRegionList = intersectRegions(getimageRegionList(), enabledRegions)
 	} else {
 		imageRegionList = append(imageRegionList, input.Region)
 	}
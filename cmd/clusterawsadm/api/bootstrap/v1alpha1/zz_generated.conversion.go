@@ -222,6 +222,7 @@ func autoConvert_v1beta1_AWSIAMConfigurationSpec_To_v1alpha1_AWSIAMConfiguration
 		out.EKS = nil
 	}
 	out.EventBridge = (*EventBridgeConfig)(unsafe.Pointer(in.EventBridge))
+	// WARNING: in.Observability requires manual conversion: does not exist in peer-type
 	out.Partition = in.Partition
 	out.SecureSecretsBackends = *(*[]v1beta2.SecretBackend)(unsafe.Pointer(&in.SecureSecretsBackends))
 	// WARNING: in.S3Buckets requires manual conversion: does not exist in peer-type
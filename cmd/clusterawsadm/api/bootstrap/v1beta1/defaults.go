@@ -84,6 +84,16 @@ func SetDefaults_AWSIAMConfigurationSpec(obj *AWSIAMConfigurationSpec) { //nolin
 			Enable: false,
 		}
 	}
+	if obj.Observability == nil {
+		obj.Observability = &ObservabilityConfig{
+			Enable: false,
+		}
+	}
+	if obj.Budgets == nil {
+		obj.Budgets = &BudgetsConfig{
+			Enable: false,
+		}
+	}
 	if obj.EKS.ManagedMachinePool == nil {
 		obj.EKS.ManagedMachinePool = &AWSIAMRoleSpec{
 			Disable: true,
@@ -132,6 +132,19 @@ type EventBridgeConfig struct {
 	Enable bool `json:"enable,omitempty"`
 }
 
+// ObservabilityConfig represents configuration for enabling the CloudWatch alarms
+// and dashboards of AWSClusters.
+type ObservabilityConfig struct {
+	// Enable controls whether permissions are granted to manage CloudWatch alarms and dashboards
+	Enable bool `json:"enable,omitempty"`
+}
+
+// BudgetsConfig represents configuration for enabling the AWS Budgets of AWSClusters.
+type BudgetsConfig struct {
+	// Enable controls whether permissions are granted to manage AWS Budgets
+	Enable bool `json:"enable,omitempty"`
+}
+
 // ClusterAPIControllers controls the configuration of the AWS IAM role for
 // the Kubernetes Cluster API Provider AWS controller.
 type ClusterAPIControllers struct {
@@ -140,6 +153,10 @@ type ClusterAPIControllers struct {
 	// consumed by Cluster API when creating an ec2 instance. Defaults to
 	// *.<suffix>, where suffix is defaulted to .cluster-api-provider-aws.sigs.k8s.io
 	AllowedEC2InstanceProfiles []string `json:"allowedEC2InstanceProfiles,omitempty"`
+
+	// AllowInstanceProfileCreation allows Cluster API to create and delete the IAM instance profiles, and
+	// their roles, declared by the managedIAMInstanceProfile of AWSMachines. Defaults to false.
+	AllowInstanceProfileCreation bool `json:"allowInstanceProfileCreation,omitempty"`
 }
 
 // Nodes controls the configuration of the AWS IAM role for worker nodes
@@ -218,6 +235,12 @@ type AWSIAMConfigurationSpec struct {
 	// EventBridge controls configuration for consuming EventBridge events
 	EventBridge *EventBridgeConfig `json:"eventBridge,omitempty"`
 
+	// Observability controls configuration for managing the CloudWatch alarms and dashboards of AWSClusters
+	Observability *ObservabilityConfig `json:"observability,omitempty"`
+
+	// Budgets controls configuration for managing the AWS Budgets of AWSClusters
+	Budgets *BudgetsConfig `json:"budgets,omitempty"`
+
 	// Partition is the AWS security partition being used. Defaults to "aws"
 	Partition string `json:"partition,omitempty"`
 
@@ -83,6 +83,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 			Action: iamv1.Actions{
 				"ec2:DescribeIpamPools",
 				"ec2:AllocateIpamPoolCidr",
+				"ec2:ReleaseIpamPoolAllocation",
 				"ec2:AttachNetworkInterface",
 				"ec2:DetachNetworkInterface",
 				"ec2:AllocateAddress",
@@ -93,8 +94,10 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:AssociateVpcCidrBlock",
 				"ec2:AttachInternetGateway",
 				"ec2:AuthorizeSecurityGroupIngress",
+				"ec2:AuthorizeSecurityGroupEgress",
 				"ec2:CreateCarrierGateway",
 				"ec2:CreateInternetGateway",
+				"ec2:CreateInstanceConnectEndpoint",
 				"ec2:CreateEgressOnlyInternetGateway",
 				"ec2:CreateNatGateway",
 				"ec2:CreateNetworkInterface",
@@ -110,8 +113,10 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:ModifyVpcEndpoint",
 				"ec2:DeleteCarrierGateway",
 				"ec2:DeleteInternetGateway",
+				"ec2:DeleteInstanceConnectEndpoint",
 				"ec2:DeleteEgressOnlyInternetGateway",
 				"ec2:DeleteNatGateway",
+				"ec2:DeleteRoute",
 				"ec2:DeleteRouteTable",
 				"ec2:ReplaceRoute",
 				"ec2:DeleteSecurityGroup",
@@ -122,13 +127,18 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DescribeAccountAttributes",
 				"ec2:DescribeAddresses",
 				"ec2:DescribeAvailabilityZones",
+				"ec2:DescribeRegions",
 				"ec2:DescribeCarrierGateways",
 				"ec2:DescribeInstances",
+				"ec2:DescribeInstanceConnectEndpoints",
 				"ec2:DescribeInstanceTypes",
 				"ec2:DescribeInternetGateways",
 				"ec2:DescribeEgressOnlyInternetGateways",
 				"ec2:DescribeInstanceTypes",
+				"ec2:DescribeInstanceTypeOfferings",
+				"ec2:GetInstanceTypesFromInstanceRequirements",
 				"ec2:DescribeImages",
+				"ec2:CopyImage",
 				"ec2:DescribeNatGateways",
 				"ec2:DescribeNetworkInterfaces",
 				"ec2:DescribeNetworkInterfaceAttribute",
@@ -149,9 +159,28 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:ModifySubnetAttribute",
 				"ec2:ReleaseAddress",
 				"ec2:RevokeSecurityGroupIngress",
+				"ec2:RevokeSecurityGroupEgress",
+				"ec2:UpdateSecurityGroupRuleDescriptionsIngress",
+				"ec2:UpdateSecurityGroupRuleDescriptionsEgress",
 				"ec2:RunInstances",
 				"ec2:TerminateInstances",
 				"ec2:GetSecurityGroupsForVpc",
+				"ec2:DescribeInstanceStatus",
+				"ec2:DescribeSpotInstanceRequests",
+				"ec2:DescribeCapacityReservations",
+				"ec2:GetConsoleOutput",
+				"ec2:CreateNetworkInsightsPath",
+				"ec2:DeleteNetworkInsightsPath",
+				"ec2:StartNetworkInsightsAnalysis",
+				"ec2:DescribeNetworkInsightsAnalyses",
+				"ec2:DeleteNetworkInsightsAnalysis",
+				"ssm:DescribeInstanceInformation",
+				"ssm:CreateAssociation",
+				"ssm:DeleteAssociation",
+				"ssm:ListAssociations",
+				"ssm:DescribeMaintenanceWindowTargets",
+				"ssm:RegisterTargetWithMaintenanceWindow",
+				"ssm:DeregisterTargetFromMaintenanceWindow",
 				"tag:GetResources",
 				"elasticloadbalancing:AddTags",
 				"elasticloadbalancing:CreateLoadBalancer",
@@ -177,11 +206,16 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"elasticloadbalancing:RegisterTargets",
 				"elasticloadbalancing:DeregisterTargets",
 				"elasticloadbalancing:DeleteListener",
+				"elasticloadbalancing:ModifyListener",
 				"autoscaling:DescribeAutoScalingGroups",
 				"autoscaling:DescribeInstanceRefreshes",
+				"autoscaling:DescribeScalingActivities",
 				"autoscaling:DeleteLifecycleHook",
 				"autoscaling:DescribeLifecycleHooks",
 				"autoscaling:PutLifecycleHook",
+				"autoscaling:DescribeScheduledActions",
+				"autoscaling:PutScheduledUpdateGroupAction",
+				"autoscaling:DeleteScheduledAction",
 				"ec2:CreateLaunchTemplate",
 				"ec2:CreateLaunchTemplateVersion",
 				"ec2:DescribeLaunchTemplates",
@@ -190,6 +224,33 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DeleteLaunchTemplateVersions",
 				"ec2:DescribeKeyPairs",
 				"ec2:ModifyInstanceMetadataOptions",
+				"kms:DescribeKey",
+				"kms:GetKeyPolicy",
+				"kms:ListGrants",
+				"health:DescribeEvents",
+				"servicequotas:GetServiceQuota",
+				"cloudwatch:GetMetricStatistics",
+				"route53:CreateHostedZone",
+				"route53:DeleteHostedZone",
+				"route53:ListHostedZonesByVPC",
+				"route53:ChangeTagsForResource",
+				"route53:ListTagsForResource",
+				"route53:ChangeResourceRecordSets",
+				"route53:ListResourceRecordSets",
+				"route53:AssociateVPCWithHostedZone",
+				"logs:CreateLogGroup",
+				"logs:DeleteLogGroup",
+				"logs:DescribeLogGroups",
+				"logs:PutRetentionPolicy",
+				"logs:TagResource",
+				"logs:ListTagsForResource",
+				"ssm:CreateDocument",
+				"ssm:GetDocument",
+				"ssm:UpdateDocument",
+				"ssm:UpdateDocumentDefaultVersion",
+				"ssm:DeleteDocument",
+				"ssm:AddTagsToResource",
+				"ssm:ListTagsForResource",
 			},
 		},
 		{
@@ -204,6 +265,9 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"autoscaling:StartInstanceRefresh",
 				"autoscaling:DeleteAutoScalingGroup",
 				"autoscaling:DeleteTags",
+				"autoscaling:SetInstanceHealth",
+				"autoscaling:SetInstanceProtection",
+				"autoscaling:TerminateInstanceInAutoScalingGroup",
 			},
 		},
 		{
@@ -242,6 +306,29 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				iamv1.StringLike: map[string]string{"iam:AWSServiceName": "spot.amazonaws.com"},
 			},
 		},
+		{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"arn:*:iam::*:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling",
+				"arn:*:iam::*:role/aws-service-role/elasticloadbalancing.amazonaws.com/AWSServiceRoleForElasticLoadBalancing",
+				"arn:*:iam::*:role/aws-service-role/spot.amazonaws.com/AWSServiceRoleForEC2Spot",
+			},
+			Action: iamv1.Actions{
+				"iam:GetRole",
+			},
+		},
+		{
+			Effect: iamv1.EffectAllow,
+			Action: iamv1.Actions{
+				"iam:CreateServiceLinkedRole",
+			},
+			Resource: iamv1.Resources{
+				"arn:*:iam::*:role/aws-service-role/ec2-instance-connect.amazonaws.com/AWSServiceRoleForEC2InstanceConnect",
+			},
+			Condition: iamv1.Conditions{
+				iamv1.StringLike: map[string]string{"iam:AWSServiceName": "ec2-instance-connect.amazonaws.com"},
+			},
+		},
 		{
 			Effect:   iamv1.EffectAllow,
 			Resource: t.allowedEC2InstanceProfiles(),
@@ -296,7 +383,9 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 			Action: iamv1.Actions{
 				"s3:CreateBucket",
 				"s3:DeleteBucket",
+				"s3:DeleteBucketPolicy",
 				"s3:DeleteObject",
+				"s3:GetBucketPolicy",
 				"s3:GetObject",
 				"s3:ListBucket",
 				"s3:PutBucketPolicy",
@@ -327,6 +416,71 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 			},
 		})
 	}
+	if t.Spec.Observability.Enable {
+		statement = append(statement, iamv1.StatementEntry{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{iamv1.Any},
+			Action: iamv1.Actions{
+				"autoscaling:EnableMetricsCollection",
+				"cloudwatch:DeleteAlarms",
+				"cloudwatch:DeleteDashboards",
+				"cloudwatch:DescribeAlarms",
+				"cloudwatch:GetDashboard",
+				"cloudwatch:PutDashboard",
+				"cloudwatch:PutMetricAlarm",
+			},
+		})
+	}
+	if t.Spec.Budgets.Enable {
+		statement = append(statement, iamv1.StatementEntry{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{iamv1.Any},
+			Action: iamv1.Actions{
+				"budgets:ModifyBudget",
+				"budgets:ViewBudget",
+			},
+		})
+	}
+	if t.Spec.ClusterAPIControllers.AllowInstanceProfileCreation {
+		statement = append(statement, iamv1.StatementEntry{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"arn:*:iam::*:role/*",
+				"arn:*:iam::*:instance-profile/*",
+			},
+			Action: iamv1.Actions{
+				"iam:AddRoleToInstanceProfile",
+				"iam:AttachRolePolicy",
+				"iam:CreateInstanceProfile",
+				"iam:CreateRole",
+				"iam:DeleteInstanceProfile",
+				"iam:DeleteRole",
+				"iam:DeleteRolePolicy",
+				"iam:DetachRolePolicy",
+				"iam:GetInstanceProfile",
+				"iam:GetRole",
+				"iam:ListAttachedRolePolicies",
+				"iam:ListRolePolicies",
+				"iam:PutRolePolicy",
+				"iam:RemoveRoleFromInstanceProfile",
+				"iam:TagInstanceProfile",
+				"iam:TagRole",
+			},
+		}, iamv1.StatementEntry{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"arn:*:iam::*:role/*",
+			},
+			Action: iamv1.Actions{
+				"iam:PassRole",
+			},
+			Condition: iamv1.Conditions{
+				"StringEquals": map[string]string{
+					"iam:PassedToService": "ec2.amazonaws.com",
+				},
+			},
+		})
+	}
 
 	return &iamv1.PolicyDocument{
 		Version:   iamv1.CurrentVersion,
@@ -102,6 +102,30 @@ func TestRenderCloudformation(t *testing.T) {
 				return t
 			},
 		},
+		{
+			fixture: "with_instance_profile_creation",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.ClusterAPIControllers.AllowInstanceProfileCreation = true
+				return t
+			},
+		},
+		{
+			fixture: "with_observability",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.Observability.Enable = true
+				return t
+			},
+		},
+		{
+			fixture: "with_budgets",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.Budgets.Enable = true
+				return t
+			},
+		},
 		{
 			fixture: "with_eks_default_roles",
 			template: func() Template {
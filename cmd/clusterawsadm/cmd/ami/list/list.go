@@ -43,7 +43,7 @@ func ListAMICmd() *cobra.Command {
 		Short: "List AMIs from the default AWS account where AMIs are stored",
 		Long: templates.LongDesc(`
 			List AMIs based on Kubernetes version, OS, region. If no arguments are provided,
-			it will print all AMIs in all regions, OS types for the supported Kubernetes versions.
+			it will print all AMIs in all published regions enabled for the account, OS types for the supported Kubernetes versions.
             Supported Kubernetes versions start from the latest stable version and goes 2 release back:
 			if the latest stable release is v1.20.4- v1.19.x and v1.18.x are supported.
 			Note: First release of each version will be skipped, e.g., v1.21.0
@@ -53,7 +53,7 @@ func ListAMICmd() *cobra.Command {
 		# List AMIs from the default AWS account where AMIs are stored.
 		# Available os options: centos-7, ubuntu-24.04, ubuntu-22.04, amazon-2, flatcar-stable
 		clusterawsadm ami list --kubernetes-version=v1.18.12 --os=ubuntu-20.04  --region=us-west-2
-		# To list all supported AMIs in all supported Kubernetes versions, regions, and linux distributions:
+		# To list all supported AMIs in all supported Kubernetes versions, published regions enabled for the account, and linux distributions:
 		clusterawsadm ami list
 		`),
 		Args: cobra.NoArgs,
@@ -17,8 +17,11 @@ limitations under the License.
 package iam
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/config"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -29,6 +32,7 @@ import (
 	cloudformation "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/cloudformation/service"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/cmd/bootstrap/credentials"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/cmd/flags"
+	iamservice "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/iam/service"
 )
 
 func printCloudFormationTemplateCmd() *cobra.Command {
@@ -86,6 +90,9 @@ func createCloudFormationStackCmd() *cobra.Command {
 
 		# Create or update IAM roles and policies for Kubernetes using a AWS CloudFormation stack with a custom configuration.
 		clusterawsadm bootstrap iam create-cloudformation-stack --config bootstrap_config.yaml
+
+		# Create or update IAM roles and policies for Kubernetes directly with the AWS IAM API, without AWS CloudFormation.
+		clusterawsadm bootstrap iam create-cloudformation-stack --no-cloudformation
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			t, err := getBootstrapTemplate(cmd)
@@ -97,6 +104,22 @@ func createCloudFormationStackCmd() *cobra.Command {
 				fmt.Println("AWS_REGION env not set and --region flag not provided, default configuration will be used")
 			}
 
+			if noCloudFormation(cmd) {
+				fmt.Println("Attempting to create AWS IAM resources without AWS CloudFormation")
+				iamSvc, err := newIAMService(t)
+				if err != nil {
+					fmt.Printf("Error: %v\n", err)
+					return err
+				}
+
+				if err := iamSvc.ReconcileBootstrapResources(context.TODO(), *t.RenderCloudFormation(), t.Spec.StackTags); err != nil {
+					fmt.Printf("Error: %v\n", err)
+					return err
+				}
+
+				return iamSvc.ShowBootstrapResources(*t.RenderCloudFormation())
+			}
+
 			fmt.Printf("Attempting to create AWS CloudFormation stack %s\n", t.Spec.StackName)
 			sess, err := session.NewSessionWithOptions(session.Options{
 				SharedConfigState: session.SharedConfigEnable,
@@ -119,6 +142,7 @@ func createCloudFormationStackCmd() *cobra.Command {
 		},
 	}
 	addConfigFlag(newCmd)
+	addNoCloudFormationFlag(newCmd)
 	flags.AddRegionFlag(newCmd)
 	return newCmd
 }
@@ -143,6 +167,21 @@ func deleteCloudFormationStackCmd() *cobra.Command {
 				fmt.Println("AWS_REGION env not set and --region flag not provided, default configuration will be used")
 			}
 
+			if noCloudFormation(cmd) {
+				fmt.Println("Attempting to delete AWS IAM resources created without AWS CloudFormation")
+				iamSvc, err := newIAMService(t)
+				if err != nil {
+					fmt.Printf("Error: %v\n", err)
+					return err
+				}
+
+				if err := iamSvc.DeleteBootstrapResources(context.TODO(), *t.RenderCloudFormation()); err != nil {
+					fmt.Printf("Error: %v\n", err)
+					return err
+				}
+				return nil
+			}
+
 			fmt.Printf("Attempting to delete AWS CloudFormation stack %s\n", t.Spec.StackName)
 			sess, err := session.NewSessionWithOptions(session.Options{
 				SharedConfigState: session.SharedConfigEnable,
@@ -165,6 +204,7 @@ func deleteCloudFormationStackCmd() *cobra.Command {
 		},
 	}
 	addConfigFlag(newCmd)
+	addNoCloudFormationFlag(newCmd)
 	flags.AddRegionFlag(newCmd)
 	return newCmd
 }
@@ -180,3 +220,24 @@ func resolveTemplateRegion(t *bootstrap.Template, cmd *cobra.Command) error {
 	}
 	return nil
 }
+
+func addNoCloudFormationFlag(c *cobra.Command) {
+	c.Flags().Bool("no-cloudformation", false, templates.LongDesc(`
+		Manage the AWS IAM resources directly with the AWS IAM API instead of an AWS
+		CloudFormation stack, for accounts where AWS CloudFormation is not allowed.
+	`))
+}
+
+func noCloudFormation(cmd *cobra.Command) bool {
+	noCFN, err := cmd.Flags().GetBool("no-cloudformation")
+	return err == nil && noCFN
+}
+
+func newIAMService(t *bootstrap.Template) (*iamservice.Service, error) {
+	cfg, err := config.LoadDefaultConfig(context.TODO(), config.WithRegion(t.Spec.Region))
+	if err != nil {
+		return nil, err
+	}
+
+	return iamservice.NewService(awsiam.NewFromConfig(cfg)), nil
+}
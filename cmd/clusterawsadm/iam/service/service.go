@@ -0,0 +1,833 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package iam provides the API operation methods for reconciling the bootstrap
+// AWS Identity and Access Management (IAM) resources directly with the AWS IAM API,
+// for accounts where AWS CloudFormation cannot be used.
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/smithy-go"
+	go_cfn "github.com/awslabs/goformation/v4/cloudformation"
+	cfn_iam "github.com/awslabs/goformation/v4/cloudformation/iam"
+	"github.com/awslabs/goformation/v4/cloudformation/tags"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+// maxPolicyVersions is the maximum number of versions IAM keeps for a managed policy.
+const maxPolicyVersions = 5
+
+// IAMAPI is the subset of the AWS IAM API used to reconcile the bootstrap resources.
+type IAMAPI interface {
+	GetRole(ctx context.Context, params *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error)
+	CreateRole(ctx context.Context, params *iam.CreateRoleInput, optFns ...func(*iam.Options)) (*iam.CreateRoleOutput, error)
+	DeleteRole(ctx context.Context, params *iam.DeleteRoleInput, optFns ...func(*iam.Options)) (*iam.DeleteRoleOutput, error)
+	UpdateAssumeRolePolicy(ctx context.Context, params *iam.UpdateAssumeRolePolicyInput, optFns ...func(*iam.Options)) (*iam.UpdateAssumeRolePolicyOutput, error)
+	PutRolePermissionsBoundary(ctx context.Context, params *iam.PutRolePermissionsBoundaryInput, optFns ...func(*iam.Options)) (*iam.PutRolePermissionsBoundaryOutput, error)
+	TagRole(ctx context.Context, params *iam.TagRoleInput, optFns ...func(*iam.Options)) (*iam.TagRoleOutput, error)
+	AttachRolePolicy(ctx context.Context, params *iam.AttachRolePolicyInput, optFns ...func(*iam.Options)) (*iam.AttachRolePolicyOutput, error)
+	DetachRolePolicy(ctx context.Context, params *iam.DetachRolePolicyInput, optFns ...func(*iam.Options)) (*iam.DetachRolePolicyOutput, error)
+	ListAttachedRolePolicies(ctx context.Context, params *iam.ListAttachedRolePoliciesInput, optFns ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error)
+	PutRolePolicy(ctx context.Context, params *iam.PutRolePolicyInput, optFns ...func(*iam.Options)) (*iam.PutRolePolicyOutput, error)
+	DeleteRolePolicy(ctx context.Context, params *iam.DeleteRolePolicyInput, optFns ...func(*iam.Options)) (*iam.DeleteRolePolicyOutput, error)
+
+	GetInstanceProfile(ctx context.Context, params *iam.GetInstanceProfileInput, optFns ...func(*iam.Options)) (*iam.GetInstanceProfileOutput, error)
+	CreateInstanceProfile(ctx context.Context, params *iam.CreateInstanceProfileInput, optFns ...func(*iam.Options)) (*iam.CreateInstanceProfileOutput, error)
+	DeleteInstanceProfile(ctx context.Context, params *iam.DeleteInstanceProfileInput, optFns ...func(*iam.Options)) (*iam.DeleteInstanceProfileOutput, error)
+	AddRoleToInstanceProfile(ctx context.Context, params *iam.AddRoleToInstanceProfileInput, optFns ...func(*iam.Options)) (*iam.AddRoleToInstanceProfileOutput, error)
+	RemoveRoleFromInstanceProfile(ctx context.Context, params *iam.RemoveRoleFromInstanceProfileInput, optFns ...func(*iam.Options)) (*iam.RemoveRoleFromInstanceProfileOutput, error)
+
+	ListPolicies(ctx context.Context, params *iam.ListPoliciesInput, optFns ...func(*iam.Options)) (*iam.ListPoliciesOutput, error)
+	CreatePolicy(ctx context.Context, params *iam.CreatePolicyInput, optFns ...func(*iam.Options)) (*iam.CreatePolicyOutput, error)
+	DeletePolicy(ctx context.Context, params *iam.DeletePolicyInput, optFns ...func(*iam.Options)) (*iam.DeletePolicyOutput, error)
+	GetPolicyVersion(ctx context.Context, params *iam.GetPolicyVersionInput, optFns ...func(*iam.Options)) (*iam.GetPolicyVersionOutput, error)
+	ListPolicyVersions(ctx context.Context, params *iam.ListPolicyVersionsInput, optFns ...func(*iam.Options)) (*iam.ListPolicyVersionsOutput, error)
+	CreatePolicyVersion(ctx context.Context, params *iam.CreatePolicyVersionInput, optFns ...func(*iam.Options)) (*iam.CreatePolicyVersionOutput, error)
+	DeletePolicyVersion(ctx context.Context, params *iam.DeletePolicyVersionInput, optFns ...func(*iam.Options)) (*iam.DeletePolicyVersionOutput, error)
+	ListEntitiesForPolicy(ctx context.Context, params *iam.ListEntitiesForPolicyInput, optFns ...func(*iam.Options)) (*iam.ListEntitiesForPolicyOutput, error)
+
+	GetGroup(ctx context.Context, params *iam.GetGroupInput, optFns ...func(*iam.Options)) (*iam.GetGroupOutput, error)
+	CreateGroup(ctx context.Context, params *iam.CreateGroupInput, optFns ...func(*iam.Options)) (*iam.CreateGroupOutput, error)
+	DeleteGroup(ctx context.Context, params *iam.DeleteGroupInput, optFns ...func(*iam.Options)) (*iam.DeleteGroupOutput, error)
+	AttachGroupPolicy(ctx context.Context, params *iam.AttachGroupPolicyInput, optFns ...func(*iam.Options)) (*iam.AttachGroupPolicyOutput, error)
+	DetachGroupPolicy(ctx context.Context, params *iam.DetachGroupPolicyInput, optFns ...func(*iam.Options)) (*iam.DetachGroupPolicyOutput, error)
+
+	GetUser(ctx context.Context, params *iam.GetUserInput, optFns ...func(*iam.Options)) (*iam.GetUserOutput, error)
+	CreateUser(ctx context.Context, params *iam.CreateUserInput, optFns ...func(*iam.Options)) (*iam.CreateUserOutput, error)
+	DeleteUser(ctx context.Context, params *iam.DeleteUserInput, optFns ...func(*iam.Options)) (*iam.DeleteUserOutput, error)
+	TagUser(ctx context.Context, params *iam.TagUserInput, optFns ...func(*iam.Options)) (*iam.TagUserOutput, error)
+	AddUserToGroup(ctx context.Context, params *iam.AddUserToGroupInput, optFns ...func(*iam.Options)) (*iam.AddUserToGroupOutput, error)
+	RemoveUserFromGroup(ctx context.Context, params *iam.RemoveUserFromGroupInput, optFns ...func(*iam.Options)) (*iam.RemoveUserFromGroupOutput, error)
+	AttachUserPolicy(ctx context.Context, params *iam.AttachUserPolicyInput, optFns ...func(*iam.Options)) (*iam.AttachUserPolicyOutput, error)
+	DetachUserPolicy(ctx context.Context, params *iam.DetachUserPolicyInput, optFns ...func(*iam.Options)) (*iam.DetachUserPolicyOutput, error)
+	PutUserPolicy(ctx context.Context, params *iam.PutUserPolicyInput, optFns ...func(*iam.Options)) (*iam.PutUserPolicyOutput, error)
+	DeleteUserPolicy(ctx context.Context, params *iam.DeleteUserPolicyInput, optFns ...func(*iam.Options)) (*iam.DeleteUserPolicyOutput, error)
+}
+
+// Service reconciles the IAM resources of a bootstrap CloudFormation template
+// with the AWS IAM API, without creating a CloudFormation stack.
+type Service struct {
+	IAM IAMAPI
+}
+
+// NewService returns a new service given the IAM api client.
+func NewService(i IAMAPI) *Service {
+	return &Service{
+		IAM: i,
+	}
+}
+
+// bootstrapResources holds the IAM resources of a bootstrap template, grouped by type and
+// sorted by logical ID, along with the physical names referenced by the other resources.
+type bootstrapResources struct {
+	groups           []*cfn_iam.Group
+	roles            []*cfn_iam.Role
+	instanceProfiles []*cfn_iam.InstanceProfile
+	managedPolicies  []*cfn_iam.ManagedPolicy
+	users            []*cfn_iam.User
+
+	names map[string]string
+}
+
+func newBootstrapResources(t go_cfn.Template) (*bootstrapResources, error) {
+	r := &bootstrapResources{names: map[string]string{}}
+
+	logicalIDs := make([]string, 0, len(t.Resources))
+	for logicalID := range t.Resources {
+		logicalIDs = append(logicalIDs, logicalID)
+	}
+	sort.Strings(logicalIDs)
+
+	for _, logicalID := range logicalIDs {
+		switch resource := t.Resources[logicalID].(type) {
+		case *cfn_iam.Group:
+			r.groups = append(r.groups, resource)
+			r.names[go_cfn.Ref(logicalID)] = resource.GroupName
+		case *cfn_iam.Role:
+			r.roles = append(r.roles, resource)
+			r.names[go_cfn.Ref(logicalID)] = resource.RoleName
+		case *cfn_iam.InstanceProfile:
+			r.instanceProfiles = append(r.instanceProfiles, resource)
+		case *cfn_iam.ManagedPolicy:
+			r.managedPolicies = append(r.managedPolicies, resource)
+		case *cfn_iam.User:
+			r.users = append(r.users, resource)
+			r.names[go_cfn.Ref(logicalID)] = resource.UserName
+		default:
+			return nil, errors.Errorf("resource %q of type %s is not supported without AWS CloudFormation", logicalID, t.Resources[logicalID].AWSCloudFormationType())
+		}
+	}
+	return r, nil
+}
+
+// resolve replaces the references to other resources of the template by their name.
+func (r *bootstrapResources) resolve(refs []string) []string {
+	resolved := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if name, ok := r.names[ref]; ok {
+			ref = name
+		}
+		resolved = append(resolved, ref)
+	}
+	return resolved
+}
+
+// ReconcileBootstrapResources creates or updates the IAM resources of the bootstrap template.
+// Resources are never replaced: the attributes that cannot be updated are left unchanged,
+// and policies attached outside of the template are not detached.
+func (s *Service) ReconcileBootstrapResources(ctx context.Context, t go_cfn.Template, stackTags map[string]string) error {
+	r, err := newBootstrapResources(t)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range r.groups {
+		if err := s.reconcileGroup(ctx, group); err != nil {
+			return err
+		}
+	}
+	for _, role := range r.roles {
+		if err := s.reconcileRole(ctx, role, stackTags); err != nil {
+			return err
+		}
+	}
+	for _, profile := range r.instanceProfiles {
+		if err := s.reconcileInstanceProfile(ctx, profile, r.resolve(profile.Roles)); err != nil {
+			return err
+		}
+	}
+	for _, policy := range r.managedPolicies {
+		if err := s.reconcileManagedPolicy(ctx, policy, r); err != nil {
+			return err
+		}
+	}
+	for _, user := range r.users {
+		if err := s.reconcileUser(ctx, user, r.resolve(user.Groups), stackTags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteBootstrapResources deletes the IAM resources of the bootstrap template.
+// Resources that do not exist are ignored.
+func (s *Service) DeleteBootstrapResources(ctx context.Context, t go_cfn.Template) error {
+	r, err := newBootstrapResources(t)
+	if err != nil {
+		return err
+	}
+
+	for _, user := range r.users {
+		if err := s.deleteUser(ctx, user, r.resolve(user.Groups)); err != nil {
+			return err
+		}
+	}
+	for _, policy := range r.managedPolicies {
+		if err := s.deleteManagedPolicy(ctx, policy); err != nil {
+			return err
+		}
+	}
+	for _, profile := range r.instanceProfiles {
+		if err := s.deleteInstanceProfile(ctx, profile); err != nil {
+			return err
+		}
+	}
+	for _, role := range r.roles {
+		if err := s.deleteRole(ctx, role); err != nil {
+			return err
+		}
+	}
+	for _, group := range r.groups {
+		if err := s.deleteGroup(ctx, group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ShowBootstrapResources prints out in tabular format the IAM resources of the bootstrap template.
+func (s *Service) ShowBootstrapResources(t go_cfn.Template) error {
+	r, err := newBootstrapResources(t)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print("\nFollowing resources are reconciled: \n\n")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', tabwriter.Debug)
+	fmt.Fprintln(w, "Resource\tName")
+	for _, group := range r.groups {
+		fmt.Fprintf(w, "%s\t%s\n", group.AWSCloudFormationType(), group.GroupName)
+	}
+	for _, role := range r.roles {
+		fmt.Fprintf(w, "%s\t%s\n", role.AWSCloudFormationType(), role.RoleName)
+	}
+	for _, profile := range r.instanceProfiles {
+		fmt.Fprintf(w, "%s\t%s\n", profile.AWSCloudFormationType(), profile.InstanceProfileName)
+	}
+	for _, policy := range r.managedPolicies {
+		fmt.Fprintf(w, "%s\t%s\n", policy.AWSCloudFormationType(), policy.ManagedPolicyName)
+	}
+	for _, user := range r.users {
+		fmt.Fprintf(w, "%s\t%s\n", user.AWSCloudFormationType(), user.UserName)
+	}
+	w.Flush()
+
+	fmt.Print("\n\n")
+
+	return nil
+}
+
+func (s *Service) reconcileGroup(ctx context.Context, group *cfn_iam.Group) error {
+	_, err := s.IAM.GetGroup(ctx, &iam.GetGroupInput{GroupName: aws.String(group.GroupName)})
+	switch {
+	case isNotFound(err):
+		input := &iam.CreateGroupInput{GroupName: aws.String(group.GroupName)}
+		if group.Path != "" {
+			input.Path = aws.String(group.Path)
+		}
+		klog.V(2).Infof("creating IAM group %q", group.GroupName)
+		if _, err := s.IAM.CreateGroup(ctx, input); err != nil {
+			return errors.Wrapf(err, "failed to create IAM group %q", group.GroupName)
+		}
+	case err != nil:
+		return errors.Wrapf(err, "failed to get IAM group %q", group.GroupName)
+	}
+
+	for _, policyArn := range group.ManagedPolicyArns {
+		if _, err := s.IAM.AttachGroupPolicy(ctx, &iam.AttachGroupPolicyInput{
+			GroupName: aws.String(group.GroupName),
+			PolicyArn: aws.String(policyArn),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to attach policy %q to IAM group %q", policyArn, group.GroupName)
+		}
+	}
+	return nil
+}
+
+func (s *Service) reconcileRole(ctx context.Context, role *cfn_iam.Role, stackTags map[string]string) error {
+	trustPolicy, err := policyDocumentToJSON(role.AssumeRolePolicyDocument)
+	if err != nil {
+		return err
+	}
+	roleTags := mergeTags(role.Tags, stackTags)
+
+	_, err = s.IAM.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(role.RoleName)})
+	switch {
+	case isNotFound(err):
+		input := &iam.CreateRoleInput{
+			RoleName:                 aws.String(role.RoleName),
+			AssumeRolePolicyDocument: aws.String(trustPolicy),
+			Tags:                     roleTags,
+		}
+		if role.Path != "" {
+			input.Path = aws.String(role.Path)
+		}
+		if role.PermissionsBoundary != "" {
+			input.PermissionsBoundary = aws.String(role.PermissionsBoundary)
+		}
+		klog.V(2).Infof("creating IAM role %q", role.RoleName)
+		if _, err := s.IAM.CreateRole(ctx, input); err != nil {
+			return errors.Wrapf(err, "failed to create IAM role %q", role.RoleName)
+		}
+	case err != nil:
+		return errors.Wrapf(err, "failed to get IAM role %q", role.RoleName)
+	default:
+		klog.V(2).Infof("updating IAM role %q", role.RoleName)
+		if _, err := s.IAM.UpdateAssumeRolePolicy(ctx, &iam.UpdateAssumeRolePolicyInput{
+			RoleName:       aws.String(role.RoleName),
+			PolicyDocument: aws.String(trustPolicy),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to update the trust policy of IAM role %q", role.RoleName)
+		}
+		if role.PermissionsBoundary != "" {
+			if _, err := s.IAM.PutRolePermissionsBoundary(ctx, &iam.PutRolePermissionsBoundaryInput{
+				RoleName:            aws.String(role.RoleName),
+				PermissionsBoundary: aws.String(role.PermissionsBoundary),
+			}); err != nil {
+				return errors.Wrapf(err, "failed to set the permissions boundary of IAM role %q", role.RoleName)
+			}
+		}
+		if len(roleTags) > 0 {
+			if _, err := s.IAM.TagRole(ctx, &iam.TagRoleInput{
+				RoleName: aws.String(role.RoleName),
+				Tags:     roleTags,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to tag IAM role %q", role.RoleName)
+			}
+		}
+	}
+
+	for _, policyArn := range role.ManagedPolicyArns {
+		if _, err := s.IAM.AttachRolePolicy(ctx, &iam.AttachRolePolicyInput{
+			RoleName:  aws.String(role.RoleName),
+			PolicyArn: aws.String(policyArn),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to attach policy %q to IAM role %q", policyArn, role.RoleName)
+		}
+	}
+
+	for _, policy := range role.Policies {
+		document, err := policyDocumentToJSON(policy.PolicyDocument)
+		if err != nil {
+			return err
+		}
+		if _, err := s.IAM.PutRolePolicy(ctx, &iam.PutRolePolicyInput{
+			RoleName:       aws.String(role.RoleName),
+			PolicyName:     aws.String(policy.PolicyName),
+			PolicyDocument: aws.String(document),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to put policy %q of IAM role %q", policy.PolicyName, role.RoleName)
+		}
+	}
+	return nil
+}
+
+func (s *Service) reconcileInstanceProfile(ctx context.Context, profile *cfn_iam.InstanceProfile, roles []string) error {
+	var existingRoles []iamtypes.Role
+	out, err := s.IAM.GetInstanceProfile(ctx, &iam.GetInstanceProfileInput{InstanceProfileName: aws.String(profile.InstanceProfileName)})
+	switch {
+	case isNotFound(err):
+		input := &iam.CreateInstanceProfileInput{InstanceProfileName: aws.String(profile.InstanceProfileName)}
+		if profile.Path != "" {
+			input.Path = aws.String(profile.Path)
+		}
+		klog.V(2).Infof("creating IAM instance profile %q", profile.InstanceProfileName)
+		if _, err := s.IAM.CreateInstanceProfile(ctx, input); err != nil {
+			return errors.Wrapf(err, "failed to create IAM instance profile %q", profile.InstanceProfileName)
+		}
+	case err != nil:
+		return errors.Wrapf(err, "failed to get IAM instance profile %q", profile.InstanceProfileName)
+	default:
+		existingRoles = out.InstanceProfile.Roles
+	}
+
+	for _, role := range roles {
+		if containsRole(existingRoles, role) {
+			continue
+		}
+		if _, err := s.IAM.AddRoleToInstanceProfile(ctx, &iam.AddRoleToInstanceProfileInput{
+			InstanceProfileName: aws.String(profile.InstanceProfileName),
+			RoleName:            aws.String(role),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to add IAM role %q to instance profile %q", role, profile.InstanceProfileName)
+		}
+	}
+	return nil
+}
+
+func (s *Service) reconcileManagedPolicy(ctx context.Context, policy *cfn_iam.ManagedPolicy, r *bootstrapResources) error {
+	document, err := policyDocumentToJSON(policy.PolicyDocument)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.findManagedPolicy(ctx, policy.ManagedPolicyName)
+	if err != nil {
+		return err
+	}
+
+	var policyArn string
+	if existing == nil {
+		input := &iam.CreatePolicyInput{
+			PolicyName:     aws.String(policy.ManagedPolicyName),
+			PolicyDocument: aws.String(document),
+		}
+		if policy.Description != "" {
+			input.Description = aws.String(policy.Description)
+		}
+		if policy.Path != "" {
+			input.Path = aws.String(policy.Path)
+		}
+		klog.V(2).Infof("creating IAM policy %q", policy.ManagedPolicyName)
+		out, err := s.IAM.CreatePolicy(ctx, input)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create IAM policy %q", policy.ManagedPolicyName)
+		}
+		policyArn = aws.ToString(out.Policy.Arn)
+	} else {
+		policyArn = aws.ToString(existing.Arn)
+		if err := s.updateManagedPolicyDocument(ctx, existing, document); err != nil {
+			return err
+		}
+	}
+
+	for _, group := range r.resolve(policy.Groups) {
+		if _, err := s.IAM.AttachGroupPolicy(ctx, &iam.AttachGroupPolicyInput{
+			GroupName: aws.String(group),
+			PolicyArn: aws.String(policyArn),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to attach IAM policy %q to group %q", policy.ManagedPolicyName, group)
+		}
+	}
+	for _, role := range r.resolve(policy.Roles) {
+		if _, err := s.IAM.AttachRolePolicy(ctx, &iam.AttachRolePolicyInput{
+			RoleName:  aws.String(role),
+			PolicyArn: aws.String(policyArn),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to attach IAM policy %q to role %q", policy.ManagedPolicyName, role)
+		}
+	}
+	for _, user := range r.resolve(policy.Users) {
+		if _, err := s.IAM.AttachUserPolicy(ctx, &iam.AttachUserPolicyInput{
+			UserName:  aws.String(user),
+			PolicyArn: aws.String(policyArn),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to attach IAM policy %q to user %q", policy.ManagedPolicyName, user)
+		}
+	}
+	return nil
+}
+
+// updateManagedPolicyDocument creates a new default version of the managed policy when its document changed,
+// deleting the oldest version first when the policy already has the maximum number of versions.
+func (s *Service) updateManagedPolicyDocument(ctx context.Context, policy *iamtypes.Policy, document string) error {
+	current, err := s.IAM.GetPolicyVersion(ctx, &iam.GetPolicyVersionInput{
+		PolicyArn: policy.Arn,
+		VersionId: policy.DefaultVersionId,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the default version of IAM policy %q", aws.ToString(policy.PolicyName))
+	}
+	equal, err := policyDocumentsEqual(aws.ToString(current.PolicyVersion.Document), document)
+	if err != nil {
+		return errors.Wrapf(err, "failed to compare the document of IAM policy %q", aws.ToString(policy.PolicyName))
+	}
+	if equal {
+		return nil
+	}
+
+	versions, err := s.IAM.ListPolicyVersions(ctx, &iam.ListPolicyVersionsInput{PolicyArn: policy.Arn})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list the versions of IAM policy %q", aws.ToString(policy.PolicyName))
+	}
+	if len(versions.Versions) >= maxPolicyVersions {
+		var oldest *iamtypes.PolicyVersion
+		for i := range versions.Versions {
+			version := &versions.Versions[i]
+			if version.IsDefaultVersion {
+				continue
+			}
+			if oldest == nil || aws.ToTime(version.CreateDate).Before(aws.ToTime(oldest.CreateDate)) {
+				oldest = version
+			}
+		}
+		if oldest != nil {
+			if _, err := s.IAM.DeletePolicyVersion(ctx, &iam.DeletePolicyVersionInput{
+				PolicyArn: policy.Arn,
+				VersionId: oldest.VersionId,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to delete version %q of IAM policy %q", aws.ToString(oldest.VersionId), aws.ToString(policy.PolicyName))
+			}
+		}
+	}
+
+	klog.V(2).Infof("updating IAM policy %q", aws.ToString(policy.PolicyName))
+	if _, err := s.IAM.CreatePolicyVersion(ctx, &iam.CreatePolicyVersionInput{
+		PolicyArn:      policy.Arn,
+		PolicyDocument: aws.String(document),
+		SetAsDefault:   true,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to update IAM policy %q", aws.ToString(policy.PolicyName))
+	}
+	return nil
+}
+
+func (s *Service) reconcileUser(ctx context.Context, user *cfn_iam.User, groups []string, stackTags map[string]string) error {
+	userTags := mergeTags(user.Tags, stackTags)
+
+	_, err := s.IAM.GetUser(ctx, &iam.GetUserInput{UserName: aws.String(user.UserName)})
+	switch {
+	case isNotFound(err):
+		input := &iam.CreateUserInput{
+			UserName: aws.String(user.UserName),
+			Tags:     userTags,
+		}
+		if user.Path != "" {
+			input.Path = aws.String(user.Path)
+		}
+		if user.PermissionsBoundary != "" {
+			input.PermissionsBoundary = aws.String(user.PermissionsBoundary)
+		}
+		klog.V(2).Infof("creating IAM user %q", user.UserName)
+		if _, err := s.IAM.CreateUser(ctx, input); err != nil {
+			return errors.Wrapf(err, "failed to create IAM user %q", user.UserName)
+		}
+	case err != nil:
+		return errors.Wrapf(err, "failed to get IAM user %q", user.UserName)
+	default:
+		if len(userTags) > 0 {
+			if _, err := s.IAM.TagUser(ctx, &iam.TagUserInput{
+				UserName: aws.String(user.UserName),
+				Tags:     userTags,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to tag IAM user %q", user.UserName)
+			}
+		}
+	}
+
+	for _, group := range groups {
+		if _, err := s.IAM.AddUserToGroup(ctx, &iam.AddUserToGroupInput{
+			UserName:  aws.String(user.UserName),
+			GroupName: aws.String(group),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to add IAM user %q to group %q", user.UserName, group)
+		}
+	}
+
+	for _, policyArn := range user.ManagedPolicyArns {
+		if _, err := s.IAM.AttachUserPolicy(ctx, &iam.AttachUserPolicyInput{
+			UserName:  aws.String(user.UserName),
+			PolicyArn: aws.String(policyArn),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to attach policy %q to IAM user %q", policyArn, user.UserName)
+		}
+	}
+
+	for _, policy := range user.Policies {
+		document, err := policyDocumentToJSON(policy.PolicyDocument)
+		if err != nil {
+			return err
+		}
+		if _, err := s.IAM.PutUserPolicy(ctx, &iam.PutUserPolicyInput{
+			UserName:       aws.String(user.UserName),
+			PolicyName:     aws.String(policy.PolicyName),
+			PolicyDocument: aws.String(document),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to put policy %q of IAM user %q", policy.PolicyName, user.UserName)
+		}
+	}
+	return nil
+}
+
+func (s *Service) deleteUser(ctx context.Context, user *cfn_iam.User, groups []string) error {
+	for _, group := range groups {
+		if _, err := s.IAM.RemoveUserFromGroup(ctx, &iam.RemoveUserFromGroupInput{
+			UserName:  aws.String(user.UserName),
+			GroupName: aws.String(group),
+		}); err != nil && !isNotFound(err) {
+			return errors.Wrapf(err, "failed to remove IAM user %q from group %q", user.UserName, group)
+		}
+	}
+	for _, policyArn := range user.ManagedPolicyArns {
+		if _, err := s.IAM.DetachUserPolicy(ctx, &iam.DetachUserPolicyInput{
+			UserName:  aws.String(user.UserName),
+			PolicyArn: aws.String(policyArn),
+		}); err != nil && !isNotFound(err) {
+			return errors.Wrapf(err, "failed to detach policy %q from IAM user %q", policyArn, user.UserName)
+		}
+	}
+	for _, policy := range user.Policies {
+		if _, err := s.IAM.DeleteUserPolicy(ctx, &iam.DeleteUserPolicyInput{
+			UserName:   aws.String(user.UserName),
+			PolicyName: aws.String(policy.PolicyName),
+		}); err != nil && !isNotFound(err) {
+			return errors.Wrapf(err, "failed to delete policy %q of IAM user %q", policy.PolicyName, user.UserName)
+		}
+	}
+
+	klog.V(2).Infof("deleting IAM user %q", user.UserName)
+	if _, err := s.IAM.DeleteUser(ctx, &iam.DeleteUserInput{UserName: aws.String(user.UserName)}); err != nil && !isNotFound(err) {
+		return errors.Wrapf(err, "failed to delete IAM user %q", user.UserName)
+	}
+	return nil
+}
+
+func (s *Service) deleteManagedPolicy(ctx context.Context, policy *cfn_iam.ManagedPolicy) error {
+	existing, err := s.findManagedPolicy(ctx, policy.ManagedPolicyName)
+	if err != nil || existing == nil {
+		return err
+	}
+
+	entities, err := s.IAM.ListEntitiesForPolicy(ctx, &iam.ListEntitiesForPolicyInput{PolicyArn: existing.Arn})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list the entities of IAM policy %q", policy.ManagedPolicyName)
+	}
+	for _, group := range entities.PolicyGroups {
+		if _, err := s.IAM.DetachGroupPolicy(ctx, &iam.DetachGroupPolicyInput{
+			GroupName: group.GroupName,
+			PolicyArn: existing.Arn,
+		}); err != nil && !isNotFound(err) {
+			return errors.Wrapf(err, "failed to detach IAM policy %q from group %q", policy.ManagedPolicyName, aws.ToString(group.GroupName))
+		}
+	}
+	for _, role := range entities.PolicyRoles {
+		if _, err := s.IAM.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{
+			RoleName:  role.RoleName,
+			PolicyArn: existing.Arn,
+		}); err != nil && !isNotFound(err) {
+			return errors.Wrapf(err, "failed to detach IAM policy %q from role %q", policy.ManagedPolicyName, aws.ToString(role.RoleName))
+		}
+	}
+	for _, user := range entities.PolicyUsers {
+		if _, err := s.IAM.DetachUserPolicy(ctx, &iam.DetachUserPolicyInput{
+			UserName:  user.UserName,
+			PolicyArn: existing.Arn,
+		}); err != nil && !isNotFound(err) {
+			return errors.Wrapf(err, "failed to detach IAM policy %q from user %q", policy.ManagedPolicyName, aws.ToString(user.UserName))
+		}
+	}
+
+	versions, err := s.IAM.ListPolicyVersions(ctx, &iam.ListPolicyVersionsInput{PolicyArn: existing.Arn})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list the versions of IAM policy %q", policy.ManagedPolicyName)
+	}
+	for _, version := range versions.Versions {
+		if version.IsDefaultVersion {
+			continue
+		}
+		if _, err := s.IAM.DeletePolicyVersion(ctx, &iam.DeletePolicyVersionInput{
+			PolicyArn: existing.Arn,
+			VersionId: version.VersionId,
+		}); err != nil && !isNotFound(err) {
+			return errors.Wrapf(err, "failed to delete version %q of IAM policy %q", aws.ToString(version.VersionId), policy.ManagedPolicyName)
+		}
+	}
+
+	klog.V(2).Infof("deleting IAM policy %q", policy.ManagedPolicyName)
+	if _, err := s.IAM.DeletePolicy(ctx, &iam.DeletePolicyInput{PolicyArn: existing.Arn}); err != nil && !isNotFound(err) {
+		return errors.Wrapf(err, "failed to delete IAM policy %q", policy.ManagedPolicyName)
+	}
+	return nil
+}
+
+func (s *Service) deleteInstanceProfile(ctx context.Context, profile *cfn_iam.InstanceProfile) error {
+	out, err := s.IAM.GetInstanceProfile(ctx, &iam.GetInstanceProfileInput{InstanceProfileName: aws.String(profile.InstanceProfileName)})
+	switch {
+	case isNotFound(err):
+		return nil
+	case err != nil:
+		return errors.Wrapf(err, "failed to get IAM instance profile %q", profile.InstanceProfileName)
+	}
+
+	for _, role := range out.InstanceProfile.Roles {
+		if _, err := s.IAM.RemoveRoleFromInstanceProfile(ctx, &iam.RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(profile.InstanceProfileName),
+			RoleName:            role.RoleName,
+		}); err != nil && !isNotFound(err) {
+			return errors.Wrapf(err, "failed to remove IAM role %q from instance profile %q", aws.ToString(role.RoleName), profile.InstanceProfileName)
+		}
+	}
+
+	klog.V(2).Infof("deleting IAM instance profile %q", profile.InstanceProfileName)
+	if _, err := s.IAM.DeleteInstanceProfile(ctx, &iam.DeleteInstanceProfileInput{InstanceProfileName: aws.String(profile.InstanceProfileName)}); err != nil && !isNotFound(err) {
+		return errors.Wrapf(err, "failed to delete IAM instance profile %q", profile.InstanceProfileName)
+	}
+	return nil
+}
+
+func (s *Service) deleteRole(ctx context.Context, role *cfn_iam.Role) error {
+	attached, err := s.IAM.ListAttachedRolePolicies(ctx, &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(role.RoleName)})
+	switch {
+	case isNotFound(err):
+		return nil
+	case err != nil:
+		return errors.Wrapf(err, "failed to list the policies of IAM role %q", role.RoleName)
+	}
+	for _, policy := range attached.AttachedPolicies {
+		if _, err := s.IAM.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{
+			RoleName:  aws.String(role.RoleName),
+			PolicyArn: policy.PolicyArn,
+		}); err != nil && !isNotFound(err) {
+			return errors.Wrapf(err, "failed to detach policy %q from IAM role %q", aws.ToString(policy.PolicyArn), role.RoleName)
+		}
+	}
+	for _, policy := range role.Policies {
+		if _, err := s.IAM.DeleteRolePolicy(ctx, &iam.DeleteRolePolicyInput{
+			RoleName:   aws.String(role.RoleName),
+			PolicyName: aws.String(policy.PolicyName),
+		}); err != nil && !isNotFound(err) {
+			return errors.Wrapf(err, "failed to delete policy %q of IAM role %q", policy.PolicyName, role.RoleName)
+		}
+	}
+
+	klog.V(2).Infof("deleting IAM role %q", role.RoleName)
+	if _, err := s.IAM.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String(role.RoleName)}); err != nil && !isNotFound(err) {
+		return errors.Wrapf(err, "failed to delete IAM role %q", role.RoleName)
+	}
+	return nil
+}
+
+func (s *Service) deleteGroup(ctx context.Context, group *cfn_iam.Group) error {
+	for _, policyArn := range group.ManagedPolicyArns {
+		if _, err := s.IAM.DetachGroupPolicy(ctx, &iam.DetachGroupPolicyInput{
+			GroupName: aws.String(group.GroupName),
+			PolicyArn: aws.String(policyArn),
+		}); err != nil && !isNotFound(err) {
+			return errors.Wrapf(err, "failed to detach policy %q from IAM group %q", policyArn, group.GroupName)
+		}
+	}
+
+	klog.V(2).Infof("deleting IAM group %q", group.GroupName)
+	if _, err := s.IAM.DeleteGroup(ctx, &iam.DeleteGroupInput{GroupName: aws.String(group.GroupName)}); err != nil && !isNotFound(err) {
+		return errors.Wrapf(err, "failed to delete IAM group %q", group.GroupName)
+	}
+	return nil
+}
+
+// findManagedPolicy returns the customer managed policy with the given name, or nil if it does not exist.
+func (s *Service) findManagedPolicy(ctx context.Context, name string) (*iamtypes.Policy, error) {
+	paginator := iam.NewListPoliciesPaginator(s.IAM, &iam.ListPoliciesInput{Scope: iamtypes.PolicyScopeTypeLocal})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list IAM policies")
+		}
+		for i := range page.Policies {
+			if aws.ToString(page.Policies[i].PolicyName) == name {
+				return &page.Policies[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// policyDocumentToJSON marshals a policy document of the template to JSON.
+func policyDocumentToJSON(document interface{}) (string, error) {
+	b, err := json.Marshal(document)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal IAM policy document")
+	}
+	return string(b), nil
+}
+
+// policyDocumentsEqual compares the URL-encoded policy document returned by IAM with a JSON policy document.
+func policyDocumentsEqual(encoded, document string) (bool, error) {
+	decoded, err := url.QueryUnescape(encoded)
+	if err != nil {
+		return false, err
+	}
+	var existing, desired interface{}
+	if err := json.Unmarshal([]byte(decoded), &existing); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal([]byte(document), &desired); err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(existing, desired), nil
+}
+
+// mergeTags returns the IAM tags of a resource, including the tags of the bootstrap stack.
+func mergeTags(resourceTags []tags.Tag, stackTags map[string]string) []iamtypes.Tag {
+	merged := map[string]string{}
+	for k, v := range stackTags {
+		merged[k] = v
+	}
+	for _, tag := range resourceTags {
+		merged[tag.Key] = tag.Value
+	}
+
+	iamTags := make([]iamtypes.Tag, 0, len(merged))
+	for k, v := range merged {
+		iamTags = append(iamTags, iamtypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+	// Sort so that unit tests can expect a stable order
+	sort.Slice(iamTags, func(i, j int) bool { return *iamTags[i].Key < *iamTags[j].Key })
+	return iamTags
+}
+
+func containsRole(roles []iamtypes.Role, name string) bool {
+	for _, role := range roles {
+		if aws.ToString(role.RoleName) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isNotFound(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "NoSuchEntity"
+	}
+	return false
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	go_cfn "github.com/awslabs/goformation/v4/cloudformation"
+	cfn_iam "github.com/awslabs/goformation/v4/cloudformation/iam"
+	"github.com/awslabs/goformation/v4/cloudformation/tags"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/iam/service/mock_iam"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+)
+
+const (
+	testPolicyArn = "arn:aws:iam::123456789012:policy/controllers"
+)
+
+func testTemplate() go_cfn.Template {
+	template := go_cfn.NewTemplate()
+	template.Resources["Policy"] = &cfn_iam.ManagedPolicy{
+		ManagedPolicyName: "controllers",
+		PolicyDocument:    testPolicyDocument("ec2:DescribeInstances"),
+		Roles:             []string{go_cfn.Ref("Role")},
+	}
+	template.Resources["Role"] = &cfn_iam.Role{
+		RoleName:                 "nodes",
+		AssumeRolePolicyDocument: testPolicyDocument("sts:AssumeRole"),
+		ManagedPolicyArns:        []string{"arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy"},
+		Tags:                     []tags.Tag{{Key: "team", Value: "nodes"}},
+	}
+	template.Resources["InstanceProfile"] = &cfn_iam.InstanceProfile{
+		InstanceProfileName: "nodes",
+		Roles:               []string{go_cfn.Ref("Role")},
+	}
+	return *template
+}
+
+func testPolicyDocument(action string) *iamv1.PolicyDocument {
+	return &iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: []iamv1.StatementEntry{
+			{
+				Effect:   iamv1.EffectAllow,
+				Resource: iamv1.Resources{iamv1.Any},
+				Action:   iamv1.Actions{action},
+			},
+		},
+	}
+}
+
+func encodedPolicyDocument(t *testing.T, action string) *string {
+	t.Helper()
+	document, err := policyDocumentToJSON(testPolicyDocument(action))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return aws.String(url.QueryEscape(document))
+}
+
+func TestServiceReconcileBootstrapResources(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(t *testing.T, m *mock_iam.MockIAMAPIMockRecorder)
+	}{
+		{
+			name: "should create the resources that do not exist",
+			expect: func(t *testing.T, m *mock_iam.MockIAMAPIMockRecorder) {
+				t.Helper()
+				m.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("nodes")}).
+					Return(nil, &iamtypes.NoSuchEntityException{})
+				m.CreateRole(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *iam.CreateRoleInput, _ ...func(*iam.Options)) (*iam.CreateRoleOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.RoleName).To(Equal(aws.String("nodes")))
+						g.Expect(aws.ToString(input.AssumeRolePolicyDocument)).To(ContainSubstring("sts:AssumeRole"))
+						g.Expect(input.Tags).To(Equal([]iamtypes.Tag{
+							{Key: aws.String("owner"), Value: aws.String("capa")},
+							{Key: aws.String("team"), Value: aws.String("nodes")},
+						}))
+						return &iam.CreateRoleOutput{}, nil
+					})
+				m.AttachRolePolicy(gomock.Any(), &iam.AttachRolePolicyInput{
+					RoleName:  aws.String("nodes"),
+					PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy"),
+				}).Return(&iam.AttachRolePolicyOutput{}, nil)
+
+				m.GetInstanceProfile(gomock.Any(), &iam.GetInstanceProfileInput{InstanceProfileName: aws.String("nodes")}).
+					Return(nil, &iamtypes.NoSuchEntityException{})
+				m.CreateInstanceProfile(gomock.Any(), &iam.CreateInstanceProfileInput{InstanceProfileName: aws.String("nodes")}).
+					Return(&iam.CreateInstanceProfileOutput{}, nil)
+				m.AddRoleToInstanceProfile(gomock.Any(), &iam.AddRoleToInstanceProfileInput{
+					InstanceProfileName: aws.String("nodes"),
+					RoleName:            aws.String("nodes"),
+				}).Return(&iam.AddRoleToInstanceProfileOutput{}, nil)
+
+				m.ListPolicies(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&iam.ListPoliciesOutput{}, nil)
+				m.CreatePolicy(gomock.Any(), gomock.Any()).
+					Return(&iam.CreatePolicyOutput{Policy: &iamtypes.Policy{Arn: aws.String(testPolicyArn)}}, nil)
+				m.AttachRolePolicy(gomock.Any(), &iam.AttachRolePolicyInput{
+					RoleName:  aws.String("nodes"),
+					PolicyArn: aws.String(testPolicyArn),
+				}).Return(&iam.AttachRolePolicyOutput{}, nil)
+			},
+		},
+		{
+			name: "should update the existing resources and replace the oldest version of a changed policy",
+			expect: func(t *testing.T, m *mock_iam.MockIAMAPIMockRecorder) {
+				t.Helper()
+				m.GetRole(gomock.Any(), gomock.Any()).
+					Return(&iam.GetRoleOutput{Role: &iamtypes.Role{RoleName: aws.String("nodes")}}, nil)
+				m.UpdateAssumeRolePolicy(gomock.Any(), gomock.Any()).
+					Return(&iam.UpdateAssumeRolePolicyOutput{}, nil)
+				m.TagRole(gomock.Any(), gomock.Any()).
+					Return(&iam.TagRoleOutput{}, nil)
+				m.AttachRolePolicy(gomock.Any(), gomock.Any()).
+					Return(&iam.AttachRolePolicyOutput{}, nil).Times(2)
+
+				m.GetInstanceProfile(gomock.Any(), gomock.Any()).
+					Return(&iam.GetInstanceProfileOutput{InstanceProfile: &iamtypes.InstanceProfile{
+						Roles: []iamtypes.Role{{RoleName: aws.String("nodes")}},
+					}}, nil)
+
+				m.ListPolicies(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&iam.ListPoliciesOutput{Policies: []iamtypes.Policy{
+						{PolicyName: aws.String("controllers"), Arn: aws.String(testPolicyArn), DefaultVersionId: aws.String("v5")},
+					}}, nil)
+				m.GetPolicyVersion(gomock.Any(), &iam.GetPolicyVersionInput{PolicyArn: aws.String(testPolicyArn), VersionId: aws.String("v5")}).
+					Return(&iam.GetPolicyVersionOutput{PolicyVersion: &iamtypes.PolicyVersion{
+						Document: encodedPolicyDocument(t, "ec2:DescribeRegions"),
+					}}, nil)
+				versions := []iamtypes.PolicyVersion{}
+				for i, id := range []string{"v1", "v2", "v3", "v4", "v5"} {
+					versions = append(versions, iamtypes.PolicyVersion{
+						VersionId:        aws.String(id),
+						IsDefaultVersion: id == "v5",
+						CreateDate:       aws.Time(time.Date(2026, 1, i+1, 0, 0, 0, 0, time.UTC)),
+					})
+				}
+				m.ListPolicyVersions(gomock.Any(), gomock.Any()).
+					Return(&iam.ListPolicyVersionsOutput{Versions: versions}, nil)
+				m.DeletePolicyVersion(gomock.Any(), &iam.DeletePolicyVersionInput{PolicyArn: aws.String(testPolicyArn), VersionId: aws.String("v1")}).
+					Return(&iam.DeletePolicyVersionOutput{}, nil)
+				m.CreatePolicyVersion(gomock.Any(), gomock.Any()).
+					Return(&iam.CreatePolicyVersionOutput{}, nil)
+			},
+		},
+		{
+			name: "should not create a new version of an unchanged policy",
+			expect: func(t *testing.T, m *mock_iam.MockIAMAPIMockRecorder) {
+				t.Helper()
+				m.GetRole(gomock.Any(), gomock.Any()).
+					Return(&iam.GetRoleOutput{Role: &iamtypes.Role{RoleName: aws.String("nodes")}}, nil)
+				m.UpdateAssumeRolePolicy(gomock.Any(), gomock.Any()).
+					Return(&iam.UpdateAssumeRolePolicyOutput{}, nil)
+				m.TagRole(gomock.Any(), gomock.Any()).
+					Return(&iam.TagRoleOutput{}, nil)
+				m.AttachRolePolicy(gomock.Any(), gomock.Any()).
+					Return(&iam.AttachRolePolicyOutput{}, nil).Times(2)
+
+				m.GetInstanceProfile(gomock.Any(), gomock.Any()).
+					Return(&iam.GetInstanceProfileOutput{InstanceProfile: &iamtypes.InstanceProfile{
+						Roles: []iamtypes.Role{{RoleName: aws.String("nodes")}},
+					}}, nil)
+
+				m.ListPolicies(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&iam.ListPoliciesOutput{Policies: []iamtypes.Policy{
+						{PolicyName: aws.String("controllers"), Arn: aws.String(testPolicyArn), DefaultVersionId: aws.String("v1")},
+					}}, nil)
+				m.GetPolicyVersion(gomock.Any(), gomock.Any()).
+					Return(&iam.GetPolicyVersionOutput{PolicyVersion: &iamtypes.PolicyVersion{
+						Document: encodedPolicyDocument(t, "ec2:DescribeInstances"),
+					}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			iamMock := mock_iam.NewMockIAMAPI(mockCtrl)
+			tt.expect(t, iamMock.EXPECT())
+
+			s := NewService(iamMock)
+			err := s.ReconcileBootstrapResources(context.TODO(), testTemplate(), map[string]string{"owner": "capa"})
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestServiceDeleteBootstrapResources(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	iamMock := mock_iam.NewMockIAMAPI(mockCtrl)
+	m := iamMock.EXPECT()
+
+	m.ListPolicies(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&iam.ListPoliciesOutput{Policies: []iamtypes.Policy{
+			{PolicyName: aws.String("controllers"), Arn: aws.String(testPolicyArn)},
+		}}, nil)
+	m.ListEntitiesForPolicy(gomock.Any(), gomock.Any()).
+		Return(&iam.ListEntitiesForPolicyOutput{PolicyRoles: []iamtypes.PolicyRole{{RoleName: aws.String("nodes")}}}, nil)
+	m.DetachRolePolicy(gomock.Any(), &iam.DetachRolePolicyInput{RoleName: aws.String("nodes"), PolicyArn: aws.String(testPolicyArn)}).
+		Return(&iam.DetachRolePolicyOutput{}, nil)
+	m.ListPolicyVersions(gomock.Any(), gomock.Any()).
+		Return(&iam.ListPolicyVersionsOutput{Versions: []iamtypes.PolicyVersion{{VersionId: aws.String("v1"), IsDefaultVersion: true}}}, nil)
+	m.DeletePolicy(gomock.Any(), &iam.DeletePolicyInput{PolicyArn: aws.String(testPolicyArn)}).
+		Return(&iam.DeletePolicyOutput{}, nil)
+
+	// The instance profile and the role were already deleted.
+	m.GetInstanceProfile(gomock.Any(), gomock.Any()).
+		Return(nil, &iamtypes.NoSuchEntityException{})
+	m.ListAttachedRolePolicies(gomock.Any(), gomock.Any()).
+		Return(nil, &iamtypes.NoSuchEntityException{})
+
+	s := NewService(iamMock)
+	g.Expect(s.DeleteBootstrapResources(context.TODO(), testTemplate())).To(Succeed())
+}
+
+func TestNewBootstrapResources(t *testing.T) {
+	g := NewWithT(t)
+
+	template := testTemplate()
+	r, err := newBootstrapResources(template)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(r.resolve([]string{go_cfn.Ref("Role"), "existing-role"})).To(Equal([]string{"nodes", "existing-role"}))
+
+	template.Resources["Bucket"] = &cfn_iam.AccessKey{}
+	_, err = newBootstrapResources(template)
+	g.Expect(err).To(HaveOccurred())
+}
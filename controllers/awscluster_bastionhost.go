@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api/util/patch"
+)
+
+// resolveBastionHost sets the AWSBastionHost referenced by the cluster in its scope. A missing bastion host is
+// handled as a disabled one, so that its instance is deleted when the cluster was hosting it.
+func (r *AWSClusterReconciler) resolveBastionHost(ctx context.Context, clusterScope *scope.ClusterScope) error {
+	ref := clusterScope.AWSCluster.Spec.BastionHostRef
+	if ref == nil {
+		return nil
+	}
+
+	bastionHost := &infrav1.AWSBastionHost{}
+	key := client.ObjectKey{Namespace: clusterScope.Namespace(), Name: ref.Name}
+	if err := r.Get(ctx, key, bastionHost); err != nil {
+		if apierrors.IsNotFound(err) {
+			clusterScope.Info("AWSBastionHost not found, the bastion host of the cluster is disabled", "bastionHost", ref.Name)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get AWSBastionHost %s", key)
+	}
+	clusterScope.SetBastionHost(bastionHost)
+
+	if !clusterScope.HostsBastionHost() && bastionHost.Status.VPCID != "" && clusterScope.VPC().ID != "" && bastionHost.Status.VPCID != clusterScope.VPC().ID {
+		r.Recorder.Eventf(clusterScope.AWSCluster, corev1.EventTypeWarning, "BastionHostVPCMismatch",
+			"AWSBastionHost %s is in VPC %s, SSH from it is not allowed in VPC %s", ref.Name, bastionHost.Status.VPCID, clusterScope.VPC().ID)
+	}
+	return nil
+}
+
+// reconcileBastionHostStatus reports the bastion instance and security group of the cluster in the status of the
+// AWSBastionHost it hosts, for the other clusters of its VPC to allow SSH from it.
+func (r *AWSClusterReconciler) reconcileBastionHostStatus(ctx context.Context, clusterScope *scope.ClusterScope) error {
+	if !clusterScope.HostsBastionHost() {
+		return nil
+	}
+
+	bastionHost := clusterScope.BastionHost()
+	patchHelper, err := patch.NewHelper(bastionHost, r.Client)
+	if err != nil {
+		return errors.Wrap(err, "failed to init patch helper")
+	}
+
+	instance := clusterScope.AWSCluster.Status.Bastion
+	bastionHost.Status.Instance = instance.DeepCopy()
+	bastionHost.Status.Ready = clusterScope.Bastion().Enabled && instance != nil && instance.State == infrav1.InstanceStateRunning
+	bastionHost.Status.VPCID = clusterScope.VPC().ID
+	bastionHost.Status.SecurityGroupID = clusterScope.SecurityGroups()[infrav1.SecurityGroupBastion].ID
+
+	return patchHelper.Patch(ctx, bastionHost)
+}
+
+// deleteBastionHostStatus clears the status of the AWSBastionHost hosted by a deleted cluster, once its bastion
+// instance is deleted.
+func (r *AWSClusterReconciler) deleteBastionHostStatus(ctx context.Context, clusterScope *scope.ClusterScope) error {
+	if err := r.resolveBastionHost(ctx, clusterScope); err != nil {
+		return err
+	}
+	if !clusterScope.HostsBastionHost() {
+		return nil
+	}
+
+	bastionHost := clusterScope.BastionHost()
+	patchHelper, err := patch.NewHelper(bastionHost, r.Client)
+	if err != nil {
+		return errors.Wrap(err, "failed to init patch helper")
+	}
+	bastionHost.Status = infrav1.AWSBastionHostStatus{}
+
+	return patchHelper.Patch(ctx, bastionHost)
+}
+
+// requeueAWSClustersForBastionHost maps an AWSBastionHost to the AWSClusters referencing it, so that the cluster
+// hosting it reconciles its instance and the other clusters allow SSH from its security group.
+func (r *AWSClusterReconciler) requeueAWSClustersForBastionHost(log logger.Wrapper) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []ctrl.Request {
+		bastionHost, ok := o.(*infrav1.AWSBastionHost)
+		if !ok {
+			klog.Errorf("Expected an AWSBastionHost but got a %T", o)
+			return nil
+		}
+
+		log := log.WithValues("objectMapper", "bastionHostToAWSCluster", "bastionHost", klog.KObj(bastionHost))
+
+		awsClusters := &infrav1.AWSClusterList{}
+		if err := r.List(ctx, awsClusters, client.InNamespace(bastionHost.Namespace)); err != nil {
+			log.Error(err, "Failed to list AWS clusters")
+			return nil
+		}
+
+		var requests []ctrl.Request
+		for _, awsCluster := range awsClusters.Items {
+			if ref := awsCluster.Spec.BastionHostRef; ref == nil || ref.Name != bastionHost.Name {
+				continue
+			}
+			log.Trace("Adding request.", "awsCluster", awsCluster.Name)
+			requests = append(requests, ctrl.Request{
+				NamespacedName: client.ObjectKey{Namespace: awsCluster.Namespace, Name: awsCluster.Name},
+			})
+		}
+		return requests
+	}
+}
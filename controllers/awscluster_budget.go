@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/budgets"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func (r *AWSClusterReconciler) getBudgetService(scope scope.BudgetScope) services.BudgetInterface {
+	if r.budgetServiceFactory != nil {
+		return r.budgetServiceFactory(scope)
+	}
+
+	return budgets.NewService(scope)
+}
+
+// reconcileBudget reconciles the AWS Budget of the cluster and reports in the BudgetWithinLimit condition whether
+// its actual or forecasted spend exceeds the limit. AWS Budgets is only called once the budget is set, so that
+// clusters not using it do not require the AWS Budgets permissions.
+// Failing to reconcile the budget does not prevent the cluster from being provisioned.
+func (r *AWSClusterReconciler) reconcileBudget(ctx context.Context, clusterScope *scope.ClusterScope) {
+	awsCluster := clusterScope.AWSCluster
+	if clusterScope.Budget() == nil && clusterScope.BudgetStatus() == nil && !conditions.Has(awsCluster, infrav1.BudgetWithinLimitCondition) {
+		return
+	}
+
+	if err := r.getBudgetService(clusterScope).ReconcileBudget(ctx); err != nil {
+		clusterScope.Error(err, "non-fatal: failed to reconcile AWS Budget")
+		r.Recorder.Eventf(awsCluster, corev1.EventTypeWarning, "FailedReconcileBudget", "Failed to reconcile AWS Budget: %v", err)
+		conditions.MarkFalse(awsCluster, infrav1.BudgetWithinLimitCondition, infrav1.BudgetReconciliationFailedReason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return
+	}
+
+	budget, status := clusterScope.Budget(), clusterScope.BudgetStatus()
+	if budget == nil {
+		conditions.Delete(awsCluster, infrav1.BudgetWithinLimitCondition)
+		return
+	}
+
+	limit := parseSpend(budget.LimitAmount)
+	switch {
+	case status != nil && parseSpend(status.ActualSpend) >= limit:
+		// The event is only recorded when the limit is first exceeded, not on every reconciliation.
+		if conditions.GetReason(awsCluster, infrav1.BudgetWithinLimitCondition) != infrav1.BudgetExceededReason {
+			r.Recorder.Eventf(awsCluster, corev1.EventTypeWarning, "BudgetExceeded", "Cost of the cluster %s USD exceeds the limit of its AWS Budget %s USD", status.ActualSpend, budget.LimitAmount)
+		}
+		conditions.MarkFalse(awsCluster, infrav1.BudgetWithinLimitCondition, infrav1.BudgetExceededReason, clusterv1.ConditionSeverityWarning,
+			"cost %s USD exceeds the limit of %s USD", status.ActualSpend, budget.LimitAmount)
+	case status != nil && parseSpend(status.ForecastedSpend) >= limit:
+		conditions.MarkFalse(awsCluster, infrav1.BudgetWithinLimitCondition, infrav1.BudgetForecastExceededReason, clusterv1.ConditionSeverityInfo,
+			"forecasted cost %s USD exceeds the limit of %s USD", status.ForecastedSpend, budget.LimitAmount)
+	default:
+		conditions.MarkTrue(awsCluster, infrav1.BudgetWithinLimitCondition)
+	}
+}
+
+// deleteBudget deletes the AWS Budget of the cluster, if it was ever set.
+func (r *AWSClusterReconciler) deleteBudget(ctx context.Context, clusterScope *scope.ClusterScope) error {
+	if clusterScope.Budget() == nil && clusterScope.BudgetStatus() == nil {
+		return nil
+	}
+
+	return r.getBudgetService(clusterScope).DeleteBudget(ctx)
+}
+
+// parseSpend parses an amount reported by AWS Budgets. An amount not calculated yet is 0.
+func parseSpend(amount string) float64 {
+	value, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
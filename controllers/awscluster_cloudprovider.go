@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/cloudprovider"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// cloudProviderConfigRequeueAfter is how long to wait for the control plane to be initialized before writing the
+// cloud provider configuration to the workload cluster.
+const cloudProviderConfigRequeueAfter = 30 * time.Second
+
+func (r *AWSClusterReconciler) getCloudProviderConfigService(scope scope.CloudProviderConfigScope) services.CloudProviderConfigInterface {
+	if r.cloudProviderConfigServiceFactory != nil {
+		return r.cloudProviderConfigServiceFactory(scope)
+	}
+
+	return cloudprovider.NewService(scope)
+}
+
+// reconcileCloudProviderConfig reconciles the cloud provider configuration of the workload cluster, once its control
+// plane is initialized. It returns how long to wait before checking the control plane again, if it is not initialized.
+// Failing to reconcile the configuration does not prevent the cluster from being provisioned.
+func (r *AWSClusterReconciler) reconcileCloudProviderConfig(ctx context.Context, clusterScope *scope.ClusterScope) time.Duration {
+	awsCluster := clusterScope.AWSCluster
+	if clusterScope.CloudProviderConfig() == nil && !conditions.Has(awsCluster, infrav1.CloudProviderConfigReadyCondition) {
+		return 0
+	}
+
+	if !conditions.IsTrue(clusterScope.Cluster, clusterv1.ControlPlaneInitializedCondition) {
+		if clusterScope.CloudProviderConfig() == nil {
+			conditions.Delete(awsCluster, infrav1.CloudProviderConfigReadyCondition)
+			return 0
+		}
+		conditions.MarkFalse(awsCluster, infrav1.CloudProviderConfigReadyCondition, infrav1.WaitingForControlPlaneInitializationReason, clusterv1.ConditionSeverityInfo, "")
+		return cloudProviderConfigRequeueAfter
+	}
+
+	if err := r.getCloudProviderConfigService(clusterScope).ReconcileCloudProviderConfig(ctx); err != nil {
+		clusterScope.Error(err, "non-fatal: failed to reconcile cloud provider configuration")
+		r.Recorder.Eventf(awsCluster, corev1.EventTypeWarning, "FailedReconcileCloudProviderConfig", "Failed to reconcile cloud provider configuration: %v", err)
+		conditions.MarkFalse(awsCluster, infrav1.CloudProviderConfigReadyCondition, infrav1.CloudProviderConfigReconciliationFailedReason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return 0
+	}
+
+	if clusterScope.CloudProviderConfig() == nil {
+		conditions.Delete(awsCluster, infrav1.CloudProviderConfigReadyCondition)
+		return 0
+	}
+	conditions.MarkTrue(awsCluster, infrav1.CloudProviderConfigReadyCondition)
+	return 0
+}
@@ -19,6 +19,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/pkg/errors"
@@ -37,13 +38,18 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/adoption"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/cloudwatchlogs"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/elb"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/gc"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/guardduty"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/route53"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/securitygroup"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
@@ -58,6 +64,10 @@ import (
 
 const (
 	deleteRequeueAfter = 20 * time.Second
+
+	// pendingDisruptiveActionsRequeueAfter is how long to wait before applying the pending disruptive actions
+	// when there is no maintenance window to wait for, e.g. when it was removed after the actions were deferred.
+	pendingDisruptiveActionsRequeueAfter = 1 * time.Minute
 )
 
 var defaultAWSSecurityGroupRoles = []infrav1.SecurityGroupRole{
@@ -70,16 +80,48 @@ var defaultAWSSecurityGroupRoles = []infrav1.SecurityGroupRole{
 // AWSClusterReconciler reconciles a AwsCluster object.
 type AWSClusterReconciler struct {
 	client.Client
-	Recorder                     record.EventRecorder
-	ec2ServiceFactory            func(scope.EC2Scope) services.EC2Interface
-	networkServiceFactory        func(scope.ClusterScope) services.NetworkInterface
-	elbServiceFactory            func(scope.ELBScope) services.ELBInterface
-	securityGroupFactory         func(scope.ClusterScope) services.SecurityGroupInterface
-	Endpoints                    []scope.ServiceEndpoint
-	WatchFilterValue             string
-	ExternalResourceGC           bool
-	AlternativeGCStrategy        bool
-	TagUnmanagedNetworkResources bool
+	Recorder                          record.EventRecorder
+	ec2ServiceFactory                 func(scope.EC2Scope) services.EC2Interface
+	networkServiceFactory             func(scope.ClusterScope) services.NetworkInterface
+	elbServiceFactory                 func(scope.ELBScope) services.ELBInterface
+	securityGroupFactory              func(scope.ClusterScope) services.SecurityGroupInterface
+	observabilityServiceFactory       func(scope.ObservabilityScope) services.ObservabilityInterface
+	budgetServiceFactory              func(scope.BudgetScope) services.BudgetInterface
+	cloudProviderConfigServiceFactory func(scope.CloudProviderConfigScope) services.CloudProviderConfigInterface
+	privateDNSServiceFactory          func(scope.PrivateDNSScope) services.PrivateDNSInterface
+	cloudWatchLogsServiceFactory      func(cloud.ClusterScoper) services.CloudWatchLogsInterface
+	sessionManagerServiceFactory      func(cloud.ClusterScoper) services.SessionManagerInterface
+	guarddutyServiceFactory           func(*scope.ClusterScope) services.GuardDutyInterface
+	quotaServiceFactory               func(cloud.ClusterScoper) services.QuotaInterface
+	Endpoints                         []scope.ServiceEndpoint
+	WatchFilterValue                  string
+	ExternalResourceGC                bool
+	AlternativeGCStrategy             bool
+	TagUnmanagedNetworkResources      bool
+}
+
+func (r *AWSClusterReconciler) getPrivateDNSService(scope scope.PrivateDNSScope) services.PrivateDNSInterface {
+	if r.privateDNSServiceFactory != nil {
+		return r.privateDNSServiceFactory(scope)
+	}
+
+	return route53.NewService(scope)
+}
+
+func (r *AWSClusterReconciler) getCloudWatchLogsService(scope cloud.ClusterScoper) services.CloudWatchLogsInterface {
+	if r.cloudWatchLogsServiceFactory != nil {
+		return r.cloudWatchLogsServiceFactory(scope)
+	}
+
+	return cloudwatchlogs.NewService(scope)
+}
+
+// getGuardDutyService factory func is added for testing purpose so that we can inject mocked GuardDutyService to the AWSClusterReconciler.
+func (r *AWSClusterReconciler) getGuardDutyService(scope *scope.ClusterScope) services.GuardDutyInterface {
+	if r.guarddutyServiceFactory != nil {
+		return r.guarddutyServiceFactory(scope)
+	}
+	return guardduty.NewService(scope)
 }
 
 // getEC2Service factory func is added for testing purpose so that we can inject mocked EC2Service to the AWSClusterReconciler.
@@ -115,9 +157,40 @@ func securityGroupRolesForCluster(scope scope.ClusterScope) []infrav1.SecurityGr
 	if scope.Bastion().Enabled {
 		roles = append(roles, infrav1.SecurityGroupBastion)
 	}
+
+	if endpoint := scope.InstanceConnectEndpoint(); endpoint != nil && endpoint.Enabled {
+		roles = append(roles, infrav1.SecurityGroupInstanceConnectEndpoint)
+	}
+
+	// The VPC endpoint of the GuardDuty data service is only created in managed VPCs.
+	if guardDuty := scope.GuardDutyRuntimeMonitoring(); guardDuty != nil && guardDuty.Enabled && scope.VPC().IsManaged(scope.Name()) {
+		roles = append(roles, infrav1.SecurityGroupGuardDutyEndpoint)
+	}
+
+	// The API server load balancer security group is not needed when all the control plane
+	// load balancers use existing security groups.
+	if controlPlaneLoadBalancersUseExistingSecurityGroups(scope) {
+		roles = slices.DeleteFunc(roles, func(role infrav1.SecurityGroupRole) bool {
+			return role == infrav1.SecurityGroupAPIServerLB
+		})
+	}
 	return roles
 }
 
+// controlPlaneLoadBalancersUseExistingSecurityGroups returns true if all the control plane load balancers
+// of the cluster are attached to existing security groups instead of the API server load balancer security group.
+func controlPlaneLoadBalancersUseExistingSecurityGroups(scope scope.ClusterScope) bool {
+	if scope.ControlPlaneLoadBalancer() == nil {
+		return false
+	}
+	for _, lb := range scope.ControlPlaneLoadBalancers() {
+		if lb != nil && len(lb.SecurityGroupIDs) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // getSecurityGroupService factory func is added for testing purpose so that we can inject mocked SecurityGroupService to the AWSClusterReconciler.
 func (r *AWSClusterReconciler) getSecurityGroupService(scope scope.ClusterScope) services.SecurityGroupInterface {
 	if r.securityGroupFactory != nil {
@@ -131,6 +204,9 @@ func (r *AWSClusterReconciler) getSecurityGroupService(scope scope.ClusterScope)
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusterroleidentities;awsclusterstaticidentities,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclustercontrolleridentities,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinetemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsbastionhosts,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsbastionhosts/status,verbs=get;update;patch
 
 func (r *AWSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	log := logger.FromContext(ctx)
@@ -192,11 +268,13 @@ func (r *AWSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	// Handle deleted clusters
 	if !awsCluster.DeletionTimestamp.IsZero() {
-		return r.reconcileDelete(ctx, clusterScope)
+		result, err := r.reconcileDelete(ctx, clusterScope)
+		return scope.RequeueOnWriteFreeze(awsCluster, result, err)
 	}
 
 	// Handle non-deleted clusters
-	return r.reconcileNormal(ctx, clusterScope)
+	result, err := r.reconcileNormal(ctx, clusterScope)
+	return scope.RequeueOnWriteFreeze(awsCluster, result, err)
 }
 
 func (r *AWSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope *scope.ClusterScope) (ctrl.Result, error) {
@@ -207,6 +285,13 @@ func (r *AWSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope
 
 	clusterScope.Info("Reconciling AWSCluster delete")
 
+	// The resources of a cluster that was never adopted were not created by this provider, leave them untouched.
+	if clusterScope.AWSCluster.Spec.Adoption != nil && !conditions.IsTrue(clusterScope.AWSCluster, infrav1.ClusterAdoptedCondition) {
+		clusterScope.Info("AWSCluster was never adopted, skipping the deletion of its AWS resources")
+		controllerutil.RemoveFinalizer(clusterScope.AWSCluster, infrav1.ClusterFinalizer)
+		return reconcile.Result{}, nil
+	}
+
 	numDependencies, err := r.dependencyCount(ctx, clusterScope)
 	if err != nil {
 		clusterScope.Error(err, "error getting AWSCluster dependencies")
@@ -247,12 +332,46 @@ func (r *AWSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope
 		allErrs = append(allErrs, errors.Wrapf(err, "error deleting S3 Bucket"))
 	}
 
+	if err := r.deleteObservability(ctx, clusterScope); err != nil {
+		allErrs = append(allErrs, errors.Wrap(err, "error deleting CloudWatch alarms and dashboard"))
+	}
+
+	if err := r.deleteBudget(ctx, clusterScope); err != nil {
+		allErrs = append(allErrs, errors.Wrap(err, "error deleting AWS Budget"))
+	}
+
+	if err := r.deleteSessionManager(clusterScope); err != nil {
+		allErrs = append(allErrs, errors.Wrap(err, "error deleting Session Manager preferences"))
+	}
+
+	if clusterScope.PrivateDNS() != nil {
+		if err := r.getPrivateDNSService(clusterScope).DeletePrivateDNS(); err != nil {
+			allErrs = append(allErrs, errors.Wrap(err, "error deleting private DNS record"))
+		}
+	}
+
+	if feature.Gates.Enabled(feature.MachineCloudWatchLogs) {
+		if err := r.getCloudWatchLogsService(clusterScope).DeleteLogGroups(); err != nil {
+			allErrs = append(allErrs, errors.Wrap(err, "error deleting CloudWatch log groups"))
+		}
+	}
+
 	if err := elbsvc.DeleteLoadbalancers(); err != nil {
 		allErrs = append(allErrs, errors.Wrapf(err, "error deleting load balancers"))
 	}
 
 	if err := ec2svc.DeleteBastion(); err != nil {
 		allErrs = append(allErrs, errors.Wrapf(err, "error deleting bastion"))
+	} else if err := r.deleteBastionHostStatus(ctx, clusterScope); err != nil {
+		allErrs = append(allErrs, errors.Wrap(err, "error clearing AWSBastionHost status"))
+	}
+
+	if err := ec2svc.DeleteInstanceConnectEndpoint(); err != nil {
+		allErrs = append(allErrs, errors.Wrap(err, "error deleting EC2 Instance Connect Endpoint"))
+	}
+
+	if err := r.getGuardDutyService(clusterScope).DeleteRuntimeMonitoring(); err != nil {
+		allErrs = append(allErrs, errors.Wrap(err, "error deleting GuardDuty Runtime Monitoring"))
 	}
 
 	if err := sgService.DeleteSecurityGroups(); err != nil {
@@ -303,8 +422,19 @@ func (r *AWSClusterReconciler) reconcileLoadBalancer(clusterScope *scope.Cluster
 
 	conditions.MarkTrue(awsCluster, infrav1.LoadBalancerReadyCondition)
 
+	host := awsCluster.Status.Network.APIServerELB.DNSName
+	if awsCluster.Spec.PrivateDNS != nil {
+		if err := r.getPrivateDNSService(clusterScope).ReconcilePrivateDNS(); err != nil {
+			clusterScope.Error(err, "failed to reconcile private DNS record")
+			conditions.MarkFalse(awsCluster, infrav1.PrivateDNSReadyCondition, infrav1.PrivateDNSFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), "%s", err.Error())
+			return nil, err
+		}
+		conditions.MarkTrue(awsCluster, infrav1.PrivateDNSReadyCondition)
+		host = awsCluster.Spec.PrivateDNS.Name
+	}
+
 	awsCluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{
-		Host: awsCluster.Status.Network.APIServerELB.DNSName,
+		Host: host,
 		Port: clusterScope.APIServerPort(),
 	}
 
@@ -324,11 +454,48 @@ func (r *AWSClusterReconciler) reconcileNormal(ctx context.Context, clusterScope
 		}
 	}
 
+	if awsCluster.Spec.Adoption != nil && !conditions.IsTrue(awsCluster, infrav1.ClusterAdoptedCondition) {
+		if err := adoption.NewService(clusterScope).ReconcileAdoption(ctx); err != nil {
+			clusterScope.Error(err, "failed to reconcile adoption")
+			conditions.MarkFalse(awsCluster, infrav1.ClusterAdoptedCondition, infrav1.AdoptionFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return reconcile.Result{}, err
+		}
+		if awsCluster.Spec.Adoption.Mode != infrav1.AdoptionModeAdopt {
+			// Nothing is changed until the adoption is confirmed, the pending changes are reported in the status.
+			conditions.MarkFalse(awsCluster, infrav1.ClusterAdoptedCondition, infrav1.AdoptionDryRunReason, clusterv1.ConditionSeverityInfo,
+				"%d changes pending, set spec.adoption.mode to Adopt to apply them", len(awsCluster.Status.Adoption.Changes))
+			return reconcile.Result{}, nil
+		}
+		conditions.MarkTrue(awsCluster, infrav1.ClusterAdoptedCondition)
+	}
+
+	if feature.Gates.Enabled(feature.PreflightChecks) {
+		r.reconcilePreflightChecks(ctx, clusterScope)
+	}
+
+	var resourceLimitsRequeueAfter time.Duration
+	if feature.Gates.Enabled(feature.ResourceLimitsReporting) {
+		resourceLimitsRequeueAfter = r.reconcileResourceLimits(ctx, clusterScope)
+	}
+
+	if err := r.resolveBastionHost(ctx, clusterScope); err != nil {
+		clusterScope.Error(err, "failed to resolve bastion host")
+		return reconcile.Result{}, err
+	}
+
 	ec2Service := r.getEC2Service(clusterScope)
 	networkSvc := r.getNetworkService(*clusterScope)
 	sgService := r.getSecurityGroupService(*clusterScope)
 	s3Service := s3.NewService(clusterScope)
 
+	// The region is validated until the cluster is ready, whether or not the preflight checks are enabled.
+	if !awsCluster.Status.Ready {
+		if err := ec2Service.ValidateRegion(); err != nil {
+			clusterScope.Error(err, "failed to validate region")
+			return reconcile.Result{}, err
+		}
+	}
+
 	if err := networkSvc.ReconcileNetwork(); err != nil {
 		clusterScope.Error(err, "failed to reconcile network")
 		return reconcile.Result{}, err
@@ -346,6 +513,23 @@ func (r *AWSClusterReconciler) reconcileNormal(ctx context.Context, clusterScope
 		return reconcile.Result{}, err
 	}
 
+	if err := r.reconcileBastionHostStatus(ctx, clusterScope); err != nil {
+		clusterScope.Error(err, "failed to update AWSBastionHost status")
+		return reconcile.Result{}, err
+	}
+
+	if err := ec2Service.ReconcileInstanceConnectEndpoint(); err != nil {
+		conditions.MarkFalse(awsCluster, infrav1.InstanceConnectEndpointReadyCondition, infrav1.InstanceConnectEndpointFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), "%s", err.Error())
+		clusterScope.Error(err, "failed to reconcile EC2 Instance Connect Endpoint")
+		return reconcile.Result{}, err
+	}
+
+	if err := r.getGuardDutyService(clusterScope).ReconcileRuntimeMonitoring(); err != nil {
+		conditions.MarkFalse(awsCluster, infrav1.GuardDutyRuntimeMonitoringReadyCondition, infrav1.GuardDutyEndpointFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), "%s", err.Error())
+		clusterScope.Error(err, "failed to reconcile GuardDuty Runtime Monitoring")
+		return reconcile.Result{}, err
+	}
+
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
 		instancestateSvc := instancestate.NewService(clusterScope)
 		if err := instancestateSvc.ReconcileEC2Events(); err != nil {
@@ -366,22 +550,50 @@ func (r *AWSClusterReconciler) reconcileNormal(ctx context.Context, clusterScope
 	}
 	conditions.MarkTrue(awsCluster, infrav1.S3BucketReadyCondition)
 
-	for _, subnet := range clusterScope.Subnets().FilterPrivate() {
-		found := false
-		for _, az := range awsCluster.Status.Network.APIServerELB.AvailabilityZones {
-			if az == subnet.AvailabilityZone {
-				found = true
-				break
-			}
-		}
+	r.reconcileObservability(ctx, clusterScope)
+	r.reconcileBudget(ctx, clusterScope)
+	r.reconcileSessionManager(clusterScope)
+	configRequeueAfter := r.reconcileCloudProviderConfig(ctx, clusterScope)
 
-		clusterScope.SetFailureDomain(subnet.AvailabilityZone, clusterv1.FailureDomainSpec{
-			ControlPlane: found,
-		})
-	}
+	controlPlaneZones := awsCluster.Spec.ControlPlanePlacement.Zones(clusterScope.Subnets(), awsCluster.Status.Network.APIServerELB.AvailabilityZones)
+	clusterScope.SetFailureDomains(awsCluster.Spec.FailureDomains.FailureDomains(clusterScope.Subnets(), controlPlaneZones))
 
 	awsCluster.Status.Ready = true
-	return reconcile.Result{}, nil
+
+	requeueAfter := minRequeueAfter(configRequeueAfter, resourceLimitsRequeueAfter)
+	if len(awsCluster.Status.PendingDisruptiveActions) > 0 {
+		actionsRequeueAfter := disruptiveActionsRequeueAfter(awsCluster.Spec.MaintenanceWindow, time.Now())
+		clusterScope.Info("Disruptive actions are deferred until the maintenance window opens", "pending-actions", len(awsCluster.Status.PendingDisruptiveActions), "requeue-after", actionsRequeueAfter)
+		// The cloud provider configuration and the resource limits are still refreshed while the actions wait for the
+		// maintenance window.
+		requeueAfter = minRequeueAfter(actionsRequeueAfter, requeueAfter)
+	}
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// minRequeueAfter returns the shortest of the given durations, ignoring the zero durations which do not ask for a
+// requeue.
+func minRequeueAfter(durations ...time.Duration) time.Duration {
+	var requeueAfter time.Duration
+	for _, d := range durations {
+		if d > 0 && (requeueAfter == 0 || d < requeueAfter) {
+			requeueAfter = d
+		}
+	}
+	return requeueAfter
+}
+
+// disruptiveActionsRequeueAfter returns how long to wait before applying the pending disruptive actions: until the
+// maintenance window opens next. Without a maintenance window, or when it never opens, the actions can be applied now
+// and are retried after pendingDisruptiveActionsRequeueAfter, as NextOpening returns the given time.
+func disruptiveActionsRequeueAfter(window *infrav1.MaintenanceWindow, now time.Time) time.Duration {
+	if window == nil {
+		return pendingDisruptiveActionsRequeueAfter
+	}
+	if next := window.NextOpening(now); next.After(now) {
+		return next.Sub(now)
+	}
+	return pendingDisruptiveActionsRequeueAfter
 }
 
 func (r *AWSClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -396,6 +608,13 @@ func (r *AWSClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 		return errors.Wrap(err, "error creating controller")
 	}
 
+	if err := controller.Watch(
+		source.Kind[client.Object](mgr.GetCache(), &infrav1.AWSBastionHost{},
+			handler.EnqueueRequestsFromMapFunc(r.requeueAWSClustersForBastionHost(log)),
+		)); err != nil {
+		return errors.Wrap(err, "failed adding a watch for AWSBastionHosts")
+	}
+
 	return controller.Watch(
 		source.Kind[client.Object](mgr.GetCache(), &clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(r.requeueAWSClusterForUnpausedCluster(ctx, log)),
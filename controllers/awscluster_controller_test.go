@@ -77,6 +77,7 @@ func TestAWSClusterReconcilerIntegrationTests(t *testing.T) {
 			mockedCreateSGCalls(false, "vpc-exists", m)
 			mockedDescribeInstanceCall(m)
 			mockedDescribeAvailabilityZones(m, []string{"us-east-1c", "us-east-1a"})
+			mockedDescribeRegionCall(m)
 
 			// Second iteration: the AWS Cluster object has been patched,
 			// thus a valid Control Plane Endpoint has been provided
@@ -192,6 +193,7 @@ func TestAWSClusterReconcilerIntegrationTests(t *testing.T) {
 			mockedCreateLBCalls(t, e, true)
 			mockedDescribeInstanceCall(m)
 			mockedDescribeAvailabilityZones(m, []string{"us-east-1c", "us-east-1a"})
+			mockedDescribeRegionCall(m)
 		}
 
 		expect(ec2Mock.EXPECT(), elbMock.EXPECT())
@@ -303,6 +305,7 @@ func TestAWSClusterReconcilerIntegrationTests(t *testing.T) {
 			mockedCreateLBV2Calls(t, e)
 			mockedDescribeInstanceCall(m)
 			mockedDescribeAvailabilityZones(m, []string{"us-east-1c", "us-east-1a"})
+			mockedDescribeRegionCall(m)
 			mockedDescribeTargetGroupsCall(t, e)
 			mockedCreateTargetGroupCall(t, e)
 			mockedModifyTargetGroupAttributes(t, e)
@@ -396,6 +399,7 @@ func TestAWSClusterReconcilerIntegrationTests(t *testing.T) {
 			mockedCreateSGCalls(false, "vpc-new", m)
 			mockedDescribeInstanceCall(m)
 			mockedDescribeAvailabilityZones(m, []string{"us-east-1a"})
+			mockedDescribeRegionCall(m)
 		}
 
 		expect(ec2Mock.EXPECT(), elbMock.EXPECT())
@@ -505,6 +509,7 @@ func TestAWSClusterReconcilerIntegrationTests(t *testing.T) {
 		elbv2Mock := mocks.NewMockELBV2API(mockCtrl)
 		elbMock := mocks.NewMockELBAPI(mockCtrl)
 		expect := func(m *mocks.MockEC2APIMockRecorder, ev2 *mocks.MockELBV2APIMockRecorder, e *mocks.MockELBAPIMockRecorder) {
+			mockedDescribeRegionCall(m)
 			mockedCreateMaximumVPCCalls(m)
 			mockedDeleteVPCCallsForNonExistentVPC(m)
 			mockedDeleteLBCalls(true, ev2, e)
@@ -686,6 +691,26 @@ func mockedDescribeAvailabilityZones(m *mocks.MockEC2APIMockRecorder, zones []st
 		Return(output, nil)
 }
 
+func mockedDescribeRegionCall(m *mocks.MockEC2APIMockRecorder) {
+	m.DescribeRegionsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeRegionsInput{
+		AllRegions: aws.Bool(true),
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("region-name"),
+				Values: aws.StringSlice([]string{"us-east-1"}),
+			},
+		},
+	})).AnyTimes().
+		Return(&ec2.DescribeRegionsOutput{
+			Regions: []*ec2.Region{
+				{
+					RegionName:  aws.String("us-east-1"),
+					OptInStatus: aws.String("opt-in-not-required"),
+				},
+			},
+		}, nil)
+}
+
 func createControllerIdentity(g *WithT) *infrav1.AWSClusterControllerIdentity {
 	controllerIdentity := &infrav1.AWSClusterControllerIdentity{
 		TypeMeta: metav1.TypeMeta{
@@ -935,6 +960,22 @@ func mockedCallsForMissingEverything(m *mocks.MockEC2APIMockRecorder, e *mocks.M
 			},
 		},
 	})).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{}}, nil)
+	describeVPCByOwnerCall := m.DescribeVpcsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeVpcsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{ec2.VpcStatePending, ec2.VpcStateAvailable}),
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: aws.StringSlice([]string{"owned"}),
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"),
+				Values: aws.StringSlice([]string{"common"}),
+			},
+		},
+	})).After(describeVPCByNameCall).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{}}, nil)
 	m.CreateVpcWithContext(context.TODO(), gomock.Eq(&ec2.CreateVpcInput{
 		CidrBlock: aws.String("10.0.0.0/8"),
 		TagSpecifications: []*ec2.TagSpecification{
@@ -956,7 +997,7 @@ func mockedCallsForMissingEverything(m *mocks.MockEC2APIMockRecorder, e *mocks.M
 				},
 			},
 		},
-	})).After(describeVPCByNameCall).Return(&ec2.CreateVpcOutput{
+	})).After(describeVPCByOwnerCall).Return(&ec2.CreateVpcOutput{
 		Vpc: &ec2.Vpc{
 			State:     aws.String("available"),
 			VpcId:     aws.String("vpc-new"),
@@ -1432,7 +1473,23 @@ func mockedCreateMaximumVPCCalls(m *mocks.MockEC2APIMockRecorder) {
 	describeVPCByNameCall := m.DescribeVpcsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcsInput{})).Return(&ec2.DescribeVpcsOutput{
 		Vpcs: []*ec2.Vpc{},
 	}, nil)
-	m.CreateVpcWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcInput{})).After(describeVPCByNameCall).Return(nil, errors.New("The maximum number of VPCs has been reached"))
+	describeVPCByOwnerCall := m.DescribeVpcsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeVpcsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{ec2.VpcStatePending, ec2.VpcStateAvailable}),
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: aws.StringSlice([]string{"owned"}),
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"),
+				Values: aws.StringSlice([]string{"common"}),
+			},
+		},
+	})).After(describeVPCByNameCall).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{}}, nil)
+	m.CreateVpcWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcInput{})).After(describeVPCByOwnerCall).Return(nil, errors.New("The maximum number of VPCs has been reached"))
 }
 
 func mockedDeleteVPCCallsForNonExistentVPC(m *mocks.MockEC2APIMockRecorder) {
@@ -24,10 +24,12 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -35,11 +37,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/quota"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func TestAWSClusterReconcilerReconcile(t *testing.T) {
@@ -139,6 +145,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 		elbSvc     *mock_services.MockELBInterface
 		networkSvc *mock_services.MockNetworkInterface
 		sgSvc      *mock_services.MockSecurityGroupInterface
+		gdSvc      *mock_services.MockGuardDutyInterface
 		recorder   *record.FakeRecorder
 		ctx        context.Context
 	)
@@ -164,6 +171,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 		elbSvc = mock_services.NewMockELBInterface(mockCtrl)
 		networkSvc = mock_services.NewMockNetworkInterface(mockCtrl)
 		sgSvc = mock_services.NewMockSecurityGroupInterface(mockCtrl)
+		gdSvc = mock_services.NewMockGuardDutyInterface(mockCtrl)
 
 		recorder = record.NewFakeRecorder(2)
 
@@ -181,6 +189,9 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 			securityGroupFactory: func(clusterScope scope.ClusterScope) services.SecurityGroupInterface {
 				return sgSvc
 			},
+			guarddutyServiceFactory: func(clusterScope *scope.ClusterScope) services.GuardDutyInterface {
+				return gdSvc
+			},
 			Recorder: recorder,
 		}
 		return csClient
@@ -196,7 +207,10 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g := NewWithT(t)
 				runningCluster := func() {
 					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+					ec2Svc.EXPECT().ReconcileInstanceConnectEndpoint().Return(nil)
+					gdSvc.EXPECT().ReconcileRuntimeMonitoring().Return(nil)
 					elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil)
+					ec2Svc.EXPECT().ValidateRegion().Return(nil)
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
 				}
@@ -252,7 +266,10 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g := NewWithT(t)
 				runningCluster := func() {
 					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+					ec2Svc.EXPECT().ReconcileInstanceConnectEndpoint().Return(nil)
+					gdSvc.EXPECT().ReconcileRuntimeMonitoring().Return(nil)
 					elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil)
+					ec2Svc.EXPECT().ValidateRegion().Return(nil)
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
 				}
@@ -280,10 +297,32 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 		})
 		t.Run("Reconcile failure", func(t *testing.T) {
 			expectedErr := errors.New("failed to get resource")
+			t.Run("Should fail AWSCluster create when the region is not enabled", func(t *testing.T) {
+				g := NewWithT(t)
+				awsCluster := getAWSCluster("test", "test")
+				regionErr := errors.New("region us-east-1 is an opt-in region not enabled for the account")
+				runningCluster := func() {
+					ec2Svc.EXPECT().ValidateRegion().Return(regionErr)
+				}
+				csClient := setup(t, &awsCluster)
+				defer teardown()
+				runningCluster()
+				cs, err := scope.NewClusterScope(
+					scope.ClusterScopeParams{
+						Client:     csClient,
+						Cluster:    &clusterv1.Cluster{},
+						AWSCluster: &awsCluster,
+					},
+				)
+				g.Expect(err).To(BeNil())
+				_, err = reconciler.reconcileNormal(context.TODO(), cs)
+				g.Expect(err).Should(Equal(regionErr))
+			})
 			t.Run("Should fail AWSCluster create with reconcile network failure", func(t *testing.T) {
 				g := NewWithT(t)
 				awsCluster := getAWSCluster("test", "test")
 				runningCluster := func() {
+					ec2Svc.EXPECT().ValidateRegion().Return(nil)
 					networkSvc.EXPECT().ReconcileNetwork().Return(expectedErr)
 				}
 				csClient := setup(t, &awsCluster)
@@ -304,6 +343,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g := NewWithT(t)
 				awsCluster := getAWSCluster("test", "test")
 				runningCluster := func() {
+					ec2Svc.EXPECT().ValidateRegion().Return(nil)
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(expectedErr)
 				}
@@ -326,6 +366,7 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g := NewWithT(t)
 				awsCluster := getAWSCluster("test", "test")
 				runningCluster := func() {
+					ec2Svc.EXPECT().ValidateRegion().Return(nil)
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
 					ec2Svc.EXPECT().ReconcileBastion().Return(expectedErr)
@@ -345,13 +386,67 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g.Expect(err).ToNot(BeNil())
 				expectAWSClusterConditions(g, cs.AWSCluster, []conditionAssertion{{infrav1.BastionHostReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityWarning, infrav1.BastionHostFailedReason}})
 			})
+			t.Run("Should fail AWSCluster create with InstanceConnectEndpointReadyCondition status false", func(t *testing.T) {
+				g := NewWithT(t)
+				awsCluster := getAWSCluster("test", "test")
+				runningCluster := func() {
+					ec2Svc.EXPECT().ValidateRegion().Return(nil)
+					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
+					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
+					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+					ec2Svc.EXPECT().ReconcileInstanceConnectEndpoint().Return(expectedErr)
+				}
+				csClient := setup(t, &awsCluster)
+				defer teardown()
+				runningCluster()
+				cs, err := scope.NewClusterScope(
+					scope.ClusterScopeParams{
+						Client:     csClient,
+						Cluster:    &clusterv1.Cluster{},
+						AWSCluster: &awsCluster,
+					},
+				)
+				g.Expect(err).To(BeNil())
+				_, err = reconciler.reconcileNormal(context.TODO(), cs)
+				g.Expect(err).ToNot(BeNil())
+				expectAWSClusterConditions(g, cs.AWSCluster, []conditionAssertion{{infrav1.InstanceConnectEndpointReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityWarning, infrav1.InstanceConnectEndpointFailedReason}})
+			})
+			t.Run("Should fail AWSCluster create with GuardDutyRuntimeMonitoringReadyCondition status false", func(t *testing.T) {
+				g := NewWithT(t)
+				awsCluster := getAWSCluster("test", "test")
+				runningCluster := func() {
+					ec2Svc.EXPECT().ValidateRegion().Return(nil)
+					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
+					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
+					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+					ec2Svc.EXPECT().ReconcileInstanceConnectEndpoint().Return(nil)
+					gdSvc.EXPECT().ReconcileRuntimeMonitoring().Return(expectedErr)
+				}
+				csClient := setup(t, &awsCluster)
+				defer teardown()
+				runningCluster()
+				cs, err := scope.NewClusterScope(
+					scope.ClusterScopeParams{
+						Client:     csClient,
+						Cluster:    &clusterv1.Cluster{},
+						AWSCluster: &awsCluster,
+					},
+				)
+				g.Expect(err).To(BeNil())
+				_, err = reconciler.reconcileNormal(context.TODO(), cs)
+				g.Expect(err).ToNot(BeNil())
+				expectAWSClusterConditions(g, cs.AWSCluster, []conditionAssertion{{infrav1.GuardDutyRuntimeMonitoringReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityWarning, infrav1.GuardDutyEndpointFailedReason}})
+			})
 			t.Run("Should fail AWSCluster create with failure in LoadBalancer reconciliation", func(t *testing.T) {
 				g := NewWithT(t)
 				awsCluster := getAWSCluster("test", "test")
 				runningCluster := func() {
+					ec2Svc.EXPECT().ValidateRegion().Return(nil)
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
 					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+					ec2Svc.EXPECT().ReconcileInstanceConnectEndpoint().Return(nil)
+					gdSvc.EXPECT().ReconcileRuntimeMonitoring().Return(nil)
 					elbSvc.EXPECT().ReconcileLoadbalancers().Return(expectedErr)
 				}
 				csClient := setup(t, &awsCluster)
@@ -373,9 +468,12 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g := NewWithT(t)
 				awsCluster := getAWSCluster("test", "test")
 				runningCluster := func() {
+					ec2Svc.EXPECT().ValidateRegion().Return(nil)
 					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
 					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
 					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+					ec2Svc.EXPECT().ReconcileInstanceConnectEndpoint().Return(nil)
+					gdSvc.EXPECT().ReconcileRuntimeMonitoring().Return(nil)
 					elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil)
 				}
 				csClient := setup(t, &awsCluster)
@@ -399,6 +497,8 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 		t.Run("Reconcile success", func(t *testing.T) {
 			deleteCluster := func() {
 				ec2Svc.EXPECT().DeleteBastion().Return(nil)
+				ec2Svc.EXPECT().DeleteInstanceConnectEndpoint().Return(nil)
+				gdSvc.EXPECT().DeleteRuntimeMonitoring().Return(nil)
 				elbSvc.EXPECT().DeleteLoadbalancers().Return(nil)
 				networkSvc.EXPECT().DeleteNetwork().Return(nil)
 				sgSvc.EXPECT().DeleteSecurityGroups().Return(nil)
@@ -431,6 +531,8 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 					t.Helper()
 					elbSvc.EXPECT().DeleteLoadbalancers().Return(expectedErr)
 					ec2Svc.EXPECT().DeleteBastion().Return(nil)
+					ec2Svc.EXPECT().DeleteInstanceConnectEndpoint().Return(nil)
+					gdSvc.EXPECT().DeleteRuntimeMonitoring().Return(nil)
 					networkSvc.EXPECT().DeleteNetwork().Return(nil)
 					sgSvc.EXPECT().DeleteSecurityGroups().Return(nil)
 				}
@@ -455,6 +557,8 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g := NewWithT(t)
 				deleteCluster := func() {
 					ec2Svc.EXPECT().DeleteBastion().Return(expectedErr)
+					ec2Svc.EXPECT().DeleteInstanceConnectEndpoint().Return(nil)
+					gdSvc.EXPECT().DeleteRuntimeMonitoring().Return(nil)
 					elbSvc.EXPECT().DeleteLoadbalancers().Return(nil)
 					networkSvc.EXPECT().DeleteNetwork().Return(nil)
 					sgSvc.EXPECT().DeleteSecurityGroups().Return(nil)
@@ -480,6 +584,8 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g := NewWithT(t)
 				deleteCluster := func() {
 					ec2Svc.EXPECT().DeleteBastion().Return(nil)
+					ec2Svc.EXPECT().DeleteInstanceConnectEndpoint().Return(nil)
+					gdSvc.EXPECT().DeleteRuntimeMonitoring().Return(nil)
 					elbSvc.EXPECT().DeleteLoadbalancers().Return(nil)
 					sgSvc.EXPECT().DeleteSecurityGroups().Return(expectedErr)
 					networkSvc.EXPECT().DeleteNetwork().Return(nil)
@@ -501,10 +607,39 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g.Expect(err).ToNot(BeNil())
 				g.Expect(awsCluster.GetFinalizers()).To(ContainElement(infrav1.ClusterFinalizer))
 			})
+			t.Run("Should fail AWSCluster delete with GuardDuty Runtime Monitoring deletion failed and Cluster Finalizer not removed", func(t *testing.T) {
+				g := NewWithT(t)
+				deleteCluster := func() {
+					ec2Svc.EXPECT().DeleteBastion().Return(nil)
+					ec2Svc.EXPECT().DeleteInstanceConnectEndpoint().Return(nil)
+					gdSvc.EXPECT().DeleteRuntimeMonitoring().Return(expectedErr)
+					elbSvc.EXPECT().DeleteLoadbalancers().Return(nil)
+					sgSvc.EXPECT().DeleteSecurityGroups().Return(nil)
+					networkSvc.EXPECT().DeleteNetwork().Return(nil)
+				}
+				awsCluster := getAWSCluster("test", "test")
+				awsCluster.Finalizers = []string{infrav1.ClusterFinalizer}
+				csClient := setup(t, &awsCluster)
+				defer teardown()
+				deleteCluster()
+				cs, err := scope.NewClusterScope(
+					scope.ClusterScopeParams{
+						Client:     csClient,
+						Cluster:    &clusterv1.Cluster{},
+						AWSCluster: &awsCluster,
+					},
+				)
+				g.Expect(err).To(BeNil())
+				_, err = reconciler.reconcileDelete(ctx, cs)
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(awsCluster.GetFinalizers()).To(ContainElement(infrav1.ClusterFinalizer))
+			})
 			t.Run("Should fail AWSCluster delete with network deletion failed and Cluster Finalizer not removed", func(t *testing.T) {
 				g := NewWithT(t)
 				deleteCluster := func() {
 					ec2Svc.EXPECT().DeleteBastion().Return(nil)
+					ec2Svc.EXPECT().DeleteInstanceConnectEndpoint().Return(nil)
+					gdSvc.EXPECT().DeleteRuntimeMonitoring().Return(nil)
 					elbSvc.EXPECT().DeleteLoadbalancers().Return(nil)
 					sgSvc.EXPECT().DeleteSecurityGroups().Return(nil)
 					networkSvc.EXPECT().DeleteNetwork().Return(expectedErr)
@@ -526,6 +661,39 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				g.Expect(err).ToNot(BeNil())
 				g.Expect(awsCluster.GetFinalizers()).To(ContainElement(infrav1.ClusterFinalizer))
 			})
+			t.Run("Should requeue AWSCluster delete while AWS writes are frozen and Cluster Finalizer not removed", func(t *testing.T) {
+				g := NewWithT(t)
+				frozenErr := awserr.New(awserrors.WriteFrozen, "frozen", nil)
+				deleteCluster := func() {
+					ec2Svc.EXPECT().DeleteBastion().Return(frozenErr)
+					ec2Svc.EXPECT().DeleteInstanceConnectEndpoint().Return(nil)
+					gdSvc.EXPECT().DeleteRuntimeMonitoring().Return(nil)
+					elbSvc.EXPECT().DeleteLoadbalancers().Return(frozenErr)
+					sgSvc.EXPECT().DeleteSecurityGroups().Return(frozenErr)
+					networkSvc.EXPECT().DeleteNetwork().Return(frozenErr)
+				}
+				awsCluster := getAWSCluster("test", "test")
+				awsCluster.Finalizers = []string{infrav1.ClusterFinalizer}
+				awsCluster.Annotations = map[string]string{
+					infrav1.WriteFreezeUntilAnnotation: time.Now().Add(time.Hour).Format(time.RFC3339),
+				}
+				csClient := setup(t, &awsCluster)
+				defer teardown()
+				deleteCluster()
+				cs, err := scope.NewClusterScope(
+					scope.ClusterScopeParams{
+						Client:     csClient,
+						Cluster:    &clusterv1.Cluster{},
+						AWSCluster: &awsCluster,
+					},
+				)
+				g.Expect(err).To(BeNil())
+				result, err := reconciler.reconcileDelete(ctx, cs)
+				result, err = scope.RequeueOnWriteFreeze(&awsCluster, result, err)
+				g.Expect(err).To(BeNil())
+				g.Expect(result.RequeueAfter).To(BeNumerically(">", 59*time.Minute))
+				g.Expect(awsCluster.GetFinalizers()).To(ContainElement(infrav1.ClusterFinalizer))
+			})
 		})
 	})
 }
@@ -556,9 +724,13 @@ func cleanupCluster(g *WithT, awsCluster *infrav1.AWSCluster, namespace *corev1.
 
 func TestSecurityGroupRolesForCluster(t *testing.T) {
 	tests := []struct {
-		name           string
-		bastionEnabled bool
-		want           []infrav1.SecurityGroupRole
+		name                     string
+		bastionEnabled           bool
+		instanceConnectEndpoint  *infrav1.InstanceConnectEndpoint
+		guardDuty                *infrav1.GuardDutyRuntimeMonitoring
+		managedVPC               bool
+		controlPlaneLoadBalancer *infrav1.AWSLoadBalancerSpec
+		want                     []infrav1.SecurityGroupRole
 	}{
 		{
 			name:           "Should use bastion security group when bastion is enabled",
@@ -570,6 +742,35 @@ func TestSecurityGroupRolesForCluster(t *testing.T) {
 			bastionEnabled: false,
 			want:           defaultAWSSecurityGroupRoles,
 		},
+		{
+			name:                    "Should use EC2 Instance Connect Endpoint security group when the endpoint is enabled",
+			instanceConnectEndpoint: &infrav1.InstanceConnectEndpoint{Enabled: true},
+			want:                    append(defaultAWSSecurityGroupRoles, infrav1.SecurityGroupInstanceConnectEndpoint),
+		},
+		{
+			name:                    "Should not use EC2 Instance Connect Endpoint security group when the endpoint is not enabled",
+			instanceConnectEndpoint: &infrav1.InstanceConnectEndpoint{Enabled: false},
+			want:                    defaultAWSSecurityGroupRoles,
+		},
+		{
+			name:       "Should use GuardDuty endpoint security group when GuardDuty Runtime Monitoring is enabled",
+			guardDuty:  &infrav1.GuardDutyRuntimeMonitoring{Enabled: true},
+			managedVPC: true,
+			want:       append(defaultAWSSecurityGroupRoles, infrav1.SecurityGroupGuardDutyEndpoint),
+		},
+		{
+			name:      "Should not use GuardDuty endpoint security group when the VPC is unmanaged",
+			guardDuty: &infrav1.GuardDutyRuntimeMonitoring{Enabled: true},
+			want:      defaultAWSSecurityGroupRoles,
+		},
+		{
+			name: "Should not use API server load balancer security group when the load balancer uses existing security groups",
+			controlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+				SecurityGroupIDs: []string{"sg-0123456789abcdef0"},
+			},
+			want: []infrav1.SecurityGroupRole{infrav1.SecurityGroupLB, infrav1.SecurityGroupControlPlane, infrav1.SecurityGroupNode},
+		},
 	}
 
 	for _, tt := range tests {
@@ -578,6 +779,14 @@ func TestSecurityGroupRolesForCluster(t *testing.T) {
 
 			c := getAWSCluster("test", "test")
 			c.Spec.Bastion.Enabled = tt.bastionEnabled
+			c.Spec.InstanceConnectEndpoint = tt.instanceConnectEndpoint
+			c.Spec.GuardDutyRuntimeMonitoring = tt.guardDuty
+			if tt.managedVPC {
+				c.Spec.NetworkSpec.VPC.Tags = infrav1.Tags{infrav1.ClusterTagKey("test-cluster"): string(infrav1.ResourceLifecycleOwned)}
+			}
+			if tt.controlPlaneLoadBalancer != nil {
+				c.Spec.ControlPlaneLoadBalancer = tt.controlPlaneLoadBalancer
+			}
 			s, err := getClusterScope(c)
 			g.Expect(err).To(BeNil(), "failed to create cluster scope for test")
 
@@ -586,3 +795,641 @@ func TestSecurityGroupRolesForCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSClusterReconcilerReconcileObservability(t *testing.T) {
+	tests := []struct {
+		name          string
+		observability *infrav1.Observability
+		conditions    clusterv1.Conditions
+		reconcileErr  error
+		wantReconcile bool
+		wantCondition *clusterv1.Condition
+	}{
+		{
+			name: "does not call CloudWatch if the alarms and the dashboard were never enabled",
+		},
+		{
+			name:          "marks the condition true once the alarms and the dashboard are reconciled",
+			observability: &infrav1.Observability{Dashboard: true},
+			wantReconcile: true,
+			wantCondition: &clusterv1.Condition{Type: infrav1.ObservabilityReadyCondition, Status: corev1.ConditionTrue},
+		},
+		{
+			name:          "marks the condition false if the alarms and the dashboard cannot be reconciled",
+			observability: &infrav1.Observability{Dashboard: true},
+			reconcileErr:  errors.New("access denied"),
+			wantReconcile: true,
+			wantCondition: &clusterv1.Condition{
+				Type:     infrav1.ObservabilityReadyCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityWarning,
+				Reason:   infrav1.ObservabilityReconciliationFailedReason,
+			},
+		},
+		{
+			name:          "removes the condition once the alarms and the dashboard of a disabled observability are deleted",
+			conditions:    clusterv1.Conditions{{Type: infrav1.ObservabilityReadyCondition, Status: corev1.ConditionTrue}},
+			wantReconcile: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			observabilitySvc := mock_services.NewMockObservabilityInterface(mockCtrl)
+			if tt.wantReconcile {
+				observabilitySvc.EXPECT().ReconcileObservability(gomock.Any()).Return(tt.reconcileErr)
+			}
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:  fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec:   infrav1.AWSClusterSpec{Observability: tt.observability},
+					Status: infrav1.AWSClusterStatus{Conditions: tt.conditions},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			reconciler := AWSClusterReconciler{
+				observabilityServiceFactory: func(scope.ObservabilityScope) services.ObservabilityInterface {
+					return observabilitySvc
+				},
+				Recorder: record.NewFakeRecorder(10),
+			}
+			reconciler.reconcileObservability(context.TODO(), clusterScope)
+
+			condition := conditions.Get(clusterScope.AWSCluster, infrav1.ObservabilityReadyCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+			g.Expect(condition.Severity).To(Equal(tt.wantCondition.Severity))
+			g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+		})
+	}
+}
+
+func TestAWSClusterReconcilerReconcileBudget(t *testing.T) {
+	budget := &infrav1.Budget{LimitAmount: "500"}
+	tests := []struct {
+		name          string
+		budget        *infrav1.Budget
+		status        *infrav1.BudgetStatus
+		conditions    clusterv1.Conditions
+		reconcileErr  error
+		wantReconcile bool
+		wantCondition *clusterv1.Condition
+		wantEvents    int
+	}{
+		{
+			name: "does not call AWS Budgets if the budget was never set",
+		},
+		{
+			name:          "marks the condition true while the spend is within the limit",
+			budget:        budget,
+			status:        &infrav1.BudgetStatus{Name: "test", ActualSpend: "120.5", ForecastedSpend: "480.0"},
+			wantReconcile: true,
+			wantCondition: &clusterv1.Condition{Type: infrav1.BudgetWithinLimitCondition, Status: corev1.ConditionTrue},
+		},
+		{
+			name:          "marks the condition false if the forecasted spend exceeds the limit",
+			budget:        budget,
+			status:        &infrav1.BudgetStatus{Name: "test", ActualSpend: "120.5", ForecastedSpend: "610.0"},
+			wantReconcile: true,
+			wantCondition: &clusterv1.Condition{
+				Type:     infrav1.BudgetWithinLimitCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityInfo,
+				Reason:   infrav1.BudgetForecastExceededReason,
+			},
+		},
+		{
+			name:          "marks the condition false and records an event once the actual spend exceeds the limit",
+			budget:        budget,
+			status:        &infrav1.BudgetStatus{Name: "test", ActualSpend: "512.3", ForecastedSpend: "610.0"},
+			wantReconcile: true,
+			wantCondition: &clusterv1.Condition{
+				Type:     infrav1.BudgetWithinLimitCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityWarning,
+				Reason:   infrav1.BudgetExceededReason,
+			},
+			wantEvents: 1,
+		},
+		{
+			name:   "does not record an event again while the actual spend exceeds the limit",
+			budget: budget,
+			status: &infrav1.BudgetStatus{Name: "test", ActualSpend: "512.3"},
+			conditions: clusterv1.Conditions{{
+				Type:     infrav1.BudgetWithinLimitCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityWarning,
+				Reason:   infrav1.BudgetExceededReason,
+			}},
+			wantReconcile: true,
+			wantCondition: &clusterv1.Condition{
+				Type:     infrav1.BudgetWithinLimitCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityWarning,
+				Reason:   infrav1.BudgetExceededReason,
+			},
+		},
+		{
+			name:          "marks the condition false if the budget cannot be reconciled",
+			budget:        budget,
+			reconcileErr:  errors.New("access denied"),
+			wantReconcile: true,
+			wantCondition: &clusterv1.Condition{
+				Type:     infrav1.BudgetWithinLimitCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityWarning,
+				Reason:   infrav1.BudgetReconciliationFailedReason,
+			},
+			wantEvents: 1,
+		},
+		{
+			name:          "removes the condition once an unset budget is deleted",
+			status:        &infrav1.BudgetStatus{Name: "test"},
+			conditions:    clusterv1.Conditions{{Type: infrav1.BudgetWithinLimitCondition, Status: corev1.ConditionTrue}},
+			wantReconcile: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			budgetSvc := mock_services.NewMockBudgetInterface(mockCtrl)
+			if tt.wantReconcile {
+				budgetSvc.EXPECT().ReconcileBudget(gomock.Any()).Return(tt.reconcileErr)
+			}
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:  fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec:   infrav1.AWSClusterSpec{Budget: tt.budget},
+					Status: infrav1.AWSClusterStatus{Budget: tt.status, Conditions: tt.conditions},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			recorder := record.NewFakeRecorder(10)
+			reconciler := AWSClusterReconciler{
+				budgetServiceFactory: func(scope.BudgetScope) services.BudgetInterface {
+					return budgetSvc
+				},
+				Recorder: recorder,
+			}
+			reconciler.reconcileBudget(context.TODO(), clusterScope)
+
+			g.Expect(recorder.Events).To(HaveLen(tt.wantEvents))
+			condition := conditions.Get(clusterScope.AWSCluster, infrav1.BudgetWithinLimitCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+			g.Expect(condition.Severity).To(Equal(tt.wantCondition.Severity))
+			g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+		})
+	}
+}
+
+func TestAWSClusterReconcilerReconcileLoadBalancerPrivateDNS(t *testing.T) {
+	tests := []struct {
+		name          string
+		privateDNS    *infrav1.PrivateDNS
+		reconcileErr  error
+		wantReconcile bool
+		wantErr       bool
+		wantHost      string
+		wantCondition *clusterv1.Condition
+	}{
+		{
+			name:     "uses the DNS name of the load balancer as the control plane endpoint",
+			wantHost: "test-apiserver.elb.us-east-1.amazonaws.com",
+		},
+		{
+			name:          "uses the private DNS name as the control plane endpoint",
+			privateDNS:    &infrav1.PrivateDNS{Name: "api.test.example.internal"},
+			wantReconcile: true,
+			wantHost:      "api.test.example.internal",
+			wantCondition: &clusterv1.Condition{Type: infrav1.PrivateDNSReadyCondition, Status: corev1.ConditionTrue},
+		},
+		{
+			name:          "does not set the control plane endpoint if the private DNS record cannot be reconciled",
+			privateDNS:    &infrav1.PrivateDNS{Name: "api.test.example.internal"},
+			reconcileErr:  errors.New("access denied"),
+			wantReconcile: true,
+			wantErr:       true,
+			wantCondition: &clusterv1.Condition{
+				Type:     infrav1.PrivateDNSReadyCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityWarning,
+				Reason:   infrav1.PrivateDNSFailedReason,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			elbSvc := mock_services.NewMockELBInterface(mockCtrl)
+			elbSvc.EXPECT().ReconcileLoadbalancers().Return(nil)
+			privateDNSSvc := mock_services.NewMockPrivateDNSInterface(mockCtrl)
+			if tt.wantReconcile {
+				privateDNSSvc.EXPECT().ReconcilePrivateDNS().Return(tt.reconcileErr)
+			}
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:  fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{LoadBalancerType: infrav1.LoadBalancerTypeNLB},
+						PrivateDNS:               tt.privateDNS,
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{APIServerELB: infrav1.LoadBalancer{DNSName: "test-apiserver.elb.us-east-1.amazonaws.com"}},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			reconciler := AWSClusterReconciler{
+				elbServiceFactory: func(scope.ELBScope) services.ELBInterface {
+					return elbSvc
+				},
+				privateDNSServiceFactory: func(scope.PrivateDNSScope) services.PrivateDNSInterface {
+					return privateDNSSvc
+				},
+				Recorder: record.NewFakeRecorder(10),
+			}
+			_, err = reconciler.reconcileLoadBalancer(clusterScope, clusterScope.AWSCluster)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(clusterScope.AWSCluster.Spec.ControlPlaneEndpoint.Host).To(Equal(tt.wantHost))
+
+			condition := conditions.Get(clusterScope.AWSCluster, infrav1.PrivateDNSReadyCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+			g.Expect(condition.Severity).To(Equal(tt.wantCondition.Severity))
+			g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+		})
+	}
+}
+
+func TestAWSClusterReconcilerReconcileCloudProviderConfig(t *testing.T) {
+	initialized := clusterv1.Conditions{{Type: clusterv1.ControlPlaneInitializedCondition, Status: corev1.ConditionTrue}}
+
+	tests := []struct {
+		name                string
+		cloudProviderConfig *infrav1.CloudProviderConfig
+		clusterConditions   clusterv1.Conditions
+		conditions          clusterv1.Conditions
+		reconcileErr        error
+		wantReconcile       bool
+		wantRequeue         bool
+		wantCondition       *clusterv1.Condition
+	}{
+		{
+			name:              "does not connect to the workload cluster if the configuration was never enabled",
+			clusterConditions: initialized,
+		},
+		{
+			name:                "waits for the control plane to be initialized",
+			cloudProviderConfig: &infrav1.CloudProviderConfig{},
+			wantRequeue:         true,
+			wantCondition: &clusterv1.Condition{
+				Type:     infrav1.CloudProviderConfigReadyCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityInfo,
+				Reason:   infrav1.WaitingForControlPlaneInitializationReason,
+			},
+		},
+		{
+			name:                "marks the condition true once the configuration is written",
+			cloudProviderConfig: &infrav1.CloudProviderConfig{},
+			clusterConditions:   initialized,
+			wantReconcile:       true,
+			wantCondition:       &clusterv1.Condition{Type: infrav1.CloudProviderConfigReadyCondition, Status: corev1.ConditionTrue},
+		},
+		{
+			name:                "marks the condition false if the configuration cannot be written",
+			cloudProviderConfig: &infrav1.CloudProviderConfig{},
+			clusterConditions:   initialized,
+			reconcileErr:        errors.New("connection refused"),
+			wantReconcile:       true,
+			wantCondition: &clusterv1.Condition{
+				Type:     infrav1.CloudProviderConfigReadyCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityWarning,
+				Reason:   infrav1.CloudProviderConfigReconciliationFailedReason,
+			},
+		},
+		{
+			name:              "removes the condition once the configuration of a disabled cloud provider config is deleted",
+			clusterConditions: initialized,
+			conditions:        clusterv1.Conditions{{Type: infrav1.CloudProviderConfigReadyCondition, Status: corev1.ConditionTrue}},
+			wantReconcile:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			cloudProviderConfigSvc := mock_services.NewMockCloudProviderConfigInterface(mockCtrl)
+			if tt.wantReconcile {
+				cloudProviderConfigSvc.EXPECT().ReconcileCloudProviderConfig(gomock.Any()).Return(tt.reconcileErr)
+			}
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+					Status:     clusterv1.ClusterStatus{Conditions: tt.clusterConditions},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec:   infrav1.AWSClusterSpec{CloudProviderConfig: tt.cloudProviderConfig},
+					Status: infrav1.AWSClusterStatus{Conditions: tt.conditions},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			reconciler := AWSClusterReconciler{
+				cloudProviderConfigServiceFactory: func(scope.CloudProviderConfigScope) services.CloudProviderConfigInterface {
+					return cloudProviderConfigSvc
+				},
+				Recorder: record.NewFakeRecorder(10),
+			}
+			requeueAfter := reconciler.reconcileCloudProviderConfig(context.TODO(), clusterScope)
+			g.Expect(requeueAfter > 0).To(Equal(tt.wantRequeue))
+
+			condition := conditions.Get(clusterScope.AWSCluster, infrav1.CloudProviderConfigReadyCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+			g.Expect(condition.Severity).To(Equal(tt.wantCondition.Severity))
+			g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+		})
+	}
+}
+
+func TestAWSClusterReconcilerReconcileSessionManager(t *testing.T) {
+	preferences := &infrav1.SessionManager{DocumentName: "test-sessions", KMSKeyID: "alias/sessions"}
+	tests := []struct {
+		name          string
+		preferences   *infrav1.SessionManager
+		documentName  string
+		expect        func(m *mock_services.MockSessionManagerInterfaceMockRecorder)
+		wantDocument  string
+		wantCondition *clusterv1.Condition
+	}{
+		{
+			name:   "does not call Systems Manager if the preferences were never set",
+			expect: func(m *mock_services.MockSessionManagerInterfaceMockRecorder) {},
+		},
+		{
+			name:        "marks the condition true once the preferences are reconciled",
+			preferences: preferences,
+			expect: func(m *mock_services.MockSessionManagerInterfaceMockRecorder) {
+				m.ReconcileSessionManagerPreferences(preferences).Return(nil)
+			},
+			wantDocument:  "test-sessions",
+			wantCondition: &clusterv1.Condition{Type: infrav1.SessionManagerReadyCondition, Status: corev1.ConditionTrue},
+		},
+		{
+			name:        "marks the condition false if the preferences cannot be reconciled",
+			preferences: preferences,
+			expect: func(m *mock_services.MockSessionManagerInterfaceMockRecorder) {
+				m.ReconcileSessionManagerPreferences(preferences).Return(errors.New("access denied"))
+			},
+			wantCondition: &clusterv1.Condition{
+				Type:     infrav1.SessionManagerReadyCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityWarning,
+				Reason:   infrav1.SessionManagerReconciliationFailedReason,
+			},
+		},
+		{
+			name:         "deletes the previous document when the preferences move to another document",
+			preferences:  preferences,
+			documentName: infrav1.SessionManagerDefaultDocumentName,
+			expect: func(m *mock_services.MockSessionManagerInterfaceMockRecorder) {
+				m.DeleteSessionManagerPreferences(infrav1.SessionManagerDefaultDocumentName).Return(nil)
+				m.ReconcileSessionManagerPreferences(preferences).Return(nil)
+			},
+			wantDocument:  "test-sessions",
+			wantCondition: &clusterv1.Condition{Type: infrav1.SessionManagerReadyCondition, Status: corev1.ConditionTrue},
+		},
+		{
+			name:         "deletes the document once the preferences are removed",
+			documentName: "test-sessions",
+			expect: func(m *mock_services.MockSessionManagerInterfaceMockRecorder) {
+				m.DeleteSessionManagerPreferences("test-sessions").Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			sessionManagerSvc := mock_services.NewMockSessionManagerInterface(mockCtrl)
+			tt.expect(sessionManagerSvc.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:  fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec:   infrav1.AWSClusterSpec{SessionManager: tt.preferences},
+					Status: infrav1.AWSClusterStatus{SessionManagerDocumentName: tt.documentName},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			reconciler := AWSClusterReconciler{
+				sessionManagerServiceFactory: func(cloud.ClusterScoper) services.SessionManagerInterface {
+					return sessionManagerSvc
+				},
+				Recorder: record.NewFakeRecorder(10),
+			}
+			reconciler.reconcileSessionManager(clusterScope)
+
+			g.Expect(clusterScope.AWSCluster.Status.SessionManagerDocumentName).To(Equal(tt.wantDocument))
+			condition := conditions.Get(clusterScope.AWSCluster, infrav1.SessionManagerReadyCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+			g.Expect(condition.Severity).To(Equal(tt.wantCondition.Severity))
+			g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+		})
+	}
+}
+
+func TestAWSClusterReconcilerReconcileResourceLimits(t *testing.T) {
+	template := &infrav1.AWSMachineTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-md", Namespace: "default", Labels: map[string]string{clusterv1.ClusterNameLabel: "test"}},
+		Spec: infrav1.AWSMachineTemplateSpec{Template: infrav1.AWSMachineTemplateResource{Spec: infrav1.AWSMachineSpec{
+			InstanceType:      "m5.large",
+			SpotMarketOptions: &infrav1.SpotMarketOptions{},
+		}}},
+	}
+	limits := &infrav1.ResourceLimits{
+		VCPUs: []infrav1.QuotaUsage{{QuotaCode: "L-34B43A08", QuotaName: "All Standard Spot Instance Requests", Limit: 100, Used: 95, Available: 5}},
+	}
+	tests := []struct {
+		name        string
+		lastUpdated *metav1.Time
+		expect      func(m *mock_services.MockQuotaInterfaceMockRecorder)
+		wantLimits  bool
+		wantEvents  int
+	}{
+		{
+			name:        "does not read the limits again before the refresh interval",
+			lastUpdated: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			expect:      func(m *mock_services.MockQuotaInterfaceMockRecorder) {},
+		},
+		{
+			name: "reports the limits of the instance types of the cluster and warns about the quotas running out",
+			expect: func(m *mock_services.MockQuotaInterfaceMockRecorder) {
+				m.ResourceLimits([]quota.InstanceType{{Name: "m5.large", Spot: true}}).Return(limits.DeepCopy(), nil)
+			},
+			wantLimits: true,
+			wantEvents: 1,
+		},
+		{
+			name: "does not fail when the limits cannot be read",
+			expect: func(m *mock_services.MockQuotaInterfaceMockRecorder) {
+				m.ResourceLimits(gomock.Any()).Return(nil, errors.New("access denied"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			quotaSvc := mock_services.NewMockQuotaInterface(mockCtrl)
+			tt.expect(quotaSvc.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(template.DeepCopy()).Build()
+			awsCluster := &infrav1.AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+			if tt.lastUpdated != nil {
+				awsCluster.Status.ResourceLimits = &infrav1.ResourceLimits{LastUpdated: tt.lastUpdated}
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     fakeClient,
+				Cluster:    &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}},
+				AWSCluster: awsCluster,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			recorder := record.NewFakeRecorder(10)
+			reconciler := AWSClusterReconciler{
+				Client: fakeClient,
+				quotaServiceFactory: func(cloud.ClusterScoper) services.QuotaInterface {
+					return quotaSvc
+				},
+				Recorder: recorder,
+			}
+			requeueAfter := reconciler.reconcileResourceLimits(context.TODO(), clusterScope)
+
+			g.Expect(requeueAfter).To(BeNumerically("<=", resourceLimitsRefreshInterval))
+			g.Expect(requeueAfter).To(BeNumerically(">", 0))
+			if tt.wantLimits {
+				g.Expect(clusterScope.AWSCluster.Status.ResourceLimits).NotTo(BeNil())
+				g.Expect(clusterScope.AWSCluster.Status.ResourceLimits.VCPUs).To(Equal(limits.VCPUs))
+				g.Expect(clusterScope.AWSCluster.Status.ResourceLimits.LastUpdated).NotTo(BeNil())
+			} else {
+				g.Expect(clusterScope.AWSCluster.Status.ResourceLimits).To(Equal(awsCluster.Status.ResourceLimits))
+			}
+			g.Expect(recorder.Events).To(HaveLen(tt.wantEvents))
+		})
+	}
+}
+
+func TestDisruptiveActionsRequeueAfter(t *testing.T) {
+	now := time.Date(2026, time.October, 14, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		window *infrav1.MaintenanceWindow
+		want   time.Duration
+	}{
+		{
+			name: "without a maintenance window the actions are applied without waiting",
+			want: pendingDisruptiveActionsRequeueAfter,
+		},
+		{
+			name: "with a maintenance window the actions wait for it to open",
+			window: &infrav1.MaintenanceWindow{
+				StartTime: "14:30",
+				Duration:  metav1.Duration{Duration: time.Hour},
+			},
+			want: 150 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(disruptiveActionsRequeueAfter(tt.window, now)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestMinRequeueAfter(t *testing.T) {
+	tests := []struct {
+		name      string
+		durations []time.Duration
+		want      time.Duration
+	}{
+		{
+			name:      "no requeue",
+			durations: []time.Duration{0, 0},
+			want:      0,
+		},
+		{
+			name:      "zero durations are ignored",
+			durations: []time.Duration{0, 30 * time.Second},
+			want:      30 * time.Second,
+		},
+		{
+			name:      "a pending maintenance window does not delay a shorter retry",
+			durations: []time.Duration{150 * time.Minute, 30 * time.Second},
+			want:      30 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(minRequeueAfter(tt.durations...)).To(Equal(tt.want))
+		})
+	}
+}
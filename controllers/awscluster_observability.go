@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/cloudwatch"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func (r *AWSClusterReconciler) getObservabilityService(scope scope.ObservabilityScope) services.ObservabilityInterface {
+	if r.observabilityServiceFactory != nil {
+		return r.observabilityServiceFactory(scope)
+	}
+
+	return cloudwatch.NewService(scope)
+}
+
+// reconcileObservability reconciles the CloudWatch alarms and dashboard of the cluster. CloudWatch is only called
+// once they are enabled, so that clusters not using them do not require the CloudWatch permissions.
+// Failing to reconcile them does not prevent the cluster from being provisioned.
+func (r *AWSClusterReconciler) reconcileObservability(ctx context.Context, clusterScope *scope.ClusterScope) {
+	awsCluster := clusterScope.AWSCluster
+	if clusterScope.Observability() == nil && !conditions.Has(awsCluster, infrav1.ObservabilityReadyCondition) {
+		return
+	}
+
+	if err := r.getObservabilityService(clusterScope).ReconcileObservability(ctx); err != nil {
+		clusterScope.Error(err, "non-fatal: failed to reconcile CloudWatch alarms and dashboard")
+		r.Recorder.Eventf(awsCluster, corev1.EventTypeWarning, "FailedReconcileObservability", "Failed to reconcile CloudWatch alarms and dashboard: %v", err)
+		conditions.MarkFalse(awsCluster, infrav1.ObservabilityReadyCondition, infrav1.ObservabilityReconciliationFailedReason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return
+	}
+
+	if clusterScope.Observability() == nil {
+		conditions.Delete(awsCluster, infrav1.ObservabilityReadyCondition)
+		return
+	}
+	conditions.MarkTrue(awsCluster, infrav1.ObservabilityReadyCondition)
+}
+
+// deleteObservability deletes the CloudWatch alarms and dashboard of the cluster, if they were ever enabled.
+func (r *AWSClusterReconciler) deleteObservability(ctx context.Context, clusterScope *scope.ClusterScope) error {
+	if clusterScope.Observability() == nil && !conditions.Has(clusterScope.AWSCluster, infrav1.ObservabilityReadyCondition) {
+		return nil
+	}
+
+	return r.getObservabilityService(clusterScope).DeleteObservability(ctx)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/preflight"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// reconcilePreflightChecks runs the preflight checks of a cluster being created until they pass, and lists their
+// failures in the PreflightChecksPassed condition. Clusters which were ready before the checks were enabled are not
+// checked. The failures do not prevent the cluster from being provisioned, as the checks can only predict errors.
+func (r *AWSClusterReconciler) reconcilePreflightChecks(ctx context.Context, clusterScope *scope.ClusterScope) {
+	awsCluster := clusterScope.AWSCluster
+	if conditions.IsTrue(awsCluster, infrav1.PreflightChecksPassedCondition) ||
+		(awsCluster.Status.Ready && !conditions.Has(awsCluster, infrav1.PreflightChecksPassedCondition)) {
+		return
+	}
+
+	images, err := r.preflightImages(ctx, clusterScope)
+	if err != nil {
+		clusterScope.Error(err, "non-fatal: failed to run preflight checks")
+		conditions.MarkFalse(awsCluster, infrav1.PreflightChecksPassedCondition, infrav1.PreflightChecksErrorReason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return
+	}
+
+	failures, err := preflight.NewService(clusterScope).RunChecks(ctx, images)
+	if len(failures) > 0 {
+		message := strings.Join(failures, "; ")
+		r.Recorder.Eventf(awsCluster, corev1.EventTypeWarning, "PreflightChecksFailed", "Preflight checks failed: %s", message)
+		conditions.MarkFalse(awsCluster, infrav1.PreflightChecksPassedCondition, infrav1.PreflightChecksFailedReason, clusterv1.ConditionSeverityWarning,
+			"%d preflight checks failed: %s", len(failures), message)
+		return
+	}
+	if err != nil {
+		clusterScope.Error(err, "non-fatal: failed to run preflight checks")
+		conditions.MarkFalse(awsCluster, infrav1.PreflightChecksPassedCondition, infrav1.PreflightChecksErrorReason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return
+	}
+	conditions.MarkTrue(awsCluster, infrav1.PreflightChecksPassedCondition)
+}
+
+// preflightImages returns the AMIs set on the bastion and on the AWSMachineTemplates of the cluster, with the
+// instance types launched from them.
+func (r *AWSClusterReconciler) preflightImages(ctx context.Context, clusterScope *scope.ClusterScope) ([]preflight.Image, error) {
+	var images []preflight.Image
+	if bastion := clusterScope.Bastion(); bastion.Enabled && bastion.AMI != "" {
+		images = append(images, preflight.Image{ID: bastion.AMI, InstanceType: bastion.InstanceType})
+	}
+
+	templates := &infrav1.AWSMachineTemplateList{}
+	if err := r.Client.List(ctx, templates, client.InNamespace(clusterScope.Namespace()), client.MatchingLabels{clusterv1.ClusterNameLabel: clusterScope.Name()}); err != nil {
+		return nil, errors.Wrap(err, "failed to list AWSMachineTemplates")
+	}
+	for _, template := range templates.Items {
+		spec := template.Spec.Template.Spec
+		if id := ptr.Deref(spec.AMI.ID, ""); id != "" {
+			images = append(images, preflight.Image{ID: id, InstanceType: spec.InstanceType})
+		}
+	}
+	return images, nil
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/quota"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	// resourceLimitsRefreshInterval is how often the resource limits of a cluster are read.
+	resourceLimitsRefreshInterval = 10 * time.Minute
+
+	// resourceLimitsLowHeadroomPercent is the percentage of a quota left under which a warning event is recorded.
+	resourceLimitsLowHeadroomPercent = 10
+)
+
+func (r *AWSClusterReconciler) getQuotaService(scope cloud.ClusterScoper) services.QuotaInterface {
+	if r.quotaServiceFactory != nil {
+		return r.quotaServiceFactory(scope)
+	}
+
+	return quota.NewService(scope)
+}
+
+// reconcileResourceLimits reports the usage of the Elastic IP and vCPU quotas of the account and the network interface
+// limits of the instance types of the cluster in its status, and records a warning event for each quota running out.
+// The limits are read again once they are older than the refresh interval. It returns when the limits are to be
+// read again. Failing to read the limits is not fatal, as they are only reported.
+func (r *AWSClusterReconciler) reconcileResourceLimits(ctx context.Context, clusterScope *scope.ClusterScope) time.Duration {
+	awsCluster := clusterScope.AWSCluster
+	if limits := awsCluster.Status.ResourceLimits; limits != nil && limits.LastUpdated != nil {
+		if age := time.Since(limits.LastUpdated.Time); age < resourceLimitsRefreshInterval {
+			return resourceLimitsRefreshInterval - age
+		}
+	}
+
+	instanceTypes, err := r.resourceLimitsInstanceTypes(ctx, clusterScope)
+	if err != nil {
+		clusterScope.Error(err, "non-fatal: failed to read resource limits")
+		return resourceLimitsRefreshInterval
+	}
+
+	limits, err := r.getQuotaService(clusterScope).ResourceLimits(instanceTypes)
+	if err != nil {
+		clusterScope.Error(err, "non-fatal: failed to read resource limits")
+		return resourceLimitsRefreshInterval
+	}
+	limits.LastUpdated = &metav1.Time{Time: time.Now()}
+	awsCluster.Status.ResourceLimits = limits
+
+	quotas := limits.VCPUs
+	if limits.ElasticIPs != nil {
+		quotas = append([]infrav1.QuotaUsage{*limits.ElasticIPs}, quotas...)
+	}
+	for _, usage := range quotas {
+		if usage.Available*100 < usage.Limit*resourceLimitsLowHeadroomPercent {
+			r.Recorder.Eventf(awsCluster, corev1.EventTypeWarning, "ResourceLimitApproaching", "Only %d of %d are available under quota %s (%s), request a quota increase",
+				usage.Available, usage.Limit, usage.QuotaCode, usage.QuotaName)
+		}
+	}
+	return resourceLimitsRefreshInterval
+}
+
+// resourceLimitsInstanceTypes returns the instance types of the bastion and of the AWSMachineTemplates of the cluster.
+func (r *AWSClusterReconciler) resourceLimitsInstanceTypes(ctx context.Context, clusterScope *scope.ClusterScope) ([]quota.InstanceType, error) {
+	var instanceTypes []quota.InstanceType
+	if bastion := clusterScope.Bastion(); bastion.Enabled && bastion.InstanceType != "" {
+		instanceTypes = append(instanceTypes, quota.InstanceType{Name: bastion.InstanceType})
+	}
+
+	templates := &infrav1.AWSMachineTemplateList{}
+	if err := r.Client.List(ctx, templates, client.InNamespace(clusterScope.Namespace()), client.MatchingLabels{clusterv1.ClusterNameLabel: clusterScope.Name()}); err != nil {
+		return nil, errors.Wrap(err, "failed to list AWSMachineTemplates")
+	}
+	for _, template := range templates.Items {
+		spec := template.Spec.Template.Spec
+		if spec.InstanceType == "" {
+			continue
+		}
+		instanceTypes = append(instanceTypes, quota.InstanceType{
+			Name: spec.InstanceType,
+			Spot: spec.SpotMarketOptions != nil || spec.MarketType == infrav1.MarketTypeSpot,
+		})
+	}
+	return instanceTypes, nil
+}
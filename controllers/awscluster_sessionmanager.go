@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ssm"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func (r *AWSClusterReconciler) getSessionManagerService(scope cloud.ClusterScoper) services.SessionManagerInterface {
+	if r.sessionManagerServiceFactory != nil {
+		return r.sessionManagerServiceFactory(scope)
+	}
+
+	return ssm.NewService(scope)
+}
+
+// reconcileSessionManager reconciles the Session Manager preferences document of the cluster, deleting the document
+// previously managed when the preferences are disabled or move to another document. Systems Manager is only called
+// once the preferences are set, so that clusters not using them do not require the permissions.
+// Failing to reconcile them does not prevent the cluster from being provisioned.
+func (r *AWSClusterReconciler) reconcileSessionManager(clusterScope *scope.ClusterScope) {
+	awsCluster := clusterScope.AWSCluster
+	preferences := clusterScope.SessionManager()
+	if preferences == nil && awsCluster.Status.SessionManagerDocumentName == "" {
+		conditions.Delete(awsCluster, infrav1.SessionManagerReadyCondition)
+		return
+	}
+
+	svc := r.getSessionManagerService(clusterScope)
+	if previous := awsCluster.Status.SessionManagerDocumentName; previous != "" && (preferences == nil || ssm.SessionManagerDocumentName(preferences) != previous) {
+		if err := svc.DeleteSessionManagerPreferences(previous); err != nil {
+			r.failSessionManager(clusterScope, err)
+			return
+		}
+		awsCluster.Status.SessionManagerDocumentName = ""
+	}
+
+	if preferences == nil {
+		conditions.Delete(awsCluster, infrav1.SessionManagerReadyCondition)
+		return
+	}
+	if err := svc.ReconcileSessionManagerPreferences(preferences); err != nil {
+		r.failSessionManager(clusterScope, err)
+		return
+	}
+	awsCluster.Status.SessionManagerDocumentName = ssm.SessionManagerDocumentName(preferences)
+	conditions.MarkTrue(awsCluster, infrav1.SessionManagerReadyCondition)
+}
+
+func (r *AWSClusterReconciler) failSessionManager(clusterScope *scope.ClusterScope, err error) {
+	clusterScope.Error(err, "non-fatal: failed to reconcile Session Manager preferences")
+	r.Recorder.Eventf(clusterScope.AWSCluster, corev1.EventTypeWarning, "FailedReconcileSessionManager", "Failed to reconcile Session Manager preferences: %v", err)
+	conditions.MarkFalse(clusterScope.AWSCluster, infrav1.SessionManagerReadyCondition, infrav1.SessionManagerReconciliationFailedReason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+}
+
+// deleteSessionManager deletes the Session Manager preferences document managed for the cluster, if any.
+func (r *AWSClusterReconciler) deleteSessionManager(clusterScope *scope.ClusterScope) error {
+	name := clusterScope.AWSCluster.Status.SessionManagerDocumentName
+	if name == "" {
+		return nil
+	}
+
+	return r.getSessionManagerService(clusterScope).DeleteSessionManagerPreferences(name)
+}
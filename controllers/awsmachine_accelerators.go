@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+)
+
+// reconcileAcceleratorInventory reports the accelerators of the instance type of the instance in the AWSMachine
+// status. The instance type is only described again when the instance type of the instance changes.
+func (r *AWSMachineReconciler) reconcileAcceleratorInventory(ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
+	if inventory := machineScope.AWSMachine.Status.AcceleratorInventory; inventory != nil && inventory.InstanceType == instance.Type {
+		return nil
+	}
+
+	accelerators, err := ec2svc.GetInstanceTypeAccelerators(instance.Type)
+	if err != nil {
+		return err
+	}
+
+	machineScope.SetAccelerators(accelerators)
+	machineScope.AWSMachine.Status.AcceleratorInventory = acceleratorInventory(instance.Type, accelerators)
+	return nil
+}
+
+// acceleratorInventory summarizes the given accelerators of an instance type.
+func acceleratorInventory(instanceType string, accelerators []infrav1.Accelerator) *infrav1.AcceleratorInventory {
+	inventory := &infrav1.AcceleratorInventory{InstanceType: instanceType}
+	for _, accelerator := range accelerators {
+		if accelerator.Type != infrav1.AcceleratorTypeGPU {
+			inventory.AcceleratorCount += accelerator.Count
+			continue
+		}
+		inventory.GPUCount += accelerator.Count
+		inventory.GPUMemoryMiB += accelerator.Count * accelerator.MemoryMiB
+		inventory.GPUTypes = append(inventory.GPUTypes, accelerator.Name)
+		inventory.MIGCapable = inventory.MIGCapable || accelerator.MIGCapable
+	}
+	return inventory
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/cloudwatchlogs"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func (r *AWSMachineReconciler) getCloudWatchLogsService(scope cloud.ClusterScoper) services.CloudWatchLogsInterface {
+	if r.cloudWatchLogsServiceFactory != nil {
+		return r.cloudWatchLogsServiceFactory(scope)
+	}
+
+	return cloudwatchlogs.NewService(scope)
+}
+
+// reconcileCloudWatchLogs ensures that the log group the logs of the instance of the AWSMachine are shipped to
+// exists, and records its name in the status of the AWSMachine.
+func (r *AWSMachineReconciler) reconcileCloudWatchLogs(machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper) error {
+	awsMachine := machineScope.AWSMachine
+	if awsMachine.Spec.CloudWatchLogs == nil {
+		awsMachine.Status.CloudWatchLogGroup = ""
+		conditions.Delete(awsMachine, infrav1.CloudWatchLogsReadyCondition)
+		return nil
+	}
+
+	logGroup := cloudwatchlogs.LogGroupName(clusterScope.Name(), machineClass(awsMachine))
+	if err := r.getCloudWatchLogsService(clusterScope).ReconcileLogGroup(logGroup, awsMachine.Spec.CloudWatchLogs.RetentionInDays); err != nil {
+		conditions.MarkFalse(awsMachine, infrav1.CloudWatchLogsReadyCondition, infrav1.CloudWatchLogsReconciliationFailedReason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return err
+	}
+
+	awsMachine.Status.CloudWatchLogGroup = logGroup
+	conditions.MarkTrue(awsMachine, infrav1.CloudWatchLogsReadyCondition)
+	return nil
+}
+
+// machineClass returns the class of an AWSMachine, which is the AWSMachineTemplate it was cloned from,
+// or the AWSMachine itself when it was not created from a template.
+func machineClass(awsMachine *infrav1.AWSMachine) string {
+	if template := awsMachine.GetAnnotations()[clusterv1.TemplateClonedFromNameAnnotation]; template != "" {
+		return template
+	}
+	return awsMachine.Name
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -77,16 +78,24 @@ const (
 // AWSMachineReconciler reconciles a AwsMachine object.
 type AWSMachineReconciler struct {
 	client.Client
-	Log                          logr.Logger
-	Recorder                     record.EventRecorder
-	ec2ServiceFactory            func(scope.EC2Scope) services.EC2Interface
-	elbServiceFactory            func(scope.ELBScope) services.ELBInterface
-	secretsManagerServiceFactory func(cloud.ClusterScoper) services.SecretInterface
-	SSMServiceFactory            func(cloud.ClusterScoper) services.SecretInterface
-	objectStoreServiceFactory    func(cloud.ClusterScoper) services.ObjectStoreInterface
-	Endpoints                    []scope.ServiceEndpoint
-	WatchFilterValue             string
-	TagUnmanagedNetworkResources bool
+	Log                           logr.Logger
+	Recorder                      record.EventRecorder
+	ec2ServiceFactory             func(scope.EC2Scope) services.EC2Interface
+	elbServiceFactory             func(scope.ELBScope) services.ELBInterface
+	secretsManagerServiceFactory  func(cloud.ClusterScoper) services.SecretInterface
+	SSMServiceFactory             func(cloud.ClusterScoper) services.SecretInterface
+	patchManagementServiceFactory func(cloud.ClusterScoper) services.PatchManagementInterface
+	objectStoreServiceFactory     func(cloud.ClusterScoper) services.ObjectStoreInterface
+	instanceProfileServiceFactory func(cloud.ClusterScoper) services.InstanceProfileInterface
+	healthServiceFactory          func(cloud.ClusterScoper) services.HealthInterface
+	quotaServiceFactory           func(cloud.ClusterScoper) services.QuotaInterface
+	cloudWatchLogsServiceFactory  func(cloud.ClusterScoper) services.CloudWatchLogsInterface
+	Endpoints                     []scope.ServiceEndpoint
+	WatchFilterValue              string
+	TagUnmanagedNetworkResources  bool
+	// BootstrapDiagnosticsTimeout is the duration after which a running instance that did not become
+	// a node is diagnosed, when the BootstrapFailureDiagnostics feature is enabled.
+	BootstrapDiagnosticsTimeout time.Duration
 }
 
 const (
@@ -117,6 +126,13 @@ func (r *AWSMachineReconciler) getSSMService(scope cloud.ClusterScoper) services
 	return ssm.NewService(scope)
 }
 
+func (r *AWSMachineReconciler) getPatchManagementService(scope cloud.ClusterScoper) services.PatchManagementInterface {
+	if r.patchManagementServiceFactory != nil {
+		return r.patchManagementServiceFactory(scope)
+	}
+	return ssm.NewService(scope)
+}
+
 func (r *AWSMachineReconciler) getSecretService(machineScope *scope.MachineScope, scope cloud.ClusterScoper) (services.SecretInterface, error) {
 	switch machineScope.SecureSecretsBackend() {
 	case infrav1.SecretBackendSSMParameterStore:
@@ -145,7 +161,8 @@ func (r *AWSMachineReconciler) getObjectStoreService(scope scope.S3Scope) servic
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=*,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=create;get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines/status,verbs=get;update;patch
-// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinetemplates,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;patch;delete
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
@@ -224,16 +241,20 @@ func (r *AWSMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	switch infraScope := infraCluster.(type) {
 	case *scope.ManagedControlPlaneScope:
 		if !awsMachine.ObjectMeta.DeletionTimestamp.IsZero() {
-			return r.reconcileDelete(ctx, machineScope, infraScope, infraScope, nil, nil)
+			result, err := r.reconcileDelete(ctx, machineScope, infraScope, infraScope, nil, nil)
+			return scope.RequeueOnWriteFreeze(infraScope.InfraCluster(), result, err)
 		}
 
-		return r.reconcileNormal(ctx, machineScope, infraScope, infraScope, nil, nil)
+		result, err := r.reconcileNormal(ctx, machineScope, infraScope, infraScope, nil, nil)
+		return scope.RequeueOnWriteFreeze(infraScope.InfraCluster(), result, err)
 	case *scope.ClusterScope:
 		if !awsMachine.ObjectMeta.DeletionTimestamp.IsZero() {
-			return r.reconcileDelete(ctx, machineScope, infraScope, infraScope, infraScope, infraScope)
+			result, err := r.reconcileDelete(ctx, machineScope, infraScope, infraScope, infraScope, infraScope)
+			return scope.RequeueOnWriteFreeze(infraScope.InfraCluster(), result, err)
 		}
 
-		return r.reconcileNormal(ctx, machineScope, infraScope, infraScope, infraScope, infraScope)
+		result, err := r.reconcileNormal(ctx, machineScope, infraScope, infraScope, infraScope, infraScope)
+		return scope.RequeueOnWriteFreeze(infraScope.InfraCluster(), result, err)
 	default:
 		return ctrl.Result{}, errors.New("infraCluster has unknown type")
 	}
@@ -308,6 +329,29 @@ func (r *AWSMachineReconciler) reconcileDelete(ctx context.Context, machineScope
 		return ctrl.Result{}, err
 	}
 
+	if machineScope.AWSMachine.Status.BootstrapDiagnosis.IsRunning() {
+		if err := ec2Service.DeleteBootstrapDiagnosis(machineScope.AWSMachine.Status.BootstrapDiagnosis); err != nil {
+			machineScope.Error(err, "unable to delete bootstrap diagnosis")
+			return ctrl.Result{}, err
+		}
+		machineScope.AWSMachine.Status.BootstrapDiagnosis = nil
+	}
+
+	if machineScope.AWSMachine.Status.ReachabilityAnalysis.IsRunning() {
+		if err := ec2Service.DeleteReachabilityAnalysis(machineScope.AWSMachine.Status.ReachabilityAnalysis); err != nil {
+			machineScope.Error(err, "unable to delete reachability analysis")
+			return ctrl.Result{}, err
+		}
+		machineScope.AWSMachine.Status.ReachabilityAnalysis = nil
+	}
+
+	if machineScope.AWSMachine.Status.PatchManagement != nil {
+		if err := r.getPatchManagementService(clusterScope).DeletePatchManagement(machineScope); err != nil {
+			machineScope.Error(err, "unable to delete patch management")
+			return ctrl.Result{}, err
+		}
+	}
+
 	instance, err := r.findInstance(machineScope, ec2Service)
 	if err != nil && err != ec2.ErrInstanceNotFoundByID {
 		machineScope.Error(err, "query to find instance failed")
@@ -324,6 +368,10 @@ func (r *AWSMachineReconciler) reconcileDelete(ctx context.Context, machineScope
 		// 4. Scale controller deployment to 1
 		machineScope.Warn("Unable to locate EC2 instance by ID or tags")
 		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "NoInstanceFound", "Unable to find matching EC2 instance")
+		if err := r.deleteManagedInstanceProfile(ctx, machineScope, clusterScope); err != nil {
+			machineScope.Error(err, "unable to delete IAM instance profile")
+			return ctrl.Result{}, err
+		}
 		controllerutil.RemoveFinalizer(machineScope.AWSMachine, infrav1.MachineFinalizer)
 		return ctrl.Result{}, nil
 	}
@@ -360,6 +408,10 @@ func (r *AWSMachineReconciler) reconcileDelete(ctx context.Context, machineScope
 		return ctrl.Result{RequeueAfter: time.Minute}, nil
 	case infrav1.InstanceStateTerminated:
 		machineScope.Info("EC2 instance terminated successfully", "instance-id", instance.ID)
+		if err := r.deleteManagedInstanceProfile(ctx, machineScope, clusterScope); err != nil {
+			machineScope.Error(err, "unable to delete IAM instance profile")
+			return ctrl.Result{}, err
+		}
 		controllerutil.RemoveFinalizer(machineScope.AWSMachine, infrav1.MachineFinalizer)
 		return ctrl.Result{}, nil
 	default:
@@ -439,11 +491,19 @@ func (r *AWSMachineReconciler) findInstance(machineScope *scope.MachineScope, ec
 		if !errors.Is(err, scope.ErrEmptyProviderID) {
 			return nil, errors.Wrapf(err, "failed to parse Spec.ProviderID")
 		}
-		// If the ProviderID is empty, try to query the instance using tags.
-		// If an instance cannot be found, GetRunningInstanceByTags returns empty instance with nil error.
-		instance, err = ec2svc.GetRunningInstanceByTags(machineScope)
+		// If the ProviderID is empty, e.g. when the AWSMachine was restored from a backup without it, look for the
+		// instance the AWSMachine or its Machine still refer to.
+		instance, err = r.findInstanceByKnownID(machineScope, ec2svc)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to query AWSMachine instance by tags")
+			return nil, err
+		}
+		if instance == nil {
+			// Otherwise, try to query the instance using tags.
+			// If an instance cannot be found, GetRunningInstanceByTags returns empty instance with nil error.
+			instance, err = ec2svc.GetRunningInstanceByTags(machineScope)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to query AWSMachine instance by tags")
+			}
 		}
 	} else {
 		// If the ProviderID is populated, describe the instance using the ID.
@@ -459,6 +519,34 @@ func (r *AWSMachineReconciler) findInstance(machineScope *scope.MachineScope, ec
 	return instance, nil
 }
 
+// findInstanceByKnownID returns the instance whose ID is set in Spec.InstanceID of the AWSMachine or in the ProviderID
+// of its Machine. It returns nil when neither is set, or when the instance does not exist or is being terminated.
+func (r *AWSMachineReconciler) findInstanceByKnownID(machineScope *scope.MachineScope, ec2svc services.EC2Interface) (*infrav1.Instance, error) {
+	id := ptr.Deref(machineScope.AWSMachine.Spec.InstanceID, "")
+	if id == "" {
+		if pid, err := scope.NewProviderID(ptr.Deref(machineScope.Machine.Spec.ProviderID, "")); err == nil {
+			id = pid.ID()
+		}
+	}
+	if id == "" {
+		return nil, nil
+	}
+
+	instance, err := ec2svc.InstanceIfExists(&id)
+	if errors.Is(err, ec2.ErrInstanceNotFoundByID) {
+		machineScope.Info("Instance referenced by the AWSMachine no longer exists", "instance-id", id)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe instance %q", id)
+	}
+	if instance.State == infrav1.InstanceStateShuttingDown || instance.State == infrav1.InstanceStateTerminated {
+		machineScope.Info("Instance referenced by the AWSMachine is terminated", "instance-id", id)
+		return nil, nil
+	}
+	return instance, nil
+}
+
 //nolint:gocyclo
 func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope, elbScope scope.ELBScope, objectStoreScope scope.S3Scope) (ctrl.Result, error) {
 	machineScope.Trace("Reconciling AWSMachine")
@@ -504,6 +592,12 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 		conditions.MarkUnknown(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceNotFoundReason, "%s", err.Error())
 		return ctrl.Result{}, err
 	}
+	if instance != nil && machineScope.GetProviderID() == "" {
+		// The instance was created before, but its ID was not recorded or was lost, e.g. when the AWSMachine was
+		// restored from a backup. Adopt it rather than creating a duplicate.
+		machineScope.Info("Adopting existing EC2 instance", "instance-id", instance.ID)
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "InstanceAdopted", "Adopted existing EC2 instance %s", instance.ID)
+	}
 
 	// If the AWSMachine doesn't have our finalizer, add it.
 	if controllerutil.AddFinalizer(machineScope.AWSMachine, infrav1.MachineFinalizer) {
@@ -531,7 +625,47 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 			objectStoreSvc = r.getObjectStoreService(objectStoreScope)
 		}
 
+		if err := r.reconcileSpotInterruptionFallback(ctx, machineScope); err != nil {
+			machineScope.Error(err, "unable to reconcile Spot fallback")
+			return ctrl.Result{}, err
+		}
+
+		if err := r.reconcileManagedInstanceProfile(ctx, machineScope, clusterScope); err != nil {
+			machineScope.Error(err, "unable to reconcile IAM instance profile")
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceProvisionFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return ctrl.Result{}, err
+		}
+
+		if feature.Gates.Enabled(feature.VCPUQuotaCheck) {
+			available, err := r.reconcileVCPUQuota(machineScope, clusterScope)
+			if err != nil {
+				machineScope.Error(err, "unable to check vCPU quota")
+				return ctrl.Result{}, err
+			}
+			if !available {
+				return ctrl.Result{RequeueAfter: vcpuQuotaRequeueAfter}, nil
+			}
+		}
+
+		if feature.Gates.Enabled(feature.MachineCloudWatchLogs) {
+			if err := r.reconcileCloudWatchLogs(machineScope, clusterScope); err != nil {
+				machineScope.Error(err, "unable to reconcile CloudWatch log group")
+				conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceProvisionFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+				return ctrl.Result{}, err
+			}
+		}
+
 		instance, err = r.createInstance(ctx, ec2svc, machineScope, clusterScope, objectStoreSvc)
+		if notActiveErr, ok := ec2.AsCapacityBlockNotActive(err); ok {
+			machineScope.Info("Waiting for the capacity block to become active", "capacity-reservation-id", notActiveErr.CapacityReservationID, "start-date", notActiveErr.StartDate)
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.CapacityBlockNotActiveReason, clusterv1.ConditionSeverityInfo, "%s", notActiveErr.Error())
+			return ctrl.Result{RequeueAfter: max(notActiveErr.RequeueAfter(), DefaultReconcilerRequeue)}, nil
+		}
+		if pendingErr, ok := ec2.AsAMICopyPending(err); ok {
+			machineScope.Info("Waiting for the copy of the AMI", "source-ami", pendingErr.SourceImageID, "ami", pendingErr.ImageID)
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.AMICopyPendingReason, clusterv1.ConditionSeverityInfo, "%s", pendingErr.Error())
+			return ctrl.Result{RequeueAfter: DefaultReconcilerRequeue}, nil
+		}
 		if err != nil {
 			machineScope.Error(err, "unable to create instance")
 			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceProvisionFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
@@ -577,6 +711,7 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 	// Proceed to reconcile the AWSMachine state.
 	if existingInstanceState == nil || *existingInstanceState != instance.State {
 		machineScope.Info("EC2 instance state changed", "state", instance.State, "instance-id", *machineScope.GetInstanceID())
+		machineScope.RecordTimelineEvent(instanceStateTransitionEvent(existingInstanceState, instance))
 	}
 
 	shouldRequeue := false
@@ -624,6 +759,9 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 		// For machine pool machines, it is expected that the ASG terminates instances at any time,
 		// so no error is logged for those.
 		if instance.State == infrav1.InstanceStateTerminated {
+			if err := r.recordSpotInterruption(ctx, machineScope); err != nil {
+				return ctrl.Result{}, err
+			}
 			machineScope.SetFailureReason("UpdateError")
 			machineScope.SetFailureMessage(errors.Errorf("EC2 instance state %q is unexpected", instance.State))
 		}
@@ -638,7 +776,12 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 		}
 
 		if instance != nil {
-			r.ensureStorageTags(ec2svc, instance, machineScope.AWSMachine, machineScope.AdditionalTags())
+			ownerTags := infrav1.Build(infrav1.BuildParams{
+				ClusterName: ec2Scope.KubernetesClusterName(),
+				Lifecycle:   infrav1.ResourceLifecycleOwned,
+				Additional:  infrav1.Tags{},
+			}.WithCloudProvider(ec2Scope.KubernetesClusterName()))
+			r.ensureStorageTags(ec2svc, instance, machineScope.AWSMachine, machineScope.AdditionalTags(), ownerTags)
 		}
 
 		if err := r.reconcileLBAttachment(machineScope, elbScope, instance); err != nil {
@@ -661,12 +804,150 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 		}
 	}
 
+	if machineScope.AWSMachine.Spec.PatchManagement != nil && instance.State == infrav1.InstanceStateRunning {
+		if err := r.getPatchManagementService(clusterScope).ReconcilePatchManagement(machineScope, instance.ID); err != nil {
+			machineScope.Error(err, "failed to reconcile patch management")
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedPatchManagement", "Failed to associate instance %q with patching resources: %v", instance.ID, err)
+			return ctrl.Result{}, err
+		}
+	}
+
+	if feature.Gates.Enabled(feature.InstanceEventTimeline) && instance.State == infrav1.InstanceStateRunning {
+		if err := r.reconcileInstanceEventTimeline(ec2svc, machineScope, instance); err != nil {
+			machineScope.Error(err, "failed to reconcile instance event timeline")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if feature.Gates.Enabled(feature.InstanceScheduledEvents) && instance.State == infrav1.InstanceStateRunning {
+		if err := r.reconcileScheduledEvents(ctx, ec2svc, machineScope, clusterScope, instance); err != nil {
+			machineScope.Error(err, "failed to reconcile instance scheduled events")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if feature.Gates.Enabled(feature.InstanceAcceleratorInventory) && machineScope.InstanceIsInKnownState() {
+		if err := r.reconcileAcceleratorInventory(ec2svc, machineScope, instance); err != nil {
+			machineScope.Error(err, "failed to reconcile accelerator inventory")
+			return ctrl.Result{}, err
+		}
+	}
+
+	var diagnosisRequeueAfter time.Duration
+	if feature.Gates.Enabled(feature.BootstrapFailureDiagnostics) && !machineScope.IsMachinePoolMachine() {
+		diagnosisRequeueAfter, err = r.reconcileBootstrapDiagnosis(ec2svc, machineScope, instance)
+		if err != nil {
+			machineScope.Error(err, "failed to reconcile bootstrap diagnosis")
+			return ctrl.Result{}, err
+		}
+	}
+
+	reachabilityRequeueAfter, err := r.reconcileReachabilityAnalysis(ec2svc, machineScope, instance)
+	if err != nil {
+		machineScope.Error(err, "failed to reconcile reachability analysis")
+		return ctrl.Result{}, err
+	}
+
 	machineScope.Debug("done reconciling instance", "instance", instance)
 	if shouldRequeue {
 		machineScope.Debug("but find the instance is pending, requeue", "instance", instance.ID)
 		return ctrl.Result{RequeueAfter: DefaultReconcilerRequeue}, nil
 	}
-	return ctrl.Result{}, nil
+	requeueAfter := diagnosisRequeueAfter
+	if reachabilityRequeueAfter > 0 && (requeueAfter == 0 || reachabilityRequeueAfter < requeueAfter) {
+		requeueAfter = reachabilityRequeueAfter
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// instanceStateTransitionEvent returns the timeline event of the transition of the instance to its current state.
+func instanceStateTransitionEvent(previousState *infrav1.InstanceState, instance *infrav1.Instance) infrav1.TimelineEvent {
+	message := fmt.Sprintf("EC2 instance is %s", instance.State)
+	if previousState != nil {
+		message = fmt.Sprintf("EC2 instance state changed from %s to %s", *previousState, instance.State)
+	}
+	return infrav1.TimelineEvent{
+		Type:       infrav1.TimelineEventTypeStateTransition,
+		InstanceID: instance.ID,
+		Reason:     string(instance.State),
+		Message:    message,
+	}
+}
+
+// reconcileInstanceEventTimeline records the interruption notices and status check impairments of a running
+// instance in the AWSMachine timeline, as well as the recovery of its status checks.
+func (r *AWSMachineReconciler) reconcileInstanceEventTimeline(ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
+	events, err := ec2svc.GetInstanceTimelineEvents(instance.ID, machineScope.AWSMachine.Status.Interruptible)
+	if err != nil {
+		return err
+	}
+
+	impaired := false
+	for _, event := range events {
+		if event.Type == infrav1.TimelineEventTypeImpairment {
+			impaired = true
+		}
+		machineScope.RecordTimelineEvent(event)
+	}
+
+	if latest := machineScope.GetLatestTimelineEvent(infrav1.TimelineEventTypeImpairment, instance.ID); !impaired && latest != nil {
+		machineScope.RecordTimelineEvent(infrav1.TimelineEvent{
+			Type:       infrav1.TimelineEventTypeImpairment,
+			InstanceID: instance.ID,
+			Reason:     infrav1.TimelineReasonStatusChecksRecovered,
+			Message:    "EC2 status checks passed",
+		})
+	}
+	return nil
+}
+
+// reconcileBootstrapDiagnosis diagnoses running instances that did not become a node within the bootstrap
+// diagnostics timeout, and removes the diagnosis once they do. It returns the duration after which the
+// diagnosis should be reconciled again, if any.
+func (r *AWSMachineReconciler) reconcileBootstrapDiagnosis(ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) (time.Duration, error) {
+	diagnosis := machineScope.AWSMachine.Status.BootstrapDiagnosis
+	if diagnosis.IsRunning() {
+		if err := ec2svc.UpdateBootstrapDiagnosis(diagnosis); err != nil {
+			return 0, err
+		}
+		if diagnosis.IsRunning() {
+			return DefaultReconcilerRequeue, nil
+		}
+		if len(diagnosis.Findings) > 0 {
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, infrav1.InstanceBootstrapFailedReason, "Instance did not become a node: %s", strings.Join(diagnosis.Findings, "; "))
+		}
+	}
+
+	if machineScope.Machine.Status.NodeRef != nil {
+		if diagnosis != nil {
+			machineScope.Info("Instance became a node, removing bootstrap diagnosis", "instance-id", instance.ID)
+			machineScope.AWSMachine.Status.BootstrapDiagnosis = nil
+		}
+		return 0, nil
+	}
+
+	if diagnosis != nil || instance.State != infrav1.InstanceStateRunning {
+		return 0, nil
+	}
+
+	// The instance is considered to be bootstrapping since it was last observed running.
+	readyCondition := conditions.Get(machineScope.AWSMachine, infrav1.InstanceReadyCondition)
+	if readyCondition == nil || readyCondition.Status != corev1.ConditionTrue {
+		return 0, nil
+	}
+	if remaining := r.BootstrapDiagnosticsTimeout - time.Since(readyCondition.LastTransitionTime.Time); remaining > 0 {
+		return remaining, nil
+	}
+
+	machineScope.Info("Instance did not become a node within the bootstrap diagnostics timeout, collecting diagnosis", "instance-id", instance.ID, "timeout", r.BootstrapDiagnosticsTimeout)
+	diagnosis = ec2svc.DiagnoseInstanceBootstrap(instance, machineScope.Cluster.Spec.ControlPlaneEndpoint)
+	machineScope.AWSMachine.Status.BootstrapDiagnosis = diagnosis
+	if diagnosis.IsRunning() {
+		return DefaultReconcilerRequeue, nil
+	}
+
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, infrav1.InstanceBootstrapFailedReason, "Instance did not become a node: %s", strings.Join(diagnosis.Findings, "; "))
+	return 0, nil
 }
 
 func (r *AWSMachineReconciler) reconcileOperationalState(ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
@@ -679,7 +960,7 @@ func (r *AWSMachineReconciler) reconcileOperationalState(ec2svc services.EC2Inte
 	}
 
 	// Ensure that the security groups are correct.
-	_, err = r.ensureSecurityGroups(ec2svc, machineScope, machineScope.AWSMachine.Spec.AdditionalSecurityGroups, existingSecurityGroups)
+	_, err = r.ensureSecurityGroups(ec2svc, machineScope, machineScope.AdditionalSecurityGroups(), existingSecurityGroups)
 	if err != nil {
 		conditions.MarkFalse(machineScope.AWSMachine, infrav1.SecurityGroupsReadyCondition, infrav1.SecurityGroupsFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
 		machineScope.Error(err, "unable to ensure security groups")
@@ -687,7 +968,7 @@ func (r *AWSMachineReconciler) reconcileOperationalState(ec2svc services.EC2Inte
 	}
 	conditions.MarkTrue(machineScope.AWSMachine, infrav1.SecurityGroupsReadyCondition)
 
-	err = r.ensureInstanceMetadataOptions(ec2svc, instance, machineScope.AWSMachine)
+	err = r.ensureInstanceMetadataOptions(ec2svc, instance, machineScope.InstanceMetadataOptions())
 	if err != nil {
 		machineScope.Error(err, "failed to ensure instance metadata options")
 		return err
@@ -752,6 +1033,36 @@ func (r *AWSMachineReconciler) resolveUserData(ctx context.Context, machineScope
 		return nil, "", err
 	}
 
+	if !machineScope.UseIgnition(userDataFormat) && !machineScope.UseBottlerocket(userDataFormat) {
+		var boothooks []string
+		if machineScope.AWSMachine.Spec.AcceleratorBootstrap != nil {
+			script, err := userdata.NewAccelerator(&userdata.AcceleratorInput{
+				EFA:       machineScope.AWSMachine.Spec.AcceleratorBootstrap.EFA,
+				HugePages: machineScope.AWSMachine.Spec.AcceleratorBootstrap.HugePages,
+			})
+			if err != nil {
+				return nil, "", errors.Wrap(err, "failed to generate the accelerator bootstrap script")
+			}
+			boothooks = append(boothooks, script)
+		}
+		if logGroup := machineScope.AWSMachine.Status.CloudWatchLogGroup; logGroup != "" {
+			script, err := userdata.NewCloudWatchAgent(&userdata.CloudWatchAgentInput{
+				LogGroup: logGroup,
+				Region:   clusterScope.Region(),
+			})
+			if err != nil {
+				return nil, "", errors.Wrap(err, "failed to generate the CloudWatch agent bootstrap script")
+			}
+			boothooks = append(boothooks, script)
+		}
+		if len(boothooks) > 0 {
+			userData, err = userdata.PrependBoothooks(userData, boothooks...)
+			if err != nil {
+				return nil, "", errors.Wrap(err, "failed to prepend the bootstrap scripts")
+			}
+		}
+	}
+
 	if machineScope.UseSecretsManager(userDataFormat) {
 		userData, err = r.cloudInitUserData(machineScope, clusterScope, userData)
 	}
@@ -1256,26 +1567,41 @@ func (r *AWSMachineReconciler) indexAWSMachineByInstanceID(o client.Object) []st
 	return nil
 }
 
-func (r *AWSMachineReconciler) ensureStorageTags(ec2svc services.EC2Interface, instance *infrav1.Instance, machine *infrav1.AWSMachine, additionalTags map[string]string) {
+// ensureStorageTags ensures that the volumes of the instance have the additional tags and the cluster ownership tags,
+// so that they are accounted to the cluster and can be garbage collected with it.
+// The volumes of the instances launched by the controller are tagged with the ownership tags at launch, only the
+// volumes whose tags were last applied by a previous version of the controller lack them.
+func (r *AWSMachineReconciler) ensureStorageTags(ec2svc services.EC2Interface, instance *infrav1.Instance, machine *infrav1.AWSMachine, additionalTags, ownerTags map[string]string) {
 	prevAnnotations, err := r.machineAnnotationJSON(machine, VolumeTagsLastAppliedAnnotation)
 	if err != nil {
 		r.Log.Error(err, "Failed to fetch the annotations for volume tags")
 	}
+
+	volumeTags := make(map[string]string, len(additionalTags)+len(ownerTags))
+	for k, v := range additionalTags {
+		volumeTags[k] = v
+	}
+	for k, v := range ownerTags {
+		volumeTags[k] = v
+	}
+
 	annotations := make(map[string]interface{}, len(instance.VolumeIDs))
 	for _, volumeID := range instance.VolumeIDs {
-		if subAnnotation, ok := prevAnnotations[volumeID].(map[string]interface{}); ok {
-			newAnnotation, err := r.ensureVolumeTags(ec2svc, aws.String(volumeID), subAnnotation, additionalTags)
-			if err != nil {
-				r.Log.Error(err, "Failed to fetch the changed volume tags in EC2 instance")
-			}
-			annotations[volumeID] = newAnnotation
-		} else {
-			newAnnotation, err := r.ensureVolumeTags(ec2svc, aws.String(volumeID), make(map[string]interface{}), additionalTags)
-			if err != nil {
-				r.Log.Error(err, "Failed to fetch the changed volume tags in EC2 instance")
+		subAnnotation, ok := prevAnnotations[volumeID].(map[string]interface{})
+		if !ok {
+			subAnnotation = make(map[string]interface{}, len(ownerTags))
+			for k, v := range ownerTags {
+				subAnnotation[k] = v
 			}
-			annotations[volumeID] = newAnnotation
 		}
+		newAnnotation, err := r.ensureVolumeTags(ec2svc, aws.String(volumeID), subAnnotation, volumeTags)
+		if err != nil {
+			r.Log.Error(err, "Failed to fetch the changed volume tags in EC2 instance")
+			r.Recorder.Eventf(machine, corev1.EventTypeWarning, "FailedTagVolume", "Failed to tag volume %q: %v", volumeID, err)
+			// Keep the last applied tags, so that the tags are applied again on the next reconciliation.
+			newAnnotation = subAnnotation
+		}
+		annotations[volumeID] = newAnnotation
 	}
 
 	if !cmp.Equal(prevAnnotations, annotations, cmpopts.EquateEmpty()) {
@@ -1287,10 +1613,10 @@ func (r *AWSMachineReconciler) ensureStorageTags(ec2svc services.EC2Interface, i
 	}
 }
 
-func (r *AWSMachineReconciler) ensureInstanceMetadataOptions(ec2svc services.EC2Interface, instance *infrav1.Instance, machine *infrav1.AWSMachine) error {
-	if cmp.Equal(machine.Spec.InstanceMetadataOptions, instance.InstanceMetadataOptions) {
+func (r *AWSMachineReconciler) ensureInstanceMetadataOptions(ec2svc services.EC2Interface, instance *infrav1.Instance, options *infrav1.InstanceMetadataOptions) error {
+	if options == nil || cmp.Equal(options, instance.InstanceMetadataOptions) {
 		return nil
 	}
 
-	return ec2svc.ModifyInstanceMetadataOptions(instance.ID, machine.Spec.InstanceMetadataOptions)
+	return ec2svc.ModifyInstanceMetadataOptions(instance.ID, options)
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
@@ -49,11 +50,13 @@ import (
 	ec2Service "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
 	elbService "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/elb"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/quota"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	kubeadmv1beta1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 const providerID = "aws:////myMachine"
@@ -2791,3 +2794,599 @@ func cleanupObject(g *WithT, obj client.Object) {
 		g.Expect(testEnv.Cleanup(ctx, obj)).To(Succeed())
 	}
 }
+
+func TestAWSMachineReconcilerDeleteManagedInstanceProfile(t *testing.T) {
+	newAWSMachine := func(name string, profileName string) *infrav1.AWSMachine {
+		return &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				UID:       types.UID(name),
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: "test"},
+			},
+			Spec: infrav1.AWSMachineSpec{
+				ManagedIAMInstanceProfile: &infrav1.ManagedIAMInstanceProfile{Name: profileName},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		others     []client.Object
+		wantDelete bool
+	}{
+		{
+			name:       "deletes the instance profile of the last AWSMachine declaring it",
+			others:     []client.Object{newAWSMachine("other", "other-workers")},
+			wantDelete: true,
+		},
+		{
+			name:   "keeps the instance profile still declared by another AWSMachine",
+			others: []client.Object{newAWSMachine("other", "gpu-workers")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			instanceProfileSvc := mock_services.NewMockInstanceProfileInterface(mockCtrl)
+			if tt.wantDelete {
+				instanceProfileSvc.EXPECT().DeleteInstanceProfile(gomock.Any(), "gpu-workers").Return(nil)
+			}
+
+			awsMachine := newAWSMachine("test", "gpu-workers")
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(tt.others, awsMachine)...).Build()
+			ms, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       client,
+				Cluster:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}},
+				Machine:      &clusterv1.Machine{},
+				InfraCluster: &scope.ClusterScope{},
+				AWSMachine:   awsMachine,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			reconciler := AWSMachineReconciler{
+				Client: client,
+				instanceProfileServiceFactory: func(cloud.ClusterScoper) services.InstanceProfileInterface {
+					return instanceProfileSvc
+				},
+				Recorder: record.NewFakeRecorder(10),
+			}
+			g.Expect(reconciler.deleteManagedInstanceProfile(context.TODO(), ms, &scope.ClusterScope{})).To(Succeed())
+		})
+	}
+}
+
+func TestAWSMachineReconcilerReconcileScheduledEvents(t *testing.T) {
+	retirement := infrav1.ScheduledEvent{
+		Source:     infrav1.ScheduledEventSourceEC2,
+		Code:       "instance-retirement",
+		Disruptive: true,
+		NotBefore:  &metav1.Time{Time: time.Now().Add(2 * time.Hour)},
+	}
+	maintenance := infrav1.ScheduledEvent{
+		Source:    infrav1.ScheduledEventSourceAWSHealth,
+		Code:      "AWS_EC2_SYSTEM_MAINTENANCE_EVENT",
+		NotBefore: &metav1.Time{Time: time.Now().Add(2 * time.Hour)},
+	}
+
+	tests := []struct {
+		name            string
+		remediation     *infrav1.ScheduledEventRemediation
+		ec2Events       []infrav1.ScheduledEvent
+		healthEvents    []infrav1.ScheduledEvent
+		wantStatus      corev1.ConditionStatus
+		wantReason      string
+		wantRemediation bool
+	}{
+		{
+			name:       "no scheduled events",
+			wantStatus: corev1.ConditionTrue,
+		},
+		{
+			name:         "reports the scheduled events without remediation",
+			ec2Events:    []infrav1.ScheduledEvent{retirement},
+			healthEvents: []infrav1.ScheduledEvent{maintenance},
+			wantStatus:   corev1.ConditionFalse,
+			wantReason:   infrav1.InstanceEventScheduledReason,
+		},
+		{
+			name:            "requests the remediation ahead of a disruptive event within the lead time",
+			remediation:     &infrav1.ScheduledEventRemediation{},
+			ec2Events:       []infrav1.ScheduledEvent{retirement},
+			wantStatus:      corev1.ConditionFalse,
+			wantReason:      infrav1.RemediationRequestedReason,
+			wantRemediation: true,
+		},
+		{
+			name:        "does not request the remediation ahead of a disruptive event after the lead time",
+			remediation: &infrav1.ScheduledEventRemediation{LeadTime: &metav1.Duration{Duration: time.Hour}},
+			ec2Events:   []infrav1.ScheduledEvent{retirement},
+			wantStatus:  corev1.ConditionFalse,
+			wantReason:  infrav1.InstanceEventScheduledReason,
+		},
+		{
+			name:         "does not request the remediation ahead of a non disruptive event",
+			remediation:  &infrav1.ScheduledEventRemediation{},
+			healthEvents: []infrav1.ScheduledEvent{maintenance},
+			wantStatus:   corev1.ConditionFalse,
+			wantReason:   infrav1.InstanceEventScheduledReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			ec2Svc.EXPECT().GetInstanceScheduledEvents("i-1").Return(tt.ec2Events, nil)
+			healthSvc := mock_services.NewMockHealthInterface(mockCtrl)
+			healthSvc.EXPECT().GetInstanceHealthEvents("i-1").Return(tt.healthEvents, nil)
+
+			machine := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec:       infrav1.AWSMachineSpec{ScheduledEventRemediation: tt.remediation},
+			}
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = clusterv1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(machine, awsMachine).Build()
+			ms, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       client,
+				Cluster:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}},
+				Machine:      machine,
+				InfraCluster: &scope.ClusterScope{},
+				AWSMachine:   awsMachine,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			reconciler := AWSMachineReconciler{
+				Client: client,
+				healthServiceFactory: func(cloud.ClusterScoper) services.HealthInterface {
+					return healthSvc
+				},
+				Recorder: record.NewFakeRecorder(10),
+			}
+			err = reconciler.reconcileScheduledEvents(context.TODO(), ec2Svc, ms, &scope.ClusterScope{}, &infrav1.Instance{ID: "i-1"})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			g.Expect(awsMachine.Status.ScheduledEvents).To(HaveLen(len(tt.ec2Events) + len(tt.healthEvents)))
+			condition := conditions.Get(awsMachine, infrav1.NoScheduledEventsCondition)
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantStatus))
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+
+			updated := &clusterv1.Machine{}
+			g.Expect(client.Get(context.TODO(), types.NamespacedName{Name: "test", Namespace: "default"}, updated)).To(Succeed())
+			_, remediate := updated.Annotations[clusterv1.RemediateMachineAnnotation]
+			g.Expect(remediate).To(Equal(tt.wantRemediation))
+		})
+	}
+}
+
+func TestAWSMachineReconcilerReconcileAcceleratorInventory(t *testing.T) {
+	h100 := infrav1.Accelerator{Type: infrav1.AcceleratorTypeGPU, Manufacturer: "NVIDIA", Name: "H100", Count: 8, MemoryMiB: 81920, MIGCapable: true}
+
+	tests := []struct {
+		name          string
+		inventory     *infrav1.AcceleratorInventory
+		instanceType  string
+		expect        func(m *mock_services.MockEC2InterfaceMockRecorder)
+		wantInventory *infrav1.AcceleratorInventory
+	}{
+		{
+			name:         "resolves the inventory of the instance type",
+			instanceType: "p5.48xlarge",
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.GetInstanceTypeAccelerators("p5.48xlarge").Return([]infrav1.Accelerator{h100}, nil)
+			},
+			wantInventory: &infrav1.AcceleratorInventory{
+				InstanceType: "p5.48xlarge",
+				GPUCount:     8,
+				GPUMemoryMiB: 655360,
+				GPUTypes:     []string{"H100"},
+				MIGCapable:   true,
+			},
+		},
+		{
+			name:         "reports an empty inventory for an instance type without accelerators",
+			instanceType: "m5.large",
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.GetInstanceTypeAccelerators("m5.large").Return(nil, nil)
+			},
+			wantInventory: &infrav1.AcceleratorInventory{InstanceType: "m5.large"},
+		},
+		{
+			name:          "does not describe the instance type again",
+			inventory:     &infrav1.AcceleratorInventory{InstanceType: "m5.large"},
+			instanceType:  "m5.large",
+			expect:        func(m *mock_services.MockEC2InterfaceMockRecorder) {},
+			wantInventory: &infrav1.AcceleratorInventory{InstanceType: "m5.large"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			tt.expect(ec2Svc.EXPECT())
+
+			ms := &scope.MachineScope{
+				AWSMachine: &infrav1.AWSMachine{
+					Status: infrav1.AWSMachineStatus{AcceleratorInventory: tt.inventory},
+				},
+			}
+
+			reconciler := AWSMachineReconciler{}
+			err := reconciler.reconcileAcceleratorInventory(ec2Svc, ms, &infrav1.Instance{ID: "i-1", Type: tt.instanceType})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(ms.AWSMachine.Status.AcceleratorInventory).To(Equal(tt.wantInventory))
+		})
+	}
+}
+
+func TestAWSMachineReconcilerReconcileVCPUQuota(t *testing.T) {
+	tests := []struct {
+		name              string
+		spec              infrav1.AWSMachineSpec
+		spotFallback      *infrav1.SpotFallbackStatus
+		expect            func(m *mock_services.MockQuotaInterfaceMockRecorder)
+		wantLaunch        bool
+		wantErr           bool
+		wantCondition     bool
+		wantConditionTrue bool
+		wantReason        string
+	}{
+		{
+			name: "allows the launch when the quota has headroom",
+			spec: infrav1.AWSMachineSpec{InstanceType: "m5.large"},
+			expect: func(m *mock_services.MockQuotaInterfaceMockRecorder) {
+				m.CheckVCPUQuota("m5.large", false, int32(1)).Return(nil)
+			},
+			wantLaunch:        true,
+			wantCondition:     true,
+			wantConditionTrue: true,
+		},
+		{
+			name: "blocks the launch when the quota is exceeded",
+			spec: infrav1.AWSMachineSpec{InstanceType: "p5.48xlarge"},
+			expect: func(m *mock_services.MockQuotaInterfaceMockRecorder) {
+				m.CheckVCPUQuota("p5.48xlarge", false, int32(1)).Return(&quota.VCPUQuotaExceededError{QuotaCode: "L-417A185B", Required: 192, Available: 96})
+			},
+			wantCondition: true,
+			wantReason:    infrav1.QuotaExceededReason,
+		},
+		{
+			name: "checks the spot quota of spot instances",
+			spec: infrav1.AWSMachineSpec{InstanceType: "m5.large", SpotMarketOptions: &infrav1.SpotMarketOptions{}},
+			expect: func(m *mock_services.MockQuotaInterfaceMockRecorder) {
+				m.CheckVCPUQuota("m5.large", true, int32(1)).Return(nil)
+			},
+			wantLaunch:        true,
+			wantCondition:     true,
+			wantConditionTrue: true,
+		},
+		{
+			name:         "checks the on-demand quota once spot instances fell back to on-demand",
+			spec:         infrav1.AWSMachineSpec{InstanceType: "m5.large", SpotMarketOptions: &infrav1.SpotMarketOptions{}},
+			spotFallback: &infrav1.SpotFallbackStatus{OnDemand: true},
+			expect: func(m *mock_services.MockQuotaInterfaceMockRecorder) {
+				m.CheckVCPUQuota("m5.large", false, int32(1)).Return(nil)
+			},
+			wantLaunch:        true,
+			wantCondition:     true,
+			wantConditionTrue: true,
+		},
+		{
+			name:       "skips capacity blocks",
+			spec:       infrav1.AWSMachineSpec{InstanceType: "p5.48xlarge", MarketType: infrav1.MarketTypeCapacityBlock},
+			expect:     func(m *mock_services.MockQuotaInterfaceMockRecorder) {},
+			wantLaunch: true,
+		},
+		{
+			name: "reports a failed check",
+			spec: infrav1.AWSMachineSpec{InstanceType: "m5.large"},
+			expect: func(m *mock_services.MockQuotaInterfaceMockRecorder) {
+				m.CheckVCPUQuota("m5.large", false, int32(1)).Return(errors.New("throttled"))
+			},
+			wantErr:       true,
+			wantCondition: true,
+			wantReason:    infrav1.QuotaCheckFailedReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			quotaSvc := mock_services.NewMockQuotaInterface(mockCtrl)
+			tt.expect(quotaSvc.EXPECT())
+
+			ms := &scope.MachineScope{
+				AWSMachine: &infrav1.AWSMachine{
+					Spec:   tt.spec,
+					Status: infrav1.AWSMachineStatus{SpotFallback: tt.spotFallback},
+				},
+			}
+			reconciler := AWSMachineReconciler{
+				Recorder: record.NewFakeRecorder(10),
+				quotaServiceFactory: func(cloud.ClusterScoper) services.QuotaInterface {
+					return quotaSvc
+				},
+			}
+
+			launch, err := reconciler.reconcileVCPUQuota(ms, nil)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(launch).To(Equal(tt.wantLaunch))
+
+			condition := conditions.Get(ms.AWSMachine, infrav1.VCPUQuotaAvailableCondition)
+			if !tt.wantCondition {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status == corev1.ConditionTrue).To(Equal(tt.wantConditionTrue))
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+		})
+	}
+}
+
+func TestAWSMachineReconcilerFindInstance(t *testing.T) {
+	running := &infrav1.Instance{ID: "i-running", State: infrav1.InstanceStateRunning}
+	byTags := &infrav1.Instance{ID: "i-tags", State: infrav1.InstanceStateRunning}
+
+	tests := []struct {
+		name              string
+		awsMachineSpec    infrav1.AWSMachineSpec
+		machineProviderID *string
+		expect            func(m *mock_services.MockEC2InterfaceMockRecorder)
+		want              *infrav1.Instance
+		wantErr           bool
+	}{
+		{
+			name:           "finds the instance by the ProviderID of the AWSMachine",
+			awsMachineSpec: infrav1.AWSMachineSpec{ProviderID: ptr.To("aws:///us-east-1a/i-running")},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.InstanceIfExists(ptr.To("i-running")).Return(running, nil)
+			},
+			want: running,
+		},
+		{
+			name:           "adopts the instance set in the InstanceID of the AWSMachine without ProviderID",
+			awsMachineSpec: infrav1.AWSMachineSpec{InstanceID: ptr.To("i-running")},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.InstanceIfExists(ptr.To("i-running")).Return(running, nil)
+			},
+			want: running,
+		},
+		{
+			name:              "adopts the instance set in the ProviderID of the Machine",
+			machineProviderID: ptr.To("aws:///us-east-1a/i-running"),
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.InstanceIfExists(ptr.To("i-running")).Return(running, nil)
+			},
+			want: running,
+		},
+		{
+			name:           "finds the instance by tags when the known instance no longer exists",
+			awsMachineSpec: infrav1.AWSMachineSpec{InstanceID: ptr.To("i-deleted")},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.InstanceIfExists(ptr.To("i-deleted")).Return(nil, ec2Service.ErrInstanceNotFoundByID)
+				m.GetRunningInstanceByTags(gomock.Any()).Return(byTags, nil)
+			},
+			want: byTags,
+		},
+		{
+			name:              "finds the instance by tags when the known instance is terminated",
+			machineProviderID: ptr.To("aws:///us-east-1a/i-terminated"),
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.InstanceIfExists(ptr.To("i-terminated")).Return(&infrav1.Instance{ID: "i-terminated", State: infrav1.InstanceStateTerminated}, nil)
+				m.GetRunningInstanceByTags(gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "finds the instance by tags without any known instance",
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.GetRunningInstanceByTags(gomock.Any()).Return(byTags, nil)
+			},
+			want: byTags,
+		},
+		{
+			name:           "fails when the known instance cannot be described",
+			awsMachineSpec: infrav1.AWSMachineSpec{InstanceID: ptr.To("i-running")},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.InstanceIfExists(ptr.To("i-running")).Return(nil, ec2Service.ErrDescribeInstance)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			tt.expect(ec2Svc.EXPECT())
+
+			ms := &scope.MachineScope{
+				Logger:     *logger.NewLogger(klog.Background()),
+				Machine:    &clusterv1.Machine{Spec: clusterv1.MachineSpec{ProviderID: tt.machineProviderID}},
+				AWSMachine: &infrav1.AWSMachine{Spec: tt.awsMachineSpec},
+			}
+			reconciler := AWSMachineReconciler{}
+
+			instance, err := reconciler.findInstance(ms, ec2Svc)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(instance).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestAWSMachineReconcilerReconcileCloudWatchLogs(t *testing.T) {
+	tests := []struct {
+		name          string
+		awsMachine    *infrav1.AWSMachine
+		expect        func(m *mock_services.MockCloudWatchLogsInterfaceMockRecorder)
+		wantErr       bool
+		wantLogGroup  string
+		wantCondition bool
+		wantReason    string
+	}{
+		{
+			name: "uses the log group of the template the machine was cloned from",
+			awsMachine: &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "workers-abcde",
+					Annotations: map[string]string{clusterv1.TemplateClonedFromNameAnnotation: "workers"},
+				},
+				Spec: infrav1.AWSMachineSpec{CloudWatchLogs: &infrav1.CloudWatchLogs{RetentionInDays: 30}},
+			},
+			expect: func(m *mock_services.MockCloudWatchLogsInterfaceMockRecorder) {
+				m.ReconcileLogGroup("/aws/capa/test-cluster/workers", int32(30)).Return(nil)
+			},
+			wantLogGroup:  "/aws/capa/test-cluster/workers",
+			wantCondition: true,
+		},
+		{
+			name: "uses the log group of the machine itself when it was not cloned from a template",
+			awsMachine: &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "standalone"},
+				Spec:       infrav1.AWSMachineSpec{CloudWatchLogs: &infrav1.CloudWatchLogs{RetentionInDays: 7}},
+			},
+			expect: func(m *mock_services.MockCloudWatchLogsInterfaceMockRecorder) {
+				m.ReconcileLogGroup("/aws/capa/test-cluster/standalone", int32(7)).Return(nil)
+			},
+			wantLogGroup:  "/aws/capa/test-cluster/standalone",
+			wantCondition: true,
+		},
+		{
+			name: "reports a failed reconciliation",
+			awsMachine: &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "standalone"},
+				Spec:       infrav1.AWSMachineSpec{CloudWatchLogs: &infrav1.CloudWatchLogs{RetentionInDays: 7}},
+			},
+			expect: func(m *mock_services.MockCloudWatchLogsInterfaceMockRecorder) {
+				m.ReconcileLogGroup("/aws/capa/test-cluster/standalone", int32(7)).Return(errors.New("access denied"))
+			},
+			wantErr:       true,
+			wantCondition: true,
+			wantReason:    infrav1.CloudWatchLogsReconciliationFailedReason,
+		},
+		{
+			name: "does nothing when CloudWatch logs are not configured",
+			awsMachine: &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "standalone"},
+			},
+			expect: func(m *mock_services.MockCloudWatchLogsInterfaceMockRecorder) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			logsSvc := mock_services.NewMockCloudWatchLogsInterface(mockCtrl)
+			tt.expect(logsSvc.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster:    &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"}},
+				AWSCluster: &infrav1.AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			ms := &scope.MachineScope{AWSMachine: tt.awsMachine}
+			reconciler := AWSMachineReconciler{
+				cloudWatchLogsServiceFactory: func(cloud.ClusterScoper) services.CloudWatchLogsInterface {
+					return logsSvc
+				},
+			}
+
+			err = reconciler.reconcileCloudWatchLogs(ms, cs)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(ms.AWSMachine.Status.CloudWatchLogGroup).To(Equal(tt.wantLogGroup))
+
+			condition := conditions.Get(ms.AWSMachine, infrav1.CloudWatchLogsReadyCondition)
+			if !tt.wantCondition {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+		})
+	}
+}
+
+func TestAWSMachineReconcilerEnsureStorageTags(t *testing.T) {
+	ownerTags := map[string]string{
+		"sigs.k8s.io/cluster-api-provider-aws/cluster/test": "owned",
+		"kubernetes.io/cluster/test":                        "owned",
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expect      func(m *mock_services.MockEC2InterfaceMockRecorder)
+	}{
+		{
+			name: "only applies the additional tags to the volumes of a new instance",
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.UpdateResourceTags(PointsTo("volume-1"), map[string]string{"colour": "pink"}, map[string]string{}).Return(nil)
+			},
+		},
+		{
+			name: "applies the ownership tags to the volumes whose tags were last applied without them",
+			annotations: map[string]string{
+				VolumeTagsLastAppliedAnnotation: `{"volume-1":{"colour":"pink"}}`,
+			},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.UpdateResourceTags(PointsTo("volume-1"), ownerTags, map[string]string{}).Return(nil)
+			},
+		},
+		{
+			name: "does not tag the volumes whose tags are up to date",
+			annotations: map[string]string{
+				VolumeTagsLastAppliedAnnotation: `{"volume-1":{"colour":"pink","sigs.k8s.io/cluster-api-provider-aws/cluster/test":"owned","kubernetes.io/cluster/test":"owned"}}`,
+			},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			tt.expect(ec2Svc.EXPECT())
+
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Annotations: map[string]string{}},
+			}
+			for k, v := range tt.annotations {
+				awsMachine.Annotations[k] = v
+			}
+			reconciler := AWSMachineReconciler{Recorder: record.NewFakeRecorder(10)}
+
+			reconciler.ensureStorageTags(ec2Svc, &infrav1.Instance{VolumeIDs: []string{"volume-1"}}, awsMachine, map[string]string{"colour": "pink"}, ownerTags)
+
+			annotation, err := reconciler.machineAnnotationJSON(awsMachine, VolumeTagsLastAppliedAnnotation)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(annotation).To(HaveKeyWithValue("volume-1", map[string]interface{}{
+				"colour": "pink",
+				"sigs.k8s.io/cluster-api-provider-aws/cluster/test": "owned",
+				"kubernetes.io/cluster/test":                        "owned",
+			}))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instanceprofile"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func (r *AWSMachineReconciler) getInstanceProfileService(scope cloud.ClusterScoper) services.InstanceProfileInterface {
+	if r.instanceProfileServiceFactory != nil {
+		return r.instanceProfileServiceFactory(scope)
+	}
+
+	return instanceprofile.NewService(scope)
+}
+
+// reconcileManagedInstanceProfile ensures that the managed IAM instance profile of the AWSMachine exists
+// before its instance is created.
+func (r *AWSMachineReconciler) reconcileManagedInstanceProfile(ctx context.Context, machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper) error {
+	profile := machineScope.AWSMachine.Spec.ManagedIAMInstanceProfile
+	if profile == nil {
+		return nil
+	}
+
+	if err := r.getInstanceProfileService(clusterScope).ReconcileInstanceProfile(ctx, profile); err != nil {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedReconcileInstanceProfile", "Failed to reconcile IAM instance profile %q: %v", profile.Name, err)
+		return err
+	}
+	return nil
+}
+
+// deleteManagedInstanceProfile deletes the managed IAM instance profile of the AWSMachine once its instance is
+// terminated, unless another AWSMachine of the cluster declares the same instance profile.
+func (r *AWSMachineReconciler) deleteManagedInstanceProfile(ctx context.Context, machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper) error {
+	profile := machineScope.AWSMachine.Spec.ManagedIAMInstanceProfile
+	if profile == nil {
+		return nil
+	}
+
+	machines := &infrav1.AWSMachineList{}
+	if err := r.Client.List(ctx, machines, client.InNamespace(machineScope.Namespace()), client.MatchingLabels{clusterv1.ClusterNameLabel: machineScope.Cluster.Name}); err != nil {
+		return errors.Wrap(err, "failed to list the AWSMachines of the cluster")
+	}
+	for _, machine := range machines.Items {
+		if machine.UID == machineScope.AWSMachine.UID || machine.Spec.ManagedIAMInstanceProfile == nil {
+			continue
+		}
+		if machine.Spec.ManagedIAMInstanceProfile.Name == profile.Name {
+			machineScope.Debug("IAM instance profile is still used by another AWSMachine", "instance-profile", profile.Name, "awsmachine", machine.Name)
+			return nil
+		}
+	}
+
+	if err := r.getInstanceProfileService(clusterScope).DeleteInstanceProfile(ctx, profile.Name); err != nil {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedDeleteInstanceProfile", "Failed to delete IAM instance profile %q: %v", profile.Name, err)
+		return err
+	}
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulDeleteInstanceProfile", "Deleted IAM instance profile %q", profile.Name)
+	return nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/quota"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// vcpuQuotaRequeueAfter is how long to wait before checking the vCPU quota again once it is exceeded.
+const vcpuQuotaRequeueAfter = 5 * time.Minute
+
+func (r *AWSMachineReconciler) getQuotaService(scope cloud.ClusterScoper) services.QuotaInterface {
+	if r.quotaServiceFactory != nil {
+		return r.quotaServiceFactory(scope)
+	}
+
+	return quota.NewService(scope)
+}
+
+// reconcileVCPUQuota ensures that the vCPU quota of the account leaves room for the instance of the AWSMachine,
+// and reports it in the VCPUQuotaAvailable condition. It returns false if the instance must not be launched.
+func (r *AWSMachineReconciler) reconcileVCPUQuota(machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper) (bool, error) {
+	awsMachine := machineScope.AWSMachine
+	// The instance type selected from the instance requirements is not known yet, and the capacity of
+	// capacity blocks is reserved ahead.
+	if awsMachine.Spec.InstanceType == "" || awsMachine.Spec.MarketType == infrav1.MarketTypeCapacityBlock {
+		conditions.Delete(awsMachine, infrav1.VCPUQuotaAvailableCondition)
+		return true, nil
+	}
+	spot := awsMachine.Spec.SpotMarketOptions != nil && !machineScope.IsSpotFallbackOnDemand()
+
+	err := r.getQuotaService(clusterScope).CheckVCPUQuota(awsMachine.Spec.InstanceType, spot, 1)
+	if exceededErr, ok := quota.AsVCPUQuotaExceeded(err); ok {
+		r.Recorder.Eventf(awsMachine, corev1.EventTypeWarning, "VCPUQuotaExceeded", "%s", exceededErr.Error())
+		conditions.MarkFalse(awsMachine, infrav1.VCPUQuotaAvailableCondition, infrav1.QuotaExceededReason, clusterv1.ConditionSeverityWarning, "%s", exceededErr.Error())
+		conditions.MarkFalse(awsMachine, infrav1.InstanceReadyCondition, infrav1.QuotaExceededReason, clusterv1.ConditionSeverityWarning, "vCPU quota %s exceeded", exceededErr.QuotaCode)
+		return false, nil
+	}
+	if err != nil {
+		conditions.MarkFalse(awsMachine, infrav1.VCPUQuotaAvailableCondition, infrav1.QuotaCheckFailedReason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return false, err
+	}
+
+	conditions.MarkTrue(awsMachine, infrav1.VCPUQuotaAvailableCondition)
+	return true, nil
+}
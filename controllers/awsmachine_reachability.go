@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	corev1 "k8s.io/api/core/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+)
+
+// reconcileReachabilityAnalysis starts the VPC Reachability Analyzer analysis requested with the
+// aws.cluster.x-k8s.io/reachability-analysis annotation, and reports its result in the AWSMachine status and in an
+// event once complete. It returns the duration after which the running analysis should be reconciled again, if any.
+func (r *AWSMachineReconciler) reconcileReachabilityAnalysis(ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) (time.Duration, error) {
+	awsMachine := machineScope.AWSMachine
+	if reachability := awsMachine.Status.ReachabilityAnalysis; reachability.IsRunning() {
+		if err := ec2svc.UpdateReachabilityAnalysis(reachability); err != nil {
+			return 0, err
+		}
+		// A new analysis is only started once the running one is complete.
+		if reachability.IsRunning() {
+			return DefaultReconcilerRequeue, nil
+		}
+		r.recordReachabilityAnalysisResult(awsMachine, reachability)
+	}
+
+	value, ok := awsMachine.GetAnnotations()[infrav1.ReachabilityAnalysisAnnotation]
+	if !ok {
+		return 0, nil
+	}
+	// The analysis is only attempted once per annotation, so that a failure to start it is not retried forever.
+	annotations := awsMachine.GetAnnotations()
+	delete(annotations, infrav1.ReachabilityAnalysisAnnotation)
+	awsMachine.SetAnnotations(annotations)
+
+	target := infrav1.ReachabilityAnalysisTarget(value)
+	if target == "" {
+		target = infrav1.ReachabilityAnalysisTargetAPIServer
+	}
+	if target != infrav1.ReachabilityAnalysisTargetAPIServer && target != infrav1.ReachabilityAnalysisTargetBastion {
+		r.Recorder.Eventf(awsMachine, corev1.EventTypeWarning, "FailedReachabilityAnalysis", "Unsupported reachability analysis target %q, must be %s or %s",
+			value, infrav1.ReachabilityAnalysisTargetAPIServer, infrav1.ReachabilityAnalysisTargetBastion)
+		return 0, nil
+	}
+
+	machineScope.Info("Starting reachability analysis", "instance-id", instance.ID, "target", target)
+	reachability, err := ec2svc.StartReachabilityAnalysis(target, instance, machineScope.Cluster.Spec.ControlPlaneEndpoint)
+	if err != nil {
+		machineScope.Error(err, "failed to start reachability analysis", "instance-id", instance.ID, "target", target)
+		r.Recorder.Eventf(awsMachine, corev1.EventTypeWarning, "FailedReachabilityAnalysis", "Failed to start %s reachability analysis: %v", target, err)
+		return 0, nil
+	}
+	awsMachine.Status.ReachabilityAnalysis = reachability
+	return DefaultReconcilerRequeue, nil
+}
+
+// recordReachabilityAnalysisResult records an event describing the result of a complete reachability analysis.
+func (r *AWSMachineReconciler) recordReachabilityAnalysisResult(awsMachine *infrav1.AWSMachine, reachability *infrav1.ReachabilityAnalysis) {
+	path := "from the instance to the API server endpoint"
+	if reachability.Target == infrav1.ReachabilityAnalysisTargetBastion {
+		path = "from the bastion host to the SSH port of the instance"
+	}
+
+	switch {
+	case reachability.Status != ec2.AnalysisStatusSucceeded:
+		r.Recorder.Eventf(awsMachine, corev1.EventTypeWarning, "FailedReachabilityAnalysis", "Reachability analysis %s failed: %s", path, reachability.StatusMessage)
+	case aws.BoolValue(reachability.NetworkPathFound):
+		r.Recorder.Eventf(awsMachine, corev1.EventTypeNormal, "NetworkPathFound", "Found a network path %s", path)
+	default:
+		r.Recorder.Eventf(awsMachine, corev1.EventTypeWarning, "NetworkPathNotFound", "No network path %s: %s", path, strings.Join(reachability.Explanations, "; "))
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/health"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func (r *AWSMachineReconciler) getHealthService(scope cloud.ClusterScoper) services.HealthInterface {
+	if r.healthServiceFactory != nil {
+		return r.healthServiceFactory(scope)
+	}
+
+	return health.NewService(scope)
+}
+
+// reconcileScheduledEvents reports the upcoming EC2 scheduled events and AWS Health events affecting a running
+// instance in the AWSMachine status and NoScheduledEvents condition and, when ScheduledEventRemediation is set,
+// requests the remediation of the Machine ahead of the disruptive events.
+func (r *AWSMachineReconciler) reconcileScheduledEvents(ctx context.Context, ec2svc services.EC2Interface, machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper, instance *infrav1.Instance) error {
+	events, err := ec2svc.GetInstanceScheduledEvents(instance.ID)
+	if err != nil {
+		return err
+	}
+	healthEvents, err := r.getHealthService(clusterScope).GetInstanceHealthEvents(instance.ID)
+	if err != nil {
+		return err
+	}
+	events = append(events, healthEvents...)
+
+	awsMachine := machineScope.AWSMachine
+	for _, event := range events {
+		if !hasScheduledEvent(awsMachine.Status.ScheduledEvents, event) {
+			r.Recorder.Eventf(awsMachine, corev1.EventTypeWarning, "InstanceEventScheduled", "Instance %s is affected by %s", instance.ID, describeScheduledEvent(event))
+		}
+	}
+	awsMachine.Status.ScheduledEvents = events
+
+	if len(events) == 0 {
+		conditions.MarkTrue(awsMachine, infrav1.NoScheduledEventsCondition)
+		return nil
+	}
+
+	trigger, err := r.requestScheduledEventRemediation(ctx, machineScope, events)
+	if err != nil {
+		return err
+	}
+	if trigger != nil {
+		conditions.MarkFalse(awsMachine, infrav1.NoScheduledEventsCondition, infrav1.RemediationRequestedReason, clusterv1.ConditionSeverityWarning,
+			"Remediation requested ahead of %s", describeScheduledEvent(*trigger))
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(events))
+	for _, event := range events {
+		descriptions = append(descriptions, describeScheduledEvent(event))
+	}
+	conditions.MarkFalse(awsMachine, infrav1.NoScheduledEventsCondition, infrav1.InstanceEventScheduledReason, clusterv1.ConditionSeverityWarning,
+		"%s", strings.Join(descriptions, "; "))
+	return nil
+}
+
+// requestScheduledEventRemediation annotates the Machine for remediation by its MachineHealthCheck when a disruptive
+// event starts within the remediation lead time, and returns that event.
+func (r *AWSMachineReconciler) requestScheduledEventRemediation(ctx context.Context, machineScope *scope.MachineScope, events []infrav1.ScheduledEvent) (*infrav1.ScheduledEvent, error) {
+	remediation := machineScope.AWSMachine.Spec.ScheduledEventRemediation
+	if remediation == nil {
+		return nil, nil
+	}
+
+	deadline := time.Now().Add(remediation.GetLeadTime())
+	var trigger *infrav1.ScheduledEvent
+	for i := range events {
+		if events[i].Disruptive && (events[i].NotBefore == nil || events[i].NotBefore.Time.Before(deadline)) {
+			trigger = &events[i]
+			break
+		}
+	}
+	if trigger == nil {
+		return nil, nil
+	}
+
+	machine := machineScope.Machine
+	if _, ok := machine.Annotations[clusterv1.RemediateMachineAnnotation]; ok {
+		return trigger, nil
+	}
+	original := machine.DeepCopy()
+	if machine.Annotations == nil {
+		machine.Annotations = map[string]string{}
+	}
+	machine.Annotations[clusterv1.RemediateMachineAnnotation] = ""
+	if err := r.Client.Patch(ctx, machine, client.MergeFrom(original)); err != nil {
+		return nil, errors.Wrapf(err, "failed to request the remediation of Machine %q", machine.Name)
+	}
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "RemediationRequested", "Requested the remediation of Machine %s ahead of %s", machine.Name, describeScheduledEvent(*trigger))
+	return trigger, nil
+}
+
+func hasScheduledEvent(events []infrav1.ScheduledEvent, event infrav1.ScheduledEvent) bool {
+	for _, e := range events {
+		if e.Source == event.Source && e.Code == event.Code && e.NotBefore.Equal(event.NotBefore) {
+			return true
+		}
+	}
+	return false
+}
+
+func describeScheduledEvent(event infrav1.ScheduledEvent) string {
+	description := fmt.Sprintf("%s event %s", event.Source, event.Code)
+	if event.NotBefore != nil {
+		description += fmt.Sprintf(" starting at %s", event.NotBefore.UTC().Format(time.RFC3339))
+	}
+	return description
+}
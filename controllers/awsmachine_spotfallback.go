@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// getClonedFromTemplate returns the AWSMachineTemplate the AWSMachine was cloned from,
+// or nil if the AWSMachine was not cloned from an existing AWSMachineTemplate.
+func (r *AWSMachineReconciler) getClonedFromTemplate(ctx context.Context, machineScope *scope.MachineScope) (*infrav1.AWSMachineTemplate, error) {
+	annotations := machineScope.AWSMachine.GetAnnotations()
+	name := annotations[clusterv1.TemplateClonedFromNameAnnotation]
+	groupKind := infrav1.GroupVersion.WithKind("AWSMachineTemplate").GroupKind()
+	if name == "" || annotations[clusterv1.TemplateClonedFromGroupKindAnnotation] != groupKind.String() {
+		return nil, nil
+	}
+
+	template := &infrav1.AWSMachineTemplate{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: machineScope.Namespace(), Name: name}, template); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get AWSMachineTemplate %s/%s", machineScope.Namespace(), name)
+	}
+	return template, nil
+}
+
+// reconcileSpotInterruptionFallback falls back to an on-demand instance when the Spot instances of the
+// AWSMachines created from the same AWSMachineTemplate were interrupted MaxInterruptions times.
+func (r *AWSMachineReconciler) reconcileSpotInterruptionFallback(ctx context.Context, machineScope *scope.MachineScope) error {
+	fallback := machineScope.AWSMachine.Spec.SpotFallback
+	if fallback == nil || fallback.MaxInterruptions == nil || machineScope.IsSpotFallbackOnDemand() {
+		return nil
+	}
+
+	template, err := r.getClonedFromTemplate(ctx, machineScope)
+	if err != nil || template == nil {
+		return err
+	}
+
+	if template.Status.SpotInterruptions >= *fallback.MaxInterruptions {
+		machineScope.Info("Spot instances were interrupted too many times, falling back to an on-demand instance",
+			"template", template.Name, "interruptions", template.Status.SpotInterruptions)
+		machineScope.SetSpotFallbackOnDemand(infrav1.SpotFallbackReasonInterrupted)
+	}
+	return nil
+}
+
+// recordSpotInterruption counts the interruption of the Spot instance of the AWSMachine in the status
+// of the AWSMachineTemplate it was created from.
+func (r *AWSMachineReconciler) recordSpotInterruption(ctx context.Context, machineScope *scope.MachineScope) error {
+	fallback := machineScope.AWSMachine.Spec.SpotFallback
+	status := machineScope.AWSMachine.Status
+	if fallback == nil || fallback.MaxInterruptions == nil || !status.Interruptible ||
+		(status.SpotFallback != nil && status.SpotFallback.InterruptionRecorded) {
+		return nil
+	}
+
+	template, err := r.getClonedFromTemplate(ctx, machineScope)
+	if err != nil || template == nil {
+		return err
+	}
+
+	original := template.DeepCopy()
+	template.Status.SpotInterruptions++
+	if err := r.Client.Patch(ctx, template, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})); err != nil {
+		return errors.Wrapf(err, "failed to record the Spot interruption in AWSMachineTemplate %s/%s", template.Namespace, template.Name)
+	}
+
+	if machineScope.AWSMachine.Status.SpotFallback == nil {
+		machineScope.AWSMachine.Status.SpotFallback = &infrav1.SpotFallbackStatus{}
+	}
+	machineScope.AWSMachine.Status.SpotFallback.InterruptionRecorded = true
+	return nil
+}
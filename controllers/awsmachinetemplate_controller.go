@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/predicates"
+)
+
+// AWSMachineTemplateReconciler reconciles the status of an AWSMachineTemplate.
+// The capacity and the node information of the instance type of the template are advertised in its status,
+// so that the cluster autoscaler can scale the MachineDeployments using the template from zero.
+type AWSMachineTemplateReconciler struct {
+	client.Client
+	ec2ServiceFactory            func(scope.EC2Scope) services.EC2Interface
+	Endpoints                    []scope.ServiceEndpoint
+	WatchFilterValue             string
+	TagUnmanagedNetworkResources bool
+}
+
+func (r *AWSMachineTemplateReconciler) getEC2Service(scope scope.EC2Scope) services.EC2Interface {
+	if r.ec2ServiceFactory != nil {
+		return r.ec2ServiceFactory(scope)
+	}
+
+	return ec2.NewService(scope)
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinetemplates,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
+
+func (r *AWSMachineTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logger.FromContext(ctx)
+
+	template := &infrav1.AWSMachineTemplate{}
+	if err := r.Get(ctx, req.NamespacedName, template); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	cluster, err := r.getCluster(ctx, template)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if cluster == nil {
+		log.Info("AWSMachineTemplate is not owned by a Cluster and is missing the cluster label")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("cluster", klog.KObj(cluster))
+
+	if annotations.IsPaused(cluster, template) {
+		log.Info("Reconciliation is paused for this object")
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.reconcileInPlaceChanges(ctx, log, cluster, template); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// The instance type of a template using instance requirements is only selected when an instance is created.
+	instanceType := template.Spec.Template.Spec.InstanceType
+	if instanceType == "" || (template.Status.Capacity != nil && template.Status.NodeInfo != nil) {
+		return ctrl.Result{}, nil
+	}
+
+	ec2Scope, err := r.getInfraCluster(ctx, log, cluster, template)
+	if err != nil {
+		return ctrl.Result{}, errors.Errorf("error getting infra provider cluster or control plane object: %v", err)
+	}
+	if ec2Scope == nil {
+		log.Info("AWSCluster or AWSManagedControlPlane is not ready yet")
+		return ctrl.Result{RequeueAfter: DefaultReconcilerRequeue}, nil
+	}
+
+	capacity, nodeInfo, err := r.getEC2Service(ec2Scope).GetInstanceTypeCapacity(instanceType)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	original := template.DeepCopy()
+	template.Status.Capacity = capacity
+	template.Status.NodeInfo = nodeInfo
+	if err := r.Client.Patch(ctx, template, client.MergeFrom(original)); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to patch the capacity of AWSMachineTemplate %s/%s", template.Namespace, template.Name)
+	}
+
+	log.Info("Updated the capacity of the AWSMachineTemplate", "instance-type", instanceType)
+	return ctrl.Result{}, nil
+}
+
+// reconcileInPlaceChanges propagates the fields of the template which can be changed in place to the AWSMachines
+// created from the template, whose instances are then updated by the AWSMachine controller without being replaced.
+func (r *AWSMachineTemplateReconciler) reconcileInPlaceChanges(ctx context.Context, log *logger.Logger, cluster *clusterv1.Cluster, template *infrav1.AWSMachineTemplate) error {
+	awsMachines := &infrav1.AWSMachineList{}
+	if err := r.List(ctx, awsMachines, client.InNamespace(template.Namespace), client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name}); err != nil {
+		return errors.Wrapf(err, "failed to list AWSMachines of cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+
+	templateGroupKind := infrav1.GroupVersion.WithKind("AWSMachineTemplate").GroupKind().String()
+	for i := range awsMachines.Items {
+		awsMachine := &awsMachines.Items[i]
+		if awsMachine.Annotations[clusterv1.TemplateClonedFromNameAnnotation] != template.Name ||
+			awsMachine.Annotations[clusterv1.TemplateClonedFromGroupKindAnnotation] != templateGroupKind ||
+			!awsMachine.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		original := awsMachine.DeepCopy()
+		awsMachine.Spec.SetInPlaceMutableFields(&template.Spec.Template.Spec)
+		if cmp.Equal(original.Spec, awsMachine.Spec, cmpopts.EquateEmpty()) {
+			continue
+		}
+
+		if err := r.Client.Patch(ctx, awsMachine, client.MergeFrom(original)); err != nil {
+			return errors.Wrapf(err, "failed to patch AWSMachine %s/%s", awsMachine.Namespace, awsMachine.Name)
+		}
+		log.Info("Propagated the in-place changes of the AWSMachineTemplate", "awsmachine", klog.KObj(awsMachine))
+	}
+	return nil
+}
+
+func (r *AWSMachineTemplateReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	log := logger.FromContext(ctx)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&infrav1.AWSMachineTemplate{}).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), log.GetLogger(), r.WatchFilterValue)).
+		Complete(r)
+}
+
+// getCluster returns the Cluster owning the AWSMachineTemplate, or the Cluster of its cluster label
+// when it has no owner yet.
+func (r *AWSMachineTemplateReconciler) getCluster(ctx context.Context, template *infrav1.AWSMachineTemplate) (*clusterv1.Cluster, error) {
+	cluster, err := util.GetOwnerCluster(ctx, r.Client, template.ObjectMeta)
+	if err != nil || cluster != nil {
+		return cluster, err
+	}
+
+	if template.Labels[clusterv1.ClusterNameLabel] == "" {
+		return nil, nil
+	}
+	return util.GetClusterFromMetadata(ctx, r.Client, template.ObjectMeta)
+}
+
+func (r *AWSMachineTemplateReconciler) getInfraCluster(ctx context.Context, log *logger.Logger, cluster *clusterv1.Cluster, template *infrav1.AWSMachineTemplate) (scope.EC2Scope, error) {
+	if cluster.Spec.ControlPlaneRef != nil && cluster.Spec.ControlPlaneRef.Kind == AWSManagedControlPlaneRefKind {
+		controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{}
+		controlPlaneName := client.ObjectKey{
+			Namespace: template.Namespace,
+			Name:      cluster.Spec.ControlPlaneRef.Name,
+		}
+
+		if err := r.Get(ctx, controlPlaneName, controlPlane); err != nil {
+			// AWSManagedControlPlane is not ready
+			return nil, nil //nolint:nilerr
+		}
+
+		return scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+			Client:                       r.Client,
+			Logger:                       log,
+			Cluster:                      cluster,
+			ControlPlane:                 controlPlane,
+			ControllerName:               "awsmachinetemplate",
+			Endpoints:                    r.Endpoints,
+			TagUnmanagedNetworkResources: r.TagUnmanagedNetworkResources,
+		})
+	}
+
+	if cluster.Spec.InfrastructureRef == nil {
+		return nil, nil
+	}
+
+	awsCluster := &infrav1.AWSCluster{}
+	infraClusterName := client.ObjectKey{
+		Namespace: template.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+
+	if err := r.Get(ctx, infraClusterName, awsCluster); err != nil {
+		// AWSCluster is not ready
+		return nil, nil //nolint:nilerr
+	}
+
+	return scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:                       r.Client,
+		Logger:                       log,
+		Cluster:                      cluster,
+		AWSCluster:                   awsCluster,
+		ControllerName:               "awsmachinetemplate",
+		Endpoints:                    r.Endpoints,
+		TagUnmanagedNetworkResources: r.TagUnmanagedNetworkResources,
+	})
+}
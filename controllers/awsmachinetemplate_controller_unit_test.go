@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestAWSMachineTemplateReconcile(t *testing.T) {
+	capacity := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("8Gi"),
+	}
+	nodeInfo := &infrav1.NodeInfo{Architecture: infrav1.ArchitectureAmd64, OperatingSystem: infrav1.OperatingSystemLinux}
+
+	testCases := []struct {
+		name         string
+		instanceType string
+		status       infrav1.AWSMachineTemplateStatus
+		expect       func(m *mock_services.MockEC2InterfaceMockRecorder)
+		wantStatus   infrav1.AWSMachineTemplateStatus
+	}{
+		{
+			name:         "should advertise the capacity of the instance type",
+			instanceType: "m5.large",
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.GetInstanceTypeCapacity("m5.large").Return(capacity, nodeInfo, nil)
+			},
+			wantStatus: infrav1.AWSMachineTemplateStatus{Capacity: capacity, NodeInfo: nodeInfo},
+		},
+		{
+			name:         "should not describe the instance type when the capacity is already advertised",
+			instanceType: "m5.large",
+			status:       infrav1.AWSMachineTemplateStatus{Capacity: capacity, NodeInfo: nodeInfo},
+			wantStatus:   infrav1.AWSMachineTemplateStatus{Capacity: capacity, NodeInfo: nodeInfo},
+		},
+		{
+			name: "should skip templates without instance type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.TODO()
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				Spec: clusterv1.ClusterSpec{
+					InfrastructureRef: &corev1.ObjectReference{Kind: "AWSCluster", Name: "test-cluster"},
+				},
+			}
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				Spec:       infrav1.AWSClusterSpec{Region: "us-east-1"},
+			}
+			controllerIdentity := &infrav1.AWSClusterControllerIdentity{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: infrav1.AWSClusterControllerIdentitySpec{
+					AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+						AllowedNamespaces: &infrav1.AllowedNamespaces{},
+					},
+				},
+			}
+			template := &infrav1.AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-template",
+					Namespace: "default",
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: "test-cluster"},
+				},
+				Spec: infrav1.AWSMachineTemplateSpec{
+					Template: infrav1.AWSMachineTemplateResource{
+						Spec: infrav1.AWSMachineSpec{InstanceType: tc.instanceType},
+					},
+				},
+				Status: tc.status,
+			}
+			fakeClient := fake.NewClientBuilder().WithObjects(cluster, awsCluster, controllerIdentity, template).Build()
+
+			mockCtrl := gomock.NewController(t)
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Svc.EXPECT())
+			}
+
+			reconciler := &AWSMachineTemplateReconciler{
+				Client: fakeClient,
+				ec2ServiceFactory: func(scope.EC2Scope) services.EC2Interface {
+					return ec2Svc
+				},
+			}
+
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(template)})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			got := &infrav1.AWSMachineTemplate{}
+			g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(template), got)).To(Succeed())
+			g.Expect(got.Status.NodeInfo).To(Equal(tc.wantStatus.NodeInfo))
+			g.Expect(got.Status.Capacity).To(HaveLen(len(tc.wantStatus.Capacity)))
+			for name, quantity := range tc.wantStatus.Capacity {
+				actual := got.Status.Capacity[name]
+				g.Expect(actual.Cmp(quantity)).To(BeZero(), "resource %s", name)
+			}
+		})
+	}
+}
+
+func TestAWSMachineTemplateReconcileInPlaceChanges(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+	}
+	metadataOptions := &infrav1.InstanceMetadataOptions{
+		HTTPEndpoint:            infrav1.InstanceMetadataEndpointStateEnabled,
+		HTTPPutResponseHopLimit: 2,
+		HTTPTokens:              infrav1.HTTPTokensStateRequired,
+		InstanceMetadataTags:    infrav1.InstanceMetadataEndpointStateDisabled,
+	}
+	template := &infrav1.AWSMachineTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-template",
+			Namespace: "default",
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: "test-cluster"},
+		},
+		Spec: infrav1.AWSMachineTemplateSpec{
+			Template: infrav1.AWSMachineTemplateResource{
+				Spec: infrav1.AWSMachineSpec{
+					AdditionalTags:          infrav1.Tags{"team": "platform"},
+					InstanceMetadataOptions: metadataOptions,
+				},
+			},
+		},
+	}
+	newAWSMachine := func(name, clonedFrom string) *infrav1.AWSMachine {
+		return &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: "test-cluster"},
+				Annotations: map[string]string{
+					clusterv1.TemplateClonedFromNameAnnotation:      clonedFrom,
+					clusterv1.TemplateClonedFromGroupKindAnnotation: infrav1.GroupVersion.WithKind("AWSMachineTemplate").GroupKind().String(),
+				},
+			},
+			Spec: infrav1.AWSMachineSpec{
+				InstanceType:   "m5.large",
+				AdditionalTags: infrav1.Tags{"team": "infra"},
+			},
+		}
+	}
+	clonedMachine := newAWSMachine("cloned", "test-template")
+	otherMachine := newAWSMachine("other", "other-template")
+	fakeClient := fake.NewClientBuilder().WithObjects(cluster, template, clonedMachine, otherMachine).Build()
+
+	reconciler := &AWSMachineTemplateReconciler{Client: fakeClient}
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(template)})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	got := &infrav1.AWSMachine{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(clonedMachine), got)).To(Succeed())
+	g.Expect(got.Spec.AdditionalTags).To(Equal(infrav1.Tags{"team": "platform"}))
+	g.Expect(got.Spec.InstanceMetadataOptions).To(Equal(metadataOptions))
+	g.Expect(got.Spec.InstanceType).To(Equal("m5.large"))
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(otherMachine), got)).To(Succeed())
+	g.Expect(got.Spec.AdditionalTags).To(Equal(infrav1.Tags{"team": "infra"}))
+	g.Expect(got.Spec.InstanceMetadataOptions).To(BeNil())
+}
@@ -40,12 +40,24 @@ func (r *AWSManagedControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 		return err
 	}
 	dst.Spec.VpcCni.Disable = r.Spec.DisableVPCCNI
+	dst.Spec.VpcCni.PodNetworking = restored.Spec.VpcCni.PodNetworking
 	dst.Spec.Partition = restored.Spec.Partition
 	dst.Spec.RestrictPrivateSubnets = restored.Spec.RestrictPrivateSubnets
 	dst.Spec.RolePath = restored.Spec.RolePath
 	dst.Spec.RolePermissionsBoundary = restored.Spec.RolePermissionsBoundary
 	dst.Status.Version = restored.Status.Version
+	dst.Status.FailureDomainCount = restored.Status.FailureDomainCount
 	dst.Spec.BootstrapSelfManagedAddons = restored.Spec.BootstrapSelfManagedAddons
+	dst.Spec.AdditionalSecurityGroupIDs = restored.Spec.AdditionalSecurityGroupIDs
+	if restored.Spec.Addons != nil && dst.Spec.Addons != nil {
+		for i := range *dst.Spec.Addons {
+			for _, addon := range *restored.Spec.Addons {
+				if addon.Name == (*dst.Spec.Addons)[i].Name {
+					(*dst.Spec.Addons)[i].DependsOn = addon.DependsOn
+				}
+			}
+		}
+	}
 	return nil
 }
 
@@ -122,7 +134,44 @@ func Convert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControlPlan
 	return autoConvert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControlPlaneSpec(in, out, scope)
 }
 
+// Convert_v1beta2_Addon_To_v1beta1_Addon is a conversion function.
+func Convert_v1beta2_Addon_To_v1beta1_Addon(in *ekscontrolplanev1.Addon, out *Addon, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_Addon_To_v1beta1_Addon(in, out, s)
+}
+
 // Convert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlPlaneStatus is an autogenerated conversion function.
 func Convert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlPlaneStatus(in *ekscontrolplanev1.AWSManagedControlPlaneStatus, out *AWSManagedControlPlaneStatus, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlPlaneStatus(in, out, s)
 }
+
+// Convert_Pointer_Slice_v1beta1_Addon_To_Pointer_Slice_v1beta2_Addon is a conversion function.
+func Convert_Pointer_Slice_v1beta1_Addon_To_Pointer_Slice_v1beta2_Addon(in **[]Addon, out **[]ekscontrolplanev1.Addon, s apiconversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+	addons := make([]ekscontrolplanev1.Addon, len(**in))
+	for i := range **in {
+		if err := Convert_v1beta1_Addon_To_v1beta2_Addon(&(**in)[i], &addons[i], s); err != nil {
+			return err
+		}
+	}
+	*out = &addons
+	return nil
+}
+
+// Convert_Pointer_Slice_v1beta2_Addon_To_Pointer_Slice_v1beta1_Addon is a conversion function.
+func Convert_Pointer_Slice_v1beta2_Addon_To_Pointer_Slice_v1beta1_Addon(in **[]ekscontrolplanev1.Addon, out **[]Addon, s apiconversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+	addons := make([]Addon, len(**in))
+	for i := range **in {
+		if err := Convert_v1beta2_Addon_To_v1beta1_Addon(&(**in)[i], &addons[i], s); err != nil {
+			return err
+		}
+	}
+	*out = &addons
+	return nil
+}
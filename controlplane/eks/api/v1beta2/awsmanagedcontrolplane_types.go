@@ -136,6 +136,13 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	EndpointAccess EndpointAccess `json:"endpointAccess,omitempty"`
 
+	// AdditionalSecurityGroupIDs are the IDs of existing security groups to attach to the EKS
+	// control plane network interfaces, in addition to the one created by the controller.
+	// Changes are applied to existing clusters.
+	// +kubebuilder:validation:MaxItems=4
+	// +optional
+	AdditionalSecurityGroupIDs []string `json:"additionalSecurityGroupIds,omitempty"`
+
 	// ControlPlaneEndpoint represents the endpoint used to communicate with the control plane.
 	// +optional
 	ControlPlaneEndpoint clusterv1.APIEndpoint `json:"controlPlaneEndpoint"`
@@ -234,6 +241,50 @@ type VpcCni struct {
 	// Env defines a list of environment variables to apply to the `aws-node` DaemonSet
 	// +optional
 	Env []corev1.EnvVar `json:"env,omitempty"`
+	// PodNetworking pre-configures the Amazon VPC CNI and the nodes for a high pod density, setting
+	// the environment variables of the `aws-node` DaemonSet, the maximum number of pods of the nodes
+	// bootstrapped with an EKSConfig and the tags of the pod subnets. The environment variables
+	// set in Env take precedence over the ones set for the pod networking.
+	// +optional
+	PodNetworking *VpcCniPodNetworking `json:"podNetworking,omitempty"`
+}
+
+// VpcCniPodNetworking specifies how the Amazon VPC CNI assigns IP addresses to the pods.
+type VpcCniPodNetworking struct {
+	// PrefixDelegation assigns /28 IPv4 prefixes to the network interfaces of the nodes instead of
+	// individual IP addresses, increasing the number of pods each node can run.
+	// +optional
+	PrefixDelegation *VpcCniPrefixDelegation `json:"prefixDelegation,omitempty"`
+
+	// CustomNetworking places the pods in the subnets of the secondary CIDR block of the cluster,
+	// in the availability zone of their node, instead of in the subnet of their node.
+	// It requires spec.secondaryCidrBlock to be set.
+	// +optional
+	CustomNetworking *VpcCniCustomNetworking `json:"customNetworking,omitempty"`
+}
+
+// VpcCniPrefixDelegation specifies the configuration of the prefix delegation of the Amazon VPC CNI.
+type VpcCniPrefixDelegation struct {
+	// WarmPrefixTarget is the number of prefixes kept attached to each node in addition to the ones in use.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	WarmPrefixTarget int32 `json:"warmPrefixTarget,omitempty"`
+
+	// MaxPods is the maximum number of pods of the nodes bootstrapped with an EKSConfig, unless it
+	// sets useMaxPods or the max-pods kubelet argument itself.
+	// +kubebuilder:default=110
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxPods int32 `json:"maxPods,omitempty"`
+}
+
+// VpcCniCustomNetworking specifies the configuration of the custom networking of the Amazon VPC CNI.
+type VpcCniCustomNetworking struct {
+	// AdditionalSecurityGroupIDs are the IDs of the security groups attached to the network interfaces
+	// of the pods in addition to the node security group.
+	// +optional
+	AdditionalSecurityGroupIDs []string `json:"additionalSecurityGroupIDs,omitempty"`
 }
 
 // EndpointAccess specifies how control plane endpoints are accessible.
@@ -282,6 +333,9 @@ type AWSManagedControlPlaneStatus struct {
 	// FailureDomains specifies a list fo available availability zones that can be used
 	// +optional
 	FailureDomains clusterv1.FailureDomains `json:"failureDomains,omitempty"`
+	// FailureDomainCount is the number of failure domains of the control plane, displayed by kubectl.
+	// +optional
+	FailureDomainCount int32 `json:"failureDomainCount,omitempty"`
 	// Bastion holds details of the instance that is used as a bastion jump box
 	// +optional
 	Bastion *infrav1.Instance `json:"bastion,omitempty"`
@@ -325,9 +379,12 @@ type AWSManagedControlPlaneStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this AWSManagedControl belongs"
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Control plane infrastructure is ready for worker nodes"
+// +kubebuilder:printcolumn:name="Infrastructure",type="string",JSONPath=".status.conditions[?(@.type==\"InfrastructureReady\")].status",description="Summary of the readiness of the control plane infrastructure"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",description="Reason the control plane is not ready",priority=1
 // +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.network.vpc.id",description="AWS VPC the control plane is using"
 // +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".spec.controlPlaneEndpoint.host",description="API Endpoint",priority=1
 // +kubebuilder:printcolumn:name="Bastion IP",type="string",JSONPath=".status.bastion.publicIp",description="Bastion IP address for breakglass access"
+// +kubebuilder:printcolumn:name="Failure Domains",type="integer",JSONPath=".status.failureDomainCount",description="Number of failure domains of the control plane"
 
 // AWSManagedControlPlane is the schema for the Amazon EKS Managed Control Plane API.
 type AWSManagedControlPlane struct {
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
+	"time"
 
 	"github.com/apparentlymart/go-cidr/cidr"
 	"github.com/pkg/errors"
@@ -101,11 +103,16 @@ func (*awsManagedControlPlaneWebhook) ValidateCreate(_ context.Context, obj runt
 	allErrs = append(allErrs, r.validateIAMAuthConfig()...)
 	allErrs = append(allErrs, r.validateSecondaryCIDR()...)
 	allErrs = append(allErrs, r.validateEKSAddons()...)
+	allErrs = append(allErrs, r.validateEKSAddonDependencies()...)
 	allErrs = append(allErrs, r.validateDisableVPCCNI()...)
+	allErrs = append(allErrs, r.validateVpcCniPodNetworking()...)
 	allErrs = append(allErrs, r.validateRestrictPrivateSubnets()...)
 	allErrs = append(allErrs, r.validateKubeProxy()...)
+	allErrs = append(allErrs, r.validateAdditionalSecurityGroupIDs()...)
+	allErrs = append(allErrs, r.validateWriteFreezeAnnotation()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validateNetwork()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateSecurityGroupEgress()...)
 	allErrs = append(allErrs, r.validatePrivateDNSHostnameTypeOnLaunch()...)
 
 	if len(allErrs) == 0 {
@@ -143,10 +150,15 @@ func (*awsManagedControlPlaneWebhook) ValidateUpdate(ctx context.Context, oldObj
 	allErrs = append(allErrs, r.validateIAMAuthConfig()...)
 	allErrs = append(allErrs, r.validateSecondaryCIDR()...)
 	allErrs = append(allErrs, r.validateEKSAddons()...)
+	allErrs = append(allErrs, r.validateEKSAddonDependencies()...)
 	allErrs = append(allErrs, r.validateDisableVPCCNI()...)
+	allErrs = append(allErrs, r.validateVpcCniPodNetworking()...)
 	allErrs = append(allErrs, r.validateRestrictPrivateSubnets()...)
 	allErrs = append(allErrs, r.validateKubeProxy()...)
+	allErrs = append(allErrs, r.validateAdditionalSecurityGroupIDs()...)
+	allErrs = append(allErrs, r.validateWriteFreezeAnnotation()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateSecurityGroupEgress()...)
 	allErrs = append(allErrs, r.validatePrivateDNSHostnameTypeOnLaunch()...)
 
 	if r.Spec.Region != oldAWSManagedControlplane.Spec.Region {
@@ -304,6 +316,68 @@ func (r *AWSManagedControlPlane) validateEKSAddons() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSManagedControlPlane) validateEKSAddonDependencies() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.Addons == nil {
+		return allErrs
+	}
+
+	addonsPath := field.NewPath("spec", "addons")
+	dependencies := map[string][]string{}
+	for _, addon := range *r.Spec.Addons {
+		dependencies[addon.Name] = addon.DependsOn
+	}
+
+	for i, addon := range *r.Spec.Addons {
+		for j, dependency := range addon.DependsOn {
+			dependencyPath := addonsPath.Index(i).Child("dependsOn").Index(j)
+			if dependency == addon.Name {
+				allErrs = append(allErrs, field.Invalid(dependencyPath, dependency, "addon cannot depend on itself"))
+				continue
+			}
+			if _, ok := dependencies[dependency]; !ok {
+				allErrs = append(allErrs, field.Invalid(dependencyPath, dependency, "dependency has to be one of the addons of spec.addons"))
+			}
+		}
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	// Visit the dependencies of each addon depth first, a dependency that is still being visited closes a cycle.
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		switch state[name] {
+		case visiting:
+			return false
+		case visited:
+			return true
+		}
+		state[name] = visiting
+		for _, dependency := range dependencies[name] {
+			if !visit(dependency) {
+				return false
+			}
+		}
+		state[name] = visited
+		return true
+	}
+	for i, addon := range *r.Spec.Addons {
+		if !visit(addon.Name) {
+			allErrs = append(allErrs, field.Invalid(addonsPath.Index(i).Child("dependsOn"), addon.DependsOn, "dependencies of the addons cannot form a cycle"))
+			break
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSManagedControlPlane) validateIAMAuthConfig() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -359,6 +433,13 @@ func (r *AWSManagedControlPlane) validateSecondaryCIDR() field.ErrorList {
 		}
 	}
 
+	for i, cidrBlock := range r.Spec.NetworkSpec.VPC.SecondaryCidrBlocks {
+		if cidrBlock.CreateSubnets {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "network", "vpc", "secondaryCidrBlocks").Index(i).Child("createSubnets"),
+				"the pod subnets of EKS clusters are created in spec.secondaryCidrBlock"))
+		}
+	}
+
 	if len(allErrs) == 0 {
 		return nil
 	}
@@ -409,6 +490,45 @@ func (r *AWSManagedControlPlane) validateDisableVPCCNI() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSManagedControlPlane) validateVpcCniPodNetworking() field.ErrorList {
+	var allErrs field.ErrorList
+
+	podNetworking := r.Spec.VpcCni.PodNetworking
+	if podNetworking == nil {
+		return nil
+	}
+	podNetworkingField := field.NewPath("spec", "vpcCni", "podNetworking")
+
+	if r.Spec.VpcCni.Disable {
+		allErrs = append(allErrs, field.Forbidden(podNetworkingField, "cannot be set when the vpc cni is disabled"))
+	}
+
+	if podNetworking.PrefixDelegation != nil && r.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
+		allErrs = append(allErrs, field.Forbidden(podNetworkingField.Child("prefixDelegation"), "cannot be set for ipv6 clusters, prefixes are always assigned to their nodes"))
+	}
+
+	if podNetworking.CustomNetworking != nil {
+		customNetworkingField := podNetworkingField.Child("customNetworking")
+		if r.Spec.SecondaryCidrBlock == nil {
+			allErrs = append(allErrs, field.Invalid(customNetworkingField, podNetworking.CustomNetworking, "requires spec.secondaryCidrBlock to be set"))
+		}
+
+		idsField := customNetworkingField.Child("additionalSecurityGroupIDs")
+		seen := make(map[string]bool, len(podNetworking.CustomNetworking.AdditionalSecurityGroupIDs))
+		for i, id := range podNetworking.CustomNetworking.AdditionalSecurityGroupIDs {
+			if !strings.HasPrefix(id, "sg-") {
+				allErrs = append(allErrs, field.Invalid(idsField.Index(i), id, "security group ID must start with sg-"))
+			}
+			if seen[id] {
+				allErrs = append(allErrs, field.Duplicate(idsField.Index(i), id))
+			}
+			seen[id] = true
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSManagedControlPlane) validateRestrictPrivateSubnets() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -425,6 +545,32 @@ func (r *AWSManagedControlPlane) validateRestrictPrivateSubnets() field.ErrorLis
 	return allErrs
 }
 
+func (r *AWSManagedControlPlane) validateAdditionalSecurityGroupIDs() field.ErrorList {
+	var allErrs field.ErrorList
+
+	idsField := field.NewPath("spec", "additionalSecurityGroupIds")
+	seen := make(map[string]bool, len(r.Spec.AdditionalSecurityGroupIDs))
+	for i, id := range r.Spec.AdditionalSecurityGroupIDs {
+		if !strings.HasPrefix(id, "sg-") {
+			allErrs = append(allErrs, field.Invalid(idsField.Index(i), id, "security group ID must start with sg-"))
+		}
+		if seen[id] {
+			allErrs = append(allErrs, field.Duplicate(idsField.Index(i), id))
+		}
+		seen[id] = true
+	}
+
+	return allErrs
+}
+
+func (r *AWSManagedControlPlane) validateWriteFreezeAnnotation() field.ErrorList {
+	if _, _, err := infrav1.WriteFreezeUntil(r, time.Now()); err != nil {
+		annotationField := field.NewPath("metadata", "annotations", infrav1.WriteFreezeUntilAnnotation)
+		return field.ErrorList{field.Invalid(annotationField, r.GetAnnotations()[infrav1.WriteFreezeUntilAnnotation], err.Error())}
+	}
+	return nil
+}
+
 func (r *AWSManagedControlPlane) validatePrivateDNSHostnameTypeOnLaunch() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -489,6 +635,24 @@ func (r *AWSManagedControlPlane) validateNetwork() field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(ipamPoolField, r.Spec.NetworkSpec.VPC.IPv6.IPAMPool, "ipamPool must have either id or name"))
 	}
 
+	for i, subnet := range r.Spec.NetworkSpec.Subnets {
+		if !subnet.IPv6Only {
+			continue
+		}
+		ipv6OnlyField := field.NewPath("spec", "network", "subnets").Index(i).Child("ipv6Only")
+		if !r.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
+			allErrs = append(allErrs, field.Invalid(ipv6OnlyField, subnet.IPv6Only, "IPv6-only subnets require IPv6 to be enabled on the VPC"))
+		}
+		if subnet.IsPublic {
+			allErrs = append(allErrs, field.Invalid(ipv6OnlyField, subnet.IPv6Only, "IPv6-only subnets cannot be public"))
+		}
+		if subnet.CidrBlock != "" {
+			allErrs = append(allErrs, field.Invalid(ipv6OnlyField, subnet.IPv6Only, "IPv6-only subnets cannot have an IPv4 cidrBlock"))
+		}
+	}
+
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.SubnetPlan.Validate(field.NewPath("spec", "network", "vpc", "subnetPlan"), &r.Spec.NetworkSpec.VPC, podSecondaryCidrBlock)...)
+
 	return allErrs
 }
 
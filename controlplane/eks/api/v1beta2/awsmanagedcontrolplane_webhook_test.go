@@ -265,6 +265,14 @@ func TestWebhookCreate(t *testing.T) {
 			secondaryCidr:        aws.String("100.64.0.0/16"),
 			secondaryCidrBlocks:  []infrav1.VpcCidrBlock{{IPv4CidrBlock: "123.456.0.0/16"}},
 		},
+		{
+			name:                 "subnets not allowed in NetworkSpec.VPC.SecondaryCidrBlocks",
+			eksClusterName:       "default_cluster1",
+			eksVersion:           "v1.19",
+			expectError:          true,
+			expectErrorToContain: "the pod subnets of EKS clusters are created in spec.secondaryCidrBlock",
+			secondaryCidrBlocks:  []infrav1.VpcCidrBlock{{IPv4CidrBlock: "100.64.0.0/16", CreateSubnets: true}},
+		},
 		{
 			name:           "invalid tags not allowed",
 			eksClusterName: "default_cluster1",
@@ -496,6 +504,36 @@ func TestWebhookCreateIPv6Details(t *testing.T) {
 			},
 			err: "ipamPool must have either id or name",
 		},
+		{
+			name:        "ipv6-only subnet without ipv6",
+			kubeVersion: "v1.22",
+			networkSpec: infrav1.NetworkSpec{
+				Subnets: []infrav1.SubnetSpec{
+					{
+						ID:       "subnet-1",
+						IPv6Only: true,
+					},
+				},
+			},
+			err: "IPv6-only subnets require IPv6 to be enabled on the VPC",
+		},
+		{
+			name:        "public ipv6-only subnet",
+			kubeVersion: "v1.22",
+			networkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					IPv6: &infrav1.IPv6{},
+				},
+				Subnets: []infrav1.SubnetSpec{
+					{
+						ID:       "subnet-1",
+						IsPublic: true,
+						IPv6Only: true,
+					},
+				},
+			},
+			err: "IPv6-only subnets cannot be public",
+		},
 	}
 
 	for _, tc := range tests {
@@ -893,3 +931,243 @@ func TestValidatingWebhookUpdateSecondaryCidr(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatingWebhookCreateVpcCniPodNetworking(t *testing.T) {
+	tests := []struct {
+		name               string
+		podNetworking      *VpcCniPodNetworking
+		disableVPCCNI      bool
+		secondaryCidrBlock *string
+		expectError        bool
+	}{
+		{
+			name: "prefix delegation",
+			podNetworking: &VpcCniPodNetworking{
+				PrefixDelegation: &VpcCniPrefixDelegation{WarmPrefixTarget: 1, MaxPods: 110},
+			},
+			expectError: false,
+		},
+		{
+			name: "prefix delegation with the vpc cni disabled",
+			podNetworking: &VpcCniPodNetworking{
+				PrefixDelegation: &VpcCniPrefixDelegation{WarmPrefixTarget: 1, MaxPods: 110},
+			},
+			disableVPCCNI: true,
+			expectError:   true,
+		},
+		{
+			name: "custom networking with a secondary cidr block",
+			podNetworking: &VpcCniPodNetworking{
+				CustomNetworking: &VpcCniCustomNetworking{AdditionalSecurityGroupIDs: []string{"sg-pods"}},
+			},
+			secondaryCidrBlock: aws.String("100.64.0.0/16"),
+			expectError:        false,
+		},
+		{
+			name: "custom networking without a secondary cidr block",
+			podNetworking: &VpcCniPodNetworking{
+				CustomNetworking: &VpcCniCustomNetworking{},
+			},
+			expectError: true,
+		},
+		{
+			name: "custom networking with an invalid security group id",
+			podNetworking: &VpcCniPodNetworking{
+				CustomNetworking: &VpcCniCustomNetworking{AdditionalSecurityGroupIDs: []string{"pods"}},
+			},
+			secondaryCidrBlock: aws.String("100.64.0.0/16"),
+			expectError:        true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName:     "default_cluster1",
+					SecondaryCidrBlock: tc.secondaryCidrBlock,
+					VpcCni: VpcCni{
+						Disable:       tc.disableVPCCNI,
+						PodNetworking: tc.podNetworking,
+					},
+				},
+			}
+
+			warn, err := (&awsManagedControlPlaneWebhook{}).ValidateCreate(context.Background(), mcp)
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
+
+func TestValidatingWebhookCreateAddonDependencies(t *testing.T) {
+	tests := []struct {
+		name        string
+		addons      []Addon
+		expectError bool
+	}{
+		{
+			name: "coredns depends on vpc-cni",
+			addons: []Addon{
+				{Name: "coredns", Version: "v1.11.1-eksbuild.4", DependsOn: []string{vpcCniAddon}},
+				{Name: vpcCniAddon, Version: "v1.18.0-eksbuild.1"},
+			},
+			expectError: false,
+		},
+		{
+			name: "dependency on an addon that is not in the spec",
+			addons: []Addon{
+				{Name: "coredns", Version: "v1.11.1-eksbuild.4", DependsOn: []string{vpcCniAddon}},
+			},
+			expectError: true,
+		},
+		{
+			name: "addon depending on itself",
+			addons: []Addon{
+				{Name: vpcCniAddon, Version: "v1.18.0-eksbuild.1", DependsOn: []string{vpcCniAddon}},
+			},
+			expectError: true,
+		},
+		{
+			name: "dependency cycle",
+			addons: []Addon{
+				{Name: "coredns", Version: "v1.11.1-eksbuild.4", DependsOn: []string{kubeProxyAddon}},
+				{Name: kubeProxyAddon, Version: "v1.29.0-eksbuild.1", DependsOn: []string{vpcCniAddon}},
+				{Name: vpcCniAddon, Version: "v1.18.0-eksbuild.1", DependsOn: []string{"coredns"}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					Addons:         &tc.addons,
+				},
+			}
+
+			warn, err := (&awsManagedControlPlaneWebhook{}).ValidateCreate(context.Background(), mcp)
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
+
+func TestValidatingWebhookSecurityGroupEgress(t *testing.T) {
+	tests := []struct {
+		name        string
+		egress      map[infrav1.SecurityGroupRole]infrav1.SecurityGroupEgressSpec
+		expectError bool
+	}{
+		{
+			name: "egress rules for the node role",
+			egress: map[infrav1.SecurityGroupRole]infrav1.SecurityGroupEgressSpec{
+				infrav1.SecurityGroupNode: {
+					RemoveDefaultEgressRule: true,
+					EgressRules: []infrav1.EgressRule{
+						{
+							Description: "HTTPS",
+							Protocol:    infrav1.SecurityGroupProtocolTCP,
+							FromPort:    443,
+							ToPort:      443,
+							CidrBlocks:  []string{"10.0.0.0/8"},
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "egress for the bastion role",
+			egress: map[infrav1.SecurityGroupRole]infrav1.SecurityGroupEgressSpec{
+				infrav1.SecurityGroupBastion: {RemoveDefaultEgressRule: true},
+			},
+			expectError: true,
+		},
+		{
+			name: "egress rules with an invalid cidr block",
+			egress: map[infrav1.SecurityGroupRole]infrav1.SecurityGroupEgressSpec{
+				infrav1.SecurityGroupControlPlane: {
+					EgressRules: []infrav1.EgressRule{
+						{
+							Description: "HTTPS",
+							Protocol:    infrav1.SecurityGroupProtocolTCP,
+							FromPort:    443,
+							ToPort:      443,
+							CidrBlocks:  []string{"10.0.0.0"},
+						},
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "egress rules without destination",
+			egress: map[infrav1.SecurityGroupRole]infrav1.SecurityGroupEgressSpec{
+				infrav1.SecurityGroupNode: {
+					EgressRules: []infrav1.EgressRule{
+						{
+							Description: "DNS",
+							Protocol:    infrav1.SecurityGroupProtocolUDP,
+							FromPort:    53,
+							ToPort:      53,
+						},
+					},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					NetworkSpec: infrav1.NetworkSpec{
+						SecurityGroupEgress: tc.egress,
+					},
+				},
+			}
+
+			warn, err := (&awsManagedControlPlaneWebhook{}).ValidateCreate(context.Background(), mcp)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(warn).To(BeEmpty())
+
+			oldMCP := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+				},
+			}
+			warn, err = (&awsManagedControlPlaneWebhook{}).ValidateUpdate(context.Background(), oldMCP, mcp)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
@@ -52,6 +52,29 @@ const (
 	EKSAddonsConfiguredFailedReason = "EKSAddonsConfiguredFailed"
 )
 
+const (
+	// EKSAddonsHealthyCondition condition reports on the health of all the EKS addons, summarized from
+	// the condition of each addon.
+	EKSAddonsHealthyCondition clusterv1.ConditionType = "EKSAddonsHealthy"
+	// EKSAddonHealthyConditionPrefix is the prefix of the condition reporting on the health of each EKS addon.
+	EKSAddonHealthyConditionPrefix = "EKSAddonHealthy/"
+	// EKSAddonWaitingForDependenciesReason used when an EKS addon is not installed yet because its dependencies are not active.
+	EKSAddonWaitingForDependenciesReason = "EKSAddonWaitingForDependencies"
+	// EKSAddonNotInstalledReason used when an EKS addon is not installed yet.
+	EKSAddonNotInstalledReason = "EKSAddonNotInstalled"
+	// EKSAddonProgressingReason used when an EKS addon is being created, updated or deleted.
+	EKSAddonProgressingReason = "EKSAddonProgressing"
+	// EKSAddonDegradedReason used when an EKS addon is degraded.
+	EKSAddonDegradedReason = "EKSAddonDegraded"
+	// EKSAddonFailedReason used when the creation, update or deletion of an EKS addon failed.
+	EKSAddonFailedReason = "EKSAddonFailed"
+)
+
+// EKSAddonHealthyCondition returns the condition reporting on the health of the EKS addon with the given name.
+func EKSAddonHealthyCondition(name string) clusterv1.ConditionType {
+	return clusterv1.ConditionType(EKSAddonHealthyConditionPrefix + name)
+}
+
 const (
 	// EKSIdentityProviderConfiguredCondition condition reports on the successful association of identity provider config.
 	EKSIdentityProviderConfiguredCondition clusterv1.ConditionType = "EKSIdentityProviderConfigured"
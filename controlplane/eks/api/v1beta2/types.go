@@ -141,6 +141,12 @@ type Addon struct {
 	// ServiceAccountRoleArn is the ARN of an IAM role to bind to the addons service account
 	// +optional
 	ServiceAccountRoleArn *string `json:"serviceAccountRoleARN,omitempty"`
+	// DependsOn is the list of names of the addons that have to be installed before this
+	// addon, e.g. vpc-cni before coredns. The addon is only created or updated once all
+	// of them are active, or degraded as addons are until there are worker nodes.
+	// +optional
+	// +listType=set
+	DependsOn []string `json:"dependsOn,omitempty"`
 }
 
 // AddonResolution defines the method for resolving parameter conflicts.
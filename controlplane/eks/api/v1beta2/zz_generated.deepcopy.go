@@ -147,6 +147,11 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 		(*in).DeepCopyInto(*out)
 	}
 	in.EndpointAccess.DeepCopyInto(&out.EndpointAccess)
+	if in.AdditionalSecurityGroupIDs != nil {
+		in, out := &in.AdditionalSecurityGroupIDs, &out.AdditionalSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	in.Bastion.DeepCopyInto(&out.Bastion)
 	if in.TokenMethod != nil {
@@ -256,6 +261,11 @@ func (in *Addon) DeepCopyInto(out *Addon) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Addon.
@@ -599,6 +609,11 @@ func (in *VpcCni) DeepCopyInto(out *VpcCni) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PodNetworking != nil {
+		in, out := &in.PodNetworking, &out.PodNetworking
+		*out = new(VpcCniPodNetworking)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcCni.
@@ -610,3 +625,63 @@ func (in *VpcCni) DeepCopy() *VpcCni {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcCniCustomNetworking) DeepCopyInto(out *VpcCniCustomNetworking) {
+	*out = *in
+	if in.AdditionalSecurityGroupIDs != nil {
+		in, out := &in.AdditionalSecurityGroupIDs, &out.AdditionalSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcCniCustomNetworking.
+func (in *VpcCniCustomNetworking) DeepCopy() *VpcCniCustomNetworking {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcCniCustomNetworking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcCniPodNetworking) DeepCopyInto(out *VpcCniPodNetworking) {
+	*out = *in
+	if in.PrefixDelegation != nil {
+		in, out := &in.PrefixDelegation, &out.PrefixDelegation
+		*out = new(VpcCniPrefixDelegation)
+		**out = **in
+	}
+	if in.CustomNetworking != nil {
+		in, out := &in.CustomNetworking, &out.CustomNetworking
+		*out = new(VpcCniCustomNetworking)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcCniPodNetworking.
+func (in *VpcCniPodNetworking) DeepCopy() *VpcCniPodNetworking {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcCniPodNetworking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcCniPrefixDelegation) DeepCopyInto(out *VpcCniPrefixDelegation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcCniPrefixDelegation.
+func (in *VpcCniPrefixDelegation) DeepCopy() *VpcCniPrefixDelegation {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcCniPrefixDelegation)
+	in.DeepCopyInto(out)
+	return out
+}
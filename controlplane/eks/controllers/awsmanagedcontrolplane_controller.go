@@ -42,6 +42,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/awsnode"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/cloudwatchlogs"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/gc"
@@ -51,6 +52,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/securitygroup"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	infrautilconditions "sigs.k8s.io/cluster-api-provider-aws/v2/util/conditions"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/util/paused"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
@@ -259,31 +261,38 @@ func (r *AWSManagedControlPlaneReconciler) Reconcile(ctx context.Context, req ct
 
 	// Always close the scope
 	defer func() {
-		applicableConditions := []clusterv1.ConditionType{
-			ekscontrolplanev1.EKSControlPlaneReadyCondition,
-			ekscontrolplanev1.IAMControlPlaneRolesReadyCondition,
-			ekscontrolplanev1.IAMAuthenticatorConfiguredCondition,
-			ekscontrolplanev1.EKSAddonsConfiguredCondition,
+		infrastructureConditions := []clusterv1.ConditionType{
 			infrav1.VpcReadyCondition,
 			infrav1.SubnetsReadyCondition,
 			infrav1.ClusterSecurityGroupsReadyCondition,
 		}
 
 		if managedScope.VPC().IsManaged(managedScope.Name()) {
-			applicableConditions = append(applicableConditions,
+			infrastructureConditions = append(infrastructureConditions,
 				infrav1.InternetGatewayReadyCondition,
 				infrav1.NatGatewaysReadyCondition,
 				infrav1.RouteTablesReadyCondition,
 				infrav1.VpcEndpointsReadyCondition,
 			)
 			if managedScope.Bastion().Enabled {
-				applicableConditions = append(applicableConditions, infrav1.BastionHostReadyCondition)
+				infrastructureConditions = append(infrastructureConditions, infrav1.BastionHostReadyCondition)
 			}
 			if managedScope.VPC().IsIPv6Enabled() {
-				applicableConditions = append(applicableConditions, infrav1.EgressOnlyInternetGatewayReadyCondition)
+				infrastructureConditions = append(infrastructureConditions, infrav1.EgressOnlyInternetGatewayReadyCondition)
 			}
 		}
 
+		applicableConditions := append([]clusterv1.ConditionType{
+			ekscontrolplanev1.EKSControlPlaneReadyCondition,
+			ekscontrolplanev1.IAMControlPlaneRolesReadyCondition,
+			ekscontrolplanev1.IAMAuthenticatorConfiguredCondition,
+			ekscontrolplanev1.EKSAddonsConfiguredCondition,
+		}, infrastructureConditions...)
+		if len(managedScope.Addons()) > 0 {
+			applicableConditions = append(applicableConditions, ekscontrolplanev1.EKSAddonsHealthyCondition)
+		}
+
+		infrautilconditions.SetSummaryCondition(managedScope.ControlPlane, infrav1.InfrastructureReadyCondition, conditions.WithConditions(infrastructureConditions...), conditions.WithStepCounter())
 		conditions.SetSummary(managedScope.ControlPlane, conditions.WithConditions(applicableConditions...), conditions.WithStepCounter())
 
 		if err := managedScope.Close(); err != nil && reterr == nil {
@@ -293,11 +302,13 @@ func (r *AWSManagedControlPlaneReconciler) Reconcile(ctx context.Context, req ct
 
 	if !awsManagedControlPlane.ObjectMeta.DeletionTimestamp.IsZero() {
 		// Handle deletion reconciliation loop.
-		return r.reconcileDelete(ctx, managedScope)
+		result, err := r.reconcileDelete(ctx, managedScope)
+		return scope.RequeueOnWriteFreeze(awsManagedControlPlane, result, err)
 	}
 
 	// Handle normal reconciliation loop.
-	return r.reconcileNormal(ctx, managedScope)
+	result, err := r.reconcileNormal(ctx, managedScope)
+	return scope.RequeueOnWriteFreeze(awsManagedControlPlane, result, err)
 }
 
 func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context, managedScope *scope.ManagedControlPlaneScope) (res ctrl.Result, reterr error) {
@@ -335,6 +346,13 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 	awsnodeService := r.getAWSNodeService(managedScope)
 	kubeproxyService := r.getKubeProxyService(managedScope)
 
+	// The region is validated until the control plane is ready, whether or not the preflight checks are enabled.
+	if !awsManagedControlPlane.Status.Ready {
+		if err := ec2Service.ValidateRegion(); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to validate region for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+		}
+	}
+
 	if err := networkSvc.ReconcileNetwork(); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile network for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
 	}
@@ -417,6 +435,13 @@ func (r *AWSManagedControlPlaneReconciler) reconcileDelete(ctx context.Context,
 		return reconcile.Result{}, err
 	}
 
+	if feature.Gates.Enabled(feature.MachineCloudWatchLogs) {
+		if err := cloudwatchlogs.NewService(managedScope).DeleteLogGroups(); err != nil {
+			log.Error(err, "error deleting CloudWatch log groups for AWSManagedControlPlane", "namespace", controlPlane.Namespace, "name", controlPlane.Name)
+			return reconcile.Result{}, err
+		}
+	}
+
 	if err := sgService.DeleteSecurityGroups(); err != nil {
 		log.Error(err, "error deleting general security groups for AWSManagedControlPlane", "namespace", controlPlane.Namespace, "name", controlPlane.Name)
 		return reconcile.Result{}, err
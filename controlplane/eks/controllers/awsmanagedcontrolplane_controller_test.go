@@ -248,6 +248,23 @@ func createControllerIdentity(g *WithT) *infrav1.AWSClusterControllerIdentity {
 // mockedCallsForMissingEverything mocks most of the AWSManagedControlPlane reconciliation calls to the AWS API,
 // except for what other functions provide (see `mockedCreateSGCalls` and `mockedDescribeInstanceCall`).
 func mockedCallsForMissingEverything(ec2Rec *mocks.MockEC2APIMockRecorder, subnets infrav1.Subnets) {
+	ec2Rec.DescribeRegionsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeRegionsInput{
+		AllRegions: aws.Bool(true),
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("region-name"),
+				Values: aws.StringSlice([]string{"us-east-1"}),
+			},
+		},
+	})).Return(&ec2.DescribeRegionsOutput{
+		Regions: []*ec2.Region{
+			{
+				RegionName:  aws.String("us-east-1"),
+				OptInStatus: aws.String("opt-in-not-required"),
+			},
+		},
+	}, nil)
+
 	describeVPCByNameCall := ec2Rec.DescribeVpcsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeVpcsInput{
 		Filters: []*ec2.Filter{
 			{
@@ -259,6 +276,22 @@ func mockedCallsForMissingEverything(ec2Rec *mocks.MockEC2APIMockRecorder, subne
 		Vpcs: []*ec2.Vpc{},
 	}, nil)
 
+	describeVPCByOwnerCall := ec2Rec.DescribeVpcsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeVpcsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{ec2.VpcStatePending, ec2.VpcStateAvailable}),
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: aws.StringSlice([]string{"owned"}),
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"),
+				Values: aws.StringSlice([]string{"common"}),
+			},
+		},
+	})).After(describeVPCByNameCall).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{}}, nil)
 	ec2Rec.CreateVpcWithContext(context.TODO(), gomock.Eq(&ec2.CreateVpcInput{
 		CidrBlock: aws.String("10.0.0.0/8"),
 		TagSpecifications: []*ec2.TagSpecification{
@@ -280,7 +313,7 @@ func mockedCallsForMissingEverything(ec2Rec *mocks.MockEC2APIMockRecorder, subne
 				},
 			},
 		},
-	})).After(describeVPCByNameCall).Return(&ec2.CreateVpcOutput{
+	})).After(describeVPCByOwnerCall).Return(&ec2.CreateVpcOutput{
 		Vpc: &ec2.Vpc{
 			State:     aws.String("available"),
 			VpcId:     aws.String("vpc-new"),
@@ -66,6 +66,19 @@ const (
 	Nightly ChannelGroupType = "nightly"
 )
 
+// KubeconfigSourceType specifies how the kubeconfig of a ROSA cluster used by Cluster API is generated.
+type KubeconfigSourceType string
+
+const (
+	// AdminUserKubeconfigSource generates the kubeconfig with a token requested with the password of a
+	// cluster admin user created by the controller. The password is stored in a secret to refresh the token.
+	AdminUserKubeconfigSource KubeconfigSourceType = "AdminUser"
+
+	// BreakGlassKubeconfigSource generates the kubeconfig with the client certificate of a break glass
+	// credential, which is renewed before it expires. No password is stored.
+	BreakGlassKubeconfigSource KubeconfigSourceType = "BreakGlass"
+)
+
 // RosaControlPlaneSpec defines the desired state of ROSAControlPlane.
 type RosaControlPlaneSpec struct { //nolint: maligned
 	// Cluster name must be valid DNS-1035 label, so it must consist of lower case alphanumeric
@@ -146,6 +159,21 @@ type RosaControlPlaneSpec struct { //nolint: maligned
 	// +kubebuilder:validation:MaxItems=1
 	ExternalAuthProviders []ExternalAuthProvider `json:"externalAuthProviders,omitempty"`
 
+	// KubeconfigSource is how the kubeconfig used by Cluster API to access the cluster, stored in the
+	// "<cluster-name>-kubeconfig" secret, is generated. "AdminUser" is only supported without external auth
+	// providers, and is used when not set. "BreakGlass" is only supported with external auth providers. When it
+	// is not set with external auth providers, the kubeconfig is expected to be provided by the user.
+	//
+	// +kubebuilder:validation:Enum=AdminUser;BreakGlass
+	// +optional
+	KubeconfigSource KubeconfigSourceType `json:"kubeconfigSource,omitempty"`
+
+	// BreakGlassCredentialValidity is how long the break glass credentials of the "BreakGlass" kubeconfig
+	// source are valid. A new credential is issued once two thirds of this duration elapsed. Defaults to 24h.
+	//
+	// +optional
+	BreakGlassCredentialValidity *metav1.Duration `json:"breakGlassCredentialValidity,omitempty"`
+
 	// InstallerRoleARN is an AWS IAM role that OpenShift Cluster Manager will assume to create the cluster..
 	InstallerRoleARN string `json:"installerRoleARN"`
 	// SupportRoleARN is an AWS IAM role used by Red Hat SREs to enable
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/blang/semver"
 	kmsArnRegexpValidator "github.com/openshift-online/ocm-common/pkg/resource/validations"
@@ -54,6 +55,8 @@ func (*rosaControlPlaneWebhook) ValidateCreate(_ context.Context, obj runtime.Ob
 		allErrs = append(allErrs, err)
 	}
 
+	allErrs = append(allErrs, r.validateKubeconfigSource()...)
+
 	if err := r.validateClusterRegistryConfig(); err != nil {
 		allErrs = append(allErrs, err)
 	}
@@ -101,6 +104,7 @@ func (*rosaControlPlaneWebhook) ValidateUpdate(_ context.Context, oldObj, newObj
 		allErrs = append(allErrs, err)
 	}
 
+	allErrs = append(allErrs, r.validateKubeconfigSource()...)
 	allErrs = append(allErrs, r.validateNetwork()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 
@@ -179,6 +183,38 @@ func (r *ROSAControlPlane) validateExternalAuthProviders() *field.Error {
 	return nil
 }
 
+// minBreakGlassCredentialValidity is the minimum validity of the break glass credentials of the kubeconfig,
+// so that they are not renewed on every reconciliation.
+const minBreakGlassCredentialValidity = 10 * time.Minute
+
+func (r *ROSAControlPlane) validateKubeconfigSource() field.ErrorList {
+	var allErrs field.ErrorList
+
+	sourcePath := field.NewPath("spec", "kubeconfigSource")
+	switch r.Spec.KubeconfigSource {
+	case AdminUserKubeconfigSource:
+		if r.Spec.EnableExternalAuthProviders {
+			allErrs = append(allErrs, field.Invalid(sourcePath, r.Spec.KubeconfigSource, "is not supported with external auth providers"))
+		}
+	case BreakGlassKubeconfigSource:
+		if !r.Spec.EnableExternalAuthProviders {
+			allErrs = append(allErrs, field.Invalid(sourcePath, r.Spec.KubeconfigSource, "requires spec.enableExternalAuthProviders"))
+		}
+	}
+
+	if validity := r.Spec.BreakGlassCredentialValidity; validity != nil {
+		validityPath := field.NewPath("spec", "breakGlassCredentialValidity")
+		if r.Spec.KubeconfigSource != BreakGlassKubeconfigSource {
+			allErrs = append(allErrs, field.Forbidden(validityPath, "can only be set with the BreakGlass kubeconfig source"))
+		}
+		if validity.Duration < minBreakGlassCredentialValidity {
+			allErrs = append(allErrs, field.Invalid(validityPath, validity.Duration.String(), fmt.Sprintf("must be at least %s", minBreakGlassCredentialValidity)))
+		}
+	}
+
+	return allErrs
+}
+
 // Default implements admission.Defaulter.
 func (*rosaControlPlaneWebhook) Default(_ context.Context, obj runtime.Object) error {
 	r, ok := obj.(*ROSAControlPlane)
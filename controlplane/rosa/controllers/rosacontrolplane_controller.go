@@ -84,6 +84,12 @@ const (
 
 	// ExternalAuthProviderLastAppliedAnnotation annotation tracks the last applied external auth configuration to inform if an update is required.
 	ExternalAuthProviderLastAppliedAnnotation = "controlplane.cluster.x-k8s.io/rosacontrolplane-last-applied-external-auth-provider"
+
+	// BreakGlassKubeconfigExpirationAnnotation annotation tracks the expiration of the break glass credential of the kubeconfig secret.
+	BreakGlassKubeconfigExpirationAnnotation = "controlplane.cluster.x-k8s.io/rosacontrolplane-break-glass-kubeconfig-expiration"
+
+	// defaultBreakGlassCredentialValidity is the validity of the break glass credentials of the kubeconfig when not set in the spec.
+	defaultBreakGlassCredentialValidity = 24 * time.Hour
 )
 
 // ROSAControlPlaneReconciler reconciles a ROSAControlPlane object.
@@ -278,6 +284,15 @@ func (r *ROSAControlPlaneReconciler) reconcileNormal(ctx context.Context, rosaSc
 				if err := r.reconcileExternalAuth(ctx, rosaScope, cluster); err != nil {
 					return ctrl.Result{}, fmt.Errorf("failed to reconcile external auth: %w", err)
 				}
+				// the kubeconfig is only generated with break glass credentials when requested,
+				// otherwise the user is expected to provide the kubeconfig for CAPI.
+				if rosaScope.ControlPlane.Spec.KubeconfigSource == rosacontrolplanev1.BreakGlassKubeconfigSource {
+					renewIn, err := r.reconcileBreakGlassKubeconfig(ctx, rosaScope, cluster)
+					if err != nil {
+						return ctrl.Result{}, fmt.Errorf("failed to reconcile break glass kubeconfig: %w", err)
+					}
+					return ctrl.Result{RequeueAfter: renewIn}, nil
+				}
 			} else {
 				// only reconcile a kubeconfig when external auth is not enabled.
 				// The user is expected to provide the kubeconfig for CAPI.
@@ -777,6 +792,95 @@ func (r *ROSAControlPlaneReconciler) reconcileExternalAuthBootstrapKubeconfig(ct
 	return nil
 }
 
+// reconcileBreakGlassKubeconfig generates the kubeconfig of the cluster with a break glass credential, so that no
+// password of a cluster admin user is stored, and renews it once two thirds of the validity of the credential elapsed.
+// It returns how long until the kubeconfig has to be renewed.
+func (r *ROSAControlPlaneReconciler) reconcileBreakGlassKubeconfig(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, cluster *cmv1.Cluster) (time.Duration, error) {
+	rosaScope.Debug("Reconciling ROSA break glass kubeconfig for cluster", "cluster-name", rosaScope.RosaClusterName())
+
+	clusterRef := client.ObjectKeyFromObject(rosaScope.Cluster)
+	kubeconfigSecret, err := secret.GetFromNamespacedName(ctx, r.Client, clusterRef, secret.Kubeconfig)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return 0, fmt.Errorf("failed to get kubeconfig secret: %w", err)
+	}
+
+	validity := defaultBreakGlassCredentialValidity
+	if rosaScope.ControlPlane.Spec.BreakGlassCredentialValidity != nil {
+		validity = rosaScope.ControlPlane.Spec.BreakGlassCredentialValidity.Duration
+	}
+
+	now := time.Now()
+	if renewIn := breakGlassKubeconfigRenewal(kubeconfigSecret, validity, now); renewIn > 0 {
+		rosaScope.ControlPlane.Status.Initialized = true
+		return renewIn, nil
+	}
+
+	externalAuthClient, err := rosa.NewExternalAuthClient(ctx, rosaScope)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create external auth client: %v", err)
+	}
+	defer externalAuthClient.Close()
+
+	expiration := now.Add(validity)
+	breakGlassConfig, err := cmv1.NewBreakGlassCredential().
+		Username(names.SimpleNameGenerator.GenerateName("capi-kubeconfig-")). // OCM requires unique usernames
+		ExpirationTimestamp(expiration).
+		Build()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build break glass config: %v", err)
+	}
+
+	breakGlassCredential, err := externalAuthClient.CreateBreakGlassCredential(cluster.ID(), breakGlassConfig)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create break glass credential: %v", err)
+	}
+
+	kubeconfigData, err := externalAuthClient.PollKubeconfig(ctx, cluster.ID(), breakGlassCredential.ID())
+	if err != nil {
+		return 0, fmt.Errorf("failed to poll break glass kubeconfig: %v", err)
+	}
+
+	if kubeconfigSecret != nil {
+		// update existing kubeconfig secret.
+		kubeconfigSecret.Data[secret.KubeconfigDataName] = []byte(kubeconfigData)
+		if kubeconfigSecret.Annotations == nil {
+			kubeconfigSecret.Annotations = make(map[string]string)
+		}
+		kubeconfigSecret.Annotations[BreakGlassKubeconfigExpirationAnnotation] = expiration.UTC().Format(time.RFC3339)
+		if err := r.Client.Update(ctx, kubeconfigSecret); err != nil {
+			return 0, fmt.Errorf("failed to update kubeconfig secret: %w", err)
+		}
+	} else {
+		// create new kubeconfig secret.
+		controllerOwnerRef := *metav1.NewControllerRef(rosaScope.ControlPlane, rosacontrolplanev1.GroupVersion.WithKind("ROSAControlPlane"))
+		kubeconfigSecret = kubeconfig.GenerateSecretWithOwner(clusterRef, []byte(kubeconfigData), controllerOwnerRef)
+		kubeconfigSecret.Annotations = map[string]string{
+			BreakGlassKubeconfigExpirationAnnotation: expiration.UTC().Format(time.RFC3339),
+		}
+		if err := r.Client.Create(ctx, kubeconfigSecret); err != nil {
+			return 0, fmt.Errorf("failed to create kubeconfig secret: %w", err)
+		}
+	}
+	rosaScope.Info("Generated ROSA break glass kubeconfig", "cluster-name", rosaScope.RosaClusterName(), "expiration", expiration)
+
+	rosaScope.ControlPlane.Status.Initialized = true
+	return breakGlassKubeconfigRenewal(kubeconfigSecret, validity, now), nil
+}
+
+// breakGlassKubeconfigRenewal returns how long until the break glass kubeconfig secret has to be renewed, once two
+// thirds of the validity of its credential elapsed. It returns zero if it has to be renewed now, including when the
+// secret doesn't exist or wasn't generated with a break glass credential.
+func breakGlassKubeconfigRenewal(kubeconfigSecret *corev1.Secret, validity time.Duration, now time.Time) time.Duration {
+	if kubeconfigSecret == nil {
+		return 0
+	}
+	expiration, err := time.Parse(time.RFC3339, kubeconfigSecret.Annotations[BreakGlassKubeconfigExpirationAnnotation])
+	if err != nil {
+		return 0
+	}
+	return max(expiration.Add(-validity/3).Sub(now), 0)
+}
+
 func (r *ROSAControlPlaneReconciler) reconcileKubeconfig(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, ocmClient rosa.OCMClient, cluster *cmv1.Cluster) error {
 	rosaScope.Debug("Reconciling ROSA kubeconfig for cluster", "cluster-name", rosaScope.RosaClusterName())
 
@@ -419,6 +419,58 @@ func TestRosaControlPlaneReconcileStatusVersion(t *testing.T) {
 	}
 }
 
+func TestBreakGlassKubeconfigRenewal(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	validity := 24 * time.Hour
+
+	kubeconfigSecret := func(annotations map[string]string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+	}
+
+	testCases := []struct {
+		name            string
+		secret          *corev1.Secret
+		expectedRenewIn time.Duration
+	}{
+		{
+			name:            "no kubeconfig secret",
+			expectedRenewIn: 0,
+		},
+		{
+			name:            "kubeconfig secret without expiration",
+			secret:          kubeconfigSecret(nil),
+			expectedRenewIn: 0,
+		},
+		{
+			name:            "kubeconfig secret with invalid expiration",
+			secret:          kubeconfigSecret(map[string]string{BreakGlassKubeconfigExpirationAnnotation: "tomorrow"}),
+			expectedRenewIn: 0,
+		},
+		{
+			name:            "newly generated kubeconfig",
+			secret:          kubeconfigSecret(map[string]string{BreakGlassKubeconfigExpirationAnnotation: now.Add(validity).Format(time.RFC3339)}),
+			expectedRenewIn: 16 * time.Hour,
+		},
+		{
+			name:            "kubeconfig past two thirds of its validity",
+			secret:          kubeconfigSecret(map[string]string{BreakGlassKubeconfigExpirationAnnotation: now.Add(6 * time.Hour).Format(time.RFC3339)}),
+			expectedRenewIn: 0,
+		},
+		{
+			name:            "expired kubeconfig",
+			secret:          kubeconfigSecret(map[string]string{BreakGlassKubeconfigExpirationAnnotation: now.Add(-time.Hour).Format(time.RFC3339)}),
+			expectedRenewIn: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(breakGlassKubeconfigRenewal(tc.secret, validity, now)).To(Equal(tc.expectedRenewIn))
+		})
+	}
+}
+
 func createObject(g *WithT, obj client.Object, namespace string) {
 	if obj.DeepCopyObject() != nil {
 		obj.SetNamespace(namespace)
@@ -55,10 +55,32 @@ func (src *AWSMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 	if restored.Spec.Ignition != nil {
 		dst.Spec.Ignition = restored.Spec.Ignition
 	}
+	if restored.Spec.MixedInstancesPolicy != nil && dst.Spec.MixedInstancesPolicy != nil {
+		dst.Spec.MixedInstancesPolicy = restored.Spec.MixedInstancesPolicy
+	}
 	dst.Status.InfrastructureMachineKind = restored.Status.InfrastructureMachineKind
+	dst.Status.PendingDisruptiveActions = restored.Status.PendingDisruptiveActions
+	if restored.Status.Instances != nil {
+		dst.Status.Instances = restored.Status.Instances
+	}
+	if restored.Spec.AvailabilityZonePlacement != nil {
+		dst.Spec.AvailabilityZonePlacement = restored.Spec.AvailabilityZonePlacement
+	}
+	if restored.Status.AvailabilityZones != nil {
+		dst.Status.AvailabilityZones = restored.Status.AvailabilityZones
+	}
+	dst.Status.FailureDomainCount = restored.Status.FailureDomainCount
+	dst.Status.Capacity = restored.Status.Capacity
+	dst.Status.NodeInfo = restored.Status.NodeInfo
 	if restored.Spec.AWSLifecycleHooks != nil {
 		dst.Spec.AWSLifecycleHooks = restored.Spec.AWSLifecycleHooks
 	}
+	if restored.Spec.ScheduledActions != nil {
+		dst.Spec.ScheduledActions = restored.Spec.ScheduledActions
+	}
+	if restored.Spec.HealthCheck != nil {
+		dst.Spec.HealthCheck = restored.Spec.HealthCheck
+	}
 
 	if restored.Spec.AWSLaunchTemplate.PrivateDNSName != nil {
 		dst.Spec.AWSLaunchTemplate.PrivateDNSName = restored.Spec.AWSLaunchTemplate.PrivateDNSName
@@ -72,6 +94,10 @@ func (src *AWSMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.AWSLaunchTemplate.MarketType = restored.Spec.AWSLaunchTemplate.MarketType
 	}
 
+	if restored.Spec.AWSLaunchTemplate.CPUOptions != nil {
+		dst.Spec.AWSLaunchTemplate.CPUOptions = restored.Spec.AWSLaunchTemplate.CPUOptions
+	}
+
 	dst.Spec.DefaultInstanceWarmup = restored.Spec.DefaultInstanceWarmup
 	dst.Spec.AWSLaunchTemplate.NonRootVolumes = restored.Spec.AWSLaunchTemplate.NonRootVolumes
 	return nil
@@ -130,6 +156,10 @@ func (src *AWSManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 		if restored.Spec.AWSLaunchTemplate.MarketType != "" {
 			dst.Spec.AWSLaunchTemplate.MarketType = restored.Spec.AWSLaunchTemplate.MarketType
 		}
+
+		if restored.Spec.AWSLaunchTemplate.CPUOptions != nil {
+			dst.Spec.AWSLaunchTemplate.CPUOptions = restored.Spec.AWSLaunchTemplate.CPUOptions
+		}
 	}
 	if restored.Spec.AvailabilityZoneSubnetType != nil {
 		dst.Spec.AvailabilityZoneSubnetType = restored.Spec.AvailabilityZoneSubnetType
@@ -140,6 +170,20 @@ func (src *AWSManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.RolePath = restored.Spec.RolePath
 	dst.Spec.RolePermissionsBoundary = restored.Spec.RolePermissionsBoundary
+	if restored.Spec.RemoteAccess != nil && dst.Spec.RemoteAccess != nil {
+		dst.Spec.RemoteAccess.Mode = restored.Spec.RemoteAccess.Mode
+	}
+	if restored.Spec.Scaling != nil && dst.Spec.Scaling != nil {
+		dst.Spec.Scaling.ManageAutoscalerTags = restored.Spec.Scaling.ManageAutoscalerTags
+	}
+	if restored.Spec.UpdateConfig != nil && dst.Spec.UpdateConfig != nil {
+		dst.Spec.UpdateConfig.Force = restored.Spec.UpdateConfig.Force
+		dst.Spec.UpdateConfig.SoakDuration = restored.Spec.UpdateConfig.SoakDuration
+	}
+	dst.Status.AppliedConfig = restored.Status.AppliedConfig
+	dst.Status.LastUpdate = restored.Status.LastUpdate
+	dst.Status.Capacity = restored.Status.Capacity
+	dst.Status.NodeInfo = restored.Status.NodeInfo
 
 	return nil
 }
@@ -160,6 +204,26 @@ func Convert_v1beta2_AWSManagedMachinePoolSpec_To_v1beta1_AWSManagedMachinePoolS
 	return autoConvert_v1beta2_AWSManagedMachinePoolSpec_To_v1beta1_AWSManagedMachinePoolSpec(in, out, s)
 }
 
+// Convert_v1beta2_ManagedRemoteAccess_To_v1beta1_ManagedRemoteAccess is a conversion function.
+func Convert_v1beta2_ManagedRemoteAccess_To_v1beta1_ManagedRemoteAccess(in *expinfrav1.ManagedRemoteAccess, out *ManagedRemoteAccess, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_ManagedRemoteAccess_To_v1beta1_ManagedRemoteAccess(in, out, s)
+}
+
+// Convert_v1beta2_ManagedMachinePoolScaling_To_v1beta1_ManagedMachinePoolScaling is a conversion function.
+func Convert_v1beta2_ManagedMachinePoolScaling_To_v1beta1_ManagedMachinePoolScaling(in *expinfrav1.ManagedMachinePoolScaling, out *ManagedMachinePoolScaling, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_ManagedMachinePoolScaling_To_v1beta1_ManagedMachinePoolScaling(in, out, s)
+}
+
+// Convert_v1beta2_UpdateConfig_To_v1beta1_UpdateConfig is a conversion function.
+func Convert_v1beta2_UpdateConfig_To_v1beta1_UpdateConfig(in *expinfrav1.UpdateConfig, out *UpdateConfig, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_UpdateConfig_To_v1beta1_UpdateConfig(in, out, s)
+}
+
+// Convert_v1beta2_AWSManagedMachinePoolStatus_To_v1beta1_AWSManagedMachinePoolStatus is a conversion function.
+func Convert_v1beta2_AWSManagedMachinePoolStatus_To_v1beta1_AWSManagedMachinePoolStatus(in *expinfrav1.AWSManagedMachinePoolStatus, out *AWSManagedMachinePoolStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_AWSManagedMachinePoolStatus_To_v1beta1_AWSManagedMachinePoolStatus(in, out, s)
+}
+
 func Convert_v1beta2_AWSMachinePoolStatus_To_v1beta1_AWSMachinePoolStatus(in *expinfrav1.AWSMachinePoolStatus, out *AWSMachinePoolStatus, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_AWSMachinePoolStatus_To_v1beta1_AWSMachinePoolStatus(in, out, s)
 }
@@ -193,6 +257,7 @@ func (src *AWSFargateProfile) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.RolePath = restored.Spec.RolePath
 	dst.Spec.RolePermissionsBoundary = restored.Spec.RolePermissionsBoundary
+	dst.Spec.SubnetSelector = restored.Spec.SubnetSelector
 
 	return nil
 }
@@ -251,6 +316,18 @@ func Convert_v1beta2_AWSMachinePoolSpec_To_v1beta1_AWSMachinePoolSpec(in *expinf
 	return autoConvert_v1beta2_AWSMachinePoolSpec_To_v1beta1_AWSMachinePoolSpec(in, out, s)
 }
 
+// Convert_v1beta2_InstancesDistribution_To_v1beta1_InstancesDistribution converts the v1beta2 InstancesDistribution receiver to a v1beta1 InstancesDistribution.
+func Convert_v1beta2_InstancesDistribution_To_v1beta1_InstancesDistribution(in *expinfrav1.InstancesDistribution, out *InstancesDistribution, s apiconversion.Scope) error {
+	// spec.mixedInstancesPolicy.instancesDistribution.spotInstancePools and spotMaxPrice have been added to v1beta2.
+	return autoConvert_v1beta2_InstancesDistribution_To_v1beta1_InstancesDistribution(in, out, s)
+}
+
+// Convert_v1beta2_Overrides_To_v1beta1_Overrides converts the v1beta2 Overrides receiver to a v1beta1 Overrides.
+func Convert_v1beta2_Overrides_To_v1beta1_Overrides(in *expinfrav1.Overrides, out *Overrides, s apiconversion.Scope) error {
+	// spec.mixedInstancesPolicy.overrides.weightedCapacity has been added to v1beta2.
+	return autoConvert_v1beta2_Overrides_To_v1beta1_Overrides(in, out, s)
+}
+
 func Convert_v1beta2_AutoScalingGroup_To_v1beta1_AutoScalingGroup(in *expinfrav1.AutoScalingGroup, out *AutoScalingGroup, s apiconversion.Scope) error {
 	// explicitly ignore CurrentlySuspended.
 	return autoConvert_v1beta2_AutoScalingGroup_To_v1beta1_AutoScalingGroup(in, out, s)
@@ -265,3 +342,8 @@ func Convert_v1beta2_RefreshPreferences_To_v1beta1_RefreshPreferences(in *expinf
 func Convert_v1beta2_FargateProfileSpec_To_v1beta1_FargateProfileSpec(in *expinfrav1.FargateProfileSpec, out *FargateProfileSpec, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_FargateProfileSpec_To_v1beta1_FargateProfileSpec(in, out, s)
 }
+
+// Convert_v1beta2_AWSMachinePoolInstanceStatus_To_v1beta1_AWSMachinePoolInstanceStatus is a conversion function.
+func Convert_v1beta2_AWSMachinePoolInstanceStatus_To_v1beta1_AWSMachinePoolInstanceStatus(in *expinfrav1.AWSMachinePoolInstanceStatus, out *AWSMachinePoolInstanceStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_AWSMachinePoolInstanceStatus_To_v1beta1_AWSMachinePoolInstanceStatus(in, out, s)
+}
@@ -47,6 +47,13 @@ type FargateProfileSpec struct {
 	// +optional
 	SubnetIDs []string `json:"subnetIDs,omitempty"`
 
+	// SubnetSelector selects the subnets of the cluster network used by the profile, instead of
+	// listing them in SubnetIDs. Only private subnets are selected as Fargate does not support
+	// public subnets. When neither SubnetIDs nor SubnetSelector are set, all the private subnets
+	// of the cluster are used.
+	// +optional
+	SubnetSelector *FargateSubnetSelector `json:"subnetSelector,omitempty"`
+
 	// AdditionalTags is an optional set of tags to add to AWS resources managed by the AWS provider, in addition to the
 	// ones added by default.
 	// +optional
@@ -96,6 +103,18 @@ type FargateSelector struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// FargateSubnetSelector selects private subnets of the cluster network.
+type FargateSubnetSelector struct {
+	// AvailabilityZones restricts the selected subnets to the subnets in these availability zones.
+	// +optional
+	// +listType=set
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// Tags restricts the selected subnets to the subnets having all of these tags.
+	// +optional
+	Tags infrav1.Tags `json:"tags,omitempty"`
+}
+
 // FargateProfileStatus defines the observed state of FargateProfile.
 type FargateProfileStatus struct {
 	// Ready denotes that the FargateProfile is available.
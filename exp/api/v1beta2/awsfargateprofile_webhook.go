@@ -158,6 +158,7 @@ func (*awsFargateProfileWebhook) ValidateCreate(_ context.Context, obj runtime.O
 
 	var allErrs field.ErrorList
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, r.validateSubnetSelector()...)
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -168,6 +169,41 @@ func (*awsFargateProfileWebhook) ValidateCreate(_ context.Context, obj runtime.O
 	)
 }
 
+func (r *AWSFargateProfile) validateSubnetSelector() field.ErrorList {
+	var allErrs field.ErrorList
+
+	selector := r.Spec.SubnetSelector
+	if selector == nil {
+		return allErrs
+	}
+
+	selectorPath := field.NewPath("spec", "subnetSelector")
+	if len(r.Spec.SubnetIDs) > 0 {
+		allErrs = append(allErrs, field.Forbidden(selectorPath, "cannot be set together with spec.subnetIDs"))
+	}
+
+	seen := map[string]bool{}
+	for i, zone := range selector.AvailabilityZones {
+		zonePath := selectorPath.Child("availabilityZones").Index(i)
+		if zone == "" {
+			allErrs = append(allErrs, field.Required(zonePath, "availability zone cannot be empty"))
+			continue
+		}
+		if seen[zone] {
+			allErrs = append(allErrs, field.Duplicate(zonePath, zone))
+		}
+		seen[zone] = true
+	}
+
+	for key := range selector.Tags {
+		if key == "" {
+			allErrs = append(allErrs, field.Invalid(selectorPath.Child("tags"), key, "tag key cannot be empty"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
 func (*awsFargateProfileWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
 	return nil, nil
@@ -179,6 +179,56 @@ func TestAWSFargateProfileValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "subnet selector is accepted",
+			profile: &AWSFargateProfile{
+				Spec: FargateProfileSpec{
+					ClusterName: "cluster-1",
+					SubnetSelector: &FargateSubnetSelector{
+						AvailabilityZones: []string{"us-east-1a", "us-east-1b"},
+						Tags:              infrav1.Tags{"tier": "fargate"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "subnet selector with subnet IDs is rejected",
+			profile: &AWSFargateProfile{
+				Spec: FargateProfileSpec{
+					ClusterName: "cluster-1",
+					SubnetIDs:   []string{"subnet-1"},
+					SubnetSelector: &FargateSubnetSelector{
+						AvailabilityZones: []string{"us-east-1a"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "subnet selector with duplicate availability zones is rejected",
+			profile: &AWSFargateProfile{
+				Spec: FargateProfileSpec{
+					ClusterName: "cluster-1",
+					SubnetSelector: &FargateSubnetSelector{
+						AvailabilityZones: []string{"us-east-1a", "us-east-1a"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "subnet selector with empty tag key is rejected",
+			profile: &AWSFargateProfile{
+				Spec: FargateProfileSpec{
+					ClusterName: "cluster-1",
+					SubnetSelector: &FargateSubnetSelector{
+						Tags: infrav1.Tags{"": "fargate"},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -19,6 +19,7 @@ package v1beta2
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -60,6 +61,11 @@ type AWSMachinePoolSpec struct {
 	// +optional
 	Subnets []infrav1.AWSResourceReference `json:"subnets,omitempty"`
 
+	// AvailabilityZonePlacement configures how the instances of the pool are placed across
+	// the availability zones of its subnets.
+	// +optional
+	AvailabilityZonePlacement *AvailabilityZonePlacement `json:"availabilityZonePlacement,omitempty"`
+
 	// AdditionalTags is an optional set of tags to add to an instance, in addition to the ones added by default by the
 	// AWS provider.
 	// +optional
@@ -97,6 +103,10 @@ type AWSMachinePoolSpec struct {
 	// +optional
 	CapacityRebalance bool `json:"capacityRebalance,omitempty"`
 
+	// HealthCheck configures how the Auto Scaling group determines the health of its instances.
+	// +optional
+	HealthCheck *AutoScalingGroupHealthCheck `json:"healthCheck,omitempty"`
+
 	// SuspendProcesses defines a list of processes to suspend for the given ASG. This is constantly reconciled.
 	// If a process is removed from this list it will automatically be resumed.
 	SuspendProcesses *SuspendProcessesTypes `json:"suspendProcesses,omitempty"`
@@ -108,6 +118,13 @@ type AWSMachinePoolSpec struct {
 	// AWSLifecycleHooks specifies lifecycle hooks for the autoscaling group.
 	// +optional
 	AWSLifecycleHooks []AWSLifecycleHook `json:"lifecycleHooks,omitempty"`
+
+	// ScheduledActions specifies scheduled actions changing the sizes of the autoscaling group on a recurring
+	// schedule, e.g. to scale a pool to zero at night. When set, the minimum, maximum and desired sizes of the
+	// existing autoscaling group are left to the scheduled actions, and the replicas of the MachinePool follow
+	// the desired capacity of the autoscaling group.
+	// +optional
+	ScheduledActions []AWSScheduledAction `json:"scheduledActions,omitempty"`
 }
 
 // SuspendProcessesTypes contains user friendly auto-completable values for suspended process names.
@@ -210,6 +227,20 @@ type AWSMachinePoolStatus struct {
 	// +optional
 	Instances []AWSMachinePoolInstanceStatus `json:"instances,omitempty"`
 
+	// FailureDomainCount is the number of availability zones the Auto Scaling group spans, displayed by kubectl.
+	// +optional
+	FailureDomainCount int32 `json:"failureDomainCount,omitempty"`
+
+	// Capacity defines the resource capacity of the instances of the pool, using the instance type of
+	// the launch template. This value is used for autoscaling from zero operations as defined in:
+	// https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20210310-opt-in-autoscaling-from-zero.md
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
+	// NodeInfo contains information about the nodes of the instances of the pool.
+	// +optional
+	NodeInfo *infrav1.NodeInfo `json:"nodeInfo,omitempty"`
+
 	// The ID of the launch template
 	LaunchTemplateID string `json:"launchTemplateID,omitempty"`
 
@@ -221,6 +252,11 @@ type AWSMachinePoolStatus struct {
 	// +optional
 	InfrastructureMachineKind string `json:"infrastructureMachineKind,omitempty"`
 
+	// PendingDisruptiveActions are the disruptive actions deferred until the maintenance window of the cluster
+	// opens, such as the instance refresh following an update of the launch template.
+	// +optional
+	PendingDisruptiveActions infrav1.DisruptiveActions `json:"pendingDisruptiveActions,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
@@ -260,6 +296,11 @@ type AWSMachinePoolStatus struct {
 	FailureMessage *string `json:"failureMessage,omitempty"`
 
 	ASGStatus *ASGStatus `json:"asgStatus,omitempty"`
+
+	// AvailabilityZones contains the placement status of the availability zones of the pool.
+	// It is only set when AvailabilityZonePlacement is configured.
+	// +optional
+	AvailabilityZones []AvailabilityZoneStatus `json:"availabilityZones,omitempty"`
 }
 
 // AWSMachinePoolInstanceStatus defines the status of the AWSMachinePoolInstance.
@@ -271,6 +312,46 @@ type AWSMachinePoolInstanceStatus struct {
 	// Version defines the Kubernetes version for the Machine Instance
 	// +optional
 	Version *string `json:"version,omitempty"`
+
+	// LifecycleState is the Auto Scaling lifecycle state of the instance, such as
+	// Pending, InService, Standby or Terminating.
+	// +optional
+	LifecycleState string `json:"lifecycleState,omitempty"`
+
+	// HealthStatus is the Auto Scaling health status of the instance, Healthy or Unhealthy.
+	// +optional
+	HealthStatus string `json:"healthStatus,omitempty"`
+
+	// AvailabilityZone is the availability zone of the instance.
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// InstanceType is the type of the instance.
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// LaunchTemplateVersion is the version of the launch template the instance was launched with.
+	// +optional
+	LaunchTemplateVersion string `json:"launchTemplateVersion,omitempty"`
+
+	// MarketType is the market of the instance, OnDemand, Spot or CapacityBlock.
+	// +optional
+	MarketType infrav1.MarketType `json:"marketType,omitempty"`
+
+	// ProtectedFromScaleIn indicates whether the instance is protected from termination
+	// by the Auto Scaling group when scaling in.
+	// +optional
+	ProtectedFromScaleIn bool `json:"protectedFromScaleIn,omitempty"`
+
+	// ScaleDownDisabled indicates whether the Node or the Machine of the instance has the scale down disabled
+	// annotation of the cluster autoscaler, in which case the instance is protected from scale in.
+	// +optional
+	ScaleDownDisabled bool `json:"scaleDownDisabled,omitempty"`
+
+	// NodeNotReadySince is the time the node of the instance last transitioned to not ready.
+	// It is not set while the node is ready.
+	// +optional
+	NodeNotReadySince *metav1.Time `json:"nodeNotReadySince,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -278,10 +359,12 @@ type AWSMachinePoolInstanceStatus struct {
 // +kubebuilder:storageversion
 // +kubebuilder:resource:path=awsmachinepools,scope=Namespaced,categories=cluster-api,shortName=awsmp
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Machine ready status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",description="Reason the machine pool is not ready",priority=1
 // +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="Machine ready status"
 // +kubebuilder:printcolumn:name="MinSize",type="integer",JSONPath=".spec.minSize",description="Minimum instanes in ASG"
 // +kubebuilder:printcolumn:name="MaxSize",type="integer",JSONPath=".spec.maxSize",description="Maximum instanes in ASG"
 // +kubebuilder:printcolumn:name="LaunchTemplate ID",type="string",JSONPath=".status.launchTemplateID",description="Launch Template ID"
+// +kubebuilder:printcolumn:name="Failure Domains",type="integer",JSONPath=".status.failureDomainCount",description="Number of availability zones of the ASG"
 
 // AWSMachinePool is the Schema for the awsmachinepools API.
 type AWSMachinePool struct {
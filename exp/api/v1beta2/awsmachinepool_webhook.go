@@ -19,6 +19,7 @@ package v1beta2
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -133,12 +134,78 @@ func (r *AWSMachinePool) validateSubnets() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachinePool) validateAvailabilityZonePlacement() field.ErrorList {
+	var allErrs field.ErrorList
+
+	placement := r.Spec.AvailabilityZonePlacement
+	if placement == nil {
+		return allErrs
+	}
+
+	placementPath := field.NewPath("spec", "availabilityZonePlacement")
+	if placement.CapacityErrorExclusionDuration != nil && placement.CapacityErrorExclusionDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(placementPath.Child("capacityErrorExclusionDuration"), placement.CapacityErrorExclusionDuration.Duration.String(), "must be positive"))
+	}
+
+	if len(r.Spec.AvailabilityZones) > 0 {
+		for i, weight := range placement.Weights {
+			if !slices.Contains(r.Spec.AvailabilityZones, weight.AvailabilityZone) {
+				allErrs = append(allErrs, field.Invalid(placementPath.Child("weights").Index(i).Child("availabilityZone"), weight.AvailabilityZone, "must be one of spec.availabilityZones"))
+			}
+		}
+
+		if placement.MinInstancesPerZone != nil {
+			zones := int32(len(r.Spec.AvailabilityZones)) //#nosec G115
+			for _, weight := range placement.Weights {
+				if weight.Weight == 0 {
+					zones--
+				}
+			}
+			if required := *placement.MinInstancesPerZone * zones; required > r.Spec.MaxSize {
+				allErrs = append(allErrs, field.Invalid(placementPath.Child("minInstancesPerZone"), *placement.MinInstancesPerZone,
+					fmt.Sprintf("requires %d instances across the %d availability zones of the pool, more than spec.maxSize", required, zones)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateHealthCheck() field.ErrorList {
+	var allErrs field.ErrorList
+
+	healthCheck := r.Spec.HealthCheck
+	if healthCheck == nil {
+		return allErrs
+	}
+
+	healthCheckPath := field.NewPath("spec", "healthCheck")
+	if healthCheck.GracePeriod != nil && healthCheck.GracePeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(healthCheckPath.Child("gracePeriod"), healthCheck.GracePeriod.Duration.String(), "must not be negative"))
+	}
+	if healthCheck.NodeHealth != nil && healthCheck.NodeHealth.UnhealthyTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(healthCheckPath.Child("nodeHealth", "unhealthyTimeout"), healthCheck.NodeHealth.UnhealthyTimeout.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
 func (r *AWSMachinePool) validateAdditionalSecurityGroups() field.ErrorList {
 	var allErrs field.ErrorList
-	for _, sg := range r.Spec.AWSLaunchTemplate.AdditionalSecurityGroups {
+	ids := make(map[string]struct{}, len(r.Spec.AWSLaunchTemplate.AdditionalSecurityGroups))
+	for i, sg := range r.Spec.AWSLaunchTemplate.AdditionalSecurityGroups {
 		if sg.ID != nil && sg.Filters != nil {
 			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.awsLaunchTemplate.AdditionalSecurityGroups"), "either ID or filters should be used"))
 		}
+		if sg.ID != nil {
+			if _, ok := ids[*sg.ID]; ok {
+				allErrs = append(allErrs, field.Duplicate(field.NewPath("spec.awsLaunchTemplate.AdditionalSecurityGroups").Index(i).Child("id"), *sg.ID))
+			}
+			ids[*sg.ID] = struct{}{}
+		}
+	}
+	if n := len(r.Spec.AWSLaunchTemplate.AdditionalSecurityGroups); n > infrav1.MaxNetworkInterfaceSecurityGroups {
+		allErrs = append(allErrs, field.TooMany(field.NewPath("spec.awsLaunchTemplate.AdditionalSecurityGroups"), n, infrav1.MaxNetworkInterfaceSecurityGroups))
 	}
 	return allErrs
 }
@@ -151,6 +218,32 @@ func (r *AWSMachinePool) validateSpotInstances() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachinePool) validateMixedInstancesPolicy() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.MixedInstancesPolicy == nil {
+		return allErrs
+	}
+
+	// An empty Spot allocation strategy is defaulted to lowest-price.
+	if distribution := r.Spec.MixedInstancesPolicy.InstancesDistribution; distribution != nil && distribution.SpotInstancePools != nil &&
+		distribution.SpotAllocationStrategy != "" && distribution.SpotAllocationStrategy != SpotAllocationStrategyLowestPrice {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.mixedInstancesPolicy.instancesDistribution.spotInstancePools"), "spotInstancePools can only be used with the lowest-price spot allocation strategy"))
+	}
+
+	weighted := 0
+	for _, override := range r.Spec.MixedInstancesPolicy.Overrides {
+		if override.WeightedCapacity != nil {
+			weighted++
+		}
+	}
+	if weighted > 0 && weighted != len(r.Spec.MixedInstancesPolicy.Overrides) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.mixedInstancesPolicy.overrides"), "weightedCapacity must be set on all overrides or on none of them"))
+	}
+
+	return allErrs
+}
+
 func (r *AWSMachinePool) validateRefreshPreferences() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -175,6 +268,10 @@ func (r *AWSMachinePool) validateLifecycleHooks() field.ErrorList {
 	return validateLifecycleHooks(r.Spec.AWSLifecycleHooks)
 }
 
+func (r *AWSMachinePool) validateScheduledActions() field.ErrorList {
+	return validateScheduledActions(r.Spec.ScheduledActions)
+}
+
 func (r *AWSMachinePool) ignitionEnabled() bool {
 	return r.Spec.Ignition != nil
 }
@@ -207,11 +304,15 @@ func (*AWSMachinePoolWebhook) ValidateCreate(_ context.Context, obj runtime.Obje
 	allErrs = append(allErrs, r.validateNonRootVolumes()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validateSubnets()...)
+	allErrs = append(allErrs, r.validateAvailabilityZonePlacement()...)
+	allErrs = append(allErrs, r.validateHealthCheck()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
 	allErrs = append(allErrs, r.validateSpotInstances()...)
+	allErrs = append(allErrs, r.validateMixedInstancesPolicy()...)
 	allErrs = append(allErrs, r.validateRefreshPreferences()...)
 	allErrs = append(allErrs, r.validateInstanceMarketType()...)
 	allErrs = append(allErrs, r.validateLifecycleHooks()...)
+	allErrs = append(allErrs, r.validateScheduledActions()...)
 	allErrs = append(allErrs, r.validateIgnition()...)
 
 	if len(allErrs) == 0 {
@@ -265,10 +366,14 @@ func (*AWSMachinePoolWebhook) ValidateUpdate(_ context.Context, oldObj, newObj r
 	allErrs = append(allErrs, r.validateDefaultCoolDown()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validateSubnets()...)
+	allErrs = append(allErrs, r.validateAvailabilityZonePlacement()...)
+	allErrs = append(allErrs, r.validateHealthCheck()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
 	allErrs = append(allErrs, r.validateSpotInstances()...)
+	allErrs = append(allErrs, r.validateMixedInstancesPolicy()...)
 	allErrs = append(allErrs, r.validateRefreshPreferences()...)
 	allErrs = append(allErrs, r.validateLifecycleHooks()...)
+	allErrs = append(allErrs, r.validateScheduledActions()...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
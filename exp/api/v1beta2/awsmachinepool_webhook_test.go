@@ -91,6 +91,18 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErrToContain: ptr.To[string]("filter"),
 		},
+		{
+			name: "Should fail if additional security groups have duplicate IDs",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+						{ID: aws.String("sg-1")},
+						{ID: aws.String("sg-1")},
+					}},
+				},
+			},
+			wantErrToContain: ptr.To[string]("Duplicate value"),
+		},
 		{
 			name: "Should fail if both subnet ID and filters passed in AWSMachinePool spec",
 			pool: &AWSMachinePool{
@@ -126,6 +138,94 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErrToContain: nil,
 		},
+		{
+			name: "Should pass if the availability zone weights are for availability zones of the pool",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AvailabilityZones: []string{"us-east-1a", "us-east-1b"},
+					AvailabilityZonePlacement: &AvailabilityZonePlacement{
+						Weights:                        []AvailabilityZoneWeight{{AvailabilityZone: "us-east-1b", Weight: 0}},
+						CapacityErrorExclusionDuration: &metav1.Duration{Duration: 10 * time.Minute},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if an availability zone weight is not for an availability zone of the pool",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AvailabilityZones: []string{"us-east-1a", "us-east-1b"},
+					AvailabilityZonePlacement: &AvailabilityZonePlacement{
+						Weights: []AvailabilityZoneWeight{{AvailabilityZone: "us-east-1c", Weight: 2}},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("availabilityZonePlacement.weights[0].availabilityZone"),
+		},
+		{
+			name: "Should pass if the maximum size allows the minimum number of instances per availability zone",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MaxSize:           4,
+					AvailabilityZones: []string{"us-east-1a", "us-east-1b", "us-east-1c"},
+					AvailabilityZonePlacement: &AvailabilityZonePlacement{
+						Weights:             []AvailabilityZoneWeight{{AvailabilityZone: "us-east-1c", Weight: 0}},
+						MinInstancesPerZone: ptr.To[int32](2),
+						MaxSkew:             ptr.To[int32](1),
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if the maximum size is lower than the minimum number of instances across availability zones",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MaxSize:           3,
+					AvailabilityZones: []string{"us-east-1a", "us-east-1b"},
+					AvailabilityZonePlacement: &AvailabilityZonePlacement{
+						MinInstancesPerZone: ptr.To[int32](2),
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("availabilityZonePlacement.minInstancesPerZone"),
+		},
+		{
+			name: "Should fail if the capacity error exclusion duration is not positive",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AvailabilityZonePlacement: &AvailabilityZonePlacement{
+						CapacityErrorExclusionDuration: &metav1.Duration{},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("capacityErrorExclusionDuration"),
+		},
+		{
+			name: "Should succeed with a node health check",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					HealthCheck: &AutoScalingGroupHealthCheck{
+						Type:        HealthCheckTypeELB,
+						GracePeriod: &metav1.Duration{Duration: 5 * time.Minute},
+						NodeHealth:  &NodeHealthCheck{UnhealthyTimeout: metav1.Duration{Duration: 10 * time.Minute}},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if the node health check unhealthy timeout is not positive",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					HealthCheck: &AutoScalingGroupHealthCheck{
+						NodeHealth: &NodeHealthCheck{},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("healthCheck.nodeHealth.unhealthyTimeout"),
+		},
 		{
 			name: "Ensure root volume with device name works (for clusterctl move)",
 			pool: &AWSMachinePool{
@@ -155,6 +255,65 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErrToContain: ptr.To[string]("spotMarketOptions"),
 		},
+		{
+			name: "Should succeed if spot instance pools are set with the lowest-price allocation strategy",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						InstancesDistribution: &InstancesDistribution{
+							SpotAllocationStrategy: SpotAllocationStrategyLowestPrice,
+							SpotInstancePools:      aws.Int64(3),
+							SpotMaxPrice:           aws.String("0.1"),
+						},
+						Overrides: []Overrides{{InstanceType: "t3.medium"}},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if spot instance pools are set with another allocation strategy",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						InstancesDistribution: &InstancesDistribution{
+							SpotAllocationStrategy: SpotAllocationStrategyCapacityOptimizedPrioritized,
+							SpotInstancePools:      aws.Int64(3),
+						},
+						Overrides: []Overrides{{InstanceType: "t3.medium"}},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spotInstancePools"),
+		},
+		{
+			name: "Should succeed if weighted capacity is set on all overrides",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{
+							{InstanceType: "t3.medium", WeightedCapacity: aws.Int64(1)},
+							{InstanceType: "t3.large", WeightedCapacity: aws.Int64(2)},
+						},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if weighted capacity is only set on some overrides",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{
+							{InstanceType: "t3.medium", WeightedCapacity: aws.Int64(1)},
+							{InstanceType: "t3.large"},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("weightedCapacity"),
+		},
 		{
 			name: "Should fail if MaxHealthyPercentage is set, but MinHealthyPercentage is not set",
 			pool: &AWSMachinePool{
@@ -257,6 +416,113 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErrToContain: nil,
 		},
+		{
+			name: "Should fail if a scheduled action has no size",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []AWSScheduledAction{
+						{
+							Name:       "scale-to-zero",
+							Recurrence: "0 20 * * 1-5",
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("at least one of minSize, maxSize or desiredCapacity is required"),
+		},
+		{
+			name: "Should fail if a scheduled action has an invalid recurrence",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []AWSScheduledAction{
+						{
+							Name:            "scale-to-zero",
+							Recurrence:      "@daily",
+							DesiredCapacity: ptr.To[int32](0),
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scheduledActions[0].recurrence"),
+		},
+		{
+			name: "Should fail if a scheduled action has an unknown time zone",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []AWSScheduledAction{
+						{
+							Name:            "scale-to-zero",
+							Recurrence:      "0 20 * * 1-5",
+							TimeZone:        "Europe/Nowhere",
+							DesiredCapacity: ptr.To[int32](0),
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scheduledActions[0].timeZone"),
+		},
+		{
+			name: "Should fail if the desired capacity of a scheduled action is greater than its max size",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []AWSScheduledAction{
+						{
+							Name:            "scale-to-zero",
+							Recurrence:      "0 20 * * 1-5",
+							MaxSize:         ptr.To[int32](0),
+							DesiredCapacity: ptr.To[int32](1),
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scheduledActions[0].desiredCapacity"),
+		},
+		{
+			name: "Should fail if scheduled actions have the same name",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []AWSScheduledAction{
+						{
+							Name:            "scale",
+							Recurrence:      "0 20 * * 1-5",
+							DesiredCapacity: ptr.To[int32](0),
+						},
+						{
+							Name:            "scale",
+							Recurrence:      "0 8 * * 1-5",
+							DesiredCapacity: ptr.To[int32](2),
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("Duplicate value"),
+		},
+		{
+			name: "Should succeed on correct scheduled actions",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []AWSScheduledAction{
+						{
+							Name:            "scale-to-zero",
+							Recurrence:      "0 20 * * 1-5",
+							TimeZone:        "Europe/Paris",
+							MinSize:         ptr.To[int32](0),
+							MaxSize:         ptr.To[int32](0),
+							DesiredCapacity: ptr.To[int32](0),
+						},
+						{
+							Name:            "scale-up",
+							Recurrence:      "0 8 * * 1-5",
+							TimeZone:        "Europe/Paris",
+							MinSize:         ptr.To[int32](1),
+							MaxSize:         ptr.To[int32](3),
+							DesiredCapacity: ptr.To[int32](2),
+						},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
 		{
 			name: "with invalid MarketType provided",
 			pool: &AWSMachinePool{
@@ -19,6 +19,7 @@ package v1beta2
 import (
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -40,6 +41,14 @@ const (
 	Al2023x86_64 ManagedMachineAMIType = "AL2023_x86_64_STANDARD"
 	// Al2023Arm64 is the AL2023 Arm AMI type.
 	Al2023Arm64 ManagedMachineAMIType = "AL2023_ARM_64_STANDARD"
+	// WindowsCore2019x86_64 is the Windows Server 2019 Core x86-64 AMI type.
+	WindowsCore2019x86_64 ManagedMachineAMIType = "WINDOWS_CORE_2019_x86_64"
+	// WindowsFull2019x86_64 is the Windows Server 2019 Full x86-64 AMI type.
+	WindowsFull2019x86_64 ManagedMachineAMIType = "WINDOWS_FULL_2019_x86_64"
+	// WindowsCore2022x86_64 is the Windows Server 2022 Core x86-64 AMI type.
+	WindowsCore2022x86_64 ManagedMachineAMIType = "WINDOWS_CORE_2022_x86_64"
+	// WindowsFull2022x86_64 is the Windows Server 2022 Full x86-64 AMI type.
+	WindowsFull2022x86_64 ManagedMachineAMIType = "WINDOWS_FULL_2022_x86_64"
 )
 
 // ManagedMachinePoolCapacityType specifies the capacity type to be used for the managed MachinePool.
@@ -129,7 +138,7 @@ type AWSManagedMachinePoolSpec struct {
 	AMIVersion *string `json:"amiVersion,omitempty"`
 
 	// AMIType defines the AMI type
-	// +kubebuilder:validation:Enum:=AL2_x86_64;AL2_x86_64_GPU;AL2_ARM_64;AL2023_x86_64_STANDARD;AL2023_ARM_64_STANDARD;CUSTOM
+	// +kubebuilder:validation:Enum:=AL2_x86_64;AL2_x86_64_GPU;AL2_ARM_64;AL2023_x86_64_STANDARD;AL2023_ARM_64_STANDARD;WINDOWS_CORE_2019_x86_64;WINDOWS_FULL_2019_x86_64;WINDOWS_CORE_2022_x86_64;WINDOWS_FULL_2022_x86_64;CUSTOM
 	// +kubebuilder:default:=AL2_x86_64
 	// +optional
 	AMIType *ManagedMachineAMIType `json:"amiType,omitempty"`
@@ -190,6 +199,19 @@ type AWSManagedMachinePoolSpec struct {
 type ManagedMachinePoolScaling struct {
 	MinSize *int32 `json:"minSize,omitempty"`
 	MaxSize *int32 `json:"maxSize,omitempty"`
+
+	// ManageAutoscalerTags lets the cluster autoscaler scale the nodegroup between MinSize and MaxSize,
+	// including from zero: the node group size annotations of the cluster autoscaler are set on the
+	// MachinePool, the node template tags of the labels, taints and capacity of the nodes are set on the
+	// Auto Scaling groups of the nodegroup, and the capacity of the nodes is reported in the status.
+	// MinSize and MaxSize are required when enabled.
+	// +optional
+	ManageAutoscalerTags bool `json:"manageAutoscalerTags,omitempty"`
+}
+
+// IsAutoscalerTagsManaged returns true if the cluster autoscaler tags of the nodegroup are managed.
+func (s *ManagedMachinePoolScaling) IsAutoscalerTagsManaged() bool {
+	return s != nil && s.ManageAutoscalerTags
 }
 
 // ManagedRemoteAccess specifies remote access settings for EC2 instances.
@@ -203,6 +225,30 @@ type ManagedRemoteAccess struct {
 
 	// Public specifies whether to open port 22 to the public internet
 	Public bool `json:"public,omitempty"`
+
+	// Mode specifies how the machines are accessed. SSH, the default, gives the SSH access
+	// described by the other fields. SSMOnly removes the SSH access, with no SSH key even from the
+	// control plane, and attaches the AmazonSSMManagedInstanceCore policy to the node role so that
+	// the machines are only accessed through AWS Systems Manager Session Manager.
+	// +kubebuilder:validation:Enum=SSH;SSMOnly
+	// +optional
+	Mode ManagedRemoteAccessMode `json:"mode,omitempty"`
+}
+
+// ManagedRemoteAccessMode specifies how the machines of a managed machine pool are accessed.
+type ManagedRemoteAccessMode string
+
+const (
+	// ManagedRemoteAccessModeSSH gives SSH access to the machines.
+	ManagedRemoteAccessModeSSH = ManagedRemoteAccessMode("SSH")
+
+	// ManagedRemoteAccessModeSSMOnly only gives access to the machines through AWS Systems Manager Session Manager.
+	ManagedRemoteAccessModeSSMOnly = ManagedRemoteAccessMode("SSMOnly")
+)
+
+// IsSSMOnly returns whether the machines are only accessed through AWS Systems Manager Session Manager.
+func (r *ManagedRemoteAccess) IsSSMOnly() bool {
+	return r != nil && r.Mode == ManagedRemoteAccessModeSSMOnly
 }
 
 // AWSManagedMachinePoolStatus defines the observed state of AWSManagedMachinePool.
@@ -262,11 +308,67 @@ type AWSManagedMachinePoolStatus struct {
 	// +optional
 	FailureMessage *string `json:"failureMessage,omitempty"`
 
+	// AppliedConfig is the configuration last applied to the EKS nodegroup. It tells the changes
+	// made to the nodegroup outside of the AWSManagedMachinePool, for instance in the AWS console,
+	// from the changes of its spec.
+	// +optional
+	AppliedConfig *AppliedNodegroupConfig `json:"appliedConfig,omitempty"`
+
+	// LastUpdate is the last version update of the EKS nodegroup started by the controller.
+	// +optional
+	LastUpdate *NodegroupVersionUpdate `json:"lastUpdate,omitempty"`
+
+	// Capacity defines the resource capacity of the nodes of the nodegroup, using its instance type.
+	// It is only reported when the cluster autoscaler tags are managed, for autoscaling from zero as defined in:
+	// https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20210310-opt-in-autoscaling-from-zero.md
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
+	// NodeInfo contains information about the nodes of the nodegroup.
+	// +optional
+	NodeInfo *infrav1.NodeInfo `json:"nodeInfo,omitempty"`
+
 	// Conditions defines current service state of the managed machine pool
 	// +optional
 	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
 }
 
+// AppliedNodegroupConfig is the configuration applied to an EKS nodegroup.
+type AppliedNodegroupConfig struct {
+	// Labels are the Kubernetes labels applied to the nodes of the nodegroup.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints are the Kubernetes taints applied to the nodes of the nodegroup.
+	// +optional
+	Taints Taints `json:"taints,omitempty"`
+}
+
+// NodegroupVersionUpdate is a version update of an EKS nodegroup.
+type NodegroupVersionUpdate struct {
+	// ID is the ID of the EKS update.
+	ID string `json:"id"`
+
+	// Description describes the update, e.g. the version the nodegroup is updated to.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Status is the status of the EKS update: InProgress, Failed, Cancelled or Successful.
+	Status string `json:"status"`
+
+	// Message explains why the update failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Generation is the generation of the AWSManagedMachinePool the update was started for.
+	// A failed update is only retried once the spec of the AWSManagedMachinePool changes.
+	Generation int64 `json:"generation"`
+
+	// CompletedAt is when the update was observed to be successful.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=awsmanagedmachinepools,scope=Namespaced,categories=cluster-api,shortName=awsmmp
 // +kubebuilder:storageversion
@@ -78,6 +78,14 @@ func (r *AWSManagedMachinePool) validateScaling() field.ErrorList {
 		if maxSize != nil && *maxSize < 0 {
 			allErrs = append(allErrs, field.Invalid(maxField, *maxSize, "must be greater than zero"))
 		}
+		if r.Spec.Scaling.ManageAutoscalerTags {
+			if minSize == nil {
+				allErrs = append(allErrs, field.Required(minField, "is required when spec.scaling.manageAutoscalerTags is enabled"))
+			}
+			if maxSize == nil {
+				allErrs = append(allErrs, field.Required(maxField, "is required when spec.scaling.manageAutoscalerTags is enabled"))
+			}
+		}
 	}
 	if len(allErrs) == 0 {
 		return nil
@@ -98,6 +106,10 @@ func (r *AWSManagedMachinePool) validateNodegroupUpdateConfig() field.ErrorList
 		if r.Spec.UpdateConfig.MaxUnavailable != nil && r.Spec.UpdateConfig.MaxUnavailablePercentage != nil {
 			allErrs = append(allErrs, field.Invalid(nodegroupUpdateConfigField, r.Spec.UpdateConfig, "cannot specify both maxUnavailable and maxUnavailablePercentage"))
 		}
+
+		if soakDuration := r.Spec.UpdateConfig.SoakDuration; soakDuration != nil && soakDuration.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(nodegroupUpdateConfigField.Child("soakDuration"), soakDuration.Duration.String(), "must not be negative"))
+		}
 	}
 
 	if len(allErrs) == 0 {
@@ -121,6 +133,21 @@ func (r *AWSManagedMachinePool) validateRemoteAccess() field.ErrorList {
 		)
 	}
 
+	if r.Spec.RemoteAccess.IsSSMOnly() {
+		if r.Spec.RemoteAccess.SSHKeyName != nil {
+			allErrs = append(allErrs, field.Forbidden(remoteAccessPath.Child("sshKeyName"), "cannot be set if mode is SSMOnly"))
+		}
+		if len(sourceSecurityGroups) > 0 {
+			allErrs = append(allErrs, field.Forbidden(remoteAccessPath.Child("sourceSecurityGroups"), "cannot be set if mode is SSMOnly"))
+		}
+		if r.Spec.RemoteAccess.Public {
+			allErrs = append(allErrs, field.Forbidden(remoteAccessPath.Child("public"), "cannot be set if mode is SSMOnly"))
+		}
+		if r.Spec.AWSLaunchTemplate != nil && r.Spec.AWSLaunchTemplate.SSHKeyName != nil && *r.Spec.AWSLaunchTemplate.SSHKeyName != "" {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "awsLaunchTemplate", "sshKeyName"), "cannot be set if spec.remoteAccess.mode is SSMOnly"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/gomega"
@@ -143,6 +144,19 @@ func TestAWSManagedMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "update config with a negative soak duration",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					UpdateConfig: &UpdateConfig{
+						MaxUnavailablePercentage: aws.Int(10),
+						SoakDuration:             &metav1.Duration{Duration: -time.Minute},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "minSize 0 is accepted",
 			pool: &AWSManagedMachinePool{
@@ -155,6 +169,86 @@ func TestAWSManagedMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "managed autoscaler tags with min and max sizes are accepted",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Scaling: &ManagedMachinePoolScaling{
+						MinSize:              ptr.To[int32](0),
+						MaxSize:              ptr.To[int32](5),
+						ManageAutoscalerTags: true,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "managed autoscaler tags without max size are rejected",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Scaling: &ManagedMachinePoolScaling{
+						MinSize:              ptr.To[int32](0),
+						ManageAutoscalerTags: true,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "SSM only remote access is accepted",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					RemoteAccess: &ManagedRemoteAccess{
+						Mode: ManagedRemoteAccessModeSSMOnly,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "SSM only remote access with an SSH key is rejected",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					RemoteAccess: &ManagedRemoteAccess{
+						Mode:       ManagedRemoteAccessModeSSMOnly,
+						SSHKeyName: aws.String("my-key"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "SSM only remote access with public access is rejected",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					RemoteAccess: &ManagedRemoteAccess{
+						Mode:   ManagedRemoteAccessModeSSMOnly,
+						Public: true,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "SSM only remote access with a launch template SSH key is rejected",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					RemoteAccess: &ManagedRemoteAccess{
+						Mode: ManagedRemoteAccessModeSSMOnly,
+					},
+					AWSLaunchTemplate: &AWSLaunchTemplate{
+						SSHKeyName: aws.String("my-key"),
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
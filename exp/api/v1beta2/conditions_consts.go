@@ -37,6 +37,15 @@ const (
 	// LaunchTemplateReconcileFailedReason used for failures during Launch Template reconciliation.
 	LaunchTemplateReconcileFailedReason = "LaunchTemplateReconcileFailed"
 
+	// EncryptionKeysReadyCondition reports on whether the Auto Scaling group can use the customer managed KMS keys
+	// encrypting the volumes of its instances.
+	EncryptionKeysReadyCondition clusterv1.ConditionType = "EncryptionKeysReady"
+	// EncryptionKeyNotUsableReason is used when the Auto Scaling group cannot use a KMS key, so that its instances
+	// would be terminated as soon as they are launched.
+	EncryptionKeyNotUsableReason = "EncryptionKeyNotUsable"
+	// EncryptionKeyValidationFailedReason is used when the KMS keys could not be validated.
+	EncryptionKeyValidationFailedReason = "EncryptionKeyValidationFailed"
+
 	// PreLaunchTemplateUpdateCheckCondition reports if all prerequisite are met for launch template update.
 	PreLaunchTemplateUpdateCheckCondition clusterv1.ConditionType = "PreLaunchTemplateUpdateCheckSuccess"
 	// PostLaunchTemplateUpdateOperationCondition reports on successfully completes post launch template update operation.
@@ -59,6 +68,18 @@ const (
 	AWSMachineCreationFailed = "AWSMachineCreationFailed"
 	// AWSMachineDeletionFailed reports if deleting AWSMachines failed.
 	AWSMachineDeletionFailed = "AWSMachineDeletionFailed"
+	// AvailabilityZoneSpreadCondition reports whether the instances of the pool are spread across its availability
+	// zones according to the minimum number of instances per zone and the maximum skew of its placement.
+	AvailabilityZoneSpreadCondition clusterv1.ConditionType = "AvailabilityZoneSpread"
+	// AvailabilityZoneRebalancingReason is used while an instance of the most populated availability zone is replaced
+	// to move it to the least populated one.
+	AvailabilityZoneRebalancingReason = "AvailabilityZoneRebalancing"
+	// AvailabilityZoneSpreadUnsatisfiableReason is used when the spread cannot be improved by replacing instances,
+	// for instance because the pool does not have enough instances to run the minimum in every availability zone.
+	AvailabilityZoneSpreadUnsatisfiableReason = "AvailabilityZoneSpreadUnsatisfiable"
+	// AvailabilityZoneRebalancingFailedReason is used when an instance could not be replaced.
+	AvailabilityZoneRebalancingFailedReason = "AvailabilityZoneRebalancingFailed"
+
 	// LifecycleHookReadyCondition reports on the status of the lifecycle hook.
 	LifecycleHookReadyCondition clusterv1.ConditionType = "LifecycleHookReady"
 	// LifecycleHookCreationFailedReason used for failures during lifecycle hook creation.
@@ -67,6 +88,11 @@ const (
 	LifecycleHookUpdateFailedReason = "LifecycleHookUpdateFailed"
 	// LifecycleHookDeletionFailedReason used for failures during lifecycle hook deletion.
 	LifecycleHookDeletionFailedReason = "LifecycleHookDeletionFailed"
+
+	// ScheduledActionsReadyCondition reports on the status of the scheduled actions of the autoscaling group.
+	ScheduledActionsReadyCondition clusterv1.ConditionType = "ScheduledActionsReady"
+	// ScheduledActionsReconciliationFailedReason used for failures while creating, updating or deleting scheduled actions.
+	ScheduledActionsReconciliationFailedReason = "ScheduledActionsReconciliationFailed"
 )
 
 const (
@@ -77,6 +103,26 @@ const (
 	// WaitingForEKSControlPlaneReason used when the machine pool is waiting for
 	// EKS control plane infrastructure to be ready before proceeding.
 	WaitingForEKSControlPlaneReason = "WaitingForEKSControlPlane"
+
+	// EKSNodegroupConfigInSyncCondition reports whether the labels and taints of the EKS nodegroup match
+	// the ones of the AWSManagedMachinePool.
+	EKSNodegroupConfigInSyncCondition clusterv1.ConditionType = "EKSNodegroupConfigInSync"
+	// EKSNodegroupConfigDriftedReason used when the labels or taints of the EKS nodegroup were changed
+	// outside of the AWSManagedMachinePool, and are being reverted.
+	EKSNodegroupConfigDriftedReason = "EKSNodegroupConfigDrifted"
+
+	// EKSNodegroupUpdatedCondition reports whether the last version update of the EKS nodegroup succeeded
+	// and the nodegroup is healthy since then.
+	EKSNodegroupUpdatedCondition clusterv1.ConditionType = "EKSNodegroupUpdated"
+	// EKSNodegroupUpdatingReason used when a version update of the EKS nodegroup is in progress.
+	EKSNodegroupUpdatingReason = "EKSNodegroupUpdating"
+	// EKSNodegroupUpdateFailedReason used when the last version update of the EKS nodegroup failed or was cancelled.
+	EKSNodegroupUpdateFailedReason = "EKSNodegroupUpdateFailed"
+	// EKSNodegroupSoakingReason used while the EKS nodegroup has to stay healthy after a version update
+	// before the next one starts.
+	EKSNodegroupSoakingReason = "EKSNodegroupSoaking"
+	// EKSNodegroupUnhealthyReason used when the EKS nodegroup has health issues after a version update.
+	EKSNodegroupUnhealthyReason = "EKSNodegroupUnhealthy"
 )
 
 const (
@@ -25,6 +25,17 @@ import (
 const (
 	// KindMachinePool is a MachinePool resource Kind
 	KindMachinePool string = "MachinePool"
+
+	// ScaleDownDisabledAnnotation is the annotation of the cluster autoscaler marking a Node as not to be removed.
+	// The instances of an AWSMachinePool whose Node or Machine has this annotation set to "true" are protected
+	// from scale in, and are not replaced by instance refreshes.
+	ScaleDownDisabledAnnotation = "cluster-autoscaler.kubernetes.io/scale-down-disabled"
+
+	// AutoscalerMinSizeAnnotation is the annotation of the cluster autoscaler setting the minimum size of a node group.
+	AutoscalerMinSizeAnnotation = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size"
+
+	// AutoscalerMaxSizeAnnotation is the annotation of the cluster autoscaler setting the maximum size of a node group.
+	AutoscalerMaxSizeAnnotation = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size"
 )
 
 // EBS can be used to automatically set up EBS volumes when an instance is launched.
@@ -146,12 +157,28 @@ type AWSLaunchTemplate struct {
 	// If marketType is not specified and spotMarketOptions is provided, the marketType defaults to "Spot".
 	// +optional
 	MarketType infrav1.MarketType `json:"marketType,omitempty"`
+
+	// CPUOptions sets the number of CPU cores and threads per core of the instances, for instance to disable
+	// simultaneous multithreading or to reduce the number of cores visible to licensing-bound workloads.
+	// +optional
+	CPUOptions *infrav1.CPUOptions `json:"cpuOptions,omitempty"`
 }
 
 // Overrides are used to override the instance type specified by the launch template with multiple
 // instance types that can be used to launch On-Demand Instances and Spot Instances.
+// The order of the overrides is the priority of the instance types for the prioritized On-Demand
+// and capacity-optimized-prioritized Spot allocation strategies, the first one having the highest priority.
 type Overrides struct {
 	InstanceType string `json:"instanceType"`
+
+	// WeightedCapacity is the number of capacity units an instance of this type counts for, from 1 to 999.
+	// When it is set for one override, it must be set for all of them, and the desired capacity of the
+	// Auto Scaling group, that is the replicas of the MachinePool, is then a number of capacity units
+	// rather than a number of instances.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=999
+	// +optional
+	WeightedCapacity *int64 `json:"weightedCapacity,omitempty"`
 }
 
 // OnDemandAllocationStrategy indicates how to allocate instance types to fulfill On-Demand capacity.
@@ -207,6 +234,21 @@ type InstancesDistribution struct {
 
 	// +kubebuilder:default=100
 	OnDemandPercentageAboveBaseCapacity *int64 `json:"onDemandPercentageAboveBaseCapacity,omitempty"`
+
+	// SpotInstancePools is the number of Spot pools with the lowest price the Spot instances are allocated
+	// across, from 1 to 20. It can only be set with the lowest-price Spot allocation strategy, and AWS
+	// defaults it to 2.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	SpotInstancePools *int64 `json:"spotInstancePools,omitempty"`
+
+	// SpotMaxPrice is the maximum price per instance-hour the Spot instances are launched at. When it is not
+	// set, the maximum price is the On-Demand price, which AWS recommends, as the instances launched at a
+	// lower maximum price are more likely to be interrupted.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	SpotMaxPrice *string `json:"spotMaxPrice,omitempty"`
 }
 
 // MixedInstancesPolicy for an Auto Scaling group.
@@ -221,22 +263,39 @@ type Tags map[string]string
 // AutoScalingGroup describes an AWS autoscaling group.
 type AutoScalingGroup struct {
 	// The tags associated with the instance.
-	ID                    string          `json:"id,omitempty"`
-	Tags                  infrav1.Tags    `json:"tags,omitempty"`
-	Name                  string          `json:"name,omitempty"`
-	DesiredCapacity       *int32          `json:"desiredCapacity,omitempty"`
-	MaxSize               int32           `json:"maxSize,omitempty"`
-	MinSize               int32           `json:"minSize,omitempty"`
-	PlacementGroup        string          `json:"placementGroup,omitempty"`
-	Subnets               []string        `json:"subnets,omitempty"`
-	DefaultCoolDown       metav1.Duration `json:"defaultCoolDown,omitempty"`
-	DefaultInstanceWarmup metav1.Duration `json:"defaultInstanceWarmup,omitempty"`
-	CapacityRebalance     bool            `json:"capacityRebalance,omitempty"`
+	ID                     string          `json:"id,omitempty"`
+	Tags                   infrav1.Tags    `json:"tags,omitempty"`
+	Name                   string          `json:"name,omitempty"`
+	DesiredCapacity        *int32          `json:"desiredCapacity,omitempty"`
+	MaxSize                int32           `json:"maxSize,omitempty"`
+	MinSize                int32           `json:"minSize,omitempty"`
+	PlacementGroup         string          `json:"placementGroup,omitempty"`
+	Subnets                []string        `json:"subnets,omitempty"`
+	AvailabilityZones      []string        `json:"availabilityZones,omitempty"`
+	DefaultCoolDown        metav1.Duration `json:"defaultCoolDown,omitempty"`
+	DefaultInstanceWarmup  metav1.Duration `json:"defaultInstanceWarmup,omitempty"`
+	CapacityRebalance      bool            `json:"capacityRebalance,omitempty"`
+	HealthCheckType        string          `json:"healthCheckType,omitempty"`
+	HealthCheckGracePeriod metav1.Duration `json:"healthCheckGracePeriod,omitempty"`
 
 	MixedInstancesPolicy      *MixedInstancesPolicy `json:"mixedInstancesPolicy,omitempty"`
 	Status                    ASGStatus
 	Instances                 []infrav1.Instance `json:"instances,omitempty"`
 	CurrentlySuspendProcesses []string           `json:"currentlySuspendProcesses,omitempty"`
+
+	// InstanceDetails contains the Auto Scaling group specific attributes of the instances.
+	InstanceDetails []AutoScalingGroupInstance `json:"instanceDetails,omitempty"`
+}
+
+// AutoScalingGroupInstance describes an instance of an Auto Scaling group.
+type AutoScalingGroupInstance struct {
+	ID                    string `json:"id"`
+	LifecycleState        string `json:"lifecycleState,omitempty"`
+	HealthStatus          string `json:"healthStatus,omitempty"`
+	AvailabilityZone      string `json:"availabilityZone,omitempty"`
+	InstanceType          string `json:"instanceType,omitempty"`
+	LaunchTemplateVersion string `json:"launchTemplateVersion,omitempty"`
+	ProtectedFromScaleIn  bool   `json:"protectedFromScaleIn,omitempty"`
 }
 
 // AWSLifecycleHook describes an AWS lifecycle hook
@@ -304,6 +363,39 @@ func (d LifecycleHookDefaultResult) String() string {
 	return string(d)
 }
 
+// AWSScheduledAction describes a scheduled action changing the sizes of an autoscaling group on a recurring schedule.
+type AWSScheduledAction struct {
+	// Name is the name of the scheduled action.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// Recurrence is the recurring schedule of the action, in the cron format with five fields
+	// (minute, hour, day of month, month, day of week), e.g. "0 20 * * 1-5" for 8 PM on weekdays.
+	// +kubebuilder:validation:MinLength=1
+	Recurrence string `json:"recurrence"`
+
+	// TimeZone is the time zone of the recurrence, as an IANA time zone name, e.g. "Europe/Paris".
+	// Daylight saving time is taken into account. Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// MinSize is the minimum size of the autoscaling group once the action runs.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MinSize *int32 `json:"minSize,omitempty"`
+
+	// MaxSize is the maximum size of the autoscaling group once the action runs.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MaxSize *int32 `json:"maxSize,omitempty"`
+
+	// DesiredCapacity is the desired capacity of the autoscaling group once the action runs.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	DesiredCapacity *int32 `json:"desiredCapacity,omitempty"`
+}
+
 // ASGStatus is a status string returned by the autoscaling API.
 type ASGStatus string
 
@@ -381,6 +473,16 @@ type UpdateConfig struct {
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:validation:Minimum=1
 	MaxUnavailablePercentage *int `json:"maxUnavailablePercentage,omitempty"`
+
+	// Force updates the version of the nodegroup even if pods cannot be drained because of a
+	// pod disruption budget, instead of failing the update.
+	// +optional
+	Force bool `json:"force,omitempty"`
+
+	// SoakDuration is how long the nodegroup has to stay healthy after a version update before the
+	// next version update starts, e.g. the next minor version when upgrading several minor versions.
+	// +optional
+	SoakDuration *metav1.Duration `json:"soakDuration,omitempty"`
 }
 
 // AZSubnetType is the type of subnet to use when an availability zone is specified.
@@ -399,3 +501,141 @@ const (
 func NewAZSubnetType(t AZSubnetType) *AZSubnetType {
 	return &t
 }
+
+// AvailabilityZonePlacement configures the placement of the instances of an AWSMachinePool across
+// the availability zones of its subnets.
+type AvailabilityZonePlacement struct {
+	// Weights are the relative weights of the availability zones of the pool.
+	// The Auto Scaling group balances its instances evenly across the availability zones it can launch
+	// instances in, so the subnets of an availability zone with a weight of 0 are excluded from the group,
+	// and the availability zones with the highest weight are kept when all the availability zones of the
+	// pool would otherwise be excluded because of capacity errors.
+	// Availability zones without a weight have a weight of 1.
+	// +listType=map
+	// +listMapKey=availabilityZone
+	// +optional
+	Weights []AvailabilityZoneWeight `json:"weights,omitempty"`
+
+	// CapacityErrorExclusionDuration is how long the subnets of an availability zone are excluded from the
+	// Auto Scaling group after the group failed to launch an instance in the availability zone because of
+	// insufficient capacity.
+	// When not set, the availability zones are not excluded on capacity errors.
+	// +optional
+	CapacityErrorExclusionDuration *metav1.Duration `json:"capacityErrorExclusionDuration,omitempty"`
+
+	// MinInstancesPerZone is the minimum number of in-service instances in each availability zone of the
+	// pool that is not excluded. When an availability zone runs fewer instances while another one runs more,
+	// an instance of the most populated availability zone is replaced, and the Auto Scaling group launches
+	// its replacement in the least populated one.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinInstancesPerZone *int32 `json:"minInstancesPerZone,omitempty"`
+
+	// MaxSkew is the maximum difference between the number of in-service instances of the availability
+	// zones of the pool that are not excluded. Instances of the most populated availability zone are
+	// replaced one at a time until the difference is within the skew.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxSkew *int32 `json:"maxSkew,omitempty"`
+}
+
+// HasSpreadConstraints returns true when the placement enforces the spread of the instances
+// across the availability zones.
+func (p *AvailabilityZonePlacement) HasSpreadConstraints() bool {
+	return p != nil && (p.MinInstancesPerZone != nil || p.MaxSkew != nil)
+}
+
+// AvailabilityZoneWeight is the weight of an availability zone of an AWSMachinePool.
+type AvailabilityZoneWeight struct {
+	// AvailabilityZone is the name of the availability zone.
+	// +kubebuilder:validation:MinLength=1
+	AvailabilityZone string `json:"availabilityZone"`
+
+	// Weight is the relative weight of the availability zone.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+}
+
+// AvailabilityZoneExclusionReason is the reason the subnets of an availability zone are excluded
+// from the Auto Scaling group of an AWSMachinePool.
+// +kubebuilder:validation:Enum=ZeroWeight;InsufficientCapacity
+type AvailabilityZoneExclusionReason string
+
+const (
+	// AvailabilityZoneExclusionReasonZeroWeight is used when the availability zone has a weight of 0.
+	AvailabilityZoneExclusionReasonZeroWeight = AvailabilityZoneExclusionReason("ZeroWeight")
+
+	// AvailabilityZoneExclusionReasonInsufficientCapacity is used when the Auto Scaling group failed
+	// to launch an instance in the availability zone because of insufficient capacity.
+	AvailabilityZoneExclusionReasonInsufficientCapacity = AvailabilityZoneExclusionReason("InsufficientCapacity")
+)
+
+// AvailabilityZoneStatus describes the placement status of an availability zone of an AWSMachinePool.
+type AvailabilityZoneStatus struct {
+	// Name is the name of the availability zone.
+	Name string `json:"name"`
+
+	// Excluded is true when the subnets of the availability zone are excluded from the Auto Scaling group.
+	// +optional
+	Excluded bool `json:"excluded,omitempty"`
+
+	// Reason is the reason the availability zone is excluded.
+	// +optional
+	Reason AvailabilityZoneExclusionReason `json:"reason,omitempty"`
+
+	// LastCapacityErrorTime is the last time the Auto Scaling group failed to launch an instance
+	// in the availability zone because of insufficient capacity.
+	// +optional
+	LastCapacityErrorTime *metav1.Time `json:"lastCapacityErrorTime,omitempty"`
+
+	// ExcludedUntil is the time until which the availability zone is excluded because of insufficient capacity.
+	// +optional
+	ExcludedUntil *metav1.Time `json:"excludedUntil,omitempty"`
+
+	// Instances is the number of in-service instances of the pool in the availability zone.
+	// +optional
+	Instances int32 `json:"instances,omitempty"`
+}
+
+// HealthCheckType is the service used by an Auto Scaling group to check the health of its instances.
+// +kubebuilder:validation:Enum=EC2;ELB
+type HealthCheckType string
+
+const (
+	// HealthCheckTypeEC2 uses the EC2 status checks of the instances.
+	HealthCheckTypeEC2 = HealthCheckType("EC2")
+
+	// HealthCheckTypeELB uses the health checks of the load balancers the Auto Scaling group is attached to,
+	// in addition to the EC2 status checks of the instances.
+	HealthCheckTypeELB = HealthCheckType("ELB")
+)
+
+// AutoScalingGroupHealthCheck configures how an Auto Scaling group determines the health of its instances.
+// Unhealthy instances are replaced by the group.
+type AutoScalingGroupHealthCheck struct {
+	// Type is the service used by the Auto Scaling group to check the health of its instances.
+	// +kubebuilder:default=EC2
+	// +optional
+	Type HealthCheckType `json:"type,omitempty"`
+
+	// GracePeriod is how long the Auto Scaling group waits after an instance enters the InService state
+	// before checking its health. When not set, the grace period of the group is left unchanged.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+
+	// NodeHealth, when set, marks the instances whose node has not been ready for too long as unhealthy,
+	// so that the Auto Scaling group replaces them even though their EC2 status checks pass.
+	// +optional
+	NodeHealth *NodeHealthCheck `json:"nodeHealth,omitempty"`
+}
+
+// NodeHealthCheck configures a custom health check of the instances of an Auto Scaling group, based on
+// the readiness of their node.
+type NodeHealthCheck struct {
+	// UnhealthyTimeout is how long the node of an instance can be not ready before the instance is
+	// marked unhealthy.
+	// +kubebuilder:default="5m"
+	// +optional
+	UnhealthyTimeout metav1.Duration `json:"unhealthyTimeout,omitempty"`
+}
@@ -18,6 +18,10 @@ package v1beta2
 
 import (
 	"fmt"
+	"strings"
+	"time"
+	// The time zones of the scheduled actions are validated by images without a time zone database.
+	_ "time/tzdata"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -48,3 +52,46 @@ func validateLifecycleHooks(hooks []AWSLifecycleHook) field.ErrorList {
 
 	return allErrs
 }
+
+func validateScheduledActions(actions []AWSScheduledAction) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := map[string]bool{}
+	for i, action := range actions {
+		path := field.NewPath("spec", "scheduledActions").Index(i)
+		if action.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("name"), "Name is required"))
+		} else if names[action.Name] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("name"), action.Name))
+		}
+		names[action.Name] = true
+
+		// The recurrence itself is validated by Auto Scaling, only its format is checked here.
+		if len(strings.Fields(action.Recurrence)) != 5 {
+			allErrs = append(allErrs, field.Invalid(path.Child("recurrence"), action.Recurrence, "must be a cron expression with 5 fields"))
+		}
+		if action.TimeZone != "" {
+			if _, err := time.LoadLocation(action.TimeZone); err != nil {
+				allErrs = append(allErrs, field.Invalid(path.Child("timeZone"), action.TimeZone, "must be an IANA time zone name"))
+			}
+		}
+
+		if action.MinSize == nil && action.MaxSize == nil && action.DesiredCapacity == nil {
+			allErrs = append(allErrs, field.Required(path, "at least one of minSize, maxSize or desiredCapacity is required"))
+			continue
+		}
+		if action.MinSize != nil && action.MaxSize != nil && *action.MinSize > *action.MaxSize {
+			allErrs = append(allErrs, field.Invalid(path.Child("minSize"), *action.MinSize, "must be less than or equal to maxSize"))
+		}
+		if action.DesiredCapacity != nil {
+			if action.MinSize != nil && *action.DesiredCapacity < *action.MinSize {
+				allErrs = append(allErrs, field.Invalid(path.Child("desiredCapacity"), *action.DesiredCapacity, "must be greater than or equal to minSize"))
+			}
+			if action.MaxSize != nil && *action.DesiredCapacity > *action.MaxSize {
+				allErrs = append(allErrs, field.Invalid(path.Child("desiredCapacity"), *action.DesiredCapacity, "must be less than or equal to maxSize"))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+)
+
+// reconcileAvailabilityZones updates the placement status of the availability zones of the AWSMachinePool
+// from the capacity errors of its Auto Scaling group. The subnets of the excluded availability zones are
+// removed from the group when the pool is updated.
+// It returns the duration after which the first availability zone excluded because of insufficient
+// capacity must be included again.
+func (r *AWSMachinePoolReconciler) reconcileAvailabilityZones(machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface, asg *expinfrav1.AutoScalingGroup) (time.Duration, error) {
+	placement := machinePoolScope.AWSMachinePool.Spec.AvailabilityZonePlacement
+	if placement == nil {
+		machinePoolScope.AWSMachinePool.Status.AvailabilityZones = nil
+		return 0, nil
+	}
+
+	var capacityErrors map[string]time.Time
+	if placement.CapacityErrorExclusionDuration != nil {
+		var err error
+		capacityErrors, err = asgsvc.GetCapacityErrors(asg.Name)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to get the capacity errors of the AutoScalingGroup")
+		}
+	}
+
+	now := time.Now()
+	previous := machinePoolScope.ExcludedAvailabilityZones()
+	zones := availabilityZoneStatuses(placement, asg.AvailabilityZones, machinePoolScope.AWSMachinePool.Status.AvailabilityZones, capacityErrors, now)
+
+	var requeueAfter time.Duration
+	for _, zone := range zones {
+		if !zone.Excluded || zone.Reason != expinfrav1.AvailabilityZoneExclusionReasonInsufficientCapacity {
+			continue
+		}
+		if !previous.Has(zone.Name) {
+			r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "AvailabilityZoneExcluded",
+				"Excluding availability zone %s until %s because of insufficient capacity", zone.Name, zone.ExcludedUntil.Format(time.RFC3339))
+		}
+		if until := zone.ExcludedUntil.Sub(now); requeueAfter == 0 || until < requeueAfter {
+			requeueAfter = until
+		}
+	}
+
+	instances := inServiceInstancesByAvailabilityZone(asg.InstanceDetails)
+	for i := range zones {
+		zones[i].Instances = instances[zones[i].Name]
+	}
+
+	machinePoolScope.AWSMachinePool.Status.AvailabilityZones = zones
+	return requeueAfter, nil
+}
+
+// inServiceInstancesByAvailabilityZone returns the number of in-service instances of an Auto Scaling group
+// in each of its availability zones.
+func inServiceInstancesByAvailabilityZone(instances []expinfrav1.AutoScalingGroupInstance) map[string]int32 {
+	counts := map[string]int32{}
+	for _, instance := range instances {
+		if instance.LifecycleState == string(autoscalingtypes.LifecycleStateInService) {
+			counts[instance.AvailabilityZone]++
+		}
+	}
+	return counts
+}
+
+// availabilityZoneStatuses computes the placement status of the availability zones of an AWSMachinePool from
+// the availability zones of its Auto Scaling group, the last capacity errors of the group by availability zone,
+// and the previous status of the pool, which keeps the availability zones removed from the group.
+func availabilityZoneStatuses(placement *expinfrav1.AvailabilityZonePlacement, asgZones []string, previous []expinfrav1.AvailabilityZoneStatus, capacityErrors map[string]time.Time, now time.Time) []expinfrav1.AvailabilityZoneStatus {
+	weights := map[string]int32{}
+	names := sets.New(asgZones...)
+	for _, weight := range placement.Weights {
+		weights[weight.AvailabilityZone] = weight.Weight
+		if weight.Weight == 0 {
+			names.Insert(weight.AvailabilityZone)
+		}
+	}
+	weightOf := func(zone string) int32 {
+		if weight, ok := weights[zone]; ok {
+			return weight
+		}
+		return 1
+	}
+
+	lastCapacityErrors := map[string]time.Time{}
+	for _, zone := range previous {
+		if zone.Excluded {
+			names.Insert(zone.Name)
+		}
+		if zone.LastCapacityErrorTime != nil {
+			lastCapacityErrors[zone.Name] = zone.LastCapacityErrorTime.Time
+		}
+	}
+	for zone, last := range capacityErrors {
+		names.Insert(zone)
+		if last.After(lastCapacityErrors[zone]) {
+			lastCapacityErrors[zone] = last
+		}
+	}
+
+	zones := make([]expinfrav1.AvailabilityZoneStatus, 0, names.Len())
+	included := false
+	for _, name := range sets.List(names) {
+		zone := expinfrav1.AvailabilityZoneStatus{Name: name}
+		last, hasCapacityError := lastCapacityErrors[name]
+		if hasCapacityError {
+			zone.LastCapacityErrorTime = &metav1.Time{Time: last}
+		}
+
+		switch {
+		case weightOf(name) == 0:
+			zone.Excluded = true
+			zone.Reason = expinfrav1.AvailabilityZoneExclusionReasonZeroWeight
+		case hasCapacityError && placement.CapacityErrorExclusionDuration != nil && last.Add(placement.CapacityErrorExclusionDuration.Duration).After(now):
+			zone.Excluded = true
+			zone.Reason = expinfrav1.AvailabilityZoneExclusionReasonInsufficientCapacity
+			zone.ExcludedUntil = &metav1.Time{Time: last.Add(placement.CapacityErrorExclusionDuration.Duration)}
+		default:
+			included = true
+		}
+		zones = append(zones, zone)
+	}
+
+	// Keep the availability zones with the highest weight when all the availability zones
+	// would be excluded because of insufficient capacity.
+	if !included {
+		var highest int32
+		for _, zone := range zones {
+			if zone.Reason == expinfrav1.AvailabilityZoneExclusionReasonInsufficientCapacity {
+				highest = max(highest, weightOf(zone.Name))
+			}
+		}
+		for i := range zones {
+			if zones[i].Reason == expinfrav1.AvailabilityZoneExclusionReasonInsufficientCapacity && weightOf(zones[i].Name) == highest {
+				zones[i].Excluded = false
+				zones[i].Reason = ""
+				zones[i].ExcludedUntil = nil
+			}
+		}
+	}
+
+	return zones
+}
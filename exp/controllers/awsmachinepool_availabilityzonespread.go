@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// availabilityZoneSpreadRequeueAfter is how long to wait for the Auto Scaling group to settle before
+// checking the spread of its instances again.
+const availabilityZoneSpreadRequeueAfter = time.Minute
+
+// reconcileAvailabilityZoneSpread enforces the minimum number of instances per availability zone and the
+// maximum skew of the placement of the AWSMachinePool. The Auto Scaling group launches new instances in the
+// availability zone with the fewest instances, but it does not move running instances when its AZRebalance
+// process is suspended or when the rebalancing is cut short, so an instance of the most populated
+// availability zone is replaced at a time until the spread is satisfied or cannot be improved.
+// It returns the duration after which the spread must be checked again.
+func (r *AWSMachinePoolReconciler) reconcileAvailabilityZoneSpread(machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface, asg *expinfrav1.AutoScalingGroup) (time.Duration, error) {
+	placement := machinePoolScope.AWSMachinePool.Spec.AvailabilityZonePlacement
+	if !placement.HasSpreadConstraints() {
+		conditions.Delete(machinePoolScope.AWSMachinePool, expinfrav1.AvailabilityZoneSpreadCondition)
+		return 0, nil
+	}
+
+	// The instances being launched or terminated are not counted until the group has settled.
+	for _, instance := range asg.InstanceDetails {
+		if strings.HasPrefix(instance.LifecycleState, "Pending") || strings.HasPrefix(instance.LifecycleState, "Terminating") {
+			return availabilityZoneSpreadRequeueAfter, nil
+		}
+	}
+
+	excluded := machinePoolScope.ExcludedAvailabilityZones()
+	counts := map[string]int32{}
+	for _, zone := range asg.AvailabilityZones {
+		if !excluded.Has(zone) {
+			counts[zone] = 0
+		}
+	}
+	if len(counts) == 0 {
+		return 0, nil
+	}
+	for zone, count := range inServiceInstancesByAvailabilityZone(asg.InstanceDetails) {
+		if _, ok := counts[zone]; ok {
+			counts[zone] = count
+		}
+	}
+
+	most, least, violation := availabilityZoneSpreadViolation(placement, counts)
+	if violation == "" {
+		conditions.MarkTrue(machinePoolScope.AWSMachinePool, expinfrav1.AvailabilityZoneSpreadCondition)
+		return 0, nil
+	}
+
+	// Moving an instance only improves the spread when the availability zones differ by more than one instance.
+	if counts[most]-counts[least] < 2 {
+		conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.AvailabilityZoneSpreadCondition, expinfrav1.AvailabilityZoneSpreadUnsatisfiableReason, clusterv1.ConditionSeverityWarning,
+			"%s, the pool does not have enough instances to improve the spread", violation)
+		return 0, nil
+	}
+
+	instanceID := replaceableInstance(asg.InstanceDetails, most)
+	if instanceID == "" {
+		conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.AvailabilityZoneSpreadCondition, expinfrav1.AvailabilityZoneSpreadUnsatisfiableReason, clusterv1.ConditionSeverityWarning,
+			"%s, the instances of availability zone %s are protected from scale in", violation, most)
+		return 0, nil
+	}
+
+	if err := asgsvc.ReplaceInstance(instanceID); err != nil {
+		conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.AvailabilityZoneSpreadCondition, expinfrav1.AvailabilityZoneRebalancingFailedReason, clusterv1.ConditionSeverityWarning,
+			"%s", err.Error())
+		return 0, err
+	}
+	r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeNormal, "AvailabilityZoneRebalancing",
+		"Replacing instance %s of availability zone %s to launch it in availability zone %s: %s", instanceID, most, least, violation)
+	conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.AvailabilityZoneSpreadCondition, expinfrav1.AvailabilityZoneRebalancingReason, clusterv1.ConditionSeverityInfo,
+		"Replacing instance %s of availability zone %s: %s", instanceID, most, violation)
+
+	return availabilityZoneSpreadRequeueAfter, nil
+}
+
+// availabilityZoneSpreadViolation returns the most and the least populated availability zones, and a message
+// describing how their number of instances violates the spread constraints of the placement, empty when the
+// spread is satisfied. Ties are broken by the name of the availability zones.
+func availabilityZoneSpreadViolation(placement *expinfrav1.AvailabilityZonePlacement, counts map[string]int32) (most string, least string, violation string) {
+	zones := sets.List(sets.KeySet(counts))
+	most, least = zones[0], zones[0]
+	for _, zone := range zones[1:] {
+		if counts[zone] > counts[most] {
+			most = zone
+		}
+		if counts[zone] < counts[least] {
+			least = zone
+		}
+	}
+
+	var violations []string
+	if placement.MinInstancesPerZone != nil && counts[least] < *placement.MinInstancesPerZone {
+		violations = append(violations, fmt.Sprintf("availability zone %s runs %d instances, fewer than the minimum of %d", least, counts[least], *placement.MinInstancesPerZone))
+	}
+	if placement.MaxSkew != nil && counts[most]-counts[least] > *placement.MaxSkew {
+		violations = append(violations, fmt.Sprintf("availability zones %s and %s differ by %d instances, more than the maximum skew of %d", most, least, counts[most]-counts[least], *placement.MaxSkew))
+	}
+
+	return most, least, strings.Join(violations, ", ")
+}
+
+// replaceableInstance returns the first in-service instance of the availability zone, by ID, that is not
+// protected from scale in, or an empty string when there is none.
+func replaceableInstance(instances []expinfrav1.AutoScalingGroupInstance, zone string) string {
+	var ids []string
+	for _, instance := range instances {
+		if instance.AvailabilityZone == zone && !instance.ProtectedFromScaleIn &&
+			instance.LifecycleState == string(autoscalingtypes.LifecycleStateInService) {
+			ids = append(ids, instance.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return ""
+	}
+	sort.Strings(ids)
+	return ids[0]
+}
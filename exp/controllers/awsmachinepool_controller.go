@@ -50,6 +50,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
 	asg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -69,6 +70,8 @@ type AWSMachinePoolReconciler struct {
 	ec2ServiceFactory            func(scope.EC2Scope) services.EC2Interface
 	reconcileServiceFactory      func(scope.EC2Scope) services.MachinePoolReconcileInterface
 	objectStoreServiceFactory    func(scope.S3Scope) services.ObjectStoreInterface
+	kmsServiceFactory            func(cloud.ClusterScoper) services.KMSInterface
+	quotaServiceFactory          func(cloud.ClusterScoper) services.QuotaInterface
 	TagUnmanagedNetworkResources bool
 }
 
@@ -108,6 +111,14 @@ func (r *AWSMachinePoolReconciler) getObjectStoreService(scope scope.S3Scope) se
 	return s3.NewService(scope)
 }
 
+func (r *AWSMachinePoolReconciler) getKMSService(scope cloud.ClusterScoper) services.KMSInterface {
+	if r.kmsServiceFactory != nil {
+		return r.kmsServiceFactory(scope)
+	}
+
+	return kms.NewService(scope)
+}
+
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinepools,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinepools/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools;machinepools/status,verbs=get;list;watch;patch
@@ -202,16 +213,18 @@ func (r *AWSMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	switch infraScope := infraCluster.(type) {
 	case *scope.ManagedControlPlaneScope:
 		if !awsMachinePool.ObjectMeta.DeletionTimestamp.IsZero() {
-			return ctrl.Result{}, r.reconcileDelete(ctx, machinePoolScope, infraScope, infraScope)
+			return scope.RequeueOnWriteFreeze(infraScope.InfraCluster(), ctrl.Result{}, r.reconcileDelete(ctx, machinePoolScope, infraScope, infraScope))
 		}
 
-		return r.reconcileNormal(ctx, machinePoolScope, infraScope, infraScope, s3Scope)
+		result, err := r.reconcileNormal(ctx, machinePoolScope, infraScope, infraScope, s3Scope)
+		return scope.RequeueOnWriteFreeze(infraScope.InfraCluster(), result, err)
 	case *scope.ClusterScope:
 		if !awsMachinePool.ObjectMeta.DeletionTimestamp.IsZero() {
-			return ctrl.Result{}, r.reconcileDelete(ctx, machinePoolScope, infraScope, infraScope)
+			return scope.RequeueOnWriteFreeze(infraScope.InfraCluster(), ctrl.Result{}, r.reconcileDelete(ctx, machinePoolScope, infraScope, infraScope))
 		}
 
-		return r.reconcileNormal(ctx, machinePoolScope, infraScope, infraScope, s3Scope)
+		result, err := r.reconcileNormal(ctx, machinePoolScope, infraScope, infraScope, s3Scope)
+		return scope.RequeueOnWriteFreeze(infraScope.InfraCluster(), result, err)
 	default:
 		return ctrl.Result{}, errors.New("infraCluster has unknown type")
 	}
@@ -296,6 +309,16 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		return ctrl.Result{}, err
 	}
 
+	// Do not launch instances whose volumes cannot be encrypted, they would be terminated right away.
+	usable, err := r.reconcileEncryptionKeys(machinePoolScope, clusterScope)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !usable {
+		// The policies and grants of the keys are not watched, validate them again later.
+		return ctrl.Result{RequeueAfter: time.Minute}, nil
+	}
+
 	canUpdateLaunchTemplate := func() (bool, error) {
 		// If there is a change: before changing the template, check if there exist an ongoing instance refresh,
 		// because only 1 instance refresh can be "InProgress". If template is updated when refresh cannot be started,
@@ -318,6 +341,12 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 			machinePoolScope.Debug("instance refresh disabled, skipping instance refresh")
 			return nil
 		}
+		// defer instance refresh until the maintenance window of the cluster opens
+		if !ec2Scope.MaintenanceWindow().IsOpen(time.Now()) {
+			machinePoolScope.Info("maintenance window is closed, deferring instance refresh")
+			machinePoolScope.AWSMachinePool.Status.PendingDisruptiveActions.Set(infrav1.DisruptiveActionInstanceRefresh, machinePoolScope.Name(), "launch template was updated")
+			return nil
+		}
 		// After creating a new version of launch template, instance refresh is required
 		// to trigger a rolling replacement of all previously launched instances.
 		// If ONLY the userdata changed, previously launched instances continue to use the old launch
@@ -328,9 +357,27 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		// Launch Template version, and the difference between the older and current versions is _more_
 		// than userdata, we should start an Instance Refresh.
 		machinePoolScope.Info("starting instance refresh", "number of instances", machinePoolScope.MachinePool.Spec.Replicas)
-		return asgsvc.StartASGInstanceRefresh(machinePoolScope)
+		if err := asgsvc.StartASGInstanceRefresh(machinePoolScope); err != nil {
+			return err
+		}
+		machinePoolScope.AWSMachinePool.Status.PendingDisruptiveActions.Remove(infrav1.DisruptiveActionInstanceRefresh, machinePoolScope.Name())
+		return nil
 	}
-	if err := reconSvc.ReconcileLaunchTemplate(ctx, machinePoolScope, machinePoolScope, s3Scope, ec2Svc, objectStoreSvc, canUpdateLaunchTemplate, runPostLaunchTemplateUpdateOperation); err != nil {
+	runSecurityGroupsUpdateOperation := func() error {
+		// instances launched from now on pick up the new launch template version
+		if asg == nil {
+			return nil
+		}
+		// Security groups can be swapped on the network interfaces of running instances,
+		// so there is no need to replace them through an instance refresh.
+		instanceIDs := make([]string, 0, len(asg.Instances))
+		for _, instance := range asg.Instances {
+			instanceIDs = append(instanceIDs, instance.ID)
+		}
+		machinePoolScope.Info("updating security groups of running instances", "number of instances", len(instanceIDs))
+		return ec2Svc.ReconcileInstancesSecurityGroups(machinePoolScope, instanceIDs)
+	}
+	if err := reconSvc.ReconcileLaunchTemplate(ctx, machinePoolScope, machinePoolScope, s3Scope, ec2Svc, objectStoreSvc, canUpdateLaunchTemplate, runPostLaunchTemplateUpdateOperation, runSecurityGroupsUpdateOperation); err != nil {
 		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedLaunchTemplateReconcile", "Failed to reconcile launch template: %v", err)
 		machinePoolScope.Error(err, "failed to reconcile launch template")
 		return ctrl.Result{}, err
@@ -340,6 +387,31 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 	conditions.MarkTrue(machinePoolScope.AWSMachinePool, expinfrav1.LaunchTemplateReadyCondition)
 
 	if asg == nil {
+		// Instances cannot be launched into a capacity block before its active window starts.
+		if launchTemplate := machinePoolScope.AWSMachinePool.Spec.AWSLaunchTemplate; launchTemplate.MarketType == infrav1.MarketTypeCapacityBlock && launchTemplate.CapacityReservationID != nil {
+			err := ec2Svc.ValidateCapacityBlock(*launchTemplate.CapacityReservationID, launchTemplate.InstanceType)
+			if notActiveErr, ok := ec2.AsCapacityBlockNotActive(err); ok {
+				machinePoolScope.Info("Waiting for the capacity block to become active", "capacity-reservation-id", notActiveErr.CapacityReservationID, "start-date", notActiveErr.StartDate)
+				conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.ASGReadyCondition, infrav1.CapacityBlockNotActiveReason, clusterv1.ConditionSeverityInfo, "%s", notActiveErr.Error())
+				return ctrl.Result{RequeueAfter: max(notActiveErr.RequeueAfter(), 15*time.Second)}, nil
+			}
+			if err != nil {
+				conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.ASGReadyCondition, expinfrav1.ASGProvisionFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+				return ctrl.Result{}, err
+			}
+		}
+
+		if feature.Gates.Enabled(feature.VCPUQuotaCheck) {
+			available, err := r.reconcileVCPUQuota(machinePoolScope, clusterScope, asg)
+			if err != nil {
+				machinePoolScope.Error(err, "failed to check vCPU quota")
+				return ctrl.Result{}, err
+			}
+			if !available {
+				return ctrl.Result{RequeueAfter: vcpuQuotaRequeueAfter}, nil
+			}
+		}
+
 		// Create new ASG
 		if err := r.createPool(machinePoolScope, clusterScope); err != nil {
 			conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.ASGReadyCondition, expinfrav1.ASGProvisionFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
@@ -374,7 +446,12 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile lifecycle hooks")
 	}
 
-	if annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	if err := r.reconcileScheduledActions(ctx, machinePoolScope, asgsvc); err != nil {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedScheduledActionsReconcile", "Failed to reconcile scheduled actions: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile scheduled actions")
+	}
+
+	if annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) || machinePoolScope.SizeManagedBySchedule() {
 		// Set MachinePool replicas to the ASG DesiredCapacity
 		if *machinePoolScope.MachinePool.Spec.Replicas != *asg.DesiredCapacity {
 			machinePoolScope.Info("Setting MachinePool replicas to ASG DesiredCapacity",
@@ -387,11 +464,34 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		}
 	}
 
+	availabilityZonesRequeueAfter, err := r.reconcileAvailabilityZones(machinePoolScope, asgsvc, asg)
+	if err != nil {
+		machinePoolScope.Error(err, "failed to reconcile availability zones")
+		return ctrl.Result{}, err
+	}
+
+	if feature.Gates.Enabled(feature.VCPUQuotaCheck) {
+		available, err := r.reconcileVCPUQuota(machinePoolScope, clusterScope, asg)
+		if err != nil {
+			machinePoolScope.Error(err, "failed to check vCPU quota")
+			return ctrl.Result{}, err
+		}
+		if !available {
+			return ctrl.Result{RequeueAfter: vcpuQuotaRequeueAfter}, nil
+		}
+	}
+
 	if err := r.updatePool(machinePoolScope, clusterScope, asg); err != nil {
 		machinePoolScope.Error(err, "error updating AWSMachinePool")
 		return ctrl.Result{}, err
 	}
 
+	instanceRefreshRequeueAfter, err := r.reconcilePendingInstanceRefresh(machinePoolScope, ec2Scope, asgsvc)
+	if err != nil {
+		machinePoolScope.Error(err, "failed to start deferred instance refresh")
+		return ctrl.Result{}, err
+	}
+
 	launchTemplateID := machinePoolScope.GetLaunchTemplateIDStatus()
 	asgName := machinePoolScope.Name()
 	resourceServiceToUpdate := []scope.ResourceServiceToUpdate{
@@ -420,26 +520,62 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 	machinePoolScope.SetAnnotation("cluster-api-provider-aws", "true")
 
 	machinePoolScope.AWSMachinePool.Spec.ProviderIDList = providerIDList
-	machinePoolScope.AWSMachinePool.Status.Replicas = int32(len(providerIDList)) //#nosec G115
+	machinePoolScope.AWSMachinePool.Status.Replicas = int32(len(providerIDList))                  //#nosec G115
+	machinePoolScope.AWSMachinePool.Status.FailureDomainCount = int32(len(asg.AvailabilityZones)) //#nosec G115
 	machinePoolScope.AWSMachinePool.Status.Ready = true
 	conditions.MarkTrue(machinePoolScope.AWSMachinePool, expinfrav1.ASGReadyCondition)
 
-	err = machinePoolScope.UpdateInstanceStatuses(ctx, asg.Instances)
+	if err := r.reconcileCapacity(machinePoolScope, ec2Svc); err != nil {
+		machinePoolScope.Error(err, "failed to get the capacity of the instance type")
+	}
+
+	marketTypes, err := r.getInstanceMarketTypes(ec2Svc, machinePoolScope, asg)
+	if err != nil {
+		machinePoolScope.Error(err, "failed to get instance market types", "instances", asg.Instances)
+	}
+
+	err = machinePoolScope.UpdateInstanceStatuses(ctx, asg.InstanceDetails, marketTypes)
 	if err != nil {
 		machinePoolScope.Error(err, "failed updating instances", "instances", asg.Instances)
 	}
 
+	// The node health and annotations of the instances are only known when the nodes of the workload cluster could be listed.
+	var nodeHealthRequeueAfter time.Duration
+	if err == nil {
+		nodeHealthRequeueAfter, err = r.reconcileNodeHealth(machinePoolScope, asgsvc)
+		if err != nil {
+			machinePoolScope.Error(err, "failed to mark instances with a not ready node unhealthy")
+		}
+		if err := r.reconcileScaleInProtection(ctx, machinePoolScope, asgsvc); err != nil {
+			machinePoolScope.Error(err, "failed to reconcile the scale in protection of instances")
+		}
+	}
+	spreadRequeueAfter, err := r.reconcileAvailabilityZoneSpread(machinePoolScope, asgsvc, asg)
+	if err != nil {
+		machinePoolScope.Error(err, "failed to reconcile the spread of the instances across availability zones")
+	}
+	requeueAfter := availabilityZonesRequeueAfter
+	for _, after := range []time.Duration{nodeHealthRequeueAfter, instanceRefreshRequeueAfter, spreadRequeueAfter} {
+		if after > 0 && (requeueAfter == 0 || after < requeueAfter) {
+			requeueAfter = after
+		}
+	}
+
 	if feature.Gates.Enabled(feature.MachinePoolMachines) {
+		machinesRequeueAfter := 3 * time.Minute
+		if requeueAfter > 0 {
+			machinesRequeueAfter = min(machinesRequeueAfter, requeueAfter)
+		}
 		return ctrl.Result{
 			// Regularly update `AWSMachine` objects, for example if ASG was scaled or refreshed instances
 			// TODO: Requeueing interval can be removed or prolonged once reconciliation of ASG EC2 instances
 			//       can be triggered by events (e.g. with feature gate `EventBridgeInstanceState`).
 			//       See https://github.com/kubernetes-sigs/cluster-api-provider-aws/issues/5323.
-			RequeueAfter: 3 * time.Minute,
+			RequeueAfter: machinesRequeueAfter,
 		}, nil
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *AWSMachinePoolReconciler) reconcileDelete(ctx context.Context, machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope) error {
@@ -618,7 +754,7 @@ func (r *AWSMachinePoolReconciler) findASG(machinePoolScope *scope.MachinePoolSc
 func diffASG(machinePoolScope *scope.MachinePoolScope, existingASG *expinfrav1.AutoScalingGroup) string {
 	detectedMachinePoolSpec := machinePoolScope.MachinePool.Spec.DeepCopy()
 
-	if !annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	if !annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) && !machinePoolScope.SizeManagedBySchedule() {
 		detectedMachinePoolSpec.Replicas = existingASG.DesiredCapacity
 	}
 	if diff := cmp.Diff(machinePoolScope.MachinePool.Spec, *detectedMachinePoolSpec); diff != "" {
@@ -626,9 +762,22 @@ func diffASG(machinePoolScope *scope.MachinePoolScope, existingASG *expinfrav1.A
 	}
 
 	detectedAWSMachinePoolSpec := machinePoolScope.AWSMachinePool.Spec.DeepCopy()
-	detectedAWSMachinePoolSpec.MaxSize = existingASG.MaxSize
-	detectedAWSMachinePoolSpec.MinSize = existingASG.MinSize
+	if !machinePoolScope.SizeManagedBySchedule() {
+		detectedAWSMachinePoolSpec.MaxSize = existingASG.MaxSize
+		detectedAWSMachinePoolSpec.MinSize = existingASG.MinSize
+	}
 	detectedAWSMachinePoolSpec.CapacityRebalance = existingASG.CapacityRebalance
+	if healthCheck := machinePoolScope.AWSMachinePool.Spec.HealthCheck; healthCheck != nil {
+		// The health check type and grace period of the ASG are left unchanged when they are not set.
+		detectedHealthCheck := healthCheck.DeepCopy()
+		if healthCheck.Type != "" {
+			detectedHealthCheck.Type = expinfrav1.HealthCheckType(existingASG.HealthCheckType)
+		}
+		if healthCheck.GracePeriod != nil {
+			detectedHealthCheck.GracePeriod = existingASG.HealthCheckGracePeriod.DeepCopy()
+		}
+		detectedAWSMachinePoolSpec.HealthCheck = detectedHealthCheck
+	}
 	{
 		mixedInstancesPolicy := machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy
 		// InstancesDistribution is optional, and the default values come from AWS, so
@@ -638,6 +787,13 @@ func diffASG(machinePoolScope *scope.MachinePoolScope, existingASG *expinfrav1.A
 			mixedInstancesPolicy = machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy.DeepCopy()
 			mixedInstancesPolicy.InstancesDistribution = existingASG.MixedInstancesPolicy.InstancesDistribution
 		}
+		// The number of Spot pools defaults to an AWS value for the lowest-price allocation
+		// strategy, so an unset one is compared with the AWS value as well.
+		if mixedInstancesPolicy != nil && mixedInstancesPolicy.InstancesDistribution != nil && mixedInstancesPolicy.InstancesDistribution.SpotInstancePools == nil &&
+			existingASG.MixedInstancesPolicy != nil && existingASG.MixedInstancesPolicy.InstancesDistribution != nil {
+			mixedInstancesPolicy = mixedInstancesPolicy.DeepCopy()
+			mixedInstancesPolicy.InstancesDistribution.SpotInstancePools = existingASG.MixedInstancesPolicy.InstancesDistribution.SpotInstancePools
+		}
 
 		if !cmp.Equal(mixedInstancesPolicy, existingASG.MixedInstancesPolicy) {
 			detectedAWSMachinePoolSpec.MixedInstancesPolicy = existingASG.MixedInstancesPolicy
@@ -699,13 +855,113 @@ func machinePoolToInfrastructureMapFunc(gvk schema.GroupVersionKind) handler.Map
 	}
 }
 
+// reconcilePendingInstanceRefresh starts the instance refresh deferred until the maintenance window of the cluster
+// opens. It returns when to requeue while the instance refresh is still pending.
+func (r *AWSMachinePoolReconciler) reconcilePendingInstanceRefresh(machinePoolScope *scope.MachinePoolScope, ec2Scope scope.EC2Scope, asgsvc services.ASGInterface) (time.Duration, error) {
+	pendingActions := &machinePoolScope.AWSMachinePool.Status.PendingDisruptiveActions
+	if !pendingActions.Has(infrav1.DisruptiveActionInstanceRefresh, machinePoolScope.Name()) {
+		return 0, nil
+	}
+
+	if refreshPreferences := machinePoolScope.AWSMachinePool.Spec.RefreshPreferences; refreshPreferences != nil && refreshPreferences.Disable {
+		machinePoolScope.Info("instance refresh disabled, dropping deferred instance refresh")
+		pendingActions.Remove(infrav1.DisruptiveActionInstanceRefresh, machinePoolScope.Name())
+		return 0, nil
+	}
+
+	window := ec2Scope.MaintenanceWindow()
+	now := time.Now()
+	if !window.IsOpen(now) {
+		return window.NextOpening(now).Sub(now), nil
+	}
+
+	canStart, err := asgsvc.CanStartASGInstanceRefresh(machinePoolScope)
+	if err != nil {
+		return 0, err
+	}
+	if !canStart {
+		machinePoolScope.Info("waiting for the ongoing instance refresh to complete before starting the deferred instance refresh")
+		return time.Minute, nil
+	}
+
+	machinePoolScope.Info("starting deferred instance refresh", "number of instances", machinePoolScope.MachinePool.Spec.Replicas)
+	if err := asgsvc.StartASGInstanceRefresh(machinePoolScope); err != nil {
+		return 0, err
+	}
+	pendingActions.Remove(infrav1.DisruptiveActionInstanceRefresh, machinePoolScope.Name())
+	return 0, nil
+}
+
 // reconcileLifecycleHooks periodically reconciles a lifecycle hook for the ASG.
+// reconcileCapacity advertises the capacity and the node information of the instance type of the launch template
+// in the status of the AWSMachinePool, so that the cluster autoscaler can scale the pool from zero.
+// The instance type is described on every reconciliation as it can change with the launch template.
+func (r *AWSMachinePoolReconciler) reconcileCapacity(machinePoolScope *scope.MachinePoolScope, ec2Svc services.EC2Interface) error {
+	instanceType := machinePoolScope.AWSMachinePool.Spec.AWSLaunchTemplate.InstanceType
+	if instanceType == "" {
+		return nil
+	}
+
+	capacity, nodeInfo, err := ec2Svc.GetInstanceTypeCapacity(instanceType)
+	if err != nil {
+		return err
+	}
+	machinePoolScope.AWSMachinePool.Status.Capacity = capacity
+	machinePoolScope.AWSMachinePool.Status.NodeInfo = nodeInfo
+	return nil
+}
+
+// getInstanceMarketTypes returns the market type of the instances of the Auto Scaling group, keyed by instance ID.
+// The instances are only described when the mixed instances policy of the pool can launch Spot instances,
+// otherwise all the instances share the market type of the launch template.
+func (r *AWSMachinePoolReconciler) getInstanceMarketTypes(ec2Svc services.EC2Interface, machinePoolScope *scope.MachinePoolScope, asg *expinfrav1.AutoScalingGroup) (map[string]infrav1.MarketType, error) {
+	spec := machinePoolScope.AWSMachinePool.Spec
+	if mixedInstancesPolicyAllowsSpot(spec.MixedInstancesPolicy) {
+		instanceIDs := make([]string, len(asg.Instances))
+		for i, instance := range asg.Instances {
+			instanceIDs[i] = instance.ID
+		}
+		return ec2Svc.GetInstanceMarketTypes(instanceIDs)
+	}
+
+	marketType := infrav1.MarketTypeOnDemand
+	if spec.MixedInstancesPolicy == nil {
+		switch {
+		case spec.AWSLaunchTemplate.MarketType != "":
+			marketType = spec.AWSLaunchTemplate.MarketType
+		case spec.AWSLaunchTemplate.SpotMarketOptions != nil:
+			marketType = infrav1.MarketTypeSpot
+		}
+	}
+
+	marketTypes := make(map[string]infrav1.MarketType, len(asg.Instances))
+	for _, instance := range asg.Instances {
+		marketTypes[instance.ID] = marketType
+	}
+	return marketTypes, nil
+}
+
+// mixedInstancesPolicyAllowsSpot returns true if the mixed instances policy can launch Spot instances.
+func mixedInstancesPolicyAllowsSpot(policy *expinfrav1.MixedInstancesPolicy) bool {
+	if policy == nil || policy.InstancesDistribution == nil {
+		return false
+	}
+	// The percentage of On-Demand instances above the base capacity defaults to 100.
+	percentage := policy.InstancesDistribution.OnDemandPercentageAboveBaseCapacity
+	return percentage != nil && *percentage < 100
+}
+
 func (r *AWSMachinePoolReconciler) reconcileLifecycleHooks(ctx context.Context, machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface) error {
 	asgName := machinePoolScope.Name()
 
 	return asg.ReconcileLifecycleHooks(ctx, asgsvc, asgName, machinePoolScope.GetLifecycleHooks(), map[string]bool{}, machinePoolScope.GetMachinePool(), machinePoolScope)
 }
 
+// reconcileScheduledActions reconciles the scheduled actions of the ASG.
+func (r *AWSMachinePoolReconciler) reconcileScheduledActions(ctx context.Context, machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface) error {
+	return asg.ReconcileScheduledActions(ctx, asgsvc, machinePoolScope.Name(), machinePoolScope.GetScheduledActions(), machinePoolScope.AWSMachinePool, machinePoolScope)
+}
+
 func (r *AWSMachinePoolReconciler) getInfraCluster(ctx context.Context, log *logger.Logger, cluster *clusterv1.Cluster, awsMachinePool *expinfrav1.AWSMachinePool) (scope.EC2Scope, scope.S3Scope, error) {
 	var clusterScope *scope.ClusterScope
 	var managedControlPlaneScope *scope.ManagedControlPlaneScope
@@ -23,6 +23,7 @@ import (
 	"flag"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go/aws"
@@ -32,6 +33,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apimachinerytypes "k8s.io/apimachinery/pkg/types"
@@ -48,6 +50,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
 	s3svc "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3/mock_s3iface"
@@ -179,6 +182,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 
 		mockCtrl = gomock.NewController(t)
 		ec2Svc = mock_services.NewMockEC2Interface(mockCtrl)
+		ec2Svc.EXPECT().GetInstanceTypeCapacity(gomock.Any()).Return(nil, nil, nil).AnyTimes()
 		asgSvc = mock_services.NewMockASGInterface(mockCtrl)
 		reconSvc = mock_services.NewMockMachinePoolReconcileInterface(mockCtrl)
 		s3Mock = mock_s3iface.NewMockS3API(mockCtrl)
@@ -309,7 +313,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				getASG(t, g)
 
 				expectedErr := errors.New("no connection available ")
-				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
+				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
 				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs)
 				g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
 			})
@@ -335,8 +339,9 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 					Subnets: []string{},
 				}
 
-				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(asg, nil)
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{}, nil)
 				asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil)
@@ -373,10 +378,11 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 					Subnets: []string{},
 				}
 
-				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(asg, nil)
 				ec2Svc.EXPECT().InstanceIfExists(aws.String("1")).Return(&infrav1.Instance{ID: "1", Type: "m6.2xlarge"}, nil)
 				ec2Svc.EXPECT().InstanceIfExists(aws.String("2")).Return(&infrav1.Instance{ID: "2", Type: "m6.2xlarge"}, nil)
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{}, nil)
 				asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil)
@@ -474,8 +480,9 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 					},
 				})).To(Succeed())
 
-				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(asg, nil)
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{}, nil)
 				asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil)
@@ -509,7 +516,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				defer teardown(t, g)
 				setSuspendedProcesses(t, g)
 
-				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().CreateASG(gomock.Any()).Return(&expinfrav1.AutoScalingGroup{
 					Name: "name",
@@ -533,7 +540,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				defer teardown(t, g)
 				setSuspendedProcesses(t, g)
 				ms.AWSMachinePool.Spec.SuspendProcesses.All = true
-				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
 				asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&expinfrav1.AutoScalingGroup{
@@ -574,7 +582,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				defer teardown(t, g)
 				setSuspendedProcesses(t, g)
 
-				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
 				asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&expinfrav1.AutoScalingGroup{
@@ -600,7 +609,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				Name:            "an-asg",
 				DesiredCapacity: ptr.To[int32](1),
 			}
-			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil)
 			asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{}, nil)
@@ -641,7 +651,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				},
 				Subnets: []string{"subnet1", "subnet2"},
 			}
-			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 			reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
 			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil).AnyTimes()
@@ -661,7 +672,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				MaxSize: int32(100),
 				Subnets: []string{"subnet1", "subnet2"},
 			}
-			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 			reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
 			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil).AnyTimes()
@@ -681,7 +693,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				MaxSize: int32(2),
 				Subnets: []string{},
 			}
-			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 			reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
 			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil).AnyTimes()
@@ -757,6 +770,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 						MixedInstancesPolicy: awsMachinePool.Spec.MixedInstancesPolicy.DeepCopy(),
 					}, nil
 				})
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil) // no change
 				// No changes, so there must not be an ASG update!
@@ -812,6 +826,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 						MixedInstancesPolicy: awsMachinePool.Spec.MixedInstancesPolicy.DeepCopy(),
 					}, nil
 				})
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil) // no change
 				// No changes, so there must not be an ASG update!
@@ -870,6 +885,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 						MixedInstancesPolicy: awsMachinePool.Spec.MixedInstancesPolicy.DeepCopy(),
 					}, nil
 				})
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil) // no change
 				// No changes, so there must not be an ASG update!
@@ -956,6 +972,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 						MixedInstancesPolicy: awsMachinePool.Spec.MixedInstancesPolicy.DeepCopy(),
 					}, nil
 				})
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil) // no change
 				// No changes, so there must not be an ASG update!
@@ -1104,6 +1121,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				// reference (`MachinePool.spec.template.spec.bootstrap`).
 				asgSvc.EXPECT().StartASGInstanceRefresh(gomock.Any())
 
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil) // no change
 				// No changes, so there must not be an ASG update!
@@ -1188,7 +1206,7 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 			}
 			ms.AWSMachinePool.Spec.AWSLifecycleHooks = append(ms.AWSMachinePool.Spec.AWSLifecycleHooks, newLifecycleHook)
 
-			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
 			// New ASG must be created with lifecycle hooks (single AWS SDK call is enough)
 			//
@@ -1214,7 +1232,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 			}
 			ms.AWSMachinePool.Spec.AWSLifecycleHooks = append(ms.AWSMachinePool.Spec.AWSLifecycleHooks, newLifecycleHook)
 
-			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Eq(ms.Name())).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Eq(ms.Name())).Return(nil, nil)
 			asgSvc.EXPECT().CreateLifecycleHook(gomock.Any(), ms.Name(), &newLifecycleHook).Return(nil)
 			reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
@@ -1244,7 +1263,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 			setup(t, g)
 			defer teardown(t, g)
 
-			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Eq(ms.Name())).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Eq(ms.Name())).Return([]*expinfrav1.AWSLifecycleHook{
 				{
 					Name:                "hook-to-remove",
@@ -1287,7 +1307,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 			}
 			ms.AWSMachinePool.Spec.AWSLifecycleHooks = append(ms.AWSMachinePool.Spec.AWSLifecycleHooks, newLifecycleHook)
 
-			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Eq(ms.Name())).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Eq(ms.Name())).Return([]*expinfrav1.AWSLifecycleHook{
 				{
 					Name:                "hook-to-remove",
@@ -1331,7 +1352,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 			}
 			ms.AWSMachinePool.Spec.AWSLifecycleHooks = append(ms.AWSMachinePool.Spec.AWSLifecycleHooks, updateLifecycleHook)
 
-			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Eq(ms.Name())).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Eq(ms.Name())).Return([]*expinfrav1.AWSLifecycleHook{
 				{
 					Name:                "hook-to-update",
@@ -1362,6 +1384,88 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 			g.Expect(err).To(Succeed())
 		})
 	})
+	t.Run("Scheduled Actions", func(t *testing.T) {
+		t.Run("New scheduled action is added and owns the ASG size", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			scaleToZero := expinfrav1.AWSScheduledAction{
+				Name:            "scale-to-zero",
+				Recurrence:      "0 20 * * 1-5",
+				TimeZone:        "Europe/Paris",
+				MinSize:         ptr.To[int32](0),
+				MaxSize:         ptr.To[int32](0),
+				DesiredCapacity: ptr.To[int32](0),
+			}
+			ms.AWSMachinePool.Spec.ScheduledActions = append(ms.AWSMachinePool.Spec.ScheduledActions, scaleToZero)
+			ms.MachinePool.Spec.Replicas = ptr.To[int32](1)
+
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Eq(ms.Name())).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Eq(ms.Name())).Return(nil, nil)
+			asgSvc.EXPECT().PutScheduledAction(gomock.Any(), ms.Name(), &scaleToZero).Return(nil)
+			reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().GetASGByName(gomock.Any()).DoAndReturn(func(scope *scope.MachinePoolScope) (*expinfrav1.AutoScalingGroup, error) {
+				// The scheduled action already scaled the ASG to zero
+				return &expinfrav1.AutoScalingGroup{
+					Name: scope.Name(),
+					Subnets: []string{
+						"subnet-1",
+					},
+					MinSize:              0,
+					MaxSize:              0,
+					DesiredCapacity:      ptr.To[int32](0),
+					MixedInstancesPolicy: awsMachinePool.Spec.MixedInstancesPolicy.DeepCopy(),
+				}, nil
+			})
+			asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil) // no change
+			// The size of the ASG is owned by the schedule, so there must not be an ASG update!
+			asgSvc.EXPECT().UpdateASG(gomock.Any()).Times(0)
+
+			g.Expect(testEnv.Create(ctx, ms.MachinePool.DeepCopy())).To(Succeed())
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs)
+			g.Expect(err).To(Succeed())
+			g.Expect(*ms.MachinePool.Spec.Replicas).To(Equal(int32(0)))
+			expectConditions(g, ms.AWSMachinePool, []conditionAssertion{{expinfrav1.ScheduledActionsReadyCondition, corev1.ConditionTrue, "", ""}})
+		})
+		t.Run("Scheduled action to remove", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g)
+			defer teardown(t, g)
+
+			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Eq(ms.Name())).Return(nil, nil)
+			scheduledActionToRemove := &expinfrav1.AWSScheduledAction{
+				Name:            "scale-to-zero",
+				Recurrence:      "0 20 * * 1-5",
+				DesiredCapacity: ptr.To[int32](0),
+			}
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Eq(ms.Name())).Return([]*expinfrav1.AWSScheduledAction{scheduledActionToRemove}, nil)
+			asgSvc.EXPECT().DeleteScheduledAction(gomock.Any(), ms.Name(), scheduledActionToRemove).Return(nil)
+			reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
+			asgSvc.EXPECT().GetASGByName(gomock.Any()).DoAndReturn(func(scope *scope.MachinePoolScope) (*expinfrav1.AutoScalingGroup, error) {
+				// No difference to `AWSMachinePool.spec`
+				return &expinfrav1.AutoScalingGroup{
+					Name: scope.Name(),
+					Subnets: []string{
+						"subnet-1",
+					},
+					MinSize:              awsMachinePool.Spec.MinSize,
+					MaxSize:              awsMachinePool.Spec.MaxSize,
+					MixedInstancesPolicy: awsMachinePool.Spec.MixedInstancesPolicy.DeepCopy(),
+				}, nil
+			})
+			asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil) // no change
+			// No changes, so there must not be an ASG update!
+			asgSvc.EXPECT().UpdateASG(gomock.Any()).Times(0)
+
+			_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs)
+			g.Expect(err).To(Succeed())
+			g.Expect(conditions.Get(ms.AWSMachinePool, expinfrav1.ScheduledActionsReadyCondition)).To(BeNil())
+		})
+	})
 }
 
 type conditionAssertion struct {
@@ -1786,6 +1890,39 @@ func TestDiffASG(t *testing.T) {
 			},
 			wantDifference: true,
 		},
+		{
+			name: "scheduled actions ignore difference between sizes",
+			args: args{
+				machinePoolScope: &scope.MachinePoolScope{
+					MachinePool: &expclusterv1.MachinePool{
+						Spec: expclusterv1.MachinePoolSpec{
+							Replicas: ptr.To[int32](2),
+						},
+					},
+					AWSMachinePool: &expinfrav1.AWSMachinePool{
+						Spec: expinfrav1.AWSMachinePoolSpec{
+							MinSize: 1,
+							MaxSize: 3,
+							ScheduledActions: []expinfrav1.AWSScheduledAction{
+								{
+									Name:            "scale-to-zero",
+									Recurrence:      "0 20 * * 1-5",
+									MinSize:         ptr.To[int32](0),
+									MaxSize:         ptr.To[int32](0),
+									DesiredCapacity: ptr.To[int32](0),
+								},
+							},
+						},
+					},
+				},
+				existingASG: &expinfrav1.AutoScalingGroup{
+					DesiredCapacity: ptr.To[int32](0),
+					MinSize:         0,
+					MaxSize:         0,
+				},
+			},
+			wantDifference: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1798,3 +1935,728 @@ func TestDiffASG(t *testing.T) {
 		})
 	}
 }
+
+func TestGetInstanceMarketTypes(t *testing.T) {
+	asg := &expinfrav1.AutoScalingGroup{
+		Instances: []infrav1.Instance{{ID: "i-1"}, {ID: "i-2"}},
+	}
+
+	tests := []struct {
+		name   string
+		spec   expinfrav1.AWSMachinePoolSpec
+		expect func(m *mock_services.MockEC2InterfaceMockRecorder)
+		want   map[string]infrav1.MarketType
+	}{
+		{
+			name: "on-demand launch template",
+			spec: expinfrav1.AWSMachinePoolSpec{},
+			want: map[string]infrav1.MarketType{"i-1": infrav1.MarketTypeOnDemand, "i-2": infrav1.MarketTypeOnDemand},
+		},
+		{
+			name: "spot launch template",
+			spec: expinfrav1.AWSMachinePoolSpec{
+				AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{SpotMarketOptions: &infrav1.SpotMarketOptions{}},
+			},
+			want: map[string]infrav1.MarketType{"i-1": infrav1.MarketTypeSpot, "i-2": infrav1.MarketTypeSpot},
+		},
+		{
+			name: "mixed instances policy without spot capacity",
+			spec: expinfrav1.AWSMachinePoolSpec{
+				MixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{
+					InstancesDistribution: &expinfrav1.InstancesDistribution{
+						OnDemandPercentageAboveBaseCapacity: aws.Int64(100),
+					},
+				},
+			},
+			want: map[string]infrav1.MarketType{"i-1": infrav1.MarketTypeOnDemand, "i-2": infrav1.MarketTypeOnDemand},
+		},
+		{
+			name: "mixed instances policy with spot capacity",
+			spec: expinfrav1.AWSMachinePoolSpec{
+				MixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{
+					InstancesDistribution: &expinfrav1.InstancesDistribution{
+						OnDemandPercentageAboveBaseCapacity: aws.Int64(50),
+					},
+				},
+			},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.GetInstanceMarketTypes([]string{"i-1", "i-2"}).Return(map[string]infrav1.MarketType{
+					"i-1": infrav1.MarketTypeOnDemand,
+					"i-2": infrav1.MarketTypeSpot,
+				}, nil)
+			},
+			want: map[string]infrav1.MarketType{"i-1": infrav1.MarketTypeOnDemand, "i-2": infrav1.MarketTypeSpot},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(ec2Svc.EXPECT())
+			}
+
+			machinePoolScope := &scope.MachinePoolScope{
+				AWSMachinePool: &expinfrav1.AWSMachinePool{Spec: tt.spec},
+			}
+			r := &AWSMachinePoolReconciler{}
+			marketTypes, err := r.getInstanceMarketTypes(ec2Svc, machinePoolScope, asg)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(marketTypes).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestReconcileCapacity(t *testing.T) {
+	capacity := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("8Gi"),
+	}
+	nodeInfo := &infrav1.NodeInfo{Architecture: infrav1.ArchitectureAmd64, OperatingSystem: infrav1.OperatingSystemLinux}
+
+	tests := []struct {
+		name         string
+		instanceType string
+		expect       func(m *mock_services.MockEC2InterfaceMockRecorder)
+		wantCapacity corev1.ResourceList
+		wantNodeInfo *infrav1.NodeInfo
+		wantErr      bool
+	}{
+		{
+			name: "launch template without instance type",
+		},
+		{
+			name:         "launch template with instance type",
+			instanceType: "m5.large",
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.GetInstanceTypeCapacity("m5.large").Return(capacity, nodeInfo, nil)
+			},
+			wantCapacity: capacity,
+			wantNodeInfo: nodeInfo,
+		},
+		{
+			name:         "instance type cannot be described",
+			instanceType: "m5.large",
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.GetInstanceTypeCapacity("m5.large").Return(nil, nil, errors.New("unauthorized"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(ec2Svc.EXPECT())
+			}
+
+			machinePoolScope := &scope.MachinePoolScope{
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					Spec: expinfrav1.AWSMachinePoolSpec{
+						AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{InstanceType: tt.instanceType},
+					},
+				},
+			}
+			r := &AWSMachinePoolReconciler{}
+			err := r.reconcileCapacity(machinePoolScope, ec2Svc)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(machinePoolScope.AWSMachinePool.Status.Capacity).To(Equal(tt.wantCapacity))
+			g.Expect(machinePoolScope.AWSMachinePool.Status.NodeInfo).To(Equal(tt.wantNodeInfo))
+		})
+	}
+}
+
+func TestAvailabilityZoneStatuses(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	exclusion := &metav1.Duration{Duration: 10 * time.Minute}
+	recent := now.Add(-5 * time.Minute)
+	old := now.Add(-time.Hour)
+
+	tests := []struct {
+		name           string
+		placement      *expinfrav1.AvailabilityZonePlacement
+		asgZones       []string
+		previous       []expinfrav1.AvailabilityZoneStatus
+		capacityErrors map[string]time.Time
+		want           []expinfrav1.AvailabilityZoneStatus
+	}{
+		{
+			name:      "availability zones without capacity errors are included",
+			placement: &expinfrav1.AvailabilityZonePlacement{CapacityErrorExclusionDuration: exclusion},
+			asgZones:  []string{"us-east-1b", "us-east-1a"},
+			want: []expinfrav1.AvailabilityZoneStatus{
+				{Name: "us-east-1a"},
+				{Name: "us-east-1b"},
+			},
+		},
+		{
+			name: "availability zones with a weight of 0 are excluded",
+			placement: &expinfrav1.AvailabilityZonePlacement{
+				Weights: []expinfrav1.AvailabilityZoneWeight{{AvailabilityZone: "us-east-1c", Weight: 0}},
+			},
+			asgZones: []string{"us-east-1a"},
+			want: []expinfrav1.AvailabilityZoneStatus{
+				{Name: "us-east-1a"},
+				{Name: "us-east-1c", Excluded: true, Reason: expinfrav1.AvailabilityZoneExclusionReasonZeroWeight},
+			},
+		},
+		{
+			name:           "availability zones with a recent capacity error are excluded",
+			placement:      &expinfrav1.AvailabilityZonePlacement{CapacityErrorExclusionDuration: exclusion},
+			asgZones:       []string{"us-east-1a", "us-east-1b"},
+			capacityErrors: map[string]time.Time{"us-east-1a": recent, "us-east-1b": old},
+			want: []expinfrav1.AvailabilityZoneStatus{
+				{
+					Name:                  "us-east-1a",
+					Excluded:              true,
+					Reason:                expinfrav1.AvailabilityZoneExclusionReasonInsufficientCapacity,
+					LastCapacityErrorTime: &metav1.Time{Time: recent},
+					ExcludedUntil:         &metav1.Time{Time: recent.Add(exclusion.Duration)},
+				},
+				{Name: "us-east-1b", LastCapacityErrorTime: &metav1.Time{Time: old}},
+			},
+		},
+		{
+			name:      "availability zones removed from the Auto Scaling group stay excluded until the exclusion expires",
+			placement: &expinfrav1.AvailabilityZonePlacement{CapacityErrorExclusionDuration: exclusion},
+			asgZones:  []string{"us-east-1b"},
+			previous: []expinfrav1.AvailabilityZoneStatus{
+				{
+					Name:                  "us-east-1a",
+					Excluded:              true,
+					Reason:                expinfrav1.AvailabilityZoneExclusionReasonInsufficientCapacity,
+					LastCapacityErrorTime: &metav1.Time{Time: recent},
+				},
+				{
+					Name:                  "us-east-1c",
+					Excluded:              true,
+					Reason:                expinfrav1.AvailabilityZoneExclusionReasonInsufficientCapacity,
+					LastCapacityErrorTime: &metav1.Time{Time: old},
+				},
+			},
+			want: []expinfrav1.AvailabilityZoneStatus{
+				{
+					Name:                  "us-east-1a",
+					Excluded:              true,
+					Reason:                expinfrav1.AvailabilityZoneExclusionReasonInsufficientCapacity,
+					LastCapacityErrorTime: &metav1.Time{Time: recent},
+					ExcludedUntil:         &metav1.Time{Time: recent.Add(exclusion.Duration)},
+				},
+				{Name: "us-east-1b"},
+				{Name: "us-east-1c", LastCapacityErrorTime: &metav1.Time{Time: old}},
+			},
+		},
+		{
+			name: "availability zones with the highest weight are kept when all the availability zones have capacity errors",
+			placement: &expinfrav1.AvailabilityZonePlacement{
+				Weights:                        []expinfrav1.AvailabilityZoneWeight{{AvailabilityZone: "us-east-1b", Weight: 3}},
+				CapacityErrorExclusionDuration: exclusion,
+			},
+			asgZones:       []string{"us-east-1a", "us-east-1b"},
+			capacityErrors: map[string]time.Time{"us-east-1a": recent, "us-east-1b": recent},
+			want: []expinfrav1.AvailabilityZoneStatus{
+				{
+					Name:                  "us-east-1a",
+					Excluded:              true,
+					Reason:                expinfrav1.AvailabilityZoneExclusionReasonInsufficientCapacity,
+					LastCapacityErrorTime: &metav1.Time{Time: recent},
+					ExcludedUntil:         &metav1.Time{Time: recent.Add(exclusion.Duration)},
+				},
+				{Name: "us-east-1b", LastCapacityErrorTime: &metav1.Time{Time: recent}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			zones := availabilityZoneStatuses(tt.placement, tt.asgZones, tt.previous, tt.capacityErrors, now)
+			g.Expect(zones).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestReconcileNodeHealth(t *testing.T) {
+	recent := metav1.NewTime(time.Now().Add(-time.Minute))
+	old := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	tests := []struct {
+		name             string
+		healthCheck      *expinfrav1.AutoScalingGroupHealthCheck
+		instances        []expinfrav1.AWSMachinePoolInstanceStatus
+		expect           func(m *mock_services.MockASGInterfaceMockRecorder)
+		wantRequeue      bool
+		wantHealthStatus []string
+	}{
+		{
+			name: "instances are not marked unhealthy without node health check",
+			healthCheck: &expinfrav1.AutoScalingGroupHealthCheck{
+				Type: expinfrav1.HealthCheckTypeEC2,
+			},
+			instances: []expinfrav1.AWSMachinePoolInstanceStatus{
+				{InstanceID: "i-1", LifecycleState: "InService", HealthStatus: "Healthy", NodeNotReadySince: &old},
+			},
+			wantHealthStatus: []string{"Healthy"},
+		},
+		{
+			name: "instances whose node has not been ready for longer than the timeout are marked unhealthy",
+			healthCheck: &expinfrav1.AutoScalingGroupHealthCheck{
+				NodeHealth: &expinfrav1.NodeHealthCheck{UnhealthyTimeout: metav1.Duration{Duration: 5 * time.Minute}},
+			},
+			instances: []expinfrav1.AWSMachinePoolInstanceStatus{
+				{InstanceID: "i-1", LifecycleState: "InService", HealthStatus: "Healthy", NodeNotReadySince: &old},
+				{InstanceID: "i-2", LifecycleState: "InService", HealthStatus: "Healthy", NodeNotReadySince: &recent},
+				{InstanceID: "i-3", LifecycleState: "InService", HealthStatus: "Healthy"},
+				{InstanceID: "i-4", LifecycleState: "Pending", HealthStatus: "Healthy", NodeNotReadySince: &old},
+				{InstanceID: "i-5", LifecycleState: "InService", HealthStatus: "Unhealthy", NodeNotReadySince: &old},
+			},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.MarkInstanceUnhealthy("i-1").Return(nil)
+			},
+			wantRequeue:      true,
+			wantHealthStatus: []string{"Unhealthy", "Healthy", "Healthy", "Healthy", "Unhealthy"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			asgSvc := mock_services.NewMockASGInterface(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(asgSvc.EXPECT())
+			}
+
+			machinePoolScope := &scope.MachinePoolScope{
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					Spec:   expinfrav1.AWSMachinePoolSpec{HealthCheck: tt.healthCheck},
+					Status: expinfrav1.AWSMachinePoolStatus{Instances: tt.instances},
+				},
+			}
+			r := &AWSMachinePoolReconciler{Recorder: record.NewFakeRecorder(10)}
+			requeueAfter, err := r.reconcileNodeHealth(machinePoolScope, asgSvc)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(requeueAfter > 0).To(Equal(tt.wantRequeue))
+
+			healthStatus := make([]string, 0, len(tt.instances))
+			for _, instance := range machinePoolScope.AWSMachinePool.Status.Instances {
+				healthStatus = append(healthStatus, instance.HealthStatus)
+			}
+			g.Expect(healthStatus).To(Equal(tt.wantHealthStatus))
+		})
+	}
+}
+
+func TestReconcileAvailabilityZoneSpread(t *testing.T) {
+	inService := func(id, zone string) expinfrav1.AutoScalingGroupInstance {
+		return expinfrav1.AutoScalingGroupInstance{ID: id, AvailabilityZone: zone, LifecycleState: "InService"}
+	}
+	protected := func(id, zone string) expinfrav1.AutoScalingGroupInstance {
+		instance := inService(id, zone)
+		instance.ProtectedFromScaleIn = true
+		return instance
+	}
+
+	tests := []struct {
+		name          string
+		placement     *expinfrav1.AvailabilityZonePlacement
+		asgZones      []string
+		instances     []expinfrav1.AutoScalingGroupInstance
+		expect        func(m *mock_services.MockASGInterfaceMockRecorder)
+		wantRequeue   bool
+		wantCondition *clusterv1.Condition
+	}{
+		{
+			name:      "the spread is not checked without spread constraints",
+			placement: &expinfrav1.AvailabilityZonePlacement{},
+			asgZones:  []string{"us-east-1a", "us-east-1b"},
+			instances: []expinfrav1.AutoScalingGroupInstance{inService("i-1", "us-east-1a"), inService("i-2", "us-east-1a")},
+		},
+		{
+			name:          "the spread is satisfied",
+			placement:     &expinfrav1.AvailabilityZonePlacement{MinInstancesPerZone: ptr.To[int32](1), MaxSkew: ptr.To[int32](1)},
+			asgZones:      []string{"us-east-1a", "us-east-1b"},
+			instances:     []expinfrav1.AutoScalingGroupInstance{inService("i-1", "us-east-1a"), inService("i-2", "us-east-1a"), inService("i-3", "us-east-1b")},
+			wantCondition: conditions.TrueCondition(expinfrav1.AvailabilityZoneSpreadCondition),
+		},
+		{
+			name: "the excluded availability zones are not part of the spread",
+			placement: &expinfrav1.AvailabilityZonePlacement{
+				Weights:             []expinfrav1.AvailabilityZoneWeight{{AvailabilityZone: "us-east-1c", Weight: 0}},
+				MinInstancesPerZone: ptr.To[int32](1),
+			},
+			asgZones:      []string{"us-east-1a", "us-east-1b", "us-east-1c"},
+			instances:     []expinfrav1.AutoScalingGroupInstance{inService("i-1", "us-east-1a"), inService("i-2", "us-east-1b")},
+			wantCondition: conditions.TrueCondition(expinfrav1.AvailabilityZoneSpreadCondition),
+		},
+		{
+			name:      "an instance of the most populated availability zone is replaced when the skew is exceeded",
+			placement: &expinfrav1.AvailabilityZonePlacement{MaxSkew: ptr.To[int32](1)},
+			asgZones:  []string{"us-east-1a", "us-east-1b"},
+			instances: []expinfrav1.AutoScalingGroupInstance{
+				protected("i-1", "us-east-1a"), inService("i-3", "us-east-1a"), inService("i-2", "us-east-1a"), inService("i-4", "us-east-1b"),
+			},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.ReplaceInstance("i-2").Return(nil)
+			},
+			wantRequeue: true,
+			wantCondition: conditions.FalseCondition(expinfrav1.AvailabilityZoneSpreadCondition, expinfrav1.AvailabilityZoneRebalancingReason, clusterv1.ConditionSeverityInfo,
+				"Replacing instance i-2 of availability zone us-east-1a: availability zones us-east-1a and us-east-1b differ by 2 instances, more than the maximum skew of 1"),
+		},
+		{
+			name:      "an instance is replaced when an availability zone runs fewer instances than the minimum",
+			placement: &expinfrav1.AvailabilityZonePlacement{MinInstancesPerZone: ptr.To[int32](1)},
+			asgZones:  []string{"us-east-1a", "us-east-1b"},
+			instances: []expinfrav1.AutoScalingGroupInstance{inService("i-1", "us-east-1a"), inService("i-2", "us-east-1a")},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.ReplaceInstance("i-1").Return(nil)
+			},
+			wantRequeue: true,
+			wantCondition: conditions.FalseCondition(expinfrav1.AvailabilityZoneSpreadCondition, expinfrav1.AvailabilityZoneRebalancingReason, clusterv1.ConditionSeverityInfo,
+				"Replacing instance i-1 of availability zone us-east-1a: availability zone us-east-1b runs 0 instances, fewer than the minimum of 1"),
+		},
+		{
+			name:      "the spread is unsatisfiable when the pool does not have enough instances",
+			placement: &expinfrav1.AvailabilityZonePlacement{MinInstancesPerZone: ptr.To[int32](2)},
+			asgZones:  []string{"us-east-1a", "us-east-1b"},
+			instances: []expinfrav1.AutoScalingGroupInstance{inService("i-1", "us-east-1a"), inService("i-2", "us-east-1a"), inService("i-3", "us-east-1b")},
+			wantCondition: conditions.FalseCondition(expinfrav1.AvailabilityZoneSpreadCondition, expinfrav1.AvailabilityZoneSpreadUnsatisfiableReason, clusterv1.ConditionSeverityWarning,
+				"availability zone us-east-1b runs 1 instances, fewer than the minimum of 2, the pool does not have enough instances to improve the spread"),
+		},
+		{
+			name:      "the spread is unsatisfiable when the instances of the most populated availability zone are protected",
+			placement: &expinfrav1.AvailabilityZonePlacement{MaxSkew: ptr.To[int32](1)},
+			asgZones:  []string{"us-east-1a", "us-east-1b"},
+			instances: []expinfrav1.AutoScalingGroupInstance{protected("i-1", "us-east-1a"), protected("i-2", "us-east-1a")},
+			wantCondition: conditions.FalseCondition(expinfrav1.AvailabilityZoneSpreadCondition, expinfrav1.AvailabilityZoneSpreadUnsatisfiableReason, clusterv1.ConditionSeverityWarning,
+				"availability zones us-east-1a and us-east-1b differ by 2 instances, more than the maximum skew of 1, the instances of availability zone us-east-1a are protected from scale in"),
+		},
+		{
+			name:        "the spread is checked again once the Auto Scaling group has settled",
+			placement:   &expinfrav1.AvailabilityZonePlacement{MaxSkew: ptr.To[int32](1)},
+			asgZones:    []string{"us-east-1a", "us-east-1b"},
+			instances:   []expinfrav1.AutoScalingGroupInstance{inService("i-1", "us-east-1a"), inService("i-2", "us-east-1a"), {ID: "i-3", AvailabilityZone: "us-east-1b", LifecycleState: "Pending"}},
+			wantRequeue: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			asgSvc := mock_services.NewMockASGInterface(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(asgSvc.EXPECT())
+			}
+
+			machinePoolScope := &scope.MachinePoolScope{
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					Spec: expinfrav1.AWSMachinePoolSpec{AvailabilityZonePlacement: tt.placement},
+				},
+			}
+			asg := &expinfrav1.AutoScalingGroup{AvailabilityZones: tt.asgZones, InstanceDetails: tt.instances}
+			r := &AWSMachinePoolReconciler{Recorder: record.NewFakeRecorder(10)}
+			requeueAfter, err := r.reconcileAvailabilityZoneSpread(machinePoolScope, asgSvc, asg)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(requeueAfter > 0).To(Equal(tt.wantRequeue))
+
+			condition := conditions.Get(machinePoolScope.AWSMachinePool, expinfrav1.AvailabilityZoneSpreadCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+			g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+			g.Expect(condition.Severity).To(Equal(tt.wantCondition.Severity))
+			g.Expect(condition.Message).To(Equal(tt.wantCondition.Message))
+		})
+	}
+}
+
+func TestReconcilePendingInstanceRefresh(t *testing.T) {
+	now := time.Now().UTC()
+	openWindow := &infrav1.MaintenanceWindow{StartTime: now.Add(-time.Hour).Format("15:04"), Duration: metav1.Duration{Duration: 3 * time.Hour}}
+	closedWindow := &infrav1.MaintenanceWindow{StartTime: now.Add(2 * time.Hour).Format("15:04"), Duration: metav1.Duration{Duration: time.Hour}}
+	pendingRefresh := infrav1.DisruptiveActions{{Type: infrav1.DisruptiveActionInstanceRefresh, Target: "test"}}
+
+	tests := []struct {
+		name               string
+		window             *infrav1.MaintenanceWindow
+		pendingActions     infrav1.DisruptiveActions
+		refreshPreferences *expinfrav1.RefreshPreferences
+		expect             func(m *mock_services.MockASGInterfaceMockRecorder)
+		wantRequeue        bool
+		wantPending        bool
+	}{
+		{
+			name:   "no pending instance refresh",
+			window: openWindow,
+		},
+		{
+			name:           "pending instance refresh outside of the maintenance window",
+			window:         closedWindow,
+			pendingActions: pendingRefresh,
+			wantRequeue:    true,
+			wantPending:    true,
+		},
+		{
+			name:           "pending instance refresh is started when the maintenance window opens",
+			window:         openWindow,
+			pendingActions: pendingRefresh,
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.CanStartASGInstanceRefresh(gomock.Any()).Return(true, nil)
+				m.StartASGInstanceRefresh(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:           "pending instance refresh waits for the ongoing instance refresh",
+			window:         openWindow,
+			pendingActions: pendingRefresh,
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.CanStartASGInstanceRefresh(gomock.Any()).Return(false, nil)
+			},
+			wantRequeue: true,
+			wantPending: true,
+		},
+		{
+			name:               "pending instance refresh is dropped when instance refresh is disabled",
+			window:             closedWindow,
+			pendingActions:     pendingRefresh,
+			refreshPreferences: &expinfrav1.RefreshPreferences{Disable: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			asgSvc := mock_services.NewMockASGInterface(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(asgSvc.EXPECT())
+			}
+
+			cs, err := setupCluster("test-cluster")
+			g.Expect(err).NotTo(HaveOccurred())
+			cs.AWSCluster.Spec.MaintenanceWindow = tt.window
+
+			machinePoolScope := &scope.MachinePoolScope{
+				Logger:      *logger.NewLogger(logr.Discard()),
+				MachinePool: &expclusterv1.MachinePool{},
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec:       expinfrav1.AWSMachinePoolSpec{RefreshPreferences: tt.refreshPreferences},
+					Status:     expinfrav1.AWSMachinePoolStatus{PendingDisruptiveActions: tt.pendingActions.DeepCopy()},
+				},
+			}
+			r := &AWSMachinePoolReconciler{}
+			requeueAfter, err := r.reconcilePendingInstanceRefresh(machinePoolScope, cs, asgSvc)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(requeueAfter > 0).To(Equal(tt.wantRequeue))
+			g.Expect(machinePoolScope.AWSMachinePool.Status.PendingDisruptiveActions.Has(infrav1.DisruptiveActionInstanceRefresh, "test")).To(Equal(tt.wantPending))
+		})
+	}
+}
+
+func TestReconcileScaleInProtection(t *testing.T) {
+	tests := []struct {
+		name                     string
+		machinePoolMachines      bool
+		machines                 []client.Object
+		instances                []expinfrav1.AWSMachinePoolInstanceStatus
+		expect                   func(m *mock_services.MockASGInterfaceMockRecorder)
+		wantErr                  bool
+		wantProtectedFromScaleIn []bool
+	}{
+		{
+			name: "instances whose scale down is disabled are protected and the others unprotected",
+			instances: []expinfrav1.AWSMachinePoolInstanceStatus{
+				{InstanceID: "i-1", LifecycleState: "InService", ScaleDownDisabled: true},
+				{InstanceID: "i-2", LifecycleState: "InService", ProtectedFromScaleIn: true},
+				{InstanceID: "i-3", LifecycleState: "InService", ScaleDownDisabled: true, ProtectedFromScaleIn: true},
+				{InstanceID: "i-4", LifecycleState: "Standby"},
+			},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.SetInstanceProtection("test", []string{"i-1"}, true).Return(nil)
+				m.SetInstanceProtection("test", []string{"i-2"}, false).Return(nil)
+			},
+			wantProtectedFromScaleIn: []bool{true, false, true, false},
+		},
+		{
+			name: "the scale in protection of instances which are not in service is left untouched",
+			instances: []expinfrav1.AWSMachinePoolInstanceStatus{
+				{InstanceID: "i-1", LifecycleState: "Pending", ScaleDownDisabled: true},
+				{InstanceID: "i-2", LifecycleState: "Terminating", ProtectedFromScaleIn: true},
+			},
+			wantProtectedFromScaleIn: []bool{false, true},
+		},
+		{
+			name: "the scale in protection is left untouched if it cannot be set",
+			instances: []expinfrav1.AWSMachinePoolInstanceStatus{
+				{InstanceID: "i-1", LifecycleState: "InService", ScaleDownDisabled: true},
+			},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.SetInstanceProtection("test", []string{"i-1"}, true).Return(errors.New("error"))
+			},
+			wantErr:                  true,
+			wantProtectedFromScaleIn: []bool{false},
+		},
+		{
+			name:                "instances of the annotated MachinePool Machines are protected",
+			machinePoolMachines: true,
+			machines: []client.Object{
+				&clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "machine-1",
+						Namespace:   "default",
+						Labels:      map[string]string{clusterv1.MachinePoolNameLabel: "test", clusterv1.ClusterNameLabel: "test-cluster"},
+						Annotations: map[string]string{expinfrav1.ScaleDownDisabledAnnotation: "true"},
+					},
+					Spec: clusterv1.MachineSpec{ClusterName: "test-cluster", ProviderID: ptr.To("aws:///us-east-1a/i-1")},
+				},
+				&clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "machine-2",
+						Namespace: "default",
+						Labels:    map[string]string{clusterv1.MachinePoolNameLabel: "test", clusterv1.ClusterNameLabel: "test-cluster"},
+					},
+					Spec: clusterv1.MachineSpec{ClusterName: "test-cluster", ProviderID: ptr.To("aws:///us-east-1a/i-2")},
+				},
+			},
+			instances: []expinfrav1.AWSMachinePoolInstanceStatus{
+				{InstanceID: "i-1", LifecycleState: "InService"},
+				{InstanceID: "i-2", LifecycleState: "InService"},
+			},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.SetInstanceProtection("test", []string{"i-1"}, true).Return(nil)
+			},
+			wantProtectedFromScaleIn: []bool{true, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePoolMachines, tt.machinePoolMachines)
+			mockCtrl := gomock.NewController(t)
+			asgSvc := mock_services.NewMockASGInterface(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(asgSvc.EXPECT())
+			}
+
+			scheme := runtime.NewScheme()
+			_ = clusterv1.AddToScheme(scheme)
+			machinePoolScope := &scope.MachinePoolScope{
+				MachinePool: &expclusterv1.MachinePool{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+					Spec:       expclusterv1.MachinePoolSpec{ClusterName: "test-cluster"},
+				},
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+					Status:     expinfrav1.AWSMachinePoolStatus{Instances: tt.instances},
+				},
+			}
+			r := &AWSMachinePoolReconciler{
+				Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.machines...).Build(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+			err := r.reconcileScaleInProtection(context.Background(), machinePoolScope, asgSvc)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+
+			protectedFromScaleIn := make([]bool, 0, len(tt.instances))
+			for _, instance := range machinePoolScope.AWSMachinePool.Status.Instances {
+				protectedFromScaleIn = append(protectedFromScaleIn, instance.ProtectedFromScaleIn)
+			}
+			g.Expect(protectedFromScaleIn).To(Equal(tt.wantProtectedFromScaleIn))
+		})
+	}
+}
+
+func TestReconcileEncryptionKeys(t *testing.T) {
+	tests := []struct {
+		name           string
+		launchTemplate expinfrav1.AWSLaunchTemplate
+		expect         func(m *mock_services.MockKMSInterfaceMockRecorder)
+		wantUsable     bool
+		wantErr        bool
+		wantCondition  *clusterv1.Condition
+	}{
+		{
+			name:       "volumes without customer managed keys are not validated",
+			wantUsable: true,
+		},
+		{
+			name: "the keys of the root and non root volumes are validated",
+			launchTemplate: expinfrav1.AWSLaunchTemplate{
+				RootVolume:     &infrav1.Volume{Encrypted: ptr.To(true), EncryptionKey: "alias/root"},
+				NonRootVolumes: []infrav1.Volume{{Encrypted: ptr.To(true), EncryptionKey: "alias/data"}, {}},
+			},
+			expect: func(m *mock_services.MockKMSInterfaceMockRecorder) {
+				m.ValidateAutoScalingKeyAccess([]string{"alias/root", "alias/data"}).Return(nil)
+			},
+			wantUsable:    true,
+			wantCondition: conditions.TrueCondition(expinfrav1.EncryptionKeysReadyCondition),
+		},
+		{
+			name: "a key which cannot be used is surfaced in the condition",
+			launchTemplate: expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{Encrypted: ptr.To(true), EncryptionKey: "alias/root"},
+			},
+			expect: func(m *mock_services.MockKMSInterfaceMockRecorder) {
+				m.ValidateAutoScalingKeyAccess([]string{"alias/root"}).Return(&kms.KeyNotUsableError{KeyID: "alias/root", Principal: "role", Reason: "the key is Disabled"})
+			},
+			wantCondition: conditions.FalseCondition(expinfrav1.EncryptionKeysReadyCondition, expinfrav1.EncryptionKeyNotUsableReason, clusterv1.ConditionSeverityError,
+				`KMS key "alias/root" cannot be used by role: the key is Disabled`),
+		},
+		{
+			name: "keys which cannot be validated are retried",
+			launchTemplate: expinfrav1.AWSLaunchTemplate{
+				RootVolume: &infrav1.Volume{Encrypted: ptr.To(true), EncryptionKey: "alias/root"},
+			},
+			expect: func(m *mock_services.MockKMSInterfaceMockRecorder) {
+				m.ValidateAutoScalingKeyAccess([]string{"alias/root"}).Return(errors.New("error"))
+			},
+			wantErr:       true,
+			wantCondition: conditions.FalseCondition(expinfrav1.EncryptionKeysReadyCondition, expinfrav1.EncryptionKeyValidationFailedReason, clusterv1.ConditionSeverityWarning, "error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			kmsSvc := mock_services.NewMockKMSInterface(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(kmsSvc.EXPECT())
+			}
+
+			machinePoolScope := &scope.MachinePoolScope{
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+					Spec:       expinfrav1.AWSMachinePoolSpec{AWSLaunchTemplate: tt.launchTemplate},
+				},
+			}
+			r := &AWSMachinePoolReconciler{
+				Recorder: record.NewFakeRecorder(10),
+				kmsServiceFactory: func(cloud.ClusterScoper) services.KMSInterface {
+					return kmsSvc
+				},
+			}
+			usable, err := r.reconcileEncryptionKeys(machinePoolScope, nil)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(usable).To(Equal(tt.wantUsable))
+
+			condition := conditions.Get(machinePoolScope.AWSMachinePool, expinfrav1.EncryptionKeysReadyCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+			g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+			g.Expect(condition.Severity).To(Equal(tt.wantCondition.Severity))
+			g.Expect(condition.Message).To(Equal(tt.wantCondition.Message))
+		})
+	}
+}
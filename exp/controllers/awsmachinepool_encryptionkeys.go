@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// reconcileEncryptionKeys ensures that the Auto Scaling group can use the customer managed KMS keys encrypting the
+// volumes of its instances, which are otherwise terminated as soon as they are launched.
+// It returns false if a key cannot be used, in which case the launch template and the Auto Scaling group must not
+// be reconciled.
+func (r *AWSMachinePoolReconciler) reconcileEncryptionKeys(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper) (bool, error) {
+	keyIDs := encryptionKeyIDs(&machinePoolScope.AWSMachinePool.Spec.AWSLaunchTemplate)
+	if len(keyIDs) == 0 {
+		conditions.Delete(machinePoolScope.AWSMachinePool, expinfrav1.EncryptionKeysReadyCondition)
+		return true, nil
+	}
+
+	err := r.getKMSService(clusterScope).ValidateAutoScalingKeyAccess(keyIDs)
+	if notUsableErr, ok := kms.AsKeyNotUsable(err); ok {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "EncryptionKeyNotUsable", "%s", notUsableErr.Error())
+		conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.EncryptionKeysReadyCondition, expinfrav1.EncryptionKeyNotUsableReason, clusterv1.ConditionSeverityError, "%s", notUsableErr.Error())
+		return false, nil
+	}
+	if err != nil {
+		conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.EncryptionKeysReadyCondition, expinfrav1.EncryptionKeyValidationFailedReason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return false, err
+	}
+
+	conditions.MarkTrue(machinePoolScope.AWSMachinePool, expinfrav1.EncryptionKeysReadyCondition)
+	return true, nil
+}
+
+// encryptionKeyIDs returns the KMS keys encrypting the volumes of the instances launched from the launch template.
+func encryptionKeyIDs(launchTemplate *expinfrav1.AWSLaunchTemplate) []string {
+	var keyIDs []string
+	if launchTemplate.RootVolume != nil && launchTemplate.RootVolume.EncryptionKey != "" {
+		keyIDs = append(keyIDs, launchTemplate.RootVolume.EncryptionKey)
+	}
+	for _, volume := range launchTemplate.NonRootVolumes {
+		if volume.EncryptionKey != "" {
+			keyIDs = append(keyIDs, volume.EncryptionKey)
+		}
+	}
+	return keyIDs
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+)
+
+// instanceHealthStatusHealthy is the Auto Scaling health status of a healthy instance.
+const instanceHealthStatusHealthy = "Healthy"
+
+// reconcileNodeHealth marks the in-service instances of the AWSMachinePool whose node has not been ready
+// for longer than the unhealthy timeout of the node health check as unhealthy, so that the Auto Scaling
+// group replaces them once their health check grace period has elapsed.
+// It returns the duration after which the next instance whose node is not ready reaches the timeout.
+func (r *AWSMachinePoolReconciler) reconcileNodeHealth(machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface) (time.Duration, error) {
+	healthCheck := machinePoolScope.AWSMachinePool.Spec.HealthCheck
+	if healthCheck == nil || healthCheck.NodeHealth == nil {
+		return 0, nil
+	}
+	timeout := healthCheck.NodeHealth.UnhealthyTimeout.Duration
+
+	now := time.Now()
+	var requeueAfter time.Duration
+	var errs []error
+	for i := range machinePoolScope.AWSMachinePool.Status.Instances {
+		instance := &machinePoolScope.AWSMachinePool.Status.Instances[i]
+		if instance.NodeNotReadySince == nil ||
+			instance.LifecycleState != string(autoscalingtypes.LifecycleStateInService) ||
+			instance.HealthStatus != instanceHealthStatusHealthy {
+			continue
+		}
+
+		if remaining := instance.NodeNotReadySince.Add(timeout).Sub(now); remaining > 0 {
+			if requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+			continue
+		}
+
+		if err := asgsvc.MarkInstanceUnhealthy(instance.InstanceID); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "InstanceMarkedUnhealthy",
+			"Marked instance %s unhealthy, its node has not been ready since %s", instance.InstanceID, instance.NodeNotReadySince.Format(time.RFC3339))
+		instance.HealthStatus = "Unhealthy"
+	}
+
+	return requeueAfter, kerrors.NewAggregate(errs)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/quota"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// vcpuQuotaRequeueAfter is how long to wait before checking the vCPU quota again once it is exceeded.
+const vcpuQuotaRequeueAfter = 5 * time.Minute
+
+func (r *AWSMachinePoolReconciler) getQuotaService(scope cloud.ClusterScoper) services.QuotaInterface {
+	if r.quotaServiceFactory != nil {
+		return r.quotaServiceFactory(scope)
+	}
+
+	return quota.NewService(scope)
+}
+
+// reconcileVCPUQuota ensures that the vCPU quota of the account leaves room for the instances launched when the
+// Auto Scaling group is created or scaled up to the replicas of the MachinePool, and reports it in the
+// VCPUQuotaAvailable condition. It returns false if the Auto Scaling group must not be created or updated.
+func (r *AWSMachinePoolReconciler) reconcileVCPUQuota(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, asg *expinfrav1.AutoScalingGroup) (bool, error) {
+	awsMachinePool := machinePoolScope.AWSMachinePool
+	launchTemplate := awsMachinePool.Spec.AWSLaunchTemplate
+	// Instances of a mixed instances policy are spread over several instance types and markets, the capacity of
+	// capacity blocks is reserved ahead, and an external autoscaler scales the Auto Scaling group itself.
+	if launchTemplate.InstanceType == "" || awsMachinePool.Spec.MixedInstancesPolicy != nil ||
+		launchTemplate.MarketType == infrav1.MarketTypeCapacityBlock ||
+		annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+		conditions.Delete(awsMachinePool, infrav1.VCPUQuotaAvailableCondition)
+		return true, nil
+	}
+
+	instances := ptr.Deref(machinePoolScope.MachinePool.Spec.Replicas, 0)
+	if asg != nil {
+		instances -= ptr.Deref(asg.DesiredCapacity, 0)
+	}
+	if instances <= 0 {
+		conditions.MarkTrue(awsMachinePool, infrav1.VCPUQuotaAvailableCondition)
+		return true, nil
+	}
+
+	err := r.getQuotaService(clusterScope).CheckVCPUQuota(launchTemplate.InstanceType, launchTemplate.SpotMarketOptions != nil, instances)
+	if exceededErr, ok := quota.AsVCPUQuotaExceeded(err); ok {
+		r.Recorder.Eventf(awsMachinePool, corev1.EventTypeWarning, "VCPUQuotaExceeded", "%s", exceededErr.Error())
+		conditions.MarkFalse(awsMachinePool, infrav1.VCPUQuotaAvailableCondition, infrav1.QuotaExceededReason, clusterv1.ConditionSeverityWarning, "%s", exceededErr.Error())
+		return false, nil
+	}
+	if err != nil {
+		conditions.MarkFalse(awsMachinePool, infrav1.VCPUQuotaAvailableCondition, infrav1.QuotaCheckFailedReason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return false, err
+	}
+
+	conditions.MarkTrue(awsMachinePool, infrav1.VCPUQuotaAvailableCondition)
+	return true, nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/labels/format"
+)
+
+// reconcileScaleInProtection protects the instances of the AWSMachinePool whose Node or Machine has the scale down
+// disabled annotation of the cluster autoscaler from scale in, so that the Auto Scaling group neither terminates them
+// when scaling in nor replaces them during instance refreshes, and removes the protection of the other instances.
+// It must only be called once the status of the nodes of the instances is known.
+func (r *AWSMachinePoolReconciler) reconcileScaleInProtection(ctx context.Context, machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface) error {
+	if feature.Gates.Enabled(feature.MachinePoolMachines) {
+		instanceIDs, err := r.getScaleDownDisabledMachineInstanceIDs(ctx, machinePoolScope)
+		if err != nil {
+			return err
+		}
+		for i := range machinePoolScope.AWSMachinePool.Status.Instances {
+			instance := &machinePoolScope.AWSMachinePool.Status.Instances[i]
+			if instanceIDs.Has(instance.InstanceID) {
+				instance.ScaleDownDisabled = true
+			}
+		}
+	}
+
+	var protect, unprotect []string
+	for _, instance := range machinePoolScope.AWSMachinePool.Status.Instances {
+		// The scale in protection can only be changed while the instance is in service or on standby.
+		if instance.LifecycleState != string(autoscalingtypes.LifecycleStateInService) &&
+			instance.LifecycleState != string(autoscalingtypes.LifecycleStateStandby) {
+			continue
+		}
+
+		switch {
+		case instance.ScaleDownDisabled && !instance.ProtectedFromScaleIn:
+			protect = append(protect, instance.InstanceID)
+		case !instance.ScaleDownDisabled && instance.ProtectedFromScaleIn:
+			unprotect = append(unprotect, instance.InstanceID)
+		}
+	}
+
+	var errs []error
+	if err := r.setInstanceProtection(machinePoolScope, asgsvc, protect, true); err != nil {
+		errs = append(errs, err)
+	}
+	if err := r.setInstanceProtection(machinePoolScope, asgsvc, unprotect, false); err != nil {
+		errs = append(errs, err)
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+func (r *AWSMachinePoolReconciler) setInstanceProtection(machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface, instanceIDs []string, protected bool) error {
+	if len(instanceIDs) == 0 {
+		return nil
+	}
+
+	if err := asgsvc.SetInstanceProtection(machinePoolScope.Name(), instanceIDs, protected); err != nil {
+		return err
+	}
+	if protected {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeNormal, "InstancesProtectedFromScaleIn",
+			"Protected instances %s from scale in, their scale down is disabled", strings.Join(instanceIDs, ", "))
+	} else {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeNormal, "InstancesUnprotectedFromScaleIn",
+			"Removed the scale in protection of instances %s", strings.Join(instanceIDs, ", "))
+	}
+
+	ids := sets.New(instanceIDs...)
+	for i := range machinePoolScope.AWSMachinePool.Status.Instances {
+		if instance := &machinePoolScope.AWSMachinePool.Status.Instances[i]; ids.Has(instance.InstanceID) {
+			instance.ProtectedFromScaleIn = protected
+		}
+	}
+	return nil
+}
+
+// getScaleDownDisabledMachineInstanceIDs returns the IDs of the instances of the MachinePool Machines which have the
+// scale down disabled annotation of the cluster autoscaler.
+func (r *AWSMachinePoolReconciler) getScaleDownDisabledMachineInstanceIDs(ctx context.Context, machinePoolScope *scope.MachinePoolScope) (sets.Set[string], error) {
+	mp := machinePoolScope.MachinePool
+	machineList := &clusterv1.MachineList{}
+	labels := map[string]string{
+		clusterv1.MachinePoolNameLabel: format.MustFormatValue(mp.Name),
+		clusterv1.ClusterNameLabel:     mp.Spec.ClusterName,
+	}
+	if err := r.Client.List(ctx, machineList, client.InNamespace(mp.Namespace), client.MatchingLabels(labels)); err != nil {
+		return nil, err
+	}
+
+	instanceIDs := sets.New[string]()
+	for _, machine := range machineList.Items {
+		if machine.Annotations[expinfrav1.ScaleDownDisabledAnnotation] != "true" || machine.Spec.ProviderID == nil {
+			continue
+		}
+		providerID := strings.Split(*machine.Spec.ProviderID, "/")
+		instanceIDs.Insert(providerID[len(providerID)-1])
+	}
+	return instanceIDs, nil
+}
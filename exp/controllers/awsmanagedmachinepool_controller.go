@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
@@ -189,10 +190,10 @@ func (r *AWSManagedMachinePoolReconciler) Reconcile(ctx context.Context, req ctr
 	}()
 
 	if !awsPool.ObjectMeta.DeletionTimestamp.IsZero() {
-		return ctrl.Result{}, r.reconcileDelete(ctx, machinePoolScope, managedControlPlaneScope)
+		return scope.RequeueOnWriteFreeze(managedControlPlaneScope.ControlPlane, ctrl.Result{}, r.reconcileDelete(ctx, machinePoolScope, managedControlPlaneScope))
 	}
 
-	return ctrl.Result{}, r.reconcileNormal(ctx, machinePoolScope, managedControlPlaneScope, managedControlPlaneScope)
+	return scope.RequeueOnWriteFreeze(managedControlPlaneScope.ControlPlane, ctrl.Result{}, r.reconcileNormal(ctx, machinePoolScope, managedControlPlaneScope, managedControlPlaneScope))
 }
 
 func (r *AWSManagedMachinePoolReconciler) reconcileNormal(
@@ -220,8 +221,10 @@ func (r *AWSManagedMachinePoolReconciler) reconcileNormal(
 		runPostLaunchTemplateUpdateOperation := func() error {
 			return nil
 		}
+		// Managed node groups roll out new launch template versions themselves, so
+		// security group changes are not applied to running instances in place.
 		var objectStoreSvc services.ObjectStoreInterface // nil because no S3 bucket support for `AWSManagedControlPlane` yet
-		if err := reconSvc.ReconcileLaunchTemplate(ctx, machinePoolScope, machinePoolScope, s3Scope, ec2svc, objectStoreSvc, canUpdateLaunchTemplate, runPostLaunchTemplateUpdateOperation); err != nil {
+		if err := reconSvc.ReconcileLaunchTemplate(ctx, machinePoolScope, machinePoolScope, s3Scope, ec2svc, objectStoreSvc, canUpdateLaunchTemplate, runPostLaunchTemplateUpdateOperation, nil); err != nil {
 			r.Recorder.Eventf(machinePoolScope.ManagedMachinePool, corev1.EventTypeWarning, "FailedLaunchTemplateReconcile", "Failed to reconcile launch template: %v", err)
 			machinePoolScope.Error(err, "failed to reconcile launch template")
 			conditions.MarkFalse(machinePoolScope.ManagedMachinePool, expinfrav1.LaunchTemplateReadyCondition, expinfrav1.LaunchTemplateReconcileFailedReason, clusterv1.ConditionSeverityError, "")
@@ -241,6 +244,10 @@ func (r *AWSManagedMachinePoolReconciler) reconcileNormal(
 		conditions.MarkTrue(machinePoolScope.ManagedMachinePool, expinfrav1.LaunchTemplateReadyCondition)
 	}
 
+	if err := r.reconcileCapacity(machinePoolScope, ec2svc); err != nil {
+		return errors.Wrap(err, "failed to reconcile capacity")
+	}
+
 	if err := ekssvc.ReconcilePool(ctx); err != nil {
 		return errors.Wrapf(err, "failed to reconcile machine pool for AWSManagedMachinePool %s/%s", machinePoolScope.ManagedMachinePool.Namespace, machinePoolScope.ManagedMachinePool.Name)
 	}
@@ -290,6 +297,30 @@ func (r *AWSManagedMachinePoolReconciler) reconcileDelete(
 	return nil
 }
 
+// reconcileCapacity advertises the capacity and the node information of the instance type of the nodegroup in the
+// status of the AWSManagedMachinePool when the cluster autoscaler tags are managed, so that the cluster autoscaler
+// can scale the nodegroup from zero.
+func (r *AWSManagedMachinePoolReconciler) reconcileCapacity(machinePoolScope *scope.ManagedMachinePoolScope, ec2Svc services.EC2Interface) error {
+	managedPool := machinePoolScope.ManagedMachinePool
+	instanceType := ptr.Deref(managedPool.Spec.InstanceType, "")
+	if instanceType == "" && managedPool.Spec.AWSLaunchTemplate != nil {
+		instanceType = managedPool.Spec.AWSLaunchTemplate.InstanceType
+	}
+	if !managedPool.Spec.Scaling.IsAutoscalerTagsManaged() || instanceType == "" {
+		managedPool.Status.Capacity = nil
+		managedPool.Status.NodeInfo = nil
+		return nil
+	}
+
+	capacity, nodeInfo, err := ec2Svc.GetInstanceTypeCapacity(instanceType)
+	if err != nil {
+		return err
+	}
+	managedPool.Status.Capacity = capacity
+	managedPool.Status.NodeInfo = nodeInfo
+	return nil
+}
+
 // GetOwnerClusterKey returns only the Cluster name and namespace.
 func GetOwnerClusterKey(obj metav1.ObjectMeta) (*client.ObjectKey, error) {
 	for _, ref := range obj.OwnerReferences {
@@ -92,6 +92,46 @@ const (
 	// owner: @enxebre
 	// alpha: v2.2
 	ROSA featuregate.Feature = "ROSA"
+
+	// BootstrapFailureDiagnostics is used to collect diagnostics on instances that do not become nodes within a timeout.
+	// alpha: v2.9
+	BootstrapFailureDiagnostics featuregate.Feature = "BootstrapFailureDiagnostics"
+
+	// InstanceEventTimeline is used to record Spot interruption notices and status check impairments in the AWSMachine timeline.
+	// alpha: v2.9
+	InstanceEventTimeline featuregate.Feature = "InstanceEventTimeline"
+
+	// InstanceProfileCreation is used to enable the creation of the IAM instance profiles declared by AWSMachines.
+	// alpha: v2.9
+	InstanceProfileCreation featuregate.Feature = "InstanceProfileCreation"
+
+	// InstanceScheduledEvents is used to report the scheduled events and AWS Health events affecting the instances of AWSMachines,
+	// and to remediate their Machines ahead of disruptive events.
+	// alpha: v2.9
+	InstanceScheduledEvents featuregate.Feature = "InstanceScheduledEvents"
+
+	// InstanceAcceleratorInventory is used to report the accelerator inventory of the instance types of AWSMachines in their status.
+	// alpha: v2.9
+	InstanceAcceleratorInventory featuregate.Feature = "InstanceAcceleratorInventory"
+
+	// VCPUQuotaCheck is used to check the vCPU quotas of the account before launching the instances of AWSMachines and AWSMachinePools.
+	// alpha: v2.9
+	VCPUQuotaCheck featuregate.Feature = "VCPUQuotaCheck"
+
+	// PreflightChecks is used to validate the service-linked roles, quotas, KMS keys and AWS Marketplace subscriptions of the account
+	// before creating the resources of AWSClusters.
+	// alpha: v2.9
+	PreflightChecks featuregate.Feature = "PreflightChecks"
+
+	// MachineCloudWatchLogs is used to ship the logs of the instances of AWSMachines to CloudWatch Logs, and to delete
+	// their log groups along with the cluster.
+	// alpha: v2.9
+	MachineCloudWatchLogs featuregate.Feature = "MachineCloudWatchLogs"
+
+	// ResourceLimitsReporting is used to report the usage of the Elastic IP and vCPU quotas of the account and the network
+	// interface limits of the instance types of AWSClusters in their status.
+	// alpha: v2.9
+	ResourceLimitsReporting featuregate.Feature = "ResourceLimitsReporting"
 )
 
 func init() {
@@ -115,4 +155,13 @@ var defaultCAPAFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	AlternativeGCStrategy:         {Default: false, PreRelease: featuregate.Beta},
 	TagUnmanagedNetworkResources:  {Default: true, PreRelease: featuregate.Alpha},
 	ROSA:                          {Default: false, PreRelease: featuregate.Alpha},
+	BootstrapFailureDiagnostics:   {Default: false, PreRelease: featuregate.Alpha},
+	InstanceEventTimeline:         {Default: false, PreRelease: featuregate.Alpha},
+	InstanceProfileCreation:       {Default: false, PreRelease: featuregate.Alpha},
+	InstanceScheduledEvents:       {Default: false, PreRelease: featuregate.Alpha},
+	InstanceAcceleratorInventory:  {Default: false, PreRelease: featuregate.Alpha},
+	VCPUQuotaCheck:                {Default: false, PreRelease: featuregate.Alpha},
+	MachineCloudWatchLogs:         {Default: false, PreRelease: featuregate.Alpha},
+	PreflightChecks:               {Default: false, PreRelease: featuregate.Alpha},
+	ResourceLimitsReporting:       {Default: false, PreRelease: featuregate.Alpha},
 }
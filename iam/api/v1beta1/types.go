@@ -105,6 +105,21 @@ type Statements []StatementEntry
 // Principals is the map of all identities a statement entry refers to.
 type Principals map[PrincipalType]PrincipalID
 
+// UnmarshalJSON defines an Unmarshaler for Principals, which also accepts the "*" wildcard covering all AWS identities.
+func (principals *Principals) UnmarshalJSON(data []byte) error {
+	var id string
+	if err := json.Unmarshal(data, &id); err == nil {
+		*principals = Principals{PrincipalAWS: PrincipalID{id}}
+		return nil
+	}
+	var ids map[PrincipalType]PrincipalID
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return errors.Wrap(err, "couldn't unmarshal as either map or string")
+	}
+	*principals = Principals(ids)
+	return nil
+}
+
 // Actions is the list of actions.
 type Actions []string
 
@@ -126,6 +141,21 @@ func (actions *Actions) UnmarshalJSON(data []byte) error {
 // Resources is the list of resources.
 type Resources []string
 
+// UnmarshalJSON is a Resources Unmarshaler.
+func (resources *Resources) UnmarshalJSON(data []byte) error {
+	var ids []string
+	if err := json.Unmarshal(data, &ids); err == nil {
+		*resources = Resources(ids)
+		return nil
+	}
+	var id string
+	if err := json.Unmarshal(data, &id); err != nil {
+		return errors.Wrap(err, "couldn't unmarshal as either []string or string")
+	}
+	*resources = []string{id}
+	return nil
+}
+
 // PrincipalID represents the list of all identities, such as ARNs.
 type PrincipalID []string
 
@@ -107,6 +107,7 @@ var (
 	waitInfraPeriod             time.Duration
 	maxWaitActiveUpdateDelete   time.Duration
 	syncPeriod                  time.Duration
+	bootstrapDiagnosticsTimeout time.Duration
 	webhookPort                 int
 	webhookCertDir              string
 	healthAddr                  string
@@ -316,6 +317,7 @@ func setupReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager, awsServi
 			Endpoints:                    awsServiceEndpoints,
 			WatchFilterValue:             watchFilterValue,
 			TagUnmanagedNetworkResources: feature.Gates.Enabled(feature.TagUnmanagedNetworkResources),
+			BootstrapDiagnosticsTimeout:  bootstrapDiagnosticsTimeout,
 		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsMachineConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "AWSMachine")
 			os.Exit(1)
@@ -334,9 +336,20 @@ func setupReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager, awsServi
 			setupLog.Error(err, "unable to create controller", "controller", "AWSCluster")
 			os.Exit(1)
 		}
+
+		if err := (&controllers.AWSMachineTemplateReconciler{
+			Client:                       mgr.GetClient(),
+			Endpoints:                    awsServiceEndpoints,
+			WatchFilterValue:             watchFilterValue,
+			TagUnmanagedNetworkResources: feature.Gates.Enabled(feature.TagUnmanagedNetworkResources),
+		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsMachineConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AWSMachineTemplate")
+			os.Exit(1)
+		}
 	} else {
 		setupLog.Info("controller disabled", "controller", "AWSMachine", "controller-group", controllers.Unmanaged)
 		setupLog.Info("controller disabled", "controller", "AWSCluster", "controller-group", controllers.Unmanaged)
+		setupLog.Info("controller disabled", "controller", "AWSMachineTemplate", "controller-group", controllers.Unmanaged)
 	}
 
 	if feature.Gates.Enabled(feature.MachinePool) {
@@ -395,6 +408,10 @@ func setupReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager, awsServi
 		setupLog.Error(err, "unable to create webhook", "webhook", "AWSClusterTemplate")
 		os.Exit(1)
 	}
+	if err := (&infrav1.AWSBastionHost{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AWSBastionHost")
+		os.Exit(1)
+	}
 	if err := (&infrav1.AWSClusterControllerIdentity{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "AWSClusterControllerIdentity")
 		os.Exit(1)
@@ -594,6 +611,12 @@ func initFlags(fs *pflag.FlagSet) {
 		"The maximum duration to wait for managed AWS resources to be ready.",
 	)
 
+	fs.DurationVar(&bootstrapDiagnosticsTimeout,
+		"bootstrap-diagnostics-timeout",
+		15*time.Minute,
+		"The duration after which a running instance that did not become a node is diagnosed. Requires the BootstrapFailureDiagnostics feature gate.",
+	)
+
 	fs.DurationVar(&syncPeriod,
 		"sync-period",
 		10*time.Minute,
@@ -35,16 +35,20 @@ const (
 	EIPNotFound                       = "InvalidElasticIpID.NotFound"
 	GatewayNotFound                   = "InvalidGatewayID.NotFound"
 	GroupNotFound                     = "InvalidGroup.NotFound"
+	ImageNotFound                     = "InvalidAMIID.NotFound"
 	InternetGatewayNotFound           = "InvalidInternetGatewayID.NotFound"
 	InvalidCarrierGatewayNotFound     = "InvalidCarrierGatewayID.NotFound"
 	EgressOnlyInternetGatewayNotFound = "InvalidEgressOnlyInternetGatewayID.NotFound"
 	InUseIPAddress                    = "InvalidIPAddress.InUse"
+	InsufficientFreeAddresses         = "InsufficientFreeAddressesInSubnet"
+	InsufficientInstanceCapacity      = "InsufficientInstanceCapacity"
 	InvalidAccessKeyID                = "InvalidAccessKeyId"
 	InvalidClientTokenID              = "InvalidClientTokenId"
 	InvalidInstanceID                 = "InvalidInstanceID.NotFound"
 	InvalidSubnet                     = "InvalidSubnet"
 	LaunchTemplateNameNotFound        = "InvalidLaunchTemplateName.NotFoundException"
 	LoadBalancerNotFound              = "LoadBalancerNotFound"
+	MaxSpotInstanceCountExceeded      = "MaxSpotInstanceCountExceeded"
 	NATGatewayNotFound                = "InvalidNatGatewayID.NotFound"
 	//nolint:gosec
 	NoCredentialProviders                   = "NoCredentialProviders"
@@ -53,13 +57,16 @@ const (
 	ResourceExists                          = "ResourceExistsException"
 	ResourceNotFound                        = "InvalidResourceID.NotFound"
 	RouteTableNotFound                      = "InvalidRouteTableID.NotFound"
+	SpotMaxPriceTooLow                      = "SpotMaxPriceTooLow"
 	SubnetNotFound                          = "InvalidSubnetID.NotFound"
+	Unsupported                             = "Unsupported"
 	UnrecognizedClientException             = "UnrecognizedClientException"
 	UnauthorizedOperation                   = "UnauthorizedOperation"
 	VPCNotFound                             = "InvalidVpcID.NotFound"
 	VPCMissingParameter                     = "MissingParameter"
 	ErrCodeRepositoryAlreadyExistsException = "RepositoryAlreadyExistsException"
 	ASGNotFound                             = "AutoScalingGroup.NotFound"
+	WriteFrozen                             = "WriteFrozen"
 )
 
 var _ error = &EC2Error{}
@@ -139,6 +146,19 @@ func IsRepositoryExists(err error) bool {
 	return false
 }
 
+// IsWriteFrozen returns true if the AWS API call was rejected because the cluster is in a write freeze.
+func IsWriteFrozen(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == WriteFrozen
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == WriteFrozen
+	}
+	return false
+}
+
 // NewFailedDependency returns an error which indicates that a dependency failure status.
 func NewFailedDependency(msg string) error {
 	return &EC2Error{
@@ -185,6 +205,44 @@ func IsInvalidNotFoundError(err error) bool {
 			return true
 		case ASGNotFound:
 			return true
+		case ImageNotFound:
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInsufficientInstanceCapacity returns whether the error is InsufficientInstanceCapacity.
+func IsInsufficientInstanceCapacity(err error) bool {
+	if code, ok := Code(err); ok {
+		return code == InsufficientInstanceCapacity
+	}
+
+	return false
+}
+
+// IsInstanceConstraintError tests for the errors returned when an instance cannot be launched because of the
+// capacity of its instance type, the instance type not being supported in the availability zone, or the lack of
+// free addresses in its subnet.
+func IsInstanceConstraintError(err error) bool {
+	if code, ok := Code(err); ok {
+		switch code {
+		case InsufficientInstanceCapacity, Unsupported, InsufficientFreeAddresses:
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsSpotCapacityError tests for the errors returned when a Spot instance cannot be launched
+// because of the available Spot capacity or price.
+func IsSpotCapacityError(err error) bool {
+	if code, ok := Code(err); ok {
+		switch code {
+		case InsufficientInstanceCapacity, MaxSpotInstanceCountExceeded, SpotMaxPriceTooLow:
+			return true
 		}
 	}
 
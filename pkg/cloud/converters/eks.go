@@ -23,6 +23,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
@@ -147,6 +148,20 @@ func TaintEffectFromSDK(effect ekstypes.TaintEffect) (expinfrav1.TaintEffect, er
 	}
 }
 
+// TaintEffectToKubernetes is used to convert a TaintEffect to the Kubernetes taint effect value.
+func TaintEffectToKubernetes(effect expinfrav1.TaintEffect) (corev1.TaintEffect, error) {
+	switch effect {
+	case expinfrav1.TaintEffectNoExecute:
+		return corev1.TaintEffectNoExecute, nil
+	case expinfrav1.TaintEffectPreferNoSchedule:
+		return corev1.TaintEffectPreferNoSchedule, nil
+	case expinfrav1.TaintEffectNoSchedule:
+		return corev1.TaintEffectNoSchedule, nil
+	default:
+		return "", ErrUnknowTaintEffect
+	}
+}
+
 // ConvertSDKToIdentityProvider is used to convert an AWS SDK OIDCIdentityProviderConfig to a CAPA OidcIdentityProviderConfig.
 func ConvertSDKToIdentityProvider(in *ekscontrolplanev1.OIDCIdentityProviderConfig) *identityprovider.OidcIdentityProviderConfig {
 	if in != nil {
@@ -230,6 +245,14 @@ func AMITypeToSDK(amiType expinfrav1.ManagedMachineAMIType) ekstypes.AMITypes {
 		return ekstypes.AMITypesAl2023Arm64Standard
 	case expinfrav1.Al2023x86_64:
 		return ekstypes.AMITypesAl2023X8664Standard
+	case expinfrav1.WindowsCore2019x86_64:
+		return ekstypes.AMITypesWindowsCore2019X8664
+	case expinfrav1.WindowsFull2019x86_64:
+		return ekstypes.AMITypesWindowsFull2019X8664
+	case expinfrav1.WindowsCore2022x86_64:
+		return ekstypes.AMITypesWindowsCore2022X8664
+	case expinfrav1.WindowsFull2022x86_64:
+		return ekstypes.AMITypesWindowsFull2022X8664
 	default:
 		return ekstypes.AMITypesCustom
 	}
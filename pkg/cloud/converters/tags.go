@@ -154,6 +154,17 @@ func MapToSecretsManagerTags(src infrav1.Tags) []*secretsmanager.Tag {
 	return tags
 }
 
+// SSMTagsToMap converts a []*ssm.Tag into a infrav1.Tags.
+func SSMTagsToMap(src []*ssm.Tag) infrav1.Tags {
+	tags := make(infrav1.Tags, len(src))
+
+	for _, t := range src {
+		tags[*t.Key] = *t.Value
+	}
+
+	return tags
+}
+
 // MapToSSMTags converts a infrav1.Tags to a []*ssm.Tag.
 func MapToSSMTags(src infrav1.Tags) []*ssm.Tag {
 	tags := make([]*ssm.Tag, 0, len(src))
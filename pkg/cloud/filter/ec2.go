@@ -55,6 +55,14 @@ func (ec2Filters) Name(name string) *ec2.Filter {
 	}
 }
 
+// Tag returns a filter based on the value of a tag.
+func (ec2Filters) Tag(key, value string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String(fmt.Sprintf("tag:%s", key)),
+		Values: aws.StringSlice([]string{value}),
+	}
+}
+
 // ClusterOwned returns a filter using the Cluster API per-cluster tag where
 // the resource is owned.
 func (ec2Filters) ClusterOwned(clusterName string) *ec2.Filter {
@@ -137,6 +145,14 @@ func (ec2Filters) InstanceStates(states ...string) *ec2.Filter {
 	}
 }
 
+// InstanceID returns a filter based on the id of an instance.
+func (ec2Filters) InstanceID(instanceID string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("instance-id"),
+		Values: aws.StringSlice([]string{instanceID}),
+	}
+}
+
 // VPCStates returns a filter based on the list of states passed in.
 func (ec2Filters) VPCStates(states ...string) *ec2.Filter {
 	return &ec2.Filter{
@@ -173,3 +189,11 @@ func (ec2Filters) SecurityGroupName(name string) *ec2.Filter {
 		Values: aws.StringSlice([]string{name}),
 	}
 }
+
+// RegionName returns a filter based on the name of a region.
+func (ec2Filters) RegionName(name string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("region-name"),
+		Values: aws.StringSlice([]string{name}),
+	}
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+)
+
+// BudgetScope is a scope for use with the AWS Budgets reconciling service.
+type BudgetScope interface {
+	cloud.ClusterScoper
+
+	// Budget returns the AWS Budget configuration of the cluster.
+	Budget() *infrav1.Budget
+
+	// BudgetStatus returns the spend of the AWS Budget recorded in the status of the cluster.
+	BudgetStatus() *infrav1.BudgetStatus
+
+	// SetBudgetStatus sets the spend of the AWS Budget in the status of the cluster.
+	SetBudgetStatus(status *infrav1.BudgetStatus)
+}
@@ -19,6 +19,7 @@ package scope
 import (
 	"context"
 	"fmt"
+	"time"
 
 	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	awsclient "github.com/aws/aws-sdk-go/aws/client"
@@ -31,8 +32,10 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/throttle"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	infrautilconditions "sigs.k8s.io/cluster-api-provider-aws/v2/util/conditions"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/util/system"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/controllers/remote"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 )
@@ -107,6 +110,9 @@ type ClusterScope struct {
 	Cluster    *clusterv1.Cluster
 	AWSCluster *infrav1.AWSCluster
 
+	// bastionHost is the AWSBastionHost referenced by the AWSCluster, if any.
+	bastionHost *infrav1.AWSBastionHost
+
 	session           awsclient.ConfigProvider
 	sessionV2         awsv2.Config
 	serviceLimiters   throttle.ServiceLimiters
@@ -141,19 +147,34 @@ func (s *ClusterScope) SetSubnets(subnets infrav1.Subnets) {
 	s.AWSCluster.Spec.NetworkSpec.Subnets = subnets
 }
 
-// CNIIngressRules returns the CNI spec ingress rules.
+// CNIIngressRules returns the CNI spec ingress rules, including the rules of the CNI profile.
 func (s *ClusterScope) CNIIngressRules() infrav1.CNIIngressRules {
 	if s.AWSCluster.Spec.NetworkSpec.CNI != nil {
-		return s.AWSCluster.Spec.NetworkSpec.CNI.CNIIngressRules
+		return s.AWSCluster.Spec.NetworkSpec.CNI.IngressRules()
 	}
 	return infrav1.CNIIngressRules{}
 }
 
+// AdditionalPublicRoutes returns the additional routes of the public subnets route tables.
+func (s *ClusterScope) AdditionalPublicRoutes() []infrav1.Route {
+	return s.AWSCluster.Spec.NetworkSpec.AdditionalPublicRoutes
+}
+
+// AdditionalPrivateRoutes returns the additional routes of the private subnets route tables.
+func (s *ClusterScope) AdditionalPrivateRoutes() []infrav1.Route {
+	return s.AWSCluster.Spec.NetworkSpec.AdditionalPrivateRoutes
+}
+
 // SecurityGroupOverrides returns the cluster security group overrides.
 func (s *ClusterScope) SecurityGroupOverrides() map[infrav1.SecurityGroupRole]string {
 	return s.AWSCluster.Spec.NetworkSpec.SecurityGroupOverrides
 }
 
+// SecurityGroupEgress returns the cluster security group egress configuration.
+func (s *ClusterScope) SecurityGroupEgress() map[infrav1.SecurityGroupRole]infrav1.SecurityGroupEgressSpec {
+	return s.AWSCluster.Spec.NetworkSpec.SecurityGroupEgress
+}
+
 // SecurityGroups returns the cluster security groups as a map, it creates the map if empty.
 func (s *ClusterScope) SecurityGroups() map[infrav1.SecurityGroupRole]infrav1.SecurityGroup {
 	return s.AWSCluster.Status.Network.SecurityGroups
@@ -164,6 +185,11 @@ func (s *ClusterScope) SecondaryCidrBlock() *string {
 	return nil
 }
 
+// PodSubnetTags is currently unimplemented for non-managed clusters.
+func (s *ClusterScope) PodSubnetTags() infrav1.Tags {
+	return nil
+}
+
 // SecondaryCidrBlocks returns the additional CIDR blocks to be associated with the managed VPC.
 func (s *ClusterScope) SecondaryCidrBlocks() []infrav1.VpcCidrBlock {
 	return s.AWSCluster.Spec.NetworkSpec.VPC.SecondaryCidrBlocks
@@ -236,6 +262,52 @@ func (s *ClusterScope) ControlPlaneEndpoint() clusterv1.APIEndpoint {
 	return s.AWSCluster.Spec.ControlPlaneEndpoint
 }
 
+// Observability returns the cluster CloudWatch alarms and dashboard configuration.
+func (s *ClusterScope) Observability() *infrav1.Observability {
+	return s.AWSCluster.Spec.Observability
+}
+
+// CloudProviderConfig returns the cloud provider configuration of the cluster.
+func (s *ClusterScope) CloudProviderConfig() *infrav1.CloudProviderConfig {
+	return s.AWSCluster.Spec.CloudProviderConfig
+}
+
+// PrivateDNS returns the private DNS record configuration of the API server endpoint.
+func (s *ClusterScope) PrivateDNS() *infrav1.PrivateDNS {
+	return s.AWSCluster.Spec.PrivateDNS
+}
+
+// SessionManager returns the Session Manager preferences of the cluster.
+func (s *ClusterScope) SessionManager() *infrav1.SessionManager {
+	return s.AWSCluster.Spec.SessionManager
+}
+
+// PrivateHostedZoneID returns the ID of the private hosted zone created with the cluster.
+func (s *ClusterScope) PrivateHostedZoneID() string {
+	return s.AWSCluster.Status.PrivateHostedZoneID
+}
+
+// SetPrivateHostedZoneID records the ID of the private hosted zone created with the cluster.
+func (s *ClusterScope) SetPrivateHostedZoneID(id string) {
+	s.AWSCluster.Status.PrivateHostedZoneID = id
+}
+
+// RemoteClient returns the Kubernetes client for connecting to the workload cluster.
+func (s *ClusterScope) RemoteClient() (client.Client, error) {
+	clusterKey := client.ObjectKey{
+		Name:      s.Name(),
+		Namespace: s.Namespace(),
+	}
+
+	restConfig, err := remote.RESTConfig(context.Background(), s.AWSCluster.Name, s.client, clusterKey)
+	if err != nil {
+		return nil, fmt.Errorf("getting remote rest config for %s/%s: %w", s.Namespace(), s.Name(), err)
+	}
+	restConfig.Timeout = 1 * time.Minute
+
+	return client.New(restConfig, client.Options{Scheme: scheme})
+}
+
 // Bucket returns the cluster bucket configuration.
 func (s *ClusterScope) Bucket() *infrav1.S3Bucket {
 	return s.AWSCluster.Spec.S3Bucket
@@ -273,7 +345,7 @@ func (s *ClusterScope) PatchObject() error {
 			infrav1.VpcEndpointsReadyCondition,
 		)
 
-		if s.AWSCluster.Spec.Bastion.Enabled {
+		if s.Bastion().Enabled {
 			applicableConditions = append(applicableConditions, infrav1.BastionHostReadyCondition)
 		}
 		if s.VPC().IsIPv6Enabled() {
@@ -281,6 +353,11 @@ func (s *ClusterScope) PatchObject() error {
 		}
 	}
 
+	infrautilconditions.SetSummaryCondition(s.AWSCluster, infrav1.InfrastructureReadyCondition,
+		conditions.WithConditions(applicableConditions...),
+		conditions.WithStepCounterIf(s.AWSCluster.ObjectMeta.DeletionTimestamp.IsZero()),
+		conditions.WithStepCounter(),
+	)
 	conditions.SetSummary(s.AWSCluster,
 		conditions.WithConditions(applicableConditions...),
 		conditions.WithStepCounterIf(s.AWSCluster.ObjectMeta.DeletionTimestamp.IsZero()),
@@ -292,6 +369,7 @@ func (s *ClusterScope) PatchObject() error {
 		s.AWSCluster,
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 			clusterv1.ReadyCondition,
+			infrav1.InfrastructureReadyCondition,
 			infrav1.VpcReadyCondition,
 			infrav1.SubnetsReadyCondition,
 			infrav1.InternetGatewayReadyCondition,
@@ -302,6 +380,11 @@ func (s *ClusterScope) PatchObject() error {
 			infrav1.ClusterSecurityGroupsReadyCondition,
 			infrav1.BastionHostReadyCondition,
 			infrav1.LoadBalancerReadyCondition,
+			infrav1.ClusterAdoptedCondition,
+			infrav1.ObservabilityReadyCondition,
+			infrav1.CloudProviderConfigReadyCondition,
+			infrav1.PrivateDNSReadyCondition,
+			infrav1.SessionManagerReadyCondition,
 			infrav1.PrincipalUsageAllowedCondition,
 			infrav1.PrincipalCredentialRetrievedCondition,
 		}})
@@ -335,6 +418,18 @@ func (s *ClusterScope) SetFailureDomain(id string, spec clusterv1.FailureDomainS
 		s.AWSCluster.Status.FailureDomains = make(clusterv1.FailureDomains)
 	}
 	s.AWSCluster.Status.FailureDomains[id] = spec
+	s.AWSCluster.Status.FailureDomainCount = int32(len(s.AWSCluster.Status.FailureDomains)) //#nosec G115
+}
+
+// SetFailureDomains replaces the infrastructure provider failure domains with the ones given as input.
+func (s *ClusterScope) SetFailureDomains(domains clusterv1.FailureDomains) {
+	s.AWSCluster.Status.FailureDomains = domains
+	s.AWSCluster.Status.FailureDomainCount = int32(len(domains)) //#nosec G115
+}
+
+// FailureDomains returns the infrastructure provider failure domains of the cluster.
+func (s *ClusterScope) FailureDomains() clusterv1.FailureDomains {
+	return s.AWSCluster.Status.FailureDomains
 }
 
 // SetNatGatewaysIPs sets the Nat Gateways Public IPs.
@@ -377,9 +472,45 @@ func (s *ClusterScope) ServiceLimiter(service string) *throttle.ServiceLimiter {
 
 // Bastion returns the bastion details.
 func (s *ClusterScope) Bastion() *infrav1.Bastion {
+	if s.HostsBastionHost() {
+		return s.bastionHost.Bastion()
+	}
 	return &s.AWSCluster.Spec.Bastion
 }
 
+// BastionHost returns the AWSBastionHost referenced by the cluster, if any.
+func (s *ClusterScope) BastionHost() *infrav1.AWSBastionHost {
+	return s.bastionHost
+}
+
+// SetBastionHost sets the AWSBastionHost referenced by the cluster.
+func (s *ClusterScope) SetBastionHost(bastionHost *infrav1.AWSBastionHost) {
+	s.bastionHost = bastionHost
+}
+
+// HostsBastionHost returns true if the cluster creates the instance of the AWSBastionHost it references.
+func (s *ClusterScope) HostsBastionHost() bool {
+	return s.bastionHost != nil && s.bastionHost.Spec.ClusterName == s.Name()
+}
+
+// bastionHostIngressRule returns the rule allowing SSH from the AWSBastionHost referenced by the cluster, when
+// the bastion host is created by another cluster of the same VPC.
+func (s *ClusterScope) bastionHostIngressRule() *infrav1.IngressRule {
+	if s.bastionHost == nil || s.HostsBastionHost() {
+		return nil
+	}
+	if s.bastionHost.Status.SecurityGroupID == "" || s.bastionHost.Status.VPCID != s.VPC().ID {
+		return nil
+	}
+	return &infrav1.IngressRule{
+		Description:            "SSH",
+		Protocol:               infrav1.SecurityGroupProtocolTCP,
+		FromPort:               22,
+		ToPort:                 22,
+		SourceSecurityGroupIDs: []string{s.bastionHost.Status.SecurityGroupID},
+	}
+}
+
 // TagUnmanagedNetworkResources returns if the feature flag tag unmanaged network resources is set.
 func (s *ClusterScope) TagUnmanagedNetworkResources() bool {
 	return s.tagUnmanagedNetworkResources
@@ -390,6 +521,51 @@ func (s *ClusterScope) SetBastionInstance(instance *infrav1.Instance) {
 	s.AWSCluster.Status.Bastion = instance
 }
 
+// InstanceConnectEndpoint returns the EC2 Instance Connect Endpoint details.
+func (s *ClusterScope) InstanceConnectEndpoint() *infrav1.InstanceConnectEndpoint {
+	return s.AWSCluster.Spec.InstanceConnectEndpoint
+}
+
+// InstanceConnectEndpointStatus returns the EC2 Instance Connect Endpoint recorded in the status of the cluster.
+func (s *ClusterScope) InstanceConnectEndpointStatus() *infrav1.InstanceConnectEndpointStatus {
+	return s.AWSCluster.Status.InstanceConnectEndpoint
+}
+
+// SetInstanceConnectEndpoint sets the EC2 Instance Connect Endpoint in the status of the cluster.
+func (s *ClusterScope) SetInstanceConnectEndpoint(endpoint *infrav1.InstanceConnectEndpointStatus) {
+	s.AWSCluster.Status.InstanceConnectEndpoint = endpoint
+}
+
+// GuardDutyRuntimeMonitoring returns the GuardDuty Runtime Monitoring prerequisites of the instances.
+func (s *ClusterScope) GuardDutyRuntimeMonitoring() *infrav1.GuardDutyRuntimeMonitoring {
+	return s.AWSCluster.Spec.GuardDutyRuntimeMonitoring
+}
+
+// GuardDutyRuntimeMonitoringStatus returns the GuardDuty Runtime Monitoring prerequisites recorded in the status of the cluster.
+func (s *ClusterScope) GuardDutyRuntimeMonitoringStatus() *infrav1.GuardDutyRuntimeMonitoringStatus {
+	return s.AWSCluster.Status.GuardDutyRuntimeMonitoring
+}
+
+// SetGuardDutyRuntimeMonitoringStatus sets the GuardDuty Runtime Monitoring prerequisites in the status of the cluster.
+func (s *ClusterScope) SetGuardDutyRuntimeMonitoringStatus(status *infrav1.GuardDutyRuntimeMonitoringStatus) {
+	s.AWSCluster.Status.GuardDutyRuntimeMonitoring = status
+}
+
+// Budget returns the AWS Budget configuration of the cluster.
+func (s *ClusterScope) Budget() *infrav1.Budget {
+	return s.AWSCluster.Spec.Budget
+}
+
+// BudgetStatus returns the spend of the AWS Budget recorded in the status of the cluster.
+func (s *ClusterScope) BudgetStatus() *infrav1.BudgetStatus {
+	return s.AWSCluster.Status.Budget
+}
+
+// SetBudgetStatus sets the spend of the AWS Budget in the status of the cluster.
+func (s *ClusterScope) SetBudgetStatus(status *infrav1.BudgetStatus) {
+	s.AWSCluster.Status.Budget = status
+}
+
 // SSHKeyName returns the SSH key name to use for instances.
 func (s *ClusterScope) SSHKeyName() *string {
 	return s.AWSCluster.Spec.SSHKeyName
@@ -416,6 +592,31 @@ func (s *ClusterScope) ImageLookupBaseOS() string {
 	return s.AWSCluster.Spec.ImageLookupBaseOS
 }
 
+// ControlPlanePlacement returns the placement constraints of the control plane machines.
+func (s *ClusterScope) ControlPlanePlacement() *infrav1.ControlPlanePlacement {
+	return s.AWSCluster.Spec.ControlPlanePlacement
+}
+
+// MaintenanceWindow returns the window outside of which disruptive changes are deferred.
+func (s *ClusterScope) MaintenanceWindow() *infrav1.MaintenanceWindow {
+	return s.AWSCluster.Spec.MaintenanceWindow
+}
+
+// MachineDefaults returns the settings inherited by the machines of the cluster which do not set them.
+func (s *ClusterScope) MachineDefaults() *infrav1.MachineDefaults {
+	return s.AWSCluster.Spec.MachineDefaults
+}
+
+// SetPendingDisruptiveAction records a disruptive action deferred until the next maintenance window in the status of the cluster.
+func (s *ClusterScope) SetPendingDisruptiveAction(actionType infrav1.DisruptiveActionType, target, reason string) {
+	s.AWSCluster.Status.PendingDisruptiveActions.Set(actionType, target, reason)
+}
+
+// ClearPendingDisruptiveAction removes a disruptive action from the pending ones in the status of the cluster.
+func (s *ClusterScope) ClearPendingDisruptiveAction(actionType infrav1.DisruptiveActionType, target string) {
+	s.AWSCluster.Status.PendingDisruptiveActions.Remove(actionType, target)
+}
+
 // Partition returns the cluster partition.
 func (s *ClusterScope) Partition() string {
 	if s.AWSCluster.Spec.Partition == "" {
@@ -426,12 +627,20 @@ func (s *ClusterScope) Partition() string {
 
 // AdditionalControlPlaneIngressRules returns the additional ingress rules for control plane security group.
 func (s *ClusterScope) AdditionalControlPlaneIngressRules() []infrav1.IngressRule {
-	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().AdditionalControlPlaneIngressRules
+	rules := s.AWSCluster.Spec.NetworkSpec.DeepCopy().AdditionalControlPlaneIngressRules
+	if rule := s.bastionHostIngressRule(); rule != nil {
+		rules = append(rules, *rule)
+	}
+	return rules
 }
 
 // AdditionalNodeIngressRules returns the additional ingress rules for the node security group.
 func (s *ClusterScope) AdditionalNodeIngressRules() []infrav1.IngressRule {
-	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().AdditionalNodeIngressRules
+	rules := s.AWSCluster.Spec.NetworkSpec.DeepCopy().AdditionalNodeIngressRules
+	if rule := s.bastionHostIngressRule(); rule != nil {
+		rules = append(rules, *rule)
+	}
+	return rules
 }
 
 // UnstructuredControlPlane returns the unstructured object for the control plane, if any.
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func bastionHost(clusterName string, replicas int32) *infrav1.AWSBastionHost {
+	return &infrav1.AWSBastionHost{
+		ObjectMeta: metav1.ObjectMeta{Name: "bastion"},
+		Spec: infrav1.AWSBastionHostSpec{
+			ClusterName:       clusterName,
+			Replicas:          ptr.To[int32](replicas),
+			AllowedCIDRBlocks: []string{"10.0.0.0/8"},
+			InstanceType:      "t3.small",
+		},
+		Status: infrav1.AWSBastionHostStatus{
+			VPCID:           "vpc-host",
+			SecurityGroupID: "sg-bastion",
+		},
+	}
+}
+
+func TestClusterScopeBastionHost(t *testing.T) {
+	sshRule := infrav1.IngressRule{
+		Description:            "SSH",
+		Protocol:               infrav1.SecurityGroupProtocolTCP,
+		FromPort:               22,
+		ToPort:                 22,
+		SourceSecurityGroupIDs: []string{"sg-bastion"},
+	}
+
+	testCases := []struct {
+		name          string
+		clusterName   string
+		vpcID         string
+		bastionHost   *infrav1.AWSBastionHost
+		expectBastion infrav1.Bastion
+		expectRules   []infrav1.IngressRule
+	}{
+		{
+			name:          "without bastion host",
+			clusterName:   "host",
+			vpcID:         "vpc-host",
+			expectBastion: infrav1.Bastion{},
+		},
+		{
+			name:        "cluster hosting the bastion host",
+			clusterName: "host",
+			vpcID:       "vpc-host",
+			bastionHost: bastionHost("host", 1),
+			expectBastion: infrav1.Bastion{
+				Enabled:           true,
+				AllowedCIDRBlocks: []string{"10.0.0.0/8"},
+				InstanceType:      "t3.small",
+			},
+		},
+		{
+			name:        "cluster hosting a bastion host scaled to zero",
+			clusterName: "host",
+			vpcID:       "vpc-host",
+			bastionHost: bastionHost("host", 0),
+			expectBastion: infrav1.Bastion{
+				AllowedCIDRBlocks: []string{"10.0.0.0/8"},
+				InstanceType:      "t3.small",
+			},
+		},
+		{
+			name:          "cluster sharing the bastion host",
+			clusterName:   "guest",
+			vpcID:         "vpc-host",
+			bastionHost:   bastionHost("host", 1),
+			expectBastion: infrav1.Bastion{},
+			expectRules:   []infrav1.IngressRule{sshRule},
+		},
+		{
+			name:          "cluster sharing a bastion host of another VPC",
+			clusterName:   "guest",
+			vpcID:         "vpc-guest",
+			bastionHost:   bastionHost("host", 1),
+			expectBastion: infrav1.Bastion{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s := &ClusterScope{
+				Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: tc.clusterName}},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{VPC: infrav1.VPCSpec{ID: tc.vpcID}},
+					},
+				},
+			}
+			s.SetBastionHost(tc.bastionHost)
+
+			g.Expect(*s.Bastion()).To(Equal(tc.expectBastion))
+			g.Expect(s.AdditionalControlPlaneIngressRules()).To(Equal(tc.expectRules))
+			g.Expect(s.AdditionalNodeIngressRules()).To(Equal(tc.expectRules))
+		})
+	}
+}
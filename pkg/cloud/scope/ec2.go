@@ -19,6 +19,7 @@ package scope
 import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
 // EC2Scope is the interface for the scope to be used with the ec2 service.
@@ -43,6 +44,18 @@ type EC2Scope interface {
 	// SetBastionInstance sets the bastion instance in the status of the cluster.
 	SetBastionInstance(instance *infrav1.Instance)
 
+	// InstanceConnectEndpoint returns the EC2 Instance Connect Endpoint details for the cluster.
+	InstanceConnectEndpoint() *infrav1.InstanceConnectEndpoint
+
+	// InstanceConnectEndpointStatus returns the EC2 Instance Connect Endpoint recorded in the status of the cluster.
+	InstanceConnectEndpointStatus() *infrav1.InstanceConnectEndpointStatus
+
+	// SetInstanceConnectEndpoint sets the EC2 Instance Connect Endpoint in the status of the cluster.
+	SetInstanceConnectEndpoint(endpoint *infrav1.InstanceConnectEndpointStatus)
+
+	// GuardDutyRuntimeMonitoring returns the GuardDuty Runtime Monitoring prerequisites of the instances of the cluster.
+	GuardDutyRuntimeMonitoring() *infrav1.GuardDutyRuntimeMonitoring
+
 	// SSHKeyName returns the SSH key name to use for instances.
 	SSHKeyName() *string
 
@@ -54,4 +67,16 @@ type EC2Scope interface {
 
 	// ImageLookupBaseOS returns the base operating system name to use when looking up AMIs
 	ImageLookupBaseOS() string
+
+	// FailureDomains returns the infrastructure provider failure domains of the cluster.
+	FailureDomains() clusterv1.FailureDomains
+
+	// ControlPlanePlacement returns the placement constraints of the control plane machines.
+	ControlPlanePlacement() *infrav1.ControlPlanePlacement
+
+	// MaintenanceWindow returns the window outside of which disruptive changes are deferred.
+	MaintenanceWindow() *infrav1.MaintenanceWindow
+
+	// MachineDefaults returns the settings inherited by the machines of the cluster which do not set them.
+	MachineDefaults() *infrav1.MachineDefaults
 }
@@ -19,9 +19,11 @@ package scope
 import (
 	"context"
 	"encoding/base64"
+	"strconv"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
@@ -163,6 +165,16 @@ func (m *MachineScope) SetInstanceID(instanceID string) {
 	m.AWSMachine.Spec.InstanceID = ptr.To[string](instanceID)
 }
 
+// SetInstanceType sets the AWSMachine status instance type selected from the instance requirements.
+func (m *MachineScope) SetInstanceType(instanceType string) {
+	m.AWSMachine.Status.InstanceType = instanceType
+}
+
+// SetAccelerators sets the AWSMachine status accelerators.
+func (m *MachineScope) SetAccelerators(accelerators []infrav1.Accelerator) {
+	m.AWSMachine.Status.Accelerators = accelerators
+}
+
 // GetInstanceState returns the AWSMachine instance state from the status.
 func (m *MachineScope) GetInstanceState() *infrav1.InstanceState {
 	return m.AWSMachine.Status.InstanceState
@@ -173,6 +185,34 @@ func (m *MachineScope) SetInstanceState(v infrav1.InstanceState) {
 	m.AWSMachine.Status.InstanceState = &v
 }
 
+// GetLatestTimelineEvent returns the most recent timeline event of the given type for an instance, if any.
+func (m *MachineScope) GetLatestTimelineEvent(eventType infrav1.TimelineEventType, instanceID string) *infrav1.TimelineEvent {
+	timeline := m.AWSMachine.Status.Timeline
+	for i := len(timeline) - 1; i >= 0; i-- {
+		if timeline[i].Type == eventType && timeline[i].InstanceID == instanceID {
+			return &timeline[i]
+		}
+	}
+	return nil
+}
+
+// RecordTimelineEvent appends an event to the AWSMachine status timeline, unless the most recent event
+// of the same type for the same instance has the same reason. Only the most recent events are kept.
+func (m *MachineScope) RecordTimelineEvent(event infrav1.TimelineEvent) {
+	if latest := m.GetLatestTimelineEvent(event.Type, event.InstanceID); latest != nil && latest.Reason == event.Reason {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = metav1.Now()
+	}
+
+	timeline := append(m.AWSMachine.Status.Timeline, event)
+	if len(timeline) > infrav1.MaxTimelineEvents {
+		timeline = timeline[len(timeline)-infrav1.MaxTimelineEvents:]
+	}
+	m.AWSMachine.Status.Timeline = timeline
+}
+
 // SetReady sets the AWSMachine Ready Status.
 func (m *MachineScope) SetReady() {
 	m.AWSMachine.Status.Ready = true
@@ -204,7 +244,7 @@ func (m *MachineScope) SetAnnotation(key, value string) {
 // UseSecretsManager returns the computed value of whether or not
 // userdata should be stored using AWS Secrets Manager.
 func (m *MachineScope) UseSecretsManager(userDataFormat string) bool {
-	return !m.AWSMachine.Spec.CloudInit.InsecureSkipSecretsManager && !m.UseIgnition(userDataFormat)
+	return !m.AWSMachine.Spec.CloudInit.InsecureSkipSecretsManager && !m.UseIgnition(userDataFormat) && !m.UseBottlerocket(userDataFormat)
 }
 
 // UseIgnition returns true if the AWSMachine should use Ignition.
@@ -212,6 +252,11 @@ func (m *MachineScope) UseIgnition(userDataFormat string) bool {
 	return userDataFormat == "ignition" || (m.AWSMachine.Spec.Ignition != nil)
 }
 
+// UseBottlerocket returns true if the bootstrap data are Bottlerocket settings, which are not run by cloud-init.
+func (m *MachineScope) UseBottlerocket(userDataFormat string) bool {
+	return userDataFormat == "bottlerocket"
+}
+
 // SecureSecretsBackend returns the chosen secret backend.
 func (m *MachineScope) SecureSecretsBackend() infrav1.SecretBackend {
 	return m.AWSMachine.Spec.CloudInit.SecureSecretsBackend
@@ -325,6 +370,8 @@ func (m *MachineScope) PatchObject() error {
 			infrav1.InstanceReadyCondition,
 			infrav1.SecurityGroupsReadyCondition,
 			infrav1.ELBAttachedCondition,
+			infrav1.NoScheduledEventsCondition,
+			infrav1.VCPUQuotaAvailableCondition,
 		}})
 }
 
@@ -340,12 +387,50 @@ func (m *MachineScope) AdditionalTags() infrav1.Tags {
 
 	// Start with the cluster-wide tags...
 	tags.Merge(m.InfraCluster.AdditionalTags())
+	// ... then the tags inherited by the machines of the cluster...
+	if defaults := m.InfraCluster.MachineDefaults(); defaults != nil {
+		tags.Merge(defaults.AdditionalTags)
+	}
 	// ... and merge in the Machine's
 	tags.Merge(m.AWSMachine.Spec.AdditionalTags)
 
+	if patchManagement := m.AWSMachine.Spec.PatchManagement; patchManagement != nil && patchManagement.PatchGroup != "" {
+		tags[infrav1.PatchGroupTagKey] = patchManagement.PatchGroup
+	}
+
+	setGuardDutyManagedTag(tags, m.InfraCluster.GuardDutyRuntimeMonitoring())
+
 	return tags
 }
 
+// setGuardDutyManagedTag includes or excludes the instances from the automated configuration of the GuardDuty
+// security agent, unless the GuardDuty tag is already set on the cluster or on the machines.
+func setGuardDutyManagedTag(tags infrav1.Tags, guardDuty *infrav1.GuardDutyRuntimeMonitoring) {
+	if guardDuty == nil || !guardDuty.Enabled {
+		return
+	}
+	if _, ok := tags[infrav1.GuardDutyManagedTagKey]; !ok {
+		tags[infrav1.GuardDutyManagedTagKey] = strconv.FormatBool(guardDuty.AgentManagement != infrav1.GuardDutyAgentManagementManual)
+	}
+}
+
+// InstanceMetadataOptions returns the metadata options of the instance, falling back to the ones inherited
+// from the cluster.
+func (m *MachineScope) InstanceMetadataOptions() *infrav1.InstanceMetadataOptions {
+	return instanceMetadataOptionsWithDefaults(m.AWSMachine.Spec.InstanceMetadataOptions, m.InfraCluster.MachineDefaults())
+}
+
+// AdditionalSecurityGroups returns the additional security groups of the instance, including the ones
+// inherited from the cluster.
+func (m *MachineScope) AdditionalSecurityGroups() []infrav1.AWSResourceReference {
+	return additionalSecurityGroupsWithDefaults(m.AWSMachine.Spec.AdditionalSecurityGroups, m.InfraCluster.MachineDefaults())
+}
+
+// RootVolume returns the root volume of the instance, encrypted as inherited from the cluster.
+func (m *MachineScope) RootVolume() *infrav1.Volume {
+	return rootVolumeWithDefaults(m.AWSMachine.Spec.RootVolume, m.InfraCluster.MachineDefaults())
+}
+
 // HasFailed returns the failure state of the machine scope.
 func (m *MachineScope) HasFailed() bool {
 	return m.AWSMachine.Status.FailureReason != nil || m.AWSMachine.Status.FailureMessage != nil
@@ -409,11 +494,25 @@ func (m *MachineScope) IsExternallyManaged() bool {
 
 // SetInterruptible sets the AWSMachine status Interruptible.
 func (m *MachineScope) SetInterruptible() {
-	if m.AWSMachine.Spec.SpotMarketOptions != nil {
+	if m.AWSMachine.Spec.SpotMarketOptions != nil && !m.IsSpotFallbackOnDemand() {
 		m.AWSMachine.Status.Interruptible = true
 	}
 }
 
+// IsSpotFallbackOnDemand returns true when the Spot AWSMachine fell back to on-demand instances.
+func (m *MachineScope) IsSpotFallbackOnDemand() bool {
+	return m.AWSMachine.Status.SpotFallback != nil && m.AWSMachine.Status.SpotFallback.OnDemand
+}
+
+// SetSpotFallbackOnDemand records the fallback of the Spot AWSMachine to on-demand instances.
+func (m *MachineScope) SetSpotFallbackOnDemand(reason infrav1.SpotFallbackReason) {
+	if m.AWSMachine.Status.SpotFallback == nil {
+		m.AWSMachine.Status.SpotFallback = &infrav1.SpotFallbackStatus{}
+	}
+	m.AWSMachine.Status.SpotFallback.OnDemand = true
+	m.AWSMachine.Status.SpotFallback.Reason = reason
+}
+
 // GetElasticIPPool returns the Elastic IP Pool for an machine, when exists.
 func (m *MachineScope) GetElasticIPPool() *infrav1.ElasticIPPool {
 	if m.AWSMachine == nil {
@@ -18,6 +18,7 @@ package scope
 
 import (
 	"encoding/base64"
+	"fmt"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -258,6 +259,17 @@ func TestUseSecretsManagerTrue(t *testing.T) {
 	}
 }
 
+func TestUseSecretsManagerFalseWithBottlerocket(t *testing.T) {
+	scope, err := setupMachineScope()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if scope.UseSecretsManager("bottlerocket") {
+		t.Fatalf("UseSecretsManager should be false")
+	}
+}
+
 func TestUseIgnition(t *testing.T) {
 	t.Run("returns_true_when_given_bootstrap_data_format_is_ignition", func(t *testing.T) {
 		scope, err := setupMachineScope()
@@ -341,3 +353,118 @@ func TestSetProviderID(t *testing.T) {
 		t.Fatalf("Expected providerID %s, got %s", expectedProviderID, providerID)
 	}
 }
+
+func TestRecordTimelineEvent(t *testing.T) {
+	scope, err := setupMachineScope()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scope.RecordTimelineEvent(infrav1.TimelineEvent{Type: infrav1.TimelineEventTypeStateTransition, InstanceID: "i-1", Reason: "pending"})
+	scope.RecordTimelineEvent(infrav1.TimelineEvent{Type: infrav1.TimelineEventTypeStateTransition, InstanceID: "i-1", Reason: "pending"})
+	scope.RecordTimelineEvent(infrav1.TimelineEvent{Type: infrav1.TimelineEventTypeImpairment, InstanceID: "i-1", Reason: "InstanceStatusImpaired"})
+	scope.RecordTimelineEvent(infrav1.TimelineEvent{Type: infrav1.TimelineEventTypeStateTransition, InstanceID: "i-1", Reason: "running"})
+	scope.RecordTimelineEvent(infrav1.TimelineEvent{Type: infrav1.TimelineEventTypeImpairment, InstanceID: "i-1", Reason: "InstanceStatusImpaired"})
+
+	timeline := scope.AWSMachine.Status.Timeline
+	if len(timeline) != 3 {
+		t.Fatalf("Expected 3 timeline events, got %d", len(timeline))
+	}
+	if timeline[0].Time.IsZero() {
+		t.Fatalf("Expected the event time to be set")
+	}
+	if latest := scope.GetLatestTimelineEvent(infrav1.TimelineEventTypeStateTransition, "i-1"); latest == nil || latest.Reason != "running" {
+		t.Fatalf("Expected the latest state transition to be running, got %v", latest)
+	}
+	if latest := scope.GetLatestTimelineEvent(infrav1.TimelineEventTypeStateTransition, "i-2"); latest != nil {
+		t.Fatalf("Expected no state transition for another instance, got %v", latest)
+	}
+
+	for i := 0; i < infrav1.MaxTimelineEvents; i++ {
+		scope.RecordTimelineEvent(infrav1.TimelineEvent{Type: infrav1.TimelineEventTypeStateTransition, InstanceID: "i-1", Reason: fmt.Sprintf("state-%d", i)})
+	}
+	timeline = scope.AWSMachine.Status.Timeline
+	if len(timeline) != infrav1.MaxTimelineEvents {
+		t.Fatalf("Expected %d timeline events, got %d", infrav1.MaxTimelineEvents, len(timeline))
+	}
+	if last := timeline[len(timeline)-1].Reason; last != fmt.Sprintf("state-%d", infrav1.MaxTimelineEvents-1) {
+		t.Fatalf("Expected the newest event to be kept, got %s", last)
+	}
+}
+
+func TestMachineDefaults(t *testing.T) {
+	scope, err := setupMachineScope()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if options := scope.InstanceMetadataOptions(); options != nil {
+		t.Fatalf("Expected no metadata options without machine defaults, got %v", options)
+	}
+	if volume := scope.RootVolume(); volume != nil {
+		t.Fatalf("Expected no root volume without machine defaults, got %v", volume)
+	}
+
+	scope.InfraCluster.(*ClusterScope).AWSCluster.Spec.AdditionalTags = infrav1.Tags{"team": "platform", "env": "cluster"}
+	scope.InfraCluster.(*ClusterScope).AWSCluster.Spec.MachineDefaults = &infrav1.MachineDefaults{
+		InstanceMetadataOptions: &infrav1.InstanceMetadataOptions{HTTPTokens: infrav1.HTTPTokensStateRequired},
+		AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+			{ID: ptr.To("sg-machine")},
+			{ID: ptr.To("sg-default")},
+		},
+		AdditionalTags:       infrav1.Tags{"env": "machines", "cost-center": "1234"},
+		RootVolumeEncryption: &infrav1.RootVolumeEncryption{EncryptionKey: "alias/ebs"},
+	}
+	scope.AWSMachine.Spec.AdditionalSecurityGroups = []infrav1.AWSResourceReference{{ID: ptr.To("sg-machine")}}
+	scope.AWSMachine.Spec.AdditionalTags = infrav1.Tags{"cost-center": "5678"}
+
+	if options := scope.InstanceMetadataOptions(); options == nil || options.HTTPTokens != infrav1.HTTPTokensStateRequired {
+		t.Fatalf("Expected the metadata options of the cluster, got %v", options)
+	}
+	if groups := scope.AdditionalSecurityGroups(); len(groups) != 2 || *groups[0].ID != "sg-machine" || *groups[1].ID != "sg-default" {
+		t.Fatalf("Expected the security groups of the machine followed by the other ones of the cluster, got %v", groups)
+	}
+	wantTags := infrav1.Tags{"team": "platform", "env": "machines", "cost-center": "5678"}
+	if tags := scope.AdditionalTags(); len(tags.Difference(wantTags)) != 0 || len(wantTags.Difference(tags)) != 0 {
+		t.Fatalf("Expected tags %v, got %v", wantTags, tags)
+	}
+	if volume := scope.RootVolume(); volume == nil || !ptr.Deref(volume.Encrypted, false) || volume.EncryptionKey != "alias/ebs" || volume.Size != 0 {
+		t.Fatalf("Expected an encrypted root volume of the size of the image, got %v", volume)
+	}
+
+	scope.AWSMachine.Spec.InstanceMetadataOptions = &infrav1.InstanceMetadataOptions{HTTPTokens: infrav1.HTTPTokensStateOptional}
+	scope.AWSMachine.Spec.RootVolume = &infrav1.Volume{Size: 50, Encrypted: ptr.To(false)}
+	if options := scope.InstanceMetadataOptions(); options.HTTPTokens != infrav1.HTTPTokensStateOptional {
+		t.Fatalf("Expected the metadata options of the machine, got %v", options)
+	}
+	if volume := scope.RootVolume(); ptr.Deref(volume.Encrypted, true) || volume.EncryptionKey != "" {
+		t.Fatalf("Expected the root volume of the machine, got %v", volume)
+	}
+}
+
+func TestMachineGuardDutyTags(t *testing.T) {
+	scope, err := setupMachineScope()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := scope.AdditionalTags()[infrav1.GuardDutyManagedTagKey]; ok {
+		t.Fatal("Expected no GuardDuty tag without GuardDuty Runtime Monitoring")
+	}
+
+	awsCluster := scope.InfraCluster.(*ClusterScope).AWSCluster
+	awsCluster.Spec.GuardDutyRuntimeMonitoring = &infrav1.GuardDutyRuntimeMonitoring{Enabled: true}
+	if value := scope.AdditionalTags()[infrav1.GuardDutyManagedTagKey]; value != "true" {
+		t.Fatalf("Expected the instance to be managed by GuardDuty, got %q", value)
+	}
+
+	awsCluster.Spec.GuardDutyRuntimeMonitoring.AgentManagement = infrav1.GuardDutyAgentManagementManual
+	if value := scope.AdditionalTags()[infrav1.GuardDutyManagedTagKey]; value != "false" {
+		t.Fatalf("Expected the instance to be excluded from GuardDuty, got %q", value)
+	}
+
+	scope.AWSMachine.Spec.AdditionalTags = infrav1.Tags{infrav1.GuardDutyManagedTagKey: "true"}
+	if value := scope.AdditionalTags()[infrav1.GuardDutyManagedTagKey]; value != "true" {
+		t.Fatalf("Expected the GuardDuty tag of the machine, got %q", value)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// instanceMetadataOptionsWithDefaults returns the given metadata options, or the default ones of the cluster
+// when they are not set.
+func instanceMetadataOptionsWithDefaults(options *infrav1.InstanceMetadataOptions, defaults *infrav1.MachineDefaults) *infrav1.InstanceMetadataOptions {
+	if options != nil || defaults == nil || defaults.InstanceMetadataOptions == nil {
+		return options
+	}
+	return defaults.InstanceMetadataOptions.DeepCopy()
+}
+
+// additionalSecurityGroupsWithDefaults returns the given additional security groups, followed by the default
+// additional security groups of the cluster which are not already part of them, as the security groups of a
+// network interface cannot be modified with duplicate IDs.
+func additionalSecurityGroupsWithDefaults(groups []infrav1.AWSResourceReference, defaults *infrav1.MachineDefaults) []infrav1.AWSResourceReference {
+	if defaults == nil || len(defaults.AdditionalSecurityGroups) == 0 {
+		return groups
+	}
+
+	ids := make(map[string]struct{}, len(groups))
+	for _, group := range groups {
+		if group.ID != nil {
+			ids[*group.ID] = struct{}{}
+		}
+	}
+	out := make([]infrav1.AWSResourceReference, 0, len(groups)+len(defaults.AdditionalSecurityGroups))
+	out = append(out, groups...)
+	for i := range defaults.AdditionalSecurityGroups {
+		group := defaults.AdditionalSecurityGroups[i]
+		if group.ID != nil {
+			if _, ok := ids[*group.ID]; ok {
+				continue
+			}
+		}
+		out = append(out, *group.DeepCopy())
+	}
+	return out
+}
+
+// rootVolumeWithDefaults returns the given root volume, encrypted as requested by the defaults of the cluster
+// when it does not set whether it is encrypted. A root volume which is only set to be encrypted keeps the
+// size of the root volume of the image.
+func rootVolumeWithDefaults(volume *infrav1.Volume, defaults *infrav1.MachineDefaults) *infrav1.Volume {
+	if defaults == nil || defaults.RootVolumeEncryption == nil {
+		return volume
+	}
+	if volume != nil && (volume.Encrypted != nil || volume.EncryptionKey != "") {
+		return volume
+	}
+
+	out := &infrav1.Volume{}
+	if volume != nil {
+		out = volume.DeepCopy()
+	}
+	out.Encrypted = ptr.To(true)
+	out.EncryptionKey = defaults.RootVolumeEncryption.EncryptionKey
+	return out
+}
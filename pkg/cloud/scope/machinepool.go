@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -164,9 +165,15 @@ func (m *MachinePoolScope) AdditionalTags() infrav1.Tags {
 
 	// Start with the cluster-wide tags...
 	tags.Merge(m.InfraCluster.AdditionalTags())
+	// ... then the tags inherited by the machines of the cluster...
+	if defaults := m.InfraCluster.MachineDefaults(); defaults != nil {
+		tags.Merge(defaults.AdditionalTags)
+	}
 	// ... and merge in the Machine's
 	tags.Merge(m.AWSMachinePool.Spec.AdditionalTags)
 
+	setGuardDutyManagedTag(tags, m.InfraCluster.GuardDutyRuntimeMonitoring())
+
 	return tags
 }
 
@@ -178,6 +185,8 @@ func (m *MachinePoolScope) PatchObject() error {
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 			expinfrav1.ASGReadyCondition,
 			expinfrav1.LaunchTemplateReadyCondition,
+			expinfrav1.EncryptionKeysReadyCondition,
+			infrav1.VCPUQuotaAvailableCondition,
 		}})
 }
 
@@ -291,19 +300,59 @@ func (m *MachinePoolScope) SubnetIDs(subnetIDs []string) ([]string, error) {
 	})
 }
 
+// ExcludedAvailabilityZones returns the availability zones whose subnets are excluded from the Auto Scaling group:
+// the availability zones with a weight of 0 and the availability zones excluded in the status of the AWSMachinePool.
+func (m *MachinePoolScope) ExcludedAvailabilityZones() sets.Set[string] {
+	excluded := sets.New[string]()
+	placement := m.AWSMachinePool.Spec.AvailabilityZonePlacement
+	if placement == nil {
+		return excluded
+	}
+
+	for _, weight := range placement.Weights {
+		if weight.Weight == 0 {
+			excluded.Insert(weight.AvailabilityZone)
+		}
+	}
+	for _, zone := range m.AWSMachinePool.Status.AvailabilityZones {
+		if zone.Excluded {
+			excluded.Insert(zone.Name)
+		}
+	}
+	return excluded
+}
+
 // NodeStatus represents the status of a Kubernetes node.
 type NodeStatus struct {
 	Ready   bool
 	Version string
+	// NotReadySince is the time the node last transitioned to not ready, nil while the node is ready.
+	NotReadySince *metav1.Time
+	// ScaleDownDisabled is true when the node has the scale down disabled annotation of the cluster autoscaler.
+	ScaleDownDisabled bool
 }
 
 // UpdateInstanceStatuses ties ASG instances and Node status data together and updates AWSMachinePool
-// This updates if ASG instances ready and kubelet version running on the node..
-func (m *MachinePoolScope) UpdateInstanceStatuses(ctx context.Context, instances []infrav1.Instance) error {
+// This updates if ASG instances ready and kubelet version running on the node, as well as the
+// Auto Scaling attributes and the market type of the instances.
+func (m *MachinePoolScope) UpdateInstanceStatuses(ctx context.Context, instances []expinfrav1.AutoScalingGroupInstance, marketTypes map[string]infrav1.MarketType) error {
 	providerIDs := make([]string, len(instances))
+	instanceStatuses := make([]expinfrav1.AWSMachinePoolInstanceStatus, len(instances))
 	for i, instance := range instances {
 		providerIDs[i] = fmt.Sprintf("aws:////%s", instance.ID)
+		instanceStatuses[i] = expinfrav1.AWSMachinePoolInstanceStatus{
+			InstanceID:            instance.ID,
+			LifecycleState:        instance.LifecycleState,
+			HealthStatus:          instance.HealthStatus,
+			AvailabilityZone:      instance.AvailabilityZone,
+			InstanceType:          instance.InstanceType,
+			LaunchTemplateVersion: instance.LaunchTemplateVersion,
+			MarketType:            marketTypes[instance.ID],
+			ProtectedFromScaleIn:  instance.ProtectedFromScaleIn,
+		}
 	}
+	// The instance inventory is recorded even if the nodes of the workload cluster cannot be listed.
+	m.AWSMachinePool.Status.Instances = instanceStatuses
 
 	nodeStatusByProviderID, err := m.getNodeStatusByProviderID(ctx, providerIDs)
 	if err != nil {
@@ -311,15 +360,12 @@ func (m *MachinePoolScope) UpdateInstanceStatuses(ctx context.Context, instances
 	}
 
 	var readyReplicas int32
-	instanceStatuses := make([]expinfrav1.AWSMachinePoolInstanceStatus, len(instances))
-	for i, instance := range instances {
-		instanceStatuses[i] = expinfrav1.AWSMachinePoolInstanceStatus{
-			InstanceID: instance.ID,
-		}
-
-		instanceStatus := instanceStatuses[i]
-		if nodeStatus, ok := nodeStatusByProviderID[fmt.Sprintf("aws:////%s", instanceStatus.InstanceID)]; ok {
+	for i := range instanceStatuses {
+		instanceStatus := &instanceStatuses[i]
+		if nodeStatus, ok := nodeStatusByProviderID[providerIDs[i]]; ok && nodeStatus.Version != "" {
 			instanceStatus.Version = &nodeStatus.Version
+			instanceStatus.NodeNotReadySince = nodeStatus.NotReadySince
+			instanceStatus.ScaleDownDisabled = nodeStatus.ScaleDownDisabled
 			if nodeStatus.Ready {
 				readyReplicas++
 			}
@@ -327,7 +373,6 @@ func (m *MachinePoolScope) UpdateInstanceStatuses(ctx context.Context, instances
 	}
 
 	// TODO: readyReplicas can be used as status.replicas but this will delay machinepool to become ready. next reconcile updates this.
-	m.AWSMachinePool.Status.Instances = instanceStatuses
 	return nil
 }
 
@@ -352,8 +397,9 @@ func (m *MachinePoolScope) getNodeStatusByProviderID(ctx context.Context, provid
 			strList := strings.Split(node.Spec.ProviderID, "/")
 
 			if status, ok := nodeStatusMap[fmt.Sprintf("aws:////%s", strList[len(strList)-1])]; ok {
-				status.Ready = nodeIsReady(node)
+				status.Ready, status.NotReadySince = nodeReadiness(node)
 				status.Version = node.Status.NodeInfo.KubeletVersion
+				status.ScaleDownDisabled = node.Annotations[expinfrav1.ScaleDownDisabledAnnotation] == "true"
 			}
 		}
 
@@ -365,18 +411,33 @@ func (m *MachinePoolScope) getNodeStatusByProviderID(ctx context.Context, provid
 	return nodeStatusMap, nil
 }
 
-func nodeIsReady(node corev1.Node) bool {
+// nodeReadiness returns whether the node is ready and, if it is not, the time it last transitioned to not ready.
+// A node without a Ready condition is considered not ready since its creation.
+func nodeReadiness(node corev1.Node) (bool, *metav1.Time) {
 	for _, n := range node.Status.Conditions {
 		if n.Type == corev1.NodeReady {
-			return n.Status == corev1.ConditionTrue
+			if n.Status == corev1.ConditionTrue {
+				return true, nil
+			}
+			return false, n.LastTransitionTime.DeepCopy()
 		}
 	}
-	return false
+	return false, node.CreationTimestamp.DeepCopy()
 }
 
 // GetLaunchTemplate returns the launch template.
+// The settings the launch template does not set are inherited from the cluster.
 func (m *MachinePoolScope) GetLaunchTemplate() *expinfrav1.AWSLaunchTemplate {
-	return &m.AWSMachinePool.Spec.AWSLaunchTemplate
+	defaults := m.InfraCluster.MachineDefaults()
+	if defaults == nil {
+		return &m.AWSMachinePool.Spec.AWSLaunchTemplate
+	}
+
+	lt := m.AWSMachinePool.Spec.AWSLaunchTemplate.DeepCopy()
+	lt.InstanceMetadataOptions = instanceMetadataOptionsWithDefaults(lt.InstanceMetadataOptions, defaults)
+	lt.AdditionalSecurityGroups = additionalSecurityGroupsWithDefaults(lt.AdditionalSecurityGroups, defaults)
+	lt.RootVolume = rootVolumeWithDefaults(lt.RootVolume, defaults)
+	return lt
 }
 
 // GetMachinePool returns the machine pool object.
@@ -398,3 +459,14 @@ func (m *MachinePoolScope) GetRuntimeObject() runtime.Object {
 func (m *MachinePoolScope) GetLifecycleHooks() []expinfrav1.AWSLifecycleHook {
 	return m.AWSMachinePool.Spec.AWSLifecycleHooks
 }
+
+// GetScheduledActions returns the desired scheduled actions for the ASG.
+func (m *MachinePoolScope) GetScheduledActions() []expinfrav1.AWSScheduledAction {
+	return m.AWSMachinePool.Spec.ScheduledActions
+}
+
+// SizeManagedBySchedule returns true when scheduled actions change the sizes of the ASG. The minimum, maximum and
+// desired sizes of an existing ASG are then not reconciled with the AWSMachinePool and the MachinePool.
+func (m *MachinePoolScope) SizeManagedBySchedule() bool {
+	return m.AWSMachinePool != nil && len(m.AWSMachinePool.Spec.ScheduledActions) > 0
+}
@@ -203,14 +203,24 @@ func (s *ManagedControlPlaneScope) SetSubnets(subnets infrav1.Subnets) {
 	s.ControlPlane.Spec.NetworkSpec.Subnets = subnets
 }
 
-// CNIIngressRules returns the CNI spec ingress rules.
+// CNIIngressRules returns the CNI spec ingress rules, including the rules of the CNI profile.
 func (s *ManagedControlPlaneScope) CNIIngressRules() infrav1.CNIIngressRules {
 	if s.ControlPlane.Spec.NetworkSpec.CNI != nil {
-		return s.ControlPlane.Spec.NetworkSpec.CNI.CNIIngressRules
+		return s.ControlPlane.Spec.NetworkSpec.CNI.IngressRules()
 	}
 	return infrav1.CNIIngressRules{}
 }
 
+// AdditionalPublicRoutes returns the additional routes of the public subnets route tables.
+func (s *ManagedControlPlaneScope) AdditionalPublicRoutes() []infrav1.Route {
+	return s.ControlPlane.Spec.NetworkSpec.AdditionalPublicRoutes
+}
+
+// AdditionalPrivateRoutes returns the additional routes of the private subnets route tables.
+func (s *ManagedControlPlaneScope) AdditionalPrivateRoutes() []infrav1.Route {
+	return s.ControlPlane.Spec.NetworkSpec.AdditionalPrivateRoutes
+}
+
 // SecurityGroups returns the control plane security groups as a map, it creates the map if empty.
 func (s *ManagedControlPlaneScope) SecurityGroups() map[infrav1.SecurityGroupRole]infrav1.SecurityGroup {
 	return s.ControlPlane.Status.Network.SecurityGroups
@@ -221,6 +231,15 @@ func (s *ManagedControlPlaneScope) SecondaryCidrBlock() *string {
 	return s.ControlPlane.Spec.SecondaryCidrBlock
 }
 
+// PodSubnetTags returns the additional tags of the subnets created in the secondary CIDR block for the pods, so
+// that the VPC CNI discovers them when its pod networking is configured.
+func (s *ManagedControlPlaneScope) PodSubnetTags() infrav1.Tags {
+	if s.ControlPlane.Spec.VpcCni.PodNetworking == nil {
+		return nil
+	}
+	return infrav1.Tags{infrav1.NameKubernetesCNISubnetRole: "1"}
+}
+
 // SecondaryCidrBlocks returns the additional CIDR blocks to be associated with the managed VPC.
 func (s *ManagedControlPlaneScope) SecondaryCidrBlocks() []infrav1.VpcCidrBlock {
 	return s.ControlPlane.Spec.NetworkSpec.VPC.SecondaryCidrBlocks
@@ -248,6 +267,11 @@ func (s *ManagedControlPlaneScope) SecurityGroupOverrides() map[infrav1.Security
 	return s.ControlPlane.Spec.NetworkSpec.SecurityGroupOverrides
 }
 
+// SecurityGroupEgress returns the egress configuration of the security groups in the ControlPlane spec.
+func (s *ManagedControlPlaneScope) SecurityGroupEgress() map[infrav1.SecurityGroupRole]infrav1.SecurityGroupEgressSpec {
+	return s.ControlPlane.Spec.NetworkSpec.SecurityGroupEgress
+}
+
 // Name returns the CAPI cluster name.
 func (s *ManagedControlPlaneScope) Name() string {
 	return s.Cluster.Name
@@ -281,6 +305,7 @@ func (s *ManagedControlPlaneScope) PatchObject() error {
 		context.TODO(),
 		s.ControlPlane,
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
+			infrav1.InfrastructureReadyCondition,
 			infrav1.VpcReadyCondition,
 			infrav1.SubnetsReadyCondition,
 			infrav1.ClusterSecurityGroupsReadyCondition,
@@ -322,6 +347,7 @@ func (s *ManagedControlPlaneScope) SetFailureDomain(id string, spec clusterv1.Fa
 		s.ControlPlane.Status.FailureDomains = make(clusterv1.FailureDomains)
 	}
 	s.ControlPlane.Status.FailureDomains[id] = spec
+	s.ControlPlane.Status.FailureDomainCount = int32(len(s.ControlPlane.Status.FailureDomains)) //#nosec G115
 }
 
 // InfraCluster returns the AWS infrastructure cluster or control plane object.
@@ -365,6 +391,28 @@ func (s *ManagedControlPlaneScope) SetBastionInstance(instance *infrav1.Instance
 	s.ControlPlane.Status.Bastion = instance
 }
 
+// InstanceConnectEndpoint returns the EC2 Instance Connect Endpoint details.
+// For ManagedControlPlane this is always nil, as we don't support EC2 Instance Connect Endpoints for managed clusters.
+func (s *ManagedControlPlaneScope) InstanceConnectEndpoint() *infrav1.InstanceConnectEndpoint {
+	return nil
+}
+
+// InstanceConnectEndpointStatus returns the EC2 Instance Connect Endpoint recorded in the status of the cluster.
+// For ManagedControlPlane this is always nil, as we don't support EC2 Instance Connect Endpoints for managed clusters.
+func (s *ManagedControlPlaneScope) InstanceConnectEndpointStatus() *infrav1.InstanceConnectEndpointStatus {
+	return nil
+}
+
+// SetInstanceConnectEndpoint is a no-op for ManagedControlPlane, as we don't support EC2 Instance Connect Endpoints for managed clusters.
+func (s *ManagedControlPlaneScope) SetInstanceConnectEndpoint(_ *infrav1.InstanceConnectEndpointStatus) {
+}
+
+// GuardDutyRuntimeMonitoring returns the GuardDuty Runtime Monitoring prerequisites of the instances.
+// For ManagedControlPlane this is always nil, as the GuardDuty security agent is installed with the EKS add-on.
+func (s *ManagedControlPlaneScope) GuardDutyRuntimeMonitoring() *infrav1.GuardDutyRuntimeMonitoring {
+	return nil
+}
+
 // SSHKeyName returns the SSH key name to use for instances.
 func (s *ManagedControlPlaneScope) SSHKeyName() *string {
 	return s.ControlPlane.Spec.SSHKeyName
@@ -391,6 +439,15 @@ func (s *ManagedControlPlaneScope) KubernetesClusterName() string {
 	return s.ControlPlane.Spec.EKSClusterName
 }
 
+// KubernetesVersion returns the Kubernetes version of the EKS control plane, or its desired version
+// when the control plane has not been created yet.
+func (s *ManagedControlPlaneScope) KubernetesVersion() *string {
+	if s.ControlPlane.Status.Version != nil {
+		return s.ControlPlane.Status.Version
+	}
+	return s.ControlPlane.Spec.Version
+}
+
 // EnableIAM indicates that reconciliation should create IAM roles.
 func (s *ManagedControlPlaneScope) EnableIAM() bool {
 	return s.enableIAM
@@ -416,6 +473,37 @@ func (s *ManagedControlPlaneScope) ImageLookupBaseOS() string {
 	return s.ControlPlane.Spec.ImageLookupBaseOS
 }
 
+// FailureDomains returns the infrastructure provider failure domains of the control plane.
+func (s *ManagedControlPlaneScope) FailureDomains() clusterv1.FailureDomains {
+	return s.ControlPlane.Status.FailureDomains
+}
+
+// ControlPlanePlacement returns the placement constraints of the control plane machines.
+// For ManagedControlPlane this is always nil, as EKS places the control plane.
+func (s *ManagedControlPlaneScope) ControlPlanePlacement() *infrav1.ControlPlanePlacement {
+	return nil
+}
+
+// MaintenanceWindow returns the window outside of which disruptive changes are deferred.
+// For ManagedControlPlane this is always nil, as disruptive changes are applied as soon as they are requested.
+func (s *ManagedControlPlaneScope) MaintenanceWindow() *infrav1.MaintenanceWindow {
+	return nil
+}
+
+// MachineDefaults returns the settings inherited by the machines of the cluster which do not set them.
+// For ManagedControlPlane this is always nil, as the machines do not inherit settings from the control plane.
+func (s *ManagedControlPlaneScope) MachineDefaults() *infrav1.MachineDefaults {
+	return nil
+}
+
+// SetPendingDisruptiveAction is a no-op for ManagedControlPlane, as it has no maintenance window.
+func (s *ManagedControlPlaneScope) SetPendingDisruptiveAction(_ infrav1.DisruptiveActionType, _, _ string) {
+}
+
+// ClearPendingDisruptiveAction is a no-op for ManagedControlPlane, as it has no maintenance window.
+func (s *ManagedControlPlaneScope) ClearPendingDisruptiveAction(_ infrav1.DisruptiveActionType, _ string) {
+}
+
 // IAMAuthConfig returns the IAM authenticator config. The returned value will never be nil.
 func (s *ManagedControlPlaneScope) IAMAuthConfig() *ekscontrolplanev1.IAMAuthenticatorConfig {
 	if s.ControlPlane.Spec.IAMAuthenticatorConfig == nil {
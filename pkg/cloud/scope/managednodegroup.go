@@ -284,6 +284,8 @@ func (s *ManagedMachinePoolScope) PatchObject() error {
 		s.ManagedMachinePool,
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 			expinfrav1.EKSNodegroupReadyCondition,
+			expinfrav1.EKSNodegroupConfigInSyncCondition,
+			expinfrav1.EKSNodegroupUpdatedCondition,
 			expinfrav1.IAMNodegroupRolesReadyCondition,
 		}})
 }
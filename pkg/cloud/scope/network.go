@@ -35,6 +35,10 @@ type NetworkScope interface {
 	SetSubnets(subnets infrav1.Subnets)
 	// CNIIngressRules returns the CNI spec ingress rules.
 	CNIIngressRules() infrav1.CNIIngressRules
+	// AdditionalPublicRoutes returns the additional routes of the public subnets route tables.
+	AdditionalPublicRoutes() []infrav1.Route
+	// AdditionalPrivateRoutes returns the additional routes of the private subnets route tables.
+	AdditionalPrivateRoutes() []infrav1.Route
 	// SecurityGroups returns the cluster security groups as a map, it creates the map if empty.
 	SecurityGroups() map[infrav1.SecurityGroupRole]infrav1.SecurityGroup
 	// SecondaryCidrBlock returns the optional secondary CIDR block to use for pod IPs. This may later be renamed since
@@ -45,6 +49,8 @@ type NetworkScope interface {
 	// AllSecondaryCidrBlocks returns a unique list of all secondary CIDR blocks (combining `SecondaryCidrBlock` and
 	// `SecondaryCidrBlocks`).
 	AllSecondaryCidrBlocks() []infrav1.VpcCidrBlock
+	// PodSubnetTags returns the additional tags of the subnets created in the secondary CIDR block for the pods.
+	PodSubnetTags() infrav1.Tags
 
 	// Bastion returns the bastion details for the cluster.
 	Bastion() *infrav1.Bastion
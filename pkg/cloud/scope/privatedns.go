@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+)
+
+// PrivateDNSScope is the interface for the scope to be used with the private DNS service.
+type PrivateDNSScope interface {
+	cloud.ClusterScoper
+
+	// VPC returns the cluster VPC.
+	VPC() *infrav1.VPCSpec
+	// Network returns the cluster network object.
+	Network() *infrav1.NetworkStatus
+	// PrivateDNS returns the private DNS record configuration of the API server endpoint.
+	PrivateDNS() *infrav1.PrivateDNS
+	// PrivateHostedZoneID returns the ID of the private hosted zone created with the cluster.
+	PrivateHostedZoneID() string
+	// SetPrivateHostedZoneID records the ID of the private hosted zone created with the cluster.
+	SetPrivateHostedZoneID(id string)
+}
@@ -34,6 +34,9 @@ type SGScope interface {
 	// SecurityGroupOverrides returns the security groups that are used as overrides in the cluster spec
 	SecurityGroupOverrides() map[infrav1.SecurityGroupRole]string
 
+	// SecurityGroupEgress returns the egress configuration of the managed security groups, by role.
+	SecurityGroupEgress() map[infrav1.SecurityGroupRole]infrav1.SecurityGroupEgressSpec
+
 	// VPC returns the cluster VPC.
 	VPC() *infrav1.VPCSpec
 
@@ -43,6 +46,9 @@ type SGScope interface {
 	// Bastion returns the bastion details for the cluster.
 	Bastion() *infrav1.Bastion
 
+	// InstanceConnectEndpoint returns the EC2 Instance Connect Endpoint details for the cluster.
+	InstanceConnectEndpoint() *infrav1.InstanceConnectEndpoint
+
 	// ControlPlaneLoadBalancer returns the load balancer settings that are requested.
 	// Deprecated: Use ControlPlaneLoadBalancers()
 	ControlPlaneLoadBalancer() *infrav1.AWSLoadBalancerSpec
@@ -65,4 +71,13 @@ type SGScope interface {
 
 	// NodePortIngressRuleCidrBlocks returns the CIDR blocks for the node NodePort ingress rules.
 	NodePortIngressRuleCidrBlocks() []string
+
+	// MaintenanceWindow returns the window outside of which disruptive changes are deferred.
+	MaintenanceWindow() *infrav1.MaintenanceWindow
+
+	// SetPendingDisruptiveAction records a disruptive action deferred until the next maintenance window in the status of the cluster.
+	SetPendingDisruptiveAction(actionType infrav1.DisruptiveActionType, target, reason string)
+
+	// ClearPendingDisruptiveAction removes a disruptive action from the pending ones in the status of the cluster.
+	ClearPendingDisruptiveAction(actionType infrav1.DisruptiveActionType, target string)
 }
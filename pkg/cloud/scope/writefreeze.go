@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// readOnlyOperationPrefixes are the prefixes of the AWS API operations which do not change
+// any resource, and are allowed during a write freeze.
+var readOnlyOperationPrefixes = []string{"Describe", "Get", "List", "Head", "Lookup", "Search"}
+
+// readOnlyOperations are the AWS API operations which do not follow the naming of the read-only
+// operations, but are needed to keep reading resources during a write freeze.
+var readOnlyOperations = map[string]bool{
+	"AssumeRole":                 true,
+	"AssumeRoleWithWebIdentity":  true,
+	"DecodeAuthorizationMessage": true,
+	"ReceiveMessage":             true,
+}
+
+func isReadOnlyOperation(name string) bool {
+	if readOnlyOperations[name] {
+		return true
+	}
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeFreezeSource returns the object carrying the write freeze annotation for the clients of a
+// scope: the infrastructure cluster when the scope knows it, the target of the client otherwise.
+func writeFreezeSource(scopeUser cloud.ScopeUsage, target runtime.Object) metav1.Object {
+	if s, ok := scopeUser.(interface{ InfraCluster() cloud.ClusterObject }); ok {
+		if infraCluster := s.InfraCluster(); infraCluster != nil {
+			return infraCluster
+		}
+	}
+	obj, err := meta.Accessor(target)
+	if err != nil {
+		return nil
+	}
+	return obj
+}
+
+// writeFreezeError returns the error for a mutating operation made while the source is frozen.
+func writeFreezeError(source metav1.Object, operation string) (string, bool) {
+	if source == nil || isReadOnlyOperation(operation) {
+		return "", false
+	}
+	until, frozen, err := infrav1.WriteFreezeUntil(source, time.Now())
+	if err != nil || !frozen {
+		return "", false
+	}
+	return fmt.Sprintf("operation %s is not allowed while AWS writes are frozen until %s", operation, until.Format(time.RFC3339)), true
+}
+
+// rejectFrozenWrites returns a request handler failing mutating AWS API calls while the cluster
+// is in a write freeze.
+func rejectFrozenWrites(scopeUser cloud.ScopeUsage, target runtime.Object) request.NamedHandler {
+	source := writeFreezeSource(scopeUser, target)
+	return request.NamedHandler{
+		Name: "capa/write-freeze",
+		Fn: func(r *request.Request) {
+			if msg, frozen := writeFreezeError(source, r.Operation.Name); frozen {
+				record.Warnf(target, awserrors.WriteFrozen, "Operation %s was not sent to AWS, writes are frozen", r.Operation.Name)
+				r.Error = awserr.New(awserrors.WriteFrozen, msg, nil)
+			}
+		},
+	}
+}
+
+// withWriteFreezeMiddleware returns a middleware failing mutating AWS API calls made with the AWS
+// GO SDK V2 while the cluster is in a write freeze.
+func withWriteFreezeMiddleware(scopeUser cloud.ScopeUsage, target runtime.Object) func(*middleware.Stack) error {
+	source := writeFreezeSource(scopeUser, target)
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("capa/WriteFreezeMiddleware", func(ctx context.Context, input middleware.InitializeInput, handler middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			operation := awsmiddleware.GetOperationName(ctx)
+			if msg, frozen := writeFreezeError(source, operation); frozen {
+				record.Warnf(target, awserrors.WriteFrozen, "Operation %s was not sent to AWS, writes are frozen", operation)
+				return middleware.InitializeOutput{}, middleware.Metadata{}, &smithy.GenericAPIError{Code: awserrors.WriteFrozen, Message: msg, Fault: smithy.FaultClient}
+			}
+			return handler.HandleInitialize(ctx, input)
+		}), middleware.After)
+	}
+}
+
+// isWriteFrozen returns true if the error, or every error of an aggregate such as the ones
+// returned when deleting a cluster, was caused by a write freeze. Aggregates are walked since
+// they do not unwrap their errors.
+func isWriteFrozen(err error) bool {
+	var agg kerrors.Aggregate
+	if !errors.As(err, &agg) {
+		return awserrors.IsWriteFrozen(err)
+	}
+	for _, e := range agg.Errors() {
+		if !isWriteFrozen(e) {
+			return false
+		}
+	}
+	return len(agg.Errors()) > 0
+}
+
+// RequeueOnWriteFreeze turns the error of a reconciliation stopped by a write freeze on the object
+// into a requeue once the freeze ends, so that the freeze is not reported as a failure. Errors
+// which were not all caused by the freeze are returned.
+func RequeueOnWriteFreeze(obj metav1.Object, result reconcile.Result, err error) (reconcile.Result, error) {
+	if !isWriteFrozen(err) {
+		return result, err
+	}
+	until, frozen, parseErr := infrav1.WriteFreezeUntil(obj, time.Now())
+	if parseErr != nil || !frozen {
+		return result, err
+	}
+	return reconcile.Result{RequeueAfter: time.Until(until)}, nil
+}
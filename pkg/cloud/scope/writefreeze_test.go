@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+)
+
+type writeFreezeScope struct {
+	infraCluster *infrav1.AWSCluster
+}
+
+func (s writeFreezeScope) ControllerName() string {
+	return "awsmachine"
+}
+
+func (s writeFreezeScope) InfraCluster() cloud.ClusterObject {
+	return s.infraCluster
+}
+
+func frozenAWSCluster(until time.Time) *infrav1.AWSCluster {
+	return &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-cluster",
+			Annotations: map[string]string{
+				infrav1.WriteFreezeUntilAnnotation: until.Format(time.RFC3339),
+			},
+		},
+	}
+}
+
+func TestRejectFrozenWrites(t *testing.T) {
+	testCases := []struct {
+		name         string
+		infraCluster *infrav1.AWSCluster
+		operation    string
+		expectFrozen bool
+	}{
+		{
+			name:         "mutating call without write freeze",
+			infraCluster: &infrav1.AWSCluster{},
+			operation:    "RunInstances",
+		},
+		{
+			name:         "mutating call during write freeze",
+			infraCluster: frozenAWSCluster(time.Now().Add(time.Hour)),
+			operation:    "RunInstances",
+			expectFrozen: true,
+		},
+		{
+			name:         "read-only call during write freeze",
+			infraCluster: frozenAWSCluster(time.Now().Add(time.Hour)),
+			operation:    "DescribeInstances",
+		},
+		{
+			name:         "role assumption during write freeze",
+			infraCluster: frozenAWSCluster(time.Now().Add(time.Hour)),
+			operation:    "AssumeRole",
+		},
+		{
+			name:         "mutating call after write freeze",
+			infraCluster: frozenAWSCluster(time.Now().Add(-time.Hour)),
+			operation:    "RunInstances",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			// The freeze is read from the infrastructure cluster, not from the target of the client.
+			handler := rejectFrozenWrites(writeFreezeScope{infraCluster: tc.infraCluster}, &infrav1.AWSMachine{})
+			r := request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{Name: tc.operation}, nil, nil)
+			handler.Fn(r)
+
+			if !tc.expectFrozen {
+				g.Expect(r.Error).To(BeNil())
+				return
+			}
+			g.Expect(awserrors.IsWriteFrozen(r.Error)).To(BeTrue())
+		})
+	}
+}
+
+func TestRequeueOnWriteFreeze(t *testing.T) {
+	frozenErr := awserr.New(awserrors.WriteFrozen, "frozen", nil)
+	testCases := []struct {
+		name          string
+		infraCluster  *infrav1.AWSCluster
+		err           error
+		expectErr     bool
+		expectRequeue bool
+	}{
+		{
+			name:         "no error",
+			infraCluster: frozenAWSCluster(time.Now().Add(time.Hour)),
+		},
+		{
+			name:         "other errors are returned",
+			infraCluster: frozenAWSCluster(time.Now().Add(time.Hour)),
+			err:          errors.New("failed"),
+			expectErr:    true,
+		},
+		{
+			name:          "write freeze errors are requeued",
+			infraCluster:  frozenAWSCluster(time.Now().Add(time.Hour)),
+			err:           frozenErr,
+			expectRequeue: true,
+		},
+		{
+			name:          "write freeze errors from the AWS GO SDK V2 are requeued",
+			infraCluster:  frozenAWSCluster(time.Now().Add(time.Hour)),
+			err:           &smithy.GenericAPIError{Code: awserrors.WriteFrozen},
+			expectRequeue: true,
+		},
+		{
+			name:          "aggregated write freeze errors are requeued",
+			infraCluster:  frozenAWSCluster(time.Now().Add(time.Hour)),
+			err:           kerrors.NewAggregate([]error{pkgerrors.Wrap(frozenErr, "error deleting load balancers"), pkgerrors.Wrap(frozenErr, "error deleting network")}),
+			expectRequeue: true,
+		},
+		{
+			name:         "aggregated errors not all caused by the write freeze are returned",
+			infraCluster: frozenAWSCluster(time.Now().Add(time.Hour)),
+			err:          kerrors.NewAggregate([]error{pkgerrors.Wrap(frozenErr, "error deleting load balancers"), errors.New("failed")}),
+			expectErr:    true,
+		},
+		{
+			name:         "write freeze errors after the freeze ended are returned",
+			infraCluster: frozenAWSCluster(time.Now().Add(-time.Hour)),
+			err:          frozenErr,
+			expectErr:    true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result, err := RequeueOnWriteFreeze(tc.infraCluster, reconcile.Result{}, tc.err)
+			g.Expect(err != nil).To(Equal(tc.expectErr))
+			if tc.expectRequeue {
+				g.Expect(result.RequeueAfter).To(BeNumerically(">", 59*time.Minute))
+			} else {
+				g.Expect(result.RequeueAfter).To(BeZero())
+			}
+		})
+	}
+}
@@ -0,0 +1,384 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adoption
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	elbsdk "github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	rgapi "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/elb"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// loadBalancerResourceType is the resource type of the load balancers in the Resource Groups Tagging API.
+const loadBalancerResourceType = "elasticloadbalancing:loadbalancer"
+
+// discovery accumulates the outcome of the discovery of the existing resources of a cluster.
+type discovery struct {
+	status infrav1.AdoptionStatus
+
+	// vpcID is the ID of the discovered VPC, if any.
+	vpcID string
+	// vpcSource is how the VPC was discovered.
+	vpcSource infrav1.AdoptionSource
+	// taggedSubnetIDs are the IDs of the subnets discovered from the adoption tags.
+	taggedSubnetIDs []string
+}
+
+func (d *discovery) found(kind infrav1.AdoptedResourceKind, id string, source infrav1.AdoptionSource) {
+	d.status.Resources = append(d.status.Resources, infrav1.AdoptedResource{Kind: kind, ID: id, Source: source})
+}
+
+func (d *discovery) change(format string, args ...interface{}) {
+	d.status.Changes = append(d.status.Changes, fmt.Sprintf(format, args...))
+}
+
+// ReconcileAdoption discovers the existing resources of the cluster and records them in its status, along with the
+// changes adopting them makes. In the Adopt mode, the VPC and subnets discovered from their tags are also recorded
+// in the spec of the cluster, so that they are reconciled as existing resources instead of being created.
+func (s *Service) ReconcileAdoption(ctx context.Context) error {
+	adoption := s.scope.AWSCluster.Spec.Adoption
+	if adoption == nil {
+		s.scope.AWSCluster.Status.Adoption = nil
+		return nil
+	}
+
+	s.scope.Debug("Discovering the existing resources of the cluster", "mode", adoption.Mode)
+
+	d := &discovery{}
+	if err := s.discoverVPC(ctx, d); err != nil {
+		return err
+	}
+	if err := s.discoverSubnets(ctx, d); err != nil {
+		return err
+	}
+	if err := s.discoverLoadBalancer(ctx, d); err != nil {
+		return err
+	}
+	if err := s.discoverInstances(ctx, d); err != nil {
+		return err
+	}
+	s.scope.AWSCluster.Status.Adoption = &d.status
+
+	if adoption.Mode != infrav1.AdoptionModeAdopt {
+		return nil
+	}
+
+	if s.scope.VPC().ID == "" && d.vpcID != "" {
+		s.scope.VPC().ID = d.vpcID
+		record.Eventf(s.scope.AWSCluster, "SuccessfulAdoptVPC", "Adopted VPC %q", d.vpcID)
+		s.scope.Info("Adopted VPC", "vpc-id", d.vpcID)
+	}
+	if len(s.scope.Subnets()) == 0 && len(d.taggedSubnetIDs) > 0 {
+		subnets := make(infrav1.Subnets, 0, len(d.taggedSubnetIDs))
+		for _, id := range d.taggedSubnetIDs {
+			subnets = append(subnets, infrav1.SubnetSpec{ID: id})
+		}
+		s.scope.SetSubnets(subnets)
+		record.Eventf(s.scope.AWSCluster, "SuccessfulAdoptSubnets", "Adopted subnets %s", strings.Join(d.taggedSubnetIDs, ", "))
+		s.scope.Info("Adopted subnets", "subnet-ids", d.taggedSubnetIDs)
+	}
+
+	return nil
+}
+
+// discoverVPC discovers the VPC referenced in the spec, or else the only VPC carrying the adoption tags.
+func (s *Service) discoverVPC(ctx context.Context, d *discovery) error {
+	if id := s.scope.VPC().ID; id != "" {
+		out, err := s.EC2Client.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{
+			VpcIds: aws.StringSlice([]string{id}),
+		})
+		if awserrors.IsNotFound(err) || (err == nil && len(out.Vpcs) == 0) {
+			return errors.Errorf("VPC %q referenced in spec.network.vpc.id was not found", id)
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to describe VPC %q", id)
+		}
+		d.vpcID, d.vpcSource = id, infrav1.AdoptionSourceSpec
+		d.found(infrav1.AdoptedResourceKindVPC, id, infrav1.AdoptionSourceSpec)
+		return nil
+	}
+
+	if len(s.adoptionTags()) == 0 {
+		d.change("create a new VPC")
+		return nil
+	}
+
+	out, err := s.EC2Client.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{
+		Filters: s.adoptionTagFilters(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to describe the VPCs carrying the adoption tags")
+	}
+
+	switch len(out.Vpcs) {
+	case 0:
+		d.change("create a new VPC, no VPC carries the adoption tags")
+	case 1:
+		id := aws.StringValue(out.Vpcs[0].VpcId)
+		d.vpcID, d.vpcSource = id, infrav1.AdoptionSourceTags
+		d.found(infrav1.AdoptedResourceKindVPC, id, infrav1.AdoptionSourceTags)
+		d.change("set spec.network.vpc.id to %s", id)
+	default:
+		return errors.Errorf("found %d VPCs carrying the adoption tags, expected at most one", len(out.Vpcs))
+	}
+	return nil
+}
+
+// discoverSubnets discovers the subnets referenced in the spec when the VPC is, or else the subnets of the
+// discovered VPC carrying the adoption tags.
+func (s *Service) discoverSubnets(ctx context.Context, d *discovery) error {
+	if d.vpcID == "" {
+		// The subnets are created with the VPC.
+		return nil
+	}
+
+	if d.vpcSource == infrav1.AdoptionSourceSpec && len(s.scope.Subnets()) > 0 {
+		ids := make([]string, 0, len(s.scope.Subnets()))
+		for _, sn := range s.scope.Subnets() {
+			ids = append(ids, sn.GetResourceID())
+		}
+		out, err := s.EC2Client.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{
+			SubnetIds: aws.StringSlice(ids),
+		})
+		if code, _ := awserrors.Code(err); code == awserrors.SubnetNotFound {
+			return errors.Wrap(err, "subnets referenced in spec.network.subnets were not found")
+		} else if err != nil {
+			return errors.Wrap(err, "failed to describe the subnets referenced in spec.network.subnets")
+		}
+		for _, sn := range out.Subnets {
+			d.found(infrav1.AdoptedResourceKindSubnet, aws.StringValue(sn.SubnetId), infrav1.AdoptionSourceSpec)
+		}
+		return nil
+	}
+
+	out, err := s.EC2Client.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{
+		Filters: append([]*ec2.Filter{filter.EC2.VPC(d.vpcID)}, s.adoptionTagFilters()...),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe the subnets of VPC %q", d.vpcID)
+	}
+	if len(out.Subnets) == 0 {
+		d.change("no subnet to adopt was found in VPC %s", d.vpcID)
+		return nil
+	}
+
+	ids := make([]string, 0, len(out.Subnets))
+	for _, sn := range out.Subnets {
+		ids = append(ids, aws.StringValue(sn.SubnetId))
+	}
+	sort.Strings(ids)
+
+	// Without adoption tags, all the subnets of the VPC are used, as for any existing VPC.
+	source := infrav1.AdoptionSourceVPC
+	if len(s.adoptionTags()) > 0 {
+		source = infrav1.AdoptionSourceTags
+	}
+	for _, id := range ids {
+		d.found(infrav1.AdoptedResourceKindSubnet, id, source)
+	}
+	if source == infrav1.AdoptionSourceTags && len(s.scope.Subnets()) == 0 {
+		d.taggedSubnetIDs = ids
+		d.change("set spec.network.subnets to %s", strings.Join(ids, ", "))
+	}
+	return nil
+}
+
+// discoverLoadBalancer discovers the primary control plane load balancer from its name. Load balancers carrying
+// the adoption tags under another name are reported, but are not adopted, as the name of the control plane load
+// balancer can't be changed once the cluster is created.
+func (s *Service) discoverLoadBalancer(ctx context.Context, d *discovery) error {
+	lbSpec := s.scope.ControlPlaneLoadBalancer()
+	if lbSpec == nil || lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeDisabled {
+		return nil
+	}
+
+	var (
+		name string
+		err  error
+	)
+	if lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeClassic {
+		name, err = elb.ELBName(s.scope)
+	} else {
+		name, err = elb.LBName(s.scope, lbSpec)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to get control plane load balancer name")
+	}
+
+	exists, err := s.loadBalancerExists(ctx, name, lbSpec.LoadBalancerType)
+	if err != nil {
+		return err
+	}
+	if exists {
+		d.found(infrav1.AdoptedResourceKindLoadBalancer, name, infrav1.AdoptionSourceSpec)
+		return nil
+	}
+	d.change("create load balancer %s", name)
+
+	if len(s.adoptionTags()) == 0 {
+		return nil
+	}
+	names, err := s.taggedLoadBalancerNames(ctx)
+	if err != nil {
+		return err
+	}
+	for _, tagged := range names {
+		d.found(infrav1.AdoptedResourceKindLoadBalancer, tagged, infrav1.AdoptionSourceTags)
+		d.change("load balancer %s carries the adoption tags but is not named in spec.controlPlaneLoadBalancer.name, it is not adopted", tagged)
+	}
+	return nil
+}
+
+func (s *Service) loadBalancerExists(ctx context.Context, name string, lbType infrav1.LoadBalancerType) (bool, error) {
+	var (
+		found bool
+		err   error
+	)
+	if lbType == infrav1.LoadBalancerTypeClassic {
+		var out *elbsdk.DescribeLoadBalancersOutput
+		out, err = s.ELBClient.DescribeLoadBalancersWithContext(ctx, &elbsdk.DescribeLoadBalancersInput{
+			LoadBalancerNames: aws.StringSlice([]string{name}),
+		})
+		found = err == nil && len(out.LoadBalancerDescriptions) > 0
+	} else {
+		var out *elbv2.DescribeLoadBalancersOutput
+		out, err = s.ELBV2Client.DescribeLoadBalancersWithContext(ctx, &elbv2.DescribeLoadBalancersInput{
+			Names: aws.StringSlice([]string{name}),
+		})
+		found = err == nil && len(out.LoadBalancers) > 0
+	}
+	if code, _ := awserrors.Code(err); code == awserrors.LoadBalancerNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Wrapf(err, "failed to describe load balancer %q", name)
+	}
+	return found, nil
+}
+
+// taggedLoadBalancerNames returns the names of the load balancers carrying the adoption tags.
+func (s *Service) taggedLoadBalancerNames(ctx context.Context) ([]string, error) {
+	tagFilters := []*rgapi.TagFilter{}
+	for _, key := range s.adoptionTagKeys() {
+		tagFilters = append(tagFilters, &rgapi.TagFilter{
+			Key:    aws.String(key),
+			Values: aws.StringSlice([]string{s.adoptionTags()[key]}),
+		})
+	}
+
+	var names []string
+	var parseErr error
+	err := s.ResourceTaggingClient.GetResourcesPagesWithContext(ctx, &rgapi.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{loadBalancerResourceType}),
+		TagFilters:          tagFilters,
+	}, func(out *rgapi.GetResourcesOutput, _ bool) bool {
+		for _, mapping := range out.ResourceTagMappingList {
+			parsed, err := arn.Parse(aws.StringValue(mapping.ResourceARN))
+			if err != nil {
+				parseErr = errors.Wrapf(err, "failed to parse load balancer ARN %q", aws.StringValue(mapping.ResourceARN))
+				return false
+			}
+			// Classic load balancers are loadbalancer/<name>, the others loadbalancer/<type>/<name>/<id>.
+			parts := strings.Split(parsed.Resource, "/")
+			switch len(parts) {
+			case 2:
+				names = append(names, parts[1])
+			case 4:
+				names = append(names, parts[2])
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the load balancers carrying the adoption tags")
+	}
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// discoverInstances discovers the instances carrying the adoption tags. They are adopted by the AWSMachines
+// referencing them in their spec.
+func (s *Service) discoverInstances(ctx context.Context, d *discovery) error {
+	if len(s.adoptionTags()) == 0 {
+		return nil
+	}
+
+	filters := append(s.adoptionTagFilters(), filter.EC2.InstanceStates(
+		ec2.InstanceStateNamePending,
+		ec2.InstanceStateNameRunning,
+		ec2.InstanceStateNameStopping,
+		ec2.InstanceStateNameStopped,
+	))
+	if d.vpcID != "" {
+		filters = append(filters, filter.EC2.VPC(d.vpcID))
+	}
+
+	var ids []string
+	if err := s.EC2Client.DescribeInstancesPagesWithContext(ctx, &ec2.DescribeInstancesInput{Filters: filters}, func(out *ec2.DescribeInstancesOutput, _ bool) bool {
+		for _, res := range out.Reservations {
+			for _, instance := range res.Instances {
+				ids = append(ids, aws.StringValue(instance.InstanceId))
+			}
+		}
+		return true
+	}); err != nil {
+		return errors.Wrap(err, "failed to describe the instances carrying the adoption tags")
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		d.found(infrav1.AdoptedResourceKindInstance, id, infrav1.AdoptionSourceTags)
+		d.change("reference instance %s in the spec.instanceID of an AWSMachine to adopt it", id)
+	}
+	return nil
+}
+
+func (s *Service) adoptionTags() infrav1.Tags {
+	return s.scope.AWSCluster.Spec.Adoption.Tags
+}
+
+func (s *Service) adoptionTagKeys() []string {
+	keys := make([]string, 0, len(s.adoptionTags()))
+	for key := range s.adoptionTags() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s *Service) adoptionTagFilters() []*ec2.Filter {
+	filters := make([]*ec2.Filter, 0, len(s.adoptionTags()))
+	for _, key := range s.adoptionTagKeys() {
+		filters = append(filters, filter.EC2.Tag(key, s.adoptionTags()[key]))
+	}
+	return filters
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adoption
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	rgapi "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestServiceReconcileAdoption(t *testing.T) {
+	adoptionTags := infrav1.Tags{"owner": "legacy"}
+	tagFilter := filter.EC2.Tag("owner", "legacy")
+
+	expectTaggedResources := func(m *mocks.MockEC2APIMockRecorder, v2 *mocks.MockELBV2APIMockRecorder, rg *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder) {
+		m.DescribeVpcsWithContext(context.TODO(), &ec2.DescribeVpcsInput{Filters: []*ec2.Filter{tagFilter}}).
+			Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-legacy")}}}, nil)
+		m.DescribeSubnetsWithContext(context.TODO(), &ec2.DescribeSubnetsInput{
+			Filters: []*ec2.Filter{filter.EC2.VPC("vpc-legacy"), tagFilter},
+		}).Return(&ec2.DescribeSubnetsOutput{Subnets: []*ec2.Subnet{
+			{SubnetId: aws.String("subnet-b")},
+			{SubnetId: aws.String("subnet-a")},
+		}}, nil)
+		v2.DescribeLoadBalancersWithContext(context.TODO(), &elbv2.DescribeLoadBalancersInput{
+			Names: aws.StringSlice([]string{"legacy-apiserver"}),
+		}).Return(nil, awserr.New(awserrors.LoadBalancerNotFound, "not found", nil))
+		rg.GetResourcesPagesWithContext(context.TODO(), &rgapi.GetResourcesInput{
+			ResourceTypeFilters: aws.StringSlice([]string{loadBalancerResourceType}),
+			TagFilters:          []*rgapi.TagFilter{{Key: aws.String("owner"), Values: aws.StringSlice([]string{"legacy"})}},
+		}, gomock.Any()).DoAndReturn(func(_ context.Context, _ *rgapi.GetResourcesInput, fn func(*rgapi.GetResourcesOutput, bool) bool, _ ...request.Option) error {
+			fn(&rgapi.GetResourcesOutput{ResourceTagMappingList: []*rgapi.ResourceTagMapping{
+				{ResourceARN: aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/legacy-lb/50dc6c495c0c9188")},
+			}}, true)
+			return nil
+		})
+		m.DescribeInstancesPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool, _ ...request.Option) error {
+				fn(&ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{
+					{Instances: []*ec2.Instance{{InstanceId: aws.String("i-legacy")}}},
+				}}, true)
+				return nil
+			})
+	}
+
+	taggedResources := []infrav1.AdoptedResource{
+		{Kind: infrav1.AdoptedResourceKindVPC, ID: "vpc-legacy", Source: infrav1.AdoptionSourceTags},
+		{Kind: infrav1.AdoptedResourceKindSubnet, ID: "subnet-a", Source: infrav1.AdoptionSourceTags},
+		{Kind: infrav1.AdoptedResourceKindSubnet, ID: "subnet-b", Source: infrav1.AdoptionSourceTags},
+		{Kind: infrav1.AdoptedResourceKindLoadBalancer, ID: "legacy-lb", Source: infrav1.AdoptionSourceTags},
+		{Kind: infrav1.AdoptedResourceKindInstance, ID: "i-legacy", Source: infrav1.AdoptionSourceTags},
+	}
+
+	tests := []struct {
+		name          string
+		adoption      *infrav1.Adoption
+		vpcID         string
+		expect        func(m *mocks.MockEC2APIMockRecorder, v2 *mocks.MockELBV2APIMockRecorder, rg *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder)
+		expectError   bool
+		expectStatus  []infrav1.AdoptedResource
+		expectVPCID   string
+		expectSubnets []string
+	}{
+		{
+			name:         "should only report the resources carrying the adoption tags in a dry run",
+			adoption:     &infrav1.Adoption{Mode: infrav1.AdoptionModeDryRun, Tags: adoptionTags},
+			expect:       expectTaggedResources,
+			expectStatus: taggedResources,
+		},
+		{
+			name:          "should record the VPC and subnets carrying the adoption tags in the spec when adopting",
+			adoption:      &infrav1.Adoption{Mode: infrav1.AdoptionModeAdopt, Tags: adoptionTags},
+			expect:        expectTaggedResources,
+			expectStatus:  taggedResources,
+			expectVPCID:   "vpc-legacy",
+			expectSubnets: []string{"subnet-a", "subnet-b"},
+		},
+		{
+			name:     "should fail when the VPC referenced in the spec does not exist",
+			adoption: &infrav1.Adoption{Mode: infrav1.AdoptionModeDryRun},
+			vpcID:    "vpc-missing",
+			expect: func(m *mocks.MockEC2APIMockRecorder, _ *mocks.MockELBV2APIMockRecorder, _ *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder) {
+				m.DescribeVpcsWithContext(context.TODO(), &ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{"vpc-missing"})}).
+					Return(nil, awserr.New(awserrors.VPCNotFound, "not found", nil))
+			},
+			expectError: true,
+		},
+		{
+			name:     "should fail when several VPCs carry the adoption tags",
+			adoption: &infrav1.Adoption{Mode: infrav1.AdoptionModeAdopt, Tags: adoptionTags},
+			expect: func(m *mocks.MockEC2APIMockRecorder, _ *mocks.MockELBV2APIMockRecorder, _ *mocks.MockResourceGroupsTaggingAPIAPIMockRecorder) {
+				m.DescribeVpcsWithContext(context.TODO(), &ec2.DescribeVpcsInput{Filters: []*ec2.Filter{tagFilter}}).
+					Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-1")}, {VpcId: aws.String("vpc-2")}}}, nil)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			elbv2Mock := mocks.NewMockELBV2API(mockCtrl)
+			rgapiMock := mocks.NewMockResourceGroupsTaggingAPIAPI(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: "ns"},
+				Spec: infrav1.AWSClusterSpec{
+					Adoption: tc.adoption,
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{ID: tc.vpcID},
+					},
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						Name:             aws.String("legacy-apiserver"),
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+					},
+				},
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).Build()
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: "ns"},
+				},
+				AWSCluster: awsCluster,
+				Client:     client,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT(), elbv2Mock.EXPECT(), rgapiMock.EXPECT())
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+			s.ELBV2Client = elbv2Mock
+			s.ResourceTaggingClient = rgapiMock
+
+			err = s.ReconcileAdoption(context.TODO())
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(awsCluster.Status.Adoption).NotTo(BeNil())
+			g.Expect(awsCluster.Status.Adoption.Resources).To(Equal(tc.expectStatus))
+			g.Expect(awsCluster.Status.Adoption.Changes).NotTo(BeEmpty())
+			g.Expect(awsCluster.Spec.NetworkSpec.VPC.ID).To(Equal(tc.expectVPCID))
+
+			subnetIDs := []string{}
+			for _, sn := range awsCluster.Spec.NetworkSpec.Subnets {
+				subnetIDs = append(subnetIDs, sn.ID)
+			}
+			if tc.expectSubnets == nil {
+				g.Expect(subnetIDs).To(BeEmpty())
+			} else {
+				g.Expect(subnetIDs).To(Equal(tc.expectSubnets))
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -52,12 +54,18 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*
 		MaxSize:           aws.Int32Value(v.MaxSize), //#nosec G115
 		MinSize:           aws.Int32Value(v.MinSize), //#nosec G115
 		CapacityRebalance: aws.BoolValue(v.CapacityRebalance),
+		HealthCheckType:   aws.StringValue(v.HealthCheckType),
 		// TODO: determine what additional values go here and what else should be in the struct
 	}
 
+	if v.HealthCheckGracePeriod != nil {
+		i.HealthCheckGracePeriod = metav1.Duration{Duration: time.Duration(*v.HealthCheckGracePeriod) * time.Second}
+	}
+
 	if v.VPCZoneIdentifier != nil {
 		i.Subnets = strings.Split(*v.VPCZoneIdentifier, ",")
 	}
+	i.AvailabilityZones = v.AvailabilityZones
 
 	if v.MixedInstancesPolicy != nil {
 		i.MixedInstancesPolicy = &expinfrav1.MixedInstancesPolicy{
@@ -68,7 +76,15 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*
 		}
 
 		for _, override := range v.MixedInstancesPolicy.LaunchTemplate.Overrides {
-			i.MixedInstancesPolicy.Overrides = append(i.MixedInstancesPolicy.Overrides, expinfrav1.Overrides{InstanceType: aws.StringValue(override.InstanceType)})
+			o := expinfrav1.Overrides{InstanceType: aws.StringValue(override.InstanceType)}
+			if weight, err := strconv.ParseInt(aws.StringValue(override.WeightedCapacity), 10, 64); err == nil {
+				o.WeightedCapacity = aws.Int64(weight)
+			}
+			i.MixedInstancesPolicy.Overrides = append(i.MixedInstancesPolicy.Overrides, o)
+		}
+		// An empty maximum price is the On-Demand price, like an unset one.
+		if maxPrice := aws.StringValue(v.MixedInstancesPolicy.InstancesDistribution.SpotMaxPrice); maxPrice != "" {
+			i.MixedInstancesPolicy.InstancesDistribution.SpotMaxPrice = aws.String(maxPrice)
 		}
 
 		onDemandAllocationStrategy := aws.StringValue(v.MixedInstancesPolicy.InstancesDistribution.OnDemandAllocationStrategy)
@@ -85,6 +101,8 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*
 		switch spotAllocationStrategy {
 		case string(expinfrav1.SpotAllocationStrategyLowestPrice):
 			i.MixedInstancesPolicy.InstancesDistribution.SpotAllocationStrategy = expinfrav1.SpotAllocationStrategyLowestPrice
+			// The number of Spot pools is only used by the lowest-price allocation strategy.
+			i.MixedInstancesPolicy.InstancesDistribution.SpotInstancePools = utils.ToInt64Pointer(v.MixedInstancesPolicy.InstancesDistribution.SpotInstancePools)
 		case string(expinfrav1.SpotAllocationStrategyCapacityOptimized):
 			i.MixedInstancesPolicy.InstancesDistribution.SpotAllocationStrategy = expinfrav1.SpotAllocationStrategyCapacityOptimized
 		case string(expinfrav1.SpotAllocationStrategyCapacityOptimizedPrioritized):
@@ -112,6 +130,19 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*
 				AvailabilityZone: *autoscalingInstance.AvailabilityZone,
 			}
 			i.Instances = append(i.Instances, *tmp)
+
+			details := expinfrav1.AutoScalingGroupInstance{
+				ID:                   aws.StringValue(autoscalingInstance.InstanceId),
+				LifecycleState:       string(autoscalingInstance.LifecycleState),
+				HealthStatus:         aws.StringValue(autoscalingInstance.HealthStatus),
+				AvailabilityZone:     aws.StringValue(autoscalingInstance.AvailabilityZone),
+				InstanceType:         aws.StringValue(autoscalingInstance.InstanceType),
+				ProtectedFromScaleIn: aws.BoolValue(autoscalingInstance.ProtectedFromScaleIn),
+			}
+			if autoscalingInstance.LaunchTemplate != nil {
+				details.LaunchTemplateVersion = aws.StringValue(autoscalingInstance.LaunchTemplate.Version)
+			}
+			i.InstanceDetails = append(i.InstanceDetails, details)
 		}
 	}
 
@@ -205,6 +236,10 @@ func (s *Service) CreateASG(machinePoolScope *scope.MachinePoolScope) (*expinfra
 		input.DesiredCapacity = aws.Int32(*desiredCapacity)
 	}
 
+	if healthCheck := machinePoolScope.AWSMachinePool.Spec.HealthCheck; healthCheck != nil {
+		input.HealthCheckType, input.HealthCheckGracePeriod = sdkHealthCheck(healthCheck)
+	}
+
 	if machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy != nil {
 		input.MixedInstancesPolicy = createSDKMixedInstancesPolicy(name, machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy)
 	} else {
@@ -279,18 +314,30 @@ func (s *Service) UpdateASG(machinePoolScope *scope.MachinePoolScope) error {
 
 	input := &autoscaling.UpdateAutoScalingGroupInput{
 		AutoScalingGroupName: aws.String(machinePoolScope.Name()), // TODO: define dynamically - borrow logic from ec2
-		MaxSize:              aws.Int32(machinePoolScope.AWSMachinePool.Spec.MaxSize),
-		MinSize:              aws.Int32(machinePoolScope.AWSMachinePool.Spec.MinSize),
 		VPCZoneIdentifier:    aws.String(strings.Join(subnetIDs, ",")),
 		CapacityRebalance:    aws.Bool(machinePoolScope.AWSMachinePool.Spec.CapacityRebalance),
 	}
 
-	if machinePoolScope.MachinePool.Spec.Replicas != nil && !annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
-		input.DesiredCapacity = aws.Int32(*machinePoolScope.MachinePool.Spec.Replicas)
+	// The sizes of the ASG are left to its scheduled actions, if any.
+	if !machinePoolScope.SizeManagedBySchedule() {
+		input.MaxSize = aws.Int32(machinePoolScope.AWSMachinePool.Spec.MaxSize)
+		input.MinSize = aws.Int32(machinePoolScope.AWSMachinePool.Spec.MinSize)
+
+		if machinePoolScope.MachinePool.Spec.Replicas != nil && !annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+			input.DesiredCapacity = aws.Int32(*machinePoolScope.MachinePool.Spec.Replicas)
+		}
+	}
+
+	if healthCheck := machinePoolScope.AWSMachinePool.Spec.HealthCheck; healthCheck != nil {
+		input.HealthCheckType, input.HealthCheckGracePeriod = sdkHealthCheck(healthCheck)
 	}
 
 	if machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy != nil {
 		input.MixedInstancesPolicy = createSDKMixedInstancesPolicy(machinePoolScope.Name(), machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy)
+		// The maximum price of the Spot instances is only reset to the On-Demand price with an empty one.
+		if distribution := input.MixedInstancesPolicy.InstancesDistribution; distribution != nil && distribution.SpotMaxPrice == nil {
+			distribution.SpotMaxPrice = aws.String("")
+		}
 	} else {
 		input.LaunchTemplate = &autoscalingtypes.LaunchTemplateSpecification{
 			LaunchTemplateId: aws.String(machinePoolScope.AWSMachinePool.Status.LaunchTemplateID),
@@ -354,6 +401,8 @@ func (s *Service) StartASGInstanceRefresh(scope *scope.MachinePoolScope) error {
 			InstanceWarmup:       instanceWarmup,
 			MinHealthyPercentage: minHealthyPercentage,
 			MaxHealthyPercentage: maxHealthyPercentage,
+			// Instances protected from scale in, such as the ones whose node must not be disrupted, are not replaced.
+			ScaleInProtectedInstances: autoscalingtypes.ScaleInProtectedInstancesIgnore,
 		},
 	}
 
@@ -364,6 +413,65 @@ func (s *Service) StartASGInstanceRefresh(scope *scope.MachinePoolScope) error {
 	return nil
 }
 
+// MarkInstanceUnhealthy sets the health status of an instance of an Auto Scaling group to Unhealthy,
+// so that the group replaces it once its health check grace period has elapsed.
+func (s *Service) MarkInstanceUnhealthy(instanceID string) error {
+	input := &autoscaling.SetInstanceHealthInput{
+		InstanceId:               aws.String(instanceID),
+		HealthStatus:             aws.String("Unhealthy"),
+		ShouldRespectGracePeriod: aws.Bool(true),
+	}
+
+	if _, err := s.ASGClient.SetInstanceHealth(context.TODO(), input); err != nil {
+		return errors.Wrapf(err, "failed to set the health status of instance %q", instanceID)
+	}
+
+	return nil
+}
+
+// ReplaceInstance terminates an instance of an Auto Scaling group without decrementing its desired capacity,
+// so that the group launches a replacement in the availability zone with the fewest instances.
+func (s *Service) ReplaceInstance(instanceID string) error {
+	input := &autoscaling.TerminateInstanceInAutoScalingGroupInput{
+		InstanceId:                     aws.String(instanceID),
+		ShouldDecrementDesiredCapacity: aws.Bool(false),
+	}
+
+	if _, err := s.ASGClient.TerminateInstanceInAutoScalingGroup(context.TODO(), input); err != nil {
+		return errors.Wrapf(err, "failed to replace instance %q", instanceID)
+	}
+
+	return nil
+}
+
+// SetInstanceProtection protects the given instances of an Auto Scaling group from termination when
+// scaling in, or removes their protection.
+func (s *Service) SetInstanceProtection(asgName string, instanceIDs []string, protected bool) error {
+	input := &autoscaling.SetInstanceProtectionInput{
+		AutoScalingGroupName: aws.String(asgName),
+		InstanceIds:          instanceIDs,
+		ProtectedFromScaleIn: aws.Bool(protected),
+	}
+
+	if _, err := s.ASGClient.SetInstanceProtection(context.TODO(), input); err != nil {
+		return errors.Wrapf(err, "failed to set the scale in protection of instances %v of ASG %q", instanceIDs, asgName)
+	}
+
+	return nil
+}
+
+// sdkHealthCheck returns the health check type and grace period of an Auto Scaling group,
+// leaving the values which are not set unchanged.
+func sdkHealthCheck(healthCheck *expinfrav1.AutoScalingGroupHealthCheck) (healthCheckType *string, gracePeriod *int32) {
+	if healthCheck.Type != "" {
+		healthCheckType = aws.String(string(healthCheck.Type))
+	}
+	if healthCheck.GracePeriod != nil {
+		gracePeriod = aws.Int32(int32(healthCheck.GracePeriod.Duration.Seconds()))
+	}
+	return healthCheckType, gracePeriod
+}
+
 func createSDKMixedInstancesPolicy(name string, i *expinfrav1.MixedInstancesPolicy) *autoscalingtypes.MixedInstancesPolicy {
 	mixedInstancesPolicy := &autoscalingtypes.MixedInstancesPolicy{
 		LaunchTemplate: &autoscalingtypes.LaunchTemplate{
@@ -380,13 +488,19 @@ func createSDKMixedInstancesPolicy(name string, i *expinfrav1.MixedInstancesPoli
 			OnDemandBaseCapacity:                utils.ToInt32Pointer(i.InstancesDistribution.OnDemandBaseCapacity),
 			OnDemandPercentageAboveBaseCapacity: utils.ToInt32Pointer(i.InstancesDistribution.OnDemandPercentageAboveBaseCapacity),
 			SpotAllocationStrategy:              aws.String(string(i.InstancesDistribution.SpotAllocationStrategy)),
+			SpotInstancePools:                   utils.ToInt32Pointer(i.InstancesDistribution.SpotInstancePools),
+			SpotMaxPrice:                        i.InstancesDistribution.SpotMaxPrice,
 		}
 	}
 
 	for _, override := range i.Overrides {
-		mixedInstancesPolicy.LaunchTemplate.Overrides = append(mixedInstancesPolicy.LaunchTemplate.Overrides, autoscalingtypes.LaunchTemplateOverrides{
+		sdkOverride := autoscalingtypes.LaunchTemplateOverrides{
 			InstanceType: aws.String(override.InstanceType),
-		})
+		}
+		if override.WeightedCapacity != nil {
+			sdkOverride.WeightedCapacity = aws.String(strconv.FormatInt(*override.WeightedCapacity, 10))
+		}
+		mixedInstancesPolicy.LaunchTemplate.Overrides = append(mixedInstancesPolicy.LaunchTemplate.Overrides, sdkOverride)
 	}
 
 	return mixedInstancesPolicy
@@ -544,5 +658,10 @@ func (s *Service) SubnetIDs(scope *scope.MachinePoolScope) ([]string, error) {
 		}
 	}
 
-	return scope.SubnetIDs(subnetIDs)
+	subnetIDs, err := scope.SubnetIDs(subnetIDs)
+	if err != nil {
+		return subnetIDs, err
+	}
+
+	return s.filterSubnetsByAvailabilityZone(scope, subnetIDs)
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
@@ -190,6 +191,50 @@ func TestServiceSDKToAutoScalingGroup(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid input - spot pools, maximum price and weighted capacity",
+			input: &autoscalingtypes.AutoScalingGroup{
+				DesiredCapacity: aws.Int32(1234),
+				MaxSize:         aws.Int32(1234),
+				MinSize:         aws.Int32(1234),
+				MixedInstancesPolicy: &autoscalingtypes.MixedInstancesPolicy{
+					InstancesDistribution: &autoscalingtypes.InstancesDistribution{
+						OnDemandAllocationStrategy: aws.String("prioritized"),
+						SpotAllocationStrategy:     aws.String("lowest-price"),
+						SpotInstancePools:          aws.Int32(3),
+						SpotMaxPrice:               aws.String("0.1"),
+					},
+					LaunchTemplate: &autoscalingtypes.LaunchTemplate{
+						Overrides: []autoscalingtypes.LaunchTemplateOverrides{
+							{
+								InstanceType:     aws.String("t2.medium"),
+								WeightedCapacity: aws.String("2"),
+							},
+						},
+					},
+				},
+			},
+			want: &expinfrav1.AutoScalingGroup{
+				DesiredCapacity: aws.Int32(1234),
+				MaxSize:         int32(1234),
+				MinSize:         int32(1234),
+				MixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{
+					InstancesDistribution: &expinfrav1.InstancesDistribution{
+						OnDemandAllocationStrategy: expinfrav1.OnDemandAllocationStrategyPrioritized,
+						SpotAllocationStrategy:     expinfrav1.SpotAllocationStrategyLowestPrice,
+						SpotInstancePools:          aws.Int64(3),
+						SpotMaxPrice:               aws.String("0.1"),
+					},
+					Overrides: []expinfrav1.Overrides{
+						{
+							InstanceType:     "t2.medium",
+							WeightedCapacity: aws.Int64(2),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "valid input - suspended processes",
 			input: &autoscalingtypes.AutoScalingGroup{
@@ -248,6 +293,13 @@ func TestServiceSDKToAutoScalingGroup(t *testing.T) {
 						InstanceId:       aws.String("instanceId"),
 						LifecycleState:   "lifecycleState",
 						AvailabilityZone: aws.String("us-east-1a"),
+						HealthStatus:     aws.String("Healthy"),
+						InstanceType:     aws.String("t2.medium"),
+						LaunchTemplate: &autoscalingtypes.LaunchTemplateSpecification{
+							LaunchTemplateId: aws.String("lt-1"),
+							Version:          aws.String("3"),
+						},
+						ProtectedFromScaleIn: aws.Bool(true),
 					},
 				},
 			},
@@ -282,6 +334,17 @@ func TestServiceSDKToAutoScalingGroup(t *testing.T) {
 						AvailabilityZone: "us-east-1a",
 					},
 				},
+				InstanceDetails: []expinfrav1.AutoScalingGroupInstance{
+					{
+						ID:                    "instanceId",
+						LifecycleState:        "lifecycleState",
+						HealthStatus:          "Healthy",
+						AvailabilityZone:      "us-east-1a",
+						InstanceType:          "t2.medium",
+						LaunchTemplateVersion: "3",
+						ProtectedFromScaleIn:  true,
+					},
+				},
 			},
 			wantErr: false,
 		},
@@ -744,6 +807,24 @@ func TestServiceUpdateASG(t *testing.T) {
 				m.UpdateAutoScalingGroup(context.TODO(), gomock.AssignableToTypeOf(&autoscaling.UpdateAutoScalingGroupInput{})).Return(nil, awserrors.NewFailedDependency("dependency failure"))
 			},
 		},
+		{
+			name:            "should update the health check of the ASG",
+			machinePoolName: "update-asg-health-check",
+			wantErr:         false,
+			setupMachinePoolScope: func(mps *scope.MachinePoolScope) {
+				mps.AWSMachinePool.Spec.HealthCheck = &expinfrav1.AutoScalingGroupHealthCheck{
+					Type:        expinfrav1.HealthCheckTypeELB,
+					GracePeriod: &metav1.Duration{Duration: 3 * time.Minute},
+				}
+			},
+			expect: func(e *mocks.MockEC2APIMockRecorder, m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder, g *WithT) {
+				m.UpdateAutoScalingGroup(context.TODO(), gomock.AssignableToTypeOf(&autoscaling.UpdateAutoScalingGroupInput{})).DoAndReturn(func(ctx context.Context, input *autoscaling.UpdateAutoScalingGroupInput, options ...request.Option) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+					g.Expect(input.HealthCheckType).To(BeComparableTo(ptr.To("ELB")))
+					g.Expect(input.HealthCheckGracePeriod).To(BeComparableTo(ptr.To[int32](180)))
+					return &autoscaling.UpdateAutoScalingGroupOutput{}, nil
+				})
+			},
+		},
 		{
 			name:            "externally managed replicas annotation",
 			machinePoolName: "update-asg-externally-managed-replicas-annotation",
@@ -790,6 +871,140 @@ func TestServiceUpdateASG(t *testing.T) {
 	}
 }
 
+func TestServiceMarkInstanceUnhealthy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name    string
+		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "should set the health status of the instance respecting its grace period",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.SetInstanceHealth(context.TODO(), gomock.Eq(&autoscaling.SetInstanceHealthInput{
+					InstanceId:               aws.String("i-1"),
+					HealthStatus:             aws.String("Unhealthy"),
+					ShouldRespectGracePeriod: aws.Bool(true),
+				})).Return(&autoscaling.SetInstanceHealthOutput{}, nil)
+			},
+		},
+		{
+			name: "should return an error if the health status cannot be set",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.SetInstanceHealth(context.TODO(), gomock.Any()).Return(nil, &autoscalingtypes.ResourceContentionFault{})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			clusterScope, err := getClusterScope(getFakeClient())
+			g.Expect(err).ToNot(HaveOccurred())
+
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			err = s.MarkInstanceUnhealthy("i-1")
+			checkErr(tt.wantErr, err, g)
+		})
+	}
+}
+
+func TestServiceReplaceInstance(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name    string
+		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "should terminate the instance without decrementing the desired capacity",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.TerminateInstanceInAutoScalingGroup(context.TODO(), gomock.Eq(&autoscaling.TerminateInstanceInAutoScalingGroupInput{
+					InstanceId:                     aws.String("i-1"),
+					ShouldDecrementDesiredCapacity: aws.Bool(false),
+				})).Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil)
+			},
+		},
+		{
+			name: "should return an error if the instance cannot be terminated",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.TerminateInstanceInAutoScalingGroup(context.TODO(), gomock.Any()).Return(nil, &autoscalingtypes.ScalingActivityInProgressFault{})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			clusterScope, err := getClusterScope(getFakeClient())
+			g.Expect(err).ToNot(HaveOccurred())
+
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			err = s.ReplaceInstance("i-1")
+			checkErr(tt.wantErr, err, g)
+		})
+	}
+}
+
+func TestServiceSetInstanceProtection(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name    string
+		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "should set the scale in protection of the instances",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.SetInstanceProtection(context.TODO(), gomock.Eq(&autoscaling.SetInstanceProtectionInput{
+					AutoScalingGroupName: aws.String("asg-1"),
+					InstanceIds:          []string{"i-1", "i-2"},
+					ProtectedFromScaleIn: aws.Bool(true),
+				})).Return(&autoscaling.SetInstanceProtectionOutput{}, nil)
+			},
+		},
+		{
+			name: "should return an error if the scale in protection cannot be set",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.SetInstanceProtection(context.TODO(), gomock.Any()).Return(nil, &autoscalingtypes.ResourceContentionFault{})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			clusterScope, err := getClusterScope(getFakeClient())
+			g.Expect(err).ToNot(HaveOccurred())
+
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			err = s.SetInstanceProtection("asg-1", []string{"i-1", "i-2"}, true)
+			checkErr(tt.wantErr, err, g)
+		})
+	}
+}
+
 func TestServiceUpdateASGWithSubnetFilters(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -1215,9 +1430,10 @@ func TestServiceStartASGInstanceRefresh(t *testing.T) {
 					AutoScalingGroupName: aws.String("mpn"),
 					Strategy:             autoscalingtypes.RefreshStrategyRolling,
 					Preferences: &autoscalingtypes.RefreshPreferences{
-						InstanceWarmup:       aws.Int32(100),
-						MinHealthyPercentage: aws.Int32(80),
-						MaxHealthyPercentage: aws.Int32(100),
+						InstanceWarmup:            aws.Int32(100),
+						MinHealthyPercentage:      aws.Int32(80),
+						MaxHealthyPercentage:      aws.Int32(100),
+						ScaleInProtectedInstances: autoscalingtypes.ScaleInProtectedInstancesIgnore,
 					},
 				})).
 					Return(nil, awserrors.NewNotFound("not found"))
@@ -1231,9 +1447,10 @@ func TestServiceStartASGInstanceRefresh(t *testing.T) {
 					AutoScalingGroupName: aws.String("mpn"),
 					Strategy:             autoscalingtypes.RefreshStrategyRolling,
 					Preferences: &autoscalingtypes.RefreshPreferences{
-						InstanceWarmup:       aws.Int32(100),
-						MinHealthyPercentage: aws.Int32(80),
-						MaxHealthyPercentage: aws.Int32(100),
+						InstanceWarmup:            aws.Int32(100),
+						MinHealthyPercentage:      aws.Int32(80),
+						MaxHealthyPercentage:      aws.Int32(100),
+						ScaleInProtectedInstances: autoscalingtypes.ScaleInProtectedInstancesIgnore,
 					},
 				})).
 					Return(&autoscaling.StartInstanceRefreshOutput{}, nil)
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// capacityErrorMessages are the status messages of the scaling activities that failed to launch
+// an instance because of insufficient capacity.
+var capacityErrorMessages = []string{
+	awserrors.InsufficientInstanceCapacity,
+	"do not have sufficient",
+	"no Spot capacity available",
+}
+
+// GetCapacityErrors returns the last time the Auto Scaling group failed to launch an instance
+// because of insufficient capacity, keyed by availability zone.
+func (s *Service) GetCapacityErrors(asgName string) (map[string]time.Time, error) {
+	out, err := s.ASGClient.DescribeScalingActivities(context.TODO(), &autoscaling.DescribeScalingActivitiesInput{
+		AutoScalingGroupName: aws.String(asgName),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe scaling activities for AutoScalingGroup: %q", asgName)
+	}
+
+	capacityErrors := map[string]time.Time{}
+	for _, activity := range out.Activities {
+		if activity.StatusCode != autoscalingtypes.ScalingActivityStatusCodeFailed || !isCapacityErrorMessage(aws.StringValue(activity.StatusMessage)) {
+			continue
+		}
+
+		// The details of a scaling activity are a JSON object with the subnet and availability zone of the instance.
+		details := map[string]string{}
+		if err := json.Unmarshal([]byte(aws.StringValue(activity.Details)), &details); err != nil {
+			s.scope.Debug("Skipping scaling activity with unexpected details", "activity", aws.StringValue(activity.ActivityId), "details", aws.StringValue(activity.Details))
+			continue
+		}
+		zone := details["Availability Zone"]
+		if zone == "" || activity.StartTime == nil {
+			continue
+		}
+		if last, ok := capacityErrors[zone]; !ok || activity.StartTime.After(last) {
+			capacityErrors[zone] = *activity.StartTime
+		}
+	}
+
+	return capacityErrors, nil
+}
+
+func isCapacityErrorMessage(message string) bool {
+	for _, capacityErrorMessage := range capacityErrorMessages {
+		if strings.Contains(message, capacityErrorMessage) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterSubnetsByAvailabilityZone removes the subnets of the excluded availability zones of the AWSMachinePool.
+func (s *Service) filterSubnetsByAvailabilityZone(scope *scope.MachinePoolScope, subnetIDs []string) ([]string, error) {
+	excluded := scope.ExcludedAvailabilityZones()
+	if excluded.Len() == 0 || len(subnetIDs) == 0 {
+		return subnetIDs, nil
+	}
+
+	out, err := s.EC2Client.DescribeSubnetsWithContext(context.TODO(), &ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice(subnetIDs),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe the subnets of the AutoScalingGroup")
+	}
+
+	zones := make(map[string]string, len(out.Subnets))
+	for _, subnet := range out.Subnets {
+		zones[aws.StringValue(subnet.SubnetId)] = aws.StringValue(subnet.AvailabilityZone)
+	}
+
+	filtered := make([]string, 0, len(subnetIDs))
+	for _, subnetID := range subnetIDs {
+		if !excluded.Has(zones[subnetID]) {
+			filtered = append(filtered, subnetID)
+		}
+	}
+
+	if len(filtered) == 0 {
+		errMessage := fmt.Sprintf("no subnets available for ASG %q, all the availability zones of its subnets are excluded: %v", scope.Name(), sets.List(excluded))
+		record.Warnf(scope.AWSMachinePool, "FailedPlacement", errMessage)
+		return nil, awserrors.NewFailedDependency(errMessage)
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestServiceGetCapacityErrors(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	first := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	second := first.Add(5 * time.Minute)
+
+	tests := []struct {
+		name    string
+		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		want    map[string]time.Time
+		wantErr bool
+	}{
+		{
+			name: "should return the last capacity error of each availability zone",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeScalingActivities(context.TODO(), gomock.Eq(&autoscaling.DescribeScalingActivitiesInput{
+					AutoScalingGroupName: aws.String("test-asg"),
+				})).Return(&autoscaling.DescribeScalingActivitiesOutput{
+					Activities: []autoscalingtypes.Activity{
+						{
+							ActivityId:    aws.String("activity-1"),
+							StatusCode:    autoscalingtypes.ScalingActivityStatusCodeFailed,
+							StatusMessage: aws.String("We currently do not have sufficient m5.large capacity in the Availability Zone you requested (us-east-1a)."),
+							Details:       aws.String(`{"Subnet ID":"subnet-1","Availability Zone":"us-east-1a"}`),
+							StartTime:     aws.Time(first),
+						},
+						{
+							ActivityId:    aws.String("activity-2"),
+							StatusCode:    autoscalingtypes.ScalingActivityStatusCodeFailed,
+							StatusMessage: aws.String("We currently do not have sufficient m5.large capacity in the Availability Zone you requested (us-east-1a)."),
+							Details:       aws.String(`{"Subnet ID":"subnet-1","Availability Zone":"us-east-1a"}`),
+							StartTime:     aws.Time(second),
+						},
+						{
+							ActivityId:    aws.String("activity-3"),
+							StatusCode:    autoscalingtypes.ScalingActivityStatusCodeFailed,
+							StatusMessage: aws.String("The image id does not exist."),
+							Details:       aws.String(`{"Subnet ID":"subnet-2","Availability Zone":"us-east-1b"}`),
+							StartTime:     aws.Time(second),
+						},
+						{
+							ActivityId:    aws.String("activity-4"),
+							StatusCode:    autoscalingtypes.ScalingActivityStatusCodeSuccessful,
+							StatusMessage: aws.String(""),
+							Details:       aws.String(`{"Subnet ID":"subnet-3","Availability Zone":"us-east-1c"}`),
+							StartTime:     aws.Time(second),
+						},
+					},
+				}, nil)
+			},
+			want: map[string]time.Time{"us-east-1a": second},
+		},
+		{
+			name: "should return an error if the scaling activities cannot be described",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeScalingActivities(context.TODO(), gomock.Any()).Return(nil, &autoscalingtypes.ResourceContentionFault{})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fakeClient := getFakeClient()
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			capacityErrors, err := s.GetCapacityErrors("test-asg")
+			checkErr(tt.wantErr, err, g)
+			g.Expect(capacityErrors).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestServiceSubnetIDsWithAvailabilityZonePlacement(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	describeSubnets := func(e *mocks.MockEC2APIMockRecorder) {
+		e.DescribeSubnetsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeSubnetsInput{
+			SubnetIds: aws.StringSlice([]string{"subnet-1", "subnet-2", "subnet-3"}),
+		})).Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []*ec2.Subnet{
+				{SubnetId: aws.String("subnet-1"), AvailabilityZone: aws.String("us-east-1a")},
+				{SubnetId: aws.String("subnet-2"), AvailabilityZone: aws.String("us-east-1b")},
+				{SubnetId: aws.String("subnet-3"), AvailabilityZone: aws.String("us-east-1c")},
+			},
+		}, nil)
+	}
+
+	tests := []struct {
+		name      string
+		placement *expinfrav1.AvailabilityZonePlacement
+		zones     []expinfrav1.AvailabilityZoneStatus
+		expect    func(e *mocks.MockEC2APIMockRecorder)
+		want      []string
+		wantErr   bool
+	}{
+		{
+			name: "should return all the subnets without availability zone placement",
+			zones: []expinfrav1.AvailabilityZoneStatus{
+				{Name: "us-east-1a", Excluded: true, Reason: expinfrav1.AvailabilityZoneExclusionReasonInsufficientCapacity},
+			},
+			want: []string{"subnet-1", "subnet-2", "subnet-3"},
+		},
+		{
+			name:      "should return all the subnets when no availability zone is excluded",
+			placement: &expinfrav1.AvailabilityZonePlacement{},
+			want:      []string{"subnet-1", "subnet-2", "subnet-3"},
+		},
+		{
+			name: "should remove the subnets of the excluded availability zones",
+			placement: &expinfrav1.AvailabilityZonePlacement{
+				Weights: []expinfrav1.AvailabilityZoneWeight{{AvailabilityZone: "us-east-1c", Weight: 0}},
+			},
+			zones: []expinfrav1.AvailabilityZoneStatus{
+				{Name: "us-east-1a", Excluded: true, Reason: expinfrav1.AvailabilityZoneExclusionReasonInsufficientCapacity},
+				{Name: "us-east-1b"},
+			},
+			expect: describeSubnets,
+			want:   []string{"subnet-2"},
+		},
+		{
+			name: "should return an error when all the availability zones are excluded",
+			placement: &expinfrav1.AvailabilityZonePlacement{
+				Weights: []expinfrav1.AvailabilityZoneWeight{
+					{AvailabilityZone: "us-east-1a", Weight: 0},
+					{AvailabilityZone: "us-east-1b", Weight: 0},
+					{AvailabilityZone: "us-east-1c", Weight: 0},
+				},
+			},
+			expect:  describeSubnets,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fakeClient := getFakeClient()
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(ec2Mock.EXPECT())
+			}
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			mps, err := getMachinePoolScope(fakeClient, clusterScope)
+			g.Expect(err).ToNot(HaveOccurred())
+			mps.AWSMachinePool.Spec.Subnets = []infrav1.AWSResourceReference{
+				{ID: aws.String("subnet-1")},
+				{ID: aws.String("subnet-2")},
+				{ID: aws.String("subnet-3")},
+			}
+			mps.AWSMachinePool.Spec.AvailabilityZonePlacement = tt.placement
+			mps.AWSMachinePool.Status.AvailabilityZones = tt.zones
+
+			subnetIDs, err := s.SubnetIDs(mps)
+			checkErr(tt.wantErr, err, g)
+			if !tt.wantErr {
+				g.Expect(subnetIDs).To(Equal(tt.want))
+			}
+		})
+	}
+}
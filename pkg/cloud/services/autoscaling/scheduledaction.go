@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// DescribeScheduledActions returns the scheduled actions of the given AutoScalingGroup after retrieving them from the AWS API.
+func (s *Service) DescribeScheduledActions(asgName string) ([]*expinfrav1.AWSScheduledAction, error) {
+	input := &autoscaling.DescribeScheduledActionsInput{
+		AutoScalingGroupName: ptr.To(asgName),
+	}
+
+	var actions []*expinfrav1.AWSScheduledAction
+	paginator := autoscaling.NewDescribeScheduledActionsPaginator(s.ASGClient, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe scheduled actions for AutoScalingGroup: %q", asgName)
+		}
+		for _, action := range out.ScheduledUpdateGroupActions {
+			actions = append(actions, s.SDKToScheduledAction(action))
+		}
+	}
+
+	return actions, nil
+}
+
+// PutScheduledAction creates or updates a scheduled action of the given AutoScalingGroup.
+func (s *Service) PutScheduledAction(ctx context.Context, asgName string, action *expinfrav1.AWSScheduledAction) error {
+	input := &autoscaling.PutScheduledUpdateGroupActionInput{
+		AutoScalingGroupName: ptr.To(asgName),
+		ScheduledActionName:  ptr.To(action.Name),
+		Recurrence:           ptr.To(action.Recurrence),
+		MinSize:              action.MinSize,
+		MaxSize:              action.MaxSize,
+		DesiredCapacity:      action.DesiredCapacity,
+	}
+	if action.TimeZone != "" {
+		input.TimeZone = ptr.To(action.TimeZone)
+	}
+
+	if _, err := s.ASGClient.PutScheduledUpdateGroupAction(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to put scheduled action %q for AutoScalingGroup: %q", action.Name, asgName)
+	}
+
+	return nil
+}
+
+// DeleteScheduledAction deletes a scheduled action of the given AutoScalingGroup.
+func (s *Service) DeleteScheduledAction(ctx context.Context, asgName string, action *expinfrav1.AWSScheduledAction) error {
+	input := &autoscaling.DeleteScheduledActionInput{
+		AutoScalingGroupName: ptr.To(asgName),
+		ScheduledActionName:  ptr.To(action.Name),
+	}
+
+	if _, err := s.ASGClient.DeleteScheduledAction(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to delete scheduled action %q for AutoScalingGroup: %q", action.Name, asgName)
+	}
+
+	return nil
+}
+
+// SDKToScheduledAction converts an AWS SDK ScheduledUpdateGroupAction to the CAPA scheduled action type.
+func (s *Service) SDKToScheduledAction(action autoscalingtypes.ScheduledUpdateGroupAction) *expinfrav1.AWSScheduledAction {
+	return &expinfrav1.AWSScheduledAction{
+		Name:            ptr.Deref(action.ScheduledActionName, ""),
+		Recurrence:      ptr.Deref(action.Recurrence, ""),
+		TimeZone:        ptr.Deref(action.TimeZone, ""),
+		MinSize:         action.MinSize,
+		MaxSize:         action.MaxSize,
+		DesiredCapacity: action.DesiredCapacity,
+	}
+}
+
+// scheduledActionTimeZone returns the time zone of a scheduled action, which is UTC when it is not set.
+func scheduledActionTimeZone(action *expinfrav1.AWSScheduledAction) string {
+	if action.TimeZone == "" || action.TimeZone == "Etc/UTC" {
+		return "UTC"
+	}
+	return action.TimeZone
+}
+
+func scheduledActionNeedsUpdate(existing *expinfrav1.AWSScheduledAction, expected *expinfrav1.AWSScheduledAction) bool {
+	return existing.Recurrence != expected.Recurrence ||
+		scheduledActionTimeZone(existing) != scheduledActionTimeZone(expected) ||
+		!ptr.Equal(existing.MinSize, expected.MinSize) ||
+		!ptr.Equal(existing.MaxSize, expected.MaxSize) ||
+		!ptr.Equal(existing.DesiredCapacity, expected.DesiredCapacity)
+}
+
+// ReconcileScheduledActions reconciles the scheduled actions of an ASG by creating missing actions, updating
+// mismatching actions and deleting extraneous actions.
+func ReconcileScheduledActions(ctx context.Context, asgService services.ASGInterface, asgName string, wantedActions []expinfrav1.AWSScheduledAction, storeConditionsOnObject conditions.Setter, log logger.Wrapper) error {
+	existingActions, err := asgService.DescribeScheduledActions(asgName)
+	if err != nil {
+		return err
+	}
+
+	existingByName := make(map[string]*expinfrav1.AWSScheduledAction, len(existingActions))
+	for _, action := range existingActions {
+		existingByName[action.Name] = action
+	}
+
+	wantedNames := make(map[string]bool, len(wantedActions))
+	for i := range wantedActions {
+		wanted := &wantedActions[i]
+		wantedNames[wanted.Name] = true
+
+		if existing, ok := existingByName[wanted.Name]; ok && !scheduledActionNeedsUpdate(existing, wanted) {
+			continue
+		}
+		log.Info("Putting scheduled action", "action", wanted.Name)
+		if err := asgService.PutScheduledAction(ctx, asgName, wanted); err != nil {
+			conditions.MarkFalse(storeConditionsOnObject, expinfrav1.ScheduledActionsReadyCondition, expinfrav1.ScheduledActionsReconciliationFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return err
+		}
+	}
+
+	for _, existing := range existingActions {
+		if wantedNames[existing.Name] {
+			continue
+		}
+		log.Info("Deleting extraneous scheduled action", "action", existing.Name)
+		if err := asgService.DeleteScheduledAction(ctx, asgName, existing); err != nil {
+			conditions.MarkFalse(storeConditionsOnObject, expinfrav1.ScheduledActionsReadyCondition, expinfrav1.ScheduledActionsReconciliationFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return err
+		}
+	}
+
+	if len(wantedActions) == 0 {
+		conditions.Delete(storeConditionsOnObject, expinfrav1.ScheduledActionsReadyCondition)
+		return nil
+	}
+	conditions.MarkTrue(storeConditionsOnObject, expinfrav1.ScheduledActionsReadyCondition)
+	return nil
+}
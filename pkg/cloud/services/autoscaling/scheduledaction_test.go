@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+)
+
+func TestScheduledActionNeedsUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		existing   expinfrav1.AWSScheduledAction
+		expected   expinfrav1.AWSScheduledAction
+		wantUpdate bool
+	}{
+		{
+			name: "exactly equal",
+			existing: expinfrav1.AWSScheduledAction{
+				Name:            "scale-to-zero",
+				Recurrence:      "0 20 * * 1-5",
+				TimeZone:        "Europe/Paris",
+				DesiredCapacity: ptr.To[int32](0),
+			},
+			expected: expinfrav1.AWSScheduledAction{
+				Name:            "scale-to-zero",
+				Recurrence:      "0 20 * * 1-5",
+				TimeZone:        "Europe/Paris",
+				DesiredCapacity: ptr.To[int32](0),
+			},
+			wantUpdate: false,
+		},
+
+		{
+			name: "time zone not set in manifest, but set to UTC by AWS",
+			existing: expinfrav1.AWSScheduledAction{
+				Name:            "scale-to-zero",
+				Recurrence:      "0 20 * * 1-5",
+				TimeZone:        "Etc/UTC",
+				DesiredCapacity: ptr.To[int32](0),
+			},
+			expected: expinfrav1.AWSScheduledAction{
+				Name:            "scale-to-zero",
+				Recurrence:      "0 20 * * 1-5",
+				DesiredCapacity: ptr.To[int32](0),
+			},
+			wantUpdate: false,
+		},
+
+		{
+			name: "recurrence differs",
+			existing: expinfrav1.AWSScheduledAction{
+				Name:            "scale-to-zero",
+				Recurrence:      "0 20 * * 1-5",
+				DesiredCapacity: ptr.To[int32](0),
+			},
+			expected: expinfrav1.AWSScheduledAction{
+				Name:            "scale-to-zero",
+				Recurrence:      "0 19 * * 1-5",
+				DesiredCapacity: ptr.To[int32](0),
+			},
+			wantUpdate: true,
+		},
+
+		{
+			name: "time zone differs",
+			existing: expinfrav1.AWSScheduledAction{
+				Name:            "scale-to-zero",
+				Recurrence:      "0 20 * * 1-5",
+				TimeZone:        "Europe/Paris",
+				DesiredCapacity: ptr.To[int32](0),
+			},
+			expected: expinfrav1.AWSScheduledAction{
+				Name:            "scale-to-zero",
+				Recurrence:      "0 20 * * 1-5",
+				TimeZone:        "America/New_York",
+				DesiredCapacity: ptr.To[int32](0),
+			},
+			wantUpdate: true,
+		},
+
+		{
+			name: "sizes differ",
+			existing: expinfrav1.AWSScheduledAction{
+				Name:            "scale-to-zero",
+				Recurrence:      "0 20 * * 1-5",
+				DesiredCapacity: ptr.To[int32](0),
+			},
+			expected: expinfrav1.AWSScheduledAction{
+				Name:            "scale-to-zero",
+				Recurrence:      "0 20 * * 1-5",
+				MinSize:         ptr.To[int32](0),
+				MaxSize:         ptr.To[int32](0),
+				DesiredCapacity: ptr.To[int32](0),
+			},
+			wantUpdate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := tt.existing
+			expected := tt.expected
+			g := NewWithT(t)
+			g.Expect(scheduledActionNeedsUpdate(&existing, &expected)).To(Equal(tt.wantUpdate))
+		})
+	}
+}
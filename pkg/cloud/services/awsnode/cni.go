@@ -68,7 +68,7 @@ func (s *Service) ReconcileCNI(ctx context.Context) error {
 	}
 
 	var needsUpdate bool
-	if len(s.scope.VpcCni().Env) > 0 {
+	if len(s.environment()) > 0 {
 		s.scope.Info("updating aws-node daemonset environment variables", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
 
 		for i := range ds.Spec.Template.Spec.Containers {
@@ -182,6 +182,10 @@ func (s *Service) getSecurityGroups() ([]string, error) {
 		sgs = append(sgs, s.scope.SecurityGroups()[sg].ID)
 	}
 
+	if podNetworking := s.scope.VpcCni().PodNetworking; podNetworking != nil && podNetworking.CustomNetworking != nil {
+		sgs = append(sgs, podNetworking.CustomNetworking.AdditionalSecurityGroupIDs...)
+	}
+
 	return sgs, nil
 }
 
@@ -191,7 +195,7 @@ func (s *Service) applyUserProvidedEnvironmentProperties(containerEnv []corev1.E
 		envVars     = make(map[string]corev1.EnvVar)
 		needsUpdate = false
 	)
-	for _, e := range s.scope.VpcCni().Env {
+	for _, e := range s.environment() {
 		envVars[e.Name] = e
 	}
 	// Handle the case where we overwrite an existing value if it's not already the desired value.
@@ -257,6 +257,106 @@ func TestReconcileCniVpcCniValues(t *testing.T) {
 	}
 }
 
+func TestReconcileCniPodNetworking(t *testing.T) {
+	daemonSet := func() *v1.DaemonSet {
+		return &v1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      awsNodeName,
+				Namespace: awsNodeNamespace,
+			},
+			Spec: v1.DaemonSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: awsNodeName,
+								Env: []corev1.EnvVar{
+									{Name: enablePrefixDelegationEnv, Value: "false"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("prefix delegation sets the environment values, unless the user overrides them", func(t *testing.T) {
+		g := NewWithT(t)
+		mockClient := &cachingClient{
+			getValue: daemonSet(),
+		}
+		m := &mockScope{
+			client: mockClient,
+			cni: ekscontrolplanev1.VpcCni{
+				Env: []corev1.EnvVar{
+					{Name: warmPrefixTargetEnv, Value: "3"},
+				},
+				PodNetworking: &ekscontrolplanev1.VpcCniPodNetworking{
+					PrefixDelegation: &ekscontrolplanev1.VpcCniPrefixDelegation{WarmPrefixTarget: 1, MaxPods: 110},
+				},
+			},
+		}
+		s := NewService(m)
+
+		err := s.ReconcileCNI(context.Background())
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(mockClient.updateChain).To(HaveLen(1))
+		ds, ok := mockClient.updateChain[0].(*v1.DaemonSet)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ConsistOf(
+			corev1.EnvVar{Name: enablePrefixDelegationEnv, Value: "true"},
+			corev1.EnvVar{Name: warmPrefixTargetEnv, Value: "3"},
+		))
+	})
+
+	t.Run("custom networking sets the environment values and the additional security groups", func(t *testing.T) {
+		g := NewWithT(t)
+		mockClient := &cachingClient{
+			getValue: daemonSet(),
+		}
+		m := &mockScope{
+			client: mockClient,
+			cni: ekscontrolplanev1.VpcCni{
+				PodNetworking: &ekscontrolplanev1.VpcCniPodNetworking{
+					CustomNetworking: &ekscontrolplanev1.VpcCniCustomNetworking{
+						AdditionalSecurityGroupIDs: []string{"sg-pods"},
+					},
+				},
+			},
+			secondaryCidrBlock: aws.String("100.64.0.0/16"),
+			securityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+				infrav1.SecurityGroupNode: {ID: "sg-node"},
+			},
+			subnets: infrav1.Subnets{
+				{
+					ID:               "subnet-pods",
+					CidrBlock:        "100.64.0.0/16",
+					AvailabilityZone: "us-east-1a",
+					Tags: infrav1.Tags{
+						infrav1.NameAWSSubnetAssociation: infrav1.SecondarySubnetTagValue,
+					},
+				},
+			},
+		}
+		s := NewService(m)
+
+		err := s.ReconcileCNI(context.Background())
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(mockClient.updateChain).To(HaveLen(2)) // 0: eniconfig 1: daemonset
+		eniconf, ok := mockClient.updateChain[0].(*v1alpha1.ENIConfig)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(eniconf.Spec.SecurityGroups).To(Equal([]string{"sg-node", "sg-pods"}))
+		ds, ok := mockClient.updateChain[1].(*v1.DaemonSet)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ConsistOf(
+			corev1.EnvVar{Name: enablePrefixDelegationEnv, Value: "false"},
+			corev1.EnvVar{Name: customNetworkConfigEnv, Value: "true"},
+			corev1.EnvVar{Name: eniConfigLabelDefEnv, Value: zoneLabel},
+		))
+	})
+}
+
 type cachingClient struct {
 	client.Client
 	getValue    client.Object
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsnode
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	enablePrefixDelegationEnv = "ENABLE_PREFIX_DELEGATION"
+	warmPrefixTargetEnv       = "WARM_PREFIX_TARGET"
+	customNetworkConfigEnv    = "AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG"
+	eniConfigLabelDefEnv      = "ENI_CONFIG_LABEL_DEF"
+
+	// zoneLabel is the node label selecting the ENIConfig of a node, which are named after the availability zones.
+	zoneLabel = "topology.kubernetes.io/zone"
+)
+
+// environment returns the environment variables to apply to the aws-node DaemonSet. The variables configuring the pod
+// networking come first, so that the ones provided by the user take precedence over them.
+func (s *Service) environment() []corev1.EnvVar {
+	var env []corev1.EnvVar
+
+	if podNetworking := s.scope.VpcCni().PodNetworking; podNetworking != nil {
+		if pd := podNetworking.PrefixDelegation; pd != nil {
+			env = append(env,
+				corev1.EnvVar{Name: enablePrefixDelegationEnv, Value: "true"},
+				corev1.EnvVar{Name: warmPrefixTargetEnv, Value: strconv.Itoa(int(pd.WarmPrefixTarget))},
+			)
+		}
+		if podNetworking.CustomNetworking != nil {
+			env = append(env,
+				corev1.EnvVar{Name: customNetworkConfigEnv, Value: "true"},
+				corev1.EnvVar{Name: eniConfigLabelDefEnv, Value: zoneLabel},
+			)
+		}
+	}
+
+	return append(env, s.scope.VpcCni().Env...)
+}
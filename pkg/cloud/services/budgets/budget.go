@@ -0,0 +1,326 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budgets
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+)
+
+const (
+	// costFilterTagKeyValue is the cost filter matching the cost of the resources with a cost allocation tag.
+	costFilterTagKeyValue = "TagKeyValue"
+
+	// currencyUSD is the only currency of cost budgets.
+	currencyUSD = "USD"
+)
+
+// ReconcileBudget creates or updates the AWS Budget of the cluster and its notifications, and records its spend
+// in the status of the cluster. The budget is deleted once it is not wanted anymore.
+func (s *Service) ReconcileBudget(ctx context.Context) error {
+	spec := s.scope.Budget()
+	if spec == nil {
+		return s.DeleteBudget(ctx)
+	}
+
+	accountID, err := s.accountID(ctx)
+	if err != nil {
+		return err
+	}
+
+	desired := s.desiredBudget(spec)
+	existing, err := s.describeBudget(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		if _, err := s.BudgetsClient.CreateBudgetWithContext(ctx, &budgets.CreateBudgetInput{
+			AccountId:                    aws.String(accountID),
+			Budget:                       desired,
+			NotificationsWithSubscribers: desiredNotifications(spec),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create AWS Budget %q", s.budgetName())
+		}
+		s.scope.Info("Created AWS Budget", "name", s.budgetName())
+		s.scope.SetBudgetStatus(&infrav1.BudgetStatus{Name: s.budgetName()})
+		return nil
+	}
+
+	if budgetNeedsUpdate(existing, desired) {
+		if _, err := s.BudgetsClient.UpdateBudgetWithContext(ctx, &budgets.UpdateBudgetInput{
+			AccountId: aws.String(accountID),
+			NewBudget: desired,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to update AWS Budget %q", s.budgetName())
+		}
+		s.scope.Info("Updated AWS Budget", "name", s.budgetName())
+	}
+	if err := s.reconcileNotifications(ctx, accountID, spec); err != nil {
+		return err
+	}
+
+	status := &infrav1.BudgetStatus{Name: s.budgetName()}
+	if spend := existing.CalculatedSpend; spend != nil {
+		if spend.ActualSpend != nil {
+			status.ActualSpend = aws.StringValue(spend.ActualSpend.Amount)
+		}
+		if spend.ForecastedSpend != nil {
+			status.ForecastedSpend = aws.StringValue(spend.ForecastedSpend.Amount)
+		}
+	}
+	if existing.LastUpdatedTime != nil {
+		lastUpdated := metav1.NewTime(*existing.LastUpdatedTime)
+		status.LastUpdated = &lastUpdated
+	}
+	s.scope.SetBudgetStatus(status)
+	return nil
+}
+
+// DeleteBudget deletes the AWS Budget of the cluster, if it was created.
+func (s *Service) DeleteBudget(ctx context.Context) error {
+	if s.scope.Budget() == nil && s.scope.BudgetStatus() == nil {
+		return nil
+	}
+
+	accountID, err := s.accountID(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := s.BudgetsClient.DeleteBudgetWithContext(ctx, &budgets.DeleteBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(s.budgetName()),
+	}); err != nil {
+		if code, _ := awserrors.Code(err); code != budgets.ErrCodeNotFoundException {
+			return errors.Wrapf(err, "failed to delete AWS Budget %q", s.budgetName())
+		}
+	} else {
+		s.scope.Info("Deleted AWS Budget", "name", s.budgetName())
+	}
+	s.scope.SetBudgetStatus(nil)
+	return nil
+}
+
+func (s *Service) budgetName() string {
+	return s.scope.Name()
+}
+
+// accountID returns the ID of the account the budget of the cluster is created in.
+func (s *Service) accountID(ctx context.Context) (string, error) {
+	identity, err := s.STSClient.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get the caller identity")
+	}
+	return aws.StringValue(identity.Account), nil
+}
+
+func (s *Service) describeBudget(ctx context.Context, accountID string) (*budgets.Budget, error) {
+	out, err := s.BudgetsClient.DescribeBudgetWithContext(ctx, &budgets.DescribeBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(s.budgetName()),
+	})
+	if err != nil {
+		if code, _ := awserrors.Code(err); code == budgets.ErrCodeNotFoundException {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to describe AWS Budget %q", s.budgetName())
+	}
+	return out.Budget, nil
+}
+
+// desiredBudget returns a cost budget filtered on the ownership tag of the cluster, which must be activated as a
+// user-defined cost allocation tag.
+func (s *Service) desiredBudget(spec *infrav1.Budget) *budgets.Budget {
+	timeUnit := spec.TimeUnit
+	if timeUnit == "" {
+		timeUnit = infrav1.BudgetTimeUnitMonthly
+	}
+	return &budgets.Budget{
+		BudgetName: aws.String(s.budgetName()),
+		BudgetType: aws.String(budgets.BudgetTypeCost),
+		BudgetLimit: &budgets.Spend{
+			Amount: aws.String(spec.LimitAmount),
+			Unit:   aws.String(currencyUSD),
+		},
+		TimeUnit: aws.String(string(timeUnit)),
+		CostFilters: map[string][]*string{
+			costFilterTagKeyValue: aws.StringSlice([]string{fmt.Sprintf("user:%s$%s", infrav1.ClusterTagKey(s.scope.Name()), infrav1.ResourceLifecycleOwned)}),
+		},
+	}
+}
+
+// budgetNeedsUpdate returns whether the limit, the time unit or the cost filters of a budget differ from the
+// desired ones. AWS Budgets returns the limit with a different precision, e.g. "500.0" for "500".
+func budgetNeedsUpdate(existing, desired *budgets.Budget) bool {
+	if existing.BudgetLimit == nil || !sameAmount(aws.StringValue(existing.BudgetLimit.Amount), aws.StringValue(desired.BudgetLimit.Amount)) {
+		return true
+	}
+	return aws.StringValue(existing.TimeUnit) != aws.StringValue(desired.TimeUnit) ||
+		!cmp.Equal(aws.StringValueSlice(existing.CostFilters[costFilterTagKeyValue]), aws.StringValueSlice(desired.CostFilters[costFilterTagKeyValue]))
+}
+
+func sameAmount(a, b string) bool {
+	x, errX := strconv.ParseFloat(a, 64)
+	y, errY := strconv.ParseFloat(b, 64)
+	if errX != nil || errY != nil {
+		return a == b
+	}
+	return x == y
+}
+
+// desiredNotifications returns the notifications of the budget, sent to the SNS topic when the actual or
+// forecasted spend exceeds a percentage of the limit.
+func desiredNotifications(spec *infrav1.Budget) []*budgets.NotificationWithSubscribers {
+	if spec.SNSTopicARN == "" {
+		return nil
+	}
+	notifications := make([]*budgets.NotificationWithSubscribers, 0, len(spec.Notifications))
+	for _, n := range spec.Notifications {
+		notificationType := n.Type
+		if notificationType == "" {
+			notificationType = infrav1.BudgetNotificationTypeActual
+		}
+		notifications = append(notifications, &budgets.NotificationWithSubscribers{
+			Notification: &budgets.Notification{
+				NotificationType:   aws.String(string(notificationType)),
+				ComparisonOperator: aws.String(budgets.ComparisonOperatorGreaterThan),
+				Threshold:          aws.Float64(float64(n.ThresholdPercent)),
+				ThresholdType:      aws.String(budgets.ThresholdTypePercentage),
+			},
+			Subscribers: []*budgets.Subscriber{{
+				SubscriptionType: aws.String(budgets.SubscriptionTypeSns),
+				Address:          aws.String(spec.SNSTopicARN),
+			}},
+		})
+	}
+	return notifications
+}
+
+// notificationKey identifies a notification of a budget, which cannot have two notifications with the same type,
+// comparison operator, threshold and threshold type.
+func notificationKey(n *budgets.Notification) string {
+	return fmt.Sprintf("%s/%s/%g/%s", aws.StringValue(n.NotificationType), aws.StringValue(n.ComparisonOperator), aws.Float64Value(n.Threshold), aws.StringValue(n.ThresholdType))
+}
+
+// reconcileNotifications creates the missing notifications of the budget, deletes the ones which are not wanted
+// anymore and ensures the SNS topic is the only subscriber of the others.
+func (s *Service) reconcileNotifications(ctx context.Context, accountID string, spec *infrav1.Budget) error {
+	desired := map[string]*budgets.NotificationWithSubscribers{}
+	for _, n := range desiredNotifications(spec) {
+		desired[notificationKey(n.Notification)] = n
+	}
+
+	var existing []*budgets.Notification
+	if err := s.BudgetsClient.DescribeNotificationsForBudgetPagesWithContext(ctx, &budgets.DescribeNotificationsForBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(s.budgetName()),
+	}, func(out *budgets.DescribeNotificationsForBudgetOutput, _ bool) bool {
+		existing = append(existing, out.Notifications...)
+		return true
+	}); err != nil {
+		return errors.Wrapf(err, "failed to describe the notifications of AWS Budget %q", s.budgetName())
+	}
+
+	for _, n := range existing {
+		key := notificationKey(n)
+		want, ok := desired[key]
+		if !ok {
+			if _, err := s.BudgetsClient.DeleteNotificationWithContext(ctx, &budgets.DeleteNotificationInput{
+				AccountId:    aws.String(accountID),
+				BudgetName:   aws.String(s.budgetName()),
+				Notification: n,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to delete notification %q of AWS Budget %q", key, s.budgetName())
+			}
+			s.scope.Debug("Deleted AWS Budget notification", "name", s.budgetName(), "notification", key)
+			continue
+		}
+		delete(desired, key)
+		if err := s.reconcileSubscribers(ctx, accountID, n, want.Subscribers[0]); err != nil {
+			return err
+		}
+	}
+
+	for key, n := range desired {
+		if _, err := s.BudgetsClient.CreateNotificationWithContext(ctx, &budgets.CreateNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(s.budgetName()),
+			Notification: n.Notification,
+			Subscribers:  n.Subscribers,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create notification %q of AWS Budget %q", key, s.budgetName())
+		}
+		s.scope.Debug("Created AWS Budget notification", "name", s.budgetName(), "notification", key)
+	}
+	return nil
+}
+
+func (s *Service) reconcileSubscribers(ctx context.Context, accountID string, notification *budgets.Notification, want *budgets.Subscriber) error {
+	var subscribers []*budgets.Subscriber
+	if err := s.BudgetsClient.DescribeSubscribersForNotificationPagesWithContext(ctx, &budgets.DescribeSubscribersForNotificationInput{
+		AccountId:    aws.String(accountID),
+		BudgetName:   aws.String(s.budgetName()),
+		Notification: notification,
+	}, func(out *budgets.DescribeSubscribersForNotificationOutput, _ bool) bool {
+		subscribers = append(subscribers, out.Subscribers...)
+		return true
+	}); err != nil {
+		return errors.Wrapf(err, "failed to describe the subscribers of a notification of AWS Budget %q", s.budgetName())
+	}
+
+	// A notification needs at least one subscriber, so the SNS topic is subscribed before the others are deleted.
+	var stale []*budgets.Subscriber
+	found := false
+	for _, subscriber := range subscribers {
+		if aws.StringValue(subscriber.SubscriptionType) == aws.StringValue(want.SubscriptionType) && aws.StringValue(subscriber.Address) == aws.StringValue(want.Address) {
+			found = true
+		} else {
+			stale = append(stale, subscriber)
+		}
+	}
+	if !found {
+		if _, err := s.BudgetsClient.CreateSubscriberWithContext(ctx, &budgets.CreateSubscriberInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(s.budgetName()),
+			Notification: notification,
+			Subscriber:   want,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create subscriber %q of AWS Budget %q", aws.StringValue(want.Address), s.budgetName())
+		}
+	}
+	for _, subscriber := range stale {
+		if _, err := s.BudgetsClient.DeleteSubscriberWithContext(ctx, &budgets.DeleteSubscriberInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(s.budgetName()),
+			Notification: notification,
+			Subscriber:   subscriber,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to delete subscriber %q of AWS Budget %q", aws.StringValue(subscriber.Address), s.budgetName())
+		}
+	}
+	return nil
+}
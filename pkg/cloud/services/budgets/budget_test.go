@@ -0,0 +1,278 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budgets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/budgets/mock_budgetsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts/mock_stsiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	accountID = "123456789012"
+	topicARN  = "arn:aws:sns:us-east-1:123456789012:budgets"
+)
+
+var budgetNotFound = awserr.New(budgets.ErrCodeNotFoundException, "budget not found", nil)
+
+func TestReconcileBudget(t *testing.T) {
+	lastUpdated := time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC)
+	existingBudget := func(amount string) *budgets.Budget {
+		return &budgets.Budget{
+			BudgetName:  aws.String("test-cluster"),
+			BudgetType:  aws.String(budgets.BudgetTypeCost),
+			BudgetLimit: &budgets.Spend{Amount: aws.String(amount), Unit: aws.String("USD")},
+			TimeUnit:    aws.String(budgets.TimeUnitMonthly),
+			CostFilters: map[string][]*string{
+				"TagKeyValue": aws.StringSlice([]string{"user:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster$owned"}),
+			},
+			CalculatedSpend: &budgets.CalculatedSpend{
+				ActualSpend:     &budgets.Spend{Amount: aws.String("120.5"), Unit: aws.String("USD")},
+				ForecastedSpend: &budgets.Spend{Amount: aws.String("610.0"), Unit: aws.String("USD")},
+			},
+			LastUpdatedTime: aws.Time(lastUpdated),
+		}
+	}
+	actual80 := &budgets.Notification{
+		NotificationType:   aws.String(budgets.NotificationTypeActual),
+		ComparisonOperator: aws.String(budgets.ComparisonOperatorGreaterThan),
+		Threshold:          aws.Float64(80),
+		ThresholdType:      aws.String(budgets.ThresholdTypePercentage),
+	}
+	forecasted100 := &budgets.Notification{
+		NotificationType:   aws.String(budgets.NotificationTypeForecasted),
+		ComparisonOperator: aws.String(budgets.ComparisonOperatorGreaterThan),
+		Threshold:          aws.Float64(100),
+		ThresholdType:      aws.String(budgets.ThresholdTypePercentage),
+	}
+	topic := &budgets.Subscriber{SubscriptionType: aws.String(budgets.SubscriptionTypeSns), Address: aws.String(topicARN)}
+
+	tests := []struct {
+		name         string
+		budget       *infrav1.Budget
+		status       *infrav1.BudgetStatus
+		expect       func(m *mock_budgetsiface.MockBudgetsAPIMockRecorder)
+		expectStatus *infrav1.BudgetStatus
+	}{
+		{
+			name: "creates the budget with its notifications",
+			budget: &infrav1.Budget{
+				LimitAmount:   "500",
+				Notifications: []infrav1.BudgetNotification{{ThresholdPercent: 80}},
+				SNSTopicARN:   topicARN,
+			},
+			expect: func(m *mock_budgetsiface.MockBudgetsAPIMockRecorder) {
+				m.DescribeBudgetWithContext(gomock.Any(), &budgets.DescribeBudgetInput{
+					AccountId:  aws.String(accountID),
+					BudgetName: aws.String("test-cluster"),
+				}).Return(nil, budgetNotFound)
+				m.CreateBudgetWithContext(gomock.Any(), &budgets.CreateBudgetInput{
+					AccountId: aws.String(accountID),
+					Budget: &budgets.Budget{
+						BudgetName:  aws.String("test-cluster"),
+						BudgetType:  aws.String(budgets.BudgetTypeCost),
+						BudgetLimit: &budgets.Spend{Amount: aws.String("500"), Unit: aws.String("USD")},
+						TimeUnit:    aws.String(budgets.TimeUnitMonthly),
+						CostFilters: map[string][]*string{
+							"TagKeyValue": aws.StringSlice([]string{"user:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster$owned"}),
+						},
+					},
+					NotificationsWithSubscribers: []*budgets.NotificationWithSubscribers{{
+						Notification: actual80,
+						Subscribers:  []*budgets.Subscriber{topic},
+					}},
+				}).Return(&budgets.CreateBudgetOutput{}, nil)
+			},
+			expectStatus: &infrav1.BudgetStatus{Name: "test-cluster"},
+		},
+		{
+			name: "records the spend of an up to date budget",
+			budget: &infrav1.Budget{
+				LimitAmount:   "500",
+				TimeUnit:      infrav1.BudgetTimeUnitMonthly,
+				Notifications: []infrav1.BudgetNotification{{Type: infrav1.BudgetNotificationTypeActual, ThresholdPercent: 80}},
+				SNSTopicARN:   topicARN,
+			},
+			expect: func(m *mock_budgetsiface.MockBudgetsAPIMockRecorder) {
+				m.DescribeBudgetWithContext(gomock.Any(), gomock.Any()).Return(&budgets.DescribeBudgetOutput{Budget: existingBudget("500.0")}, nil)
+				expectNotifications(m, actual80)
+				expectSubscribers(m, topic)
+			},
+			expectStatus: &infrav1.BudgetStatus{
+				Name:            "test-cluster",
+				ActualSpend:     "120.5",
+				ForecastedSpend: "610.0",
+				LastUpdated:     &metav1.Time{Time: lastUpdated},
+			},
+		},
+		{
+			name: "updates the limit and the notifications of the budget",
+			budget: &infrav1.Budget{
+				LimitAmount:   "1000",
+				Notifications: []infrav1.BudgetNotification{{Type: infrav1.BudgetNotificationTypeForecasted, ThresholdPercent: 100}},
+				SNSTopicARN:   topicARN,
+			},
+			expect: func(m *mock_budgetsiface.MockBudgetsAPIMockRecorder) {
+				m.DescribeBudgetWithContext(gomock.Any(), gomock.Any()).Return(&budgets.DescribeBudgetOutput{Budget: existingBudget("500.0")}, nil)
+				m.UpdateBudgetWithContext(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *budgets.UpdateBudgetInput, _ ...request.Option) (*budgets.UpdateBudgetOutput, error) {
+					if aws.StringValue(input.NewBudget.BudgetLimit.Amount) != "1000" {
+						t.Fatalf("expected a limit of 1000, got %s", aws.StringValue(input.NewBudget.BudgetLimit.Amount))
+					}
+					return &budgets.UpdateBudgetOutput{}, nil
+				})
+				expectNotifications(m, actual80)
+				m.DeleteNotificationWithContext(gomock.Any(), &budgets.DeleteNotificationInput{
+					AccountId:    aws.String(accountID),
+					BudgetName:   aws.String("test-cluster"),
+					Notification: actual80,
+				}).Return(&budgets.DeleteNotificationOutput{}, nil)
+				m.CreateNotificationWithContext(gomock.Any(), &budgets.CreateNotificationInput{
+					AccountId:    aws.String(accountID),
+					BudgetName:   aws.String("test-cluster"),
+					Notification: forecasted100,
+					Subscribers:  []*budgets.Subscriber{topic},
+				}).Return(&budgets.CreateNotificationOutput{}, nil)
+			},
+			expectStatus: &infrav1.BudgetStatus{
+				Name:            "test-cluster",
+				ActualSpend:     "120.5",
+				ForecastedSpend: "610.0",
+				LastUpdated:     &metav1.Time{Time: lastUpdated},
+			},
+		},
+		{
+			name: "replaces the subscriber of a notification once the SNS topic changed",
+			budget: &infrav1.Budget{
+				LimitAmount:   "500",
+				Notifications: []infrav1.BudgetNotification{{ThresholdPercent: 80}},
+				SNSTopicARN:   topicARN,
+			},
+			expect: func(m *mock_budgetsiface.MockBudgetsAPIMockRecorder) {
+				oldTopic := &budgets.Subscriber{SubscriptionType: aws.String(budgets.SubscriptionTypeSns), Address: aws.String("arn:aws:sns:us-east-1:123456789012:old")}
+				m.DescribeBudgetWithContext(gomock.Any(), gomock.Any()).Return(&budgets.DescribeBudgetOutput{Budget: existingBudget("500")}, nil)
+				expectNotifications(m, actual80)
+				expectSubscribers(m, oldTopic)
+				create := m.CreateSubscriberWithContext(gomock.Any(), &budgets.CreateSubscriberInput{
+					AccountId:    aws.String(accountID),
+					BudgetName:   aws.String("test-cluster"),
+					Notification: actual80,
+					Subscriber:   topic,
+				}).Return(&budgets.CreateSubscriberOutput{}, nil)
+				m.DeleteSubscriberWithContext(gomock.Any(), &budgets.DeleteSubscriberInput{
+					AccountId:    aws.String(accountID),
+					BudgetName:   aws.String("test-cluster"),
+					Notification: actual80,
+					Subscriber:   oldTopic,
+				}).Return(&budgets.DeleteSubscriberOutput{}, nil).After(create)
+			},
+			expectStatus: &infrav1.BudgetStatus{
+				Name:            "test-cluster",
+				ActualSpend:     "120.5",
+				ForecastedSpend: "610.0",
+				LastUpdated:     &metav1.Time{Time: lastUpdated},
+			},
+		},
+		{
+			name:   "deletes the budget once unset",
+			status: &infrav1.BudgetStatus{Name: "test-cluster"},
+			expect: func(m *mock_budgetsiface.MockBudgetsAPIMockRecorder) {
+				m.DeleteBudgetWithContext(gomock.Any(), &budgets.DeleteBudgetInput{
+					AccountId:  aws.String(accountID),
+					BudgetName: aws.String("test-cluster"),
+				}).Return(&budgets.DeleteBudgetOutput{}, nil)
+			},
+		},
+		{
+			name:   "ignores a budget already deleted",
+			status: &infrav1.BudgetStatus{Name: "test-cluster"},
+			expect: func(m *mock_budgetsiface.MockBudgetsAPIMockRecorder) {
+				m.DeleteBudgetWithContext(gomock.Any(), gomock.Any()).Return(nil, budgetNotFound)
+			},
+		},
+		{
+			name:   "does nothing without a budget",
+			expect: func(m *mock_budgetsiface.MockBudgetsAPIMockRecorder) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			budgetsMock := mock_budgetsiface.NewMockBudgetsAPI(mockCtrl)
+			stsMock := mock_stsiface.NewMockSTSAPI(mockCtrl)
+			tt.expect(budgetsMock.EXPECT())
+			if tt.budget != nil || tt.status != nil {
+				stsMock.EXPECT().GetCallerIdentityWithContext(gomock.Any(), gomock.Any()).Return(&sts.GetCallerIdentityOutput{Account: aws.String(accountID)}, nil)
+			}
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec:   infrav1.AWSClusterSpec{Region: "us-east-1", Budget: tt.budget},
+					Status: infrav1.AWSClusterStatus{Budget: tt.status},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.BudgetsClient = budgetsMock
+			s.STSClient = stsMock
+
+			g.Expect(s.ReconcileBudget(context.TODO())).To(Succeed())
+			g.Expect(clusterScope.BudgetStatus()).To(Equal(tt.expectStatus))
+		})
+	}
+}
+
+func expectNotifications(m *mock_budgetsiface.MockBudgetsAPIMockRecorder, notifications ...*budgets.Notification) {
+	m.DescribeNotificationsForBudgetPagesWithContext(gomock.Any(), &budgets.DescribeNotificationsForBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String("test-cluster"),
+	}, gomock.Any()).DoAndReturn(func(_ context.Context, _ *budgets.DescribeNotificationsForBudgetInput, fn func(*budgets.DescribeNotificationsForBudgetOutput, bool) bool, _ ...request.Option) error {
+		fn(&budgets.DescribeNotificationsForBudgetOutput{Notifications: notifications}, true)
+		return nil
+	})
+}
+
+func expectSubscribers(m *mock_budgetsiface.MockBudgetsAPIMockRecorder, subscribers ...*budgets.Subscriber) {
+	m.DescribeSubscribersForNotificationPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *budgets.DescribeSubscribersForNotificationInput, fn func(*budgets.DescribeSubscribersForNotificationOutput, bool) bool, _ ...request.Option) error {
+		fn(&budgets.DescribeSubscribersForNotificationOutput{Subscribers: subscribers}, true)
+		return nil
+	})
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package budgets provides a way to interact with AWS Budgets.
+package budgets
+
+import (
+	"github.com/aws/aws-sdk-go/service/budgets/budgetsiface"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+// Service holds a collection of interfaces.
+// The interfaces are broken down like this to group functions together.
+// One alternative is to have a large list of functions from the budgets client.
+type Service struct {
+	scope         scope.BudgetScope
+	BudgetsClient budgetsiface.BudgetsAPI
+	STSClient     stsiface.STSAPI
+}
+
+// NewService returns a new service given the api clients.
+func NewService(budgetScope scope.BudgetScope) *Service {
+	return &Service{
+		scope:         budgetScope,
+		BudgetsClient: scope.NewBudgetsClient(budgetScope, budgetScope, budgetScope, budgetScope.InfraCluster()),
+		STSClient:     scope.NewSTSClient(budgetScope, budgetScope, budgetScope, budgetScope.InfraCluster()),
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudprovider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+const (
+	// ConfigMapName is the name of the ConfigMap holding the cloud provider configuration in the workload cluster.
+	ConfigMapName = "aws-cloud-config"
+
+	// CloudConfigKey is the key of the configuration file of the cloud-controller-manager in the ConfigMap.
+	CloudConfigKey = "cloud.conf"
+)
+
+// ReconcileCloudProviderConfig writes the cloud provider configuration derived from the cluster to the workload
+// cluster, or deletes it when it is disabled.
+func (s *Service) ReconcileCloudProviderConfig(ctx context.Context) error {
+	s.scope.Debug("Reconciling cloud provider configuration in cluster", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
+
+	remoteClient, err := s.scope.RemoteClient()
+	if err != nil {
+		return fmt.Errorf("getting client for remote cluster: %w", err)
+	}
+
+	existing := &corev1.ConfigMap{}
+	if err := remoteClient.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: ConfigMapName}, existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("getting cloud provider config map: %w", err)
+		}
+		existing = nil
+	}
+
+	if s.scope.CloudProviderConfig() == nil {
+		if existing == nil {
+			return nil
+		}
+		s.scope.Info("Deleting cloud provider configuration", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
+		if err := remoteClient.Delete(ctx, existing); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting cloud provider config map: %w", err)
+		}
+		return nil
+	}
+
+	data := s.configData()
+	if existing == nil {
+		s.scope.Info("Creating cloud provider configuration", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceSystem,
+				Name:      ConfigMapName,
+				Labels: map[string]string{
+					"app.kubernetes.io/managed-by": "cluster-api-provider-aws",
+					"app.kubernetes.io/part-of":    s.scope.Name(),
+				},
+			},
+			Data: data,
+		}
+		if err := remoteClient.Create(ctx, configMap); err != nil {
+			return fmt.Errorf("creating cloud provider config map: %w", err)
+		}
+		return nil
+	}
+
+	if maps.Equal(existing.Data, data) {
+		return nil
+	}
+	s.scope.Info("Updating cloud provider configuration", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
+	existing.Data = data
+	if err := remoteClient.Update(ctx, existing); err != nil {
+		return fmt.Errorf("updating cloud provider config map: %w", err)
+	}
+	return nil
+}
+
+// configData returns the content of the cloud provider ConfigMap: the configuration file of the
+// cloud-controller-manager, and the individual settings the CSI drivers and Helm values can refer to.
+func (s *Service) configData() map[string]string {
+	clusterName := s.scope.KubernetesClusterName()
+	vpcID := s.scope.VPC().ID
+	roleARN := s.scope.CloudProviderConfig().RoleARN
+
+	data := map[string]string{
+		"clusterName":      clusterName,
+		"clusterTagKey":    infrav1.ClusterTagKey(clusterName),
+		"region":           s.scope.Region(),
+		"vpcID":            vpcID,
+		"publicSubnetIDs":  strings.Join(s.scope.Subnets().FilterPublic().IDs(), ","),
+		"privateSubnetIDs": strings.Join(s.scope.Subnets().FilterPrivate().IDs(), ","),
+	}
+	if roleARN != "" {
+		data["roleARN"] = roleARN
+	}
+
+	// The configuration file uses the gcfg format read by the cloud-controller-manager.
+	var conf strings.Builder
+	conf.WriteString("[Global]\n")
+	fmt.Fprintf(&conf, "Region = %s\n", s.scope.Region())
+	fmt.Fprintf(&conf, "VPC = %s\n", vpcID)
+	fmt.Fprintf(&conf, "KubernetesClusterTag = %s\n", clusterName)
+	fmt.Fprintf(&conf, "KubernetesClusterID = %s\n", clusterName)
+	if roleARN != "" {
+		fmt.Fprintf(&conf, "RoleARN = %s\n", roleARN)
+	}
+	if s.scope.VPC().IsIPv6Enabled() {
+		conf.WriteString("NodeIPFamilies = ipv6\n")
+	}
+	conf.WriteString("NodeIPFamilies = ipv4\n")
+	data[CloudConfigKey] = conf.String()
+
+	return data
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudprovider
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+type testScope struct {
+	*scope.ClusterScope
+	remoteClient client.Client
+}
+
+func (s *testScope) RemoteClient() (client.Client, error) {
+	return s.remoteClient, nil
+}
+
+func TestReconcileCloudProviderConfig(t *testing.T) {
+	network := infrav1.NetworkSpec{
+		VPC: infrav1.VPCSpec{ID: "vpc-1"},
+		Subnets: infrav1.Subnets{
+			{ID: "subnet-public-1", AvailabilityZone: "us-east-1a", IsPublic: true},
+			{ID: "subnet-private-1", AvailabilityZone: "us-east-1a"},
+			{ID: "subnet-private-2", AvailabilityZone: "us-east-1b"},
+		},
+	}
+	wantData := map[string]string{
+		"clusterName":      "test-cluster",
+		"clusterTagKey":    "sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster",
+		"region":           "us-east-1",
+		"vpcID":            "vpc-1",
+		"publicSubnetIDs":  "subnet-public-1",
+		"privateSubnetIDs": "subnet-private-1,subnet-private-2",
+		"roleARN":          "arn:aws:iam::123456789012:role/ccm",
+		CloudConfigKey: `[Global]
+Region = us-east-1
+VPC = vpc-1
+KubernetesClusterTag = test-cluster
+KubernetesClusterID = test-cluster
+RoleARN = arn:aws:iam::123456789012:role/ccm
+NodeIPFamilies = ipv4
+`,
+	}
+
+	tests := []struct {
+		name                string
+		cloudProviderConfig *infrav1.CloudProviderConfig
+		existing            *corev1.ConfigMap
+		wantData            map[string]string
+	}{
+		{
+			name:                "creates the configuration",
+			cloudProviderConfig: &infrav1.CloudProviderConfig{RoleARN: "arn:aws:iam::123456789012:role/ccm"},
+			wantData:            wantData,
+		},
+		{
+			name:                "updates an outdated configuration",
+			cloudProviderConfig: &infrav1.CloudProviderConfig{RoleARN: "arn:aws:iam::123456789012:role/ccm"},
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: ConfigMapName},
+				Data:       map[string]string{"vpcID": "vpc-0"},
+			},
+			wantData: wantData,
+		},
+		{
+			name: "deletes the configuration once disabled",
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: ConfigMapName},
+				Data:       wantData,
+			},
+		},
+		{
+			name: "does nothing when disabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = corev1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						Region:              "us-east-1",
+						NetworkSpec:         network,
+						CloudProviderConfig: tt.cloudProviderConfig,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			remoteClientBuilder := fake.NewClientBuilder().WithScheme(scheme)
+			if tt.existing != nil {
+				remoteClientBuilder = remoteClientBuilder.WithObjects(tt.existing)
+			}
+			remoteClient := remoteClientBuilder.Build()
+
+			s := NewService(&testScope{ClusterScope: clusterScope, remoteClient: remoteClient})
+			g.Expect(s.ReconcileCloudProviderConfig(context.TODO())).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			err = remoteClient.Get(context.TODO(), types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: ConfigMapName}, configMap)
+			if tt.wantData == nil {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(configMap.Data).To(Equal(tt.wantData))
+		})
+	}
+}
+
+func TestConfigDataIPv6(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				Region:              "us-east-1",
+				NetworkSpec:         infrav1.NetworkSpec{VPC: infrav1.VPCSpec{ID: "vpc-1", IPv6: &infrav1.IPv6{}}},
+				CloudProviderConfig: &infrav1.CloudProviderConfig{},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(&testScope{ClusterScope: clusterScope})
+	g.Expect(s.configData()[CloudConfigKey]).To(Equal(`[Global]
+Region = us-east-1
+VPC = vpc-1
+KubernetesClusterTag = test-cluster
+KubernetesClusterID = test-cluster
+NodeIPFamilies = ipv6
+NodeIPFamilies = ipv4
+`))
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// maxAlarmsPerDelete is the maximum number of alarms which can be deleted with a single call.
+	maxAlarmsPerDelete = 100
+
+	// failedLaunchesPeriods is the number of periods of 5 minutes during which the instances of an Auto Scaling
+	// group must be below its desired capacity for its launches to be considered failed.
+	failedLaunchesPeriods = 3
+)
+
+// alarmNamePrefix returns the prefix of the names of the alarms of the cluster. Cluster names cannot contain
+// slashes, so the alarms of a cluster never share the prefix of the alarms of another cluster.
+func (s *Service) alarmNamePrefix() string {
+	return s.scope.Name() + "/"
+}
+
+func (s *Service) reconcileAlarms(ctx context.Context, resources *clusterResources) error {
+	existing, err := s.describeAlarms(ctx)
+	if err != nil {
+		return err
+	}
+
+	var desired []*cloudwatch.PutMetricAlarmInput
+	if alarms := s.scope.Observability().Alarms; alarms != nil {
+		desired = s.desiredAlarms(resources, alarms.Actions)
+	}
+
+	for _, alarm := range desired {
+		name := aws.StringValue(alarm.AlarmName)
+		current, found := existing[name]
+		delete(existing, name)
+		if found && alarmUpToDate(current, alarm) {
+			continue
+		}
+
+		if _, err := s.CloudWatchClient.PutMetricAlarmWithContext(ctx, alarm); err != nil {
+			return errors.Wrapf(err, "failed to put CloudWatch alarm %q", name)
+		}
+		s.scope.Info("Reconciled CloudWatch alarm", "name", name)
+	}
+
+	return s.deleteAlarms(ctx, existing)
+}
+
+// deleteAlarms deletes the given alarms of the cluster, or all of them if alarms is nil.
+func (s *Service) deleteAlarms(ctx context.Context, alarms map[string]*cloudwatch.MetricAlarm) error {
+	if alarms == nil {
+		var err error
+		if alarms, err = s.describeAlarms(ctx); err != nil {
+			return err
+		}
+	}
+
+	names := sets.List(sets.KeySet(alarms))
+	for len(names) > 0 {
+		batch := names[:min(len(names), maxAlarmsPerDelete)]
+		names = names[len(batch):]
+
+		if _, err := s.CloudWatchClient.DeleteAlarmsWithContext(ctx, &cloudwatch.DeleteAlarmsInput{AlarmNames: aws.StringSlice(batch)}); err != nil {
+			return errors.Wrapf(err, "failed to delete CloudWatch alarms %s", strings.Join(batch, ", "))
+		}
+		s.scope.Info("Deleted CloudWatch alarms", "names", batch)
+	}
+	return nil
+}
+
+// describeAlarms returns the alarms of the cluster by name.
+func (s *Service) describeAlarms(ctx context.Context) (map[string]*cloudwatch.MetricAlarm, error) {
+	alarms := map[string]*cloudwatch.MetricAlarm{}
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNamePrefix: aws.String(s.alarmNamePrefix()),
+		AlarmTypes:      aws.StringSlice([]string{cloudwatch.AlarmTypeMetricAlarm}),
+	}
+	if err := s.CloudWatchClient.DescribeAlarmsPagesWithContext(ctx, input, func(out *cloudwatch.DescribeAlarmsOutput, _ bool) bool {
+		for _, alarm := range out.MetricAlarms {
+			alarms[aws.StringValue(alarm.AlarmName)] = alarm
+		}
+		return true
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to describe the CloudWatch alarms of the cluster")
+	}
+	return alarms, nil
+}
+
+// desiredAlarms returns the alarms watching the NAT gateways, network load balancers and Auto Scaling groups
+// of the cluster.
+func (s *Service) desiredAlarms(resources *clusterResources, actions []string) []*cloudwatch.PutMetricAlarmInput {
+	var alarms []*cloudwatch.PutMetricAlarmInput
+	newAlarm := func(name, description string) *cloudwatch.PutMetricAlarmInput {
+		return &cloudwatch.PutMetricAlarmInput{
+			AlarmName:          aws.String(s.alarmNamePrefix() + name),
+			AlarmDescription:   aws.String(fmt.Sprintf("%s Managed by the cluster %s/%s.", description, s.scope.Namespace(), s.scope.Name())),
+			AlarmActions:       aws.StringSlice(actions),
+			ComparisonOperator: aws.String(cloudwatch.ComparisonOperatorGreaterThanThreshold),
+			Threshold:          aws.Float64(0),
+			TreatMissingData:   aws.String("notBreaching"),
+		}
+	}
+
+	for _, id := range resources.natGatewayIDs {
+		alarm := newAlarm(fmt.Sprintf("nat-gateway/%s/port-allocation-errors", id),
+			fmt.Sprintf("NAT gateway %s could not allocate source ports.", id))
+		alarm.Namespace = aws.String("AWS/NATGateway")
+		alarm.MetricName = aws.String("ErrorPortAllocation")
+		alarm.Dimensions = []*cloudwatch.Dimension{{Name: aws.String("NatGatewayId"), Value: aws.String(id)}}
+		alarm.Statistic = aws.String(cloudwatch.StatisticSum)
+		alarm.Period = aws.Int64(300)
+		alarm.EvaluationPeriods = aws.Int64(1)
+		alarms = append(alarms, alarm)
+	}
+
+	for _, lb := range resources.loadBalancers {
+		for _, targetGroup := range lb.targetGroups {
+			alarm := newAlarm(fmt.Sprintf("%s/unhealthy-hosts", targetGroup),
+				fmt.Sprintf("Target group %s of load balancer %s has unhealthy targets.", targetGroup, lb.name))
+			alarm.Namespace = aws.String("AWS/NetworkELB")
+			alarm.MetricName = aws.String("UnHealthyHostCount")
+			alarm.Dimensions = []*cloudwatch.Dimension{
+				{Name: aws.String("LoadBalancer"), Value: aws.String(lb.name)},
+				{Name: aws.String("TargetGroup"), Value: aws.String(targetGroup)},
+			}
+			alarm.Statistic = aws.String(cloudwatch.StatisticMaximum)
+			alarm.Period = aws.Int64(60)
+			alarm.EvaluationPeriods = aws.Int64(5)
+			alarms = append(alarms, alarm)
+		}
+	}
+
+	// Auto Scaling groups do not publish a metric for failed launches: launches are considered failed when the
+	// instances in service stay below the desired capacity.
+	for _, group := range resources.autoScalingGroups {
+		name := aws.StringValue(group.AutoScalingGroupName)
+		alarm := newAlarm(fmt.Sprintf("auto-scaling-group/%s/failed-launches", name),
+			fmt.Sprintf("Auto Scaling group %s failed to launch instances.", name))
+		groupMetric := func(id, metricName, statistic string) *cloudwatch.MetricDataQuery {
+			return &cloudwatch.MetricDataQuery{
+				Id: aws.String(id),
+				MetricStat: &cloudwatch.MetricStat{
+					Metric: &cloudwatch.Metric{
+						Namespace:  aws.String("AWS/AutoScaling"),
+						MetricName: aws.String(metricName),
+						Dimensions: []*cloudwatch.Dimension{{Name: aws.String("AutoScalingGroupName"), Value: aws.String(name)}},
+					},
+					Period: aws.Int64(300),
+					Stat:   aws.String(statistic),
+				},
+				ReturnData: aws.Bool(false),
+			}
+		}
+		alarm.Metrics = []*cloudwatch.MetricDataQuery{
+			groupMetric("desired", metricGroupDesiredCapacity, cloudwatch.StatisticMinimum),
+			groupMetric("inservice", metricGroupInServiceInstances, cloudwatch.StatisticMaximum),
+			{
+				Id:         aws.String("missing"),
+				Expression: aws.String("desired - inservice"),
+				Label:      aws.String("Missing instances"),
+				ReturnData: aws.Bool(true),
+			},
+		}
+		alarm.EvaluationPeriods = aws.Int64(failedLaunchesPeriods)
+		alarms = append(alarms, alarm)
+	}
+
+	return alarms
+}
+
+// alarmUpToDate returns whether the existing alarm has the configuration of the desired alarm. The metrics of an
+// alarm are identified by its name, only the fields which can change for a given name are compared.
+func alarmUpToDate(current *cloudwatch.MetricAlarm, desired *cloudwatch.PutMetricAlarmInput) bool {
+	return aws.Float64Value(current.Threshold) == aws.Float64Value(desired.Threshold) &&
+		aws.Int64Value(current.EvaluationPeriods) == aws.Int64Value(desired.EvaluationPeriods) &&
+		aws.StringValue(current.AlarmDescription) == aws.StringValue(desired.AlarmDescription) &&
+		sets.New(aws.StringValueSlice(current.AlarmActions)...).Equal(sets.New(aws.StringValueSlice(desired.AlarmActions)...))
+}
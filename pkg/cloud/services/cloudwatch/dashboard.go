@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+)
+
+// dashboard is the body of a CloudWatch dashboard, see
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+type dashboard struct {
+	Widgets []widget `json:"widgets"`
+}
+
+type widget struct {
+	Type       string           `json:"type"`
+	Width      int              `json:"width"`
+	Height     int              `json:"height"`
+	Properties widgetProperties `json:"properties"`
+}
+
+type widgetProperties struct {
+	Title   string     `json:"title"`
+	Region  string     `json:"region"`
+	View    string     `json:"view"`
+	Stat    string     `json:"stat"`
+	Period  int        `json:"period"`
+	Metrics [][]string `json:"metrics"`
+}
+
+// dashboardName returns the name of the dashboard of the cluster. Dashboard names cannot contain dots.
+func (s *Service) dashboardName() string {
+	return strings.ReplaceAll(s.scope.Name(), ".", "_")
+}
+
+func (s *Service) reconcileDashboard(ctx context.Context, resources *clusterResources) error {
+	if !s.scope.Observability().Dashboard {
+		return s.deleteDashboard(ctx)
+	}
+
+	body, err := json.Marshal(s.desiredDashboard(resources))
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal the CloudWatch dashboard")
+	}
+
+	out, err := s.CloudWatchClient.GetDashboardWithContext(ctx, &cloudwatch.GetDashboardInput{DashboardName: aws.String(s.dashboardName())})
+	if err != nil {
+		if code, _ := awserrors.Code(err); code != cloudwatch.ErrCodeDashboardNotFoundError {
+			return errors.Wrapf(err, "failed to get CloudWatch dashboard %q", s.dashboardName())
+		}
+	} else if aws.StringValue(out.DashboardBody) == string(body) {
+		return nil
+	}
+
+	putOut, err := s.CloudWatchClient.PutDashboardWithContext(ctx, &cloudwatch.PutDashboardInput{
+		DashboardName: aws.String(s.dashboardName()),
+		DashboardBody: aws.String(string(body)),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to put CloudWatch dashboard %q", s.dashboardName())
+	}
+	for _, message := range putOut.DashboardValidationMessages {
+		s.scope.Info("CloudWatch dashboard validation warning", "name", s.dashboardName(), "message", aws.StringValue(message.Message))
+	}
+	s.scope.Info("Reconciled CloudWatch dashboard", "name", s.dashboardName())
+	return nil
+}
+
+func (s *Service) deleteDashboard(ctx context.Context) error {
+	if _, err := s.CloudWatchClient.DeleteDashboardsWithContext(ctx, &cloudwatch.DeleteDashboardsInput{
+		DashboardNames: aws.StringSlice([]string{s.dashboardName()}),
+	}); err != nil {
+		if code, _ := awserrors.Code(err); code == cloudwatch.ErrCodeDashboardNotFoundError {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to delete CloudWatch dashboard %q", s.dashboardName())
+	}
+	s.scope.Info("Deleted CloudWatch dashboard", "name", s.dashboardName())
+	return nil
+}
+
+// desiredDashboard returns a dashboard showing the metrics of the NAT gateways, network load balancers and Auto
+// Scaling groups of the cluster. Widgets without metrics are omitted.
+func (s *Service) desiredDashboard(resources *clusterResources) *dashboard {
+	newWidget := func(title, stat string, period int, metrics [][]string) widget {
+		return widget{
+			Type:   "metric",
+			Width:  12,
+			Height: 6,
+			Properties: widgetProperties{
+				Title:   title,
+				Region:  s.scope.Region(),
+				View:    "timeSeries",
+				Stat:    stat,
+				Period:  period,
+				Metrics: metrics,
+			},
+		}
+	}
+
+	var portAllocationErrors, activeConnections [][]string
+	for _, id := range resources.natGatewayIDs {
+		portAllocationErrors = append(portAllocationErrors, []string{"AWS/NATGateway", "ErrorPortAllocation", "NatGatewayId", id})
+		activeConnections = append(activeConnections, []string{"AWS/NATGateway", "ActiveConnectionCount", "NatGatewayId", id})
+	}
+
+	var unhealthyHosts, healthyHosts [][]string
+	for _, lb := range resources.loadBalancers {
+		for _, targetGroup := range lb.targetGroups {
+			unhealthyHosts = append(unhealthyHosts, []string{"AWS/NetworkELB", "UnHealthyHostCount", "LoadBalancer", lb.name, "TargetGroup", targetGroup})
+			healthyHosts = append(healthyHosts, []string{"AWS/NetworkELB", "HealthyHostCount", "LoadBalancer", lb.name, "TargetGroup", targetGroup})
+		}
+	}
+
+	var groupCapacity [][]string
+	for _, group := range resources.autoScalingGroups {
+		name := aws.StringValue(group.AutoScalingGroupName)
+		groupCapacity = append(groupCapacity,
+			[]string{"AWS/AutoScaling", metricGroupDesiredCapacity, "AutoScalingGroupName", name},
+			[]string{"AWS/AutoScaling", metricGroupInServiceInstances, "AutoScalingGroupName", name},
+		)
+	}
+
+	d := &dashboard{Widgets: []widget{}}
+	for _, w := range []widget{
+		newWidget("NAT gateway port allocation errors", cloudwatch.StatisticSum, 300, portAllocationErrors),
+		newWidget("NAT gateway active connections", cloudwatch.StatisticMaximum, 300, activeConnections),
+		newWidget("Load balancer unhealthy targets", cloudwatch.StatisticMaximum, 60, unhealthyHosts),
+		newWidget("Load balancer healthy targets", cloudwatch.StatisticMinimum, 60, healthyHosts),
+		newWidget("Auto Scaling group capacity", cloudwatch.StatisticMaximum, 60, groupCapacity),
+	} {
+		if len(w.Properties.Metrics) > 0 {
+			d.Widgets = append(d.Widgets, w)
+		}
+	}
+	return d
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+const (
+	// metricGroupDesiredCapacity and metricGroupInServiceInstances are the metrics of the Auto Scaling groups
+	// used by the alarms and the dashboard, which are only published once their collection is enabled.
+	metricGroupDesiredCapacity    = "GroupDesiredCapacity"
+	metricGroupInServiceInstances = "GroupInServiceInstances"
+
+	// metricsGranularity is the only granularity supported by the Auto Scaling group metrics.
+	metricsGranularity = "1Minute"
+)
+
+// clusterResources are the AWS resources of the cluster watched by the alarms and shown on the dashboard.
+type clusterResources struct {
+	natGatewayIDs     []string
+	loadBalancers     []networkLoadBalancer
+	autoScalingGroups []autoscalingtypes.AutoScalingGroup
+}
+
+// networkLoadBalancer is a network load balancer of the cluster, identified as in the dimensions of its metrics.
+type networkLoadBalancer struct {
+	name         string
+	targetGroups []string
+}
+
+// ReconcileObservability creates or updates the CloudWatch alarms and dashboard of the cluster, and deletes the
+// alarms and the dashboard which are not wanted anymore.
+func (s *Service) ReconcileObservability(ctx context.Context) error {
+	observability := s.scope.Observability()
+	if observability == nil || (observability.Alarms == nil && !observability.Dashboard) {
+		return s.DeleteObservability(ctx)
+	}
+
+	resources, err := s.describeResources(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.enableGroupMetrics(ctx, resources.autoScalingGroups); err != nil {
+		return err
+	}
+
+	if err := s.reconcileAlarms(ctx, resources); err != nil {
+		return err
+	}
+	return s.reconcileDashboard(ctx, resources)
+}
+
+// DeleteObservability deletes the CloudWatch alarms and dashboard of the cluster.
+func (s *Service) DeleteObservability(ctx context.Context) error {
+	if err := s.deleteAlarms(ctx, nil); err != nil {
+		return err
+	}
+	return s.deleteDashboard(ctx)
+}
+
+func (s *Service) describeResources(ctx context.Context) (*clusterResources, error) {
+	resources := &clusterResources{}
+
+	natGatewayIDs := sets.New[string]()
+	for _, subnet := range s.scope.Subnets() {
+		if subnet.NatGatewayID != nil {
+			natGatewayIDs.Insert(*subnet.NatGatewayID)
+		}
+	}
+	resources.natGatewayIDs = sets.List(natGatewayIDs)
+
+	for _, lb := range []infrav1.LoadBalancer{s.scope.Network().APIServerELB, s.scope.Network().SecondaryAPIServerELB} {
+		if lb.LoadBalancerType != infrav1.LoadBalancerTypeNLB || lb.ARN == "" {
+			continue
+		}
+		nlb, err := s.describeNetworkLoadBalancer(ctx, lb.ARN)
+		if err != nil {
+			return nil, err
+		}
+		resources.loadBalancers = append(resources.loadBalancers, *nlb)
+	}
+
+	groups, err := s.describeAutoScalingGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources.autoScalingGroups = groups
+	return resources, nil
+}
+
+func (s *Service) describeNetworkLoadBalancer(ctx context.Context, lbARN string) (*networkLoadBalancer, error) {
+	name, err := metricDimension(lbARN, "loadbalancer/")
+	if err != nil {
+		return nil, err
+	}
+	nlb := &networkLoadBalancer{name: name}
+
+	out, err := s.ELBV2Client.DescribeTargetGroupsWithContext(ctx, &elbv2.DescribeTargetGroupsInput{LoadBalancerArn: aws.String(lbARN)})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe the target groups of load balancer %q", lbARN)
+	}
+	for _, tg := range out.TargetGroups {
+		targetGroup, err := metricDimension(aws.StringValue(tg.TargetGroupArn), "")
+		if err != nil {
+			return nil, err
+		}
+		nlb.targetGroups = append(nlb.targetGroups, targetGroup)
+	}
+	return nlb, nil
+}
+
+// metricDimension returns the value identifying an Elastic Load Balancing resource in the dimensions of its
+// metrics, that is the resource of its ARN without the given prefix.
+func metricDimension(resourceARN, prefix string) (string, error) {
+	parsed, err := arn.Parse(resourceARN)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse ARN %q", resourceARN)
+	}
+	return strings.TrimPrefix(parsed.Resource, prefix), nil
+}
+
+func (s *Service) describeAutoScalingGroups(ctx context.Context) ([]autoscalingtypes.AutoScalingGroup, error) {
+	var groups []autoscalingtypes.AutoScalingGroup
+	input := &autoscaling.DescribeAutoScalingGroupsInput{
+		Filters: []autoscalingtypes.Filter{
+			{Name: aws.String("tag-key"), Values: []string{infrav1.ClusterTagKey(s.scope.Name())}},
+		},
+	}
+	for {
+		out, err := s.ASGClient.DescribeAutoScalingGroups(ctx, input)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe the Auto Scaling groups of the cluster")
+		}
+		groups = append(groups, out.AutoScalingGroups...)
+		if out.NextToken == nil {
+			return groups, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+// enableGroupMetrics enables the collection of the metrics of the Auto Scaling groups of the cluster used by the
+// alarms and the dashboard.
+func (s *Service) enableGroupMetrics(ctx context.Context, groups []autoscalingtypes.AutoScalingGroup) error {
+	for _, group := range groups {
+		enabled := sets.New[string]()
+		for _, metric := range group.EnabledMetrics {
+			enabled.Insert(aws.StringValue(metric.Metric))
+		}
+		if enabled.HasAll(metricGroupDesiredCapacity, metricGroupInServiceInstances) {
+			continue
+		}
+
+		if _, err := s.ASGClient.EnableMetricsCollection(ctx, &autoscaling.EnableMetricsCollectionInput{
+			AutoScalingGroupName: group.AutoScalingGroupName,
+			Granularity:          aws.String(metricsGranularity),
+			Metrics:              []string{metricGroupDesiredCapacity, metricGroupInServiceInstances},
+		}); err != nil {
+			return errors.Wrapf(err, "failed to enable the metrics collection of Auto Scaling group %q", aws.StringValue(group.AutoScalingGroupName))
+		}
+		s.scope.Debug("Enabled the metrics collection of Auto Scaling group", "name", aws.StringValue(group.AutoScalingGroupName))
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/cloudwatch/mock_cloudwatchiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	lbARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/test-cluster-apiserver/50dc6c495c0c9188"
+	tgARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/apiserver-target/73e2d6bc24d8a067"
+)
+
+var dashboardNotFound = awserr.New(cloudwatch.ErrCodeDashboardNotFoundError, "dashboard not found", nil)
+
+func TestReconcileObservability(t *testing.T) {
+	tests := []struct {
+		name          string
+		observability *infrav1.Observability
+		expect        func(cw *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, elb *mocks.MockELBV2APIMockRecorder, asg *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+	}{
+		{
+			name: "creates the alarms and the dashboard and deletes the stale alarms",
+			observability: &infrav1.Observability{
+				Alarms:    &infrav1.CloudWatchAlarms{Actions: []string{"arn:aws:sns:us-east-1:123456789012:oncall"}},
+				Dashboard: true,
+			},
+			expect: func(cw *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, elb *mocks.MockELBV2APIMockRecorder, asg *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				elb.DescribeTargetGroupsWithContext(gomock.Any(), &elbv2.DescribeTargetGroupsInput{LoadBalancerArn: aws.String(lbARN)}).
+					Return(&elbv2.DescribeTargetGroupsOutput{TargetGroups: []*elbv2.TargetGroup{{TargetGroupArn: aws.String(tgARN)}}}, nil)
+				asg.DescribeAutoScalingGroups(gomock.Any(), gomock.Any()).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+					AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{{AutoScalingGroupName: aws.String("workers")}},
+				}, nil)
+				asg.EnableMetricsCollection(gomock.Any(), &autoscaling.EnableMetricsCollectionInput{
+					AutoScalingGroupName: aws.String("workers"),
+					Granularity:          aws.String("1Minute"),
+					Metrics:              []string{"GroupDesiredCapacity", "GroupInServiceInstances"},
+				}).Return(&autoscaling.EnableMetricsCollectionOutput{}, nil)
+				expectDescribeAlarms(cw, "test-cluster/nat-gateway/nat-old/port-allocation-errors")
+				for _, name := range []string{
+					"test-cluster/nat-gateway/nat-1/port-allocation-errors",
+					"test-cluster/targetgroup/apiserver-target/73e2d6bc24d8a067/unhealthy-hosts",
+					"test-cluster/auto-scaling-group/workers/failed-launches",
+				} {
+					cw.PutMetricAlarmWithContext(gomock.Any(), alarmNamed(name)).Return(&cloudwatch.PutMetricAlarmOutput{}, nil)
+				}
+				cw.DeleteAlarmsWithContext(gomock.Any(), &cloudwatch.DeleteAlarmsInput{
+					AlarmNames: aws.StringSlice([]string{"test-cluster/nat-gateway/nat-old/port-allocation-errors"}),
+				}).Return(&cloudwatch.DeleteAlarmsOutput{}, nil)
+				cw.GetDashboardWithContext(gomock.Any(), &cloudwatch.GetDashboardInput{DashboardName: aws.String("test-cluster")}).Return(nil, dashboardNotFound)
+				cw.PutDashboardWithContext(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *cloudwatch.PutDashboardInput, _ ...request.Option) (*cloudwatch.PutDashboardOutput, error) {
+					body := &dashboard{}
+					if err := json.Unmarshal([]byte(aws.StringValue(input.DashboardBody)), body); err != nil {
+						t.Fatalf("invalid dashboard body: %v", err)
+					}
+					if len(body.Widgets) != 5 {
+						t.Fatalf("expected 5 widgets, got %d", len(body.Widgets))
+					}
+					return &cloudwatch.PutDashboardOutput{}, nil
+				})
+			},
+		},
+		{
+			name:          "leaves the up to date alarms untouched and deletes the dashboard once disabled",
+			observability: &infrav1.Observability{Alarms: &infrav1.CloudWatchAlarms{}},
+			expect: func(cw *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, elb *mocks.MockELBV2APIMockRecorder, asg *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				elb.DescribeTargetGroupsWithContext(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{}, nil)
+				asg.DescribeAutoScalingGroups(gomock.Any(), gomock.Any()).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+				cw.DescribeAlarmsPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *cloudwatch.DescribeAlarmsInput, fn func(*cloudwatch.DescribeAlarmsOutput, bool) bool, _ ...request.Option) error {
+					fn(&cloudwatch.DescribeAlarmsOutput{MetricAlarms: []*cloudwatch.MetricAlarm{{
+						AlarmName:         aws.String("test-cluster/nat-gateway/nat-1/port-allocation-errors"),
+						AlarmDescription:  aws.String("NAT gateway nat-1 could not allocate source ports. Managed by the cluster default/test-cluster."),
+						Threshold:         aws.Float64(0),
+						EvaluationPeriods: aws.Int64(1),
+					}}}, true)
+					return nil
+				})
+				cw.DeleteDashboardsWithContext(gomock.Any(), gomock.Any()).Return(nil, dashboardNotFound)
+			},
+		},
+		{
+			name: "deletes the alarms and the dashboard once disabled",
+			expect: func(cw *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, elb *mocks.MockELBV2APIMockRecorder, asg *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				expectDescribeAlarms(cw, "test-cluster/nat-gateway/nat-1/port-allocation-errors")
+				cw.DeleteAlarmsWithContext(gomock.Any(), gomock.Any()).Return(&cloudwatch.DeleteAlarmsOutput{}, nil)
+				cw.DeleteDashboardsWithContext(gomock.Any(), &cloudwatch.DeleteDashboardsInput{
+					DashboardNames: aws.StringSlice([]string{"test-cluster"}),
+				}).Return(&cloudwatch.DeleteDashboardsOutput{}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			cwMock := mock_cloudwatchiface.NewMockCloudWatchAPI(mockCtrl)
+			elbMock := mocks.NewMockELBV2API(mockCtrl)
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(cwMock.EXPECT(), elbMock.EXPECT(), asgMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						Region:        "us-east-1",
+						Observability: tt.observability,
+						NetworkSpec: infrav1.NetworkSpec{
+							Subnets: infrav1.Subnets{
+								{ID: "subnet-public-1", IsPublic: true, NatGatewayID: aws.String("nat-1")},
+								{ID: "subnet-public-2", IsPublic: true, NatGatewayID: aws.String("nat-1")},
+							},
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							APIServerELB: infrav1.LoadBalancer{ARN: lbARN, LoadBalancerType: infrav1.LoadBalancerTypeNLB},
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.CloudWatchClient = cwMock
+			s.ELBV2Client = elbMock
+			s.ASGClient = asgMock
+
+			g.Expect(s.ReconcileObservability(context.TODO())).To(Succeed())
+		})
+	}
+}
+
+func expectDescribeAlarms(cw *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, names ...string) {
+	cw.DescribeAlarmsPagesWithContext(gomock.Any(), &cloudwatch.DescribeAlarmsInput{
+		AlarmNamePrefix: aws.String("test-cluster/"),
+		AlarmTypes:      aws.StringSlice([]string{cloudwatch.AlarmTypeMetricAlarm}),
+	}, gomock.Any()).DoAndReturn(func(_ context.Context, _ *cloudwatch.DescribeAlarmsInput, fn func(*cloudwatch.DescribeAlarmsOutput, bool) bool, _ ...request.Option) error {
+		out := &cloudwatch.DescribeAlarmsOutput{}
+		for _, name := range names {
+			out.MetricAlarms = append(out.MetricAlarms, &cloudwatch.MetricAlarm{AlarmName: aws.String(name)})
+		}
+		fn(out, true)
+		return nil
+	})
+}
+
+// alarmNamed matches the PutMetricAlarmInput of the alarm with the given name.
+type alarmNamed string
+
+func (m alarmNamed) Matches(x interface{}) bool {
+	input, ok := x.(*cloudwatch.PutMetricAlarmInput)
+	return ok && aws.StringValue(input.AlarmName) == string(m)
+}
+
+func (m alarmNamed) String() string {
+	return "is the alarm " + string(m)
+}
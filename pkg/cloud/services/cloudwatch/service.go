@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cloudwatch provides a way to interact with Amazon CloudWatch.
+package cloudwatch
+
+import (
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	asg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling"
+)
+
+// Service holds a collection of interfaces.
+// The interfaces are broken down like this to group functions together.
+// One alternative is to have a large list of functions from the cloudwatch client.
+type Service struct {
+	scope            scope.ObservabilityScope
+	CloudWatchClient cloudwatchiface.CloudWatchAPI
+	ELBV2Client      elbv2iface.ELBV2API
+	ASGClient        asg.AutoScalingAPI
+}
+
+// NewService returns a new service given the api clients.
+func NewService(observabilityScope scope.ObservabilityScope) *Service {
+	return &Service{
+		scope:            observabilityScope,
+		CloudWatchClient: scope.NewCloudWatchClient(observabilityScope, observabilityScope, observabilityScope, observabilityScope.InfraCluster()),
+		ELBV2Client:      scope.NewELBv2Client(observabilityScope, observabilityScope, observabilityScope, observabilityScope.InfraCluster()),
+		ASGClient:        scope.NewASGClient(observabilityScope, observabilityScope, observabilityScope, observabilityScope.InfraCluster()),
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatchlogs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// LogGroupName returns the name of the log group shared by the machines of the given class of a cluster.
+func LogGroupName(clusterName, class string) string {
+	return logGroupPrefix(clusterName) + class
+}
+
+func logGroupPrefix(clusterName string) string {
+	return fmt.Sprintf("/aws/capa/%s/", clusterName)
+}
+
+// ReconcileLogGroup ensures that the log group with the given name exists, and that its log events are retained
+// for the given number of days. The retention of log groups which were not created by the controller is left
+// untouched.
+func (s *Service) ReconcileLogGroup(name string, retentionInDays int32) error {
+	logGroup, err := s.describeLogGroup(name)
+	if err != nil {
+		return err
+	}
+
+	if logGroup == nil {
+		s.scope.Debug("Creating CloudWatch log group", "log-group", name)
+		_, err := s.CloudWatchLogsClient.CreateLogGroupWithContext(context.TODO(), &cloudwatchlogs.CreateLogGroupInput{
+			LogGroupName: aws.String(name),
+			Tags:         aws.StringMap(infrav1.Build(s.getLogGroupTagParams(name))),
+		})
+		// The log group is shared by the machines of the same class, which may be created concurrently.
+		if err != nil && !isErrorCode(err, cloudwatchlogs.ErrCodeResourceAlreadyExistsException) {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateLogGroup", "Failed to create CloudWatch log group %q: %v", name, err)
+			return errors.Wrapf(err, "failed to create CloudWatch log group %q", name)
+		}
+		if err == nil {
+			record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateLogGroup", "Created CloudWatch log group %q", name)
+		}
+		return s.putRetentionPolicy(name, retentionInDays)
+	}
+
+	owned, err := s.isOwned(logGroup)
+	if err != nil {
+		return err
+	}
+	if !owned {
+		s.scope.Debug("CloudWatch log group is not owned by the cluster, leaving its retention untouched", "log-group", name)
+		return nil
+	}
+	if aws.Int64Value(logGroup.RetentionInDays) == int64(retentionInDays) {
+		return nil
+	}
+	return s.putRetentionPolicy(name, retentionInDays)
+}
+
+// DeleteLogGroups deletes the log groups created for the machines of the cluster.
+func (s *Service) DeleteLogGroups() error {
+	var logGroups []*cloudwatchlogs.LogGroup
+	err := s.CloudWatchLogsClient.DescribeLogGroupsPagesWithContext(context.TODO(), &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(logGroupPrefix(s.scope.Name())),
+	}, func(out *cloudwatchlogs.DescribeLogGroupsOutput, _ bool) bool {
+		logGroups = append(logGroups, out.LogGroups...)
+		return true
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to describe CloudWatch log groups")
+	}
+
+	for _, logGroup := range logGroups {
+		name := aws.StringValue(logGroup.LogGroupName)
+		owned, err := s.isOwned(logGroup)
+		if err != nil {
+			return err
+		}
+		if !owned {
+			continue
+		}
+
+		s.scope.Debug("Deleting CloudWatch log group", "log-group", name)
+		_, err = s.CloudWatchLogsClient.DeleteLogGroupWithContext(context.TODO(), &cloudwatchlogs.DeleteLogGroupInput{
+			LogGroupName: logGroup.LogGroupName,
+		})
+		if err != nil && !isErrorCode(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteLogGroup", "Failed to delete CloudWatch log group %q: %v", name, err)
+			return errors.Wrapf(err, "failed to delete CloudWatch log group %q", name)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteLogGroup", "Deleted CloudWatch log group %q", name)
+	}
+	return nil
+}
+
+// describeLogGroup returns the log group with the given name, or nil if it does not exist.
+func (s *Service) describeLogGroup(name string) (*cloudwatchlogs.LogGroup, error) {
+	var logGroup *cloudwatchlogs.LogGroup
+	err := s.CloudWatchLogsClient.DescribeLogGroupsPagesWithContext(context.TODO(), &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(name),
+	}, func(out *cloudwatchlogs.DescribeLogGroupsOutput, _ bool) bool {
+		for _, candidate := range out.LogGroups {
+			if aws.StringValue(candidate.LogGroupName) == name {
+				logGroup = candidate
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe CloudWatch log group %q", name)
+	}
+	return logGroup, nil
+}
+
+// isOwned returns whether the log group was created for the machines of the cluster.
+func (s *Service) isOwned(logGroup *cloudwatchlogs.LogGroup) (bool, error) {
+	out, err := s.CloudWatchLogsClient.ListTagsForResourceWithContext(context.TODO(), &cloudwatchlogs.ListTagsForResourceInput{
+		ResourceArn: logGroup.LogGroupArn,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list the tags of CloudWatch log group %q", aws.StringValue(logGroup.LogGroupName))
+	}
+	return aws.StringValue(out.Tags[infrav1.ClusterTagKey(s.scope.Name())]) == string(infrav1.ResourceLifecycleOwned), nil
+}
+
+func (s *Service) putRetentionPolicy(name string, retentionInDays int32) error {
+	if retentionInDays == 0 {
+		return nil
+	}
+	_, err := s.CloudWatchLogsClient.PutRetentionPolicyWithContext(context.TODO(), &cloudwatchlogs.PutRetentionPolicyInput{
+		LogGroupName:    aws.String(name),
+		RetentionInDays: aws.Int64(int64(retentionInDays)),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to set the retention of CloudWatch log group %q", name)
+	}
+	return nil
+}
+
+func (s *Service) getLogGroupTagParams(name string) infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+func isErrorCode(err error, code string) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && aerr.Code() == code
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatchlogs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/cloudwatchlogs/mock_cloudwatchlogsiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const logGroupName = "/aws/capa/test-cluster/workers"
+
+func TestLogGroupName(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(LogGroupName("test-cluster", "workers")).To(Equal(logGroupName))
+}
+
+func TestReconcileLogGroup(t *testing.T) {
+	ownedTags := map[string]*string{infrav1.ClusterTagKey("test-cluster"): aws.String(string(infrav1.ResourceLifecycleOwned))}
+
+	tests := []struct {
+		name   string
+		expect func(m *mock_cloudwatchlogsiface.MockCloudWatchLogsAPIMockRecorder)
+	}{
+		{
+			name: "creates the log group and sets its retention",
+			expect: func(m *mock_cloudwatchlogsiface.MockCloudWatchLogsAPIMockRecorder) {
+				describeLogGroups(m, logGroupName)
+				m.CreateLogGroupWithContext(context.TODO(), gomock.Eq(&cloudwatchlogs.CreateLogGroupInput{
+					LogGroupName: aws.String(logGroupName),
+					Tags: map[string]*string{
+						infrav1.ClusterTagKey("test-cluster"): aws.String(string(infrav1.ResourceLifecycleOwned)),
+						"Name":                                aws.String(logGroupName),
+					},
+				})).Return(&cloudwatchlogs.CreateLogGroupOutput{}, nil)
+				m.PutRetentionPolicyWithContext(context.TODO(), gomock.Eq(&cloudwatchlogs.PutRetentionPolicyInput{
+					LogGroupName:    aws.String(logGroupName),
+					RetentionInDays: aws.Int64(30),
+				})).Return(&cloudwatchlogs.PutRetentionPolicyOutput{}, nil)
+			},
+		},
+		{
+			name: "sets the retention of a log group created concurrently",
+			expect: func(m *mock_cloudwatchlogsiface.MockCloudWatchLogsAPIMockRecorder) {
+				describeLogGroups(m, logGroupName)
+				m.CreateLogGroupWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New(cloudwatchlogs.ErrCodeResourceAlreadyExistsException, "exists", nil))
+				m.PutRetentionPolicyWithContext(context.TODO(), gomock.Any()).Return(&cloudwatchlogs.PutRetentionPolicyOutput{}, nil)
+			},
+		},
+		{
+			name: "updates the retention of an owned log group",
+			expect: func(m *mock_cloudwatchlogsiface.MockCloudWatchLogsAPIMockRecorder) {
+				describeLogGroups(m, logGroupName, &cloudwatchlogs.LogGroup{
+					LogGroupName:    aws.String(logGroupName),
+					LogGroupArn:     aws.String("arn:aws:logs:us-east-1:123456789012:log-group:" + logGroupName),
+					RetentionInDays: aws.Int64(7),
+				})
+				m.ListTagsForResourceWithContext(context.TODO(), gomock.Any()).Return(&cloudwatchlogs.ListTagsForResourceOutput{Tags: ownedTags}, nil)
+				m.PutRetentionPolicyWithContext(context.TODO(), gomock.Eq(&cloudwatchlogs.PutRetentionPolicyInput{
+					LogGroupName:    aws.String(logGroupName),
+					RetentionInDays: aws.Int64(30),
+				})).Return(&cloudwatchlogs.PutRetentionPolicyOutput{}, nil)
+			},
+		},
+		{
+			name: "does not change an up to date log group",
+			expect: func(m *mock_cloudwatchlogsiface.MockCloudWatchLogsAPIMockRecorder) {
+				describeLogGroups(m, logGroupName, &cloudwatchlogs.LogGroup{
+					LogGroupName:    aws.String(logGroupName),
+					RetentionInDays: aws.Int64(30),
+				})
+				m.ListTagsForResourceWithContext(context.TODO(), gomock.Any()).Return(&cloudwatchlogs.ListTagsForResourceOutput{Tags: ownedTags}, nil)
+			},
+		},
+		{
+			name: "leaves the retention of an unmanaged log group untouched",
+			expect: func(m *mock_cloudwatchlogsiface.MockCloudWatchLogsAPIMockRecorder) {
+				describeLogGroups(m, logGroupName, &cloudwatchlogs.LogGroup{
+					LogGroupName:    aws.String(logGroupName),
+					RetentionInDays: aws.Int64(365),
+				})
+				m.ListTagsForResourceWithContext(context.TODO(), gomock.Any()).Return(&cloudwatchlogs.ListTagsForResourceOutput{}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			logsMock := mock_cloudwatchlogsiface.NewMockCloudWatchLogsAPI(mockCtrl)
+			tt.expect(logsMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{Spec: infrav1.AWSClusterSpec{Region: "us-east-1"}},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.CloudWatchLogsClient = logsMock
+
+			g.Expect(s.ReconcileLogGroup(logGroupName, 30)).To(Succeed())
+		})
+	}
+}
+
+func TestDeleteLogGroups(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	logsMock := mock_cloudwatchlogsiface.NewMockCloudWatchLogsAPI(mockCtrl)
+	m := logsMock.EXPECT()
+
+	describeLogGroups(m, "/aws/capa/test-cluster/",
+		&cloudwatchlogs.LogGroup{LogGroupName: aws.String(logGroupName), LogGroupArn: aws.String("owned")},
+		&cloudwatchlogs.LogGroup{LogGroupName: aws.String("/aws/capa/test-cluster/unmanaged"), LogGroupArn: aws.String("unmanaged")},
+	)
+	m.ListTagsForResourceWithContext(context.TODO(), gomock.Eq(&cloudwatchlogs.ListTagsForResourceInput{ResourceArn: aws.String("owned")})).
+		Return(&cloudwatchlogs.ListTagsForResourceOutput{Tags: map[string]*string{
+			infrav1.ClusterTagKey("test-cluster"): aws.String(string(infrav1.ResourceLifecycleOwned)),
+		}}, nil)
+	m.ListTagsForResourceWithContext(context.TODO(), gomock.Eq(&cloudwatchlogs.ListTagsForResourceInput{ResourceArn: aws.String("unmanaged")})).
+		Return(&cloudwatchlogs.ListTagsForResourceOutput{}, nil)
+	m.DeleteLogGroupWithContext(context.TODO(), gomock.Eq(&cloudwatchlogs.DeleteLogGroupInput{LogGroupName: aws.String(logGroupName)})).
+		Return(&cloudwatchlogs.DeleteLogGroupOutput{}, nil)
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		},
+		AWSCluster: &infrav1.AWSCluster{Spec: infrav1.AWSClusterSpec{Region: "us-east-1"}},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(clusterScope)
+	s.CloudWatchLogsClient = logsMock
+
+	g.Expect(s.DeleteLogGroups()).To(Succeed())
+}
+
+func describeLogGroups(m *mock_cloudwatchlogsiface.MockCloudWatchLogsAPIMockRecorder, prefix string, logGroups ...*cloudwatchlogs.LogGroup) {
+	m.DescribeLogGroupsPagesWithContext(context.TODO(), gomock.Eq(&cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(prefix),
+	}), gomock.Any()).DoAndReturn(func(_ context.Context, _ *cloudwatchlogs.DescribeLogGroupsInput, fn func(*cloudwatchlogs.DescribeLogGroupsOutput, bool) bool, _ ...request.Option) error {
+		fn(&cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: logGroups}, true)
+		return nil
+	})
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+// migCapableGPUs are the names, as reported by EC2, of the NVIDIA GPU models supporting Multi-Instance GPU partitioning.
+var migCapableGPUs = sets.New[string]("A100", "A30", "H100", "H200", "B200", "GB200")
+
+// GetInstanceTypeAccelerators returns the accelerators of the given instance type.
+func (s *Service) GetInstanceTypeAccelerators(instanceType string) ([]infrav1.Accelerator, error) {
+	out, err := s.EC2Client.DescribeInstanceTypesWithContext(context.TODO(), &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: []*string{aws.String(instanceType)},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe instance type %q", instanceType)
+	}
+	if len(out.InstanceTypes) == 0 {
+		return nil, errors.Errorf("instance type result empty for type %q", instanceType)
+	}
+	return instanceTypeAccelerators(out.InstanceTypes[0]), nil
+}
+
+// reconcileAcceleratorBootstrap resolves the accelerators of the instance type of the machine,
+// and attaches an Elastic Fabric Adapter to the instance when requested.
+func (s *Service) reconcileAcceleratorBootstrap(scope *scope.MachineScope, input *infrav1.Instance) error {
+	bootstrap := scope.AWSMachine.Spec.AcceleratorBootstrap
+
+	out, err := s.EC2Client.DescribeInstanceTypesWithContext(context.TODO(), &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: []*string{aws.String(input.Type)},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe instance type %q", input.Type)
+	}
+	if len(out.InstanceTypes) == 0 {
+		return errors.Errorf("instance type result empty for type %q", input.Type)
+	}
+	info := out.InstanceTypes[0]
+
+	if bootstrap.EFA {
+		if info.NetworkInfo == nil || !aws.BoolValue(info.NetworkInfo.EfaSupported) {
+			return errors.Errorf("instance type %q does not support the Elastic Fabric Adapter", input.Type)
+		}
+		if input.NetworkInterfaceType == "" {
+			input.NetworkInterfaceType = infrav1.NetworkInterfaceTypeEFAWithENAInterface
+		}
+	}
+
+	scope.SetAccelerators(instanceTypeAccelerators(info))
+	return nil
+}
+
+// instanceTypeAccelerators returns the GPU, Neuron and Inferentia accelerators of an instance type.
+func instanceTypeAccelerators(info *ec2.InstanceTypeInfo) []infrav1.Accelerator {
+	var accelerators []infrav1.Accelerator
+
+	if info.GpuInfo != nil {
+		for _, gpu := range info.GpuInfo.Gpus {
+			accelerator := infrav1.Accelerator{
+				Type:         infrav1.AcceleratorTypeGPU,
+				Manufacturer: aws.StringValue(gpu.Manufacturer),
+				Name:         aws.StringValue(gpu.Name),
+				Count:        int32(aws.Int64Value(gpu.Count)),
+				MIGCapable:   strings.EqualFold(aws.StringValue(gpu.Manufacturer), "NVIDIA") && migCapableGPUs.Has(aws.StringValue(gpu.Name)),
+			}
+			if gpu.MemoryInfo != nil {
+				accelerator.MemoryMiB = int32(aws.Int64Value(gpu.MemoryInfo.SizeInMiB))
+			}
+			accelerators = append(accelerators, accelerator)
+		}
+	}
+
+	if info.NeuronInfo != nil {
+		for _, device := range info.NeuronInfo.NeuronDevices {
+			accelerator := infrav1.Accelerator{
+				Type:         infrav1.AcceleratorTypeNeuron,
+				Manufacturer: "AWS",
+				Name:         aws.StringValue(device.Name),
+				Count:        int32(aws.Int64Value(device.Count)),
+			}
+			if device.MemoryInfo != nil {
+				accelerator.MemoryMiB = int32(aws.Int64Value(device.MemoryInfo.SizeInMiB))
+			}
+			accelerators = append(accelerators, accelerator)
+		}
+	}
+
+	if info.InferenceAcceleratorInfo != nil {
+		for _, device := range info.InferenceAcceleratorInfo.Accelerators {
+			accelerator := infrav1.Accelerator{
+				Type:         infrav1.AcceleratorTypeInference,
+				Manufacturer: aws.StringValue(device.Manufacturer),
+				Name:         aws.StringValue(device.Name),
+				Count:        int32(aws.Int64Value(device.Count)),
+			}
+			if device.MemoryInfo != nil {
+				accelerator.MemoryMiB = int32(aws.Int64Value(device.MemoryInfo.SizeInMiB))
+			}
+			accelerators = append(accelerators, accelerator)
+		}
+	}
+
+	return accelerators
+}
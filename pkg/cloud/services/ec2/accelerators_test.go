@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestReconcileAcceleratorBootstrap(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	trn1 := &ec2.InstanceTypeInfo{
+		InstanceType: aws.String("trn1.32xlarge"),
+		NetworkInfo:  &ec2.NetworkInfo{EfaSupported: aws.Bool(true)},
+		NeuronInfo: &ec2.NeuronInfo{
+			NeuronDevices: []*ec2.NeuronDeviceInfo{
+				{
+					Name:       aws.String("Trainium"),
+					Count:      aws.Int64(16),
+					MemoryInfo: &ec2.NeuronDeviceMemoryInfo{SizeInMiB: aws.Int64(32768)},
+				},
+			},
+		},
+	}
+	g5 := &ec2.InstanceTypeInfo{
+		InstanceType: aws.String("g5.xlarge"),
+		NetworkInfo:  &ec2.NetworkInfo{EfaSupported: aws.Bool(false)},
+		GpuInfo: &ec2.GpuInfo{
+			Gpus: []*ec2.GpuDeviceInfo{
+				{
+					Manufacturer: aws.String("NVIDIA"),
+					Name:         aws.String("A10G"),
+					Count:        aws.Int64(1),
+					MemoryInfo:   &ec2.GpuDeviceMemoryInfo{SizeInMiB: aws.Int64(24576)},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                     string
+		instanceType             string
+		bootstrap                infrav1.AcceleratorBootstrap
+		networkInterfaceType     infrav1.NetworkInterfaceType
+		info                     *ec2.InstanceTypeInfo
+		wantNetworkInterfaceType infrav1.NetworkInterfaceType
+		wantAccelerators         []infrav1.Accelerator
+		wantErr                  bool
+	}{
+		{
+			name:                     "should attach an EFA and record the Neuron devices of a Trainium instance",
+			instanceType:             "trn1.32xlarge",
+			bootstrap:                infrav1.AcceleratorBootstrap{EFA: true},
+			info:                     trn1,
+			wantNetworkInterfaceType: infrav1.NetworkInterfaceTypeEFAWithENAInterface,
+			wantAccelerators: []infrav1.Accelerator{
+				{Type: infrav1.AcceleratorTypeNeuron, Manufacturer: "AWS", Name: "Trainium", Count: 16, MemoryMiB: 32768},
+			},
+		},
+		{
+			name:                     "should keep the network interface type when EFA is not requested",
+			instanceType:             "trn1.32xlarge",
+			bootstrap:                infrav1.AcceleratorBootstrap{HugePages: 1024},
+			info:                     trn1,
+			wantNetworkInterfaceType: "",
+			wantAccelerators: []infrav1.Accelerator{
+				{Type: infrav1.AcceleratorTypeNeuron, Manufacturer: "AWS", Name: "Trainium", Count: 16, MemoryMiB: 32768},
+			},
+		},
+		{
+			name:                     "should record the GPUs of a GPU instance",
+			instanceType:             "g5.xlarge",
+			info:                     g5,
+			wantNetworkInterfaceType: "",
+			wantAccelerators: []infrav1.Accelerator{
+				{Type: infrav1.AcceleratorTypeGPU, Manufacturer: "NVIDIA", Name: "A10G", Count: 1, MemoryMiB: 24576},
+			},
+		},
+		{
+			name:         "should fail when EFA is requested on an instance type without EFA support",
+			instanceType: "g5.xlarge",
+			bootstrap:    infrav1.AcceleratorBootstrap{EFA: true},
+			info:         g5,
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			ec2Mock.EXPECT().DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+				InstanceTypes: []*string{aws.String(tc.instanceType)},
+			})).Return(&ec2.DescribeInstanceTypesOutput{InstanceTypes: []*ec2.InstanceTypeInfo{tc.info}}, nil)
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			machineScope := &scope.MachineScope{
+				AWSMachine: &infrav1.AWSMachine{
+					Spec: infrav1.AWSMachineSpec{
+						InstanceType:         tc.instanceType,
+						AcceleratorBootstrap: tc.bootstrap.DeepCopy(),
+					},
+				},
+			}
+			input := &infrav1.Instance{Type: tc.instanceType, NetworkInterfaceType: tc.networkInterfaceType}
+
+			err = s.reconcileAcceleratorBootstrap(machineScope, input)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(input.NetworkInterfaceType).To(Equal(tc.wantNetworkInterfaceType))
+			g.Expect(machineScope.AWSMachine.Status.Accelerators).To(Equal(tc.wantAccelerators))
+		})
+	}
+}
+
+func TestGetInstanceTypeAccelerators(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name             string
+		info             *ec2.InstanceTypeInfo
+		wantAccelerators []infrav1.Accelerator
+	}{
+		{
+			name: "should flag the NVIDIA GPUs supporting Multi-Instance GPU partitioning",
+			info: &ec2.InstanceTypeInfo{
+				InstanceType: aws.String("p4d.24xlarge"),
+				GpuInfo: &ec2.GpuInfo{
+					Gpus: []*ec2.GpuDeviceInfo{
+						{
+							Manufacturer: aws.String("NVIDIA"),
+							Name:         aws.String("A100"),
+							Count:        aws.Int64(8),
+							MemoryInfo:   &ec2.GpuDeviceMemoryInfo{SizeInMiB: aws.Int64(40960)},
+						},
+					},
+				},
+			},
+			wantAccelerators: []infrav1.Accelerator{
+				{Type: infrav1.AcceleratorTypeGPU, Manufacturer: "NVIDIA", Name: "A100", Count: 8, MemoryMiB: 40960, MIGCapable: true},
+			},
+		},
+		{
+			name: "should not flag the GPUs without Multi-Instance GPU support",
+			info: &ec2.InstanceTypeInfo{
+				InstanceType: aws.String("g4dn.xlarge"),
+				GpuInfo: &ec2.GpuInfo{
+					Gpus: []*ec2.GpuDeviceInfo{
+						{
+							Manufacturer: aws.String("NVIDIA"),
+							Name:         aws.String("T4"),
+							Count:        aws.Int64(1),
+							MemoryInfo:   &ec2.GpuDeviceMemoryInfo{SizeInMiB: aws.Int64(16384)},
+						},
+					},
+				},
+			},
+			wantAccelerators: []infrav1.Accelerator{
+				{Type: infrav1.AcceleratorTypeGPU, Manufacturer: "NVIDIA", Name: "T4", Count: 1, MemoryMiB: 16384},
+			},
+		},
+		{
+			name: "should return no accelerators for an instance type without accelerators",
+			info: &ec2.InstanceTypeInfo{InstanceType: aws.String("m5.large")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			ec2Mock.EXPECT().DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+				InstanceTypes: []*string{tc.info.InstanceType},
+			})).Return(&ec2.DescribeInstanceTypesOutput{InstanceTypes: []*ec2.InstanceTypeInfo{tc.info}}, nil)
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			accelerators, err := s.GetInstanceTypeAccelerators(aws.StringValue(tc.info.InstanceType))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(accelerators).To(Equal(tc.wantAccelerators))
+		})
+	}
+}
@@ -94,6 +94,16 @@ const (
 
 	// EKS GPU AL2023 AMI ID SSM Parameter name.
 	eksGPUAmiAl2023SSMParameterFormat = "/aws/service/eks/optimized-ami/%s/amazon-linux-2023/x86_64/nvidia/recommended/image_id"
+
+	// EKS Bottlerocket AMI ID SSM Parameter name, formatted with the Kubernetes version and the architecture.
+	eksBottlerocketAmiSSMParameterFormat = "/aws/service/bottlerocket/aws-k8s-%s/%s/latest/image_id"
+
+	// EKS Bottlerocket GPU AMI ID SSM Parameter name, formatted with the Kubernetes version and the architecture.
+	eksBottlerocketGPUAmiSSMParameterFormat = "/aws/service/bottlerocket/aws-k8s-%s-nvidia/%s/latest/image_id"
+
+	// EKS Ubuntu AMI ID SSM Parameter name published by Canonical, formatted with the Kubernetes version and
+	// the Debian architecture.
+	eksUbuntuAmiSSMParameterFormat = "/aws/service/canonical/ubuntu/eks/22.04/%s/stable/current/%s/hvm/ebs-gp2/ami-id"
 )
 
 // AMILookup contains the parameters used to template AMI names used for lookup.
@@ -331,27 +341,37 @@ func (s *Service) eksAMILookup(kubernetesVersion string, architecture string, am
 		amiType = new(infrav1.EKSAMILookupType)
 	}
 
+	if architecture != Amd64ArchitectureTag && architecture != Arm64ArchitectureTag {
+		return "", fmt.Errorf("cannot look up eks-optimized image for architecture %q", architecture)
+	}
+
 	switch *amiType {
 	case infrav1.AmazonLinux2023GPU:
 		paramName = fmt.Sprintf(eksGPUAmiAl2023SSMParameterFormat, formattedVersion)
 	case infrav1.AmazonLinuxGPU:
 		paramName = fmt.Sprintf(eksGPUAmiSSMParameterFormat, formattedVersion)
+	case infrav1.Bottlerocket:
+		paramName = fmt.Sprintf(eksBottlerocketAmiSSMParameterFormat, formattedVersion, architecture)
+	case infrav1.BottlerocketGPU:
+		paramName = fmt.Sprintf(eksBottlerocketGPUAmiSSMParameterFormat, formattedVersion, architecture)
+	case infrav1.Ubuntu:
+		// Canonical publishes the images with the Debian name of the architecture.
+		ubuntuArchitecture := "amd64"
+		if architecture == Arm64ArchitectureTag {
+			ubuntuArchitecture = "arm64"
+		}
+		paramName = fmt.Sprintf(eksUbuntuAmiSSMParameterFormat, formattedVersion, ubuntuArchitecture)
+	case infrav1.AmazonLinux2023:
+		if architecture == Arm64ArchitectureTag {
+			paramName = fmt.Sprintf(eksARM64AmiAl2023SSMParameterFormat, formattedVersion)
+		} else {
+			paramName = fmt.Sprintf(eksAmiAl2023SSMParameterFormat, formattedVersion)
+		}
 	default:
-		switch architecture {
-		case Arm64ArchitectureTag:
-			if *amiType == infrav1.AmazonLinux2023 {
-				paramName = fmt.Sprintf(eksARM64AmiAl2023SSMParameterFormat, formattedVersion)
-			} else {
-				paramName = fmt.Sprintf(eksARM64AmiSSMParameterFormat, formattedVersion)
-			}
-		case Amd64ArchitectureTag:
-			if *amiType == infrav1.AmazonLinux2023 {
-				paramName = fmt.Sprintf(eksAmiAl2023SSMParameterFormat, formattedVersion)
-			} else {
-				paramName = fmt.Sprintf(eksAmiSSMParameterFormat, formattedVersion)
-			}
-		default:
-			return "", fmt.Errorf("cannot look up eks-optimized image for architecture %q", architecture)
+		if architecture == Arm64ArchitectureTag {
+			paramName = fmt.Sprintf(eksARM64AmiSSMParameterFormat, formattedVersion)
+		} else {
+			paramName = fmt.Sprintf(eksAmiSSMParameterFormat, formattedVersion)
 		}
 	}
 
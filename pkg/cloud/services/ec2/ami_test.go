@@ -480,6 +480,8 @@ func TestEKSAMILookUp(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	gpuAMI := infrav1.AmazonLinuxGPU
+	bottlerocketAMI := infrav1.Bottlerocket
+	ubuntuAMI := infrav1.Ubuntu
 	tests := []struct {
 		name       string
 		k8sVersion string
@@ -522,6 +524,47 @@ func TestEKSAMILookUp(t *testing.T) {
 			want:    "id",
 			wantErr: false,
 		},
+		{
+			name:       "Should return an id corresponding to the architecture if Bottlerocket AMI type passed",
+			k8sVersion: "v1.30.2",
+			arch:       "arm64",
+			amiType:    &bottlerocketAMI,
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Eq(&ssm.GetParameterInput{
+					Name: aws.String("/aws/service/bottlerocket/aws-k8s-1.30/arm64/latest/image_id"),
+				})).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("id"),
+					},
+				}, nil)
+			},
+			want:    "id",
+			wantErr: false,
+		},
+		{
+			name:       "Should return an id corresponding to the Debian architecture if Ubuntu AMI type passed",
+			k8sVersion: "v1.30.2",
+			arch:       "x86_64",
+			amiType:    &ubuntuAMI,
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Eq(&ssm.GetParameterInput{
+					Name: aws.String("/aws/service/canonical/ubuntu/eks/22.04/1.30/stable/current/amd64/hvm/ebs-gp2/ami-id"),
+				})).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("id"),
+					},
+				}, nil)
+			},
+			want:    "id",
+			wantErr: false,
+		},
+		{
+			name:       "Should return an error if the architecture is not supported",
+			k8sVersion: "v1.30.2",
+			arch:       "i386",
+			amiType:    &bottlerocketAMI,
+			wantErr:    true,
+		},
 		{
 			name:       "Should return an error if GetParameter call fails with some AWS error",
 			k8sVersion: "v1.23.3",
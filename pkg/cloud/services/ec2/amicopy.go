@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// sourceAMITagKey is the tag of the copies of AMIs owned by another account, set to the ID of the copied AMI.
+const sourceAMITagKey = infrav1.NameAWSProviderPrefix + "source-ami"
+
+// AMICopyPendingError is returned when an instance cannot be created yet, because the copy of its AMI
+// into the account of the cluster is not available.
+type AMICopyPendingError struct {
+	// SourceImageID is the ID of the AMI owned by another account.
+	SourceImageID string
+	// ImageID is the ID of the copy.
+	ImageID string
+}
+
+func (e *AMICopyPendingError) Error() string {
+	return fmt.Sprintf("waiting for the copy %q of AMI %q to become available", e.ImageID, e.SourceImageID)
+}
+
+// AsAMICopyPending returns the AMICopyPendingError wrapped by err, if any.
+func AsAMICopyPending(err error) (*AMICopyPendingError, bool) {
+	var pendingErr *AMICopyPendingError
+	if errors.As(err, &pendingErr) {
+		return pendingErr, true
+	}
+	return nil, false
+}
+
+// resolveMachineAMI ensures that an instance can be launched from the AMI referenced by the AWSMachine,
+// so that a missing launch permission is reported instead of failing RunInstances.
+// When the AMI is owned by another account and the AWSMachine has an AMI copy, it returns the ID of the
+// copy of the AMI in the account of the cluster, copying it first.
+func (s *Service) resolveMachineAMI(scope *scope.MachineScope, imageID string) (string, error) {
+	image, err := s.describeImage(imageID, nil)
+	if err != nil {
+		return "", err
+	}
+	if image == nil {
+		return "", errors.Errorf("AMI %q does not exist in region %q or the account of the cluster has no launch permission for it", imageID, s.scope.Region())
+	}
+	if state := aws.StringValue(image.State); state != ec2.ImageStateAvailable {
+		return "", errors.Errorf("AMI %q is %s", imageID, state)
+	}
+
+	amiCopy := scope.AWSMachine.Spec.AMICopy
+	if amiCopy == nil {
+		return imageID, nil
+	}
+
+	owned, err := s.describeImage(imageID, []string{"self"})
+	if err != nil {
+		return "", err
+	}
+	if owned != nil {
+		return imageID, nil
+	}
+
+	return s.ensureAMICopy(scope, image, amiCopy)
+}
+
+// ensureAMICopy returns the ID of the copy of the given AMI in the account of the cluster, copying it if there
+// is no copy yet. It returns an AMICopyPendingError while the copy is not available.
+func (s *Service) ensureAMICopy(scope *scope.MachineScope, image *ec2.Image, amiCopy *infrav1.AMICopy) (string, error) {
+	sourceImageID := aws.StringValue(image.ImageId)
+
+	out, err := s.EC2Client.DescribeImagesWithContext(context.TODO(), &ec2.DescribeImagesInput{
+		Owners: aws.StringSlice([]string{"self"}),
+		Filters: []*ec2.Filter{
+			{Name: aws.String("tag:" + sourceAMITagKey), Values: aws.StringSlice([]string{sourceImageID})},
+		},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe the copies of AMI %q", sourceImageID)
+	}
+	for _, copied := range out.Images {
+		switch state := aws.StringValue(copied.State); state {
+		case ec2.ImageStateAvailable:
+			return aws.StringValue(copied.ImageId), nil
+		case ec2.ImageStatePending:
+			return "", &AMICopyPendingError{SourceImageID: sourceImageID, ImageID: aws.StringValue(copied.ImageId)}
+		}
+	}
+
+	input := &ec2.CopyImageInput{
+		SourceImageId: aws.String(sourceImageID),
+		SourceRegion:  aws.String(s.scope.Region()),
+		Name:          aws.String(fmt.Sprintf("%s-%s", aws.StringValue(image.Name), sourceImageID)),
+		Description:   aws.String(fmt.Sprintf("Copy of %s", sourceImageID)),
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeImage),
+				Tags: []*ec2.Tag{
+					{Key: aws.String(sourceAMITagKey), Value: aws.String(sourceImageID)},
+				},
+			},
+		},
+	}
+	if amiCopy.Encrypted {
+		input.Encrypted = aws.Bool(true)
+		kmsKeyID := amiCopy.KMSKeyID
+		if rootVolume := scope.RootVolume(); kmsKeyID == "" && rootVolume != nil {
+			kmsKeyID = rootVolume.EncryptionKey
+		}
+		if kmsKeyID != "" {
+			input.KmsKeyId = aws.String(kmsKeyID)
+		}
+	}
+
+	copyOut, err := s.EC2Client.CopyImageWithContext(context.TODO(), input)
+	if err != nil {
+		record.Warnf(scope.AWSMachine, "FailedCopyAMI", "Failed to copy AMI %q into the account of the cluster: %v", sourceImageID, err)
+		return "", errors.Wrapf(err, "failed to copy AMI %q", sourceImageID)
+	}
+
+	imageID := aws.StringValue(copyOut.ImageId)
+	record.Eventf(scope.AWSMachine, "SuccessfulCopyAMI", "Copying AMI %q into the account of the cluster as %q", sourceImageID, imageID)
+	return "", &AMICopyPendingError{SourceImageID: sourceImageID, ImageID: imageID}
+}
+
+// describeImage returns the AMI with the given ID owned by the given owners, or nil if the AMI is not found.
+func (s *Service) describeImage(imageID string, owners []string) (*ec2.Image, error) {
+	input := &ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice([]string{imageID}),
+	}
+	if len(owners) > 0 {
+		input.Owners = aws.StringSlice(owners)
+	}
+
+	out, err := s.EC2Client.DescribeImagesWithContext(context.TODO(), input)
+	if err != nil {
+		if awserrors.IsInvalidNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to describe AMI %q", imageID)
+	}
+	if len(out.Images) == 0 {
+		return nil, nil
+	}
+	return out.Images[0], nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestResolveMachineAMI(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	const sourceImageID = "ami-source"
+	sourceImage := &ec2.Image{ImageId: aws.String(sourceImageID), Name: aws.String("ubuntu-eks"), State: aws.String(ec2.ImageStateAvailable)}
+
+	describeSource := func(m *mocks.MockEC2APIMockRecorder, images ...*ec2.Image) {
+		m.DescribeImagesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeImagesInput{
+			ImageIds: aws.StringSlice([]string{sourceImageID}),
+		})).Return(&ec2.DescribeImagesOutput{Images: images}, nil)
+	}
+	describeOwned := func(m *mocks.MockEC2APIMockRecorder, images ...*ec2.Image) {
+		m.DescribeImagesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeImagesInput{
+			ImageIds: aws.StringSlice([]string{sourceImageID}),
+			Owners:   aws.StringSlice([]string{"self"}),
+		})).Return(&ec2.DescribeImagesOutput{Images: images}, nil)
+	}
+	describeCopies := func(m *mocks.MockEC2APIMockRecorder, images ...*ec2.Image) {
+		m.DescribeImagesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeImagesInput{
+			Owners: aws.StringSlice([]string{"self"}),
+			Filters: []*ec2.Filter{
+				{Name: aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/source-ami"), Values: aws.StringSlice([]string{sourceImageID})},
+			},
+		})).Return(&ec2.DescribeImagesOutput{Images: images}, nil)
+	}
+
+	testCases := []struct {
+		name        string
+		spec        infrav1.AWSMachineSpec
+		expect      func(m *mocks.MockEC2APIMockRecorder)
+		want        string
+		wantPending bool
+		wantErr     bool
+	}{
+		{
+			name: "should launch the AMI when it is shared with the account",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeSource(m, sourceImage)
+			},
+			want: sourceImageID,
+		},
+		{
+			name: "should fail when the AMI is not shared with the account",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeImagesWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New(awserrors.ImageNotFound, "The image id '[ami-source]' does not exist", nil))
+			},
+			wantErr: true,
+		},
+		{
+			name: "should fail when the AMI is not available",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeSource(m, &ec2.Image{ImageId: aws.String(sourceImageID), State: aws.String(ec2.ImageStateDeregistered)})
+			},
+			wantErr: true,
+		},
+		{
+			name: "should launch the AMI when it is owned by the account",
+			spec: infrav1.AWSMachineSpec{AMICopy: &infrav1.AMICopy{}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeSource(m, sourceImage)
+				describeOwned(m, sourceImage)
+			},
+			want: sourceImageID,
+		},
+		{
+			name: "should launch the available copy of the AMI",
+			spec: infrav1.AWSMachineSpec{AMICopy: &infrav1.AMICopy{}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeSource(m, sourceImage)
+				describeOwned(m)
+				describeCopies(m, &ec2.Image{ImageId: aws.String("ami-copy"), State: aws.String(ec2.ImageStateAvailable)})
+			},
+			want: "ami-copy",
+		},
+		{
+			name: "should wait for the pending copy of the AMI",
+			spec: infrav1.AWSMachineSpec{AMICopy: &infrav1.AMICopy{}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeSource(m, sourceImage)
+				describeOwned(m)
+				describeCopies(m, &ec2.Image{ImageId: aws.String("ami-copy"), State: aws.String(ec2.ImageStatePending)})
+			},
+			wantPending: true,
+		},
+		{
+			name: "should copy the AMI with the encryption key of the root volume",
+			spec: infrav1.AWSMachineSpec{
+				AMICopy:    &infrav1.AMICopy{Encrypted: true},
+				RootVolume: &infrav1.Volume{EncryptionKey: "alias/cluster"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeSource(m, sourceImage)
+				describeOwned(m)
+				describeCopies(m, &ec2.Image{ImageId: aws.String("ami-failed"), State: aws.String(ec2.ImageStateFailed)})
+				m.CopyImageWithContext(context.TODO(), gomock.Eq(&ec2.CopyImageInput{
+					SourceImageId: aws.String(sourceImageID),
+					SourceRegion:  aws.String("us-east-1"),
+					Name:          aws.String("ubuntu-eks-ami-source"),
+					Description:   aws.String("Copy of ami-source"),
+					Encrypted:     aws.Bool(true),
+					KmsKeyId:      aws.String("alias/cluster"),
+					TagSpecifications: []*ec2.TagSpecification{
+						{
+							ResourceType: aws.String(ec2.ResourceTypeImage),
+							Tags: []*ec2.Tag{
+								{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/source-ami"), Value: aws.String(sourceImageID)},
+							},
+						},
+					},
+				})).Return(&ec2.CopyImageOutput{ImageId: aws.String("ami-copy")}, nil)
+			},
+			wantPending: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+			clusterScope.AWSCluster.Spec.Region = "us-east-1"
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			machineScope := &scope.MachineScope{
+				InfraCluster: clusterScope,
+				AWSMachine:   &infrav1.AWSMachine{Spec: tc.spec},
+			}
+
+			imageID, err := s.resolveMachineAMI(machineScope, sourceImageID)
+			if tc.wantPending {
+				_, ok := AsAMICopyPending(err)
+				g.Expect(ok).To(BeTrue(), "expected an AMICopyPendingError, got %v", err)
+				return
+			}
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(imageID).To(Equal(tc.want))
+		})
+	}
+}
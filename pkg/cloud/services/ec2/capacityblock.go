@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+)
+
+// CapacityBlockNotActiveError is returned when instances cannot be launched into a capacity block yet,
+// because its active window has not started.
+type CapacityBlockNotActiveError struct {
+	// CapacityReservationID is the ID of the capacity block.
+	CapacityReservationID string
+	// State is the state of the capacity block, scheduled or payment-pending.
+	State string
+	// StartDate is the start of the active window of the capacity block.
+	StartDate time.Time
+}
+
+func (e *CapacityBlockNotActiveError) Error() string {
+	return fmt.Sprintf("capacity block %q is %s and becomes active at %s", e.CapacityReservationID, e.State, e.StartDate.UTC().Format(time.RFC3339))
+}
+
+// RequeueAfter returns the duration until the active window of the capacity block starts.
+func (e *CapacityBlockNotActiveError) RequeueAfter() time.Duration {
+	return time.Until(e.StartDate)
+}
+
+// AsCapacityBlockNotActive returns the CapacityBlockNotActiveError wrapped by err, if any.
+func AsCapacityBlockNotActive(err error) (*CapacityBlockNotActiveError, bool) {
+	var notActiveErr *CapacityBlockNotActiveError
+	if errors.As(err, &notActiveErr) {
+		return notActiveErr, true
+	}
+	return nil, false
+}
+
+// ValidateCapacityBlock ensures that instances of the given type can be launched into a capacity block.
+// It returns a CapacityBlockNotActiveError if the active window of the capacity block has not started yet.
+func (s *Service) ValidateCapacityBlock(capacityReservationID, instanceType string) error {
+	out, err := s.EC2Client.DescribeCapacityReservationsWithContext(context.TODO(), &ec2.DescribeCapacityReservationsInput{
+		CapacityReservationIds: []*string{aws.String(capacityReservationID)},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe capacity block %q", capacityReservationID)
+	}
+	if len(out.CapacityReservations) == 0 {
+		return errors.Errorf("capacity block %q not found", capacityReservationID)
+	}
+
+	reservation := out.CapacityReservations[0]
+	if aws.StringValue(reservation.ReservationType) != ec2.CapacityReservationTypeCapacityBlock {
+		return errors.Errorf("capacity reservation %q is not a capacity block", capacityReservationID)
+	}
+	if instanceType != "" && aws.StringValue(reservation.InstanceType) != instanceType {
+		return errors.Errorf("capacity block %q reserves %s instances, not %s", capacityReservationID, aws.StringValue(reservation.InstanceType), instanceType)
+	}
+
+	switch state := aws.StringValue(reservation.State); state {
+	case ec2.CapacityReservationStateActive:
+		return nil
+	case ec2.CapacityReservationStateScheduled, ec2.CapacityReservationStatePaymentPending:
+		return &CapacityBlockNotActiveError{
+			CapacityReservationID: capacityReservationID,
+			State:                 state,
+			StartDate:             aws.TimeValue(reservation.StartDate),
+		}
+	default:
+		return errors.Errorf("capacity block %q is %s", capacityReservationID, state)
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestValidateCapacityBlock(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	reservationID := "cr-12345678901234567"
+	startDate := time.Now().Add(time.Hour)
+
+	describeReservation := func(m *mocks.MockEC2APIMockRecorder, reservation *ec2.CapacityReservation) {
+		out := &ec2.DescribeCapacityReservationsOutput{}
+		if reservation != nil {
+			out.CapacityReservations = []*ec2.CapacityReservation{reservation}
+		}
+		m.DescribeCapacityReservationsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeCapacityReservationsInput{
+			CapacityReservationIds: []*string{aws.String(reservationID)},
+		})).Return(out, nil)
+	}
+
+	testCases := []struct {
+		name            string
+		instanceType    string
+		expect          func(m *mocks.MockEC2APIMockRecorder)
+		expectErr       bool
+		expectNotActive bool
+	}{
+		{
+			name:         "active capacity block",
+			instanceType: "p5.48xlarge",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeReservation(m, &ec2.CapacityReservation{
+					InstanceType:    aws.String("p5.48xlarge"),
+					ReservationType: aws.String(ec2.CapacityReservationTypeCapacityBlock),
+					State:           aws.String(ec2.CapacityReservationStateActive),
+				})
+			},
+		},
+		{
+			name:         "scheduled capacity block",
+			instanceType: "p5.48xlarge",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeReservation(m, &ec2.CapacityReservation{
+					InstanceType:    aws.String("p5.48xlarge"),
+					ReservationType: aws.String(ec2.CapacityReservationTypeCapacityBlock),
+					State:           aws.String(ec2.CapacityReservationStateScheduled),
+					StartDate:       aws.Time(startDate),
+				})
+			},
+			expectErr:       true,
+			expectNotActive: true,
+		},
+		{
+			name:         "expired capacity block",
+			instanceType: "p5.48xlarge",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeReservation(m, &ec2.CapacityReservation{
+					InstanceType:    aws.String("p5.48xlarge"),
+					ReservationType: aws.String(ec2.CapacityReservationTypeCapacityBlock),
+					State:           aws.String(ec2.CapacityReservationStateExpired),
+				})
+			},
+			expectErr: true,
+		},
+		{
+			name:         "on-demand capacity reservation",
+			instanceType: "p5.48xlarge",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeReservation(m, &ec2.CapacityReservation{
+					InstanceType:    aws.String("p5.48xlarge"),
+					ReservationType: aws.String(ec2.CapacityReservationTypeDefault),
+					State:           aws.String(ec2.CapacityReservationStateActive),
+				})
+			},
+			expectErr: true,
+		},
+		{
+			name:         "instance type mismatch",
+			instanceType: "m5.large",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeReservation(m, &ec2.CapacityReservation{
+					InstanceType:    aws.String("p5.48xlarge"),
+					ReservationType: aws.String(ec2.CapacityReservationTypeCapacityBlock),
+					State:           aws.String(ec2.CapacityReservationStateActive),
+				})
+			},
+			expectErr: true,
+		},
+		{
+			name:         "capacity block not found",
+			instanceType: "p5.48xlarge",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeReservation(m, nil)
+			},
+			expectErr: true,
+		},
+		{
+			name:         "describe capacity reservations fails",
+			instanceType: "p5.48xlarge",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeCapacityReservationsWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			err = s.ValidateCapacityBlock(reservationID, tc.instanceType)
+			if !tc.expectErr {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(HaveOccurred())
+
+			notActiveErr, ok := AsCapacityBlockNotActive(errors.Wrap(err, "failed to create instance"))
+			g.Expect(ok).To(Equal(tc.expectNotActive))
+			if tc.expectNotActive {
+				g.Expect(notActiveErr.StartDate).To(Equal(startDate))
+				g.Expect(notActiveErr.RequeueAfter()).To(BeNumerically(">", 0))
+			}
+		})
+	}
+}
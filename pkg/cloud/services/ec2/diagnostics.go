@@ -0,0 +1,325 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	// systemLogLines is the number of lines of the system log kept in the diagnosis.
+	systemLogLines = 30
+
+	// ssmPingStatusNotRegistered is reported when the instance is not registered with SSM.
+	ssmPingStatusNotRegistered = "NotRegistered"
+
+	// instanceStatusOK is the EC2 status of a passing status check.
+	instanceStatusOK = "ok"
+)
+
+// lookupHost resolves the API server endpoint host, it is a variable so that it can be replaced in tests.
+var lookupHost = net.LookupHost
+
+// DiagnoseInstanceBootstrap collects the EC2 status checks, system log and SSM connectivity of an instance
+// that did not become a node, and starts a VPC Reachability Analyzer analysis between the instance and the
+// API server endpoint. Failures to collect a piece of information are reported as findings.
+func (s *Service) DiagnoseInstanceBootstrap(instance *infrav1.Instance, endpoint clusterv1.APIEndpoint) *infrav1.BootstrapDiagnosis {
+	diagnosis := &infrav1.BootstrapDiagnosis{
+		CollectedAt: metav1.Now(),
+	}
+
+	if err := s.collectInstanceStatus(instance.ID, diagnosis); err != nil {
+		diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("unable to describe the instance status checks: %v", err))
+	}
+
+	if err := s.collectSystemLog(instance.ID, diagnosis); err != nil {
+		diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("unable to get the system log: %v", err))
+	}
+
+	if err := s.collectSSMPingStatus(instance.ID, diagnosis); err != nil {
+		diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("unable to describe the SSM agent status: %v", err))
+	}
+
+	reachability, err := s.startAPIServerReachabilityAnalysis(instance.ID, endpoint, s.getReachabilityAnalysisTagParams(fmt.Sprintf("%s-bootstrap-diagnosis", instance.ID)))
+	if err != nil {
+		diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("unable to analyze the reachability of the API server endpoint: %v", err))
+	}
+	diagnosis.Reachability = reachability
+
+	record.Warnf(s.scope.InfraCluster(), "InstanceBootstrapDiagnosed", "Instance %q did not become a node, collected bootstrap diagnosis", instance.ID)
+
+	return diagnosis
+}
+
+// UpdateBootstrapDiagnosis refreshes the running reachability analysis of the diagnosis, and deletes
+// the Reachability Analyzer resources once the analysis is complete.
+func (s *Service) UpdateBootstrapDiagnosis(diagnosis *infrav1.BootstrapDiagnosis) error {
+	reachability := diagnosis.Reachability
+	if !reachability.IsRunning() {
+		return nil
+	}
+
+	if err := s.refreshReachabilityAnalysis(reachability); err != nil {
+		return err
+	}
+
+	switch reachability.Status {
+	case infrav1.ReachabilityAnalysisStatusRunning:
+		return nil
+	case ec2.AnalysisStatusSucceeded:
+		if !aws.BoolValue(reachability.NetworkPathFound) {
+			diagnosis.Findings = append(diagnosis.Findings, "the API server endpoint is not reachable from the instance")
+		}
+	default:
+		diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("network insights analysis failed: %s", reachability.StatusMessage))
+	}
+
+	return s.DeleteBootstrapDiagnosis(diagnosis)
+}
+
+// DeleteBootstrapDiagnosis deletes the Reachability Analyzer resources created for the diagnosis.
+func (s *Service) DeleteBootstrapDiagnosis(diagnosis *infrav1.BootstrapDiagnosis) error {
+	if diagnosis == nil {
+		return nil
+	}
+	return s.DeleteReachabilityAnalysis(diagnosis.Reachability)
+}
+
+func (s *Service) collectInstanceStatus(instanceID string, diagnosis *infrav1.BootstrapDiagnosis) error {
+	out, err := s.EC2Client.DescribeInstanceStatusWithContext(context.TODO(), &ec2.DescribeInstanceStatusInput{
+		InstanceIds:         []*string{aws.String(instanceID)},
+		IncludeAllInstances: aws.Bool(true),
+	})
+	if err != nil {
+		return err
+	}
+	if len(out.InstanceStatuses) == 0 {
+		return errors.Errorf("no status found for instance %q", instanceID)
+	}
+
+	status := out.InstanceStatuses[0]
+	if status.SystemStatus != nil {
+		diagnosis.SystemStatus = aws.StringValue(status.SystemStatus.Status)
+	}
+	if status.InstanceStatus != nil {
+		diagnosis.InstanceStatus = aws.StringValue(status.InstanceStatus.Status)
+	}
+
+	if diagnosis.SystemStatus != "" && diagnosis.SystemStatus != instanceStatusOK {
+		diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("the EC2 system status check is %s", diagnosis.SystemStatus))
+	}
+	if diagnosis.InstanceStatus != "" && diagnosis.InstanceStatus != instanceStatusOK {
+		diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("the EC2 instance status check is %s", diagnosis.InstanceStatus))
+	}
+	return nil
+}
+
+func (s *Service) collectSystemLog(instanceID string, diagnosis *infrav1.BootstrapDiagnosis) error {
+	out, err := s.EC2Client.GetConsoleOutputWithContext(context.TODO(), &ec2.GetConsoleOutputInput{
+		InstanceId: aws.String(instanceID),
+	})
+	if err != nil {
+		return err
+	}
+
+	output, err := base64.StdEncoding.DecodeString(aws.StringValue(out.Output))
+	if err != nil {
+		return errors.Wrap(err, "failed to decode the system log")
+	}
+	if len(output) == 0 {
+		diagnosis.Findings = append(diagnosis.Findings, "the system log is empty")
+		return nil
+	}
+
+	lines := strings.Split(strings.TrimRight(string(output), "\n"), "\n")
+	if len(lines) > systemLogLines {
+		lines = lines[len(lines)-systemLogLines:]
+	}
+	diagnosis.SystemLog = strings.Join(lines, "\n")
+	return nil
+}
+
+func (s *Service) collectSSMPingStatus(instanceID string, diagnosis *infrav1.BootstrapDiagnosis) error {
+	out, err := s.SSMClient.DescribeInstanceInformationWithContext(context.TODO(), &ssm.DescribeInstanceInformationInput{
+		Filters: []*ssm.InstanceInformationStringFilter{
+			{
+				Key:    aws.String("InstanceIds"),
+				Values: []*string{aws.String(instanceID)},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(out.InstanceInformationList) == 0 {
+		diagnosis.SSMPingStatus = ssmPingStatusNotRegistered
+		diagnosis.Findings = append(diagnosis.Findings, "the SSM agent is not registered, the instance may not be able to reach the SSM endpoints")
+		return nil
+	}
+
+	diagnosis.SSMPingStatus = aws.StringValue(out.InstanceInformationList[0].PingStatus)
+	if diagnosis.SSMPingStatus != ssm.PingStatusOnline {
+		diagnosis.Findings = append(diagnosis.Findings, fmt.Sprintf("the SSM agent status is %s", diagnosis.SSMPingStatus))
+	}
+	return nil
+}
+
+// startAPIServerReachabilityAnalysis starts the analysis of the network path between an instance and the
+// API server endpoint.
+func (s *Service) startAPIServerReachabilityAnalysis(instanceID string, endpoint clusterv1.APIEndpoint, tagParams infrav1.BuildParams) (*infrav1.ReachabilityAnalysis, error) {
+	if !endpoint.IsValid() {
+		return nil, errors.New("the API server endpoint is not set")
+	}
+
+	destinationIP, err := resolveEndpointIPv4(endpoint.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.startReachabilityAnalysis(&ec2.CreateNetworkInsightsPathInput{
+		Source:          aws.String(instanceID),
+		DestinationIp:   aws.String(destinationIP),
+		DestinationPort: aws.Int64(int64(endpoint.Port)),
+		Protocol:        aws.String(ec2.ProtocolTcp),
+	}, tagParams)
+}
+
+// startReachabilityAnalysis creates the given network insights path and starts its analysis. The path is
+// deleted if the analysis cannot be started.
+func (s *Service) startReachabilityAnalysis(input *ec2.CreateNetworkInsightsPathInput, tagParams infrav1.BuildParams) (*infrav1.ReachabilityAnalysis, error) {
+	input.TagSpecifications = []*ec2.TagSpecification{
+		tags.BuildParamsToTagSpecification(ec2.ResourceTypeNetworkInsightsPath, tagParams),
+	}
+	pathOut, err := s.EC2Client.CreateNetworkInsightsPathWithContext(context.TODO(), input)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create network insights path")
+	}
+
+	reachability := &infrav1.ReachabilityAnalysis{
+		NetworkInsightsPathID: aws.StringValue(pathOut.NetworkInsightsPath.NetworkInsightsPathId),
+	}
+
+	analysisOut, err := s.EC2Client.StartNetworkInsightsAnalysisWithContext(context.TODO(), &ec2.StartNetworkInsightsAnalysisInput{
+		NetworkInsightsPathId: aws.String(reachability.NetworkInsightsPathID),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeNetworkInsightsAnalysis, tagParams),
+		},
+	})
+	if err != nil {
+		if _, deleteErr := s.EC2Client.DeleteNetworkInsightsPathWithContext(context.TODO(), &ec2.DeleteNetworkInsightsPathInput{
+			NetworkInsightsPathId: aws.String(reachability.NetworkInsightsPathID),
+		}); deleteErr != nil {
+			s.scope.Error(deleteErr, "failed to delete network insights path", "network-insights-path-id", reachability.NetworkInsightsPathID)
+		}
+		return nil, errors.Wrap(err, "failed to start network insights analysis")
+	}
+
+	reachability.NetworkInsightsAnalysisID = aws.StringValue(analysisOut.NetworkInsightsAnalysis.NetworkInsightsAnalysisId)
+	reachability.Status = aws.StringValue(analysisOut.NetworkInsightsAnalysis.Status)
+	return reachability, nil
+}
+
+// refreshReachabilityAnalysis updates the status and the result of a running analysis. An analysis which
+// does not exist anymore is reported as failed.
+func (s *Service) refreshReachabilityAnalysis(reachability *infrav1.ReachabilityAnalysis) error {
+	out, err := s.EC2Client.DescribeNetworkInsightsAnalysesWithContext(context.TODO(), &ec2.DescribeNetworkInsightsAnalysesInput{
+		NetworkInsightsAnalysisIds: []*string{aws.String(reachability.NetworkInsightsAnalysisID)},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe network insights analysis %q", reachability.NetworkInsightsAnalysisID)
+	}
+	if len(out.NetworkInsightsAnalyses) == 0 {
+		reachability.Status = ec2.AnalysisStatusFailed
+		reachability.StatusMessage = "the analysis was not found"
+		return nil
+	}
+
+	analysis := out.NetworkInsightsAnalyses[0]
+	reachability.Status = aws.StringValue(analysis.Status)
+	switch reachability.Status {
+	case infrav1.ReachabilityAnalysisStatusRunning:
+	case ec2.AnalysisStatusSucceeded:
+		reachability.NetworkPathFound = analysis.NetworkPathFound
+		for _, explanation := range analysis.Explanations {
+			reachability.Explanations = append(reachability.Explanations, explanationToString(explanation))
+		}
+	default:
+		reachability.StatusMessage = aws.StringValue(analysis.StatusMessage)
+	}
+	return nil
+}
+
+func (s *Service) getReachabilityAnalysisTagParams(name string) infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.KubernetesClusterName(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+// resolveEndpointIPv4 returns the IPv4 address of the API server endpoint host.
+func resolveEndpointIPv4(host string) (string, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		return host, nil
+	}
+
+	addresses, err := lookupHost(host)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve the API server endpoint %q", host)
+	}
+	for _, address := range addresses {
+		if ip := net.ParseIP(address); ip != nil && ip.To4() != nil {
+			return address, nil
+		}
+	}
+	return "", errors.Errorf("no IPv4 address found for the API server endpoint %q", host)
+}
+
+func explanationToString(explanation *ec2.Explanation) string {
+	component := ""
+	if explanation.Component != nil {
+		component = aws.StringValue(explanation.Component.Id)
+	}
+	if component == "" {
+		return aws.StringValue(explanation.ExplanationCode)
+	}
+	return fmt.Sprintf("%s: %s", aws.StringValue(explanation.ExplanationCode), component)
+}
+
+func isNetworkInsightsNotFound(err error) bool {
+	code, ok := awserrors.Code(errors.Cause(err))
+	return ok && strings.HasSuffix(code, ".NotFound")
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ssm/mock_ssmiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestDiagnoseInstanceBootstrap(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	instanceID := "i-0123456789abcdef0"
+	endpoint := clusterv1.APIEndpoint{Host: "10.0.0.10", Port: 6443}
+
+	describeStatus := func(m *mocks.MockEC2APIMockRecorder, systemStatus, instanceStatus string) {
+		m.DescribeInstanceStatusWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceStatusInput{
+			InstanceIds:         []*string{aws.String(instanceID)},
+			IncludeAllInstances: aws.Bool(true),
+		})).Return(&ec2.DescribeInstanceStatusOutput{
+			InstanceStatuses: []*ec2.InstanceStatus{
+				{
+					InstanceId:     aws.String(instanceID),
+					SystemStatus:   &ec2.InstanceStatusSummary{Status: aws.String(systemStatus)},
+					InstanceStatus: &ec2.InstanceStatusSummary{Status: aws.String(instanceStatus)},
+				},
+			},
+		}, nil)
+	}
+	consoleOutput := func(m *mocks.MockEC2APIMockRecorder, output string) {
+		m.GetConsoleOutputWithContext(context.TODO(), gomock.Eq(&ec2.GetConsoleOutputInput{
+			InstanceId: aws.String(instanceID),
+		})).Return(&ec2.GetConsoleOutputOutput{
+			InstanceId: aws.String(instanceID),
+			Output:     aws.String(base64.StdEncoding.EncodeToString([]byte(output))),
+		}, nil)
+	}
+	startAnalysis := func(m *mocks.MockEC2APIMockRecorder) {
+		m.CreateNetworkInsightsPathWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateNetworkInsightsPathInput{})).
+			DoAndReturn(func(_ context.Context, input *ec2.CreateNetworkInsightsPathInput, _ ...interface{}) (*ec2.CreateNetworkInsightsPathOutput, error) {
+				if aws.StringValue(input.Source) != instanceID || aws.StringValue(input.DestinationIp) != "10.0.0.10" ||
+					aws.Int64Value(input.DestinationPort) != 6443 || aws.StringValue(input.Protocol) != ec2.ProtocolTcp {
+					t.Fatalf("unexpected network insights path input: %v", input)
+				}
+				return &ec2.CreateNetworkInsightsPathOutput{
+					NetworkInsightsPath: &ec2.NetworkInsightsPath{NetworkInsightsPathId: aws.String("nip-1")},
+				}, nil
+			})
+		m.StartNetworkInsightsAnalysisWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.StartNetworkInsightsAnalysisInput{})).
+			Return(&ec2.StartNetworkInsightsAnalysisOutput{
+				NetworkInsightsAnalysis: &ec2.NetworkInsightsAnalysis{
+					NetworkInsightsAnalysisId: aws.String("nia-1"),
+					Status:                    aws.String(ec2.AnalysisStatusRunning),
+				},
+			}, nil)
+	}
+
+	testCases := []struct {
+		name      string
+		endpoint  clusterv1.APIEndpoint
+		expect    func(m *mocks.MockEC2APIMockRecorder)
+		expectSSM func(m *mock_ssmiface.MockSSMAPIMockRecorder)
+		check     func(g *WithT, diagnosis *infrav1.BootstrapDiagnosis)
+	}{
+		{
+			name:     "should collect a healthy diagnosis and start a reachability analysis",
+			endpoint: endpoint,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeStatus(m, "ok", "ok")
+				consoleOutput(m, "line 1\nline 2\n")
+				startAnalysis(m)
+			},
+			expectSSM: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.DescribeInstanceInformationWithContext(context.TODO(), gomock.Any()).Return(&ssm.DescribeInstanceInformationOutput{
+					InstanceInformationList: []*ssm.InstanceInformation{{PingStatus: aws.String(ssm.PingStatusOnline)}},
+				}, nil)
+			},
+			check: func(g *WithT, diagnosis *infrav1.BootstrapDiagnosis) {
+				g.Expect(diagnosis.SystemStatus).To(Equal("ok"))
+				g.Expect(diagnosis.InstanceStatus).To(Equal("ok"))
+				g.Expect(diagnosis.SystemLog).To(Equal("line 1\nline 2"))
+				g.Expect(diagnosis.SSMPingStatus).To(Equal(ssm.PingStatusOnline))
+				g.Expect(diagnosis.Findings).To(BeEmpty())
+				g.Expect(diagnosis.Reachability).To(Equal(&infrav1.ReachabilityAnalysis{
+					NetworkInsightsPathID:     "nip-1",
+					NetworkInsightsAnalysisID: "nia-1",
+					Status:                    ec2.AnalysisStatusRunning,
+				}))
+				g.Expect(diagnosis.IsRunning()).To(BeTrue())
+			},
+		},
+		{
+			name:     "should report failing status checks, truncate the system log and report an unregistered SSM agent",
+			endpoint: endpoint,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeStatus(m, "ok", "impaired")
+				log := ""
+				for i := 0; i < systemLogLines+10; i++ {
+					log += "line\n"
+				}
+				consoleOutput(m, log+"last line\n")
+				startAnalysis(m)
+			},
+			expectSSM: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.DescribeInstanceInformationWithContext(context.TODO(), gomock.Any()).Return(&ssm.DescribeInstanceInformationOutput{}, nil)
+			},
+			check: func(g *WithT, diagnosis *infrav1.BootstrapDiagnosis) {
+				g.Expect(diagnosis.InstanceStatus).To(Equal("impaired"))
+				g.Expect(diagnosis.SystemLog).To(HaveSuffix("line\nlast line"))
+				g.Expect(strings.Split(diagnosis.SystemLog, "\n")).To(HaveLen(systemLogLines))
+				g.Expect(diagnosis.SSMPingStatus).To(Equal(ssmPingStatusNotRegistered))
+				g.Expect(diagnosis.Findings).To(HaveLen(2))
+			},
+		},
+		{
+			name:     "should report the failures to collect information as findings",
+			endpoint: clusterv1.APIEndpoint{},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceStatusWithContext(context.TODO(), gomock.Any()).Return(nil, awserr.New("UnauthorizedOperation", "", nil))
+				m.GetConsoleOutputWithContext(context.TODO(), gomock.Any()).Return(&ec2.GetConsoleOutputOutput{}, nil)
+			},
+			expectSSM: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.DescribeInstanceInformationWithContext(context.TODO(), gomock.Any()).Return(nil, awserr.New("AccessDeniedException", "", nil))
+			},
+			check: func(g *WithT, diagnosis *infrav1.BootstrapDiagnosis) {
+				g.Expect(diagnosis.Reachability).To(BeNil())
+				g.Expect(diagnosis.IsRunning()).To(BeFalse())
+				g.Expect(diagnosis.Findings).To(HaveLen(4))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+			tc.expectSSM(ssmMock.EXPECT())
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+			s.SSMClient = ssmMock
+
+			diagnosis := s.DiagnoseInstanceBootstrap(&infrav1.Instance{ID: instanceID}, tc.endpoint)
+			g.Expect(diagnosis.CollectedAt.IsZero()).To(BeFalse())
+			tc.check(g, diagnosis)
+		})
+	}
+}
+
+func TestUpdateBootstrapDiagnosis(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	runningDiagnosis := func() *infrav1.BootstrapDiagnosis {
+		return &infrav1.BootstrapDiagnosis{
+			Reachability: &infrav1.ReachabilityAnalysis{
+				NetworkInsightsPathID:     "nip-1",
+				NetworkInsightsAnalysisID: "nia-1",
+				Status:                    ec2.AnalysisStatusRunning,
+			},
+		}
+	}
+	describeAnalysis := func(m *mocks.MockEC2APIMockRecorder, analysis *ec2.NetworkInsightsAnalysis) {
+		m.DescribeNetworkInsightsAnalysesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeNetworkInsightsAnalysesInput{
+			NetworkInsightsAnalysisIds: []*string{aws.String("nia-1")},
+		})).Return(&ec2.DescribeNetworkInsightsAnalysesOutput{
+			NetworkInsightsAnalyses: []*ec2.NetworkInsightsAnalysis{analysis},
+		}, nil)
+	}
+	deleteResources := func(m *mocks.MockEC2APIMockRecorder) {
+		m.DeleteNetworkInsightsAnalysisWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNetworkInsightsAnalysisInput{
+			NetworkInsightsAnalysisId: aws.String("nia-1"),
+		})).Return(&ec2.DeleteNetworkInsightsAnalysisOutput{}, nil)
+		m.DeleteNetworkInsightsPathWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNetworkInsightsPathInput{
+			NetworkInsightsPathId: aws.String("nip-1"),
+		})).Return(&ec2.DeleteNetworkInsightsPathOutput{}, nil)
+	}
+
+	testCases := []struct {
+		name      string
+		diagnosis *infrav1.BootstrapDiagnosis
+		expect    func(m *mocks.MockEC2APIMockRecorder)
+		check     func(g *WithT, diagnosis *infrav1.BootstrapDiagnosis)
+		expectErr bool
+	}{
+		{
+			name:      "should do nothing when no analysis is running",
+			diagnosis: &infrav1.BootstrapDiagnosis{},
+			expect:    func(m *mocks.MockEC2APIMockRecorder) {},
+			check: func(g *WithT, diagnosis *infrav1.BootstrapDiagnosis) {
+				g.Expect(diagnosis.Findings).To(BeEmpty())
+			},
+		},
+		{
+			name:      "should keep waiting while the analysis is running",
+			diagnosis: runningDiagnosis(),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeAnalysis(m, &ec2.NetworkInsightsAnalysis{Status: aws.String(ec2.AnalysisStatusRunning)})
+			},
+			check: func(g *WithT, diagnosis *infrav1.BootstrapDiagnosis) {
+				g.Expect(diagnosis.IsRunning()).To(BeTrue())
+			},
+		},
+		{
+			name:      "should record an unreachable endpoint and delete the analysis resources",
+			diagnosis: runningDiagnosis(),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeAnalysis(m, &ec2.NetworkInsightsAnalysis{
+					Status:           aws.String(ec2.AnalysisStatusSucceeded),
+					NetworkPathFound: aws.Bool(false),
+					Explanations: []*ec2.Explanation{
+						{
+							ExplanationCode: aws.String("ENI_SG_RULES_MISMATCH"),
+							Component:       &ec2.AnalysisComponent{Id: aws.String("sg-1")},
+						},
+					},
+				})
+				deleteResources(m)
+			},
+			check: func(g *WithT, diagnosis *infrav1.BootstrapDiagnosis) {
+				g.Expect(diagnosis.IsRunning()).To(BeFalse())
+				g.Expect(diagnosis.Reachability.NetworkPathFound).To(Equal(aws.Bool(false)))
+				g.Expect(diagnosis.Reachability.Explanations).To(Equal([]string{"ENI_SG_RULES_MISMATCH: sg-1"}))
+				g.Expect(diagnosis.Findings).To(HaveLen(1))
+			},
+		},
+		{
+			name:      "should record a failed analysis and ignore already deleted resources",
+			diagnosis: runningDiagnosis(),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeAnalysis(m, &ec2.NetworkInsightsAnalysis{
+					Status:        aws.String(ec2.AnalysisStatusFailed),
+					StatusMessage: aws.String("internal error"),
+				})
+				m.DeleteNetworkInsightsAnalysisWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New("InvalidNetworkInsightsAnalysisId.NotFound", "", nil))
+				m.DeleteNetworkInsightsPathWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New("InvalidNetworkInsightsPathId.NotFound", "", nil))
+			},
+			check: func(g *WithT, diagnosis *infrav1.BootstrapDiagnosis) {
+				g.Expect(diagnosis.Reachability.Status).To(Equal(ec2.AnalysisStatusFailed))
+				g.Expect(diagnosis.Findings).To(ConsistOf("network insights analysis failed: internal error"))
+			},
+		},
+		{
+			name:      "should return an error when the analysis cannot be described",
+			diagnosis: runningDiagnosis(),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkInsightsAnalysesWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New("RequestLimitExceeded", "", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			err = s.UpdateBootstrapDiagnosis(tc.diagnosis)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			tc.check(g, tc.diagnosis)
+		})
+	}
+}
+
+func TestResolveEndpointIPv4(t *testing.T) {
+	defer func(f func(string) ([]string, error)) { lookupHost = f }(lookupHost)
+	lookupHost = func(host string) ([]string, error) {
+		return []string{"2001:db8::1", "192.0.2.10"}, nil
+	}
+
+	g := NewWithT(t)
+
+	ip, err := resolveEndpointIPv4("10.0.0.1")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ip).To(Equal("10.0.0.1"))
+
+	ip, err = resolveEndpointIPv4("api.example.com")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ip).To(Equal("192.0.2.10"))
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ReconcileInstanceConnectEndpoint ensures an EC2 Instance Connect Endpoint is created for the cluster
+// when requested, and deleted otherwise.
+func (s *Service) ReconcileInstanceConnectEndpoint() error {
+	if !s.instanceConnectEndpointEnabled() {
+		s.scope.Trace("Skipping EC2 Instance Connect Endpoint reconcile")
+		if s.scope.InstanceConnectEndpointStatus() == nil {
+			return nil
+		}
+		return s.DeleteInstanceConnectEndpoint()
+	}
+
+	s.scope.Debug("Reconciling EC2 Instance Connect Endpoint")
+
+	subnets := s.scope.Subnets().FilterPrivate()
+	if len(subnets) == 0 {
+		s.scope.Debug("No private subnets available, skipping EC2 Instance Connect Endpoint")
+		return nil
+	}
+
+	sg, ok := s.scope.SecurityGroups()[infrav1.SecurityGroupInstanceConnectEndpoint]
+	if !ok || sg.ID == "" {
+		return errors.New("failed to reconcile EC2 Instance Connect Endpoint, its security group is not available")
+	}
+
+	endpoint, err := s.describeInstanceConnectEndpoint()
+	if awserrors.IsNotFound(err) {
+		endpoint, err = s.createInstanceConnectEndpoint(subnets[0].GetResourceID(), sg.ID)
+		if err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	s.scope.SetInstanceConnectEndpoint(&infrav1.InstanceConnectEndpointStatus{
+		ID:       aws.StringValue(endpoint.InstanceConnectEndpointId),
+		SubnetID: aws.StringValue(endpoint.SubnetId),
+		DNSName:  aws.StringValue(endpoint.DnsName),
+	})
+
+	switch aws.StringValue(endpoint.State) {
+	case ec2.Ec2InstanceConnectEndpointStateCreateComplete:
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.InstanceConnectEndpointReadyCondition)
+	case ec2.Ec2InstanceConnectEndpointStateCreateFailed:
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.InstanceConnectEndpointReadyCondition, infrav1.InstanceConnectEndpointFailedReason, clusterv1.ConditionSeverityError, "%s", aws.StringValue(endpoint.StateMessage))
+		return errors.Errorf("failed to create EC2 Instance Connect Endpoint %q: %s", aws.StringValue(endpoint.InstanceConnectEndpointId), aws.StringValue(endpoint.StateMessage))
+	default:
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.InstanceConnectEndpointReadyCondition, infrav1.InstanceConnectEndpointCreatingReason, clusterv1.ConditionSeverityInfo, "")
+	}
+
+	s.scope.Debug("Reconcile EC2 Instance Connect Endpoint completed successfully")
+	return nil
+}
+
+// DeleteInstanceConnectEndpoint deletes the EC2 Instance Connect Endpoint of the cluster and waits for it to be gone,
+// so that its network interface no longer holds on to the security groups and subnets of the cluster.
+// Clusters that never requested an endpoint are skipped.
+func (s *Service) DeleteInstanceConnectEndpoint() error {
+	if !s.instanceConnectEndpointEnabled() && s.scope.InstanceConnectEndpointStatus() == nil {
+		s.scope.Trace("EC2 Instance Connect Endpoint was never requested, skipping deletion")
+		return nil
+	}
+
+	endpoint, err := s.describeInstanceConnectEndpoint()
+	if err != nil {
+		if awserrors.IsNotFound(err) {
+			s.scope.Trace("EC2 Instance Connect Endpoint does not exist")
+			s.scope.SetInstanceConnectEndpoint(nil)
+			return nil
+		}
+		return err
+	}
+
+	id := aws.StringValue(endpoint.InstanceConnectEndpointId)
+	if aws.StringValue(endpoint.State) != ec2.Ec2InstanceConnectEndpointStateDeleteInProgress {
+		if _, err := s.EC2Client.DeleteInstanceConnectEndpointWithContext(context.TODO(), &ec2.DeleteInstanceConnectEndpointInput{
+			InstanceConnectEndpointId: aws.String(id),
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteInstanceConnectEndpoint", "Failed to delete EC2 Instance Connect Endpoint %q: %v", id, err)
+			return errors.Wrapf(err, "failed to delete EC2 Instance Connect Endpoint %q", id)
+		}
+	}
+
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.InstanceConnectEndpointReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		endpoint, err := s.describeInstanceConnectEndpoint()
+		if awserrors.IsNotFound(err) {
+			return true, nil
+		} else if err != nil {
+			return false, err
+		}
+		if aws.StringValue(endpoint.State) == ec2.Ec2InstanceConnectEndpointStateDeleteFailed {
+			return false, errors.Errorf("in failed state: %s", aws.StringValue(endpoint.StateMessage))
+		}
+		return false, nil
+	}); err != nil {
+		return errors.Wrapf(err, "failed to wait for EC2 Instance Connect Endpoint deletion %q", id)
+	}
+
+	s.scope.SetInstanceConnectEndpoint(nil)
+
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.InstanceConnectEndpointReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteInstanceConnectEndpoint", "Deleted EC2 Instance Connect Endpoint %q", id)
+	s.scope.Info("Deleted EC2 Instance Connect Endpoint", "id", id)
+
+	return nil
+}
+
+// instanceConnectEndpointEnabled returns true if an EC2 Instance Connect Endpoint is requested for the cluster.
+func (s *Service) instanceConnectEndpointEnabled() bool {
+	return s.scope.InstanceConnectEndpoint() != nil && s.scope.InstanceConnectEndpoint().Enabled
+}
+
+func (s *Service) createInstanceConnectEndpoint(subnetID, securityGroupID string) (*ec2.Ec2InstanceConnectEndpoint, error) {
+	if !conditions.Has(s.scope.InfraCluster(), infrav1.InstanceConnectEndpointReadyCondition) {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.InstanceConnectEndpointReadyCondition, infrav1.InstanceConnectEndpointCreatingReason, clusterv1.ConditionSeverityInfo, "")
+		if err := s.scope.PatchObject(); err != nil {
+			return nil, errors.Wrap(err, "failed to patch conditions")
+		}
+	}
+
+	out, err := s.EC2Client.CreateInstanceConnectEndpointWithContext(context.TODO(), &ec2.CreateInstanceConnectEndpointInput{
+		SubnetId:          aws.String(subnetID),
+		SecurityGroupIds:  aws.StringSlice([]string{securityGroupID}),
+		TagSpecifications: []*ec2.TagSpecification{tags.BuildParamsToTagSpecification(ec2.ResourceTypeInstanceConnectEndpoint, s.getInstanceConnectEndpointTagParams())},
+	})
+	if err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.InstanceConnectEndpointReadyCondition, infrav1.InstanceConnectEndpointFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateInstanceConnectEndpoint", "Failed to create EC2 Instance Connect Endpoint in subnet %q: %v", subnetID, err)
+		return nil, errors.Wrapf(err, "failed to create EC2 Instance Connect Endpoint in subnet %q", subnetID)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateInstanceConnectEndpoint", "Created EC2 Instance Connect Endpoint %q", aws.StringValue(out.InstanceConnectEndpoint.InstanceConnectEndpointId))
+	s.scope.Info("Created EC2 Instance Connect Endpoint", "id", aws.StringValue(out.InstanceConnectEndpoint.InstanceConnectEndpointId), "subnet-id", subnetID)
+
+	return out.InstanceConnectEndpoint, nil
+}
+
+// describeInstanceConnectEndpoint returns the EC2 Instance Connect Endpoint owned by the cluster, if any.
+// Endpoints that are already deleted are ignored.
+func (s *Service) describeInstanceConnectEndpoint() (*ec2.Ec2InstanceConnectEndpoint, error) {
+	out, err := s.EC2Client.DescribeInstanceConnectEndpointsWithContext(context.TODO(), &ec2.DescribeInstanceConnectEndpointsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.ProviderRole(infrav1.InstanceConnectEndpointRoleTagValue),
+			filter.EC2.Cluster(s.scope.Name()),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe EC2 Instance Connect Endpoints")
+	}
+
+	for _, endpoint := range out.InstanceConnectEndpoints {
+		if aws.StringValue(endpoint.State) != ec2.Ec2InstanceConnectEndpointStateDeleteComplete {
+			return endpoint, nil
+		}
+	}
+
+	return nil, awserrors.NewNotFound("EC2 Instance Connect Endpoint not found")
+}
+
+func (s *Service) getInstanceConnectEndpointTagParams() infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-instance-connect-endpoint", s.scope.Name())),
+		Role:        aws.String(infrav1.InstanceConnectEndpointRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
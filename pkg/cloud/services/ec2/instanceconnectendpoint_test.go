@@ -0,0 +1,242 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestServiceReconcileInstanceConnectEndpoint(t *testing.T) {
+	clusterName := "cluster"
+
+	describeInput := &ec2.DescribeInstanceConnectEndpointsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.ProviderRole(infrav1.InstanceConnectEndpointRoleTagValue),
+			filter.EC2.Cluster(clusterName),
+		},
+	}
+
+	endpoint := func(state string) *ec2.Ec2InstanceConnectEndpoint {
+		return &ec2.Ec2InstanceConnectEndpoint{
+			InstanceConnectEndpointId: aws.String("eice-123"),
+			SubnetId:                  aws.String("subnet-private"),
+			DnsName:                   aws.String("eice-123.ec2-instance-connect-endpoint.us-east-1.amazonaws.com"),
+			State:                     aws.String(state),
+		}
+	}
+
+	tests := []struct {
+		name               string
+		spec               *infrav1.InstanceConnectEndpoint
+		status             *infrav1.InstanceConnectEndpointStatus
+		subnets            infrav1.Subnets
+		expect             func(m *mocks.MockEC2APIMockRecorder)
+		expectError        bool
+		expectStatus       *infrav1.InstanceConnectEndpointStatus
+		expectConditionSet bool
+		expectReady        corev1.ConditionStatus
+	}{
+		{
+			name: "should not call AWS when the endpoint was never requested",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+			},
+		},
+		{
+			name:   "should delete the endpoint when it is disabled",
+			spec:   &infrav1.InstanceConnectEndpoint{Enabled: false},
+			status: &infrav1.InstanceConnectEndpointStatus{ID: "eice-123"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeInstanceConnectEndpointsOutput{
+						InstanceConnectEndpoints: []*ec2.Ec2InstanceConnectEndpoint{endpoint(ec2.Ec2InstanceConnectEndpointStateCreateComplete)},
+					}, nil)
+				m.DeleteInstanceConnectEndpointWithContext(context.TODO(), gomock.Eq(&ec2.DeleteInstanceConnectEndpointInput{
+					InstanceConnectEndpointId: aws.String("eice-123"),
+				})).Return(&ec2.DeleteInstanceConnectEndpointOutput{}, nil)
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeInstanceConnectEndpointsOutput{
+						InstanceConnectEndpoints: []*ec2.Ec2InstanceConnectEndpoint{endpoint(ec2.Ec2InstanceConnectEndpointStateDeleteComplete)},
+					}, nil)
+			},
+			expectConditionSet: true,
+			expectReady:        corev1.ConditionFalse,
+		},
+		{
+			name: "should skip the endpoint when there are no private subnets",
+			spec: &infrav1.InstanceConnectEndpoint{Enabled: true},
+			subnets: infrav1.Subnets{
+				{ID: "subnet-public", IsPublic: true},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+			},
+		},
+		{
+			name: "should create the endpoint in a private subnet when it doesn't exist",
+			spec: &infrav1.InstanceConnectEndpoint{Enabled: true},
+			subnets: infrav1.Subnets{
+				{ID: "subnet-public", IsPublic: true},
+				{ID: "subnet-private", IsPublic: false},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeInstanceConnectEndpointsOutput{}, nil)
+				m.CreateInstanceConnectEndpointWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateInstanceConnectEndpointInput, _ ...request.Option) (*ec2.CreateInstanceConnectEndpointOutput, error) {
+						if aws.StringValue(input.SubnetId) != "subnet-private" {
+							return nil, errors.Errorf("unexpected subnet %q", aws.StringValue(input.SubnetId))
+						}
+						if len(input.SecurityGroupIds) != 1 || aws.StringValue(input.SecurityGroupIds[0]) != "sg-instance-connect-endpoint" {
+							return nil, errors.Errorf("unexpected security groups %v", aws.StringValueSlice(input.SecurityGroupIds))
+						}
+						if len(input.TagSpecifications) != 1 || aws.StringValue(input.TagSpecifications[0].ResourceType) != ec2.ResourceTypeInstanceConnectEndpoint {
+							return nil, errors.New("unexpected tag specifications")
+						}
+						return &ec2.CreateInstanceConnectEndpointOutput{
+							InstanceConnectEndpoint: endpoint(ec2.Ec2InstanceConnectEndpointStateCreateInProgress),
+						}, nil
+					})
+			},
+			expectStatus: &infrav1.InstanceConnectEndpointStatus{
+				ID:       "eice-123",
+				SubnetID: "subnet-private",
+				DNSName:  "eice-123.ec2-instance-connect-endpoint.us-east-1.amazonaws.com",
+			},
+			expectConditionSet: true,
+			expectReady:        corev1.ConditionFalse,
+		},
+		{
+			name: "should mark the endpoint ready once it is created",
+			spec: &infrav1.InstanceConnectEndpoint{Enabled: true},
+			subnets: infrav1.Subnets{
+				{ID: "subnet-private", IsPublic: false},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeInstanceConnectEndpointsOutput{
+						InstanceConnectEndpoints: []*ec2.Ec2InstanceConnectEndpoint{endpoint(ec2.Ec2InstanceConnectEndpointStateCreateComplete)},
+					}, nil)
+			},
+			expectStatus: &infrav1.InstanceConnectEndpointStatus{
+				ID:       "eice-123",
+				SubnetID: "subnet-private",
+				DNSName:  "eice-123.ec2-instance-connect-endpoint.us-east-1.amazonaws.com",
+			},
+			expectConditionSet: true,
+			expectReady:        corev1.ConditionTrue,
+		},
+		{
+			name: "should fail when the endpoint creation failed",
+			spec: &infrav1.InstanceConnectEndpoint{Enabled: true},
+			subnets: infrav1.Subnets{
+				{ID: "subnet-private", IsPublic: false},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceConnectEndpointsWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeInstanceConnectEndpointsOutput{
+						InstanceConnectEndpoints: []*ec2.Ec2InstanceConnectEndpoint{endpoint(ec2.Ec2InstanceConnectEndpointStateCreateFailed)},
+					}, nil)
+			},
+			expectError:        true,
+			expectConditionSet: true,
+			expectReady:        corev1.ConditionFalse,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockControl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).To(BeNil())
+
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					InstanceConnectEndpoint: tc.spec,
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{
+							ID: "vpcID",
+						},
+						Subnets: tc.subnets,
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					InstanceConnectEndpoint: tc.status,
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupInstanceConnectEndpoint: {ID: "sg-instance-connect-endpoint"},
+						},
+					},
+				},
+			}
+
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).WithStatusSubresource(awsCluster).Build()
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				AWSCluster: awsCluster,
+				Client:     client,
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(ec2Mock.EXPECT())
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.ReconcileInstanceConnectEndpoint()
+			if tc.expectError {
+				g.Expect(err).NotTo(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(scope.AWSCluster.Status.InstanceConnectEndpoint).To(Equal(tc.expectStatus))
+			}
+
+			g.Expect(conditions.Has(scope.AWSCluster, infrav1.InstanceConnectEndpointReadyCondition)).To(Equal(tc.expectConditionSet))
+			if tc.expectConditionSet {
+				g.Expect(conditions.Get(scope.AWSCluster, infrav1.InstanceConnectEndpointReadyCondition).Status).To(Equal(tc.expectReady))
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// InstanceConstraintError is returned when an instance cannot be launched because of the capacity of its instance
+// type, the instance type not being supported in the availability zone, or the lack of free addresses in its subnet.
+// It describes the instance type, to help choosing another instance type or availability zone.
+type InstanceConstraintError struct {
+	// Err is the error returned when launching the instance.
+	Err error
+	// InstanceType is the type of the instance.
+	InstanceType string
+	// NetworkPerformance is the network performance of the instance type, e.g. "Up to 10 Gigabit".
+	NetworkPerformance string
+	// MaximumNetworkInterfaces is the maximum number of network interfaces of the instance type.
+	MaximumNetworkInterfaces int64
+	// EBSBaselineBandwidthInMbps is the baseline bandwidth of the EBS volumes of the instance type.
+	EBSBaselineBandwidthInMbps int64
+	// EBSMaximumBandwidthInMbps is the bandwidth the EBS volumes of the instance type can burst to.
+	EBSMaximumBandwidthInMbps int64
+	// BurstablePerformance is whether the instance type is a burstable performance instance type.
+	BurstablePerformance bool
+	// AvailabilityZones are the availability zones the instance type is offered in.
+	AvailabilityZones []string
+}
+
+func (e *InstanceConstraintError) Error() string {
+	var characteristics []string
+	if e.NetworkPerformance != "" {
+		characteristics = append(characteristics, fmt.Sprintf("network performance %q", e.NetworkPerformance))
+	}
+	if e.MaximumNetworkInterfaces > 0 {
+		characteristics = append(characteristics, fmt.Sprintf("up to %d network interfaces", e.MaximumNetworkInterfaces))
+	}
+	switch {
+	case e.EBSMaximumBandwidthInMbps > e.EBSBaselineBandwidthInMbps && e.EBSBaselineBandwidthInMbps > 0:
+		characteristics = append(characteristics, fmt.Sprintf("an EBS bandwidth of %d Mbps bursting to %d Mbps", e.EBSBaselineBandwidthInMbps, e.EBSMaximumBandwidthInMbps))
+	case e.EBSBaselineBandwidthInMbps > 0:
+		characteristics = append(characteristics, fmt.Sprintf("an EBS bandwidth of %d Mbps", e.EBSBaselineBandwidthInMbps))
+	}
+	if e.BurstablePerformance {
+		characteristics = append(characteristics, "burstable CPU performance")
+	}
+
+	details := fmt.Sprintf("instance type %q", e.InstanceType)
+	if len(characteristics) > 0 {
+		details += " has " + strings.Join(characteristics, ", ") + " and"
+	}
+	if len(e.AvailabilityZones) > 0 {
+		details += " is offered in availability zones " + strings.Join(e.AvailabilityZones, ", ")
+	} else {
+		details += " is not offered in any availability zone of the region"
+	}
+	return fmt.Sprintf("%v; %s", e.Err, details)
+}
+
+// Unwrap returns the error returned when launching the instance.
+func (e *InstanceConstraintError) Unwrap() error {
+	return e.Err
+}
+
+// Cause returns the error returned when launching the instance, so that the AWS error can be inspected with
+// errors.Cause.
+func (e *InstanceConstraintError) Cause() error {
+	return e.Err
+}
+
+// describeInstanceConstraint returns an InstanceConstraintError describing the given instance type, which an
+// instance failed to be launched with. The launch error is returned as is when the instance type cannot be described.
+func (s *Service) describeInstanceConstraint(launchErr error, instanceType string) error {
+	out, err := s.EC2Client.DescribeInstanceTypesWithContext(context.TODO(), &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice([]string{instanceType}),
+	})
+	if err != nil || len(out.InstanceTypes) == 0 {
+		s.scope.Debug("Failed to describe instance type, not describing the constraints of the instance", "instance-type", instanceType, "error", err)
+		return launchErr
+	}
+	info := out.InstanceTypes[0]
+
+	constraintErr := &InstanceConstraintError{
+		Err:                  launchErr,
+		InstanceType:         instanceType,
+		BurstablePerformance: aws.BoolValue(info.BurstablePerformanceSupported),
+	}
+	if info.NetworkInfo != nil {
+		constraintErr.NetworkPerformance = aws.StringValue(info.NetworkInfo.NetworkPerformance)
+		constraintErr.MaximumNetworkInterfaces = aws.Int64Value(info.NetworkInfo.MaximumNetworkInterfaces)
+	}
+	if info.EbsInfo != nil && info.EbsInfo.EbsOptimizedInfo != nil {
+		constraintErr.EBSBaselineBandwidthInMbps = aws.Int64Value(info.EbsInfo.EbsOptimizedInfo.BaselineBandwidthInMbps)
+		constraintErr.EBSMaximumBandwidthInMbps = aws.Int64Value(info.EbsInfo.EbsOptimizedInfo.MaximumBandwidthInMbps)
+	}
+
+	if err := s.EC2Client.DescribeInstanceTypeOfferingsPagesWithContext(context.TODO(), &ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+		Filters: []*ec2.Filter{
+			{Name: aws.String("instance-type"), Values: aws.StringSlice([]string{instanceType})},
+		},
+	}, func(out *ec2.DescribeInstanceTypeOfferingsOutput, _ bool) bool {
+		for _, offering := range out.InstanceTypeOfferings {
+			constraintErr.AvailabilityZones = append(constraintErr.AvailabilityZones, aws.StringValue(offering.Location))
+		}
+		return true
+	}); err != nil {
+		s.scope.Debug("Failed to describe instance type offerings, not describing the constraints of the instance", "instance-type", instanceType, "error", err)
+		return launchErr
+	}
+	sort.Strings(constraintErr.AvailabilityZones)
+
+	return constraintErr
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestDescribeInstanceConstraint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	launchErr := errors.Wrap(awserr.New(awserrors.InsufficientInstanceCapacity, "insufficient capacity", nil), "failed to run instance")
+
+	describeOfferings := func(m *mocks.MockEC2APIMockRecorder, zones ...string) {
+		m.DescribeInstanceTypeOfferingsPagesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypeOfferingsInput{
+			LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+			Filters: []*ec2.Filter{
+				{Name: aws.String("instance-type"), Values: aws.StringSlice([]string{"t3.large"})},
+			},
+		}), gomock.Any()).DoAndReturn(func(_ context.Context, _ *ec2.DescribeInstanceTypeOfferingsInput, fn func(*ec2.DescribeInstanceTypeOfferingsOutput, bool) bool, _ ...interface{}) error {
+			out := &ec2.DescribeInstanceTypeOfferingsOutput{}
+			for _, zone := range zones {
+				out.InstanceTypeOfferings = append(out.InstanceTypeOfferings, &ec2.InstanceTypeOffering{InstanceType: aws.String("t3.large"), Location: aws.String(zone)})
+			}
+			fn(out, true)
+			return nil
+		})
+	}
+
+	testCases := []struct {
+		name    string
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr string
+	}{
+		{
+			name: "describes the characteristics of the instance type",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: aws.StringSlice([]string{"t3.large"}),
+				})).Return(&ec2.DescribeInstanceTypesOutput{
+					InstanceTypes: []*ec2.InstanceTypeInfo{
+						{
+							InstanceType:                  aws.String("t3.large"),
+							BurstablePerformanceSupported: aws.Bool(true),
+							NetworkInfo: &ec2.NetworkInfo{
+								NetworkPerformance:       aws.String("Up to 5 Gigabit"),
+								MaximumNetworkInterfaces: aws.Int64(3),
+							},
+							EbsInfo: &ec2.EbsInfo{
+								EbsOptimizedInfo: &ec2.EbsOptimizedInfo{
+									BaselineBandwidthInMbps: aws.Int64(695),
+									MaximumBandwidthInMbps:  aws.Int64(2780),
+								},
+							},
+						},
+					},
+				}, nil)
+				describeOfferings(m, "us-east-1b", "us-east-1a")
+			},
+			wantErr: `failed to run instance: InsufficientInstanceCapacity: insufficient capacity; instance type "t3.large" has network performance "Up to 5 Gigabit", up to 3 network interfaces, an EBS bandwidth of 695 Mbps bursting to 2780 Mbps, burstable CPU performance and is offered in availability zones us-east-1a, us-east-1b`,
+		},
+		{
+			name: "reports an instance type offered in no availability zone",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeInstanceTypesOutput{
+					InstanceTypes: []*ec2.InstanceTypeInfo{{InstanceType: aws.String("t3.large")}},
+				}, nil)
+				describeOfferings(m)
+			},
+			wantErr: `failed to run instance: InsufficientInstanceCapacity: insufficient capacity; instance type "t3.large" is not offered in any availability zone of the region`,
+		},
+		{
+			name: "returns the launch error when the instance type cannot be described",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).Return(nil, awserr.New(awserrors.UnauthorizedOperation, "not allowed", nil))
+			},
+			wantErr: "failed to run instance: InsufficientInstanceCapacity: insufficient capacity",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			err = s.describeInstanceConstraint(launchErr, "t3.large")
+			g.Expect(err).To(MatchError(tc.wantErr))
+			g.Expect(awserrors.IsInsufficientInstanceCapacity(errors.Cause(err))).To(BeTrue())
+		})
+	}
+}
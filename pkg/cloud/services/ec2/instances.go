@@ -20,13 +20,16 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -39,6 +42,9 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
+// vpcCNINodeInstanceIDTagKey is the tag the VPC CNI sets on the network interfaces it attaches to the nodes.
+const vpcCNINodeInstanceIDTagKey = "node.k8s.amazonaws.com/instance_id"
+
 // GetRunningInstanceByTags returns the existing instance or nothing if it doesn't exist.
 func (s *Service) GetRunningInstanceByTags(scope *scope.MachineScope) (*infrav1.Instance, error) {
 	s.scope.Debug("Looking for existing machine instance by tags")
@@ -105,6 +111,48 @@ func (s *Service) InstanceIfExists(id *string) (*infrav1.Instance, error) {
 	return nil, ErrInstanceNotFoundByID
 }
 
+// GetInstanceMarketTypes returns the market type of the given instances, keyed by instance ID.
+// Instances that cannot be found are omitted.
+func (s *Service) GetInstanceMarketTypes(instanceIDs []string) (map[string]infrav1.MarketType, error) {
+	marketTypes := make(map[string]infrav1.MarketType, len(instanceIDs))
+	if len(instanceIDs) == 0 {
+		return marketTypes, nil
+	}
+
+	input := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-id"),
+				Values: aws.StringSlice(instanceIDs),
+			},
+		},
+	}
+	if err := s.EC2Client.DescribeInstancesPagesWithContext(context.TODO(), input, func(out *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range out.Reservations {
+			for _, instance := range reservation.Instances {
+				marketTypes[aws.StringValue(instance.InstanceId)] = instanceLifecycleToMarketType(instance.InstanceLifecycle)
+			}
+		}
+		return true
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to describe instances")
+	}
+
+	return marketTypes, nil
+}
+
+// instanceLifecycleToMarketType converts the lifecycle of an EC2 instance to its market type.
+func instanceLifecycleToMarketType(lifecycle *string) infrav1.MarketType {
+	switch aws.StringValue(lifecycle) {
+	case ec2.InstanceLifecycleTypeSpot:
+		return infrav1.MarketTypeSpot
+	case ec2.InstanceLifecycleTypeCapacityBlock:
+		return infrav1.MarketTypeCapacityBlock
+	default:
+		return infrav1.MarketTypeOnDemand
+	}
+}
+
 // CreateInstance runs an ec2 instance.
 //
 //nolint:gocyclo // this function has multiple processes to perform
@@ -114,11 +162,14 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 	input := &infrav1.Instance{
 		Type:                 scope.AWSMachine.Spec.InstanceType,
 		IAMProfile:           scope.AWSMachine.Spec.IAMInstanceProfile,
-		RootVolume:           scope.AWSMachine.Spec.RootVolume.DeepCopy(),
+		RootVolume:           scope.RootVolume().DeepCopy(),
 		NonRootVolumes:       scope.AWSMachine.Spec.NonRootVolumes,
 		NetworkInterfaces:    scope.AWSMachine.Spec.NetworkInterfaces,
 		NetworkInterfaceType: scope.AWSMachine.Spec.NetworkInterfaceType,
 	}
+	if profile := scope.AWSMachine.Spec.ManagedIAMInstanceProfile; profile != nil {
+		input.IAMProfile = profile.Name
+	}
 
 	// Make sure to use the MachineScope here to get the merger of AWSCluster and AWSMachine tags
 	additionalTags := scope.AdditionalTags()
@@ -132,6 +183,26 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 
 	var err error
 
+	// Select the instance type from the instance requirements, among the instance types offered in
+	// the availability zone of the subnet of the instance.
+	if input.Type == "" && scope.AWSMachine.Spec.InstanceRequirements != nil {
+		input.SubnetID, err = s.findSubnet(scope)
+		if err != nil {
+			return nil, err
+		}
+		availabilityZone, err := s.subnetAvailabilityZone(input.SubnetID)
+		if err != nil {
+			return nil, err
+		}
+		input.Type, err = s.selectInstanceType(scope.AWSMachine.Spec.InstanceRequirements, availabilityZone)
+		if err != nil {
+			record.Warnf(scope.AWSMachine, "FailedSelectInstanceType", "Failed to select an instance type from the instance requirements: %v", err)
+			return nil, err
+		}
+		s.scope.Debug("Selected instance type from instance requirements", "instance-type", input.Type, "availability-zone", availabilityZone)
+		scope.SetInstanceType(input.Type)
+	}
+
 	imageArchitecture, err := s.pickArchitectureForInstanceType(input.Type)
 	if err != nil {
 		return nil, err
@@ -139,7 +210,10 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 
 	// Pick image from the machine configuration, or use a default one.
 	if scope.AWSMachine.Spec.AMI.ID != nil { //nolint:nestif
-		input.ImageID = *scope.AWSMachine.Spec.AMI.ID
+		input.ImageID, err = s.resolveMachineAMI(scope, *scope.AWSMachine.Spec.AMI.ID)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		if scope.Machine.Spec.Version == nil {
 			err := errors.New("Either AWSMachine's spec.ami.id or Machine's spec.version must be defined")
@@ -176,19 +250,35 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 		}
 	}
 
-	subnetID, err := s.findSubnet(scope)
-	if err != nil {
-		return nil, err
+	if scope.AWSMachine.Spec.AcceleratorBootstrap != nil {
+		if err := s.reconcileAcceleratorBootstrap(scope, input); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.SubnetID == "" {
+		input.SubnetID, err = s.findSubnet(scope)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ipv6Only := false
+	if subnet := s.scope.Subnets().FindByID(input.SubnetID); subnet != nil && subnet.IPv6Only {
+		if err := s.validateIPv6OnlyInstanceType(input.Type); err != nil {
+			record.Warnf(scope.AWSMachine, "FailedValidateInstanceType", "Instance type %q cannot be launched in IPv6-only subnet %q: %v", input.Type, input.SubnetID, err)
+			return nil, err
+		}
+		ipv6Only = true
 	}
-	input.SubnetID = subnetID
 
 	// Preserve user-defined PublicIp option.
 	input.PublicIPOnLaunch = scope.AWSMachine.Spec.PublicIP
 
-	// Public address from BYO Public IPv4 Pools need to be associated after launch (main machine
-	// reconciliate loop) preventing duplicated public IP. The map on launch is explicitly
-	// disabled in instances with PublicIP defined to true.
-	if scope.AWSMachine.Spec.ElasticIPPool != nil && scope.AWSMachine.Spec.ElasticIPPool.PublicIpv4Pool != nil {
+	// Public address from BYO Public IPv4 Pools, or adopted from pre-tagged Elastic IPs, need to be
+	// associated after launch (main machine reconciliate loop) preventing duplicated public IP. The
+	// map on launch is explicitly disabled in instances with PublicIP defined to true.
+	if pool := scope.AWSMachine.Spec.ElasticIPPool; pool != nil && (pool.PublicIpv4Pool != nil || pool.HasTagSelector()) {
 		input.PublicIPOnLaunch = ptr.To(false)
 	}
 
@@ -241,7 +331,7 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 
 	input.SpotMarketOptions = scope.AWSMachine.Spec.SpotMarketOptions
 
-	input.InstanceMetadataOptions = scope.AWSMachine.Spec.InstanceMetadataOptions
+	input.InstanceMetadataOptions = scope.InstanceMetadataOptions()
 
 	input.Tenancy = scope.AWSMachine.Spec.Tenancy
 
@@ -249,16 +339,56 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 
 	input.PlacementGroupPartition = scope.AWSMachine.Spec.PlacementGroupPartition
 
+	if scope.IsControlPlane() {
+		if err := s.applyControlPlanePlacement(scope, input); err != nil {
+			return nil, err
+		}
+	}
+
+	// The partition failure domains place their machines in their partition, unless the AWSMachine sets a placement group.
+	if input.PlacementGroupName == "" && scope.Machine.Spec.FailureDomain != nil {
+		attributes := s.scope.FailureDomains()[*scope.Machine.Spec.FailureDomain].Attributes
+		if name, ok := attributes[infrav1.FailureDomainAttributePlacementGroupName]; ok {
+			input.PlacementGroupName = name
+			input.PlacementGroupPartition, err = strconv.ParseInt(attributes[infrav1.FailureDomainAttributePlacementGroupPartition], 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse the placement group partition of failure domain %q", *scope.Machine.Spec.FailureDomain)
+			}
+		}
+	}
+
 	input.PrivateDNSName = scope.AWSMachine.Spec.PrivateDNSName
+	// Instances of IPv6-only subnets have no IPv4 address to base their hostname on.
+	if ipv6Only && input.PrivateDNSName == nil {
+		input.PrivateDNSName = &infrav1.PrivateDNSName{
+			HostnameType:                    aws.String(ec2.HostnameTypeResourceName),
+			EnableResourceNameDNSAAAARecord: aws.Bool(true),
+		}
+	}
 
 	input.CapacityReservationID = scope.AWSMachine.Spec.CapacityReservationID
 
 	input.MarketType = scope.AWSMachine.Spec.MarketType
 
+	input.CPUOptions = scope.AWSMachine.Spec.CPUOptions
+
+	if scope.IsSpotFallbackOnDemand() {
+		useOnDemandMarket(input)
+	}
+
+	if input.MarketType == infrav1.MarketTypeCapacityBlock && input.CapacityReservationID != nil {
+		if err := s.ValidateCapacityBlock(*input.CapacityReservationID, input.Type); err != nil {
+			return nil, err
+		}
+	}
+
 	s.scope.Debug("Running instance", "machine-role", scope.Role())
 	s.scope.Debug("Running instance with instance metadata options", "metadata options", input.InstanceMetadataOptions)
-	out, err := s.runInstance(scope.Role(), input)
+	out, err := s.runInstanceWithSpotFallback(scope, input)
 	if err != nil {
+		if awserrors.IsInstanceConstraintError(errors.Cause(err)) {
+			err = s.describeInstanceConstraint(err, input.Type)
+		}
 		// Only record the failure event if the error is not related to failed dependencies.
 		// This is to avoid spamming failure events since the machine will be requeued by the actuator.
 		if !awserrors.IsFailedDependency(errors.Cause(err)) {
@@ -313,6 +443,21 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 func (s *Service) findSubnet(scope *scope.MachineScope) (string, error) {
 	// Check Machine.Spec.FailureDomain first as it's used by KubeadmControlPlane to spread machines across failure domains.
 	failureDomain := scope.Machine.Spec.FailureDomain
+	// The failure domains which are not named after their zone, such as the partitions of a placement group,
+	// hold the zone of their subnets in their attributes.
+	var failureDomainAttributes map[string]string
+	if failureDomain != nil {
+		failureDomainAttributes = s.scope.FailureDomains()[*failureDomain].Attributes
+		if zone, ok := failureDomainAttributes[infrav1.FailureDomainAttributeZone]; ok {
+			failureDomain = &zone
+		}
+	}
+
+	// The control plane machines are only launched in the subnets dedicated to them, if any.
+	clusterSubnets := s.scope.Subnets()
+	if placement := s.scope.ControlPlanePlacement(); scope.IsControlPlane() && placement != nil && len(placement.SubnetIDs) > 0 {
+		clusterSubnets = clusterSubnets.FilterByIDs(placement.SubnetIDs)
+	}
 
 	// We basically have 2 sources for subnets:
 	//   1. If subnet.id or subnet.filters are specified, we directly query AWS
@@ -386,7 +531,7 @@ func (s *Service) findSubnet(scope *scope.MachineScope) (string, error) {
 		return *filtered[0].SubnetId, nil
 	case failureDomain != nil:
 		if scope.AWSMachine.Spec.PublicIP != nil && *scope.AWSMachine.Spec.PublicIP {
-			subnets := s.scope.Subnets().FilterPublic().FilterNonCni().FilterByZone(*failureDomain)
+			subnets := clusterSubnets.FilterPublic().FilterNonCni().FilterByZone(*failureDomain)
 			if len(subnets) == 0 {
 				errMessage := fmt.Sprintf("failed to run machine %q with public IP, no public subnets available in availability zone %q",
 					scope.Name(), *failureDomain)
@@ -396,7 +541,14 @@ func (s *Service) findSubnet(scope *scope.MachineScope) (string, error) {
 			return subnets[0].GetResourceID(), nil
 		}
 
-		subnets := s.scope.Subnets().FilterPrivate().FilterNonCni().FilterByZone(*failureDomain)
+		subnets := clusterSubnets.FilterPrivate()
+		if failureDomainAttributes[infrav1.FailureDomainAttributeZoneType] == string(infrav1.ZoneTypeLocalZone) {
+			subnets = clusterSubnets.FilterPrivateEdge()
+		}
+		subnets = subnets.FilterNonCni().FilterByZone(*failureDomain)
+		if outpostArn, ok := failureDomainAttributes[infrav1.FailureDomainAttributeOutpostArn]; ok {
+			subnets = subnets.FilterByOutpost(outpostArn)
+		}
 		if len(subnets) == 0 {
 			errMessage := fmt.Sprintf("failed to run machine %q, no subnets available in availability zone %q",
 				scope.Name(), *failureDomain)
@@ -405,7 +557,7 @@ func (s *Service) findSubnet(scope *scope.MachineScope) (string, error) {
 		}
 		return subnets[0].GetResourceID(), nil
 	case scope.AWSMachine.Spec.PublicIP != nil && *scope.AWSMachine.Spec.PublicIP:
-		subnets := s.scope.Subnets().FilterPublic().FilterNonCni()
+		subnets := clusterSubnets.FilterPublic().FilterNonCni()
 		if len(subnets) == 0 {
 			errMessage := fmt.Sprintf("failed to run machine %q with public IP, no public subnets available", scope.Name())
 			record.Eventf(scope.AWSMachine, "FailedCreate", errMessage)
@@ -417,7 +569,7 @@ func (s *Service) findSubnet(scope *scope.MachineScope) (string, error) {
 		// with control plane machines.
 
 	default:
-		sns := s.scope.Subnets().FilterPrivate().FilterNonCni()
+		sns := clusterSubnets.FilterPrivate().FilterNonCni()
 		if len(sns) == 0 {
 			errMessage := fmt.Sprintf("failed to run machine %q, no subnets available", scope.Name())
 			record.Eventf(s.scope.InfraCluster(), "FailedCreateInstance", errMessage)
@@ -427,6 +579,47 @@ func (s *Service) findSubnet(scope *scope.MachineScope) (string, error) {
 	}
 }
 
+// applyControlPlanePlacement applies the placement constraints of the cluster to a control plane instance, and fails
+// if its AWSMachine conflicts with them.
+func (s *Service) applyControlPlanePlacement(scope *scope.MachineScope, input *infrav1.Instance) error {
+	placement := s.scope.ControlPlanePlacement()
+	if placement == nil {
+		return nil
+	}
+
+	var conflicts []string
+	if len(placement.AllowedInstanceTypes) > 0 && !slices.Contains(placement.AllowedInstanceTypes, input.Type) {
+		conflicts = append(conflicts, fmt.Sprintf("instance type %q is not allowed", input.Type))
+	}
+	if placement.Tenancy != "" {
+		if input.Tenancy != "" && input.Tenancy != placement.Tenancy {
+			conflicts = append(conflicts, fmt.Sprintf("tenancy %q is not %q", input.Tenancy, placement.Tenancy))
+		}
+		input.Tenancy = placement.Tenancy
+	}
+	if placement.PlacementGroupName != "" {
+		if input.PlacementGroupName != "" && input.PlacementGroupName != placement.PlacementGroupName {
+			conflicts = append(conflicts, fmt.Sprintf("placement group %q is not %q", input.PlacementGroupName, placement.PlacementGroupName))
+		}
+		input.PlacementGroupName = placement.PlacementGroupName
+	}
+	// The subnet may come from the subnet filters of the AWSMachine, which are not restricted to the dedicated subnets.
+	if len(placement.SubnetIDs) > 0 && !slices.Contains(placement.SubnetIDs, input.SubnetID) {
+		dedicated := s.scope.Subnets().FilterByIDs(placement.SubnetIDs)
+		if len(dedicated.FilterByIDs([]string{input.SubnetID})) == 0 {
+			conflicts = append(conflicts, fmt.Sprintf("subnet %q is not dedicated to the control plane", input.SubnetID))
+		}
+	}
+
+	if len(conflicts) > 0 {
+		errMessage := fmt.Sprintf("failed to run control plane machine %q, it conflicts with the control plane placement of the cluster: %s",
+			scope.Name(), strings.Join(conflicts, ", "))
+		record.Warnf(scope.AWSMachine, "FailedCreate", errMessage)
+		return errors.New(errMessage)
+	}
+	return nil
+}
+
 // getFilteredSubnets fetches subnets filtered based on the criteria passed.
 func (s *Service) getFilteredSubnets(criteria ...*ec2.Filter) ([]*ec2.Subnet, error) {
 	out, err := s.EC2Client.DescribeSubnetsWithContext(context.TODO(), &ec2.DescribeSubnetsInput{Filters: criteria})
@@ -441,7 +634,7 @@ func (s *Service) getFilteredSubnets(criteria ...*ec2.Filter) ([]*ec2.Subnet, er
 func (s *Service) GetCoreSecurityGroups(scope *scope.MachineScope) ([]string, error) {
 	if scope.IsExternallyManaged() {
 		ids := make([]string, 0)
-		for _, sg := range scope.AWSMachine.Spec.AdditionalSecurityGroups {
+		for _, sg := range scope.AdditionalSecurityGroups() {
 			if sg.ID == nil {
 				continue
 			}
@@ -588,6 +781,12 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		input.NetworkInterfaces[0].InterfaceType = aws.String(string(i.NetworkInterfaceType))
 	}
 
+	// The first IPv6 address of instances of IPv6 enabled subnets is made their primary IPv6 address, which is
+	// required to register them with the IPv6 target groups of the load balancers.
+	if subnet := s.scope.Subnets().FindByID(i.SubnetID); len(i.NetworkInterfaces) == 0 && subnet != nil && (subnet.IsIPv6 || subnet.IPv6Only) {
+		input.EnablePrimaryIpv6 = aws.Bool(true)
+	}
+
 	if i.IAMProfile != "" {
 		input.IamInstanceProfile = &ec2.IamInstanceProfileSpecification{
 			Name: aws.String(i.IAMProfile),
@@ -653,6 +852,7 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	input.MetadataOptions = getInstanceMetadataOptionsRequest(i.InstanceMetadataOptions)
 	input.PrivateDnsNameOptions = getPrivateDNSNameOptionsRequest(i.PrivateDNSName)
 	input.CapacityReservationSpecification = getCapacityReservationSpecification(i.CapacityReservationID)
+	input.CpuOptions = getCPUOptionsRequest(i.CPUOptions)
 
 	if i.Tenancy != "" {
 		input.Placement = &ec2.Placement{
@@ -726,6 +926,9 @@ func (s *Service) GetInstanceSecurityGroups(instanceID string) (map[string][]str
 
 	out := make(map[string][]string)
 	for _, eni := range enis {
+		if isCNIManagedNetworkInterface(eni) {
+			continue
+		}
 		var groups []string
 		for _, group := range eni.Groups {
 			groups = append(groups, aws.StringValue(group.GroupId))
@@ -748,6 +951,10 @@ func (s *Service) UpdateInstanceSecurityGroups(instanceID string, ids []string)
 	s.scope.Debug("Found ENIs on instance", "number-of-enis", len(enis), "instance-id", instanceID)
 
 	for _, eni := range enis {
+		if isCNIManagedNetworkInterface(eni) {
+			s.scope.Debug("Skipping network interface managed by the VPC CNI", "interface", aws.StringValue(eni.NetworkInterfaceId))
+			continue
+		}
 		if err := s.attachSecurityGroupsToNetworkInterface(ids, aws.StringValue(eni.NetworkInterfaceId)); err != nil {
 			return errors.Wrapf(err, "failed to modify network interfaces on instance %q", instanceID)
 		}
@@ -756,6 +963,56 @@ func (s *Service) UpdateInstanceSecurityGroups(instanceID string, ids []string)
 	return nil
 }
 
+// ReconcileInstancesSecurityGroups swaps the security groups of the given instances, launched from the launch template
+// of the scope, for the ones of the launch template when they differ, without replacing the instances.
+func (s *Service) ReconcileInstancesSecurityGroups(scope scope.LaunchTemplateScope, instanceIDs []string) error {
+	core, err := s.GetCoreNodeSecurityGroups(scope)
+	if err != nil {
+		return err
+	}
+	additional, err := s.GetAdditionalSecurityGroupsIDs(scope.GetLaunchTemplate().AdditionalSecurityGroups)
+	if err != nil {
+		return err
+	}
+	desired := sets.New[string](core...).Insert(additional...)
+
+	for _, instanceID := range instanceIDs {
+		existing, err := s.GetInstanceSecurityGroups(instanceID)
+		if err != nil {
+			return err
+		}
+
+		changed := false
+		for _, groups := range existing {
+			if !desired.Equal(sets.New[string](groups...)) {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			continue
+		}
+
+		if err := s.UpdateInstanceSecurityGroups(instanceID, sets.List(desired)); err != nil {
+			return err
+		}
+		record.Eventf(scope.GetMachinePool(), "SuccessfulUpdateSecurityGroups", "Updated the security groups of instance %q in place", instanceID)
+	}
+
+	return nil
+}
+
+// isCNIManagedNetworkInterface returns whether a network interface was attached to its instance by the VPC CNI. The
+// VPC CNI manages the security groups of these interfaces itself, so they are left untouched.
+func isCNIManagedNetworkInterface(eni *ec2.NetworkInterface) bool {
+	for _, tag := range eni.TagSet {
+		if aws.StringValue(tag.Key) == vpcCNINodeInstanceIDTagKey {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdateResourceTags updates the tags for an instance.
 // This will be called if there is anything to create (update) or delete.
 // We may not always have to perform each action, so we check what we're
@@ -938,6 +1195,13 @@ func (s *Service) SDKToInstance(v *ec2.Instance) (*infrav1.Instance, error) {
 		}
 	}
 
+	if v.CpuOptions != nil && v.CpuOptions.CoreCount != nil && v.CpuOptions.ThreadsPerCore != nil {
+		i.CPUOptions = &infrav1.CPUOptions{
+			CoreCount:      aws.Int64Value(v.CpuOptions.CoreCount),
+			ThreadsPerCore: aws.Int64Value(v.CpuOptions.ThreadsPerCore),
+		}
+	}
+
 	return i, nil
 }
 
@@ -971,6 +1235,17 @@ func (s *Service) getInstanceAddresses(instance *ec2.Instance) []clusterv1.Machi
 			addresses = append(addresses, privateIPAddress)
 		}
 
+		// Network interfaces of dual-stack subnets also have IPv6 addresses, and the ones of IPv6-only subnets only
+		// have IPv6 addresses.
+		for _, ipv6 := range eni.Ipv6Addresses {
+			if addr := aws.StringValue(ipv6.Ipv6Address); addr != "" {
+				addresses = append(addresses, clusterv1.MachineAddress{
+					Type:    clusterv1.MachineInternalIP,
+					Address: addr,
+				})
+			}
+		}
+
 		// An elastic IP is attached if association is non nil pointer
 		if eni.Association != nil {
 			if addr := aws.StringValue(eni.Association.PublicDnsName); addr != "" {
@@ -1064,6 +1339,11 @@ func (s *Service) checkRootVolume(rootVolume *infrav1.Volume, imageID string) (*
 		return nil, errors.Wrapf(err, "failed to get root volume from image %q", imageID)
 	}
 
+	// A root volume which does not set its size, such as one only encrypted as inherited from the cluster,
+	// keeps the size of the root volume of the image.
+	if rootVolume.Size == 0 {
+		rootVolume.Size = *snapshotSize
+	}
 	if rootVolume.Size < *snapshotSize {
 		return nil, errors.Errorf("root volume size (%d) must be greater than or equal to snapshot size (%d)", rootVolume.Size, *snapshotSize)
 	}
@@ -1243,6 +1523,17 @@ func getInstanceMetadataOptionsRequest(metadataOptions *infrav1.InstanceMetadata
 	return request
 }
 
+func getCPUOptionsRequest(cpuOptions *infrav1.CPUOptions) *ec2.CpuOptionsRequest {
+	if cpuOptions == nil {
+		return nil
+	}
+
+	return &ec2.CpuOptionsRequest{
+		CoreCount:      aws.Int64(cpuOptions.CoreCount),
+		ThreadsPerCore: aws.Int64(cpuOptions.ThreadsPerCore),
+	}
+}
+
 func getPrivateDNSNameOptionsRequest(privateDNSName *infrav1.PrivateDNSName) *ec2.PrivateDnsNameOptionsRequest {
 	if privateDNSName == nil {
 		return nil
@@ -149,6 +149,60 @@ func TestInstanceIfExists(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:       "dual-stack instance exists",
+			instanceID: "id-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstancesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstancesInput{
+					InstanceIds: []*string{aws.String("id-1")},
+				})).
+					Return(&ec2.DescribeInstancesOutput{
+						Reservations: []*ec2.Reservation{
+							{
+								Instances: []*ec2.Instance{
+									{
+										InstanceId:   aws.String("id-1"),
+										InstanceType: aws.String("m5.large"),
+										SubnetId:     aws.String("subnet-1"),
+										ImageId:      aws.String("ami-1"),
+										State: &ec2.InstanceState{
+											Code: aws.Int64(16),
+											Name: aws.String(ec2.StateAvailable),
+										},
+										NetworkInterfaces: []*ec2.InstanceNetworkInterface{
+											{
+												PrivateIpAddress: aws.String("10.0.1.10"),
+												Ipv6Addresses: []*ec2.InstanceIpv6Address{
+													{
+														Ipv6Address:   aws.String("2001:db8:1234:1a01::10"),
+														IsPrimaryIpv6: aws.Bool(true),
+													},
+												},
+											},
+										},
+										Placement: &ec2.Placement{
+											AvailabilityZone: aws.String("test-zone-1a"),
+										},
+									},
+								},
+							},
+						},
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+
+				expectedAddresses := []clusterv1.MachineAddress{
+					{Type: clusterv1.MachineInternalIP, Address: "10.0.1.10"},
+					{Type: clusterv1.MachineInternalIP, Address: "2001:db8:1234:1a01::10"},
+				}
+				if !cmp.Equal(instance.Addresses, expectedAddresses) {
+					t.Fatalf("expected addresses %v but got: %v", expectedAddresses, instance.Addresses)
+				}
+			},
+		},
 		{
 			name:       "error describing instances",
 			instanceID: "one",
@@ -274,6 +328,213 @@ func TestTerminateInstance(t *testing.T) {
 	}
 }
 
+func TestUpdateInstanceSecurityGroups(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	describeInput := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("attachment.instance-id"),
+				Values: []*string{aws.String("i-1")},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		expect func(m *mocks.MockEC2APIMockRecorder)
+		check  func(err error)
+	}{
+		{
+			name: "updates the security groups of every network interface",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{
+							{NetworkInterfaceId: aws.String("eni-1")},
+							{NetworkInterfaceId: aws.String("eni-2")},
+						},
+					}, nil)
+				m.ModifyNetworkInterfaceAttributeWithContext(context.TODO(), gomock.Eq(&ec2.ModifyNetworkInterfaceAttributeInput{
+					NetworkInterfaceId: aws.String("eni-1"),
+					Groups:             aws.StringSlice([]string{"sg-1", "sg-2"}),
+				})).Return(&ec2.ModifyNetworkInterfaceAttributeOutput{}, nil)
+				m.ModifyNetworkInterfaceAttributeWithContext(context.TODO(), gomock.Eq(&ec2.ModifyNetworkInterfaceAttributeInput{
+					NetworkInterfaceId: aws.String("eni-2"),
+					Groups:             aws.StringSlice([]string{"sg-1", "sg-2"}),
+				})).Return(&ec2.ModifyNetworkInterfaceAttributeOutput{}, nil)
+			},
+			check: func(err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "leaves the network interfaces managed by the VPC CNI untouched",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{
+							{NetworkInterfaceId: aws.String("eni-1")},
+							{
+								NetworkInterfaceId: aws.String("eni-2"),
+								TagSet: []*ec2.Tag{
+									{Key: aws.String("node.k8s.amazonaws.com/instance_id"), Value: aws.String("i-1")},
+								},
+							},
+						},
+					}, nil)
+				m.ModifyNetworkInterfaceAttributeWithContext(context.TODO(), gomock.Eq(&ec2.ModifyNetworkInterfaceAttributeInput{
+					NetworkInterfaceId: aws.String("eni-1"),
+					Groups:             aws.StringSlice([]string{"sg-1", "sg-2"}),
+				})).Return(&ec2.ModifyNetworkInterfaceAttributeOutput{}, nil)
+			},
+			check: func(err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "returns an error when a network interface cannot be modified",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{
+							{NetworkInterfaceId: aws.String("eni-1")},
+						},
+					}, nil)
+				m.ModifyNetworkInterfaceAttributeWithContext(context.TODO(), gomock.Any()).
+					Return(nil, errors.New("too many security groups"))
+			},
+			check: func(err error) {
+				if err == nil {
+					t.Fatalf("expected error but got none")
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.UpdateInstanceSecurityGroups("i-1", []string{"sg-1", "sg-2"})
+			tc.check(err)
+		})
+	}
+}
+
+func TestGetInstanceMarketTypes(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name        string
+		instanceIDs []string
+		expect      func(m *mocks.MockEC2APIMockRecorder)
+		want        map[string]infrav1.MarketType
+		expectErr   bool
+	}{
+		{
+			name:        "no instances",
+			instanceIDs: nil,
+			expect:      func(m *mocks.MockEC2APIMockRecorder) {},
+			want:        map[string]infrav1.MarketType{},
+		},
+		{
+			name:        "on-demand, spot and capacity block instances",
+			instanceIDs: []string{"i-ondemand", "i-spot", "i-capacityblock", "i-terminated"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstancesPagesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstancesInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("instance-id"),
+							Values: aws.StringSlice([]string{"i-ondemand", "i-spot", "i-capacityblock", "i-terminated"}),
+						},
+					},
+				}), gomock.Any()).DoAndReturn(func(_ context.Context, _ *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool, _ ...request.Option) error {
+					fn(&ec2.DescribeInstancesOutput{
+						Reservations: []*ec2.Reservation{
+							{
+								Instances: []*ec2.Instance{
+									{InstanceId: aws.String("i-ondemand")},
+									{InstanceId: aws.String("i-spot"), InstanceLifecycle: aws.String(ec2.InstanceLifecycleTypeSpot)},
+									{InstanceId: aws.String("i-capacityblock"), InstanceLifecycle: aws.String(ec2.InstanceLifecycleTypeCapacityBlock)},
+								},
+							},
+						},
+					}, true)
+					return nil
+				})
+			},
+			want: map[string]infrav1.MarketType{
+				"i-ondemand":      infrav1.MarketTypeOnDemand,
+				"i-spot":          infrav1.MarketTypeSpot,
+				"i-capacityblock": infrav1.MarketTypeCapacityBlock,
+			},
+		},
+		{
+			name:        "describe instances fails",
+			instanceIDs: []string{"i-1"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstancesPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+					Return(awserrors.NewFailedDependency("dependency failure"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			marketTypes, err := s.GetInstanceMarketTypes(tc.instanceIDs)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(marketTypes).To(Equal(tc.want))
+		})
+	}
+}
+
 func TestCreateInstance(t *testing.T) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1782,7 +2043,7 @@ func TestCreateInstance(t *testing.T) {
 			},
 		},
 		{
-			name: "with subnet ID that does not exist",
+			name: "with subnet ID of an IPv6 enabled subnet",
 			machine: &clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{"set": "node"},
@@ -1799,7 +2060,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 				InstanceType: "m5.large",
 				Subnet: &infrav1.AWSResourceReference{
-					ID: aws.String("non-matching-subnet"),
+					ID: aws.String("matching-subnet"),
 				},
 			},
 			awsCluster: &infrav1.AWSCluster{
@@ -1810,7 +2071,9 @@ func TestCreateInstance(t *testing.T) {
 							ID: "vpc-id",
 						},
 						Subnets: infrav1.Subnets{{
-							ID: "subnet-1",
+							ID:            "matching-subnet",
+							IsIPv6:        true,
+							IPv6CidrBlock: "2001:db8:1234:1a01::/64",
 						}},
 					},
 				},
@@ -1834,6 +2097,19 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeSubnetsWithContext(context.TODO(), &ec2.DescribeSubnetsInput{
+						Filters: []*ec2.Filter{
+							filter.EC2.SubnetStates(ec2.SubnetStatePending, ec2.SubnetStateAvailable),
+							{Name: aws.String("subnet-id"), Values: aws.StringSlice([]string{"matching-subnet"})},
+						},
+					}).
+					Return(&ec2.DescribeSubnetsOutput{
+						Subnets: []*ec2.Subnet{{
+							SubnetId:         aws.String("matching-subnet"),
+							AvailabilityZone: aws.String("us-east-1b"),
+						}},
+					}, nil)
 				m.
 					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
 						InstanceTypes: []*string{
@@ -1852,29 +2128,55 @@ func TestCreateInstance(t *testing.T) {
 						},
 					}, nil)
 				m.
-					DescribeSubnetsWithContext(context.TODO(), &ec2.DescribeSubnetsInput{
-						Filters: []*ec2.Filter{
-							filter.EC2.SubnetStates(ec2.SubnetStatePending, ec2.SubnetStateAvailable),
-							{Name: aws.String("subnet-id"), Values: aws.StringSlice([]string{"non-matching-subnet"})},
-						},
-					}).
-					Return(&ec2.DescribeSubnetsOutput{
-						Subnets: []*ec2.Subnet{},
+					RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...request.Option) (*ec2.Reservation, error) {
+						if !aws.BoolValue(input.EnablePrimaryIpv6) {
+							t.Fatalf("expected a primary IPv6 address to be enabled: %+v", input)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									IamInstanceProfile: &ec2.IamInstanceProfile{
+										Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+									},
+									InstanceId:     aws.String("two"),
+									InstanceType:   aws.String("m5.large"),
+									SubnetId:       aws.String("matching-subnet"),
+									ImageId:        aws.String("ami-1"),
+									RootDeviceName: aws.String("device-1"),
+									BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+										{
+											DeviceName: aws.String("device-1"),
+											Ebs: &ec2.EbsInstanceBlockDevice{
+												VolumeId: aws.String("volume-1"),
+											},
+										},
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: &az,
+									},
+								},
+							},
+						}, nil
+					})
+				m.
+					DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
 					}, nil)
 			},
 			check: func(instance *infrav1.Instance, err error) {
-				expectedErrMsg := "failed to run machine \"aws-test1\", no subnets available matching criteria"
-				if err == nil {
-					t.Fatalf("Expected error, but got nil")
-				}
-
-				if !strings.Contains(err.Error(), expectedErrMsg) {
-					t.Fatalf("Expected error: %s\nInstead got: %s", expectedErrMsg, err.Error())
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
 				}
 			},
 		},
 		{
-			name: "with subnet ID that does not belong to Cluster",
+			name: "with subnet ID that does not exist",
 			machine: &clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{"set": "node"},
@@ -1891,7 +2193,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 				InstanceType: "m5.large",
 				Subnet: &infrav1.AWSResourceReference{
-					ID: aws.String("matching-subnet"),
+					ID: aws.String("non-matching-subnet"),
 				},
 			},
 			awsCluster: &infrav1.AWSCluster{
@@ -1927,14 +2229,106 @@ func TestCreateInstance(t *testing.T) {
 			},
 			expect: func(m *mocks.MockEC2APIMockRecorder) {
 				m.
-					RunInstancesWithContext(context.TODO(), gomock.Any()).
-					Return(&ec2.Reservation{
-						Instances: []*ec2.Instance{
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
 							{
-								State: &ec2.InstanceState{
-									Name: aws.String(ec2.InstanceStateNamePending),
-								},
-								IamInstanceProfile: &ec2.IamInstanceProfile{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.
+					DescribeSubnetsWithContext(context.TODO(), &ec2.DescribeSubnetsInput{
+						Filters: []*ec2.Filter{
+							filter.EC2.SubnetStates(ec2.SubnetStatePending, ec2.SubnetStateAvailable),
+							{Name: aws.String("subnet-id"), Values: aws.StringSlice([]string{"non-matching-subnet"})},
+						},
+					}).
+					Return(&ec2.DescribeSubnetsOutput{
+						Subnets: []*ec2.Subnet{},
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				expectedErrMsg := "failed to run machine \"aws-test1\", no subnets available matching criteria"
+				if err == nil {
+					t.Fatalf("Expected error, but got nil")
+				}
+
+				if !strings.Contains(err.Error(), expectedErrMsg) {
+					t.Fatalf("Expected error: %s\nInstead got: %s", expectedErrMsg, err.Error())
+				}
+			},
+		},
+		{
+			name: "with subnet ID that does not belong to Cluster",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "m5.large",
+				Subnet: &infrav1.AWSResourceReference{
+					ID: aws.String("matching-subnet"),
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{
+							ID: "vpc-id",
+						},
+						Subnets: infrav1.Subnets{{
+							ID: "subnet-1",
+						}},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					RunInstancesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
 									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
 								},
 								InstanceId:     aws.String("two"),
@@ -4070,12 +4464,443 @@ func TestCreateInstance(t *testing.T) {
 			},
 		},
 		{
-			name: "with custom placement group and partition number",
+			name: "with custom placement group and partition number",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:            "m5.large",
+				PlacementGroupName:      "placement-group1",
+				PlacementGroupPartition: 2,
+				UncompressedUserData:    &isUncompressedFalse,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m. // TODO: Restore these parameters, but with the tags as well
+					RunInstancesWithContext(context.TODO(), gomock.Eq(&ec2.RunInstancesInput{
+						ImageId:      aws.String("abc"),
+						InstanceType: aws.String("m5.large"),
+						KeyName:      aws.String("default"),
+						MaxCount:     aws.Int64(1),
+						MinCount:     aws.Int64(1),
+						Placement: &ec2.Placement{
+							GroupName:       aws.String("placement-group1"),
+							PartitionNumber: aws.Int64(2),
+						},
+						NetworkInterfaces: []*ec2.InstanceNetworkInterfaceSpecification{
+							{
+								DeviceIndex: aws.Int64(0),
+								SubnetId:    aws.String("subnet-1"),
+								Groups:      aws.StringSlice([]string{"2", "3"}),
+							},
+						},
+						TagSpecifications: []*ec2.TagSpecification{
+							{
+								ResourceType: aws.String("instance"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
+							{
+								ResourceType: aws.String("volume"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
+							{
+								ResourceType: aws.String("network-interface"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("MachineName"),
+										Value: aws.String("default/machine-aws-test1"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("aws-test1"),
+									},
+									{
+										Key:   aws.String("kubernetes.io/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("node"),
+									},
+								},
+							},
+						},
+						UserData: aws.String(base64.StdEncoding.EncodeToString(userDataCompressed)),
+					})).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m5.large"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+									GroupName:        aws.String("placement-group1"),
+									PartitionNumber:  aws.Int64(2),
+								},
+							},
+						},
+					}, nil)
+				m.
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.
+					DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "expect error when placementGroupPartition is set, but placementGroupName is empty",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    map[string]string{"set": "node"},
+					Namespace: "default",
+					Name:      "machine-aws-test1",
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:            "m5.large",
+				PlacementGroupPartition: 2,
+				UncompressedUserData:    &isUncompressedFalse,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				expectedErrMsg := "placementGroupPartition is set but placementGroupName is empty"
+				if err == nil {
+					t.Fatalf("Expected error, but got nil")
+				}
+				if !strings.Contains(err.Error(), expectedErrMsg) {
+					t.Fatalf("Expected error: %s\nInstead got: `%s", expectedErrMsg, err.Error())
+				}
+			},
+		},
+		{
+			name: "placement partition failureDomain defined",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To[string]("bootstrap-data"),
+					},
+					FailureDomain: aws.String("us-east-1b-partition-2"),
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AMIReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "m5.large",
+			},
+			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							infrav1.SubnetSpec{
+								ID:               "subnet-1a",
+								AvailabilityZone: "us-east-1a",
+							},
+							infrav1.SubnetSpec{
+								ID:               "subnet-1b",
+								AvailabilityZone: "us-east-1b",
+							},
+						},
+						VPC: infrav1.VPCSpec{
+							ID: "vpc-test",
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					FailureDomains: clusterv1.FailureDomains{
+						"us-east-1b-partition-2": {
+							Attributes: map[string]string{
+								infrav1.FailureDomainAttributeZone:                    "us-east-1b",
+								infrav1.FailureDomainAttributePlacementGroupName:      "partitions",
+								infrav1.FailureDomainAttributePlacementGroupPartition: "2",
+							},
+						},
+					},
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.LoadBalancer{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+						InstanceTypes: []*string{
+							aws.String("m5.large"),
+						},
+					})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.
+					RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, input *ec2.RunInstancesInput, requestOptions ...request.Option) (*ec2.Reservation, error) {
+						if subnetID := aws.StringValue(input.NetworkInterfaces[0].SubnetId); subnetID != "subnet-1b" {
+							t.Fatalf("Expected subnet 'subnet-1b', not '%s'", subnetID)
+						}
+						if input.Placement == nil || aws.StringValue(input.Placement.GroupName) != "partitions" || aws.Int64Value(input.Placement.PartitionNumber) != 2 {
+							t.Fatalf("Expected partition 2 of placement group 'partitions', got %v", input.Placement)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									IamInstanceProfile: &ec2.IamInstanceProfile{
+										Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+									},
+									InstanceId:     aws.String("two"),
+									InstanceType:   aws.String("m5.large"),
+									SubnetId:       aws.String("subnet-1b"),
+									ImageId:        aws.String("ami-1"),
+									RootDeviceName: aws.String("device-1"),
+									BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+										{
+											DeviceName: aws.String("device-1"),
+											Ebs: &ec2.EbsInstanceBlockDevice{
+												VolumeId: aws.String("volume-1"),
+											},
+										},
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: aws.String("us-east-1b"),
+									},
+								},
+							},
+						}, nil
+					})
+				m.
+					DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{},
+						NextToken:         nil,
+					}, nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "control plane placement applied to a control plane machine",
 			machine: &clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels:    map[string]string{"set": "node"},
-					Namespace: "default",
-					Name:      "machine-aws-test1",
+					Labels: map[string]string{"set": "node", clusterv1.MachineControlPlaneLabel: ""},
 				},
 				Spec: clusterv1.MachineSpec{
 					Bootstrap: clusterv1.Bootstrap{
@@ -4087,23 +4912,31 @@ func TestCreateInstance(t *testing.T) {
 				AMI: infrav1.AMIReference{
 					ID: aws.String("abc"),
 				},
-				InstanceType:            "m5.large",
-				PlacementGroupName:      "placement-group1",
-				PlacementGroupPartition: 2,
-				UncompressedUserData:    &isUncompressedFalse,
+				InstanceType: "m5.large",
 			},
 			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
 				Spec: infrav1.AWSClusterSpec{
 					NetworkSpec: infrav1.NetworkSpec{
 						Subnets: infrav1.Subnets{
 							infrav1.SubnetSpec{
-								ID:       "subnet-1",
-								IsPublic: false,
+								ID:               "subnet-1a",
+								AvailabilityZone: "us-east-1a",
 							},
 							infrav1.SubnetSpec{
-								IsPublic: false,
+								ID:               "subnet-1b",
+								AvailabilityZone: "us-east-1b",
 							},
 						},
+						VPC: infrav1.VPCSpec{
+							ID: "vpc-test",
+						},
+					},
+					ControlPlanePlacement: &infrav1.ControlPlanePlacement{
+						SubnetIDs:            []string{"subnet-1b"},
+						Tenancy:              "dedicated",
+						PlacementGroupName:   "control-plane",
+						AllowedInstanceTypes: []string{"m5.large", "m5.xlarge"},
 					},
 				},
 				Status: infrav1.AWSClusterStatus{
@@ -4126,133 +4959,6 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mocks.MockEC2APIMockRecorder) {
-				m. // TODO: Restore these parameters, but with the tags as well
-					RunInstancesWithContext(context.TODO(), gomock.Eq(&ec2.RunInstancesInput{
-						ImageId:      aws.String("abc"),
-						InstanceType: aws.String("m5.large"),
-						KeyName:      aws.String("default"),
-						MaxCount:     aws.Int64(1),
-						MinCount:     aws.Int64(1),
-						Placement: &ec2.Placement{
-							GroupName:       aws.String("placement-group1"),
-							PartitionNumber: aws.Int64(2),
-						},
-						NetworkInterfaces: []*ec2.InstanceNetworkInterfaceSpecification{
-							{
-								DeviceIndex: aws.Int64(0),
-								SubnetId:    aws.String("subnet-1"),
-								Groups:      aws.StringSlice([]string{"2", "3"}),
-							},
-						},
-						TagSpecifications: []*ec2.TagSpecification{
-							{
-								ResourceType: aws.String("instance"),
-								Tags: []*ec2.Tag{
-									{
-										Key:   aws.String("MachineName"),
-										Value: aws.String("default/machine-aws-test1"),
-									},
-									{
-										Key:   aws.String("Name"),
-										Value: aws.String("aws-test1"),
-									},
-									{
-										Key:   aws.String("kubernetes.io/cluster/test1"),
-										Value: aws.String("owned"),
-									},
-									{
-										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
-										Value: aws.String("owned"),
-									},
-									{
-										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
-										Value: aws.String("node"),
-									},
-								},
-							},
-							{
-								ResourceType: aws.String("volume"),
-								Tags: []*ec2.Tag{
-									{
-										Key:   aws.String("MachineName"),
-										Value: aws.String("default/machine-aws-test1"),
-									},
-									{
-										Key:   aws.String("Name"),
-										Value: aws.String("aws-test1"),
-									},
-									{
-										Key:   aws.String("kubernetes.io/cluster/test1"),
-										Value: aws.String("owned"),
-									},
-									{
-										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
-										Value: aws.String("owned"),
-									},
-									{
-										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
-										Value: aws.String("node"),
-									},
-								},
-							},
-							{
-								ResourceType: aws.String("network-interface"),
-								Tags: []*ec2.Tag{
-									{
-										Key:   aws.String("MachineName"),
-										Value: aws.String("default/machine-aws-test1"),
-									},
-									{
-										Key:   aws.String("Name"),
-										Value: aws.String("aws-test1"),
-									},
-									{
-										Key:   aws.String("kubernetes.io/cluster/test1"),
-										Value: aws.String("owned"),
-									},
-									{
-										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test1"),
-										Value: aws.String("owned"),
-									},
-									{
-										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
-										Value: aws.String("node"),
-									},
-								},
-							},
-						},
-						UserData: aws.String(base64.StdEncoding.EncodeToString(userDataCompressed)),
-					})).
-					Return(&ec2.Reservation{
-						Instances: []*ec2.Instance{
-							{
-								State: &ec2.InstanceState{
-									Name: aws.String(ec2.InstanceStateNamePending),
-								},
-								IamInstanceProfile: &ec2.IamInstanceProfile{
-									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
-								},
-								InstanceId:     aws.String("two"),
-								InstanceType:   aws.String("m5.large"),
-								SubnetId:       aws.String("subnet-1"),
-								ImageId:        aws.String("ami-1"),
-								RootDeviceName: aws.String("device-1"),
-								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
-									{
-										DeviceName: aws.String("device-1"),
-										Ebs: &ec2.EbsInstanceBlockDevice{
-											VolumeId: aws.String("volume-1"),
-										},
-									},
-								},
-								Placement: &ec2.Placement{
-									AvailabilityZone: &az,
-									GroupName:        aws.String("placement-group1"),
-									PartitionNumber:  aws.Int64(2),
-								},
-							},
-						},
-					}, nil)
 				m.
 					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
 						InstanceTypes: []*string{
@@ -4270,6 +4976,44 @@ func TestCreateInstance(t *testing.T) {
 							},
 						},
 					}, nil)
+				m.
+					RunInstancesWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, input *ec2.RunInstancesInput, requestOptions ...request.Option) (*ec2.Reservation, error) {
+						if subnetID := aws.StringValue(input.NetworkInterfaces[0].SubnetId); subnetID != "subnet-1b" {
+							t.Fatalf("Expected subnet 'subnet-1b', not '%s'", subnetID)
+						}
+						if input.Placement == nil || aws.StringValue(input.Placement.GroupName) != "control-plane" || aws.StringValue(input.Placement.Tenancy) != "dedicated" {
+							t.Fatalf("Expected dedicated tenancy in placement group 'control-plane', got %v", input.Placement)
+						}
+						return &ec2.Reservation{
+							Instances: []*ec2.Instance{
+								{
+									State: &ec2.InstanceState{
+										Name: aws.String(ec2.InstanceStateNamePending),
+									},
+									IamInstanceProfile: &ec2.IamInstanceProfile{
+										Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+									},
+									InstanceId:     aws.String("two"),
+									InstanceType:   aws.String("m5.large"),
+									SubnetId:       aws.String("subnet-1b"),
+									ImageId:        aws.String("ami-1"),
+									RootDeviceName: aws.String("device-1"),
+									BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+										{
+											DeviceName: aws.String("device-1"),
+											Ebs: &ec2.EbsInstanceBlockDevice{
+												VolumeId: aws.String("volume-1"),
+											},
+										},
+									},
+									Placement: &ec2.Placement{
+										AvailabilityZone: aws.String("us-east-1b"),
+									},
+								},
+							},
+						}, nil
+					})
 				m.
 					DescribeNetworkInterfacesWithContext(context.TODO(), gomock.Any()).
 					Return(&ec2.DescribeNetworkInterfacesOutput{
@@ -4284,12 +5028,10 @@ func TestCreateInstance(t *testing.T) {
 			},
 		},
 		{
-			name: "expect error when placementGroupPartition is set, but placementGroupName is empty",
+			name: "control plane machine conflicting with the control plane placement",
 			machine: &clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels:    map[string]string{"set": "node"},
-					Namespace: "default",
-					Name:      "machine-aws-test1",
+					Labels: map[string]string{"set": "node", clusterv1.MachineControlPlaneLabel: ""},
 				},
 				Spec: clusterv1.MachineSpec{
 					Bootstrap: clusterv1.Bootstrap{
@@ -4301,22 +5043,32 @@ func TestCreateInstance(t *testing.T) {
 				AMI: infrav1.AMIReference{
 					ID: aws.String("abc"),
 				},
-				InstanceType:            "m5.large",
-				PlacementGroupPartition: 2,
-				UncompressedUserData:    &isUncompressedFalse,
+				InstanceType: "c5.large",
+				Tenancy:      "default",
 			},
 			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
 				Spec: infrav1.AWSClusterSpec{
 					NetworkSpec: infrav1.NetworkSpec{
 						Subnets: infrav1.Subnets{
 							infrav1.SubnetSpec{
-								ID:       "subnet-1",
-								IsPublic: false,
+								ID:               "subnet-1a",
+								AvailabilityZone: "us-east-1a",
 							},
 							infrav1.SubnetSpec{
-								IsPublic: false,
+								ID:               "subnet-1b",
+								AvailabilityZone: "us-east-1b",
 							},
 						},
+						VPC: infrav1.VPCSpec{
+							ID: "vpc-test",
+						},
+					},
+					ControlPlanePlacement: &infrav1.ControlPlanePlacement{
+						SubnetIDs:            []string{"subnet-1b"},
+						Tenancy:              "dedicated",
+						PlacementGroupName:   "control-plane",
+						AllowedInstanceTypes: []string{"m5.large", "m5.xlarge"},
 					},
 				},
 				Status: infrav1.AWSClusterStatus{
@@ -4342,7 +5094,7 @@ func TestCreateInstance(t *testing.T) {
 				m.
 					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
 						InstanceTypes: []*string{
-							aws.String("m5.large"),
+							aws.String("c5.large"),
 						},
 					})).
 					Return(&ec2.DescribeInstanceTypesOutput{
@@ -4358,7 +5110,7 @@ func TestCreateInstance(t *testing.T) {
 					}, nil)
 			},
 			check: func(instance *infrav1.Instance, err error) {
-				expectedErrMsg := "placementGroupPartition is set but placementGroupName is empty"
+				expectedErrMsg := `instance type "c5.large" is not allowed, tenancy "default" is not "dedicated"`
 				if err == nil {
 					t.Fatalf("Expected error, but got nil")
 				}
@@ -5384,6 +6136,20 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.
+					DescribeCapacityReservationsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeCapacityReservationsInput{
+						CapacityReservationIds: []*string{aws.String("cr-12345678901234567")},
+					})).
+					Return(&ec2.DescribeCapacityReservationsOutput{
+						CapacityReservations: []*ec2.CapacityReservation{
+							{
+								CapacityReservationId: aws.String("cr-12345678901234567"),
+								InstanceType:          aws.String("m5.large"),
+								ReservationType:       aws.String(ec2.CapacityReservationTypeCapacityBlock),
+								State:                 aws.String(ec2.CapacityReservationStateActive),
+							},
+						},
+					}, nil)
 				m.
 					DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
 						InstanceTypes: []*string{
@@ -5715,6 +6481,12 @@ func TestCreateInstance(t *testing.T) {
 				t.Fatalf("Failed to create test context: %v", err)
 			}
 			machineScope.AWSMachine.Spec = *tc.machineConfig
+			// The AMI of the machine is described to check that instances can be launched from it.
+			ec2Mock.EXPECT().DescribeImagesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeImagesInput{
+				ImageIds: aws.StringSlice([]string{"abc"}),
+			})).Return(&ec2.DescribeImagesOutput{
+				Images: []*ec2.Image{{ImageId: aws.String("abc"), State: aws.String(ec2.ImageStateAvailable)}},
+			}, nil).AnyTimes()
 			tc.expect(ec2Mock.EXPECT())
 
 			s := NewService(clusterScope)
@@ -6242,3 +7014,33 @@ func TestGetCapacityReservationSpecification(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCPUOptionsRequest(t *testing.T) {
+	testCases := []struct {
+		name            string
+		cpuOptions      *infrav1.CPUOptions
+		expectedRequest *ec2.CpuOptionsRequest
+	}{
+		{
+			name:            "with no CPU options specified",
+			cpuOptions:      nil,
+			expectedRequest: nil,
+		},
+		{
+			name:       "with simultaneous multithreading disabled",
+			cpuOptions: &infrav1.CPUOptions{CoreCount: 4, ThreadsPerCore: 1},
+			expectedRequest: &ec2.CpuOptionsRequest{
+				CoreCount:      aws.Int64(4),
+				ThreadsPerCore: aws.Int64(1),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := getCPUOptionsRequest(tc.cpuOptions)
+			if !cmp.Equal(request, tc.expectedRequest) {
+				t.Errorf("Case: %s. Got: %v, expected: %v", tc.name, request, tc.expectedRequest)
+			}
+		})
+	}
+}
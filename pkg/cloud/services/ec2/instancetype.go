@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+)
+
+const (
+	// maxDescribeInstanceTypes is the maximum number of instance types that can be described at once.
+	maxDescribeInstanceTypes = 100
+
+	// nvidiaGPUResourceName is the extended resource name of the NVIDIA GPUs of a node.
+	nvidiaGPUResourceName corev1.ResourceName = "nvidia.com/gpu"
+)
+
+// selectInstanceType returns the smallest instance type satisfying the given requirements, and offered
+// in the given availability zone. Instance types are ordered by number of vCPUs, then by memory, which
+// selects the cheapest instance type in most cases.
+func (s *Service) selectInstanceType(requirements *infrav1.InstanceRequirements, availabilityZone string) (string, error) {
+	architecture := requirements.Architecture
+	if architecture == "" {
+		architecture = DefaultArchitectureTag
+	}
+
+	var candidates []*string
+	if err := s.EC2Client.GetInstanceTypesFromInstanceRequirementsPagesWithContext(context.TODO(), &ec2.GetInstanceTypesFromInstanceRequirementsInput{
+		ArchitectureTypes:    aws.StringSlice([]string{architecture}),
+		VirtualizationTypes:  aws.StringSlice([]string{ec2.VirtualizationTypeHvm}),
+		InstanceRequirements: instanceRequirementsRequest(requirements),
+	}, func(out *ec2.GetInstanceTypesFromInstanceRequirementsOutput, _ bool) bool {
+		for _, instanceType := range out.InstanceTypes {
+			candidates = append(candidates, instanceType.InstanceType)
+		}
+		return true
+	}); err != nil {
+		return "", errors.Wrap(err, "failed to get instance types from instance requirements")
+	}
+	if len(candidates) == 0 {
+		return "", awserrors.NewFailedDependency("no instance type satisfies the instance requirements")
+	}
+
+	var offered []*string
+	if err := s.EC2Client.DescribeInstanceTypeOfferingsPagesWithContext(context.TODO(), &ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+		Filters: []*ec2.Filter{
+			{Name: aws.String("location"), Values: aws.StringSlice([]string{availabilityZone})},
+			{Name: aws.String("instance-type"), Values: candidates},
+		},
+	}, func(out *ec2.DescribeInstanceTypeOfferingsOutput, _ bool) bool {
+		for _, offering := range out.InstanceTypeOfferings {
+			offered = append(offered, offering.InstanceType)
+		}
+		return true
+	}); err != nil {
+		return "", errors.Wrapf(err, "failed to describe instance type offerings in availability zone %q", availabilityZone)
+	}
+	if len(offered) == 0 {
+		return "", awserrors.NewFailedDependency("no instance type satisfying the instance requirements is offered in availability zone " + availabilityZone)
+	}
+
+	var infos []*ec2.InstanceTypeInfo
+	for start := 0; start < len(offered); start += maxDescribeInstanceTypes {
+		end := min(start+maxDescribeInstanceTypes, len(offered))
+		out, err := s.EC2Client.DescribeInstanceTypesWithContext(context.TODO(), &ec2.DescribeInstanceTypesInput{
+			InstanceTypes: offered[start:end],
+		})
+		if err != nil {
+			return "", errors.Wrap(err, "failed to describe instance types")
+		}
+		infos = append(infos, out.InstanceTypes...)
+	}
+	if len(infos) == 0 {
+		return "", errors.New("instance type result empty for the instance requirements")
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		vCPUsI, vCPUsJ := instanceTypeVCPUs(infos[i]), instanceTypeVCPUs(infos[j])
+		if vCPUsI != vCPUsJ {
+			return vCPUsI < vCPUsJ
+		}
+		memoryI, memoryJ := instanceTypeMemoryMiB(infos[i]), instanceTypeMemoryMiB(infos[j])
+		if memoryI != memoryJ {
+			return memoryI < memoryJ
+		}
+		return aws.StringValue(infos[i].InstanceType) < aws.StringValue(infos[j].InstanceType)
+	})
+
+	return aws.StringValue(infos[0].InstanceType), nil
+}
+
+// GetInstanceTypeCapacity returns the resource capacity and the node information of the given instance type,
+// which the cluster autoscaler uses to scale a node group from zero.
+func (s *Service) GetInstanceTypeCapacity(instanceType string) (corev1.ResourceList, *infrav1.NodeInfo, error) {
+	out, err := s.EC2Client.DescribeInstanceTypesWithContext(context.TODO(), &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: []*string{aws.String(instanceType)},
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to describe instance type %q", instanceType)
+	}
+	if len(out.InstanceTypes) == 0 {
+		return nil, nil, errors.Errorf("instance type result empty for type %q", instanceType)
+	}
+	info := out.InstanceTypes[0]
+
+	capacity := corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewQuantity(instanceTypeVCPUs(info), resource.DecimalSI),
+		corev1.ResourceMemory: *resource.NewQuantity(instanceTypeMemoryMiB(info)*1024*1024, resource.BinarySI),
+	}
+	var gpus int64
+	if info.GpuInfo != nil {
+		for _, gpu := range info.GpuInfo.Gpus {
+			if strings.EqualFold(aws.StringValue(gpu.Manufacturer), "NVIDIA") {
+				gpus += aws.Int64Value(gpu.Count)
+			}
+		}
+	}
+	if gpus > 0 {
+		capacity[nvidiaGPUResourceName] = *resource.NewQuantity(gpus, resource.DecimalSI)
+	}
+
+	nodeInfo := &infrav1.NodeInfo{
+		Architecture:    infrav1.ArchitectureAmd64,
+		OperatingSystem: infrav1.OperatingSystemLinux,
+	}
+	if info.ProcessorInfo != nil {
+		for _, architecture := range info.ProcessorInfo.SupportedArchitectures {
+			if aws.StringValue(architecture) == Arm64ArchitectureTag {
+				nodeInfo.Architecture = infrav1.ArchitectureArm64
+				break
+			}
+		}
+	}
+
+	return capacity, nodeInfo, nil
+}
+
+// subnetAvailabilityZone returns the availability zone of the given subnet.
+// validateIPv6OnlyInstanceType ensures that instances of the given type can be launched in IPv6-only subnets,
+// which requires instance types built on the Nitro System. The instance type is not validated if the
+// controller is not allowed to describe instance types.
+func (s *Service) validateIPv6OnlyInstanceType(instanceType string) error {
+	out, err := s.EC2Client.DescribeInstanceTypesWithContext(context.TODO(), &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice([]string{instanceType}),
+	})
+	if err != nil {
+		if awserrors.IsPermissionsError(err) {
+			s.scope.Debug("Not allowed to describe instance types, skipping IPv6-only validation", "instance-type", instanceType)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to describe instance type %q", instanceType)
+	}
+	if len(out.InstanceTypes) == 0 {
+		return errors.Errorf("instance type result empty for type %q", instanceType)
+	}
+
+	info := out.InstanceTypes[0]
+	if aws.StringValue(info.Hypervisor) != ec2.InstanceTypeHypervisorNitro {
+		return errors.Errorf("instance type %q is not built on the Nitro System", instanceType)
+	}
+	if info.NetworkInfo == nil || !aws.BoolValue(info.NetworkInfo.Ipv6Supported) {
+		return errors.Errorf("instance type %q does not support IPv6", instanceType)
+	}
+	return nil
+}
+
+func (s *Service) subnetAvailabilityZone(subnetID string) (string, error) {
+	if subnet := s.scope.Subnets().FindByID(subnetID); subnet != nil && subnet.AvailabilityZone != "" {
+		return subnet.AvailabilityZone, nil
+	}
+
+	subnets, err := s.getFilteredSubnets(&ec2.Filter{Name: aws.String("subnet-id"), Values: aws.StringSlice([]string{subnetID})})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe subnet %q", subnetID)
+	}
+	if len(subnets) == 0 {
+		return "", errors.Errorf("subnet %q not found", subnetID)
+	}
+	return aws.StringValue(subnets[0].AvailabilityZone), nil
+}
+
+func instanceRequirementsRequest(requirements *infrav1.InstanceRequirements) *ec2.InstanceRequirementsRequest {
+	request := &ec2.InstanceRequirementsRequest{
+		VCpuCount: &ec2.VCpuCountRangeRequest{
+			Min: aws.Int64(int64(requirements.VCPUCount.Min)),
+			Max: int64Ptr(requirements.VCPUCount.Max),
+		},
+		MemoryMiB: &ec2.MemoryMiBRequest{
+			Min: aws.Int64(int64(requirements.MemoryMiB.Min)),
+			Max: int64Ptr(requirements.MemoryMiB.Max),
+		},
+	}
+	if requirements.AcceleratorCount != nil {
+		request.AcceleratorCount = &ec2.AcceleratorCountRequest{
+			Min: aws.Int64(int64(requirements.AcceleratorCount.Min)),
+			Max: int64Ptr(requirements.AcceleratorCount.Max),
+		}
+	}
+	for _, acceleratorType := range requirements.AcceleratorTypes {
+		request.AcceleratorTypes = append(request.AcceleratorTypes, aws.String(string(acceleratorType)))
+	}
+	if len(requirements.AllowedInstanceTypes) > 0 {
+		request.AllowedInstanceTypes = aws.StringSlice(requirements.AllowedInstanceTypes)
+	}
+	if len(requirements.ExcludedInstanceTypes) > 0 {
+		request.ExcludedInstanceTypes = aws.StringSlice(requirements.ExcludedInstanceTypes)
+	}
+	return request
+}
+
+func int64Ptr(v *int32) *int64 {
+	if v == nil {
+		return nil
+	}
+	return aws.Int64(int64(*v))
+}
+
+func instanceTypeVCPUs(info *ec2.InstanceTypeInfo) int64 {
+	if info.VCpuInfo == nil {
+		return 0
+	}
+	return aws.Int64Value(info.VCpuInfo.DefaultVCpus)
+}
+
+func instanceTypeMemoryMiB(info *ec2.InstanceTypeInfo) int64 {
+	if info.MemoryInfo == nil {
+		return 0
+	}
+	return aws.Int64Value(info.MemoryInfo.SizeInMiB)
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestSelectInstanceType(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	requirements := &infrav1.InstanceRequirements{
+		VCPUCount: infrav1.ResourceRange{Min: 2, Max: ptr.To[int32](4)},
+		MemoryMiB: infrav1.ResourceRange{Min: 4096},
+	}
+
+	getInstanceTypes := func(m *mocks.MockEC2APIMockRecorder, instanceTypes ...string) {
+		m.GetInstanceTypesFromInstanceRequirementsPagesWithContext(context.TODO(), gomock.Eq(&ec2.GetInstanceTypesFromInstanceRequirementsInput{
+			ArchitectureTypes:   aws.StringSlice([]string{"x86_64"}),
+			VirtualizationTypes: aws.StringSlice([]string{"hvm"}),
+			InstanceRequirements: &ec2.InstanceRequirementsRequest{
+				VCpuCount: &ec2.VCpuCountRangeRequest{Min: aws.Int64(2), Max: aws.Int64(4)},
+				MemoryMiB: &ec2.MemoryMiBRequest{Min: aws.Int64(4096)},
+			},
+		}), gomock.Any()).DoAndReturn(func(_ context.Context, _ *ec2.GetInstanceTypesFromInstanceRequirementsInput, fn func(*ec2.GetInstanceTypesFromInstanceRequirementsOutput, bool) bool, _ ...interface{}) error {
+			out := &ec2.GetInstanceTypesFromInstanceRequirementsOutput{}
+			for _, instanceType := range instanceTypes {
+				out.InstanceTypes = append(out.InstanceTypes, &ec2.InstanceTypeInfoFromInstanceRequirements{InstanceType: aws.String(instanceType)})
+			}
+			fn(out, true)
+			return nil
+		})
+	}
+	describeOfferings := func(m *mocks.MockEC2APIMockRecorder, instanceTypes ...string) {
+		m.DescribeInstanceTypeOfferingsPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, input *ec2.DescribeInstanceTypeOfferingsInput, fn func(*ec2.DescribeInstanceTypeOfferingsOutput, bool) bool, _ ...interface{}) error {
+				if aws.StringValue(input.Filters[0].Values[0]) != "us-east-1a" {
+					t.Fatalf("expected the offerings of availability zone us-east-1a, got %v", input.Filters)
+				}
+				out := &ec2.DescribeInstanceTypeOfferingsOutput{}
+				for _, instanceType := range instanceTypes {
+					out.InstanceTypeOfferings = append(out.InstanceTypeOfferings, &ec2.InstanceTypeOffering{InstanceType: aws.String(instanceType)})
+				}
+				fn(out, true)
+				return nil
+			})
+	}
+	instanceTypeInfo := func(instanceType string, vCPUs, memoryMiB int64) *ec2.InstanceTypeInfo {
+		return &ec2.InstanceTypeInfo{
+			InstanceType: aws.String(instanceType),
+			VCpuInfo:     &ec2.VCpuInfo{DefaultVCpus: aws.Int64(vCPUs)},
+			MemoryInfo:   &ec2.MemoryInfo{SizeInMiB: aws.Int64(memoryMiB)},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		expect    func(m *mocks.MockEC2APIMockRecorder)
+		want      string
+		expectErr bool
+	}{
+		{
+			name: "should select the smallest instance type offered in the availability zone",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				getInstanceTypes(m, "m5.xlarge", "c5.large", "m5.large", "t3.medium")
+				describeOfferings(m, "m5.xlarge", "m5.large", "c5.large")
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: aws.StringSlice([]string{"m5.xlarge", "m5.large", "c5.large"}),
+				})).Return(&ec2.DescribeInstanceTypesOutput{
+					InstanceTypes: []*ec2.InstanceTypeInfo{
+						instanceTypeInfo("m5.xlarge", 4, 16384),
+						instanceTypeInfo("m5.large", 2, 8192),
+						instanceTypeInfo("c5.large", 2, 4096),
+					},
+				}, nil)
+			},
+			want: "c5.large",
+		},
+		{
+			name: "should fail when no instance type satisfies the requirements",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				getInstanceTypes(m)
+			},
+			expectErr: true,
+		},
+		{
+			name: "should fail when no matching instance type is offered in the availability zone",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				getInstanceTypes(m, "m5.large")
+				describeOfferings(m)
+			},
+			expectErr: true,
+		},
+		{
+			name: "should fail when the instance types cannot be listed",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.GetInstanceTypesFromInstanceRequirementsPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+					Return(awserrors.NewFailedDependency("dependency failure"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			instanceType, err := s.selectInstanceType(requirements, "us-east-1a")
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(instanceType).To(Equal(tc.want))
+		})
+	}
+}
+
+func TestGetInstanceTypeCapacity(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	describeInstanceType := func(m *mocks.MockEC2APIMockRecorder, info *ec2.InstanceTypeInfo) {
+		m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+			InstanceTypes: aws.StringSlice([]string{aws.StringValue(info.InstanceType)}),
+		})).Return(&ec2.DescribeInstanceTypesOutput{InstanceTypes: []*ec2.InstanceTypeInfo{info}}, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		instanceType string
+		expect       func(m *mocks.MockEC2APIMockRecorder)
+		wantCapacity corev1.ResourceList
+		wantNodeInfo *infrav1.NodeInfo
+		expectErr    bool
+	}{
+		{
+			name:         "should return the capacity of an amd64 instance type",
+			instanceType: "m5.large",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeInstanceType(m, &ec2.InstanceTypeInfo{
+					InstanceType:  aws.String("m5.large"),
+					VCpuInfo:      &ec2.VCpuInfo{DefaultVCpus: aws.Int64(2)},
+					MemoryInfo:    &ec2.MemoryInfo{SizeInMiB: aws.Int64(8192)},
+					ProcessorInfo: &ec2.ProcessorInfo{SupportedArchitectures: aws.StringSlice([]string{"i386", "x86_64"})},
+				})
+			},
+			wantCapacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+			wantNodeInfo: &infrav1.NodeInfo{Architecture: infrav1.ArchitectureAmd64, OperatingSystem: infrav1.OperatingSystemLinux},
+		},
+		{
+			name:         "should return the capacity of an arm64 instance type",
+			instanceType: "m6g.xlarge",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeInstanceType(m, &ec2.InstanceTypeInfo{
+					InstanceType:  aws.String("m6g.xlarge"),
+					VCpuInfo:      &ec2.VCpuInfo{DefaultVCpus: aws.Int64(4)},
+					MemoryInfo:    &ec2.MemoryInfo{SizeInMiB: aws.Int64(16384)},
+					ProcessorInfo: &ec2.ProcessorInfo{SupportedArchitectures: aws.StringSlice([]string{"arm64"})},
+				})
+			},
+			wantCapacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+			},
+			wantNodeInfo: &infrav1.NodeInfo{Architecture: infrav1.ArchitectureArm64, OperatingSystem: infrav1.OperatingSystemLinux},
+		},
+		{
+			name:         "should count the NVIDIA GPUs of the instance type",
+			instanceType: "p3.8xlarge",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeInstanceType(m, &ec2.InstanceTypeInfo{
+					InstanceType:  aws.String("p3.8xlarge"),
+					VCpuInfo:      &ec2.VCpuInfo{DefaultVCpus: aws.Int64(32)},
+					MemoryInfo:    &ec2.MemoryInfo{SizeInMiB: aws.Int64(249856)},
+					ProcessorInfo: &ec2.ProcessorInfo{SupportedArchitectures: aws.StringSlice([]string{"x86_64"})},
+					GpuInfo: &ec2.GpuInfo{Gpus: []*ec2.GpuDeviceInfo{
+						{Manufacturer: aws.String("NVIDIA"), Count: aws.Int64(4)},
+					}},
+				})
+			},
+			wantCapacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("32"),
+				corev1.ResourceMemory: resource.MustParse("244Gi"),
+				nvidiaGPUResourceName: resource.MustParse("4"),
+			},
+			wantNodeInfo: &infrav1.NodeInfo{Architecture: infrav1.ArchitectureAmd64, OperatingSystem: infrav1.OperatingSystemLinux},
+		},
+		{
+			name:         "should fail when the instance type is unknown",
+			instanceType: "m5.unknown",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeInstanceTypesOutput{}, nil)
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			capacity, nodeInfo, err := s.GetInstanceTypeCapacity(tc.instanceType)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(capacity).To(HaveLen(len(tc.wantCapacity)))
+			for name, quantity := range tc.wantCapacity {
+				actual := capacity[name]
+				g.Expect(actual.Cmp(quantity)).To(BeZero(), "resource %s", name)
+			}
+			g.Expect(nodeInfo).To(Equal(tc.wantNodeInfo))
+		})
+	}
+}
+
+func TestValidateIPv6OnlyInstanceType(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name      string
+		info      *ec2.InstanceTypeInfo
+		expectErr bool
+	}{
+		{
+			name: "should accept a nitro instance type supporting IPv6",
+			info: &ec2.InstanceTypeInfo{
+				InstanceType: aws.String("m5.large"),
+				Hypervisor:   aws.String(ec2.InstanceTypeHypervisorNitro),
+				NetworkInfo:  &ec2.NetworkInfo{Ipv6Supported: aws.Bool(true)},
+			},
+		},
+		{
+			name: "should reject a xen instance type",
+			info: &ec2.InstanceTypeInfo{
+				InstanceType: aws.String("m4.large"),
+				Hypervisor:   aws.String(ec2.InstanceTypeHypervisorXen),
+				NetworkInfo:  &ec2.NetworkInfo{Ipv6Supported: aws.Bool(true)},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should reject an instance type not supporting IPv6",
+			info: &ec2.InstanceTypeInfo{
+				InstanceType: aws.String("t3.nano"),
+				Hypervisor:   aws.String(ec2.InstanceTypeHypervisorNitro),
+				NetworkInfo:  &ec2.NetworkInfo{Ipv6Supported: aws.Bool(false)},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			ec2Mock.EXPECT().DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+				InstanceTypes: []*string{tc.info.InstanceType},
+			})).Return(&ec2.DescribeInstanceTypesOutput{InstanceTypes: []*ec2.InstanceTypeInfo{tc.info}}, nil)
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			err = s.validateIPv6OnlyInstanceType(aws.StringValue(tc.info.InstanceType))
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
@@ -58,7 +58,8 @@ const (
 )
 
 // ReconcileLaunchTemplate reconciles a launch template and triggers instance refresh conditionally, depending on
-// changes.
+// changes. When only the security groups of the launch template changed and runSecurityGroupsUpdateOperation is not
+// nil, it is run instead of runPostLaunchTemplateUpdateOperation to swap them on the running instances.
 //
 //nolint:gocyclo,maintidx
 func (s *Service) ReconcileLaunchTemplate(
@@ -70,6 +71,7 @@ func (s *Service) ReconcileLaunchTemplate(
 	objectStoreSvc services.ObjectStoreInterface,
 	canUpdateLaunchTemplate func() (bool, error),
 	runPostLaunchTemplateUpdateOperation func() error,
+	runSecurityGroupsUpdateOperation func() error,
 ) error {
 	bootstrapData, bootstrapDataFormat, bootstrapDataSecretKey, err := scope.GetRawBootstrapData()
 	if err != nil {
@@ -238,7 +240,11 @@ func (s *Service) ReconcileLaunchTemplate(
 	userDataSecretKeyChanged := launchTemplateUserDataSecretKey != nil && bootstrapDataSecretKey.String() != launchTemplateUserDataSecretKey.String()
 	launchTemplateNeedsUserDataSecretKeyTag := launchTemplateUserDataSecretKey == nil
 
-	if needsUpdate || tagsChanged || amiChanged || userDataSecretKeyChanged {
+	// Security groups are swapped on the running instances, without replacing them.
+	securityGroupsOnlyChanged := needsUpdate && !tagsChanged && !amiChanged && !userDataSecretKeyChanged &&
+		runSecurityGroupsUpdateOperation != nil && !launchTemplateSettingsChanged(scope.GetLaunchTemplate(), launchTemplate)
+
+	if (needsUpdate || tagsChanged || amiChanged || userDataSecretKeyChanged) && !securityGroupsOnlyChanged {
 		canUpdate, err := canUpdateLaunchTemplate()
 		if err != nil {
 			return err
@@ -298,7 +304,13 @@ func (s *Service) ReconcileLaunchTemplate(
 		}
 	}
 
-	if needsUpdate || tagsChanged || amiChanged || userDataSecretKeyChanged {
+	if securityGroupsOnlyChanged {
+		if err := runSecurityGroupsUpdateOperation(); err != nil {
+			conditions.MarkFalse(scope.GetSetter(), expinfrav1.PostLaunchTemplateUpdateOperationCondition, expinfrav1.PostLaunchTemplateUpdateOperationFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return err
+		}
+		conditions.MarkTrue(scope.GetSetter(), expinfrav1.PostLaunchTemplateUpdateOperationCondition)
+	} else if needsUpdate || tagsChanged || amiChanged || userDataSecretKeyChanged {
 		if err := runPostLaunchTemplateUpdateOperation(); err != nil {
 			conditions.MarkFalse(scope.GetSetter(), expinfrav1.PostLaunchTemplateUpdateOperationCondition, expinfrav1.PostLaunchTemplateUpdateOperationFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
 			return err
@@ -649,6 +661,7 @@ func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imag
 	}
 	data.InstanceMarketOptions = instanceMarketOptions
 	data.PrivateDnsNameOptions = getLaunchTemplatePrivateDNSNameOptionsRequest(scope.GetLaunchTemplate().PrivateDNSName)
+	data.CpuOptions = getLaunchTemplateCPUOptionsRequest(scope.GetLaunchTemplate().CPUOptions)
 
 	blockDeviceMappings := []*ec2.LaunchTemplateBlockDeviceMappingRequest{}
 
@@ -871,6 +884,13 @@ func (s *Service) SDKToLaunchTemplate(d *ec2.LaunchTemplateVersion) (*expinfrav1
 		}
 	}
 
+	if v.CpuOptions != nil && v.CpuOptions.CoreCount != nil && v.CpuOptions.ThreadsPerCore != nil {
+		i.CPUOptions = &infrav1.CPUOptions{
+			CoreCount:      aws.Int64Value(v.CpuOptions.CoreCount),
+			ThreadsPerCore: aws.Int64Value(v.CpuOptions.ThreadsPerCore),
+		}
+	}
+
 	if v.IamInstanceProfile != nil {
 		i.IamInstanceProfile = aws.StringValue(v.IamInstanceProfile.Name)
 	}
@@ -927,31 +947,7 @@ func (s *Service) SDKToLaunchTemplate(d *ec2.LaunchTemplateVersion) (*expinfrav1
 // FIXME(dlipovetsky): This check should account for changed userdata, but does not yet do so.
 // Although userdata is stored in an EC2 Launch Template, it is not a field of AWSLaunchTemplate.
 func (s *Service) LaunchTemplateNeedsUpdate(scope scope.LaunchTemplateScope, incoming *expinfrav1.AWSLaunchTemplate, existing *expinfrav1.AWSLaunchTemplate) (bool, error) {
-	if incoming.IamInstanceProfile != existing.IamInstanceProfile {
-		return true, nil
-	}
-
-	if incoming.InstanceType != existing.InstanceType {
-		return true, nil
-	}
-
-	if !cmp.Equal(incoming.InstanceMetadataOptions, existing.InstanceMetadataOptions) {
-		return true, nil
-	}
-
-	if !cmp.Equal(incoming.SpotMarketOptions, existing.SpotMarketOptions) {
-		return true, nil
-	}
-
-	if !cmp.Equal(incoming.CapacityReservationID, existing.CapacityReservationID) {
-		return true, nil
-	}
-
-	if !cmp.Equal(incoming.PrivateDNSName, existing.PrivateDNSName) {
-		return true, nil
-	}
-
-	if !cmp.Equal(incoming.SSHKeyName, existing.SSHKeyName) {
+	if launchTemplateSettingsChanged(incoming, existing) {
 		return true, nil
 	}
 
@@ -980,6 +976,24 @@ func (s *Service) LaunchTemplateNeedsUpdate(scope scope.LaunchTemplateScope, inc
 	return false, nil
 }
 
+// launchTemplateSettingsChanged returns whether the settings of a launch template other than its security groups,
+// which can be swapped on the running instances, changed.
+func launchTemplateSettingsChanged(incoming *expinfrav1.AWSLaunchTemplate, existing *expinfrav1.AWSLaunchTemplate) bool {
+	return incoming.IamInstanceProfile != existing.IamInstanceProfile ||
+		incoming.InstanceType != existing.InstanceType ||
+		!cmp.Equal(incoming.InstanceMetadataOptions, existing.InstanceMetadataOptions) ||
+		!cmp.Equal(incoming.SpotMarketOptions, existing.SpotMarketOptions) ||
+		!cmp.Equal(incoming.CapacityReservationID, existing.CapacityReservationID) ||
+		!cmp.Equal(incoming.PrivateDNSName, existing.PrivateDNSName) ||
+		!cmp.Equal(incoming.CPUOptions, existing.CPUOptions) ||
+		!cmp.Equal(incoming.SSHKeyName, existing.SSHKeyName)
+}
+
+// kubernetesVersioner is implemented by the scopes of the control planes that know their Kubernetes version.
+type kubernetesVersioner interface {
+	KubernetesVersion() *string
+}
+
 // DiscoverLaunchTemplateAMI will discover the AMI launch template.
 func (s *Service) DiscoverLaunchTemplateAMI(scope scope.LaunchTemplateScope) (*string, error) {
 	lt := scope.GetLaunchTemplate()
@@ -989,6 +1003,12 @@ func (s *Service) DiscoverLaunchTemplateAMI(scope scope.LaunchTemplateScope) (*s
 	}
 
 	templateVersion := scope.GetMachinePool().Spec.Template.Spec.Version
+	// EKS-optimized images can be looked up for the version of the control plane the machine pool joins.
+	if templateVersion == nil && scope.IsEKSManaged() && lt.AMI.EKSOptimizedLookupType != nil {
+		if controlPlane, ok := scope.GetEC2Scope().(kubernetesVersioner); ok {
+			templateVersion = controlPlane.KubernetesVersion()
+		}
+	}
 	if templateVersion == nil {
 		err := errors.New("Either AWSMachinePool's spec.awslaunchtemplate.ami.id or MachinePool's spec.template.spec.version must be defined")
 		s.scope.Error(err, "")
@@ -1194,6 +1214,17 @@ func getLaunchTemplateInstanceMarketOptionsRequest(i *expinfrav1.AWSLaunchTempla
 	}
 }
 
+func getLaunchTemplateCPUOptionsRequest(cpuOptions *infrav1.CPUOptions) *ec2.LaunchTemplateCpuOptionsRequest {
+	if cpuOptions == nil {
+		return nil
+	}
+
+	return &ec2.LaunchTemplateCpuOptionsRequest{
+		CoreCount:      aws.Int64(cpuOptions.CoreCount),
+		ThreadsPerCore: aws.Int64(cpuOptions.ThreadsPerCore),
+	}
+}
+
 func getLaunchTemplatePrivateDNSNameOptionsRequest(privateDNSName *infrav1.PrivateDNSName) *ec2.LaunchTemplatePrivateDnsNameOptionsRequest {
 	if privateDNSName == nil {
 		return nil
@@ -908,6 +908,36 @@ func TestServiceLaunchTemplateNeedsUpdate(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "Should return true if CPU options are different",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				CPUOptions: &infrav1.CPUOptions{CoreCount: 4, ThreadsPerCore: 1},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+				CPUOptions: &infrav1.CPUOptions{CoreCount: 4, ThreadsPerCore: 2},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Should return true if incoming removes CPU options and existing has some",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				CPUOptions: nil,
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+				CPUOptions: &infrav1.CPUOptions{CoreCount: 4, ThreadsPerCore: 1},
+			},
+			want:    true,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -955,6 +985,65 @@ func TestServiceLaunchTemplateNeedsUpdate(t *testing.T) {
 	}
 }
 
+func TestLaunchTemplateSettingsChanged(t *testing.T) {
+	tests := []struct {
+		name     string
+		incoming *expinfrav1.AWSLaunchTemplate
+		existing *expinfrav1.AWSLaunchTemplate
+		want     bool
+	}{
+		{
+			name: "only security groups changed",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				InstanceType: "t3.large",
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-1")},
+					{ID: aws.String("sg-2")},
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				InstanceType: "t3.large",
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-1")},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "security groups and instance type changed",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				InstanceType: "t3.xlarge",
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-2")},
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				InstanceType: "t3.large",
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-1")},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "ssh key changed",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				SSHKeyName: aws.String("new"),
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				SSHKeyName: aws.String("old"),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(launchTemplateSettingsChanged(tt.incoming, tt.existing)).To(Equal(tt.want))
+		})
+	}
+}
+
 func TestGetLaunchTemplateID(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -1935,6 +2024,7 @@ func TestDiscoverLaunchTemplateAMIForEKS(t *testing.T) {
 		name              string
 		awsLaunchTemplate expinfrav1.AWSLaunchTemplate
 		machineTemplate   clusterv1.MachineTemplateSpec
+		modify            func(mcps *scope.ManagedControlPlaneScope, ms *scope.MachinePoolScope)
 		expectEC2         func(m *mocks.MockEC2APIMockRecorder)
 		expectSSM         func(m *mock_ssmiface.MockSSMAPIMockRecorder)
 		check             func(*WithT, *string, error)
@@ -1968,6 +2058,41 @@ func TestDiscoverLaunchTemplateAMIForEKS(t *testing.T) {
 				g.Expect(err).NotTo(HaveOccurred())
 			},
 		},
+		{
+			name: "Should look up the EKS-optimized AMI for the control plane version if the machine pool has no version",
+			modify: func(mcps *scope.ManagedControlPlaneScope, ms *scope.MachinePoolScope) {
+				mcps.ControlPlane.Status.Version = aws.String("v1.30.4")
+				ms.MachinePool.Spec.Template.Spec.Version = nil
+				ms.AWSMachinePool.Spec.AWSLaunchTemplate.AMI.EKSOptimizedLookupType = ptr.To(infrav1.Bottlerocket)
+			},
+			expectEC2: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								ProcessorInfo: &ec2.ProcessorInfo{
+									SupportedArchitectures: []*string{
+										aws.String("x86_64"),
+									},
+								},
+							},
+						},
+					}, nil)
+			},
+			expectSSM: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Eq(&ssm.GetParameterInput{
+					Name: aws.String("/aws/service/bottlerocket/aws-k8s-1.30/x86_64/latest/image_id"),
+				})).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("bottlerocket"),
+					},
+				}, nil)
+			},
+			check: func(g *WithT, res *string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(res).Should(Equal(aws.String("bottlerocket")))
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1986,6 +2111,10 @@ func TestDiscoverLaunchTemplateAMIForEKS(t *testing.T) {
 			ms, err := setupMachinePoolScope(client, mcps)
 			g.Expect(err).NotTo(HaveOccurred())
 
+			if tc.modify != nil {
+				tc.modify(mcps, ms)
+			}
+
 			if tc.expectEC2 != nil {
 				tc.expectEC2(ec2Mock.EXPECT())
 			}
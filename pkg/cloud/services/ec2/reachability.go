@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// sshPort is the destination port of the analysis of the network path between the bastion host and an instance.
+const sshPort = 22
+
+// StartReachabilityAnalysis starts a VPC Reachability Analyzer analysis of the given network path of an instance:
+// from the instance to the API server endpoint, or from the bastion host to the SSH port of the instance.
+func (s *Service) StartReachabilityAnalysis(target infrav1.ReachabilityAnalysisTarget, instance *infrav1.Instance, endpoint clusterv1.APIEndpoint) (*infrav1.ReachabilityAnalysis, error) {
+	tagParams := s.getReachabilityAnalysisTagParams(fmt.Sprintf("%s-reachability-analysis", instance.ID))
+
+	var reachability *infrav1.ReachabilityAnalysis
+	var err error
+	switch target {
+	case infrav1.ReachabilityAnalysisTargetAPIServer:
+		reachability, err = s.startAPIServerReachabilityAnalysis(instance.ID, endpoint, tagParams)
+	case infrav1.ReachabilityAnalysisTargetBastion:
+		reachability, err = s.startBastionReachabilityAnalysis(instance.ID, tagParams)
+	default:
+		return nil, errors.Errorf("unsupported reachability analysis target %q", target)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	reachability.Target = target
+	reachability.StartedAt = ptr.To(metav1.Now())
+	s.scope.Debug("Started reachability analysis", "instance-id", instance.ID, "target", target, "network-insights-analysis-id", reachability.NetworkInsightsAnalysisID)
+	return reachability, nil
+}
+
+// UpdateReachabilityAnalysis refreshes a running analysis, and deletes its Reachability Analyzer resources once
+// the analysis is complete.
+func (s *Service) UpdateReachabilityAnalysis(reachability *infrav1.ReachabilityAnalysis) error {
+	if !reachability.IsRunning() {
+		return nil
+	}
+
+	if err := s.refreshReachabilityAnalysis(reachability); err != nil {
+		return err
+	}
+	if reachability.IsRunning() {
+		return nil
+	}
+
+	return s.DeleteReachabilityAnalysis(reachability)
+}
+
+// DeleteReachabilityAnalysis deletes the Reachability Analyzer resources of an analysis.
+func (s *Service) DeleteReachabilityAnalysis(reachability *infrav1.ReachabilityAnalysis) error {
+	if reachability == nil {
+		return nil
+	}
+
+	if reachability.NetworkInsightsAnalysisID != "" {
+		if _, err := s.EC2Client.DeleteNetworkInsightsAnalysisWithContext(context.TODO(), &ec2.DeleteNetworkInsightsAnalysisInput{
+			NetworkInsightsAnalysisId: aws.String(reachability.NetworkInsightsAnalysisID),
+		}); err != nil && !isNetworkInsightsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete network insights analysis %q", reachability.NetworkInsightsAnalysisID)
+		}
+	}
+
+	if reachability.NetworkInsightsPathID != "" {
+		if _, err := s.EC2Client.DeleteNetworkInsightsPathWithContext(context.TODO(), &ec2.DeleteNetworkInsightsPathInput{
+			NetworkInsightsPathId: aws.String(reachability.NetworkInsightsPathID),
+		}); err != nil && !isNetworkInsightsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete network insights path %q", reachability.NetworkInsightsPathID)
+		}
+	}
+
+	s.scope.Debug("Deleted reachability analysis resources", "network-insights-path-id", reachability.NetworkInsightsPathID, "network-insights-analysis-id", reachability.NetworkInsightsAnalysisID)
+	return nil
+}
+
+// startBastionReachabilityAnalysis starts the analysis of the network path between the bastion host of the
+// cluster and the SSH port of an instance.
+func (s *Service) startBastionReachabilityAnalysis(instanceID string, tagParams infrav1.BuildParams) (*infrav1.ReachabilityAnalysis, error) {
+	bastion, err := s.describeBastionInstance()
+	if err != nil {
+		if awserrors.IsNotFound(err) {
+			return nil, errors.New("the cluster has no bastion host")
+		}
+		return nil, err
+	}
+
+	return s.startReachabilityAnalysis(&ec2.CreateNetworkInsightsPathInput{
+		Source:          aws.String(bastion.ID),
+		Destination:     aws.String(instanceID),
+		DestinationPort: aws.Int64(sshPort),
+		Protocol:        aws.String(ec2.ProtocolTcp),
+	}, tagParams)
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestStartReachabilityAnalysis(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	instanceID := "i-0123456789abcdef0"
+	bastionID := "i-0123456789abcdef1"
+	endpoint := clusterv1.APIEndpoint{Host: "10.0.0.10", Port: 6443}
+
+	startAnalysis := func(m *mocks.MockEC2APIMockRecorder, check func(input *ec2.CreateNetworkInsightsPathInput)) {
+		m.CreateNetworkInsightsPathWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateNetworkInsightsPathInput{})).
+			DoAndReturn(func(_ context.Context, input *ec2.CreateNetworkInsightsPathInput, _ ...interface{}) (*ec2.CreateNetworkInsightsPathOutput, error) {
+				check(input)
+				return &ec2.CreateNetworkInsightsPathOutput{
+					NetworkInsightsPath: &ec2.NetworkInsightsPath{NetworkInsightsPathId: aws.String("nip-1")},
+				}, nil
+			})
+		m.StartNetworkInsightsAnalysisWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.StartNetworkInsightsAnalysisInput{})).
+			Return(&ec2.StartNetworkInsightsAnalysisOutput{
+				NetworkInsightsAnalysis: &ec2.NetworkInsightsAnalysis{
+					NetworkInsightsAnalysisId: aws.String("nia-1"),
+					Status:                    aws.String(ec2.AnalysisStatusRunning),
+				},
+			}, nil)
+	}
+
+	testCases := []struct {
+		name      string
+		target    infrav1.ReachabilityAnalysisTarget
+		expect    func(g *WithT, m *mocks.MockEC2APIMockRecorder)
+		expectErr string
+	}{
+		{
+			name:   "should analyze the network path from the instance to the API server endpoint",
+			target: infrav1.ReachabilityAnalysisTargetAPIServer,
+			expect: func(g *WithT, m *mocks.MockEC2APIMockRecorder) {
+				startAnalysis(m, func(input *ec2.CreateNetworkInsightsPathInput) {
+					g.Expect(aws.StringValue(input.Source)).To(Equal(instanceID))
+					g.Expect(aws.StringValue(input.DestinationIp)).To(Equal("10.0.0.10"))
+					g.Expect(aws.Int64Value(input.DestinationPort)).To(Equal(int64(6443)))
+					g.Expect(aws.StringValue(input.Protocol)).To(Equal(ec2.ProtocolTcp))
+				})
+			},
+		},
+		{
+			name:   "should analyze the network path from the bastion host to the SSH port of the instance",
+			target: infrav1.ReachabilityAnalysisTargetBastion,
+			expect: func(g *WithT, m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstancesWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeInstancesOutput{
+					Reservations: []*ec2.Reservation{
+						{
+							Instances: []*ec2.Instance{
+								{
+									InstanceId: aws.String(bastionID),
+									State:      &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameRunning)},
+									Placement:  &ec2.Placement{AvailabilityZone: aws.String("us-east-1a")},
+								},
+							},
+						},
+					},
+				}, nil)
+				startAnalysis(m, func(input *ec2.CreateNetworkInsightsPathInput) {
+					g.Expect(aws.StringValue(input.Source)).To(Equal(bastionID))
+					g.Expect(aws.StringValue(input.Destination)).To(Equal(instanceID))
+					g.Expect(aws.Int64Value(input.DestinationPort)).To(Equal(int64(sshPort)))
+				})
+			},
+		},
+		{
+			name:   "should fail when the cluster has no bastion host",
+			target: infrav1.ReachabilityAnalysisTargetBastion,
+			expect: func(g *WithT, m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstancesWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeInstancesOutput{}, nil)
+			},
+			expectErr: "the cluster has no bastion host",
+		},
+		{
+			name:      "should fail with an unsupported target",
+			target:    infrav1.ReachabilityAnalysisTarget("Internet"),
+			expect:    func(g *WithT, m *mocks.MockEC2APIMockRecorder) {},
+			expectErr: `unsupported reachability analysis target "Internet"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(g, ec2Mock.EXPECT())
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			reachability, err := s.StartReachabilityAnalysis(tc.target, &infrav1.Instance{ID: instanceID}, endpoint)
+			if tc.expectErr != "" {
+				g.Expect(err).To(MatchError(tc.expectErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(reachability.Target).To(Equal(tc.target))
+			g.Expect(reachability.StartedAt).NotTo(BeNil())
+			g.Expect(reachability.NetworkInsightsPathID).To(Equal("nip-1"))
+			g.Expect(reachability.NetworkInsightsAnalysisID).To(Equal("nia-1"))
+			g.Expect(reachability.IsRunning()).To(BeTrue())
+		})
+	}
+}
+
+func TestUpdateReachabilityAnalysis(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	runningAnalysis := func() *infrav1.ReachabilityAnalysis {
+		return &infrav1.ReachabilityAnalysis{
+			Target:                    infrav1.ReachabilityAnalysisTargetBastion,
+			NetworkInsightsPathID:     "nip-1",
+			NetworkInsightsAnalysisID: "nia-1",
+			Status:                    ec2.AnalysisStatusRunning,
+		}
+	}
+	describeAnalysis := func(m *mocks.MockEC2APIMockRecorder, analyses ...*ec2.NetworkInsightsAnalysis) {
+		m.DescribeNetworkInsightsAnalysesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeNetworkInsightsAnalysesInput{
+			NetworkInsightsAnalysisIds: []*string{aws.String("nia-1")},
+		})).Return(&ec2.DescribeNetworkInsightsAnalysesOutput{
+			NetworkInsightsAnalyses: analyses,
+		}, nil)
+	}
+	deleteResources := func(m *mocks.MockEC2APIMockRecorder) {
+		m.DeleteNetworkInsightsAnalysisWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNetworkInsightsAnalysisInput{
+			NetworkInsightsAnalysisId: aws.String("nia-1"),
+		})).Return(&ec2.DeleteNetworkInsightsAnalysisOutput{}, nil)
+		m.DeleteNetworkInsightsPathWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNetworkInsightsPathInput{
+			NetworkInsightsPathId: aws.String("nip-1"),
+		})).Return(&ec2.DeleteNetworkInsightsPathOutput{}, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		reachability *infrav1.ReachabilityAnalysis
+		expect       func(m *mocks.MockEC2APIMockRecorder)
+		check        func(g *WithT, reachability *infrav1.ReachabilityAnalysis)
+	}{
+		{
+			name:         "should do nothing when the analysis is complete",
+			reachability: &infrav1.ReachabilityAnalysis{Status: ec2.AnalysisStatusSucceeded},
+			expect:       func(m *mocks.MockEC2APIMockRecorder) {},
+			check: func(g *WithT, reachability *infrav1.ReachabilityAnalysis) {
+				g.Expect(reachability.Status).To(Equal(ec2.AnalysisStatusSucceeded))
+			},
+		},
+		{
+			name:         "should keep the resources while the analysis is running",
+			reachability: runningAnalysis(),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeAnalysis(m, &ec2.NetworkInsightsAnalysis{Status: aws.String(ec2.AnalysisStatusRunning)})
+			},
+			check: func(g *WithT, reachability *infrav1.ReachabilityAnalysis) {
+				g.Expect(reachability.IsRunning()).To(BeTrue())
+			},
+		},
+		{
+			name:         "should store the result and delete the resources of a complete analysis",
+			reachability: runningAnalysis(),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeAnalysis(m, &ec2.NetworkInsightsAnalysis{
+					Status:           aws.String(ec2.AnalysisStatusSucceeded),
+					NetworkPathFound: aws.Bool(false),
+					Explanations: []*ec2.Explanation{
+						{
+							ExplanationCode: aws.String("NO_ROUTE_TO_DESTINATION"),
+							Component:       &ec2.AnalysisComponent{Id: aws.String("rtb-1")},
+						},
+					},
+				})
+				deleteResources(m)
+			},
+			check: func(g *WithT, reachability *infrav1.ReachabilityAnalysis) {
+				g.Expect(reachability.IsRunning()).To(BeFalse())
+				g.Expect(reachability.NetworkPathFound).To(Equal(aws.Bool(false)))
+				g.Expect(reachability.Explanations).To(Equal([]string{"NO_ROUTE_TO_DESTINATION: rtb-1"}))
+			},
+		},
+		{
+			name:         "should report a deleted analysis as failed",
+			reachability: runningAnalysis(),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeAnalysis(m)
+				deleteResources(m)
+			},
+			check: func(g *WithT, reachability *infrav1.ReachabilityAnalysis) {
+				g.Expect(reachability.Status).To(Equal(ec2.AnalysisStatusFailed))
+				g.Expect(reachability.StatusMessage).To(Equal("the analysis was not found"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.UpdateReachabilityAnalysis(tc.reachability)).To(Succeed())
+			tc.check(g, tc.reachability)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+const (
+	// RegionOptInNotRequired is the opt-in status of the regions enabled by default for all accounts.
+	RegionOptInNotRequired = "opt-in-not-required"
+
+	// RegionOptedIn is the opt-in status of the opt-in regions enabled for the account.
+	RegionOptedIn = "opted-in"
+
+	// RegionNotOptedIn is the opt-in status of the opt-in regions not enabled for the account.
+	RegionNotOptedIn = "not-opted-in"
+
+	// errCodeOptInRequired is the error code returned by EC2 when the account is not subscribed to a service or
+	// has not opted in to a region.
+	errCodeOptInRequired = "OptInRequired"
+)
+
+// DescribeRegion returns the region of the given name with its opt-in status, including the opt-in regions not
+// enabled for the account. It returns nil when the partition of the client has no such region, so that regions
+// launched after a release are recognized without a static list.
+func DescribeRegion(ctx context.Context, client ec2iface.EC2API, name string) (*ec2.Region, error) {
+	out, err := client.DescribeRegionsWithContext(ctx, &ec2.DescribeRegionsInput{
+		AllRegions: aws.Bool(true),
+		Filters:    []*ec2.Filter{filter.EC2.RegionName(name)},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe region %q", name)
+	}
+	for _, region := range out.Regions {
+		if aws.StringValue(region.RegionName) == name {
+			return region, nil
+		}
+	}
+	return nil, nil
+}
+
+// EnabledRegions returns the sorted names of the regions enabled for the account: the regions which don't require
+// opting in and the opt-in regions the account opted in to.
+func EnabledRegions(ctx context.Context, client ec2iface.EC2API) ([]string, error) {
+	out, err := client.DescribeRegionsWithContext(ctx, &ec2.DescribeRegionsInput{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe regions")
+	}
+	names := make([]string, 0, len(out.Regions))
+	for _, region := range out.Regions {
+		if aws.StringValue(region.OptInStatus) == RegionNotOptedIn {
+			continue
+		}
+		names = append(names, aws.StringValue(region.RegionName))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// CheckRegion returns why the region of the given name cannot host the resources of the account: because it does
+// not exist, or because it is an opt-in region not enabled for the account. It returns an empty reason when the
+// region can be used.
+func CheckRegion(ctx context.Context, client ec2iface.EC2API, name string) (string, error) {
+	notEnabled := fmt.Sprintf("region %s is an opt-in region not enabled for the account, enable it with: aws account enable-region --region-name %s", name, name)
+
+	region, err := DescribeRegion(ctx, client, name)
+	if err != nil {
+		if code, _ := awserrors.Code(errors.Cause(err)); code == errCodeOptInRequired {
+			return notEnabled, nil
+		}
+		return "", err
+	}
+	if region == nil {
+		return fmt.Sprintf("region %s does not exist", name), nil
+	}
+	if aws.StringValue(region.OptInStatus) == RegionNotOptedIn {
+		return notEnabled, nil
+	}
+	return "", nil
+}
+
+// ValidateRegion ensures that the region of the cluster exists and is enabled for the account before any of its
+// resources is created, as the calls to a disabled region fail with errors which don't name the region. The check
+// is skipped when the controller is not allowed to describe the regions.
+func (s *Service) ValidateRegion() error {
+	name := s.scope.Region()
+	if name == "" {
+		return nil
+	}
+
+	reason, err := CheckRegion(context.TODO(), s.EC2Client, name)
+	if err != nil {
+		if awserrors.IsPermissionsError(errors.Cause(err)) {
+			s.scope.Debug("Not allowed to describe regions, skipping region validation")
+			return nil
+		}
+		return err
+	}
+	if reason != "" {
+		record.Warnf(s.scope.InfraCluster(), "InvalidRegion", "Cluster cannot be provisioned: %s", reason)
+		return errors.New(reason)
+	}
+	return nil
+}
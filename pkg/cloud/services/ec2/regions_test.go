@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestDescribeRegion(t *testing.T) {
+	describeInput := func(name string) *ec2.DescribeRegionsInput {
+		return &ec2.DescribeRegionsInput{
+			AllRegions: aws.Bool(true),
+			Filters:    []*ec2.Filter{{Name: aws.String("region-name"), Values: aws.StringSlice([]string{name})}},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		region     string
+		expect     func(m *mocks.MockEC2APIMockRecorder)
+		wantRegion *ec2.Region
+		wantErr    bool
+	}{
+		{
+			name:   "opt-in region not enabled for the account",
+			region: "ap-southeast-7",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRegionsWithContext(context.TODO(), describeInput("ap-southeast-7")).Return(&ec2.DescribeRegionsOutput{
+					Regions: []*ec2.Region{{RegionName: aws.String("ap-southeast-7"), OptInStatus: aws.String(RegionNotOptedIn)}},
+				}, nil)
+			},
+			wantRegion: &ec2.Region{RegionName: aws.String("ap-southeast-7"), OptInStatus: aws.String(RegionNotOptedIn)},
+		},
+		{
+			name:   "unknown region",
+			region: "us-south-9",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRegionsWithContext(context.TODO(), describeInput("us-south-9")).Return(&ec2.DescribeRegionsOutput{}, nil)
+			},
+		},
+		{
+			name:   "error",
+			region: "us-east-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRegionsWithContext(context.TODO(), describeInput("us-east-1")).Return(nil, awserr.New("InternalError", "", nil))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tt.expect(ec2Mock.EXPECT())
+
+			region, err := DescribeRegion(context.TODO(), ec2Mock, tt.region)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(region).To(Equal(tt.wantRegion))
+		})
+	}
+}
+
+func TestEnabledRegions(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().DescribeRegionsWithContext(context.TODO(), &ec2.DescribeRegionsInput{}).Return(&ec2.DescribeRegionsOutput{
+		Regions: []*ec2.Region{
+			{RegionName: aws.String("us-east-1"), OptInStatus: aws.String(RegionOptInNotRequired)},
+			{RegionName: aws.String("ap-southeast-7"), OptInStatus: aws.String(RegionOptedIn)},
+			{RegionName: aws.String("mx-central-1"), OptInStatus: aws.String(RegionNotOptedIn)},
+			{RegionName: aws.String("eu-west-1"), OptInStatus: aws.String(RegionOptInNotRequired)},
+		},
+	}, nil)
+
+	regions, err := EnabledRegions(context.TODO(), ec2Mock)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(regions).To(Equal([]string{"ap-southeast-7", "eu-west-1", "us-east-1"}))
+}
+
+func TestServiceValidateRegion(t *testing.T) {
+	describeInput := &ec2.DescribeRegionsInput{
+		AllRegions: aws.Bool(true),
+		Filters:    []*ec2.Filter{{Name: aws.String("region-name"), Values: aws.StringSlice([]string{"ap-southeast-7"})}},
+	}
+
+	tests := []struct {
+		name    string
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr string
+	}{
+		{
+			name: "region enabled for the account",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRegionsWithContext(context.TODO(), describeInput).Return(&ec2.DescribeRegionsOutput{
+					Regions: []*ec2.Region{{RegionName: aws.String("ap-southeast-7"), OptInStatus: aws.String(RegionOptedIn)}},
+				}, nil)
+			},
+		},
+		{
+			name: "opt-in region not enabled for the account",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRegionsWithContext(context.TODO(), describeInput).Return(&ec2.DescribeRegionsOutput{
+					Regions: []*ec2.Region{{RegionName: aws.String("ap-southeast-7"), OptInStatus: aws.String(RegionNotOptedIn)}},
+				}, nil)
+			},
+			wantErr: "region ap-southeast-7 is an opt-in region not enabled for the account, enable it with: aws account enable-region --region-name ap-southeast-7",
+		},
+		{
+			name: "opt-in required",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRegionsWithContext(context.TODO(), describeInput).Return(nil, awserr.New("OptInRequired", "", nil))
+			},
+			wantErr: "region ap-southeast-7 is an opt-in region not enabled for the account, enable it with: aws account enable-region --region-name ap-southeast-7",
+		},
+		{
+			name: "unknown region",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRegionsWithContext(context.TODO(), describeInput).Return(&ec2.DescribeRegionsOutput{}, nil)
+			},
+			wantErr: "region ap-southeast-7 does not exist",
+		},
+		{
+			name: "not allowed to describe regions",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRegionsWithContext(context.TODO(), describeInput).Return(nil, awserr.New("UnauthorizedOperation", "", nil))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tt.expect(ec2Mock.EXPECT())
+
+			scheme, err := setupScheme()
+			g.Expect(err).To(BeNil())
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       infrav1.AWSClusterSpec{Region: "ap-southeast-7"},
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+				AWSCluster: awsCluster,
+				Client:     client,
+			})
+			g.Expect(err).To(BeNil())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.ValidateRegion()
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// disruptiveEventCodes are the codes of the scheduled events which reboot, stop or terminate the instance.
+// system-maintenance events may only temporarily affect the network or power of the instance.
+var disruptiveEventCodes = map[string]bool{
+	ec2.EventCodeInstanceReboot:     true,
+	ec2.EventCodeSystemReboot:       true,
+	ec2.EventCodeInstanceRetirement: true,
+	ec2.EventCodeInstanceStop:       true,
+}
+
+// GetInstanceScheduledEvents returns the upcoming scheduled events of the instance, ordered as reported by EC2.
+// Completed and canceled events, whose description is prefixed with [Completed] or [Canceled], are ignored.
+func (s *Service) GetInstanceScheduledEvents(instanceID string) ([]infrav1.ScheduledEvent, error) {
+	out, err := s.EC2Client.DescribeInstanceStatusWithContext(context.TODO(), &ec2.DescribeInstanceStatusInput{
+		InstanceIds:         []*string{aws.String(instanceID)},
+		IncludeAllInstances: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe status of instance %q", instanceID)
+	}
+
+	var events []infrav1.ScheduledEvent
+	for _, status := range out.InstanceStatuses {
+		for _, event := range status.Events {
+			description := aws.StringValue(event.Description)
+			if strings.HasPrefix(description, "[Completed]") || strings.HasPrefix(description, "[Canceled]") {
+				continue
+			}
+
+			scheduledEvent := infrav1.ScheduledEvent{
+				Source:      infrav1.ScheduledEventSourceEC2,
+				Code:        aws.StringValue(event.Code),
+				Disruptive:  disruptiveEventCodes[aws.StringValue(event.Code)],
+				Description: description,
+			}
+			if event.NotBefore != nil {
+				scheduledEvent.NotBefore = &metav1.Time{Time: *event.NotBefore}
+			}
+			if event.NotAfter != nil {
+				scheduledEvent.NotAfter = &metav1.Time{Time: *event.NotAfter}
+			}
+			events = append(events, scheduledEvent)
+		}
+	}
+	return events, nil
+}
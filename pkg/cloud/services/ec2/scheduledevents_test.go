@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestGetInstanceScheduledEvents(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	instanceID := "i-0123456789abcdef0"
+	notBefore := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	notAfter := time.Date(2026, 1, 2, 5, 0, 0, 0, time.UTC)
+
+	describeStatus := func(m *mocks.MockEC2APIMockRecorder, events ...*ec2.InstanceStatusEvent) {
+		m.DescribeInstanceStatusWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceStatusInput{
+			InstanceIds:         []*string{aws.String(instanceID)},
+			IncludeAllInstances: aws.Bool(true),
+		})).Return(&ec2.DescribeInstanceStatusOutput{
+			InstanceStatuses: []*ec2.InstanceStatus{{InstanceId: aws.String(instanceID), Events: events}},
+		}, nil)
+	}
+
+	testCases := []struct {
+		name      string
+		expect    func(m *mocks.MockEC2APIMockRecorder)
+		want      []infrav1.ScheduledEvent
+		expectErr bool
+	}{
+		{
+			name: "no scheduled events",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeStatus(m)
+			},
+		},
+		{
+			name: "disruptive and non disruptive events",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeStatus(m,
+					&ec2.InstanceStatusEvent{
+						Code:        aws.String(ec2.EventCodeInstanceRetirement),
+						Description: aws.String("The instance is running on degraded hardware"),
+						NotBefore:   aws.Time(notBefore),
+					},
+					&ec2.InstanceStatusEvent{
+						Code:        aws.String(ec2.EventCodeSystemMaintenance),
+						Description: aws.String("Network maintenance"),
+						NotBefore:   aws.Time(notBefore),
+						NotAfter:    aws.Time(notAfter),
+					},
+				)
+			},
+			want: []infrav1.ScheduledEvent{
+				{
+					Source:      infrav1.ScheduledEventSourceEC2,
+					Code:        ec2.EventCodeInstanceRetirement,
+					Disruptive:  true,
+					Description: "The instance is running on degraded hardware",
+					NotBefore:   &metav1.Time{Time: notBefore},
+				},
+				{
+					Source:      infrav1.ScheduledEventSourceEC2,
+					Code:        ec2.EventCodeSystemMaintenance,
+					Description: "Network maintenance",
+					NotBefore:   &metav1.Time{Time: notBefore},
+					NotAfter:    &metav1.Time{Time: notAfter},
+				},
+			},
+		},
+		{
+			name: "completed and canceled events are ignored",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeStatus(m,
+					&ec2.InstanceStatusEvent{
+						Code:        aws.String(ec2.EventCodeSystemReboot),
+						Description: aws.String("[Completed] Scheduled reboot"),
+					},
+					&ec2.InstanceStatusEvent{
+						Code:        aws.String(ec2.EventCodeInstanceStop),
+						Description: aws.String("[Canceled] Scheduled stop"),
+					},
+				)
+			},
+		},
+		{
+			name: "describe instance status fails",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceStatusWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New("InternalError", "internal error", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			events, err := s.GetInstanceScheduledEvents(instanceID)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(events).To(Equal(tc.want))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// runInstanceWithSpotFallback runs the instance and, when the AWSMachine has a Spot fallback,
+// falls back to an on-demand instance when there is insufficient Spot capacity, then to the
+// alternate instance types when there is insufficient on-demand capacity.
+func (s *Service) runInstanceWithSpotFallback(scope *scope.MachineScope, input *infrav1.Instance) (*infrav1.Instance, error) {
+	out, err := s.runInstance(scope.Role(), input)
+	fallback := scope.AWSMachine.Spec.SpotFallback
+	if err == nil || fallback == nil {
+		return out, err
+	}
+
+	if isSpotInstance(input) {
+		if !awserrors.IsSpotCapacityError(errors.Cause(err)) {
+			return nil, err
+		}
+		record.Warnf(scope.AWSMachine, "SpotFallback", "Failed to run Spot instance, falling back to an on-demand instance: %v", err)
+		scope.SetSpotFallbackOnDemand(infrav1.SpotFallbackReasonInsufficientCapacity)
+		useOnDemandMarket(input)
+		out, err = s.runInstance(scope.Role(), input)
+	}
+
+	for _, instanceType := range fallback.InstanceTypes {
+		if err == nil || !awserrors.IsInsufficientInstanceCapacity(errors.Cause(err)) {
+			break
+		}
+		record.Warnf(scope.AWSMachine, "SpotFallback", "Insufficient capacity for instance type %q, trying instance type %q", input.Type, instanceType)
+		input.Type = instanceType
+		out, err = s.runInstance(scope.Role(), input)
+		if err == nil {
+			scope.SetInstanceType(instanceType)
+		}
+	}
+
+	return out, err
+}
+
+func isSpotInstance(i *infrav1.Instance) bool {
+	return i.SpotMarketOptions != nil || i.MarketType == infrav1.MarketTypeSpot
+}
+
+func useOnDemandMarket(i *infrav1.Instance) {
+	i.SpotMarketOptions = nil
+	i.MarketType = infrav1.MarketTypeOnDemand
+}
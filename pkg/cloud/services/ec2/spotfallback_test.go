@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestRunInstanceWithSpotFallback(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	capacityErr := awserr.New(awserrors.InsufficientInstanceCapacity, "insufficient capacity", nil)
+
+	runInstance := func(m *mocks.MockEC2APIMockRecorder, instanceType string, spot bool, err error) *gomock.Call {
+		return m.RunInstancesWithContext(context.TODO(), gomock.Any()).DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...interface{}) (*ec2.Reservation, error) {
+			if aws.StringValue(input.InstanceType) != instanceType || (input.InstanceMarketOptions != nil) != spot {
+				t.Fatalf("unexpected RunInstances input: %v", input)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return &ec2.Reservation{
+				Instances: []*ec2.Instance{
+					{
+						InstanceId:   aws.String("i-1234567890abcdef0"),
+						InstanceType: input.InstanceType,
+						State:        &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNamePending)},
+						Placement:    &ec2.Placement{AvailabilityZone: aws.String("us-east-1a")},
+					},
+				},
+			}, nil
+		})
+	}
+
+	testCases := []struct {
+		name             string
+		fallback         *infrav1.SpotFallback
+		expect           func(m *mocks.MockEC2APIMockRecorder)
+		wantErr          bool
+		wantOnDemand     bool
+		wantInstanceType string
+	}{
+		{
+			name: "should launch a Spot instance when there is Spot capacity",
+			fallback: &infrav1.SpotFallback{
+				InstanceTypes: []string{"m5a.large"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				runInstance(m, "m5.large", true, nil)
+			},
+		},
+		{
+			name: "should fail without a Spot fallback when there is insufficient Spot capacity",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				runInstance(m, "m5.large", true, capacityErr)
+			},
+			wantErr: true,
+		},
+		{
+			name:     "should fall back to an on-demand instance when there is insufficient Spot capacity",
+			fallback: &infrav1.SpotFallback{},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					runInstance(m, "m5.large", true, capacityErr),
+					runInstance(m, "m5.large", false, nil),
+				)
+			},
+			wantOnDemand: true,
+		},
+		{
+			name: "should try the alternate instance types when there is insufficient on-demand capacity",
+			fallback: &infrav1.SpotFallback{
+				InstanceTypes: []string{"m5a.large", "m6i.large"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					runInstance(m, "m5.large", true, capacityErr),
+					runInstance(m, "m5.large", false, capacityErr),
+					runInstance(m, "m5a.large", false, capacityErr),
+					runInstance(m, "m6i.large", false, nil),
+				)
+			},
+			wantOnDemand:     true,
+			wantInstanceType: "m6i.large",
+		},
+		{
+			name:     "should not fall back when the Spot instance fails for another reason",
+			fallback: &infrav1.SpotFallback{},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				runInstance(m, "m5.large", true, awserr.New(awserrors.UnauthorizedOperation, "unauthorized", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			machineScope := &scope.MachineScope{
+				Machine: &clusterv1.Machine{},
+				AWSMachine: &infrav1.AWSMachine{
+					Spec: infrav1.AWSMachineSpec{
+						InstanceType:      "m5.large",
+						SpotMarketOptions: &infrav1.SpotMarketOptions{},
+						SpotFallback:      tc.fallback,
+					},
+				},
+			}
+			input := &infrav1.Instance{
+				Type:              "m5.large",
+				ImageID:           "ami-1234567890abcdef0",
+				UserData:          aws.String(""),
+				SpotMarketOptions: &infrav1.SpotMarketOptions{},
+			}
+
+			_, err = s.runInstanceWithSpotFallback(machineScope, input)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(machineScope.IsSpotFallbackOnDemand()).To(Equal(tc.wantOnDemand))
+			g.Expect(machineScope.AWSMachine.Status.InstanceType).To(Equal(tc.wantInstanceType))
+		})
+	}
+}
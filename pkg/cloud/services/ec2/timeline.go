@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+)
+
+// spotInterruptionStatusCodes are the Spot instance request status codes reported when
+// the instance is about to be interrupted.
+// See https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/spot-request-status.html
+var spotInterruptionStatusCodes = map[string]bool{
+	"marked-for-termination":                      true,
+	"marked-for-stop":                             true,
+	"marked-for-hibernation":                      true,
+	"instance-terminated-by-price":                true,
+	"instance-terminated-no-capacity":             true,
+	"instance-terminated-capacity-oversubscribed": true,
+}
+
+// GetInstanceTimelineEvents returns the impairment of the status checks of a running instance and,
+// for interruptible instances, the interruption notice of its Spot instance request, if any.
+func (s *Service) GetInstanceTimelineEvents(instanceID string, interruptible bool) ([]infrav1.TimelineEvent, error) {
+	var events []infrav1.TimelineEvent
+
+	impairment, err := s.getInstanceImpairment(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if impairment != nil {
+		events = append(events, *impairment)
+	}
+
+	if interruptible {
+		interruption, err := s.getSpotInterruptionNotice(instanceID)
+		if err != nil {
+			return nil, err
+		}
+		if interruption != nil {
+			events = append(events, *interruption)
+		}
+	}
+
+	return events, nil
+}
+
+func (s *Service) getInstanceImpairment(instanceID string) (*infrav1.TimelineEvent, error) {
+	out, err := s.EC2Client.DescribeInstanceStatusWithContext(context.TODO(), &ec2.DescribeInstanceStatusInput{
+		InstanceIds: []*string{aws.String(instanceID)},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe status of instance %q", instanceID)
+	}
+	if len(out.InstanceStatuses) == 0 {
+		return nil, nil
+	}
+
+	status := out.InstanceStatuses[0]
+	var messages []string
+	var impairedSince *metav1.Time
+	for _, check := range []struct {
+		name    string
+		summary *ec2.InstanceStatusSummary
+	}{
+		{name: "system", summary: status.SystemStatus},
+		{name: "instance", summary: status.InstanceStatus},
+	} {
+		summary := check.summary
+		if summary == nil || aws.StringValue(summary.Status) != ec2.SummaryStatusImpaired {
+			continue
+		}
+		var failed []string
+		for _, detail := range summary.Details {
+			if aws.StringValue(detail.Status) != ec2.StatusTypeFailed {
+				continue
+			}
+			failed = append(failed, aws.StringValue(detail.Name))
+			if detail.ImpairedSince != nil && (impairedSince == nil || detail.ImpairedSince.Before(impairedSince.Time)) {
+				impairedSince = &metav1.Time{Time: *detail.ImpairedSince}
+			}
+		}
+		messages = append(messages, fmt.Sprintf("%s status check is impaired (failed: %s)", check.name, strings.Join(failed, ", ")))
+	}
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	event := &infrav1.TimelineEvent{
+		Type:       infrav1.TimelineEventTypeImpairment,
+		InstanceID: instanceID,
+		Reason:     infrav1.TimelineReasonStatusChecksImpaired,
+		Message:    strings.Join(messages, "; "),
+	}
+	if impairedSince != nil {
+		event.Time = *impairedSince
+	}
+	return event, nil
+}
+
+func (s *Service) getSpotInterruptionNotice(instanceID string) (*infrav1.TimelineEvent, error) {
+	out, err := s.EC2Client.DescribeSpotInstanceRequestsWithContext(context.TODO(), &ec2.DescribeSpotInstanceRequestsInput{
+		Filters: []*ec2.Filter{filter.EC2.InstanceID(instanceID)},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe Spot instance request of instance %q", instanceID)
+	}
+
+	for _, request := range out.SpotInstanceRequests {
+		if request.Status == nil || !spotInterruptionStatusCodes[aws.StringValue(request.Status.Code)] {
+			continue
+		}
+		event := &infrav1.TimelineEvent{
+			Type:       infrav1.TimelineEventTypeInterruptionNotice,
+			InstanceID: instanceID,
+			Reason:     aws.StringValue(request.Status.Code),
+			Message:    aws.StringValue(request.Status.Message),
+		}
+		if request.Status.UpdateTime != nil {
+			event.Time = metav1.Time{Time: *request.Status.UpdateTime}
+		}
+		return event, nil
+	}
+	return nil, nil
+}
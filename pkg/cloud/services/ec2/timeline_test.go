@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func TestGetInstanceTimelineEvents(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	instanceID := "i-0123456789abcdef0"
+	impairedSince := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	updateTime := time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC)
+
+	describeStatus := func(m *mocks.MockEC2APIMockRecorder, status *ec2.InstanceStatus) {
+		out := &ec2.DescribeInstanceStatusOutput{}
+		if status != nil {
+			out.InstanceStatuses = []*ec2.InstanceStatus{status}
+		}
+		m.DescribeInstanceStatusWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceStatusInput{
+			InstanceIds: []*string{aws.String(instanceID)},
+		})).Return(out, nil)
+	}
+	okSummary := &ec2.InstanceStatusSummary{Status: aws.String(ec2.SummaryStatusOk)}
+
+	testCases := []struct {
+		name          string
+		interruptible bool
+		expect        func(m *mocks.MockEC2APIMockRecorder)
+		want          []infrav1.TimelineEvent
+		expectErr     bool
+	}{
+		{
+			name: "should return no event for a healthy instance",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeStatus(m, &ec2.InstanceStatus{SystemStatus: okSummary, InstanceStatus: okSummary})
+			},
+		},
+		{
+			name: "should return no event when the instance status is not available yet",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeStatus(m, nil)
+			},
+		},
+		{
+			name: "should return an impairment event for impaired status checks",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeStatus(m, &ec2.InstanceStatus{
+					SystemStatus: &ec2.InstanceStatusSummary{
+						Status: aws.String(ec2.SummaryStatusImpaired),
+						Details: []*ec2.InstanceStatusDetails{
+							{Name: aws.String(ec2.StatusNameReachability), Status: aws.String(ec2.StatusTypeFailed), ImpairedSince: aws.Time(impairedSince)},
+						},
+					},
+					InstanceStatus: &ec2.InstanceStatusSummary{
+						Status: aws.String(ec2.SummaryStatusImpaired),
+						Details: []*ec2.InstanceStatusDetails{
+							{Name: aws.String(ec2.StatusNameReachability), Status: aws.String(ec2.StatusTypeFailed), ImpairedSince: aws.Time(impairedSince.Add(time.Minute))},
+						},
+					},
+				})
+			},
+			want: []infrav1.TimelineEvent{
+				{
+					Time:       metav1.Time{Time: impairedSince},
+					Type:       infrav1.TimelineEventTypeImpairment,
+					InstanceID: instanceID,
+					Reason:     infrav1.TimelineReasonStatusChecksImpaired,
+					Message:    "system status check is impaired (failed: reachability); instance status check is impaired (failed: reachability)",
+				},
+			},
+		},
+		{
+			name:          "should return an interruption notice for an interruptible instance marked for termination",
+			interruptible: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeStatus(m, &ec2.InstanceStatus{SystemStatus: okSummary, InstanceStatus: okSummary})
+				m.DescribeSpotInstanceRequestsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeSpotInstanceRequestsInput{
+					Filters: []*ec2.Filter{{Name: aws.String("instance-id"), Values: aws.StringSlice([]string{instanceID})}},
+				})).Return(&ec2.DescribeSpotInstanceRequestsOutput{
+					SpotInstanceRequests: []*ec2.SpotInstanceRequest{
+						{
+							Status: &ec2.SpotInstanceStatus{
+								Code:       aws.String("marked-for-termination"),
+								Message:    aws.String("Spot Instance terminated due to no available capacity."),
+								UpdateTime: aws.Time(updateTime),
+							},
+						},
+					},
+				}, nil)
+			},
+			want: []infrav1.TimelineEvent{
+				{
+					Time:       metav1.Time{Time: updateTime},
+					Type:       infrav1.TimelineEventTypeInterruptionNotice,
+					InstanceID: instanceID,
+					Reason:     "marked-for-termination",
+					Message:    "Spot Instance terminated due to no available capacity.",
+				},
+			},
+		},
+		{
+			name:          "should return no interruption notice for a fulfilled Spot instance request",
+			interruptible: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeStatus(m, &ec2.InstanceStatus{SystemStatus: okSummary, InstanceStatus: okSummary})
+				m.DescribeSpotInstanceRequestsWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeSpotInstanceRequestsOutput{
+					SpotInstanceRequests: []*ec2.SpotInstanceRequest{
+						{Status: &ec2.SpotInstanceStatus{Code: aws.String("fulfilled")}},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "should return an error when the instance status cannot be described",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceStatusWithContext(context.TODO(), gomock.Any()).Return(nil, awserr.New("UnauthorizedOperation", "", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			events, err := s.GetInstanceTimelineEvents(instanceID, tc.interruptible)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(events).To(Equal(tc.want))
+		})
+	}
+}
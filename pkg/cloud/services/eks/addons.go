@@ -19,6 +19,8 @@ package eks
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -30,6 +32,9 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	eksaddons "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/addons"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	infrautilconditions "sigs.k8s.io/cluster-api-provider-aws/v2/util/conditions"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func (s *Service) reconcileAddons(ctx context.Context) error {
@@ -57,6 +62,7 @@ func (s *Service) reconcileAddons(ctx context.Context) error {
 	// If there are no addons desired or installed then do nothing
 	if len(installed) == 0 && len(desiredAddons) == 0 {
 		s.scope.Info("no addons installed and no addons to install, no action needed")
+		s.reconcileAddonConditions(nil)
 		return nil
 	}
 
@@ -71,23 +77,35 @@ func (s *Service) reconcileAddons(ctx context.Context) error {
 	s.scope.Debug("computed EKS addons plan", "numprocs", len(procedures))
 
 	// Perform required operations
+	var procedureErr error
 	for _, procedure := range procedures {
 		s.scope.Debug("Executing addon procedure", "name", procedure.Name())
 		if err := procedure.Do(ctx); err != nil {
 			s.scope.Error(err, "failed executing addon procedure", "name", procedure.Name())
-			return fmt.Errorf("%s: %w", procedure.Name(), err)
+			procedureErr = fmt.Errorf("%s: %w", procedure.Name(), err)
+			break
 		}
 	}
 
 	// Update status with addons installed details
 	// Note: we are not relying on the computed state from the operations as we still want
-	// to update the state even if there are no operations to capture things like status changes
+	// to update the state even if there are no operations to capture things like status changes.
+	// The state is also updated when an operation failed, so that the conditions of the addons
+	// report which addon is unhealthy.
 	s.scope.Debug("getting installed eks addons to update status", "cluster", eksClusterName)
+	addonNames, err = s.listAddons(ctx, eksClusterName)
+	if err != nil {
+		return fmt.Errorf("listing eks addons: %w", err)
+	}
 	addonState, err := s.getInstalledState(ctx, eksClusterName, addonNames)
 	if err != nil {
 		return fmt.Errorf("getting installed state of eks addons: %w", err)
 	}
 	s.scope.ControlPlane.Status.Addons = addonState
+	s.reconcileAddonConditions(addonState)
+	if procedureErr != nil {
+		return procedureErr
+	}
 
 	// Persist status and record event
 	if err := s.scope.PatchObject(); err != nil {
@@ -203,6 +221,7 @@ func (s *Service) translateAPIToAddon(addons []ekscontrolplanev1.Addon) []*eksad
 			Tags:                  ngTags(s.scope.Cluster.Name, s.scope.AdditionalTags()),
 			ResolveConflict:       convertConflictResolution(*addon.ConflictResolution),
 			ServiceAccountRoleARN: addon.ServiceAccountRoleArn,
+			DependsOn:             addon.DependsOn,
 		}
 
 		converted = append(converted, convertedAddon)
@@ -211,6 +230,83 @@ func (s *Service) translateAPIToAddon(addons []ekscontrolplanev1.Addon) []*eksad
 	return converted
 }
 
+// reconcileAddonConditions sets the condition reporting on the health of each desired addon from the
+// installed state of the addons, and summarizes them into the EKSAddonsHealthy condition.
+func (s *Service) reconcileAddonConditions(addonState []ekscontrolplanev1.AddonState) {
+	controlPlane := s.scope.ControlPlane
+
+	states := map[string]ekscontrolplanev1.AddonState{}
+	for _, state := range addonState {
+		states[state.Name] = state
+	}
+
+	addonConditions := []clusterv1.ConditionType{}
+	for _, addon := range s.scope.Addons() {
+		condition := ekscontrolplanev1.EKSAddonHealthyCondition(addon.Name)
+		addonConditions = append(addonConditions, condition)
+
+		state, ok := states[addon.Name]
+		if !ok {
+			waitingFor := []string{}
+			for _, dependency := range addon.DependsOn {
+				if dependencyState, ok := states[dependency]; !ok || !isAddonInstalled(dependencyState) {
+					waitingFor = append(waitingFor, dependency)
+				}
+			}
+			if len(waitingFor) > 0 {
+				conditions.MarkFalse(controlPlane, condition, ekscontrolplanev1.EKSAddonWaitingForDependenciesReason, clusterv1.ConditionSeverityInfo, "waiting for addons %s", strings.Join(waitingFor, ", "))
+			} else {
+				conditions.MarkFalse(controlPlane, condition, ekscontrolplanev1.EKSAddonNotInstalledReason, clusterv1.ConditionSeverityInfo, "")
+			}
+			continue
+		}
+
+		status := aws.ToString(state.Status)
+		switch ekstypes.AddonStatus(status) {
+		case ekstypes.AddonStatusActive:
+			conditions.MarkTrue(controlPlane, condition)
+		case ekstypes.AddonStatusDegraded:
+			conditions.MarkFalse(controlPlane, condition, ekscontrolplanev1.EKSAddonDegradedReason, clusterv1.ConditionSeverityWarning, "%s", addonIssuesMessage(state))
+		case ekstypes.AddonStatusCreateFailed, ekstypes.AddonStatusUpdateFailed, ekstypes.AddonStatusDeleteFailed:
+			conditions.MarkFalse(controlPlane, condition, ekscontrolplanev1.EKSAddonFailedReason, clusterv1.ConditionSeverityError, "addon is %s: %s", status, addonIssuesMessage(state))
+		default:
+			conditions.MarkFalse(controlPlane, condition, ekscontrolplanev1.EKSAddonProgressingReason, clusterv1.ConditionSeverityInfo, "addon is %s", status)
+		}
+	}
+
+	// Remove the conditions of the addons that are not desired anymore
+	staleConditions := []clusterv1.ConditionType{}
+	for _, condition := range controlPlane.GetConditions() {
+		if strings.HasPrefix(string(condition.Type), ekscontrolplanev1.EKSAddonHealthyConditionPrefix) && !slices.Contains(addonConditions, condition.Type) {
+			staleConditions = append(staleConditions, condition.Type)
+		}
+	}
+	for _, condition := range staleConditions {
+		conditions.Delete(controlPlane, condition)
+	}
+
+	if len(addonConditions) == 0 {
+		conditions.Delete(controlPlane, ekscontrolplanev1.EKSAddonsHealthyCondition)
+		return
+	}
+	infrautilconditions.SetSummaryCondition(controlPlane, ekscontrolplanev1.EKSAddonsHealthyCondition, conditions.WithConditions(addonConditions...), conditions.WithStepCounter())
+}
+
+// isAddonInstalled returns whether an addon can be depended on, i.e. whether it is active or
+// degraded as addons are until there are worker nodes.
+func isAddonInstalled(state ekscontrolplanev1.AddonState) bool {
+	status := ekstypes.AddonStatus(aws.ToString(state.Status))
+	return status == ekstypes.AddonStatusActive || status == ekstypes.AddonStatusDegraded
+}
+
+func addonIssuesMessage(state ekscontrolplanev1.AddonState) string {
+	messages := []string{}
+	for _, issue := range state.Issues {
+		messages = append(messages, fmt.Sprintf("%s: %s", aws.ToString(issue.Code), aws.ToString(issue.Message)))
+	}
+	return strings.Join(messages, ", ")
+}
+
 // WaitUntilAddonDeleted is blocking function to wait until EKS Addon is Deleted.
 func (k *EKSClient) WaitUntilAddonDeleted(ctx context.Context, input *eks.DescribeAddonInput, maxWait time.Duration) error {
 	waiter := eks.NewAddonDeletedWaiter(k, func(o *eks.AddonDeletedWaiterOptions) {
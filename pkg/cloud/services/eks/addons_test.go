@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/gomega"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileAddonConditions(t *testing.T) {
+	addons := []ekscontrolplanev1.Addon{
+		{Name: "vpc-cni"},
+		{Name: "coredns", DependsOn: []string{"vpc-cni"}},
+	}
+
+	testCases := []struct {
+		name             string
+		addonState       []ekscontrolplanev1.AddonState
+		expectVpcCni     *clusterv1.Condition
+		expectCoreDNS    *clusterv1.Condition
+		expectAllHealthy bool
+	}{
+		{
+			name: "all addons active",
+			addonState: []ekscontrolplanev1.AddonState{
+				{Name: "vpc-cni", Status: aws.String(string(ekstypes.AddonStatusActive))},
+				{Name: "coredns", Status: aws.String(string(ekstypes.AddonStatusActive))},
+			},
+			expectVpcCni:     conditions.TrueCondition(ekscontrolplanev1.EKSAddonHealthyCondition("vpc-cni")),
+			expectCoreDNS:    conditions.TrueCondition(ekscontrolplanev1.EKSAddonHealthyCondition("coredns")),
+			expectAllHealthy: true,
+		},
+		{
+			name: "dependency still creating",
+			addonState: []ekscontrolplanev1.AddonState{
+				{Name: "vpc-cni", Status: aws.String(string(ekstypes.AddonStatusCreating))},
+			},
+			expectVpcCni:  conditions.FalseCondition(ekscontrolplanev1.EKSAddonHealthyCondition("vpc-cni"), ekscontrolplanev1.EKSAddonProgressingReason, clusterv1.ConditionSeverityInfo, "addon is CREATING"),
+			expectCoreDNS: conditions.FalseCondition(ekscontrolplanev1.EKSAddonHealthyCondition("coredns"), ekscontrolplanev1.EKSAddonWaitingForDependenciesReason, clusterv1.ConditionSeverityInfo, "waiting for addons vpc-cni"),
+		},
+		{
+			name: "dependency failed",
+			addonState: []ekscontrolplanev1.AddonState{
+				{
+					Name:   "vpc-cni",
+					Status: aws.String(string(ekstypes.AddonStatusCreateFailed)),
+					Issues: []ekscontrolplanev1.AddonIssue{{Code: aws.String("ConfigurationConflict"), Message: aws.String("conflicts found")}},
+				},
+			},
+			expectVpcCni:  conditions.FalseCondition(ekscontrolplanev1.EKSAddonHealthyCondition("vpc-cni"), ekscontrolplanev1.EKSAddonFailedReason, clusterv1.ConditionSeverityError, "addon is CREATE_FAILED: ConfigurationConflict: conflicts found"),
+			expectCoreDNS: conditions.FalseCondition(ekscontrolplanev1.EKSAddonHealthyCondition("coredns"), ekscontrolplanev1.EKSAddonWaitingForDependenciesReason, clusterv1.ConditionSeverityInfo, "waiting for addons vpc-cni"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{Addons: &addons},
+			}
+			// A condition of an addon that is not desired anymore.
+			conditions.MarkTrue(controlPlane, ekscontrolplanev1.EKSAddonHealthyCondition("kube-proxy"))
+
+			s := &Service{scope: &scope.ManagedControlPlaneScope{ControlPlane: controlPlane}}
+			s.reconcileAddonConditions(tc.addonState)
+
+			g.Expect(*conditions.Get(controlPlane, ekscontrolplanev1.EKSAddonHealthyCondition("vpc-cni"))).To(conditions.MatchCondition(*tc.expectVpcCni))
+			g.Expect(*conditions.Get(controlPlane, ekscontrolplanev1.EKSAddonHealthyCondition("coredns"))).To(conditions.MatchCondition(*tc.expectCoreDNS))
+			g.Expect(conditions.Has(controlPlane, ekscontrolplanev1.EKSAddonHealthyCondition("kube-proxy"))).To(BeFalse())
+			g.Expect(conditions.IsTrue(controlPlane, ekscontrolplanev1.EKSAddonsHealthyCondition)).To(Equal(tc.expectAllHealthy))
+		})
+	}
+}
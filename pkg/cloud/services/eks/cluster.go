@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -316,7 +317,7 @@ func makeKubernetesNetworkConfig(serviceCidrs *clusterv1.NetworkRanges) (*ekstyp
 	}, nil
 }
 
-func makeVpcConfig(subnets infrav1.Subnets, endpointAccess ekscontrolplanev1.EndpointAccess, securityGroups map[infrav1.SecurityGroupRole]infrav1.SecurityGroup) (*ekstypes.VpcConfigRequest, error) {
+func makeVpcConfig(subnets infrav1.Subnets, endpointAccess ekscontrolplanev1.EndpointAccess, securityGroups map[infrav1.SecurityGroupRole]infrav1.SecurityGroup, additionalSecurityGroupIDs []string) (*ekstypes.VpcConfigRequest, error) {
 	// TODO: Do we need to just add the private subnets?
 	if len(subnets) < 2 {
 		return nil, awserrors.NewFailedDependency("at least 2 subnets is required")
@@ -356,6 +357,11 @@ func makeVpcConfig(subnets infrav1.Subnets, endpointAccess ekscontrolplanev1.End
 	if ok {
 		vpcConfig.SecurityGroupIds = append(vpcConfig.SecurityGroupIds, sg.ID)
 	}
+	for _, id := range additionalSecurityGroupIDs {
+		if !slices.Contains(vpcConfig.SecurityGroupIds, id) {
+			vpcConfig.SecurityGroupIds = append(vpcConfig.SecurityGroupIds, id)
+		}
+	}
 	return vpcConfig, nil
 }
 
@@ -414,9 +420,9 @@ func (s *Service) createCluster(ctx context.Context, eksClusterName string) (*ek
 	encryptionConfigs := makeEksEncryptionConfigs(s.scope.ControlPlane.Spec.EncryptionConfig)
 	if s.scope.ControlPlane.Spec.RestrictPrivateSubnets {
 		s.scope.Info("Filtering private subnets")
-		vpcConfig, err = makeVpcConfig(s.scope.Subnets().FilterPrivate(), s.scope.ControlPlane.Spec.EndpointAccess, s.scope.SecurityGroups())
+		vpcConfig, err = makeVpcConfig(s.scope.Subnets().FilterPrivate(), s.scope.ControlPlane.Spec.EndpointAccess, s.scope.SecurityGroups(), s.scope.ControlPlane.Spec.AdditionalSecurityGroupIDs)
 	} else {
-		vpcConfig, err = makeVpcConfig(s.scope.Subnets(), s.scope.ControlPlane.Spec.EndpointAccess, s.scope.SecurityGroups())
+		vpcConfig, err = makeVpcConfig(s.scope.Subnets(), s.scope.ControlPlane.Spec.EndpointAccess, s.scope.SecurityGroups(), s.scope.ControlPlane.Spec.AdditionalSecurityGroupIDs)
 	}
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't create vpc config for cluster")
@@ -609,9 +615,9 @@ func (s *Service) reconcileVpcConfig(vpcConfig *ekstypes.VpcConfigResponse) (*ek
 	)
 	endpointAccess := s.scope.ControlPlane.Spec.EndpointAccess
 	if s.scope.ControlPlane.Spec.RestrictPrivateSubnets {
-		updatedVpcConfig, err = makeVpcConfig(s.scope.Subnets().FilterPrivate(), endpointAccess, s.scope.SecurityGroups())
+		updatedVpcConfig, err = makeVpcConfig(s.scope.Subnets().FilterPrivate(), endpointAccess, s.scope.SecurityGroups(), s.scope.ControlPlane.Spec.AdditionalSecurityGroupIDs)
 	} else {
-		updatedVpcConfig, err = makeVpcConfig(s.scope.Subnets(), endpointAccess, s.scope.SecurityGroups())
+		updatedVpcConfig, err = makeVpcConfig(s.scope.Subnets(), endpointAccess, s.scope.SecurityGroups(), s.scope.ControlPlane.Spec.AdditionalSecurityGroupIDs)
 	}
 	if err != nil {
 		return nil, err
@@ -626,6 +632,15 @@ func (s *Service) reconcileVpcConfig(vpcConfig *ekstypes.VpcConfigResponse) (*ek
 			PublicAccessCidrs:     updatedVpcConfig.PublicAccessCidrs,
 		}, nil
 	}
+	// Security groups are updated on their own, once the endpoint access is up to date. They are
+	// only managed when additional security groups are requested, leaving the ones attached by
+	// other means untouched.
+	if len(s.scope.ControlPlane.Spec.AdditionalSecurityGroupIDs) > 0 &&
+		!sets.NewString(vpcConfig.SecurityGroupIds...).Equal(sets.NewString(updatedVpcConfig.SecurityGroupIds...)) {
+		return &ekstypes.VpcConfigRequest{
+			SecurityGroupIds: updatedVpcConfig.SecurityGroupIds,
+		}, nil
+	}
 	return nil, nil
 }
 
@@ -143,6 +143,8 @@ func TestMakeVPCConfig(t *testing.T) {
 		subnets        infrav1.Subnets
 		endpointAccess ekscontrolplanev1.EndpointAccess
 		securityGroups map[infrav1.SecurityGroupRole]infrav1.SecurityGroup
+
+		additionalSecurityGroupIDs []string
 	}
 
 	idOne := "one"
@@ -241,6 +243,36 @@ func TestMakeVPCConfig(t *testing.T) {
 				SecurityGroupIds: []string{idOne},
 			},
 		},
+		{
+			name: "additional security groups",
+			input: input{
+				subnets: []infrav1.SubnetSpec{
+					{
+						ID:               idOne,
+						CidrBlock:        "10.0.10.0/24",
+						AvailabilityZone: "us-west-2a",
+						IsPublic:         true,
+					},
+					{
+						ID:               idTwo,
+						CidrBlock:        "10.0.10.0/24",
+						AvailabilityZone: "us-west-2b",
+						IsPublic:         false,
+					},
+				},
+				endpointAccess: ekscontrolplanev1.EndpointAccess{},
+				securityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+					infrav1.SecurityGroupEKSNodeAdditional: {
+						ID: idOne,
+					},
+				},
+				additionalSecurityGroupIDs: []string{"sg-byo", idOne},
+			},
+			expect: &ekstypes.VpcConfigRequest{
+				SubnetIds:        []string{idOne, idTwo},
+				SecurityGroupIds: []string{idOne, "sg-byo"},
+			},
+		},
 		{
 			name: "non canonical public access CIDR",
 			input: input{
@@ -271,7 +303,7 @@ func TestMakeVPCConfig(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			g := NewWithT(t)
-			config, err := makeVpcConfig(tc.input.subnets, tc.input.endpointAccess, tc.input.securityGroups)
+			config, err := makeVpcConfig(tc.input.subnets, tc.input.endpointAccess, tc.input.securityGroups, tc.input.additionalSecurityGroupIDs)
 			if tc.err {
 				g.Expect(err).To(HaveOccurred())
 			} else {
@@ -474,6 +506,114 @@ func TestReconcileClusterVersion(t *testing.T) {
 	}
 }
 
+func TestReconcileVpcConfig(t *testing.T) {
+	subnets := infrav1.Subnets{
+		{
+			ID:               "subnet-1",
+			CidrBlock:        "10.0.10.0/24",
+			AvailabilityZone: "us-west-2a",
+		},
+		{
+			ID:               "subnet-2",
+			CidrBlock:        "10.0.11.0/24",
+			AvailabilityZone: "us-west-2b",
+		},
+	}
+	tests := []struct {
+		name                       string
+		additionalSecurityGroupIDs []string
+		current                    *ekstypes.VpcConfigResponse
+		expect                     *ekstypes.VpcConfigRequest
+	}{
+		{
+			name: "no additional security groups leaves attached security groups untouched",
+			current: &ekstypes.VpcConfigResponse{
+				EndpointPublicAccess: true,
+				SecurityGroupIds:     []string{"sg-node", "sg-attached"},
+			},
+			expect: nil,
+		},
+		{
+			name:                       "additional security groups are attached",
+			additionalSecurityGroupIDs: []string{"sg-byo"},
+			current: &ekstypes.VpcConfigResponse{
+				EndpointPublicAccess: true,
+				SecurityGroupIds:     []string{"sg-node"},
+			},
+			expect: &ekstypes.VpcConfigRequest{
+				SecurityGroupIds: []string{"sg-node", "sg-byo"},
+			},
+		},
+		{
+			name:                       "additional security groups already attached",
+			additionalSecurityGroupIDs: []string{"sg-byo"},
+			current: &ekstypes.VpcConfigResponse{
+				EndpointPublicAccess: true,
+				SecurityGroupIds:     []string{"sg-byo", "sg-node"},
+			},
+			expect: nil,
+		},
+		{
+			name:                       "endpoint access is updated before security groups",
+			additionalSecurityGroupIDs: []string{"sg-byo"},
+			current: &ekstypes.VpcConfigResponse{
+				EndpointPublicAccess: false,
+				SecurityGroupIds:     []string{"sg-node"},
+			},
+			expect: &ekstypes.VpcConfigRequest{
+				EndpointPublicAccess: aws.Bool(true),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "default.cluster",
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							Subnets: subnets,
+						},
+						EndpointAccess: ekscontrolplanev1.EndpointAccess{
+							Public: aws.Bool(true),
+						},
+						AdditionalSecurityGroupIDs: tc.additionalSecurityGroupIDs,
+					},
+					Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+						Network: infrav1.NetworkStatus{
+							SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+								infrav1.SecurityGroupEKSNodeAdditional: {
+									ID: "sg-node",
+								},
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			s := NewService(scope)
+
+			update, err := s.reconcileVpcConfig(tc.current)
+			g.Expect(err).To(BeNil())
+			g.Expect(update).To(Equal(tc.expect))
+		})
+	}
+}
+
 func TestCreateCluster(t *testing.T) {
 	clusterName := "cluster.default"
 	version := aws.String("1.24")
@@ -19,6 +19,7 @@ package eks
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -230,12 +231,9 @@ func (s *FargateService) createFargateProfile(ctx context.Context) (*ekstypes.Fa
 
 	tags := ngTags(s.scope.ClusterName(), additionalTags)
 
-	subnets := s.scope.FargateProfile.Spec.SubnetIDs
-	if len(subnets) == 0 {
-		subnets = []string{}
-		for _, s := range s.scope.ControlPlane.Spec.NetworkSpec.Subnets.FilterPrivate() {
-			subnets = append(subnets, s.ID)
-		}
+	subnets, err := s.subnets()
+	if err != nil {
+		return nil, err
 	}
 
 	selectors := []ekstypes.FargateProfileSelector{}
@@ -263,6 +261,42 @@ func (s *FargateService) createFargateProfile(ctx context.Context) (*ekstypes.Fa
 	return out.FargateProfile, nil
 }
 
+// subnets returns the subnets of the profile: the subnets listed in the spec, which cannot be
+// public subnets of the cluster, or the private subnets of the cluster matching the subnet selector.
+func (s *FargateService) subnets() ([]string, error) {
+	spec := s.scope.FargateProfile.Spec
+	clusterSubnets := s.scope.ControlPlane.Spec.NetworkSpec.Subnets
+
+	if len(spec.SubnetIDs) > 0 {
+		for _, id := range spec.SubnetIDs {
+			if subnet := clusterSubnets.FindByID(id); subnet != nil && subnet.IsPublic {
+				return nil, errors.Errorf("subnet %s is public, fargate profiles only support private subnets", id)
+			}
+		}
+		return spec.SubnetIDs, nil
+	}
+
+	subnets := []string{}
+	selector := spec.SubnetSelector
+	for _, subnet := range clusterSubnets.FilterPrivate() {
+		if selector != nil {
+			if len(selector.AvailabilityZones) > 0 && !slices.Contains(selector.AvailabilityZones, subnet.AvailabilityZone) {
+				continue
+			}
+			if len(selector.Tags.Difference(subnet.Tags)) > 0 {
+				continue
+			}
+		}
+		subnets = append(subnets, subnet.GetResourceID())
+	}
+
+	if selector != nil && len(subnets) == 0 {
+		return nil, errors.New("no private subnet of the cluster matches the subnet selector")
+	}
+
+	return subnets, nil
+}
+
 func (s *FargateService) deleteFargateProfile(ctx context.Context) (requeue bool, err error) {
 	eksClusterName := s.scope.KubernetesClusterName()
 	profileName := s.scope.FargateProfile.Spec.ProfileName
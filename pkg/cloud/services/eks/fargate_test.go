@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+func TestFargateProfileSubnets(t *testing.T) {
+	clusterSubnets := infrav1.Subnets{
+		{ID: "subnet-public-a", AvailabilityZone: "us-east-1a", IsPublic: true},
+		{ID: "subnet-private-a", AvailabilityZone: "us-east-1a", Tags: infrav1.Tags{"tier": "fargate"}},
+		{ID: "subnet-private-b", AvailabilityZone: "us-east-1b", Tags: infrav1.Tags{"tier": "fargate"}},
+		{ID: "subnet-private-c", AvailabilityZone: "us-east-1c"},
+	}
+
+	testCases := []struct {
+		name          string
+		spec          expinfrav1.FargateProfileSpec
+		expectSubnets []string
+		expectErr     bool
+	}{
+		{
+			name:          "all private subnets by default",
+			expectSubnets: []string{"subnet-private-a", "subnet-private-b", "subnet-private-c"},
+		},
+		{
+			name:          "explicit subnets",
+			spec:          expinfrav1.FargateProfileSpec{SubnetIDs: []string{"subnet-private-c", "subnet-external"}},
+			expectSubnets: []string{"subnet-private-c", "subnet-external"},
+		},
+		{
+			name:      "explicit public subnet",
+			spec:      expinfrav1.FargateProfileSpec{SubnetIDs: []string{"subnet-public-a"}},
+			expectErr: true,
+		},
+		{
+			name: "selector by availability zones",
+			spec: expinfrav1.FargateProfileSpec{SubnetSelector: &expinfrav1.FargateSubnetSelector{
+				AvailabilityZones: []string{"us-east-1a", "us-east-1c"},
+			}},
+			expectSubnets: []string{"subnet-private-a", "subnet-private-c"},
+		},
+		{
+			name: "selector by availability zones and tags",
+			spec: expinfrav1.FargateProfileSpec{SubnetSelector: &expinfrav1.FargateSubnetSelector{
+				AvailabilityZones: []string{"us-east-1b", "us-east-1c"},
+				Tags:              infrav1.Tags{"tier": "fargate"},
+			}},
+			expectSubnets: []string{"subnet-private-b"},
+		},
+		{
+			name: "selector matching no private subnet",
+			spec: expinfrav1.FargateProfileSpec{SubnetSelector: &expinfrav1.FargateSubnetSelector{
+				Tags: infrav1.Tags{"tier": "public"},
+			}},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s := &FargateService{scope: &scope.FargateProfileScope{
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						NetworkSpec: infrav1.NetworkSpec{Subnets: clusterSubnets},
+					},
+				},
+				FargateProfile: &expinfrav1.AWSFargateProfile{Spec: tc.spec},
+			}}
+
+			subnets, err := s.subnets()
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(subnets).To(Equal(tc.expectSubnets))
+		})
+	}
+}
@@ -19,6 +19,10 @@ package eks
 import (
 	"context"
 	"fmt"
+	"maps"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -29,7 +33,9 @@ import (
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
@@ -40,6 +46,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func (s *NodegroupService) describeNodegroup(ctx context.Context) (*ekstypes.Nodegroup, error) {
@@ -139,7 +146,8 @@ func ngTags(key string, additionalTags infrav1.Tags) map[string]string {
 
 func (s *NodegroupService) remoteAccess() (*ekstypes.RemoteAccessConfig, error) {
 	pool := s.scope.ManagedMachinePool.Spec
-	if pool.RemoteAccess == nil {
+	// The machines of an SSM only pool have no SSH access at all, not even with the key of the control plane.
+	if pool.RemoteAccess == nil || pool.RemoteAccess.IsSSMOnly() {
 		return nil, nil
 	}
 
@@ -309,6 +317,11 @@ func (s *NodegroupService) deleteNodegroupAndWait(ctx context.Context) (reterr e
 }
 
 func (s *NodegroupService) reconcileNodegroupVersion(ctx context.Context, ng *ekstypes.Nodegroup) error {
+	canStartUpdate, err := s.reconcileLastUpdate(ctx, ng)
+	if err != nil {
+		return err
+	}
+
 	var specVersion *version.Version
 	if s.scope.Version() != nil {
 		var err error
@@ -333,9 +346,16 @@ func (s *NodegroupService) reconcileNodegroupVersion(ctx context.Context, ng *ek
 
 	eksClusterName := s.scope.KubernetesClusterName()
 	if (specVersion != nil && ngVersion.LessThan(specVersion)) || (specAMI != nil && *specAMI != ngAMI) || (statusLaunchTemplateVersion != nil && *statusLaunchTemplateVersion != *ngLaunchTemplateVersion) {
+		if !canStartUpdate {
+			s.scope.Info("Waiting for the last update of the nodegroup to complete before updating it", "nodegroup", s.scope.NodegroupName())
+			return nil
+		}
+
+		managedPool := s.scope.ManagedMachinePool
 		input := &eks.UpdateNodegroupVersionInput{
 			ClusterName:   aws.String(eksClusterName),
 			NodegroupName: aws.String(s.scope.NodegroupName()),
+			Force:         managedPool.Spec.UpdateConfig != nil && managedPool.Spec.UpdateConfig.Force,
 		}
 
 		var updateMsg string
@@ -357,20 +377,120 @@ func (s *NodegroupService) reconcileNodegroupVersion(ctx context.Context, ng *ek
 			updateMsg = fmt.Sprintf("to AMI version %s", *input.ReleaseVersion)
 		}
 
+		var update *ekstypes.Update
 		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-			if _, err := s.EKSClient.UpdateNodegroupVersion(ctx, input); err != nil {
+			out, err := s.EKSClient.UpdateNodegroupVersion(ctx, input)
+			if err != nil {
 				return false, err
 			}
+			update = out.Update
 			record.Eventf(s.scope.ManagedMachinePool, "SuccessfulUpdateEKSNodegroup", "Updated EKS nodegroup %s %s", eksClusterName, updateMsg)
 			return true, nil
 		}); err != nil {
 			record.Warnf(s.scope.ManagedMachinePool, "FailedUpdateEKSNodegroup", "failed to update the EKS nodegroup %s %s: %v", eksClusterName, updateMsg, err)
 			return errors.Wrapf(err, "failed to update EKS nodegroup")
 		}
+
+		if update != nil {
+			managedPool.Status.LastUpdate = &expinfrav1.NodegroupVersionUpdate{
+				ID:          aws.ToString(update.Id),
+				Description: updateMsg,
+				Status:      string(update.Status),
+				Generation:  managedPool.Generation,
+			}
+			conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupUpdatedCondition, expinfrav1.EKSNodegroupUpdatingReason, clusterv1.ConditionSeverityInfo, "updating %s", updateMsg)
+		}
 	}
 	return nil
 }
 
+// reconcileLastUpdate follows the last version update of the nodegroup and reports it in the EKSNodegroupUpdated
+// condition, with the health issues of the nodegroup. It returns false if no other version update can start yet:
+// while the last update is in progress, once it failed until the spec of the AWSManagedMachinePool changes, or
+// until the nodegroup stayed healthy for the soak duration after it.
+func (s *NodegroupService) reconcileLastUpdate(ctx context.Context, ng *ekstypes.Nodegroup) (bool, error) {
+	managedPool := s.scope.ManagedMachinePool
+	lastUpdate := managedPool.Status.LastUpdate
+	if lastUpdate == nil {
+		conditions.MarkTrue(managedPool, expinfrav1.EKSNodegroupUpdatedCondition)
+		return true, nil
+	}
+
+	if ekstypes.UpdateStatus(lastUpdate.Status) == ekstypes.UpdateStatusInProgress {
+		out, err := s.EKSClient.DescribeUpdate(ctx, &eks.DescribeUpdateInput{
+			Name:          aws.String(s.scope.KubernetesClusterName()),
+			NodegroupName: aws.String(s.scope.NodegroupName()),
+			UpdateId:      aws.String(lastUpdate.ID),
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to describe update %s of EKS nodegroup", lastUpdate.ID)
+		}
+		lastUpdate.Status = string(out.Update.Status)
+		switch out.Update.Status {
+		case ekstypes.UpdateStatusSuccessful:
+			lastUpdate.CompletedAt = ptr.To(metav1.Now())
+		case ekstypes.UpdateStatusFailed, ekstypes.UpdateStatusCancelled:
+			lastUpdate.Message = updateErrorsMessage(out.Update.Errors)
+			record.Warnf(managedPool, "FailedEKSNodegroupUpdate", "Update of EKS nodegroup %s %s is %s: %s", s.scope.NodegroupName(), lastUpdate.Description, out.Update.Status, lastUpdate.Message)
+		}
+	}
+
+	healthIssues := nodegroupHealthIssuesMessage(ng.Health)
+	switch ekstypes.UpdateStatus(lastUpdate.Status) {
+	case ekstypes.UpdateStatusInProgress:
+		conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupUpdatedCondition, expinfrav1.EKSNodegroupUpdatingReason, clusterv1.ConditionSeverityInfo, "updating %s", lastUpdate.Description)
+		return false, nil
+	case ekstypes.UpdateStatusFailed, ekstypes.UpdateStatusCancelled:
+		msg := fmt.Sprintf("update %s is %s: %s", lastUpdate.Description, lastUpdate.Status, lastUpdate.Message)
+		if healthIssues != "" {
+			msg = fmt.Sprintf("%s; nodegroup health issues: %s", msg, healthIssues)
+		}
+		conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupUpdatedCondition, expinfrav1.EKSNodegroupUpdateFailedReason, clusterv1.ConditionSeverityError, "%s", msg)
+		return managedPool.Generation != lastUpdate.Generation, nil
+	}
+
+	if healthIssues != "" {
+		conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupUpdatedCondition, expinfrav1.EKSNodegroupUnhealthyReason, clusterv1.ConditionSeverityWarning, "nodegroup health issues after update %s: %s", lastUpdate.Description, healthIssues)
+		return false, nil
+	}
+
+	if updateConfig := managedPool.Spec.UpdateConfig; updateConfig != nil && updateConfig.SoakDuration != nil && lastUpdate.CompletedAt != nil {
+		if soakEnd := lastUpdate.CompletedAt.Add(updateConfig.SoakDuration.Duration); time.Now().Before(soakEnd) {
+			conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupUpdatedCondition, expinfrav1.EKSNodegroupSoakingReason, clusterv1.ConditionSeverityInfo, "soaking until %s after update %s", soakEnd.UTC().Format(time.RFC3339), lastUpdate.Description)
+			return false, nil
+		}
+	}
+
+	conditions.MarkTrue(managedPool, expinfrav1.EKSNodegroupUpdatedCondition)
+	return true, nil
+}
+
+// updateErrorsMessage returns the errors of an EKS update as a message.
+func updateErrorsMessage(updateErrors []ekstypes.ErrorDetail) string {
+	msgs := make([]string, 0, len(updateErrors))
+	for _, updateError := range updateErrors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", updateError.ErrorCode, aws.ToString(updateError.ErrorMessage)))
+	}
+	return strings.Join(msgs, ", ")
+}
+
+// nodegroupHealthIssuesMessage returns the health issues of an EKS nodegroup as a message, or an empty
+// string if the nodegroup is healthy.
+func nodegroupHealthIssuesMessage(health *ekstypes.NodegroupHealth) string {
+	if health == nil {
+		return ""
+	}
+	msgs := make([]string, 0, len(health.Issues))
+	for _, issue := range health.Issues {
+		msg := fmt.Sprintf("%s: %s", issue.Code, aws.ToString(issue.Message))
+		if len(issue.ResourceIds) > 0 {
+			msg = fmt.Sprintf("%s (%s)", msg, strings.Join(issue.ResourceIds, ", "))
+		}
+		msgs = append(msgs, msg)
+	}
+	return strings.Join(msgs, ", ")
+}
+
 func createLabelUpdate(specLabels map[string]string, ng *ekstypes.Nodegroup) *ekstypes.UpdateLabelsPayload {
 	current := ng.Labels
 	payload := ekstypes.UpdateLabelsPayload{
@@ -428,6 +548,41 @@ func (s *NodegroupService) createTaintsUpdate(specTaints expinfrav1.Taints, ng *
 	return nil, nil
 }
 
+// nodegroupConfigDrift returns the labels and taints of the nodegroup which were changed outside of the
+// AWSManagedMachinePool since its configuration was last applied.
+func nodegroupConfigDrift(applied *expinfrav1.AppliedNodegroupConfig, ng *ekstypes.Nodegroup) ([]string, error) {
+	if applied == nil {
+		return nil, nil
+	}
+	var drift []string
+	for k, v := range ng.Labels {
+		if appliedV, ok := applied.Labels[k]; !ok || v != appliedV {
+			drift = append(drift, "label "+k)
+		}
+	}
+	for k := range applied.Labels {
+		if _, ok := ng.Labels[k]; !ok {
+			drift = append(drift, "label "+k)
+		}
+	}
+	current, err := converters.TaintsFromSDK(ng.Taints)
+	if err != nil {
+		return nil, fmt.Errorf("converting taints: %w", err)
+	}
+	for i := range current {
+		if !applied.Taints.Contains(&current[i]) {
+			drift = append(drift, "taint "+current[i].Key)
+		}
+	}
+	for i := range applied.Taints {
+		if !current.Contains(&applied.Taints[i]) {
+			drift = append(drift, "taint "+applied.Taints[i].Key)
+		}
+	}
+	sort.Strings(drift)
+	return drift, nil
+}
+
 func (s *NodegroupService) reconcileNodegroupConfig(ctx context.Context, ng *ekstypes.Nodegroup) error {
 	eksClusterName := s.scope.KubernetesClusterName()
 	s.Debug("reconciling node group config", "cluster", eksClusterName, "name", *ng.NodegroupName)
@@ -452,6 +607,20 @@ func (s *NodegroupService) reconcileNodegroupConfig(ctx context.Context, ng *eks
 		input.Taints = taintsPayload
 		needsUpdate = true
 	}
+	if input.Labels != nil || input.Taints != nil {
+		drift, err := nodegroupConfigDrift(s.scope.ManagedMachinePool.Status.AppliedConfig, ng)
+		if err != nil {
+			return err
+		}
+		if len(drift) > 0 {
+			driftMsg := strings.Join(drift, ", ")
+			s.Info("Nodegroup labels or taints were changed outside of the AWSManagedMachinePool, reverting them", "nodegroup", ng.NodegroupName, "drift", driftMsg)
+			record.Warnf(s.scope.ManagedMachinePool, "EKSNodegroupConfigDrifted", "Reverting the changes made outside of the AWSManagedMachinePool to the %s of EKS nodegroup %s", driftMsg, *ng.NodegroupName)
+			conditions.MarkFalse(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupConfigInSyncCondition, expinfrav1.EKSNodegroupConfigDriftedReason, clusterv1.ConditionSeverityWarning, "%s changed outside of the AWSManagedMachinePool", driftMsg)
+		}
+	} else {
+		conditions.MarkTrue(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupConfigInSyncCondition)
+	}
 	if machinePool := s.scope.MachinePool.Spec; machinePool.Replicas == nil {
 		if ng.ScalingConfig.DesiredSize != nil && *ng.ScalingConfig.DesiredSize != 1 {
 			s.Debug("Nodegroup desired size differs from spec, updating scaling configuration", "nodegroup", ng.NodegroupName)
@@ -475,13 +644,15 @@ func (s *NodegroupService) reconcileNodegroupConfig(ctx context.Context, ng *eks
 		return errors.Wrap(err, "invalid update config")
 	}
 
-	if !cmp.Equal(managedPool.UpdateConfig, currentUpdateConfig) {
+	// Only the unavailability settings of the update config are part of the nodegroup configuration.
+	if !cmp.Equal(converters.NodegroupUpdateconfigFromSDK(updatedConfig), currentUpdateConfig) {
 		s.Debug("Nodegroup update configuration differs from spec, updating the nodegroup update config", "nodegroup", ng.NodegroupName)
 		input.UpdateConfig = updatedConfig
 		needsUpdate = true
 	}
 	if !needsUpdate {
 		s.Debug("node group config update not needed", "cluster", eksClusterName, "name", *ng.NodegroupName)
+		s.setAppliedConfig()
 		return nil
 	}
 
@@ -489,10 +660,20 @@ func (s *NodegroupService) reconcileNodegroupConfig(ctx context.Context, ng *eks
 	if err != nil {
 		return errors.Wrap(err, "failed to update nodegroup config")
 	}
+	s.setAppliedConfig()
 
 	return nil
 }
 
+// setAppliedConfig records the labels and taints of the spec as the ones applied to the nodegroup.
+func (s *NodegroupService) setAppliedConfig() {
+	managedPool := s.scope.ManagedMachinePool
+	managedPool.Status.AppliedConfig = &expinfrav1.AppliedNodegroupConfig{
+		Labels: maps.Clone(managedPool.Spec.Labels),
+		Taints: managedPool.Spec.Taints.DeepCopy(),
+	}
+}
+
 func (s *NodegroupService) reconcileNodegroup(ctx context.Context) error {
 	ng, err := s.describeNodegroup(ctx)
 	if err != nil {
@@ -512,6 +693,7 @@ func (s *NodegroupService) reconcileNodegroup(ctx context.Context) error {
 			return errors.Errorf("owner of %s mismatch: %s", eksNodegroupName, s.scope.ClusterName())
 		}
 		s.scope.Debug("Found owned EKS nodegroup in AWS", "cluster-name", eksClusterName, "nodegroup-name", eksNodegroupName)
+		s.reportRemoteAccessDrift(ng)
 	}
 
 	if err := s.setStatus(ctx, ng); err != nil {
@@ -543,6 +725,10 @@ func (s *NodegroupService) reconcileNodegroup(ctx context.Context) error {
 		return errors.Wrap(err, "failed to wait for nodegroup to be active")
 	}
 
+	if err := s.reconcileAutoscalerAnnotations(ctx); err != nil {
+		return errors.Wrap(err, "failed to reconcile cluster autoscaler annotations")
+	}
+
 	if err := s.reconcileNodegroupVersion(ctx, ng); err != nil {
 		return errors.Wrap(err, "failed to reconcile nodegroup version")
 	}
@@ -562,6 +748,39 @@ func (s *NodegroupService) reconcileNodegroup(ctx context.Context) error {
 	return nil
 }
 
+// reconcileAutoscalerAnnotations sets the node group size annotations of the cluster autoscaler on the MachinePool
+// from the scaling of the AWSManagedMachinePool when its cluster autoscaler tags are managed. The annotations are
+// left in place otherwise, as they can be set by users.
+func (s *NodegroupService) reconcileAutoscalerAnnotations(ctx context.Context) error {
+	scaling := s.scope.ManagedMachinePool.Spec.Scaling
+	if !scaling.IsAutoscalerTagsManaged() || scaling.MinSize == nil || scaling.MaxSize == nil {
+		return nil
+	}
+
+	machinePool := s.scope.MachinePool
+	minSize, maxSize := strconv.Itoa(int(*scaling.MinSize)), strconv.Itoa(int(*scaling.MaxSize))
+	if machinePool.Annotations[expinfrav1.AutoscalerMinSizeAnnotation] == minSize && machinePool.Annotations[expinfrav1.AutoscalerMaxSizeAnnotation] == maxSize {
+		return nil
+	}
+
+	s.scope.Info("Setting MachinePool cluster autoscaler annotations", "min-size", minSize, "max-size", maxSize)
+	annotations.AddAnnotations(machinePool, map[string]string{
+		expinfrav1.AutoscalerMinSizeAnnotation: minSize,
+		expinfrav1.AutoscalerMaxSizeAnnotation: maxSize,
+	})
+	return s.scope.PatchCAPIMachinePoolObject(ctx)
+}
+
+// reportRemoteAccessDrift reports the SSH access given to the machines of an SSM only pool outside of the
+// AWSManagedMachinePool. The remote access of a nodegroup cannot be updated, so it is not reverted.
+func (s *NodegroupService) reportRemoteAccessDrift(ng *ekstypes.Nodegroup) {
+	if !s.scope.ManagedMachinePool.Spec.RemoteAccess.IsSSMOnly() || ng.RemoteAccess == nil || aws.ToString(ng.RemoteAccess.Ec2SshKey) == "" {
+		return
+	}
+	s.scope.Info("EKS nodegroup of an SSM only pool has an SSH key", "nodegroup", ng.NodegroupName, "key", aws.ToString(ng.RemoteAccess.Ec2SshKey))
+	record.Warnf(s.scope.ManagedMachinePool, "EKSNodegroupRemoteAccessDrifted", "EKS nodegroup %s has SSH key %s although its remote access mode is SSMOnly, the nodegroup has to be replaced to remove it", *ng.NodegroupName, aws.ToString(ng.RemoteAccess.Ec2SshKey))
+}
+
 func (s *NodegroupService) setStatus(ctx context.Context, ng *ekstypes.Nodegroup) error {
 	managedPool := s.scope.ManagedMachinePool
 	switch ng.Status {
@@ -0,0 +1,310 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestNodegroupConfigDrift(t *testing.T) {
+	applied := &expinfrav1.AppliedNodegroupConfig{
+		Labels: map[string]string{"role": "worker", "team": "a"},
+		Taints: expinfrav1.Taints{{Key: "dedicated", Value: "gpu", Effect: expinfrav1.TaintEffectNoSchedule}},
+	}
+	appliedTaint := ekstypes.Taint{Key: aws.String("dedicated"), Value: aws.String("gpu"), Effect: ekstypes.TaintEffectNoSchedule}
+
+	testCases := []struct {
+		name        string
+		applied     *expinfrav1.AppliedNodegroupConfig
+		ng          *ekstypes.Nodegroup
+		expectDrift []string
+	}{
+		{
+			name:    "no configuration applied yet",
+			applied: nil,
+			ng: &ekstypes.Nodegroup{
+				Labels: map[string]string{"role": "other"},
+			},
+			expectDrift: nil,
+		},
+		{
+			name:    "nodegroup matches the applied configuration",
+			applied: applied,
+			ng: &ekstypes.Nodegroup{
+				Labels: map[string]string{"role": "worker", "team": "a"},
+				Taints: []ekstypes.Taint{appliedTaint},
+			},
+			expectDrift: nil,
+		},
+		{
+			name:    "labels added, changed and removed outside of the pool",
+			applied: applied,
+			ng: &ekstypes.Nodegroup{
+				Labels: map[string]string{"role": "other", "env": "prod"},
+				Taints: []ekstypes.Taint{appliedTaint},
+			},
+			expectDrift: []string{"label env", "label role", "label team"},
+		},
+		{
+			name:    "taints added and removed outside of the pool",
+			applied: applied,
+			ng: &ekstypes.Nodegroup{
+				Labels: map[string]string{"role": "worker", "team": "a"},
+				Taints: []ekstypes.Taint{{Key: aws.String("spot"), Value: aws.String("true"), Effect: ekstypes.TaintEffectNoExecute}},
+			},
+			expectDrift: []string{"taint dedicated", "taint spot"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			drift, err := nodegroupConfigDrift(tc.applied, tc.ng)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(drift).To(Equal(tc.expectDrift))
+		})
+	}
+}
+
+func TestNodegroupRemoteAccess(t *testing.T) {
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			SSHKeyName: aws.String("control-plane-key"),
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		remoteAccess *expinfrav1.ManagedRemoteAccess
+		expect       *ekstypes.RemoteAccessConfig
+	}{
+		{
+			name:         "no remote access",
+			remoteAccess: nil,
+			expect:       nil,
+		},
+		{
+			name:         "public SSH access with the key of the control plane",
+			remoteAccess: &expinfrav1.ManagedRemoteAccess{Public: true},
+			expect: &ekstypes.RemoteAccessConfig{
+				SourceSecurityGroups: []string{},
+				Ec2SshKey:            aws.String("control-plane-key"),
+			},
+		},
+		{
+			name:         "SSM only access has no SSH key",
+			remoteAccess: &expinfrav1.ManagedRemoteAccess{Mode: expinfrav1.ManagedRemoteAccessModeSSMOnly},
+			expect:       nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &NodegroupService{
+				scope: &scope.ManagedMachinePoolScope{
+					ControlPlane: controlPlane,
+					ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{
+						Spec: expinfrav1.AWSManagedMachinePoolSpec{RemoteAccess: tc.remoteAccess},
+					},
+				},
+			}
+			remoteAccess, err := s.remoteAccess()
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(remoteAccess).To(Equal(tc.expect))
+		})
+	}
+}
+
+func TestAutoscalerNodeTemplateTags(t *testing.T) {
+	g := NewWithT(t)
+
+	s := &NodegroupService{
+		scope: &scope.ManagedMachinePoolScope{
+			ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					Labels: map[string]string{"workload": "batch"},
+					Taints: expinfrav1.Taints{{Key: "dedicated", Value: "batch", Effect: expinfrav1.TaintEffectNoSchedule}},
+				},
+				Status: expinfrav1.AWSManagedMachinePoolStatus{
+					Capacity: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("4"),
+						corev1.ResourceMemory: resource.MustParse("16Gi"),
+					},
+				},
+			},
+		},
+	}
+
+	tags, err := s.autoscalerNodeTemplateTags()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tags).To(Equal(infrav1.Tags{
+		"k8s.io/cluster-autoscaler/node-template/label/workload":   "batch",
+		"k8s.io/cluster-autoscaler/node-template/taint/dedicated":  "batch:NoSchedule",
+		"k8s.io/cluster-autoscaler/node-template/resources/cpu":    "4",
+		"k8s.io/cluster-autoscaler/node-template/resources/memory": "16Gi",
+	}))
+}
+
+func TestReconcileLastUpdate(t *testing.T) {
+	healthIssues := &ekstypes.NodegroupHealth{Issues: []ekstypes.Issue{{
+		Code:        ekstypes.NodegroupIssueCodeNodeCreationFailure,
+		Message:     aws.String("instances failed to join the cluster"),
+		ResourceIds: []string{"i-1234"},
+	}}}
+
+	testCases := []struct {
+		name             string
+		generation       int64
+		lastUpdate       *expinfrav1.NodegroupVersionUpdate
+		soakDuration     *metav1.Duration
+		health           *ekstypes.NodegroupHealth
+		expect           func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectCanUpdate  bool
+		expectCondition  *clusterv1.Condition
+		expectLastStatus string
+	}{
+		{
+			name:            "no update",
+			expectCanUpdate: true,
+			expectCondition: conditions.TrueCondition(expinfrav1.EKSNodegroupUpdatedCondition),
+		},
+		{
+			name:       "update in progress",
+			lastUpdate: &expinfrav1.NodegroupVersionUpdate{ID: "update-1", Description: "to version 1.31", Status: string(ekstypes.UpdateStatusInProgress)},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeUpdate(gomock.Any(), gomock.Eq(&eks.DescribeUpdateInput{
+					Name:          aws.String("eks-cluster"),
+					NodegroupName: aws.String("eks-nodegroup"),
+					UpdateId:      aws.String("update-1"),
+				})).Return(&eks.DescribeUpdateOutput{Update: &ekstypes.Update{Status: ekstypes.UpdateStatusInProgress}}, nil)
+			},
+			expectCanUpdate:  false,
+			expectCondition:  conditions.FalseCondition(expinfrav1.EKSNodegroupUpdatedCondition, expinfrav1.EKSNodegroupUpdatingReason, clusterv1.ConditionSeverityInfo, "updating to version 1.31"),
+			expectLastStatus: string(ekstypes.UpdateStatusInProgress),
+		},
+		{
+			name:       "update failed with health issues",
+			generation: 1,
+			lastUpdate: &expinfrav1.NodegroupVersionUpdate{ID: "update-1", Description: "to version 1.31", Status: string(ekstypes.UpdateStatusInProgress), Generation: 1},
+			health:     healthIssues,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeUpdate(gomock.Any(), gomock.Any()).Return(&eks.DescribeUpdateOutput{Update: &ekstypes.Update{
+					Status: ekstypes.UpdateStatusFailed,
+					Errors: []ekstypes.ErrorDetail{{ErrorCode: ekstypes.ErrorCodePodEvictionFailure, ErrorMessage: aws.String("pod disruption budget")}},
+				}}, nil)
+			},
+			expectCanUpdate:  false,
+			expectCondition:  conditions.FalseCondition(expinfrav1.EKSNodegroupUpdatedCondition, expinfrav1.EKSNodegroupUpdateFailedReason, clusterv1.ConditionSeverityError, "update to version 1.31 is Failed: PodEvictionFailure: pod disruption budget; nodegroup health issues: NodeCreationFailure: instances failed to join the cluster (i-1234)"),
+			expectLastStatus: string(ekstypes.UpdateStatusFailed),
+		},
+		{
+			name:             "failed update is retried once the spec changed",
+			generation:       2,
+			lastUpdate:       &expinfrav1.NodegroupVersionUpdate{ID: "update-1", Description: "to version 1.31", Status: string(ekstypes.UpdateStatusFailed), Message: "PodEvictionFailure: pod disruption budget", Generation: 1},
+			expectCanUpdate:  true,
+			expectCondition:  conditions.FalseCondition(expinfrav1.EKSNodegroupUpdatedCondition, expinfrav1.EKSNodegroupUpdateFailedReason, clusterv1.ConditionSeverityError, "update to version 1.31 is Failed: PodEvictionFailure: pod disruption budget"),
+			expectLastStatus: string(ekstypes.UpdateStatusFailed),
+		},
+		{
+			name:         "nodegroup soaking after a successful update",
+			lastUpdate:   &expinfrav1.NodegroupVersionUpdate{ID: "update-1", Description: "to version 1.31", Status: string(ekstypes.UpdateStatusInProgress)},
+			soakDuration: &metav1.Duration{Duration: time.Hour},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeUpdate(gomock.Any(), gomock.Any()).Return(&eks.DescribeUpdateOutput{Update: &ekstypes.Update{Status: ekstypes.UpdateStatusSuccessful}}, nil)
+			},
+			expectCanUpdate:  false,
+			expectLastStatus: string(ekstypes.UpdateStatusSuccessful),
+		},
+		{
+			name:             "nodegroup soaked after a successful update",
+			lastUpdate:       &expinfrav1.NodegroupVersionUpdate{ID: "update-1", Description: "to version 1.31", Status: string(ekstypes.UpdateStatusSuccessful), CompletedAt: &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}},
+			soakDuration:     &metav1.Duration{Duration: time.Hour},
+			expectCanUpdate:  true,
+			expectCondition:  conditions.TrueCondition(expinfrav1.EKSNodegroupUpdatedCondition),
+			expectLastStatus: string(ekstypes.UpdateStatusSuccessful),
+		},
+		{
+			name:             "nodegroup unhealthy after a successful update",
+			lastUpdate:       &expinfrav1.NodegroupVersionUpdate{ID: "update-1", Description: "to version 1.31", Status: string(ekstypes.UpdateStatusSuccessful), CompletedAt: &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}},
+			health:           healthIssues,
+			expectCanUpdate:  false,
+			expectCondition:  conditions.FalseCondition(expinfrav1.EKSNodegroupUpdatedCondition, expinfrav1.EKSNodegroupUnhealthyReason, clusterv1.ConditionSeverityWarning, "nodegroup health issues after update to version 1.31: NodeCreationFailure: instances failed to join the cluster (i-1234)"),
+			expectLastStatus: string(ekstypes.UpdateStatusSuccessful),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			if tc.expect != nil {
+				tc.expect(eksMock.EXPECT())
+			}
+
+			managedPool := &expinfrav1.AWSManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{Generation: tc.generation},
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-nodegroup",
+					UpdateConfig:     &expinfrav1.UpdateConfig{SoakDuration: tc.soakDuration},
+				},
+				Status: expinfrav1.AWSManagedMachinePoolStatus{LastUpdate: tc.lastUpdate},
+			}
+			s := &NodegroupService{
+				scope: &scope.ManagedMachinePoolScope{
+					ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+						Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "eks-cluster"},
+					},
+					ManagedMachinePool: managedPool,
+				},
+				EKSClient: eksMock,
+			}
+
+			canUpdate, err := s.reconcileLastUpdate(context.TODO(), &ekstypes.Nodegroup{Health: tc.health})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(canUpdate).To(Equal(tc.expectCanUpdate))
+			if tc.expectCondition != nil {
+				g.Expect(*conditions.Get(managedPool, expinfrav1.EKSNodegroupUpdatedCondition)).To(conditions.MatchCondition(*tc.expectCondition))
+			} else {
+				g.Expect(conditions.GetReason(managedPool, expinfrav1.EKSNodegroupUpdatedCondition)).To(Equal(expinfrav1.EKSNodegroupSoakingReason))
+			}
+			if tc.lastUpdate != nil {
+				g.Expect(managedPool.Status.LastUpdate.Status).To(Equal(tc.expectLastStatus))
+			}
+		})
+	}
+}
@@ -235,6 +235,10 @@ func (s *NodegroupService) reconcileNodegroupIAMRole(ctx context.Context) error
 		policies = append(policies, s.scope.ManagedMachinePool.Spec.RoleAdditionalPolicies...)
 	}
 
+	if s.scope.ManagedMachinePool.Spec.RemoteAccess.IsSSMOnly() {
+		policies = append(policies, fmt.Sprintf("arn:%s:iam::aws:policy/AmazonSSMManagedInstanceCore", s.scope.Partition()))
+	}
+
 	_, err = s.EnsurePoliciesAttached(ctx, role, policies)
 	if err != nil {
 		return errors.Wrapf(err, "error ensuring policies are attached: %v", policies)
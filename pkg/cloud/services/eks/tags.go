@@ -37,6 +37,10 @@ const (
 	eksClusterNameTag              = "eks:cluster-name"
 	eksNodeGroupNameTag            = "eks:nodegroup-name"
 	eksClusterAutoscalerEnabledTag = "k8s.io/cluster-autoscaler/enabled"
+
+	autoscalerNodeTemplateLabelTagPrefix    = "k8s.io/cluster-autoscaler/node-template/label/"
+	autoscalerNodeTemplateTaintTagPrefix    = "k8s.io/cluster-autoscaler/node-template/taint/"
+	autoscalerNodeTemplateResourceTagPrefix = "k8s.io/cluster-autoscaler/node-template/resources/"
 )
 
 func (s *Service) reconcileTags(ctx context.Context, cluster *ekstypes.Cluster) error {
@@ -131,7 +135,16 @@ func (s *NodegroupService) reconcileASGTags(ctx context.Context, ng *ekstypes.No
 		return errors.Wrap(err, "failed to describe ASG for nodegroup")
 	}
 
-	tagsToDelete, tagsToAdd := getASGTagUpdates(s.scope.ClusterName(), tagDescriptionsToMap(asg.Tags), s.scope.AdditionalTags())
+	tags := s.scope.AdditionalTags()
+	if s.scope.ManagedMachinePool.Spec.Scaling.IsAutoscalerTagsManaged() {
+		autoscalerTags, err := s.autoscalerNodeTemplateTags()
+		if err != nil {
+			return errors.Wrap(err, "failed to build cluster autoscaler tags")
+		}
+		tags.Merge(autoscalerTags)
+	}
+
+	tagsToDelete, tagsToAdd := getASGTagUpdates(s.scope.ClusterName(), tagDescriptionsToMap(asg.Tags), tags)
 	s.scope.Debug("Tags", "tagsToAdd", tagsToAdd, "tagsToDelete", tagsToDelete)
 
 	if len(tagsToAdd) > 0 {
@@ -176,6 +189,28 @@ func (s *NodegroupService) reconcileASGTags(ctx context.Context, ng *ekstypes.No
 	return nil
 }
 
+// autoscalerNodeTemplateTags returns the tags the cluster autoscaler uses to build the nodes of the nodegroup
+// when it scales it from zero: the labels, the taints and the capacity of the nodes. The auto-discovery tags
+// are not part of them as EKS sets them on the Auto Scaling groups of all the nodegroups.
+func (s *NodegroupService) autoscalerNodeTemplateTags() (infrav1.Tags, error) {
+	managedPool := s.scope.ManagedMachinePool
+	tags := infrav1.Tags{}
+	for key, value := range managedPool.Spec.Labels {
+		tags[autoscalerNodeTemplateLabelTagPrefix+key] = value
+	}
+	for _, taint := range managedPool.Spec.Taints {
+		effect, err := converters.TaintEffectToKubernetes(taint.Effect)
+		if err != nil {
+			return nil, err
+		}
+		tags[autoscalerNodeTemplateTaintTagPrefix+taint.Key] = fmt.Sprintf("%s:%s", taint.Value, effect)
+	}
+	for name, quantity := range managedPool.Status.Capacity {
+		tags[autoscalerNodeTemplateResourceTagPrefix+string(name)] = quantity.String()
+	}
+	return tags, nil
+}
+
 func (s *FargateService) reconcileTags(ctx context.Context, fp *ekstypes.FargateProfile) error {
 	tags := ngTags(s.scope.ClusterName(), s.scope.AdditionalTags())
 	return updateTags(ctx, s.EKSClient, fp.FargateProfileArn, fp.Tags, tags)
@@ -16,8 +16,9 @@ func getElasticIPRoleName() string {
 // allocatePublicIpv4AddressFromByoIPPool claims for Elastic IPs from an user-defined public IPv4 pool,
 // allocating it to the NetworkMapping structure from an Network Load Balancer.
 func (s *Service) allocatePublicIpv4AddressFromByoIPPool(input *elbv2.CreateLoadBalancerInput) error {
-	// Custom Public IPv4 Pool isn't set.
-	if s.scope.VPC().GetPublicIpv4Pool() == nil {
+	// Neither a custom Public IPv4 Pool nor pre-tagged Elastic IPs are set.
+	pool := s.scope.VPC().GetElasticIPPool()
+	if pool == nil || (pool.PublicIpv4Pool == nil && !pool.HasTagSelector()) {
 		return nil
 	}
 
@@ -34,12 +35,12 @@ func (s *Service) allocatePublicIpv4AddressFromByoIPPool(input *elbv2.CreateLoad
 		return fmt.Errorf("PublicIpv4Pool is mutually exclusive with SubnetMappings")
 	}
 
-	eips, err := s.netService.GetOrAllocateAddresses(s.scope.VPC().GetElasticIPPool(), len(input.Subnets), getElasticIPRoleName())
+	eips, err := s.netService.GetOrAllocateAddresses(pool, len(input.Subnets), getElasticIPRoleName())
 	if err != nil {
-		return fmt.Errorf("failed to allocate address from Public IPv4 Pool %q to role %s: %w", *s.scope.VPC().GetPublicIpv4Pool(), getElasticIPRoleName(), err)
+		return fmt.Errorf("failed to allocate address from Elastic IP pool to role %s: %w", getElasticIPRoleName(), err)
 	}
 	if len(eips) != len(input.Subnets) {
-		return fmt.Errorf("number of allocated EIP addresses (%d) from pool %q must match with the subnet count (%d)", len(eips), *s.scope.VPC().GetPublicIpv4Pool(), len(input.Subnets))
+		return fmt.Errorf("number of allocated EIP addresses (%d) must match with the subnet count (%d)", len(eips), len(input.Subnets))
 	}
 	for cnt, sb := range input.Subnets {
 		input.SubnetMappings = append(input.SubnetMappings, &elbv2.SubnetMapping{
@@ -195,6 +195,10 @@ func (s *Service) reconcileV2LB(lbSpec *infrav1.AWSLoadBalancerSpec) error {
 // fields: Protocol, Port or Path). To customize the health check protocol, use HealthCheckProtocol instead.
 func (s *Service) getAPITargetGroupHealthCheck(lbSpec *infrav1.AWSLoadBalancerSpec) *infrav1.TargetGroupHealthCheck {
 	apiHealthCheckProtocol := infrav1.ELBProtocolTCP.String()
+	if lbSpec != nil && lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeALB {
+		// The target groups of Application Load Balancers only support HTTP and HTTPS health checks.
+		apiHealthCheckProtocol = infrav1.ELBProtocolHTTPS.String()
+	}
 	if lbSpec != nil && lbSpec.HealthCheckProtocol != nil {
 		s.scope.Trace("Found API health check protocol override in the Load Balancer spec, applying it to the API Target Group", "api-server-elb", lbSpec.HealthCheckProtocol.String())
 		apiHealthCheckProtocol = lbSpec.HealthCheckProtocol.String()
@@ -270,11 +274,20 @@ func (s *Service) getAdditionalTargetGroupHealthCheck(ln infrav1.AdditionalListe
 	return healthCheck
 }
 
+// apiServerLBSecurityGroupIDs returns the existing security groups of the load balancer when they are set,
+// or the API server load balancer security group managed by CAPA.
+func (s *Service) apiServerLBSecurityGroupIDs(lbSpec *infrav1.AWSLoadBalancerSpec) []string {
+	if lbSpec != nil && len(lbSpec.SecurityGroupIDs) > 0 {
+		return lbSpec.SecurityGroupIDs
+	}
+	return []string{s.scope.SecurityGroups()[infrav1.SecurityGroupAPIServerLB].ID}
+}
+
 func (s *Service) getAPIServerLBSpec(elbName string, lbSpec *infrav1.AWSLoadBalancerSpec) (*infrav1.LoadBalancer, error) {
 	var securityGroupIDs []string
 	if lbSpec != nil {
 		securityGroupIDs = append(securityGroupIDs, lbSpec.AdditionalSecurityGroups...)
-		securityGroupIDs = append(securityGroupIDs, s.scope.SecurityGroups()[infrav1.SecurityGroupAPIServerLB].ID)
+		securityGroupIDs = append(securityGroupIDs, s.apiServerLBSecurityGroupIDs(lbSpec)...)
 	}
 
 	// Since we're no longer relying on s.scope.ControlPlaneLoadBalancerScheme to do the defaulting for us, do it here.
@@ -285,18 +298,24 @@ func (s *Service) getAPIServerLBSpec(elbName string, lbSpec *infrav1.AWSLoadBala
 
 	// The default API health check is TCP, allowing customization to HTTP or HTTPS when HealthCheckProtocol is set.
 	apiHealthCheck := s.getAPITargetGroupHealthCheck(lbSpec)
+	// Application Load Balancers don't support TCP listeners: they terminate TLS and forward the requests to
+	// the API servers over HTTPS.
+	apiProtocol := infrav1.ELBProtocolTCP
+	if lbSpec != nil && lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeALB {
+		apiProtocol = infrav1.ELBProtocolHTTPS
+	}
 	res := &infrav1.LoadBalancer{
 		Name:          elbName,
 		Scheme:        scheme,
 		ELBAttributes: make(map[string]*string),
 		ELBListeners: []infrav1.Listener{
 			{
-				Protocol: infrav1.ELBProtocolTCP,
+				Protocol: apiProtocol,
 				Port:     infrav1.DefaultAPIServerPort,
 				TargetGroup: infrav1.TargetGroupSpec{
 					Name:        names.SimpleNameGenerator.GenerateName(apiServerTargetGroupPrefix),
 					Port:        infrav1.DefaultAPIServerPort,
-					Protocol:    infrav1.ELBProtocolTCP,
+					Protocol:    apiProtocol,
 					VpcID:       s.scope.VPC().ID,
 					HealthCheck: apiHealthCheck,
 				},
@@ -333,7 +352,8 @@ func (s *Service) getAPIServerLBSpec(elbName string, lbSpec *infrav1.AWSLoadBala
 		res.ELBAttributes[infrav1.LoadBalancerAttributeIdleTimeTimeoutSeconds] = aws.String(infrav1.LoadBalancerAttributeIdleTimeDefaultTimeoutSecondsInSeconds)
 	}
 
-	if lbSpec != nil {
+	// Cross-zone load balancing is always enabled for Application Load Balancers.
+	if lbSpec != nil && lbSpec.LoadBalancerType != infrav1.LoadBalancerTypeALB {
 		isCrossZoneLB := lbSpec.CrossZoneLoadBalancing
 		res.ELBAttributes[infrav1.LoadBalancerAttributeEnableLoadBalancingCrossZone] = aws.String(strconv.FormatBool(isCrossZoneLB))
 	}
@@ -1086,7 +1106,7 @@ func (s *Service) getAPIServerClassicELBSpec(elbName string) (*infrav1.LoadBalan
 	if controlPlaneLoadBalancer != nil && len(controlPlaneLoadBalancer.AdditionalSecurityGroups) != 0 {
 		securityGroupIDs = append(securityGroupIDs, controlPlaneLoadBalancer.AdditionalSecurityGroups...)
 	}
-	securityGroupIDs = append(securityGroupIDs, s.scope.SecurityGroups()[infrav1.SecurityGroupAPIServerLB].ID)
+	securityGroupIDs = append(securityGroupIDs, s.apiServerLBSecurityGroupIDs(controlPlaneLoadBalancer)...)
 
 	scheme := infrav1.ELBSchemeInternetFacing
 	if controlPlaneLoadBalancer != nil && controlPlaneLoadBalancer.Scheme != nil {
@@ -1642,7 +1662,8 @@ func (s *Service) reconcileTargetGroupsAndListeners(lbARN string, spec *infrav1.
 			}
 			createdTargetGroups = append(createdTargetGroups, group)
 
-			if !lbSpec.PreserveClientIP {
+			// Client IP preservation is not an attribute of the target groups of Application Load Balancers.
+			if !lbSpec.PreserveClientIP && lbSpec.LoadBalancerType != infrav1.LoadBalancerTypeALB {
 				targetGroupAttributeInput := &elbv2.ModifyTargetGroupAttributesInput{
 					TargetGroupArn: group.TargetGroupArn,
 					Attributes: []*elbv2.TargetGroupAttribute{
@@ -1667,11 +1688,13 @@ func (s *Service) reconcileTargetGroupsAndListeners(lbARN string, spec *infrav1.
 		}
 
 		if listener == nil {
-			listener, err = s.createListener(ln, group, lbARN, spec.Tags)
+			listener, err = s.createListener(ln, group, lbARN, lbSpec, spec.Tags)
 			if err != nil {
 				return nil, nil, err
 			}
 			createdListeners = append(createdListeners, listener)
+		} else if err := s.reconcileListenerCertificate(listener, lbSpec); err != nil {
+			return nil, nil, err
 		}
 	}
 
@@ -1679,7 +1702,7 @@ func (s *Service) reconcileTargetGroupsAndListeners(lbARN string, spec *infrav1.
 }
 
 // createListener creates a single Listener.
-func (s *Service) createListener(ln infrav1.Listener, group *elbv2.TargetGroup, lbARN string, tags map[string]string) (*elbv2.Listener, error) {
+func (s *Service) createListener(ln infrav1.Listener, group *elbv2.TargetGroup, lbARN string, lbSpec *infrav1.AWSLoadBalancerSpec, tags map[string]string) (*elbv2.Listener, error) {
 	listenerInput := &elbv2.CreateListenerInput{
 		DefaultActions: []*elbv2.Action{
 			{
@@ -1692,6 +1715,9 @@ func (s *Service) createListener(ln infrav1.Listener, group *elbv2.TargetGroup,
 		Protocol:        aws.String(string(ln.Protocol)),
 		Tags:            converters.MapToV2Tags(tags),
 	}
+	if ln.Protocol == infrav1.ELBProtocolHTTPS {
+		listenerInput.Certificates = []*elbv2.Certificate{{CertificateArn: aws.String(lbSpec.CertificateARN)}}
+	}
 	// Create ClassicELBListeners
 	listener, err := s.ELBV2Client.CreateListener(listenerInput)
 	if err != nil {
@@ -1706,6 +1732,26 @@ func (s *Service) createListener(ln infrav1.Listener, group *elbv2.TargetGroup,
 	return listener.Listeners[0], nil
 }
 
+// reconcileListenerCertificate replaces the certificate of an HTTPS listener when the certificate of the load balancer
+// changed, e.g. when it is rotated.
+func (s *Service) reconcileListenerCertificate(listener *elbv2.Listener, lbSpec *infrav1.AWSLoadBalancerSpec) error {
+	if aws.StringValue(listener.Protocol) != infrav1.ELBProtocolHTTPS.String() || lbSpec.CertificateARN == "" {
+		return nil
+	}
+	if len(listener.Certificates) > 0 && aws.StringValue(listener.Certificates[0].CertificateArn) == lbSpec.CertificateARN {
+		return nil
+	}
+
+	if _, err := s.ELBV2Client.ModifyListener(&elbv2.ModifyListenerInput{
+		ListenerArn:  listener.ListenerArn,
+		Certificates: []*elbv2.Certificate{{CertificateArn: aws.String(lbSpec.CertificateARN)}},
+	}); err != nil {
+		return errors.Wrapf(err, "failed to set the certificate of listener %q", aws.StringValue(listener.ListenerArn))
+	}
+	s.scope.Debug("Updated the certificate of the listener", "listener", aws.StringValue(listener.ListenerArn), "certificate", lbSpec.CertificateARN)
+	return nil
+}
+
 // createTargetGroup creates a single Target Group.
 func (s *Service) createTargetGroup(ln infrav1.Listener, tags map[string]string) (*elbv2.TargetGroup, error) {
 	targetGroupInput := &elbv2.CreateTargetGroupInput{
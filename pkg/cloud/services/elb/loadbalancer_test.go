@@ -394,6 +394,19 @@ func TestGetAPIServerV2ELBSpecControlPlaneLoadBalancer(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "load balancer config with existing security groups specified",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				AdditionalSecurityGroups: []string{"sg-00001"},
+				SecurityGroupIDs:         []string{"sg-00002", "sg-00003"},
+				LoadBalancerType:         infrav1.LoadBalancerTypeNLB,
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.SecurityGroupIDs).To(Equal([]string{"sg-00001", "sg-00002", "sg-00003"}))
+			},
+		},
 		{
 			name: "A base listener is set up for NLB",
 			lb: &infrav1.AWSLoadBalancerSpec{
@@ -426,6 +439,24 @@ func TestGetAPIServerV2ELBSpecControlPlaneLoadBalancer(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "An HTTPS listener is set up for ALB",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType:       infrav1.LoadBalancerTypeALB,
+				CertificateARN:         "arn:aws:acm:us-east-1:123456789012:certificate/apiserver",
+				CrossZoneLoadBalancing: true,
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ELBListeners).To(HaveLen(1))
+				g.Expect(res.ELBListeners[0].Protocol).To(Equal(infrav1.ELBProtocolHTTPS))
+				g.Expect(res.ELBListeners[0].TargetGroup.Protocol).To(Equal(infrav1.ELBProtocolHTTPS))
+				g.Expect(res.ELBListeners[0].TargetGroup.HealthCheck.Protocol).To(Equal(aws.String("HTTPS")))
+				g.Expect(res.ELBListeners[0].TargetGroup.HealthCheck.Path).To(Equal(aws.String("/readyz")))
+				g.Expect(res.ELBAttributes).NotTo(HaveKey(infrav1.LoadBalancerAttributeEnableLoadBalancingCrossZone))
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2123,6 +2154,170 @@ func TestReconcileTargetGroupsAndListeners(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "creates an HTTPS listener with the certificate of an application load balancer",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				spec.LoadBalancerType = infrav1.LoadBalancerTypeALB
+				spec.ELBListeners[0].Protocol = infrav1.ELBProtocolHTTPS
+				spec.ELBListeners[0].TargetGroup.Protocol = infrav1.ELBProtocolHTTPS
+				spec.ELBListeners[0].TargetGroup.HealthCheck = &infrav1.TargetGroupHealthCheck{
+					Protocol: aws.String("HTTPS"),
+					Port:     aws.String(infrav1.DefaultAPIServerPortString),
+					Path:     aws.String("/readyz"),
+				}
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				acl.Spec.ControlPlaneLoadBalancer.LoadBalancerType = infrav1.LoadBalancerTypeALB
+				acl.Spec.ControlPlaneLoadBalancer.CertificateARN = "arn:aws:acm:us-east-1:123456789012:certificate/apiserver"
+				return acl
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetGroups(gomock.Eq(&elbv2.DescribeTargetGroupsInput{
+					LoadBalancerArn: aws.String(elbArn),
+				})).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []*elbv2.TargetGroup{},
+				}, nil)
+				m.CreateTargetGroup(gomock.Eq(&elbv2.CreateTargetGroupInput{
+					Name:     aws.String("name"),
+					Port:     aws.Int64(infrav1.DefaultAPIServerPort),
+					Protocol: aws.String("HTTPS"),
+					VpcId:    aws.String(vpcID),
+					Tags: []*elbv2.Tag{
+						{
+							Key:   aws.String("test"),
+							Value: aws.String("tag"),
+						},
+					},
+					HealthCheckEnabled:         aws.Bool(true),
+					HealthCheckPort:            aws.String(infrav1.DefaultAPIServerPortString),
+					HealthCheckProtocol:        aws.String("HTTPS"),
+					HealthCheckPath:            aws.String("/readyz"),
+					HealthyThresholdCount:      aws.Int64(infrav1.DefaultAPIServerHealthThresholdCount),
+					UnhealthyThresholdCount:    aws.Int64(infrav1.DefaultAPIServerUnhealthThresholdCount),
+					HealthCheckIntervalSeconds: aws.Int64(infrav1.DefaultAPIServerHealthCheckIntervalSec),
+					HealthCheckTimeoutSeconds:  aws.Int64(infrav1.DefaultAPIServerHealthCheckTimeoutSec),
+				})).Return(&elbv2.CreateTargetGroupOutput{
+					TargetGroups: []*elbv2.TargetGroup{
+						{
+							TargetGroupArn:  aws.String(tgArn),
+							TargetGroupName: aws.String("name"),
+							VpcId:           aws.String(vpcID),
+						},
+					},
+				}, nil)
+				m.DescribeListeners(gomock.Eq(&elbv2.DescribeListenersInput{
+					LoadBalancerArn: aws.String(elbArn),
+				})).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []*elbv2.Listener{},
+				}, nil)
+				m.CreateListener(gomock.Eq(&elbv2.CreateListenerInput{
+					Certificates: []*elbv2.Certificate{
+						{
+							CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/apiserver"),
+						},
+					},
+					DefaultActions: []*elbv2.Action{
+						{
+							TargetGroupArn: aws.String(tgArn),
+							Type:           aws.String(elbv2.ActionTypeEnumForward),
+						},
+					},
+					LoadBalancerArn: aws.String(elbArn),
+					Port:            aws.Int64(infrav1.DefaultAPIServerPort),
+					Protocol:        aws.String("HTTPS"),
+					Tags: []*elbv2.Tag{
+						{
+							Key:   aws.String("test"),
+							Value: aws.String("tag"),
+						},
+					},
+				})).Return(&elbv2.CreateListenerOutput{
+					Listeners: []*elbv2.Listener{
+						{
+							ListenerArn: aws.String("listener::arn"),
+						},
+					},
+				}, nil)
+			},
+			check: func(t *testing.T, tgs []*elbv2.TargetGroup, listeners []*elbv2.Listener, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if len(tgs) != 1 || len(listeners) != 1 {
+					t.Fatalf("expected a target group and a listener to be created, got %d and %d", len(tgs), len(listeners))
+				}
+			},
+		},
+		{
+			name: "replaces the certificate of the HTTPS listener of an application load balancer",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				spec.LoadBalancerType = infrav1.LoadBalancerTypeALB
+				spec.ELBListeners[0].Protocol = infrav1.ELBProtocolHTTPS
+				spec.ELBListeners[0].TargetGroup.Protocol = infrav1.ELBProtocolHTTPS
+				spec.ELBListeners[0].TargetGroup.Name = "apiserver-target-1234"
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				acl.Spec.ControlPlaneLoadBalancer.LoadBalancerType = infrav1.LoadBalancerTypeALB
+				acl.Spec.ControlPlaneLoadBalancer.CertificateARN = "arn:aws:acm:us-east-1:123456789012:certificate/rotated"
+				return acl
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetGroups(gomock.Eq(&elbv2.DescribeTargetGroupsInput{
+					LoadBalancerArn: aws.String(elbArn),
+				})).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []*elbv2.TargetGroup{
+						{
+							TargetGroupArn:  aws.String(tgArn),
+							TargetGroupName: aws.String("apiserver-target-5678"),
+							Port:            aws.Int64(infrav1.DefaultAPIServerPort),
+							Protocol:        aws.String("HTTPS"),
+						},
+					},
+				}, nil)
+				m.DescribeListeners(gomock.Eq(&elbv2.DescribeListenersInput{
+					LoadBalancerArn: aws.String(elbArn),
+				})).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []*elbv2.Listener{
+						{
+							Certificates: []*elbv2.Certificate{
+								{
+									CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/apiserver"),
+								},
+							},
+							DefaultActions: []*elbv2.Action{
+								{
+									TargetGroupArn: aws.String(tgArn),
+									Type:           aws.String(elbv2.ActionTypeEnumForward),
+								},
+							},
+							ListenerArn: aws.String("listener::arn"),
+							Port:        aws.Int64(infrav1.DefaultAPIServerPort),
+							Protocol:    aws.String("HTTPS"),
+						},
+					},
+				}, nil)
+				m.ModifyListener(gomock.Eq(&elbv2.ModifyListenerInput{
+					ListenerArn: aws.String("listener::arn"),
+					Certificates: []*elbv2.Certificate{
+						{
+							CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/rotated"),
+						},
+					},
+				})).Return(&elbv2.ModifyListenerOutput{}, nil)
+			},
+			check: func(t *testing.T, tgs []*elbv2.TargetGroup, listeners []*elbv2.Listener, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if len(tgs) != 0 || len(listeners) != 0 {
+					t.Fatalf("expected no target group and no listener to be created, got %d and %d", len(tgs), len(listeners))
+				}
+			},
+		},
 	}
 
 	for _, tc := range tests {
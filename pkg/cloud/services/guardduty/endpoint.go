@@ -0,0 +1,274 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardduty
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ReconcileRuntimeMonitoring ensures the VPC endpoint of the GuardDuty data service is created in the private subnets
+// of a managed cluster VPC when GuardDuty Runtime Monitoring is enabled, and deleted otherwise.
+// The instances of the cluster are tagged for the GuardDuty security agent by the machine scopes.
+func (s *Service) ReconcileRuntimeMonitoring() error {
+	if !s.enabled() {
+		s.scope.Trace("Skipping GuardDuty Runtime Monitoring reconcile")
+		if s.scope.GuardDutyRuntimeMonitoringStatus() == nil {
+			return nil
+		}
+		return s.DeleteRuntimeMonitoring()
+	}
+
+	// The endpoint of an unmanaged VPC is created by GuardDuty or by the owner of the VPC.
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping GuardDuty data service VPC endpoint in unmanaged VPC")
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.GuardDutyRuntimeMonitoringReadyCondition)
+		return nil
+	}
+
+	s.scope.Debug("Reconciling GuardDuty Runtime Monitoring")
+
+	subnetIDs := endpointSubnetIDs(s.scope.Subnets().FilterPrivate())
+	if len(subnetIDs) == 0 {
+		s.scope.Debug("No private subnets available, skipping GuardDuty data service VPC endpoint")
+		return nil
+	}
+
+	sg, ok := s.scope.SecurityGroups()[infrav1.SecurityGroupGuardDutyEndpoint]
+	if !ok || sg.ID == "" {
+		return errors.New("failed to reconcile GuardDuty data service VPC endpoint, its security group is not available")
+	}
+
+	endpoint, err := s.describeEndpoint()
+	switch {
+	case awserrors.IsNotFound(err):
+		endpoint, err = s.createEndpoint(subnetIDs, sg.ID)
+		if err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	default:
+		if err := s.addEndpointSubnets(endpoint, subnetIDs); err != nil {
+			return err
+		}
+	}
+
+	id := aws.StringValue(endpoint.VpcEndpointId)
+	s.scope.SetGuardDutyRuntimeMonitoringStatus(&infrav1.GuardDutyRuntimeMonitoringStatus{VPCEndpointID: id})
+
+	switch state := aws.StringValue(endpoint.State); {
+	case strings.EqualFold(state, ec2.StateAvailable):
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.GuardDutyRuntimeMonitoringReadyCondition)
+	case strings.EqualFold(state, ec2.StateFailed), strings.EqualFold(state, ec2.StateRejected):
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.GuardDutyRuntimeMonitoringReadyCondition, infrav1.GuardDutyEndpointFailedReason, clusterv1.ConditionSeverityError,
+			"GuardDuty data service VPC endpoint %q is %s", id, state)
+		return errors.Errorf("GuardDuty data service VPC endpoint %q is %s", id, state)
+	default:
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.GuardDutyRuntimeMonitoringReadyCondition, infrav1.GuardDutyEndpointCreatingReason, clusterv1.ConditionSeverityInfo, "")
+	}
+
+	s.scope.Debug("Reconcile GuardDuty Runtime Monitoring completed successfully")
+	return nil
+}
+
+// DeleteRuntimeMonitoring deletes the VPC endpoint of the GuardDuty data service and waits for it to be gone,
+// so that its network interfaces no longer hold on to the security groups and subnets of the cluster.
+// Clusters that never enabled GuardDuty Runtime Monitoring are skipped.
+func (s *Service) DeleteRuntimeMonitoring() error {
+	if !s.enabled() && s.scope.GuardDutyRuntimeMonitoringStatus() == nil {
+		s.scope.Trace("GuardDuty Runtime Monitoring was never enabled, skipping deletion")
+		return nil
+	}
+	if s.scope.VPC().ID == "" {
+		s.scope.SetGuardDutyRuntimeMonitoringStatus(nil)
+		return nil
+	}
+
+	endpoint, err := s.describeEndpoint()
+	if err != nil {
+		if awserrors.IsNotFound(err) {
+			s.scope.Trace("GuardDuty data service VPC endpoint does not exist")
+			s.scope.SetGuardDutyRuntimeMonitoringStatus(nil)
+			return nil
+		}
+		return err
+	}
+
+	id := aws.StringValue(endpoint.VpcEndpointId)
+	if !strings.EqualFold(aws.StringValue(endpoint.State), ec2.StateDeleting) {
+		if _, err := s.EC2Client.DeleteVpcEndpointsWithContext(context.TODO(), &ec2.DeleteVpcEndpointsInput{
+			VpcEndpointIds: aws.StringSlice([]string{id}),
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteGuardDutyEndpoint", "Failed to delete GuardDuty data service VPC endpoint %q: %v", id, err)
+			return errors.Wrapf(err, "failed to delete GuardDuty data service VPC endpoint %q", id)
+		}
+	}
+
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.GuardDutyRuntimeMonitoringReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.describeEndpoint(); awserrors.IsNotFound(err) {
+			return true, nil
+		} else if err != nil {
+			return false, err
+		}
+		return false, nil
+	}); err != nil {
+		return errors.Wrapf(err, "failed to wait for GuardDuty data service VPC endpoint deletion %q", id)
+	}
+
+	s.scope.SetGuardDutyRuntimeMonitoringStatus(nil)
+
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.GuardDutyRuntimeMonitoringReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteGuardDutyEndpoint", "Deleted GuardDuty data service VPC endpoint %q", id)
+	s.scope.Info("Deleted GuardDuty data service VPC endpoint", "id", id)
+
+	return nil
+}
+
+// enabled returns true if GuardDuty Runtime Monitoring is enabled for the cluster.
+func (s *Service) enabled() bool {
+	return s.scope.GuardDutyRuntimeMonitoring() != nil && s.scope.GuardDutyRuntimeMonitoring().Enabled
+}
+
+// serviceName returns the name of the GuardDuty data service in the region of the cluster.
+func (s *Service) serviceName() string {
+	return fmt.Sprintf("com.amazonaws.%s.guardduty-data", s.scope.Region())
+}
+
+func (s *Service) createEndpoint(subnetIDs []string, securityGroupID string) (*ec2.VpcEndpoint, error) {
+	if !conditions.Has(s.scope.InfraCluster(), infrav1.GuardDutyRuntimeMonitoringReadyCondition) {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.GuardDutyRuntimeMonitoringReadyCondition, infrav1.GuardDutyEndpointCreatingReason, clusterv1.ConditionSeverityInfo, "")
+		if err := s.scope.PatchObject(); err != nil {
+			return nil, errors.Wrap(err, "failed to patch conditions")
+		}
+	}
+
+	out, err := s.EC2Client.CreateVpcEndpointWithContext(context.TODO(), &ec2.CreateVpcEndpointInput{
+		VpcId:             aws.String(s.scope.VPC().ID),
+		ServiceName:       aws.String(s.serviceName()),
+		VpcEndpointType:   aws.String(ec2.VpcEndpointTypeInterface),
+		SubnetIds:         aws.StringSlice(subnetIDs),
+		SecurityGroupIds:  aws.StringSlice([]string{securityGroupID}),
+		PrivateDnsEnabled: aws.Bool(true),
+		TagSpecifications: []*ec2.TagSpecification{tags.BuildParamsToTagSpecification(ec2.ResourceTypeVpcEndpoint, s.getEndpointTagParams())},
+	})
+	if err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.GuardDutyRuntimeMonitoringReadyCondition, infrav1.GuardDutyEndpointFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateGuardDutyEndpoint", "Failed to create GuardDuty data service VPC endpoint: %v", err)
+		return nil, errors.Wrap(err, "failed to create GuardDuty data service VPC endpoint")
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateGuardDutyEndpoint", "Created GuardDuty data service VPC endpoint %q", aws.StringValue(out.VpcEndpoint.VpcEndpointId))
+	s.scope.Info("Created GuardDuty data service VPC endpoint", "id", aws.StringValue(out.VpcEndpoint.VpcEndpointId), "subnet-ids", subnetIDs)
+
+	return out.VpcEndpoint, nil
+}
+
+// addEndpointSubnets adds the subnets of the availability zones the endpoint is not in yet, e.g. when the cluster
+// VPC is extended to another availability zone.
+func (s *Service) addEndpointSubnets(endpoint *ec2.VpcEndpoint, subnetIDs []string) error {
+	zones := sets.New[string]()
+	for _, id := range aws.StringValueSlice(endpoint.SubnetIds) {
+		if subnet := s.scope.Subnets().FindByID(id); subnet != nil {
+			zones.Insert(subnet.AvailabilityZone)
+		}
+	}
+
+	var additions []string
+	for _, id := range subnetIDs {
+		if subnet := s.scope.Subnets().FindByID(id); subnet != nil && !zones.Has(subnet.AvailabilityZone) {
+			additions = append(additions, id)
+		}
+	}
+	if len(additions) == 0 {
+		return nil
+	}
+
+	if _, err := s.EC2Client.ModifyVpcEndpointWithContext(context.TODO(), &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: endpoint.VpcEndpointId,
+		AddSubnetIds:  aws.StringSlice(additions),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to add subnets to GuardDuty data service VPC endpoint %q", aws.StringValue(endpoint.VpcEndpointId))
+	}
+	s.scope.Info("Added subnets to GuardDuty data service VPC endpoint", "id", aws.StringValue(endpoint.VpcEndpointId), "subnet-ids", additions)
+	return nil
+}
+
+// describeEndpoint returns the VPC endpoint of the GuardDuty data service owned by the cluster, if any.
+// Endpoints that are already deleted are ignored.
+func (s *Service) describeEndpoint() (*ec2.VpcEndpoint, error) {
+	out, err := s.EC2Client.DescribeVpcEndpointsWithContext(context.TODO(), &ec2.DescribeVpcEndpointsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ProviderRole(infrav1.GuardDutyEndpointRoleTagValue),
+			filter.EC2.Cluster(s.scope.Name()),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe GuardDuty data service VPC endpoints")
+	}
+
+	for _, endpoint := range out.VpcEndpoints {
+		if !strings.EqualFold(aws.StringValue(endpoint.State), ec2.StateDeleted) {
+			return endpoint, nil
+		}
+	}
+
+	return nil, awserrors.NewNotFound("GuardDuty data service VPC endpoint not found")
+}
+
+func (s *Service) getEndpointTagParams() infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-guardduty-endpoint", s.scope.Name())),
+		Role:        aws.String(infrav1.GuardDutyEndpointRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+// endpointSubnetIDs returns a subnet per availability zone, as an interface endpoint can only have one network
+// interface per availability zone.
+func endpointSubnetIDs(subnets infrav1.Subnets) []string {
+	zones := sets.New[string]()
+	var ids []string
+	for _, subnet := range subnets {
+		if zones.Has(subnet.AvailabilityZone) {
+			continue
+		}
+		zones.Insert(subnet.AvailabilityZone)
+		ids = append(ids, subnet.GetResourceID())
+	}
+	return ids
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardduty
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestServiceReconcileRuntimeMonitoring(t *testing.T) {
+	clusterName := "cluster"
+
+	describeInput := &ec2.DescribeVpcEndpointsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC("vpc-123"),
+			filter.EC2.ProviderRole(infrav1.GuardDutyEndpointRoleTagValue),
+			filter.EC2.Cluster(clusterName),
+		},
+	}
+
+	endpoint := func(state string, subnetIDs ...string) *ec2.VpcEndpoint {
+		return &ec2.VpcEndpoint{
+			VpcEndpointId: aws.String("vpce-123"),
+			ServiceName:   aws.String("com.amazonaws.us-east-1.guardduty-data"),
+			SubnetIds:     aws.StringSlice(subnetIDs),
+			State:         aws.String(state),
+		}
+	}
+
+	privateSubnets := infrav1.Subnets{
+		{ID: "subnet-public-1a", AvailabilityZone: "us-east-1a", IsPublic: true},
+		{ID: "subnet-private-1a", AvailabilityZone: "us-east-1a"},
+		{ID: "subnet-private-1a-2", AvailabilityZone: "us-east-1a"},
+		{ID: "subnet-private-1b", AvailabilityZone: "us-east-1b"},
+	}
+
+	tests := []struct {
+		name               string
+		spec               *infrav1.GuardDutyRuntimeMonitoring
+		status             *infrav1.GuardDutyRuntimeMonitoringStatus
+		unmanagedVPC       bool
+		subnets            infrav1.Subnets
+		expect             func(m *mocks.MockEC2APIMockRecorder)
+		expectError        bool
+		expectStatus       *infrav1.GuardDutyRuntimeMonitoringStatus
+		expectConditionSet bool
+		expectReady        corev1.ConditionStatus
+	}{
+		{
+			name: "should not call AWS when GuardDuty Runtime Monitoring was never enabled",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+			},
+		},
+		{
+			name:   "should delete the endpoint when GuardDuty Runtime Monitoring is disabled",
+			spec:   &infrav1.GuardDutyRuntimeMonitoring{Enabled: false},
+			status: &infrav1.GuardDutyRuntimeMonitoringStatus{VPCEndpointID: "vpce-123"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpointsWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []*ec2.VpcEndpoint{endpoint("available", "subnet-private-1a")},
+					}, nil)
+				m.DeleteVpcEndpointsWithContext(context.TODO(), gomock.Eq(&ec2.DeleteVpcEndpointsInput{
+					VpcEndpointIds: aws.StringSlice([]string{"vpce-123"}),
+				})).Return(&ec2.DeleteVpcEndpointsOutput{}, nil)
+				m.DescribeVpcEndpointsWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []*ec2.VpcEndpoint{endpoint("deleted", "subnet-private-1a")},
+					}, nil)
+			},
+			expectConditionSet: true,
+			expectReady:        corev1.ConditionFalse,
+		},
+		{
+			name:         "should not create the endpoint in an unmanaged VPC",
+			spec:         &infrav1.GuardDutyRuntimeMonitoring{Enabled: true},
+			unmanagedVPC: true,
+			subnets:      privateSubnets,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+			},
+			expectConditionSet: true,
+			expectReady:        corev1.ConditionTrue,
+		},
+		{
+			name:    "should create the endpoint in a private subnet per availability zone when it doesn't exist",
+			spec:    &infrav1.GuardDutyRuntimeMonitoring{Enabled: true},
+			subnets: privateSubnets,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpointsWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+				m.CreateVpcEndpointWithContext(context.TODO(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateVpcEndpointInput, _ ...request.Option) (*ec2.CreateVpcEndpointOutput, error) {
+						if aws.StringValue(input.ServiceName) != "com.amazonaws.us-east-1.guardduty-data" || aws.StringValue(input.VpcEndpointType) != ec2.VpcEndpointTypeInterface {
+							return nil, errors.Errorf("unexpected service %q of type %q", aws.StringValue(input.ServiceName), aws.StringValue(input.VpcEndpointType))
+						}
+						if subnets := aws.StringValueSlice(input.SubnetIds); len(subnets) != 2 || subnets[0] != "subnet-private-1a" || subnets[1] != "subnet-private-1b" {
+							return nil, errors.Errorf("unexpected subnets %v", subnets)
+						}
+						if len(input.SecurityGroupIds) != 1 || aws.StringValue(input.SecurityGroupIds[0]) != "sg-guardduty-endpoint" {
+							return nil, errors.Errorf("unexpected security groups %v", aws.StringValueSlice(input.SecurityGroupIds))
+						}
+						if !aws.BoolValue(input.PrivateDnsEnabled) {
+							return nil, errors.New("expected private DNS to be enabled")
+						}
+						return &ec2.CreateVpcEndpointOutput{
+							VpcEndpoint: endpoint("pending", "subnet-private-1a", "subnet-private-1b"),
+						}, nil
+					})
+			},
+			expectStatus:       &infrav1.GuardDutyRuntimeMonitoringStatus{VPCEndpointID: "vpce-123"},
+			expectConditionSet: true,
+			expectReady:        corev1.ConditionFalse,
+		},
+		{
+			name:    "should add the subnets of new availability zones to the endpoint",
+			spec:    &infrav1.GuardDutyRuntimeMonitoring{Enabled: true},
+			subnets: privateSubnets,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpointsWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []*ec2.VpcEndpoint{endpoint("available", "subnet-private-1a-2")},
+					}, nil)
+				m.ModifyVpcEndpointWithContext(context.TODO(), gomock.Eq(&ec2.ModifyVpcEndpointInput{
+					VpcEndpointId: aws.String("vpce-123"),
+					AddSubnetIds:  aws.StringSlice([]string{"subnet-private-1b"}),
+				})).Return(&ec2.ModifyVpcEndpointOutput{}, nil)
+			},
+			expectStatus:       &infrav1.GuardDutyRuntimeMonitoringStatus{VPCEndpointID: "vpce-123"},
+			expectConditionSet: true,
+			expectReady:        corev1.ConditionTrue,
+		},
+		{
+			name: "should fail when the endpoint creation failed",
+			spec: &infrav1.GuardDutyRuntimeMonitoring{Enabled: true},
+			subnets: infrav1.Subnets{
+				{ID: "subnet-private-1a", AvailabilityZone: "us-east-1a"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpointsWithContext(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []*ec2.VpcEndpoint{endpoint("failed", "subnet-private-1a")},
+					}, nil)
+			},
+			expectError:        true,
+			expectConditionSet: true,
+			expectReady:        corev1.ConditionFalse,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockControl)
+
+			scheme := runtime.NewScheme()
+			g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+			vpc := infrav1.VPCSpec{
+				ID:   "vpc-123",
+				Tags: infrav1.Tags{infrav1.ClusterTagKey(clusterName): string(infrav1.ResourceLifecycleOwned)},
+			}
+			if tc.unmanagedVPC {
+				vpc.Tags = nil
+			}
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					Region:                     "us-east-1",
+					GuardDutyRuntimeMonitoring: tc.spec,
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC:     vpc,
+						Subnets: tc.subnets,
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					GuardDutyRuntimeMonitoring: tc.status,
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupGuardDutyEndpoint: {ID: "sg-guardduty-endpoint"},
+						},
+					},
+				},
+			}
+
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).WithStatusSubresource(awsCluster).Build()
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				AWSCluster: awsCluster,
+				Client:     client,
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(ec2Mock.EXPECT())
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.ReconcileRuntimeMonitoring()
+			if tc.expectError {
+				g.Expect(err).NotTo(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(scope.AWSCluster.Status.GuardDutyRuntimeMonitoring).To(Equal(tc.expectStatus))
+			}
+
+			g.Expect(conditions.Has(scope.AWSCluster, infrav1.GuardDutyRuntimeMonitoringReadyCondition)).To(Equal(tc.expectConditionSet))
+			if tc.expectConditionSet {
+				g.Expect(conditions.Get(scope.AWSCluster, infrav1.GuardDutyRuntimeMonitoringReadyCondition).Status).To(Equal(tc.expectReady))
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+)
+
+const (
+	// errCodeSubscriptionRequired is the error code returned by AWS Health when the account does not have
+	// a Business, Enterprise On-Ramp or Enterprise support plan.
+	errCodeSubscriptionRequired = "SubscriptionRequiredException"
+
+	// errCodeAccessDenied is the error code returned by AWS Health when the caller is not allowed to call an operation.
+	errCodeAccessDenied = "AccessDeniedException"
+)
+
+// disruptiveEventTypes are the fragments of the codes of the scheduled changes which reboot, stop or retire
+// instances, such as AWS_EC2_INSTANCE_RETIREMENT_SCHEDULED or AWS_EC2_INSTANCE_REBOOT_MAINTENANCE_SCHEDULED.
+var disruptiveEventTypes = []string{"RETIREMENT", "REBOOT", "STOP"}
+
+// GetInstanceHealthEvents returns the upcoming and open AWS Health issues and scheduled changes affecting the
+// instance. No events are returned if the account does not have the support plan required by the AWS Health API,
+// or if the controller is not allowed to call it.
+func (s *Service) GetInstanceHealthEvents(instanceID string) ([]infrav1.ScheduledEvent, error) {
+	input := &health.DescribeEventsInput{
+		Filter: &health.EventFilter{
+			EntityValues:        aws.StringSlice([]string{instanceID}),
+			Services:            aws.StringSlice([]string{"EC2"}),
+			EventStatusCodes:    aws.StringSlice([]string{health.EventStatusCodeUpcoming, health.EventStatusCodeOpen}),
+			EventTypeCategories: aws.StringSlice([]string{health.EventTypeCategoryScheduledChange, health.EventTypeCategoryIssue}),
+		},
+	}
+
+	var events []infrav1.ScheduledEvent
+	err := s.HealthClient.DescribeEventsPagesWithContext(context.TODO(), input, func(out *health.DescribeEventsOutput, _ bool) bool {
+		for _, event := range out.Events {
+			events = append(events, toScheduledEvent(event))
+		}
+		return true
+	})
+	if err != nil {
+		switch code, _ := awserrors.Code(err); code {
+		case errCodeSubscriptionRequired, errCodeAccessDenied:
+			s.scope.Debug("AWS Health API is not available, skipping AWS Health events", "reason", code)
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to describe the AWS Health events of instance %q", instanceID)
+	}
+	return events, nil
+}
+
+func toScheduledEvent(event *health.Event) infrav1.ScheduledEvent {
+	code := aws.StringValue(event.EventTypeCode)
+	scheduledEvent := infrav1.ScheduledEvent{
+		Source:      infrav1.ScheduledEventSourceAWSHealth,
+		Code:        code,
+		Description: "AWS Health event " + aws.StringValue(event.Arn),
+	}
+	if aws.StringValue(event.EventTypeCategory) == health.EventTypeCategoryScheduledChange {
+		for _, fragment := range disruptiveEventTypes {
+			if strings.Contains(code, fragment) {
+				scheduledEvent.Disruptive = true
+				break
+			}
+		}
+	}
+	if event.StartTime != nil {
+		scheduledEvent.NotBefore = &metav1.Time{Time: *event.StartTime}
+	}
+	if event.EndTime != nil {
+		scheduledEvent.NotAfter = &metav1.Time{Time: *event.EndTime}
+	}
+	return scheduledEvent
+}
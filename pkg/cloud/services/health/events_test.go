@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/health/mock_healthiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const instanceID = "i-0123456789abcdef0"
+
+func TestGetInstanceHealthEvents(t *testing.T) {
+	startTime := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	endTime := time.Date(2026, 1, 2, 5, 0, 0, 0, time.UTC)
+
+	describeEvents := func(m *mock_healthiface.MockHealthAPIMockRecorder, events ...*health.Event) {
+		m.DescribeEventsPagesWithContext(context.TODO(), gomock.Eq(&health.DescribeEventsInput{
+			Filter: &health.EventFilter{
+				EntityValues:        aws.StringSlice([]string{instanceID}),
+				Services:            aws.StringSlice([]string{"EC2"}),
+				EventStatusCodes:    aws.StringSlice([]string{health.EventStatusCodeUpcoming, health.EventStatusCodeOpen}),
+				EventTypeCategories: aws.StringSlice([]string{health.EventTypeCategoryScheduledChange, health.EventTypeCategoryIssue}),
+			},
+		}), gomock.Any()).DoAndReturn(func(_ context.Context, _ *health.DescribeEventsInput, fn func(*health.DescribeEventsOutput, bool) bool, _ ...request.Option) error {
+			fn(&health.DescribeEventsOutput{Events: events}, true)
+			return nil
+		})
+	}
+
+	tests := []struct {
+		name    string
+		expect  func(m *mock_healthiface.MockHealthAPIMockRecorder)
+		want    []infrav1.ScheduledEvent
+		wantErr bool
+	}{
+		{
+			name: "scheduled changes and issues",
+			expect: func(m *mock_healthiface.MockHealthAPIMockRecorder) {
+				describeEvents(m,
+					&health.Event{
+						Arn:               aws.String("arn:aws:health:us-east-1::event/EC2/AWS_EC2_INSTANCE_RETIREMENT_SCHEDULED/1"),
+						EventTypeCode:     aws.String("AWS_EC2_INSTANCE_RETIREMENT_SCHEDULED"),
+						EventTypeCategory: aws.String(health.EventTypeCategoryScheduledChange),
+						StartTime:         aws.Time(startTime),
+						EndTime:           aws.Time(endTime),
+					},
+					&health.Event{
+						Arn:               aws.String("arn:aws:health:us-east-1::event/EC2/AWS_EC2_OPERATIONAL_ISSUE/2"),
+						EventTypeCode:     aws.String("AWS_EC2_OPERATIONAL_ISSUE"),
+						EventTypeCategory: aws.String(health.EventTypeCategoryIssue),
+						StartTime:         aws.Time(startTime),
+					},
+				)
+			},
+			want: []infrav1.ScheduledEvent{
+				{
+					Source:      infrav1.ScheduledEventSourceAWSHealth,
+					Code:        "AWS_EC2_INSTANCE_RETIREMENT_SCHEDULED",
+					Disruptive:  true,
+					Description: "AWS Health event arn:aws:health:us-east-1::event/EC2/AWS_EC2_INSTANCE_RETIREMENT_SCHEDULED/1",
+					NotBefore:   &metav1.Time{Time: startTime},
+					NotAfter:    &metav1.Time{Time: endTime},
+				},
+				{
+					Source:      infrav1.ScheduledEventSourceAWSHealth,
+					Code:        "AWS_EC2_OPERATIONAL_ISSUE",
+					Description: "AWS Health event arn:aws:health:us-east-1::event/EC2/AWS_EC2_OPERATIONAL_ISSUE/2",
+					NotBefore:   &metav1.Time{Time: startTime},
+				},
+			},
+		},
+		{
+			name: "no events without the required support plan",
+			expect: func(m *mock_healthiface.MockHealthAPIMockRecorder) {
+				m.DescribeEventsPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+					Return(awserr.New(errCodeSubscriptionRequired, "subscription required", nil))
+			},
+		},
+		{
+			name: "no events if the controller is not allowed to describe them",
+			expect: func(m *mock_healthiface.MockHealthAPIMockRecorder) {
+				m.DescribeEventsPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+					Return(awserr.New(errCodeAccessDenied, "access denied", nil))
+			},
+		},
+		{
+			name: "describe events fails",
+			expect: func(m *mock_healthiface.MockHealthAPIMockRecorder) {
+				m.DescribeEventsPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).
+					Return(awserr.New("InternalError", "internal error", nil))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			healthMock := mock_healthiface.NewMockHealthAPI(mockCtrl)
+			tt.expect(healthMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{Spec: infrav1.AWSClusterSpec{Region: "us-east-1"}},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.HealthClient = healthMock
+
+			events, err := s.GetInstanceHealthEvents(instanceID)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(events).To(Equal(tt.want))
+		})
+	}
+}
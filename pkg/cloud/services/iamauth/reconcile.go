@@ -144,6 +144,10 @@ func (s *Service) getRolesForMachineDeployments(ctx context.Context, allRoles ma
 			return fmt.Errorf("failed to get AWSMachine %s/%s: %w", ref.Namespace, ref.Name, err)
 		}
 		instanceProfile := awsMachineTemplate.Spec.Template.Spec.IAMInstanceProfile
+		if profile := awsMachineTemplate.Spec.Template.Spec.ManagedIAMInstanceProfile; profile != nil {
+			// The role of a managed instance profile has the name of the instance profile.
+			instanceProfile = profile.Name
+		}
 		if _, ok := allRoles[instanceProfile]; !ok && instanceProfile != "" {
 			allRoles[instanceProfile] = struct{}{}
 		}
@@ -0,0 +1,316 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package instanceprofile provides a service to manage the IAM instance profiles declared by AWSMachines.
+package instanceprofile
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/smithy-go"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/converters"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+// Service manages the IAM instance profiles, and their roles, created for the AWSMachines of a cluster.
+type Service struct {
+	scope     cloud.ClusterScoper
+	IAMClient IAMAPI
+}
+
+// IAMAPI is the subset of the AWS IAM API that is used to manage instance profiles.
+type IAMAPI interface {
+	GetRole(ctx context.Context, params *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error)
+	CreateRole(ctx context.Context, params *iam.CreateRoleInput, optFns ...func(*iam.Options)) (*iam.CreateRoleOutput, error)
+	DeleteRole(ctx context.Context, params *iam.DeleteRoleInput, optFns ...func(*iam.Options)) (*iam.DeleteRoleOutput, error)
+	ListAttachedRolePolicies(ctx context.Context, params *iam.ListAttachedRolePoliciesInput, optFns ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error)
+	AttachRolePolicy(ctx context.Context, params *iam.AttachRolePolicyInput, optFns ...func(*iam.Options)) (*iam.AttachRolePolicyOutput, error)
+	DetachRolePolicy(ctx context.Context, params *iam.DetachRolePolicyInput, optFns ...func(*iam.Options)) (*iam.DetachRolePolicyOutput, error)
+	ListRolePolicies(ctx context.Context, params *iam.ListRolePoliciesInput, optFns ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error)
+	PutRolePolicy(ctx context.Context, params *iam.PutRolePolicyInput, optFns ...func(*iam.Options)) (*iam.PutRolePolicyOutput, error)
+	DeleteRolePolicy(ctx context.Context, params *iam.DeleteRolePolicyInput, optFns ...func(*iam.Options)) (*iam.DeleteRolePolicyOutput, error)
+	GetInstanceProfile(ctx context.Context, params *iam.GetInstanceProfileInput, optFns ...func(*iam.Options)) (*iam.GetInstanceProfileOutput, error)
+	CreateInstanceProfile(ctx context.Context, params *iam.CreateInstanceProfileInput, optFns ...func(*iam.Options)) (*iam.CreateInstanceProfileOutput, error)
+	DeleteInstanceProfile(ctx context.Context, params *iam.DeleteInstanceProfileInput, optFns ...func(*iam.Options)) (*iam.DeleteInstanceProfileOutput, error)
+	AddRoleToInstanceProfile(ctx context.Context, params *iam.AddRoleToInstanceProfileInput, optFns ...func(*iam.Options)) (*iam.AddRoleToInstanceProfileOutput, error)
+	RemoveRoleFromInstanceProfile(ctx context.Context, params *iam.RemoveRoleFromInstanceProfileInput, optFns ...func(*iam.Options)) (*iam.RemoveRoleFromInstanceProfileOutput, error)
+}
+
+// NewService returns a new service given the api clients.
+func NewService(clusterScope cloud.ClusterScoper) *Service {
+	return &Service{
+		scope:     clusterScope,
+		IAMClient: scope.NewIAMClient(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
+	}
+}
+
+// ReconcileInstanceProfile ensures that the instance profile and its role exist, and that the role has exactly the
+// declared policies. It fails if the instance profile or the role already exist and are not owned by the cluster.
+func (s *Service) ReconcileInstanceProfile(ctx context.Context, profile *infrav1.ManagedIAMInstanceProfile) error {
+	name := profile.Name
+
+	role, err := s.getRole(ctx, name)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		if role, err = s.createRole(ctx, name); err != nil {
+			return err
+		}
+		s.scope.Info("Created IAM role", "role", name)
+	} else if !s.isOwned(role.Tags) {
+		return errors.Errorf("IAM role %q already exists and is not owned by cluster %q", name, s.scope.Name())
+	}
+
+	if err := s.reconcileAttachedPolicies(ctx, name, profile.PolicyARNs); err != nil {
+		return err
+	}
+	if err := s.reconcileInlinePolicies(ctx, name, profile.InlinePolicies); err != nil {
+		return err
+	}
+
+	instanceProfile, err := s.getInstanceProfile(ctx, name)
+	if err != nil {
+		return err
+	}
+	if instanceProfile == nil {
+		out, err := s.IAMClient.CreateInstanceProfile(ctx, &iam.CreateInstanceProfileInput{
+			InstanceProfileName: aws.String(name),
+			Tags:                s.tags(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create IAM instance profile %q", name)
+		}
+		instanceProfile = out.InstanceProfile
+		s.scope.Info("Created IAM instance profile", "instance-profile", name)
+	} else if !s.isOwned(instanceProfile.Tags) {
+		return errors.Errorf("IAM instance profile %q already exists and is not owned by cluster %q", name, s.scope.Name())
+	}
+
+	switch {
+	case len(instanceProfile.Roles) == 0:
+		if _, err := s.IAMClient.AddRoleToInstanceProfile(ctx, &iam.AddRoleToInstanceProfileInput{
+			InstanceProfileName: aws.String(name),
+			RoleName:            role.RoleName,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to add IAM role %q to instance profile %q", name, name)
+		}
+	case aws.ToString(instanceProfile.Roles[0].RoleName) != name:
+		return errors.Errorf("IAM instance profile %q has unexpected role %q", name, aws.ToString(instanceProfile.Roles[0].RoleName))
+	}
+
+	return nil
+}
+
+// DeleteInstanceProfile deletes the instance profile and its role. The instance profile and the role are left
+// untouched if they are not owned by the cluster.
+func (s *Service) DeleteInstanceProfile(ctx context.Context, name string) error {
+	instanceProfile, err := s.getInstanceProfile(ctx, name)
+	if err != nil {
+		return err
+	}
+	if instanceProfile != nil && s.isOwned(instanceProfile.Tags) {
+		for _, role := range instanceProfile.Roles {
+			if _, err := s.IAMClient.RemoveRoleFromInstanceProfile(ctx, &iam.RemoveRoleFromInstanceProfileInput{
+				InstanceProfileName: aws.String(name),
+				RoleName:            role.RoleName,
+			}); err != nil && !isNotFound(err) {
+				return errors.Wrapf(err, "failed to remove IAM role %q from instance profile %q", aws.ToString(role.RoleName), name)
+			}
+		}
+		if _, err := s.IAMClient.DeleteInstanceProfile(ctx, &iam.DeleteInstanceProfileInput{
+			InstanceProfileName: aws.String(name),
+		}); err != nil && !isNotFound(err) {
+			return errors.Wrapf(err, "failed to delete IAM instance profile %q", name)
+		}
+		s.scope.Info("Deleted IAM instance profile", "instance-profile", name)
+	}
+
+	role, err := s.getRole(ctx, name)
+	if err != nil {
+		return err
+	}
+	if role == nil || !s.isOwned(role.Tags) {
+		return nil
+	}
+	if err := s.reconcileAttachedPolicies(ctx, name, nil); err != nil {
+		return err
+	}
+	if err := s.reconcileInlinePolicies(ctx, name, nil); err != nil {
+		return err
+	}
+	if _, err := s.IAMClient.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String(name)}); err != nil && !isNotFound(err) {
+		return errors.Wrapf(err, "failed to delete IAM role %q", name)
+	}
+	s.scope.Info("Deleted IAM role", "role", name)
+
+	return nil
+}
+
+func (s *Service) createRole(ctx context.Context, name string) (*iamtypes.Role, error) {
+	trustRelationship, err := converters.IAMPolicyDocumentToJSON(iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: []iamv1.StatementEntry{
+			{
+				Effect:    iamv1.EffectAllow,
+				Principal: iamv1.Principals{iamv1.PrincipalService: []string{"ec2.amazonaws.com"}},
+				Action:    iamv1.Actions{"sts:AssumeRole"},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert the trust relationship to json")
+	}
+
+	out, err := s.IAMClient.CreateRole(ctx, &iam.CreateRoleInput{
+		RoleName:                 aws.String(name),
+		AssumeRolePolicyDocument: aws.String(trustRelationship),
+		Tags:                     s.tags(),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create IAM role %q", name)
+	}
+	return out.Role, nil
+}
+
+// reconcileAttachedPolicies attaches the given managed policies to the role, and detaches the other ones.
+func (s *Service) reconcileAttachedPolicies(ctx context.Context, roleName string, policyARNs []string) error {
+	out, err := s.IAMClient.ListAttachedRolePolicies(ctx, &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list the policies attached to IAM role %q", roleName)
+	}
+
+	attached := sets.New[string]()
+	for _, policy := range out.AttachedPolicies {
+		attached.Insert(aws.ToString(policy.PolicyArn))
+	}
+	wanted := sets.New(policyARNs...)
+
+	for _, policyARN := range sets.List(wanted.Difference(attached)) {
+		if _, err := s.IAMClient.AttachRolePolicy(ctx, &iam.AttachRolePolicyInput{
+			RoleName:  aws.String(roleName),
+			PolicyArn: aws.String(policyARN),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to attach policy %q to IAM role %q", policyARN, roleName)
+		}
+	}
+	for _, policyARN := range sets.List(attached.Difference(wanted)) {
+		if _, err := s.IAMClient.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{
+			RoleName:  aws.String(roleName),
+			PolicyArn: aws.String(policyARN),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to detach policy %q from IAM role %q", policyARN, roleName)
+		}
+	}
+	return nil
+}
+
+// reconcileInlinePolicies puts the given inline policies in the role, and deletes the other ones.
+func (s *Service) reconcileInlinePolicies(ctx context.Context, roleName string, policies []infrav1.InlinePolicy) error {
+	out, err := s.IAMClient.ListRolePolicies(ctx, &iam.ListRolePoliciesInput{RoleName: aws.String(roleName)})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list the inline policies of IAM role %q", roleName)
+	}
+
+	wanted := sets.New[string]()
+	for _, policy := range policies {
+		wanted.Insert(policy.Name)
+		if _, err := s.IAMClient.PutRolePolicy(ctx, &iam.PutRolePolicyInput{
+			RoleName:       aws.String(roleName),
+			PolicyName:     aws.String(policy.Name),
+			PolicyDocument: aws.String(policy.Document),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to put inline policy %q in IAM role %q", policy.Name, roleName)
+		}
+	}
+	for _, policyName := range out.PolicyNames {
+		if wanted.Has(policyName) {
+			continue
+		}
+		if _, err := s.IAMClient.DeleteRolePolicy(ctx, &iam.DeleteRolePolicyInput{
+			RoleName:   aws.String(roleName),
+			PolicyName: aws.String(policyName),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to delete inline policy %q of IAM role %q", policyName, roleName)
+		}
+	}
+	return nil
+}
+
+// getRole returns the role with the given name, or nil if it does not exist.
+func (s *Service) getRole(ctx context.Context, name string) (*iamtypes.Role, error) {
+	out, err := s.IAMClient.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(name)})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get IAM role %q", name)
+	}
+	return out.Role, nil
+}
+
+// getInstanceProfile returns the instance profile with the given name, or nil if it does not exist.
+func (s *Service) getInstanceProfile(ctx context.Context, name string) (*iamtypes.InstanceProfile, error) {
+	out, err := s.IAMClient.GetInstanceProfile(ctx, &iam.GetInstanceProfileInput{InstanceProfileName: aws.String(name)})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get IAM instance profile %q", name)
+	}
+	return out.InstanceProfile, nil
+}
+
+// tags returns the tags of the roles and instance profiles owned by the cluster.
+func (s *Service) tags() []iamtypes.Tag {
+	tags := []iamtypes.Tag{}
+	for k, v := range infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Additional:  s.scope.AdditionalTags(),
+	}) {
+		tags = append(tags, iamtypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+
+	// Sort so that unit tests can expect a stable order
+	sort.Slice(tags, func(i, j int) bool { return *tags[i].Key < *tags[j].Key })
+
+	return tags
+}
+
+func (s *Service) isOwned(tags []iamtypes.Tag) bool {
+	for _, tag := range tags {
+		if aws.ToString(tag.Key) == infrav1.ClusterTagKey(s.scope.Name()) {
+			return aws.ToString(tag.Value) == string(infrav1.ResourceLifecycleOwned)
+		}
+	}
+	return false
+}
+
+func isNotFound(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchEntity"
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instanceprofile/mock_iamiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const profileName = "gpu-workers"
+
+var (
+	ownedTags = []iamtypes.Tag{
+		{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+	}
+	notFound = &iamtypes.NoSuchEntityException{}
+)
+
+func TestReconcileInstanceProfile(t *testing.T) {
+	profile := &infrav1.ManagedIAMInstanceProfile{
+		Name:       profileName,
+		PolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonSSMManagedInstanceCore"},
+		InlinePolicies: []infrav1.InlinePolicy{
+			{Name: "s3", Document: `{"Version":"2012-10-17"}`},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		expect  func(m *mock_iamiface.MockIAMAPIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "creates the role and the instance profile",
+			expect: func(m *mock_iamiface.MockIAMAPIMockRecorder) {
+				m.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String(profileName)}).Return(nil, notFound)
+				m.CreateRole(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *iam.CreateRoleInput, _ ...func(*iam.Options)) (*iam.CreateRoleOutput, error) {
+					if aws.ToString(input.RoleName) != profileName {
+						t.Fatalf("unexpected role name %q", aws.ToString(input.RoleName))
+					}
+					return &iam.CreateRoleOutput{Role: &iamtypes.Role{RoleName: input.RoleName, Tags: input.Tags}}, nil
+				})
+				m.ListAttachedRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+				m.AttachRolePolicy(gomock.Any(), &iam.AttachRolePolicyInput{
+					RoleName:  aws.String(profileName),
+					PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonSSMManagedInstanceCore"),
+				}).Return(&iam.AttachRolePolicyOutput{}, nil)
+				m.ListRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListRolePoliciesOutput{}, nil)
+				m.PutRolePolicy(gomock.Any(), &iam.PutRolePolicyInput{
+					RoleName:       aws.String(profileName),
+					PolicyName:     aws.String("s3"),
+					PolicyDocument: aws.String(`{"Version":"2012-10-17"}`),
+				}).Return(&iam.PutRolePolicyOutput{}, nil)
+				m.GetInstanceProfile(gomock.Any(), gomock.Any()).Return(nil, notFound)
+				m.CreateInstanceProfile(gomock.Any(), gomock.Any()).Return(&iam.CreateInstanceProfileOutput{
+					InstanceProfile: &iamtypes.InstanceProfile{InstanceProfileName: aws.String(profileName), Tags: ownedTags},
+				}, nil)
+				m.AddRoleToInstanceProfile(gomock.Any(), &iam.AddRoleToInstanceProfileInput{
+					InstanceProfileName: aws.String(profileName),
+					RoleName:            aws.String(profileName),
+				}).Return(&iam.AddRoleToInstanceProfileOutput{}, nil)
+			},
+		},
+		{
+			name: "updates the policies of the existing role",
+			expect: func(m *mock_iamiface.MockIAMAPIMockRecorder) {
+				m.GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{
+					Role: &iamtypes.Role{RoleName: aws.String(profileName), Tags: ownedTags},
+				}, nil)
+				m.ListAttachedRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListAttachedRolePoliciesOutput{
+					AttachedPolicies: []iamtypes.AttachedPolicy{
+						{PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonSSMManagedInstanceCore")},
+						{PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonS3FullAccess")},
+					},
+				}, nil)
+				m.DetachRolePolicy(gomock.Any(), &iam.DetachRolePolicyInput{
+					RoleName:  aws.String(profileName),
+					PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonS3FullAccess"),
+				}).Return(&iam.DetachRolePolicyOutput{}, nil)
+				m.ListRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListRolePoliciesOutput{PolicyNames: []string{"s3", "ecr"}}, nil)
+				m.PutRolePolicy(gomock.Any(), gomock.Any()).Return(&iam.PutRolePolicyOutput{}, nil)
+				m.DeleteRolePolicy(gomock.Any(), &iam.DeleteRolePolicyInput{
+					RoleName:   aws.String(profileName),
+					PolicyName: aws.String("ecr"),
+				}).Return(&iam.DeleteRolePolicyOutput{}, nil)
+				m.GetInstanceProfile(gomock.Any(), gomock.Any()).Return(&iam.GetInstanceProfileOutput{
+					InstanceProfile: &iamtypes.InstanceProfile{
+						InstanceProfileName: aws.String(profileName),
+						Tags:                ownedTags,
+						Roles:               []iamtypes.Role{{RoleName: aws.String(profileName)}},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "fails if the role is not owned by the cluster",
+			expect: func(m *mock_iamiface.MockIAMAPIMockRecorder) {
+				m.GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{
+					Role: &iamtypes.Role{RoleName: aws.String(profileName)},
+				}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+			tt.expect(iamMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.IAMClient = iamMock
+
+			err = s.ReconcileInstanceProfile(context.TODO(), profile)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestDeleteInstanceProfile(t *testing.T) {
+	tests := []struct {
+		name   string
+		expect func(m *mock_iamiface.MockIAMAPIMockRecorder)
+	}{
+		{
+			name: "deletes the instance profile and the role",
+			expect: func(m *mock_iamiface.MockIAMAPIMockRecorder) {
+				m.GetInstanceProfile(gomock.Any(), gomock.Any()).Return(&iam.GetInstanceProfileOutput{
+					InstanceProfile: &iamtypes.InstanceProfile{
+						InstanceProfileName: aws.String(profileName),
+						Tags:                ownedTags,
+						Roles:               []iamtypes.Role{{RoleName: aws.String(profileName)}},
+					},
+				}, nil)
+				m.RemoveRoleFromInstanceProfile(gomock.Any(), &iam.RemoveRoleFromInstanceProfileInput{
+					InstanceProfileName: aws.String(profileName),
+					RoleName:            aws.String(profileName),
+				}).Return(&iam.RemoveRoleFromInstanceProfileOutput{}, nil)
+				m.DeleteInstanceProfile(gomock.Any(), gomock.Any()).Return(&iam.DeleteInstanceProfileOutput{}, nil)
+				m.GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{
+					Role: &iamtypes.Role{RoleName: aws.String(profileName), Tags: ownedTags},
+				}, nil)
+				m.ListAttachedRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListAttachedRolePoliciesOutput{
+					AttachedPolicies: []iamtypes.AttachedPolicy{{PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonSSMManagedInstanceCore")}},
+				}, nil)
+				m.DetachRolePolicy(gomock.Any(), gomock.Any()).Return(&iam.DetachRolePolicyOutput{}, nil)
+				m.ListRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListRolePoliciesOutput{PolicyNames: []string{"s3"}}, nil)
+				m.DeleteRolePolicy(gomock.Any(), gomock.Any()).Return(&iam.DeleteRolePolicyOutput{}, nil)
+				m.DeleteRole(gomock.Any(), &iam.DeleteRoleInput{RoleName: aws.String(profileName)}).Return(&iam.DeleteRoleOutput{}, nil)
+			},
+		},
+		{
+			name: "leaves the instance profile and the role untouched if they are not owned by the cluster",
+			expect: func(m *mock_iamiface.MockIAMAPIMockRecorder) {
+				m.GetInstanceProfile(gomock.Any(), gomock.Any()).Return(&iam.GetInstanceProfileOutput{
+					InstanceProfile: &iamtypes.InstanceProfile{InstanceProfileName: aws.String(profileName)},
+				}, nil)
+				m.GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{
+					Role: &iamtypes.Role{RoleName: aws.String(profileName)},
+				}, nil)
+			},
+		},
+		{
+			name: "does nothing if the instance profile and the role do not exist",
+			expect: func(m *mock_iamiface.MockIAMAPIMockRecorder) {
+				m.GetInstanceProfile(gomock.Any(), gomock.Any()).Return(nil, notFound)
+				m.GetRole(gomock.Any(), gomock.Any()).Return(nil, notFound)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+			tt.expect(iamMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.IAMClient = iamMock
+
+			g.Expect(s.DeleteInstanceProfile(context.TODO(), profileName)).To(Succeed())
+		})
+	}
+}
@@ -19,13 +19,17 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
+	corev1 "k8s.io/api/core/v1"
 	apimachinerytypes "k8s.io/apimachinery/pkg/types"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/quota"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
 const (
@@ -35,6 +39,9 @@ const (
 	AnyIPv4CidrBlock = "0.0.0.0/0"
 	// AnyIPv6CidrBlock is the CIDR block to match all IPv6 addresses.
 	AnyIPv6CidrBlock = "::/0"
+	// NAT64CidrBlock is the well-known prefix into which DNS64 synthesizes the IPv6 addresses of IPv4-only
+	// destinations, NAT gateways translate the traffic to this prefix to IPv4.
+	NAT64CidrBlock = "64:ff9b::/96"
 )
 
 // ASGInterface encapsulates the methods exposed to the machinepool
@@ -51,16 +58,26 @@ type ASGInterface interface {
 	SuspendProcesses(name string, processes []string) error
 	ResumeProcesses(name string, processes []string) error
 	SubnetIDs(scope *scope.MachinePoolScope) ([]string, error)
+	GetCapacityErrors(asgName string) (map[string]time.Time, error)
+	MarkInstanceUnhealthy(instanceID string) error
+	ReplaceInstance(instanceID string) error
+	SetInstanceProtection(asgName string, instanceIDs []string, protected bool) error
 	DescribeLifecycleHooks(asgName string) ([]*expinfrav1.AWSLifecycleHook, error)
 	CreateLifecycleHook(ctx context.Context, asgName string, hook *expinfrav1.AWSLifecycleHook) error
 	UpdateLifecycleHook(ctx context.Context, asgName string, hook *expinfrav1.AWSLifecycleHook) error
 	DeleteLifecycleHook(ctx context.Context, asgName string, hook *expinfrav1.AWSLifecycleHook) error
+	DescribeScheduledActions(asgName string) ([]*expinfrav1.AWSScheduledAction, error)
+	PutScheduledAction(ctx context.Context, asgName string, action *expinfrav1.AWSScheduledAction) error
+	DeleteScheduledAction(ctx context.Context, asgName string, action *expinfrav1.AWSScheduledAction) error
 }
 
 // EC2Interface encapsulates the methods exposed to the machine
 // actuator.
 type EC2Interface interface {
 	InstanceIfExists(id *string) (*infrav1.Instance, error)
+	GetInstanceMarketTypes(instanceIDs []string) (map[string]infrav1.MarketType, error)
+	ValidateCapacityBlock(capacityReservationID, instanceType string) error
+	GetInstanceTypeCapacity(instanceType string) (corev1.ResourceList, *infrav1.NodeInfo, error)
 	TerminateInstance(id string) error
 	CreateInstance(scope *scope.MachineScope, userData []byte, userDataFormat string) (*infrav1.Instance, error)
 	GetRunningInstanceByTags(scope *scope.MachineScope) (*infrav1.Instance, error)
@@ -69,6 +86,7 @@ type EC2Interface interface {
 	GetCoreSecurityGroups(machine *scope.MachineScope) ([]string, error)
 	GetInstanceSecurityGroups(instanceID string) (map[string][]string, error)
 	UpdateInstanceSecurityGroups(id string, securityGroups []string) error
+	ReconcileInstancesSecurityGroups(scope scope.LaunchTemplateScope, instanceIDs []string) error
 	UpdateResourceTags(resourceID *string, create, remove map[string]string) error
 	ModifyInstanceMetadataOptions(instanceID string, options *infrav1.InstanceMetadataOptions) error
 
@@ -86,18 +104,42 @@ type EC2Interface interface {
 	LaunchTemplateNeedsUpdate(scope scope.LaunchTemplateScope, incoming *expinfrav1.AWSLaunchTemplate, existing *expinfrav1.AWSLaunchTemplate) (bool, error)
 	DeleteBastion() error
 	ReconcileBastion() error
+	DeleteInstanceConnectEndpoint() error
+	ReconcileInstanceConnectEndpoint() error
+	ValidateRegion() error
 	// ReconcileElasticIPFromPublicPool reconciles the elastic IP from a custom Public IPv4 Pool.
 	ReconcileElasticIPFromPublicPool(pool *infrav1.ElasticIPPool, instance *infrav1.Instance) (bool, error)
 
 	// ReleaseElasticIP reconciles the elastic IP from a custom Public IPv4 Pool.
 	ReleaseElasticIP(instanceID string) error
+
+	// DiagnoseInstanceBootstrap collects the diagnosis of an instance that did not become a node.
+	DiagnoseInstanceBootstrap(instance *infrav1.Instance, endpoint clusterv1.APIEndpoint) *infrav1.BootstrapDiagnosis
+	// UpdateBootstrapDiagnosis refreshes the running parts of a bootstrap diagnosis.
+	UpdateBootstrapDiagnosis(diagnosis *infrav1.BootstrapDiagnosis) error
+	// DeleteBootstrapDiagnosis deletes the AWS resources created for a bootstrap diagnosis.
+	DeleteBootstrapDiagnosis(diagnosis *infrav1.BootstrapDiagnosis) error
+	// StartReachabilityAnalysis starts a VPC Reachability Analyzer analysis of the given network path of an instance.
+	StartReachabilityAnalysis(target infrav1.ReachabilityAnalysisTarget, instance *infrav1.Instance, endpoint clusterv1.APIEndpoint) (*infrav1.ReachabilityAnalysis, error)
+	// UpdateReachabilityAnalysis refreshes a running reachability analysis.
+	UpdateReachabilityAnalysis(reachability *infrav1.ReachabilityAnalysis) error
+	// DeleteReachabilityAnalysis deletes the AWS resources created for a reachability analysis.
+	DeleteReachabilityAnalysis(reachability *infrav1.ReachabilityAnalysis) error
+	// GetInstanceTimelineEvents returns the ongoing impairment and interruption events of an instance.
+	GetInstanceTimelineEvents(instanceID string, interruptible bool) ([]infrav1.TimelineEvent, error)
+
+	// GetInstanceScheduledEvents returns the upcoming scheduled events of an instance.
+	GetInstanceScheduledEvents(instanceID string) ([]infrav1.ScheduledEvent, error)
+
+	// GetInstanceTypeAccelerators returns the accelerators of an instance type.
+	GetInstanceTypeAccelerators(instanceType string) ([]infrav1.Accelerator, error)
 }
 
 // MachinePoolReconcileInterface encapsulates high-level reconciliation functions regarding EC2 reconciliation. It is
 // separate from EC2Interface so that we can mock AWS requests separately. For example, by not mocking the
 // ReconcileLaunchTemplate function, but mocking EC2Interface, we can test which EC2 API operations would have been called.
 type MachinePoolReconcileInterface interface {
-	ReconcileLaunchTemplate(ctx context.Context, ignitionScope scope.IgnitionScope, scope scope.LaunchTemplateScope, s3Scope scope.S3Scope, ec2svc EC2Interface, objectStoreSvc ObjectStoreInterface, canUpdateLaunchTemplate func() (bool, error), runPostLaunchTemplateUpdateOperation func() error) error
+	ReconcileLaunchTemplate(ctx context.Context, ignitionScope scope.IgnitionScope, scope scope.LaunchTemplateScope, s3Scope scope.S3Scope, ec2svc EC2Interface, objectStoreSvc ObjectStoreInterface, canUpdateLaunchTemplate func() (bool, error), runPostLaunchTemplateUpdateOperation func() error, runSecurityGroupsUpdateOperation func() error) error
 	ReconcileTags(scope scope.LaunchTemplateScope, resourceServicesToUpdate []scope.ResourceServiceToUpdate) error
 }
 
@@ -109,6 +151,13 @@ type SecretInterface interface {
 	UserData(secretPrefix string, chunks int32, region string, endpoints []scope.ServiceEndpoint) ([]byte, error)
 }
 
+// PatchManagementInterface encapsulates the methods exposed to the machine
+// actuator to associate instances with AWS Systems Manager patching resources.
+type PatchManagementInterface interface {
+	ReconcilePatchManagement(m *scope.MachineScope, instanceID string) error
+	DeletePatchManagement(m *scope.MachineScope) error
+}
+
 // ELBInterface encapsulates the methods exposed to the cluster and machine
 // controller.
 type ELBInterface interface {
@@ -146,6 +195,73 @@ type ObjectStoreInterface interface {
 	DeleteForMachinePool(ctx context.Context, scope scope.LaunchTemplateScope, bootstrapDataHash string) error
 }
 
+// InstanceProfileInterface encapsulates the methods managing the IAM instance profiles declared by AWSMachines.
+type InstanceProfileInterface interface {
+	ReconcileInstanceProfile(ctx context.Context, profile *infrav1.ManagedIAMInstanceProfile) error
+	DeleteInstanceProfile(ctx context.Context, name string) error
+}
+
+// KMSInterface encapsulates the methods validating the KMS keys used to encrypt data.
+type KMSInterface interface {
+	ValidateAutoScalingKeyAccess(keyIDs []string) error
+	ValidateKeys(keyIDs []string) error
+}
+
+// QuotaInterface encapsulates the methods checking the service quotas of the account before creating resources and
+// reporting the resource limits of the account.
+type QuotaInterface interface {
+	CheckVCPUQuota(instanceType string, spot bool, instances int32) error
+	CheckVPCQuota(vpcs int64) error
+	CheckElasticIPQuota(addresses int64) error
+	ResourceLimits(instanceTypes []quota.InstanceType) (*infrav1.ResourceLimits, error)
+}
+
+// ObservabilityInterface encapsulates the methods managing the CloudWatch alarms and dashboard of a cluster.
+type ObservabilityInterface interface {
+	ReconcileObservability(ctx context.Context) error
+	DeleteObservability(ctx context.Context) error
+}
+
+// BudgetInterface encapsulates the methods managing the AWS Budget of a cluster.
+type BudgetInterface interface {
+	ReconcileBudget(ctx context.Context) error
+	DeleteBudget(ctx context.Context) error
+}
+
+// CloudProviderConfigInterface encapsulates the methods managing the cloud provider configuration of a workload cluster.
+type CloudProviderConfigInterface interface {
+	ReconcileCloudProviderConfig(ctx context.Context) error
+}
+
+// PrivateDNSInterface encapsulates the methods managing the private DNS record of the API server endpoint.
+type PrivateDNSInterface interface {
+	ReconcilePrivateDNS() error
+	DeletePrivateDNS() error
+}
+
+// CloudWatchLogsInterface encapsulates the methods managing the CloudWatch log groups the logs of the instances are shipped to.
+type CloudWatchLogsInterface interface {
+	ReconcileLogGroup(name string, retentionInDays int32) error
+	DeleteLogGroups() error
+}
+
+// SessionManagerInterface encapsulates the methods managing the Session Manager preferences of a cluster.
+type SessionManagerInterface interface {
+	ReconcileSessionManagerPreferences(preferences *infrav1.SessionManager) error
+	DeleteSessionManagerPreferences(documentName string) error
+}
+
+// GuardDutyInterface encapsulates the methods managing the VPC endpoint used by GuardDuty Runtime Monitoring.
+type GuardDutyInterface interface {
+	ReconcileRuntimeMonitoring() error
+	DeleteRuntimeMonitoring() error
+}
+
+// HealthInterface encapsulates the methods querying the AWS Health events affecting instances.
+type HealthInterface interface {
+	GetInstanceHealthEvents(instanceID string) ([]infrav1.ScheduledEvent, error)
+}
+
 // AWSNodeInterface installs the CNI for EKS clusters.
 type AWSNodeInterface interface {
 	ReconcileCNI(ctx context.Context) error
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+)
+
+const (
+	// errCodeAccessDenied is the error code returned by KMS when the caller is not allowed to call an operation.
+	errCodeAccessDenied = "AccessDeniedException"
+
+	// defaultKeyPolicyName is the name of the only policy a KMS key can have.
+	defaultKeyPolicyName = "default"
+
+	// createGrantAction is the action allowing EC2 to use a KMS key on behalf of a principal to encrypt volumes.
+	createGrantAction = "kms:CreateGrant"
+)
+
+// KeyNotUsableError is returned when the volumes of the instances launched by a principal cannot be encrypted
+// with a KMS key, so that the instances would be terminated as soon as they are launched.
+type KeyNotUsableError struct {
+	// KeyID is the ID or ARN of the KMS key.
+	KeyID string
+	// Principal is the ARN of the principal launching the instances.
+	Principal string
+	// Reason explains why the KMS key cannot be used.
+	Reason string
+}
+
+func (e *KeyNotUsableError) Error() string {
+	return fmt.Sprintf("KMS key %q cannot be used by %s: %s", e.KeyID, e.Principal, e.Reason)
+}
+
+// AsKeyNotUsable returns the KeyNotUsableError wrapped by err, if any.
+func AsKeyNotUsable(err error) (*KeyNotUsableError, bool) {
+	var notUsableErr *KeyNotUsableError
+	if errors.As(err, &notUsableErr) {
+		return notUsableErr, true
+	}
+	return nil, false
+}
+
+// AutoScalingServiceLinkedRoleARN returns the ARN of the service-linked role used by Auto Scaling groups to launch
+// instances in the given account.
+func AutoScalingServiceLinkedRoleARN(partition, accountID string) string {
+	return fmt.Sprintf("arn:%s:iam::%s:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling", partition, accountID)
+}
+
+// ValidateAutoScalingKeyAccess ensures that the Auto Scaling service-linked role of the account can use the given
+// KMS keys to encrypt the volumes of the instances it launches, that is that the keys are enabled and that either
+// the key policy or a grant allows the role to create grants for them.
+// It returns a KeyNotUsableError for the first key which cannot be used. Keys managed by AWS and keys whose
+// metadata, policy or grants the controller is not allowed to read are not validated.
+func (s *Service) ValidateAutoScalingKeyAccess(keyIDs []string) error {
+	if len(keyIDs) == 0 {
+		return nil
+	}
+
+	identity, err := s.STSClient.GetCallerIdentityWithContext(context.TODO(), &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get the caller identity")
+	}
+	callerARN, err := arn.Parse(aws.StringValue(identity.Arn))
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse the caller ARN %q", aws.StringValue(identity.Arn))
+	}
+	principal := AutoScalingServiceLinkedRoleARN(callerARN.Partition, callerARN.AccountID)
+
+	for _, keyID := range sets.List(sets.New(keyIDs...)) {
+		if err := s.validateKeyAccess(keyID, principal, callerARN.AccountID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateKeys ensures that the given KMS keys exist, are enabled and are symmetric encryption keys, so that the
+// controller can encrypt data with them. It returns an aggregate of a KeyNotUsableError for each key which cannot
+// be used. Keys managed by AWS and keys whose metadata the controller is not allowed to read are not validated.
+func (s *Service) ValidateKeys(keyIDs []string) error {
+	if len(keyIDs) == 0 {
+		return nil
+	}
+
+	identity, err := s.STSClient.GetCallerIdentityWithContext(context.TODO(), &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get the caller identity")
+	}
+	principal := aws.StringValue(identity.Arn)
+
+	var errs []error
+	for _, keyID := range sets.List(sets.New(keyIDs...)) {
+		if _, err := s.describeUsableKey(keyID, principal); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+func (s *Service) validateKeyAccess(keyID, principal, accountID string) error {
+	key, err := s.describeUsableKey(keyID, principal)
+	if err != nil || key == nil {
+		return err
+	}
+
+	// The key policy of a key of another account cannot allow the role, whose IAM policy cannot be changed,
+	// to use the key: a grant is required.
+	if aws.StringValue(key.AWSAccountId) == accountID {
+		allowed, err := s.keyPolicyAllowsCreateGrant(key.Arn, principal)
+		if err != nil || allowed {
+			return err
+		}
+	}
+	granted, err := s.hasCreateGrantGrant(key.Arn, principal)
+	if err != nil || granted {
+		return err
+	}
+
+	return &KeyNotUsableError{
+		KeyID:     keyID,
+		Principal: principal,
+		Reason:    fmt.Sprintf("neither the key policy nor a grant allows it to call %s", createGrantAction),
+	}
+}
+
+// describeUsableKey returns the metadata of a KMS key, or a KeyNotUsableError if the key does not exist, is not
+// enabled or is not a symmetric encryption key. It returns no metadata for the keys managed by AWS and when the
+// controller is not allowed to describe the key, as they are not validated.
+func (s *Service) describeUsableKey(keyID, principal string) (*kms.KeyMetadata, error) {
+	out, err := s.KMSClient.DescribeKeyWithContext(context.TODO(), &kms.DescribeKeyInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		switch code, _ := awserrors.Code(err); code {
+		case kms.ErrCodeNotFoundException:
+			return nil, &KeyNotUsableError{KeyID: keyID, Principal: principal, Reason: "the key does not exist"}
+		case errCodeAccessDenied:
+			s.scope.Debug("Not allowed to describe KMS key, skipping validation", "key", keyID)
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to describe KMS key %q", keyID)
+	}
+
+	key := out.KeyMetadata
+	if aws.StringValue(key.KeyManager) == kms.KeyManagerTypeAws {
+		return nil, nil
+	}
+	if state := aws.StringValue(key.KeyState); state != kms.KeyStateEnabled {
+		return nil, &KeyNotUsableError{KeyID: keyID, Principal: principal, Reason: fmt.Sprintf("the key is %s", state)}
+	}
+	if spec := aws.StringValue(key.KeySpec); spec != kms.KeySpecSymmetricDefault {
+		return nil, &KeyNotUsableError{KeyID: keyID, Principal: principal, Reason: fmt.Sprintf("only %s keys can be used, not %s keys", kms.KeySpecSymmetricDefault, spec)}
+	}
+	return key, nil
+}
+
+// keyPolicyAllowsCreateGrant returns whether the policy of the key allows the principal to create grants.
+// The policy is assumed to allow it if the controller is not allowed to get the policy.
+func (s *Service) keyPolicyAllowsCreateGrant(keyARN *string, principal string) (bool, error) {
+	out, err := s.KMSClient.GetKeyPolicyWithContext(context.TODO(), &kms.GetKeyPolicyInput{
+		KeyId:      keyARN,
+		PolicyName: aws.String(defaultKeyPolicyName),
+	})
+	if err != nil {
+		if code, _ := awserrors.Code(err); code == errCodeAccessDenied {
+			s.scope.Debug("Not allowed to get the policy of KMS key, skipping validation", "key", aws.StringValue(keyARN))
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "failed to get the policy of KMS key %q", aws.StringValue(keyARN))
+	}
+
+	policy := &iamv1.PolicyDocument{}
+	if err := json.Unmarshal([]byte(aws.StringValue(out.Policy)), policy); err != nil {
+		return false, errors.Wrapf(err, "failed to parse the policy of KMS key %q", aws.StringValue(keyARN))
+	}
+
+	// Conditions cannot be evaluated without the context of the request, statements with conditions are
+	// assumed to be satisfied.
+	for _, statement := range policy.Statement {
+		if statement.Effect != iamv1.EffectAllow || !matchesAny(statement.Action, createGrantAction) {
+			continue
+		}
+		for _, id := range statement.Principal[iamv1.PrincipalAWS] {
+			if id == iamv1.Any || id == principal {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// hasCreateGrantGrant returns whether a grant of the key allows the principal to create grants.
+// A grant is assumed to allow it if the controller is not allowed to list the grants.
+func (s *Service) hasCreateGrantGrant(keyARN *string, principal string) (bool, error) {
+	granted := false
+	err := s.KMSClient.ListGrantsPagesWithContext(context.TODO(), &kms.ListGrantsInput{KeyId: keyARN}, func(out *kms.ListGrantsResponse, _ bool) bool {
+		for _, grant := range out.Grants {
+			if aws.StringValue(grant.GranteePrincipal) == principal && sets.New(aws.StringValueSlice(grant.Operations)...).Has(kms.GrantOperationCreateGrant) {
+				granted = true
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		if code, _ := awserrors.Code(err); code == errCodeAccessDenied {
+			s.scope.Debug("Not allowed to list the grants of KMS key, skipping validation", "key", aws.StringValue(keyARN))
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "failed to list the grants of KMS key %q", aws.StringValue(keyARN))
+	}
+	return granted, nil
+}
+
+// matchesAny returns whether one of the patterns of a policy statement, which may contain wildcards, matches the action.
+func matchesAny(patterns []string, action string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(strings.ToLower(pattern), strings.ToLower(action)); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms/mock_kmsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts/mock_stsiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	keyARN       = "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	roleARN      = "arn:aws:iam::123456789012:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling"
+	callerARN    = "arn:aws:sts::123456789012:assumed-role/controllers.cluster-api-provider-aws.sigs.k8s.io/capa"
+	keyPolicyFmt = `{"Version":"2012-10-17","Statement":[
+{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}%s]}`
+)
+
+func TestValidateAutoScalingKeyAccess(t *testing.T) {
+	enabledKey := func(accountID string) *kms.DescribeKeyOutput {
+		return &kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{
+			Arn:          aws.String(keyARN),
+			AWSAccountId: aws.String(accountID),
+			KeyManager:   aws.String(kms.KeyManagerTypeCustomer),
+			KeyState:     aws.String(kms.KeyStateEnabled),
+			KeySpec:      aws.String(kms.KeySpecSymmetricDefault),
+		}}
+	}
+	keyPolicy := func(statements string) *kms.GetKeyPolicyOutput {
+		return &kms.GetKeyPolicyOutput{Policy: aws.String(fmt.Sprintf(keyPolicyFmt, statements))}
+	}
+	grants := func(grants ...*kms.GrantListEntry) func(context.Context, *kms.ListGrantsInput, func(*kms.ListGrantsResponse, bool) bool, ...request.Option) error {
+		return func(_ context.Context, _ *kms.ListGrantsInput, fn func(*kms.ListGrantsResponse, bool) bool, _ ...request.Option) error {
+			fn(&kms.ListGrantsResponse{Grants: grants}, true)
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name          string
+		expect        func(m *mock_kmsiface.MockKMSAPIMockRecorder)
+		wantNotUsable string
+		wantErr       bool
+	}{
+		{
+			name: "the key policy allows the service-linked role to create grants",
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKeyWithContext(gomock.Any(), &kms.DescribeKeyInput{KeyId: aws.String("alias/test")}).Return(enabledKey("123456789012"), nil)
+				m.GetKeyPolicyWithContext(gomock.Any(), &kms.GetKeyPolicyInput{KeyId: aws.String(keyARN), PolicyName: aws.String("default")}).
+					Return(keyPolicy(`,{"Effect":"Allow","Principal":{"AWS":["`+roleARN+`"]},"Action":["kms:Create*"],"Resource":"*","Condition":{"Bool":{"kms:GrantIsForAWSResource":true}}}`), nil)
+			},
+		},
+		{
+			name: "a grant allows the service-linked role to create grants",
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKeyWithContext(gomock.Any(), gomock.Any()).Return(enabledKey("123456789012"), nil)
+				m.GetKeyPolicyWithContext(gomock.Any(), gomock.Any()).Return(keyPolicy(""), nil)
+				m.ListGrantsPagesWithContext(gomock.Any(), &kms.ListGrantsInput{KeyId: aws.String(keyARN)}, gomock.Any()).
+					DoAndReturn(grants(&kms.GrantListEntry{GranteePrincipal: aws.String(roleARN), Operations: aws.StringSlice([]string{"Decrypt", "CreateGrant"})}))
+			},
+		},
+		{
+			name: "the key of another account requires a grant",
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKeyWithContext(gomock.Any(), gomock.Any()).Return(enabledKey("210987654321"), nil)
+				m.ListGrantsPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(grants(&kms.GrantListEntry{GranteePrincipal: aws.String(roleARN), Operations: aws.StringSlice([]string{"Decrypt"})}))
+			},
+			wantNotUsable: "neither the key policy nor a grant allows it to call kms:CreateGrant",
+		},
+		{
+			name: "the key policy only allows the account",
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKeyWithContext(gomock.Any(), gomock.Any()).Return(enabledKey("123456789012"), nil)
+				m.GetKeyPolicyWithContext(gomock.Any(), gomock.Any()).Return(keyPolicy(""), nil)
+				m.ListGrantsPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(grants())
+			},
+			wantNotUsable: "neither the key policy nor a grant allows it to call kms:CreateGrant",
+		},
+		{
+			name: "the key does not exist",
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKeyWithContext(gomock.Any(), gomock.Any()).Return(nil, awserr.New(kms.ErrCodeNotFoundException, "not found", nil))
+			},
+			wantNotUsable: "the key does not exist",
+		},
+		{
+			name: "the key is disabled",
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				key := enabledKey("123456789012")
+				key.KeyMetadata.KeyState = aws.String(kms.KeyStateDisabled)
+				m.DescribeKeyWithContext(gomock.Any(), gomock.Any()).Return(key, nil)
+			},
+			wantNotUsable: "the key is Disabled",
+		},
+		{
+			name: "keys managed by AWS are not validated",
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				key := enabledKey("123456789012")
+				key.KeyMetadata.KeyManager = aws.String(kms.KeyManagerTypeAws)
+				m.DescribeKeyWithContext(gomock.Any(), gomock.Any()).Return(key, nil)
+			},
+		},
+		{
+			name: "keys whose policy cannot be read are not validated",
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKeyWithContext(gomock.Any(), gomock.Any()).Return(enabledKey("123456789012"), nil)
+				m.GetKeyPolicyWithContext(gomock.Any(), gomock.Any()).Return(nil, awserr.New("AccessDeniedException", "denied", nil))
+			},
+		},
+		{
+			name: "other errors are returned",
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKeyWithContext(gomock.Any(), gomock.Any()).Return(nil, awserr.New("InternalError", "error", nil))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			kmsMock := mock_kmsiface.NewMockKMSAPI(mockCtrl)
+			stsMock := mock_stsiface.NewMockSTSAPI(mockCtrl)
+			stsMock.EXPECT().GetCallerIdentityWithContext(gomock.Any(), gomock.Any()).Return(&sts.GetCallerIdentityOutput{Arn: aws.String(callerARN)}, nil)
+			tt.expect(kmsMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.KMSClient = kmsMock
+			s.STSClient = stsMock
+
+			err = s.ValidateAutoScalingKeyAccess([]string{"alias/test", "alias/test"})
+			notUsableErr, notUsable := AsKeyNotUsable(err)
+			if tt.wantNotUsable != "" {
+				g.Expect(notUsable).To(BeTrue())
+				g.Expect(notUsableErr.Principal).To(Equal(roleARN))
+				g.Expect(notUsableErr.Reason).To(Equal(tt.wantNotUsable))
+				return
+			}
+			g.Expect(notUsable).To(BeFalse())
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestValidateKeys(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	kmsMock := mock_kmsiface.NewMockKMSAPI(mockCtrl)
+	stsMock := mock_stsiface.NewMockSTSAPI(mockCtrl)
+	stsMock.EXPECT().GetCallerIdentityWithContext(gomock.Any(), gomock.Any()).Return(&sts.GetCallerIdentityOutput{Arn: aws.String(callerARN)}, nil)
+
+	key := func(state, spec string) *kms.DescribeKeyOutput {
+		return &kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{
+			Arn:        aws.String(keyARN),
+			KeyManager: aws.String(kms.KeyManagerTypeCustomer),
+			KeyState:   aws.String(state),
+			KeySpec:    aws.String(spec),
+		}}
+	}
+	kmsMock.EXPECT().DescribeKeyWithContext(gomock.Any(), &kms.DescribeKeyInput{KeyId: aws.String("alias/enabled")}).
+		Return(key(kms.KeyStateEnabled, kms.KeySpecSymmetricDefault), nil)
+	kmsMock.EXPECT().DescribeKeyWithContext(gomock.Any(), &kms.DescribeKeyInput{KeyId: aws.String("alias/pending-deletion")}).
+		Return(key(kms.KeyStatePendingDeletion, kms.KeySpecSymmetricDefault), nil)
+	kmsMock.EXPECT().DescribeKeyWithContext(gomock.Any(), &kms.DescribeKeyInput{KeyId: aws.String("alias/asymmetric")}).
+		Return(key(kms.KeyStateEnabled, kms.KeySpecRsa2048), nil)
+	kmsMock.EXPECT().DescribeKeyWithContext(gomock.Any(), &kms.DescribeKeyInput{KeyId: aws.String("alias/denied")}).
+		Return(nil, awserr.New("AccessDeniedException", "denied", nil))
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		},
+		AWSCluster: &infrav1.AWSCluster{},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(clusterScope)
+	s.KMSClient = kmsMock
+	s.STSClient = stsMock
+
+	err = s.ValidateKeys([]string{"alias/enabled", "alias/pending-deletion", "alias/asymmetric", "alias/denied", "alias/enabled"})
+	g.Expect(err).To(HaveOccurred())
+
+	var reasons []string
+	for _, err := range kerrors.Flatten(err.(kerrors.Aggregate)).Errors() {
+		notUsableErr, notUsable := AsKeyNotUsable(err)
+		g.Expect(notUsable).To(BeTrue())
+		g.Expect(notUsableErr.Principal).To(Equal(callerARN))
+		reasons = append(reasons, notUsableErr.Reason)
+	}
+	g.Expect(reasons).To(ConsistOf("the key is PendingDeletion", "only SYMMETRIC_DEFAULT keys can be used, not RSA_2048 keys"))
+}
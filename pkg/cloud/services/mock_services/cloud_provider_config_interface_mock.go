@@ -0,0 +1,65 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services (interfaces: CloudProviderConfigInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCloudProviderConfigInterface is a mock of CloudProviderConfigInterface interface.
+type MockCloudProviderConfigInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloudProviderConfigInterfaceMockRecorder
+}
+
+// MockCloudProviderConfigInterfaceMockRecorder is the mock recorder for MockCloudProviderConfigInterface.
+type MockCloudProviderConfigInterfaceMockRecorder struct {
+	mock *MockCloudProviderConfigInterface
+}
+
+// NewMockCloudProviderConfigInterface creates a new mock instance.
+func NewMockCloudProviderConfigInterface(ctrl *gomock.Controller) *MockCloudProviderConfigInterface {
+	mock := &MockCloudProviderConfigInterface{ctrl: ctrl}
+	mock.recorder = &MockCloudProviderConfigInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloudProviderConfigInterface) EXPECT() *MockCloudProviderConfigInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ReconcileCloudProviderConfig mocks base method.
+func (m *MockCloudProviderConfigInterface) ReconcileCloudProviderConfig(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileCloudProviderConfig", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileCloudProviderConfig indicates an expected call of ReconcileCloudProviderConfig.
+func (mr *MockCloudProviderConfigInterfaceMockRecorder) ReconcileCloudProviderConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileCloudProviderConfig", reflect.TypeOf((*MockCloudProviderConfigInterface)(nil).ReconcileCloudProviderConfig), arg0)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services (interfaces: CloudWatchLogsInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCloudWatchLogsInterface is a mock of CloudWatchLogsInterface interface.
+type MockCloudWatchLogsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloudWatchLogsInterfaceMockRecorder
+}
+
+// MockCloudWatchLogsInterfaceMockRecorder is the mock recorder for MockCloudWatchLogsInterface.
+type MockCloudWatchLogsInterfaceMockRecorder struct {
+	mock *MockCloudWatchLogsInterface
+}
+
+// NewMockCloudWatchLogsInterface creates a new mock instance.
+func NewMockCloudWatchLogsInterface(ctrl *gomock.Controller) *MockCloudWatchLogsInterface {
+	mock := &MockCloudWatchLogsInterface{ctrl: ctrl}
+	mock.recorder = &MockCloudWatchLogsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloudWatchLogsInterface) EXPECT() *MockCloudWatchLogsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteLogGroups mocks base method.
+func (m *MockCloudWatchLogsInterface) DeleteLogGroups() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogGroups")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLogGroups indicates an expected call of DeleteLogGroups.
+func (mr *MockCloudWatchLogsInterfaceMockRecorder) DeleteLogGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogGroups", reflect.TypeOf((*MockCloudWatchLogsInterface)(nil).DeleteLogGroups))
+}
+
+// ReconcileLogGroup mocks base method.
+func (m *MockCloudWatchLogsInterface) ReconcileLogGroup(arg0 string, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileLogGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileLogGroup indicates an expected call of ReconcileLogGroup.
+func (mr *MockCloudWatchLogsInterfaceMockRecorder) ReconcileLogGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileLogGroup", reflect.TypeOf((*MockCloudWatchLogsInterface)(nil).ReconcileLogGroup), arg0, arg1)
+}
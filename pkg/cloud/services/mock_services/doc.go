@@ -33,10 +33,32 @@ limitations under the License.
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt network_interface_mock.go > _network_interface_mock.go && mv _network_interface_mock.go network_interface_mock.go"
 //go:generate ../../../../hack/tools/bin/mockgen -destination security_group_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services SecurityGroupInterface
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt security_group_interface_mock.go > _security_group_interface_mock.go && mv _security_group_interface_mock.go security_group_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination instance_profile_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services InstanceProfileInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt instance_profile_interface_mock.go > _instance_profile_interface_mock.go && mv _instance_profile_interface_mock.go instance_profile_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination kms_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services KMSInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt kms_interface_mock.go > _kms_interface_mock.go && mv _kms_interface_mock.go kms_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination observability_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services ObservabilityInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt observability_interface_mock.go > _observability_interface_mock.go && mv _observability_interface_mock.go observability_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination budget_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services BudgetInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt budget_interface_mock.go > _budget_interface_mock.go && mv _budget_interface_mock.go budget_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination cloud_provider_config_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services CloudProviderConfigInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt cloud_provider_config_interface_mock.go > _cloud_provider_config_interface_mock.go && mv _cloud_provider_config_interface_mock.go cloud_provider_config_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination private_dns_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services PrivateDNSInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt private_dns_interface_mock.go > _private_dns_interface_mock.go && mv _private_dns_interface_mock.go private_dns_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination cloudwatch_logs_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services CloudWatchLogsInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt cloudwatch_logs_interface_mock.go > _cloudwatch_logs_interface_mock.go && mv _cloudwatch_logs_interface_mock.go cloudwatch_logs_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination session_manager_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services SessionManagerInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt session_manager_interface_mock.go > _session_manager_interface_mock.go && mv _session_manager_interface_mock.go session_manager_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination guardduty_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services GuardDutyInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt guardduty_interface_mock.go > _guardduty_interface_mock.go && mv _guardduty_interface_mock.go guardduty_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination health_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services HealthInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt health_interface_mock.go > _health_interface_mock.go && mv _health_interface_mock.go health_interface_mock.go"
 //go:generate ../../../../hack/tools/bin/mockgen -destination aws_node_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services AWSNodeInterface
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt aws_node_interface_mock.go > _aws_node_interface_mock.go && mv _aws_node_interface_mock.go aws_node_interface_mock.go"
 //go:generate ../../../../hack/tools/bin/mockgen -destination iam_authenticator_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services IAMAuthenticatorInterface
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt iam_authenticator_interface_mock.go > _iam_authenticator_interface_mock.go && mv _iam_authenticator_interface_mock.go iam_authenticator_interface_mock.go"
 //go:generate ../../../../hack/tools/bin/mockgen -destination kube_proxy_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services KubeProxyInterface
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt kube_proxy_interface_mock.go > _kube_proxy_interface_mock.go && mv _kube_proxy_interface_mock.go kube_proxy_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination quota_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services QuotaInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt quota_interface_mock.go > _quota_interface_mock.go && mv _quota_interface_mock.go quota_interface_mock.go"
 package mock_services //nolint:stylecheck
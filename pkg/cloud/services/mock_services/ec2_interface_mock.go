@@ -25,10 +25,12 @@ import (
 
 	ec2 "github.com/aws/aws-sdk-go/service/ec2"
 	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/core/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	v1beta2 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	v1beta20 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	scope "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	v1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
 // MockEC2Interface is a mock of EC2Interface interface.
@@ -112,6 +114,34 @@ func (mr *MockEC2InterfaceMockRecorder) DeleteBastion() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBastion", reflect.TypeOf((*MockEC2Interface)(nil).DeleteBastion))
 }
 
+// DeleteBootstrapDiagnosis mocks base method.
+func (m *MockEC2Interface) DeleteBootstrapDiagnosis(arg0 *v1beta2.BootstrapDiagnosis) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBootstrapDiagnosis", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBootstrapDiagnosis indicates an expected call of DeleteBootstrapDiagnosis.
+func (mr *MockEC2InterfaceMockRecorder) DeleteBootstrapDiagnosis(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBootstrapDiagnosis", reflect.TypeOf((*MockEC2Interface)(nil).DeleteBootstrapDiagnosis), arg0)
+}
+
+// DeleteInstanceConnectEndpoint mocks base method.
+func (m *MockEC2Interface) DeleteInstanceConnectEndpoint() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstanceConnectEndpoint")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInstanceConnectEndpoint indicates an expected call of DeleteInstanceConnectEndpoint.
+func (mr *MockEC2InterfaceMockRecorder) DeleteInstanceConnectEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceConnectEndpoint", reflect.TypeOf((*MockEC2Interface)(nil).DeleteInstanceConnectEndpoint))
+}
+
 // DeleteLaunchTemplate mocks base method.
 func (m *MockEC2Interface) DeleteLaunchTemplate(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -126,6 +156,20 @@ func (mr *MockEC2InterfaceMockRecorder) DeleteLaunchTemplate(arg0 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLaunchTemplate", reflect.TypeOf((*MockEC2Interface)(nil).DeleteLaunchTemplate), arg0)
 }
 
+// DeleteReachabilityAnalysis mocks base method.
+func (m *MockEC2Interface) DeleteReachabilityAnalysis(arg0 *v1beta2.ReachabilityAnalysis) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReachabilityAnalysis", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReachabilityAnalysis indicates an expected call of DeleteReachabilityAnalysis.
+func (mr *MockEC2InterfaceMockRecorder) DeleteReachabilityAnalysis(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReachabilityAnalysis", reflect.TypeOf((*MockEC2Interface)(nil).DeleteReachabilityAnalysis), arg0)
+}
+
 // DetachSecurityGroupsFromNetworkInterface mocks base method.
 func (m *MockEC2Interface) DetachSecurityGroupsFromNetworkInterface(arg0 []string, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -140,6 +184,20 @@ func (mr *MockEC2InterfaceMockRecorder) DetachSecurityGroupsFromNetworkInterface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachSecurityGroupsFromNetworkInterface", reflect.TypeOf((*MockEC2Interface)(nil).DetachSecurityGroupsFromNetworkInterface), arg0, arg1)
 }
 
+// DiagnoseInstanceBootstrap mocks base method.
+func (m *MockEC2Interface) DiagnoseInstanceBootstrap(arg0 *v1beta2.Instance, arg1 v1beta1.APIEndpoint) *v1beta2.BootstrapDiagnosis {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiagnoseInstanceBootstrap", arg0, arg1)
+	ret0, _ := ret[0].(*v1beta2.BootstrapDiagnosis)
+	return ret0
+}
+
+// DiagnoseInstanceBootstrap indicates an expected call of DiagnoseInstanceBootstrap.
+func (mr *MockEC2InterfaceMockRecorder) DiagnoseInstanceBootstrap(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiagnoseInstanceBootstrap", reflect.TypeOf((*MockEC2Interface)(nil).DiagnoseInstanceBootstrap), arg0, arg1)
+}
+
 // DiscoverLaunchTemplateAMI mocks base method.
 func (m *MockEC2Interface) DiscoverLaunchTemplateAMI(arg0 scope.LaunchTemplateScope) (*string, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +243,36 @@ func (mr *MockEC2InterfaceMockRecorder) GetCoreSecurityGroups(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoreSecurityGroups", reflect.TypeOf((*MockEC2Interface)(nil).GetCoreSecurityGroups), arg0)
 }
 
+// GetInstanceMarketTypes mocks base method.
+func (m *MockEC2Interface) GetInstanceMarketTypes(arg0 []string) (map[string]v1beta2.MarketType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceMarketTypes", arg0)
+	ret0, _ := ret[0].(map[string]v1beta2.MarketType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceMarketTypes indicates an expected call of GetInstanceMarketTypes.
+func (mr *MockEC2InterfaceMockRecorder) GetInstanceMarketTypes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceMarketTypes", reflect.TypeOf((*MockEC2Interface)(nil).GetInstanceMarketTypes), arg0)
+}
+
+// GetInstanceScheduledEvents mocks base method.
+func (m *MockEC2Interface) GetInstanceScheduledEvents(arg0 string) ([]v1beta2.ScheduledEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceScheduledEvents", arg0)
+	ret0, _ := ret[0].([]v1beta2.ScheduledEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceScheduledEvents indicates an expected call of GetInstanceScheduledEvents.
+func (mr *MockEC2InterfaceMockRecorder) GetInstanceScheduledEvents(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceScheduledEvents", reflect.TypeOf((*MockEC2Interface)(nil).GetInstanceScheduledEvents), arg0)
+}
+
 // GetInstanceSecurityGroups mocks base method.
 func (m *MockEC2Interface) GetInstanceSecurityGroups(arg0 string) (map[string][]string, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +288,52 @@ func (mr *MockEC2InterfaceMockRecorder) GetInstanceSecurityGroups(arg0 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceSecurityGroups", reflect.TypeOf((*MockEC2Interface)(nil).GetInstanceSecurityGroups), arg0)
 }
 
+// GetInstanceTimelineEvents mocks base method.
+func (m *MockEC2Interface) GetInstanceTimelineEvents(arg0 string, arg1 bool) ([]v1beta2.TimelineEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceTimelineEvents", arg0, arg1)
+	ret0, _ := ret[0].([]v1beta2.TimelineEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceTimelineEvents indicates an expected call of GetInstanceTimelineEvents.
+func (mr *MockEC2InterfaceMockRecorder) GetInstanceTimelineEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceTimelineEvents", reflect.TypeOf((*MockEC2Interface)(nil).GetInstanceTimelineEvents), arg0, arg1)
+}
+
+// GetInstanceTypeAccelerators mocks base method.
+func (m *MockEC2Interface) GetInstanceTypeAccelerators(arg0 string) ([]v1beta2.Accelerator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceTypeAccelerators", arg0)
+	ret0, _ := ret[0].([]v1beta2.Accelerator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceTypeAccelerators indicates an expected call of GetInstanceTypeAccelerators.
+func (mr *MockEC2InterfaceMockRecorder) GetInstanceTypeAccelerators(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceTypeAccelerators", reflect.TypeOf((*MockEC2Interface)(nil).GetInstanceTypeAccelerators), arg0)
+}
+
+// GetInstanceTypeCapacity mocks base method.
+func (m *MockEC2Interface) GetInstanceTypeCapacity(arg0 string) (v1.ResourceList, *v1beta2.NodeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceTypeCapacity", arg0)
+	ret0, _ := ret[0].(v1.ResourceList)
+	ret1, _ := ret[1].(*v1beta2.NodeInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInstanceTypeCapacity indicates an expected call of GetInstanceTypeCapacity.
+func (mr *MockEC2InterfaceMockRecorder) GetInstanceTypeCapacity(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceTypeCapacity", reflect.TypeOf((*MockEC2Interface)(nil).GetInstanceTypeCapacity), arg0)
+}
+
 // GetLaunchTemplate mocks base method.
 func (m *MockEC2Interface) GetLaunchTemplate(arg0 string) (*v1beta20.AWSLaunchTemplate, string, *types.NamespacedName, *string, error) {
 	m.ctrl.T.Helper()
@@ -351,6 +485,34 @@ func (mr *MockEC2InterfaceMockRecorder) ReconcileElasticIPFromPublicPool(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileElasticIPFromPublicPool", reflect.TypeOf((*MockEC2Interface)(nil).ReconcileElasticIPFromPublicPool), arg0, arg1)
 }
 
+// ReconcileInstanceConnectEndpoint mocks base method.
+func (m *MockEC2Interface) ReconcileInstanceConnectEndpoint() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileInstanceConnectEndpoint")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileInstanceConnectEndpoint indicates an expected call of ReconcileInstanceConnectEndpoint.
+func (mr *MockEC2InterfaceMockRecorder) ReconcileInstanceConnectEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileInstanceConnectEndpoint", reflect.TypeOf((*MockEC2Interface)(nil).ReconcileInstanceConnectEndpoint))
+}
+
+// ReconcileInstancesSecurityGroups mocks base method.
+func (m *MockEC2Interface) ReconcileInstancesSecurityGroups(arg0 scope.LaunchTemplateScope, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileInstancesSecurityGroups", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileInstancesSecurityGroups indicates an expected call of ReconcileInstancesSecurityGroups.
+func (mr *MockEC2InterfaceMockRecorder) ReconcileInstancesSecurityGroups(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileInstancesSecurityGroups", reflect.TypeOf((*MockEC2Interface)(nil).ReconcileInstancesSecurityGroups), arg0, arg1)
+}
+
 // ReleaseElasticIP mocks base method.
 func (m *MockEC2Interface) ReleaseElasticIP(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -365,6 +527,21 @@ func (mr *MockEC2InterfaceMockRecorder) ReleaseElasticIP(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseElasticIP", reflect.TypeOf((*MockEC2Interface)(nil).ReleaseElasticIP), arg0)
 }
 
+// StartReachabilityAnalysis mocks base method.
+func (m *MockEC2Interface) StartReachabilityAnalysis(arg0 v1beta2.ReachabilityAnalysisTarget, arg1 *v1beta2.Instance, arg2 v1beta1.APIEndpoint) (*v1beta2.ReachabilityAnalysis, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartReachabilityAnalysis", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1beta2.ReachabilityAnalysis)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartReachabilityAnalysis indicates an expected call of StartReachabilityAnalysis.
+func (mr *MockEC2InterfaceMockRecorder) StartReachabilityAnalysis(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartReachabilityAnalysis", reflect.TypeOf((*MockEC2Interface)(nil).StartReachabilityAnalysis), arg0, arg1, arg2)
+}
+
 // TerminateInstance mocks base method.
 func (m *MockEC2Interface) TerminateInstance(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -393,6 +570,20 @@ func (mr *MockEC2InterfaceMockRecorder) TerminateInstanceAndWait(arg0 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateInstanceAndWait", reflect.TypeOf((*MockEC2Interface)(nil).TerminateInstanceAndWait), arg0)
 }
 
+// UpdateBootstrapDiagnosis mocks base method.
+func (m *MockEC2Interface) UpdateBootstrapDiagnosis(arg0 *v1beta2.BootstrapDiagnosis) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBootstrapDiagnosis", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBootstrapDiagnosis indicates an expected call of UpdateBootstrapDiagnosis.
+func (mr *MockEC2InterfaceMockRecorder) UpdateBootstrapDiagnosis(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBootstrapDiagnosis", reflect.TypeOf((*MockEC2Interface)(nil).UpdateBootstrapDiagnosis), arg0)
+}
+
 // UpdateInstanceSecurityGroups mocks base method.
 func (m *MockEC2Interface) UpdateInstanceSecurityGroups(arg0 string, arg1 []string) error {
 	m.ctrl.T.Helper()
@@ -407,6 +598,20 @@ func (mr *MockEC2InterfaceMockRecorder) UpdateInstanceSecurityGroups(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceSecurityGroups", reflect.TypeOf((*MockEC2Interface)(nil).UpdateInstanceSecurityGroups), arg0, arg1)
 }
 
+// UpdateReachabilityAnalysis mocks base method.
+func (m *MockEC2Interface) UpdateReachabilityAnalysis(arg0 *v1beta2.ReachabilityAnalysis) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReachabilityAnalysis", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReachabilityAnalysis indicates an expected call of UpdateReachabilityAnalysis.
+func (mr *MockEC2InterfaceMockRecorder) UpdateReachabilityAnalysis(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReachabilityAnalysis", reflect.TypeOf((*MockEC2Interface)(nil).UpdateReachabilityAnalysis), arg0)
+}
+
 // UpdateResourceTags mocks base method.
 func (m *MockEC2Interface) UpdateResourceTags(arg0 *string, arg1, arg2 map[string]string) error {
 	m.ctrl.T.Helper()
@@ -420,3 +625,31 @@ func (mr *MockEC2InterfaceMockRecorder) UpdateResourceTags(arg0, arg1, arg2 inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceTags", reflect.TypeOf((*MockEC2Interface)(nil).UpdateResourceTags), arg0, arg1, arg2)
 }
+
+// ValidateCapacityBlock mocks base method.
+func (m *MockEC2Interface) ValidateCapacityBlock(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCapacityBlock", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateCapacityBlock indicates an expected call of ValidateCapacityBlock.
+func (mr *MockEC2InterfaceMockRecorder) ValidateCapacityBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCapacityBlock", reflect.TypeOf((*MockEC2Interface)(nil).ValidateCapacityBlock), arg0, arg1)
+}
+
+// ValidateRegion mocks base method.
+func (m *MockEC2Interface) ValidateRegion() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateRegion")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateRegion indicates an expected call of ValidateRegion.
+func (mr *MockEC2InterfaceMockRecorder) ValidateRegion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRegion", reflect.TypeOf((*MockEC2Interface)(nil).ValidateRegion))
+}
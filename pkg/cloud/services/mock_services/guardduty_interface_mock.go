@@ -0,0 +1,78 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services (interfaces: GuardDutyInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGuardDutyInterface is a mock of GuardDutyInterface interface.
+type MockGuardDutyInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGuardDutyInterfaceMockRecorder
+}
+
+// MockGuardDutyInterfaceMockRecorder is the mock recorder for MockGuardDutyInterface.
+type MockGuardDutyInterfaceMockRecorder struct {
+	mock *MockGuardDutyInterface
+}
+
+// NewMockGuardDutyInterface creates a new mock instance.
+func NewMockGuardDutyInterface(ctrl *gomock.Controller) *MockGuardDutyInterface {
+	mock := &MockGuardDutyInterface{ctrl: ctrl}
+	mock.recorder = &MockGuardDutyInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGuardDutyInterface) EXPECT() *MockGuardDutyInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteRuntimeMonitoring mocks base method.
+func (m *MockGuardDutyInterface) DeleteRuntimeMonitoring() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRuntimeMonitoring")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRuntimeMonitoring indicates an expected call of DeleteRuntimeMonitoring.
+func (mr *MockGuardDutyInterfaceMockRecorder) DeleteRuntimeMonitoring() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRuntimeMonitoring", reflect.TypeOf((*MockGuardDutyInterface)(nil).DeleteRuntimeMonitoring))
+}
+
+// ReconcileRuntimeMonitoring mocks base method.
+func (m *MockGuardDutyInterface) ReconcileRuntimeMonitoring() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileRuntimeMonitoring")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileRuntimeMonitoring indicates an expected call of ReconcileRuntimeMonitoring.
+func (mr *MockGuardDutyInterfaceMockRecorder) ReconcileRuntimeMonitoring() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileRuntimeMonitoring", reflect.TypeOf((*MockGuardDutyInterface)(nil).ReconcileRuntimeMonitoring))
+}
@@ -0,0 +1,66 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services (interfaces: HealthInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1beta2 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// MockHealthInterface is a mock of HealthInterface interface.
+type MockHealthInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockHealthInterfaceMockRecorder
+}
+
+// MockHealthInterfaceMockRecorder is the mock recorder for MockHealthInterface.
+type MockHealthInterfaceMockRecorder struct {
+	mock *MockHealthInterface
+}
+
+// NewMockHealthInterface creates a new mock instance.
+func NewMockHealthInterface(ctrl *gomock.Controller) *MockHealthInterface {
+	mock := &MockHealthInterface{ctrl: ctrl}
+	mock.recorder = &MockHealthInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHealthInterface) EXPECT() *MockHealthInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetInstanceHealthEvents mocks base method.
+func (m *MockHealthInterface) GetInstanceHealthEvents(arg0 string) ([]v1beta2.ScheduledEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceHealthEvents", arg0)
+	ret0, _ := ret[0].([]v1beta2.ScheduledEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceHealthEvents indicates an expected call of GetInstanceHealthEvents.
+func (mr *MockHealthInterfaceMockRecorder) GetInstanceHealthEvents(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceHealthEvents", reflect.TypeOf((*MockHealthInterface)(nil).GetInstanceHealthEvents), arg0)
+}
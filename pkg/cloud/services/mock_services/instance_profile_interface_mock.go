@@ -0,0 +1,80 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services (interfaces: InstanceProfileInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1beta2 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// MockInstanceProfileInterface is a mock of InstanceProfileInterface interface.
+type MockInstanceProfileInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInstanceProfileInterfaceMockRecorder
+}
+
+// MockInstanceProfileInterfaceMockRecorder is the mock recorder for MockInstanceProfileInterface.
+type MockInstanceProfileInterfaceMockRecorder struct {
+	mock *MockInstanceProfileInterface
+}
+
+// NewMockInstanceProfileInterface creates a new mock instance.
+func NewMockInstanceProfileInterface(ctrl *gomock.Controller) *MockInstanceProfileInterface {
+	mock := &MockInstanceProfileInterface{ctrl: ctrl}
+	mock.recorder = &MockInstanceProfileInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInstanceProfileInterface) EXPECT() *MockInstanceProfileInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteInstanceProfile mocks base method.
+func (m *MockInstanceProfileInterface) DeleteInstanceProfile(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstanceProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInstanceProfile indicates an expected call of DeleteInstanceProfile.
+func (mr *MockInstanceProfileInterfaceMockRecorder) DeleteInstanceProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceProfile", reflect.TypeOf((*MockInstanceProfileInterface)(nil).DeleteInstanceProfile), arg0, arg1)
+}
+
+// ReconcileInstanceProfile mocks base method.
+func (m *MockInstanceProfileInterface) ReconcileInstanceProfile(arg0 context.Context, arg1 *v1beta2.ManagedIAMInstanceProfile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileInstanceProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileInstanceProfile indicates an expected call of ReconcileInstanceProfile.
+func (mr *MockInstanceProfileInterfaceMockRecorder) ReconcileInstanceProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileInstanceProfile", reflect.TypeOf((*MockInstanceProfileInterface)(nil).ReconcileInstanceProfile), arg0, arg1)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services (interfaces: KMSInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockKMSInterface is a mock of KMSInterface interface.
+type MockKMSInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockKMSInterfaceMockRecorder
+}
+
+// MockKMSInterfaceMockRecorder is the mock recorder for MockKMSInterface.
+type MockKMSInterfaceMockRecorder struct {
+	mock *MockKMSInterface
+}
+
+// NewMockKMSInterface creates a new mock instance.
+func NewMockKMSInterface(ctrl *gomock.Controller) *MockKMSInterface {
+	mock := &MockKMSInterface{ctrl: ctrl}
+	mock.recorder = &MockKMSInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKMSInterface) EXPECT() *MockKMSInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ValidateAutoScalingKeyAccess mocks base method.
+func (m *MockKMSInterface) ValidateAutoScalingKeyAccess(arg0 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAutoScalingKeyAccess", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateAutoScalingKeyAccess indicates an expected call of ValidateAutoScalingKeyAccess.
+func (mr *MockKMSInterfaceMockRecorder) ValidateAutoScalingKeyAccess(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAutoScalingKeyAccess", reflect.TypeOf((*MockKMSInterface)(nil).ValidateAutoScalingKeyAccess), arg0)
+}
+
+// ValidateKeys mocks base method.
+func (m *MockKMSInterface) ValidateKeys(arg0 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateKeys", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateKeys indicates an expected call of ValidateKeys.
+func (mr *MockKMSInterfaceMockRecorder) ValidateKeys(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateKeys", reflect.TypeOf((*MockKMSInterface)(nil).ValidateKeys), arg0)
+}
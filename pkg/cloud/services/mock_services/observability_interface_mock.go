@@ -0,0 +1,79 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services (interfaces: ObservabilityInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockObservabilityInterface is a mock of ObservabilityInterface interface.
+type MockObservabilityInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockObservabilityInterfaceMockRecorder
+}
+
+// MockObservabilityInterfaceMockRecorder is the mock recorder for MockObservabilityInterface.
+type MockObservabilityInterfaceMockRecorder struct {
+	mock *MockObservabilityInterface
+}
+
+// NewMockObservabilityInterface creates a new mock instance.
+func NewMockObservabilityInterface(ctrl *gomock.Controller) *MockObservabilityInterface {
+	mock := &MockObservabilityInterface{ctrl: ctrl}
+	mock.recorder = &MockObservabilityInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObservabilityInterface) EXPECT() *MockObservabilityInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteObservability mocks base method.
+func (m *MockObservabilityInterface) DeleteObservability(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObservability", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObservability indicates an expected call of DeleteObservability.
+func (mr *MockObservabilityInterfaceMockRecorder) DeleteObservability(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObservability", reflect.TypeOf((*MockObservabilityInterface)(nil).DeleteObservability), arg0)
+}
+
+// ReconcileObservability mocks base method.
+func (m *MockObservabilityInterface) ReconcileObservability(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileObservability", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileObservability indicates an expected call of ReconcileObservability.
+func (mr *MockObservabilityInterfaceMockRecorder) ReconcileObservability(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileObservability", reflect.TypeOf((*MockObservabilityInterface)(nil).ReconcileObservability), arg0)
+}
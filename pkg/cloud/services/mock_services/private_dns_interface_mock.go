@@ -0,0 +1,78 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services (interfaces: PrivateDNSInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPrivateDNSInterface is a mock of PrivateDNSInterface interface.
+type MockPrivateDNSInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivateDNSInterfaceMockRecorder
+}
+
+// MockPrivateDNSInterfaceMockRecorder is the mock recorder for MockPrivateDNSInterface.
+type MockPrivateDNSInterfaceMockRecorder struct {
+	mock *MockPrivateDNSInterface
+}
+
+// NewMockPrivateDNSInterface creates a new mock instance.
+func NewMockPrivateDNSInterface(ctrl *gomock.Controller) *MockPrivateDNSInterface {
+	mock := &MockPrivateDNSInterface{ctrl: ctrl}
+	mock.recorder = &MockPrivateDNSInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivateDNSInterface) EXPECT() *MockPrivateDNSInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeletePrivateDNS mocks base method.
+func (m *MockPrivateDNSInterface) DeletePrivateDNS() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivateDNS")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrivateDNS indicates an expected call of DeletePrivateDNS.
+func (mr *MockPrivateDNSInterfaceMockRecorder) DeletePrivateDNS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateDNS", reflect.TypeOf((*MockPrivateDNSInterface)(nil).DeletePrivateDNS))
+}
+
+// ReconcilePrivateDNS mocks base method.
+func (m *MockPrivateDNSInterface) ReconcilePrivateDNS() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcilePrivateDNS")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcilePrivateDNS indicates an expected call of ReconcilePrivateDNS.
+func (mr *MockPrivateDNSInterfaceMockRecorder) ReconcilePrivateDNS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcilePrivateDNS", reflect.TypeOf((*MockPrivateDNSInterface)(nil).ReconcilePrivateDNS))
+}
@@ -0,0 +1,109 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services (interfaces: QuotaInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1beta2 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	quota "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/quota"
+)
+
+// MockQuotaInterface is a mock of QuotaInterface interface.
+type MockQuotaInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuotaInterfaceMockRecorder
+}
+
+// MockQuotaInterfaceMockRecorder is the mock recorder for MockQuotaInterface.
+type MockQuotaInterfaceMockRecorder struct {
+	mock *MockQuotaInterface
+}
+
+// NewMockQuotaInterface creates a new mock instance.
+func NewMockQuotaInterface(ctrl *gomock.Controller) *MockQuotaInterface {
+	mock := &MockQuotaInterface{ctrl: ctrl}
+	mock.recorder = &MockQuotaInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuotaInterface) EXPECT() *MockQuotaInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CheckElasticIPQuota mocks base method.
+func (m *MockQuotaInterface) CheckElasticIPQuota(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckElasticIPQuota", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckElasticIPQuota indicates an expected call of CheckElasticIPQuota.
+func (mr *MockQuotaInterfaceMockRecorder) CheckElasticIPQuota(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckElasticIPQuota", reflect.TypeOf((*MockQuotaInterface)(nil).CheckElasticIPQuota), arg0)
+}
+
+// CheckVCPUQuota mocks base method.
+func (m *MockQuotaInterface) CheckVCPUQuota(arg0 string, arg1 bool, arg2 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckVCPUQuota", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckVCPUQuota indicates an expected call of CheckVCPUQuota.
+func (mr *MockQuotaInterfaceMockRecorder) CheckVCPUQuota(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVCPUQuota", reflect.TypeOf((*MockQuotaInterface)(nil).CheckVCPUQuota), arg0, arg1, arg2)
+}
+
+// CheckVPCQuota mocks base method.
+func (m *MockQuotaInterface) CheckVPCQuota(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckVPCQuota", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckVPCQuota indicates an expected call of CheckVPCQuota.
+func (mr *MockQuotaInterfaceMockRecorder) CheckVPCQuota(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVPCQuota", reflect.TypeOf((*MockQuotaInterface)(nil).CheckVPCQuota), arg0)
+}
+
+// ResourceLimits mocks base method.
+func (m *MockQuotaInterface) ResourceLimits(arg0 []quota.InstanceType) (*v1beta2.ResourceLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourceLimits", arg0)
+	ret0, _ := ret[0].(*v1beta2.ResourceLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResourceLimits indicates an expected call of ResourceLimits.
+func (mr *MockQuotaInterfaceMockRecorder) ResourceLimits(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceLimits", reflect.TypeOf((*MockQuotaInterface)(nil).ResourceLimits), arg0)
+}
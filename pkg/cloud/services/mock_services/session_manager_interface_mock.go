@@ -0,0 +1,79 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services (interfaces: SessionManagerInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1beta2 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// MockSessionManagerInterface is a mock of SessionManagerInterface interface.
+type MockSessionManagerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionManagerInterfaceMockRecorder
+}
+
+// MockSessionManagerInterfaceMockRecorder is the mock recorder for MockSessionManagerInterface.
+type MockSessionManagerInterfaceMockRecorder struct {
+	mock *MockSessionManagerInterface
+}
+
+// NewMockSessionManagerInterface creates a new mock instance.
+func NewMockSessionManagerInterface(ctrl *gomock.Controller) *MockSessionManagerInterface {
+	mock := &MockSessionManagerInterface{ctrl: ctrl}
+	mock.recorder = &MockSessionManagerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionManagerInterface) EXPECT() *MockSessionManagerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteSessionManagerPreferences mocks base method.
+func (m *MockSessionManagerInterface) DeleteSessionManagerPreferences(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionManagerPreferences", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSessionManagerPreferences indicates an expected call of DeleteSessionManagerPreferences.
+func (mr *MockSessionManagerInterfaceMockRecorder) DeleteSessionManagerPreferences(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionManagerPreferences", reflect.TypeOf((*MockSessionManagerInterface)(nil).DeleteSessionManagerPreferences), arg0)
+}
+
+// ReconcileSessionManagerPreferences mocks base method.
+func (m *MockSessionManagerInterface) ReconcileSessionManagerPreferences(arg0 *v1beta2.SessionManager) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileSessionManagerPreferences", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileSessionManagerPreferences indicates an expected call of ReconcileSessionManagerPreferences.
+func (mr *MockSessionManagerInterfaceMockRecorder) ReconcileSessionManagerPreferences(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileSessionManagerPreferences", reflect.TypeOf((*MockSessionManagerInterface)(nil).ReconcileSessionManagerPreferences), arg0)
+}
@@ -19,6 +19,8 @@ package network
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -46,6 +48,15 @@ func (s *Service) getOrAllocateAddresses(num int, role string, pool *infrav1.Ela
 		}
 	}
 
+	// Adopt pre-tagged addresses from the pool before allocating new ones.
+	if len(eips) < num {
+		adopted, err := s.adoptAddresses(num-len(eips), role, pool)
+		if err != nil {
+			return nil, err
+		}
+		eips = append(eips, adopted...)
+	}
+
 	// allocate addresses when needed.
 	tagSpecifications := tags.BuildParamsToTagSpecification(ec2.ResourceTypeElasticIp, s.getEIPTagParams(role))
 	for len(eips) < num {
@@ -75,6 +86,76 @@ func (s *Service) getOrAllocateAddresses(num int, role string, pool *infrav1.Ela
 	return eips, nil
 }
 
+// adoptAddresses claims up to num Elastic IPs matching the tag selector of the pool. Addresses
+// which are associated, or already claimed by a cluster, are skipped. Claimed addresses are tagged
+// as shared with the cluster and with the role, so they are reused on the next reconciliation.
+func (s *Service) adoptAddresses(num int, role string, pool *infrav1.ElasticIPPool) ([]string, error) {
+	if !pool.HasTagSelector() {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(pool.TagSelector))
+	for key := range pool.TagSelector {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	filters := make([]*ec2.Filter, 0, len(keys))
+	for _, key := range keys {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String(fmt.Sprintf("tag:%s", key)),
+			Values: aws.StringSlice([]string{pool.TagSelector[key]}),
+		})
+	}
+
+	out, err := s.EC2Client.DescribeAddressesWithContext(context.TODO(), &ec2.DescribeAddressesInput{
+		Filters: filters,
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDescribeAddresses", "Failed to query Elastic IPs to adopt for role %q: %v", role, err)
+		return nil, errors.Wrap(err, "failed to query addresses to adopt")
+	}
+
+	var eips []string
+	for _, address := range out.Addresses {
+		if len(eips) == num {
+			break
+		}
+		if address.AssociationId != nil || isClaimedAddress(address) {
+			continue
+		}
+		if _, err := s.EC2Client.CreateTagsWithContext(context.TODO(), &ec2.CreateTagsInput{
+			Resources: []*string{address.AllocationId},
+			Tags: []*ec2.Tag{
+				{
+					Key:   aws.String(infrav1.ClusterTagKey(s.scope.Name())),
+					Value: aws.String(string(infrav1.ResourceLifecycleShared)),
+				},
+				{
+					Key:   aws.String(infrav1.NameAWSClusterAPIRole),
+					Value: aws.String(role),
+				},
+			},
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedAdoptAddress", "Failed to adopt Elastic IP %q for %q: %v", aws.StringValue(address.AllocationId), role, err)
+			return nil, errors.Wrapf(err, "failed to adopt Elastic IP %q", aws.StringValue(address.AllocationId))
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulAdoptAddress", "Adopted Elastic IP %q for %q", aws.StringValue(address.AllocationId), role)
+		eips = append(eips, aws.StringValue(address.AllocationId))
+	}
+
+	return eips, nil
+}
+
+// isClaimedAddress returns true when the address is tagged as belonging to a cluster.
+func isClaimedAddress(address *ec2.Address) bool {
+	for _, tag := range address.Tags {
+		if strings.HasPrefix(aws.StringValue(tag.Key), infrav1.NameAWSProviderOwned) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Service) allocateAddress(alloc *ec2.AllocateAddressInput) (string, error) {
 	out, err := s.EC2Client.AllocateAddressWithContext(context.TODO(), alloc)
 	if err != nil {
@@ -166,12 +247,52 @@ func (s *Service) releaseAddressesWithFilter(filters []*ec2.Filter) error {
 	return nil
 }
 
+// returnAddressesWithFilter hands the adopted addresses matching the filters back to their pool,
+// disassociating them and removing the tags which claimed them for the cluster.
+func (s *Service) returnAddressesWithFilter(filters []*ec2.Filter) error {
+	out, err := s.EC2Client.DescribeAddressesWithContext(context.TODO(), &ec2.DescribeAddressesInput{
+		Filters: filters,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe adopted elastic IPs")
+	}
+	if out == nil {
+		return nil
+	}
+	for _, ip := range out.Addresses {
+		if ip.AssociationId != nil {
+			if err := s.disassociateAddress(ip); err != nil {
+				return err
+			}
+		}
+		if _, err := s.EC2Client.DeleteTagsWithContext(context.TODO(), &ec2.DeleteTagsInput{
+			Resources: []*string{ip.AllocationId},
+			Tags: []*ec2.Tag{
+				{Key: aws.String(infrav1.ClusterTagKey(s.scope.Name()))},
+				{Key: aws.String(infrav1.NameAWSClusterAPIRole)},
+			},
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedReturnEIP", "Failed to return adopted Elastic IP %q: %v", aws.StringValue(ip.AllocationId), err)
+			return errors.Wrapf(err, "failed to return adopted ElasticIP %q", aws.StringValue(ip.AllocationId))
+		}
+		s.scope.Info("returned adopted ElasticIP", "eip", aws.StringValue(ip.PublicIp), "allocation-id", aws.StringValue(ip.AllocationId))
+	}
+	return nil
+}
+
 // releaseAddresses is default cluster release flow, discoverying and releasing all
-// addresses associated and owned by the cluster tag.
+// addresses associated and owned by the cluster tag. Addresses adopted from the
+// Elastic IP pool are handed back to the pool instead of being released.
 func (s *Service) releaseAddresses() error {
 	filters := []*ec2.Filter{filter.EC2.Cluster(s.scope.Name())}
 	filters = append(filters, filter.EC2.ClusterOwned(s.scope.Name()))
-	return s.releaseAddressesWithFilter(filters)
+	if err := s.releaseAddressesWithFilter(filters); err != nil {
+		return err
+	}
+	if !s.scope.VPC().GetElasticIPPool().HasTagSelector() {
+		return nil
+	}
+	return s.returnAddressesWithFilter([]*ec2.Filter{filter.EC2.ClusterShared(s.scope.Name())})
 }
 
 func (s *Service) getEIPTagParams(role string) infrav1.BuildParams {
@@ -196,11 +317,18 @@ func (s *Service) GetAddresses(role string) (*ec2.DescribeAddressesOutput, error
 	return s.describeAddresses(role)
 }
 
-// ReleaseAddressByRole releases EIP addresses filtering by tag CAPA provider role.
+// ReleaseAddressByRole releases EIP addresses filtering by tag CAPA provider role, handing
+// adopted addresses back to their pool.
 func (s *Service) ReleaseAddressByRole(role string) error {
-	return s.releaseAddressesWithFilter([]*ec2.Filter{
+	if err := s.releaseAddressesWithFilter([]*ec2.Filter{
 		filter.EC2.ClusterOwned(s.scope.Name()),
 		filter.EC2.ProviderRole(role),
+	}); err != nil {
+		return err
+	}
+	return s.returnAddressesWithFilter([]*ec2.Filter{
+		filter.EC2.ClusterShared(s.scope.Name()),
+		filter.EC2.ProviderRole(role),
 	})
 }
 
@@ -26,6 +26,7 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -173,3 +174,233 @@ func TestServiceReleaseAddresses(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceGetOrAllocateAddressesFromTagSelector(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pool := &infrav1.ElasticIPPool{
+		TagSelector: map[string]string{"eip-pool": "capa"},
+	}
+	clusterAddressesFilter := &ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("tag-key"),
+				Values: aws.StringSlice([]string{"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"}),
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"),
+				Values: aws.StringSlice([]string{"common"}),
+			},
+		},
+	}
+	poolAddressesFilter := &ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("tag:eip-pool"),
+				Values: aws.StringSlice([]string{"capa"}),
+			},
+		},
+	}
+	adoptTags := func(allocationID string) *ec2.CreateTagsInput {
+		return &ec2.CreateTagsInput{
+			Resources: aws.StringSlice([]string{allocationID}),
+			Tags: []*ec2.Tag{
+				{
+					Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+					Value: aws.String("shared"),
+				},
+				{
+					Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+					Value: aws.String("common"),
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		num     int
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "Should adopt unassociated addresses which are not claimed by another cluster",
+			num:  2,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddressesWithContext(context.TODO(), gomock.Eq(clusterAddressesFilter)).Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.DescribeAddressesWithContext(context.TODO(), gomock.Eq(poolAddressesFilter)).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{
+							AllocationId:  aws.String("eipalloc-associated"),
+							AssociationId: aws.String("eipassoc-1"),
+						},
+						{
+							AllocationId: aws.String("eipalloc-claimed"),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/other-cluster"),
+									Value: aws.String("shared"),
+								},
+							},
+						},
+						{
+							AllocationId: aws.String("eipalloc-1"),
+						},
+						{
+							AllocationId: aws.String("eipalloc-2"),
+						},
+					},
+				}, nil)
+				m.CreateTagsWithContext(context.TODO(), gomock.Eq(adoptTags("eipalloc-1"))).Return(&ec2.CreateTagsOutput{}, nil)
+				m.CreateTagsWithContext(context.TODO(), gomock.Eq(adoptTags("eipalloc-2"))).Return(&ec2.CreateTagsOutput{}, nil)
+			},
+			want: []string{"eipalloc-1", "eipalloc-2"},
+		},
+		{
+			name: "Should allocate new addresses when the pool does not have enough addresses",
+			num:  2,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddressesWithContext(context.TODO(), gomock.Eq(clusterAddressesFilter)).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{
+							AllocationId: aws.String("eipalloc-1"),
+						},
+					},
+				}, nil)
+				m.DescribeAddressesWithContext(context.TODO(), gomock.Eq(poolAddressesFilter)).Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.AllocateAddressWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.AllocateAddressInput{})).Return(&ec2.AllocateAddressOutput{
+					AllocationId: aws.String("eipalloc-new"),
+				}, nil)
+			},
+			want: []string{"eipalloc-1", "eipalloc-new"},
+		},
+		{
+			name: "Should return error if failed to adopt an address",
+			num:  1,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeAddressesWithContext(context.TODO(), gomock.Eq(clusterAddressesFilter)).Return(&ec2.DescribeAddressesOutput{}, nil)
+				m.DescribeAddressesWithContext(context.TODO(), gomock.Eq(poolAddressesFilter)).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{
+							AllocationId: aws.String("eipalloc-1"),
+						},
+					},
+				}, nil)
+				m.CreateTagsWithContext(context.TODO(), gomock.Eq(adoptTags("eipalloc-1"))).Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"}},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(cs)
+			s.EC2Client = ec2Mock
+
+			if tt.expect != nil {
+				tt.expect(ec2Mock.EXPECT())
+			}
+
+			eips, err := s.GetOrAllocateAddresses(pool, tt.num, infrav1.CommonRoleTagValue)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(eips).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestServiceReleaseAdoptedAddresses(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+	scheme := runtime.NewScheme()
+	err := infrav1.AddToScheme(scheme)
+	g.Expect(err).NotTo(HaveOccurred())
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:  client,
+		Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"}},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{
+					VPC: infrav1.VPCSpec{
+						ElasticIPPool: &infrav1.ElasticIPPool{
+							TagSelector: map[string]string{"eip-pool": "capa"},
+						},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(cs)
+	s.EC2Client = ec2Mock
+
+	m := ec2Mock.EXPECT()
+	m.DescribeAddressesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("tag-key"),
+				Values: aws.StringSlice([]string{"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"}),
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: aws.StringSlice([]string{"owned"}),
+			},
+		},
+	})).Return(&ec2.DescribeAddressesOutput{}, nil)
+	m.DescribeAddressesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: aws.StringSlice([]string{"shared"}),
+			},
+		},
+	})).Return(&ec2.DescribeAddressesOutput{
+		Addresses: []*ec2.Address{
+			{
+				AllocationId:  aws.String("eipalloc-1"),
+				AssociationId: aws.String("eipassoc-1"),
+				PublicIp:      aws.String("public-ip"),
+			},
+		},
+	}, nil)
+	m.DisassociateAddressWithContext(context.TODO(), gomock.Eq(&ec2.DisassociateAddressInput{
+		AssociationId: aws.String("eipassoc-1"),
+	})).Return(&ec2.DisassociateAddressOutput{}, nil)
+	m.DeleteTagsWithContext(context.TODO(), gomock.Eq(&ec2.DeleteTagsInput{
+		Resources: aws.StringSlice([]string{"eipalloc-1"}),
+		Tags: []*ec2.Tag{
+			{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster")},
+			{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/role")},
+		},
+	})).Return(&ec2.DeleteTagsOutput{}, nil)
+	m.ReleaseAddressWithContext(context.TODO(), gomock.Any()).Times(0)
+
+	g.Expect(s.releaseAddresses()).To(Succeed())
+}
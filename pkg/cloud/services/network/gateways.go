@@ -41,6 +41,11 @@ func (s *Service) reconcileInternetGateways() error {
 		return nil
 	}
 
+	if s.scope.VPC().IsPrivateNatGateway() {
+		s.scope.Trace("Skipping internet gateways reconcile, NAT gateways have private connectivity")
+		return nil
+	}
+
 	s.scope.Debug("Reconciling internet gateways")
 
 	igs, err := s.describeVpcInternetGateways()
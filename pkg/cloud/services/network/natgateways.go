@@ -119,8 +119,15 @@ func (s *Service) updateNatGatewayIPs(updateTags bool) ([]string, error) {
 		}
 
 		if ngw, ok := existing[sn.GetResourceID()]; ok {
-			if len(ngw.NatGatewayAddresses) > 0 && ngw.NatGatewayAddresses[0].PublicIp != nil {
-				natGatewaysIPs = append(natGatewaysIPs, *ngw.NatGatewayAddresses[0].PublicIp)
+			if len(ngw.NatGatewayAddresses) > 0 {
+				addr := ngw.NatGatewayAddresses[0]
+				switch {
+				case addr.PublicIp != nil:
+					natGatewaysIPs = append(natGatewaysIPs, *addr.PublicIp)
+				case aws.StringValue(ngw.ConnectivityType) == ec2.ConnectivityTypePrivate && addr.PrivateIp != nil:
+					// Private NAT gateways have no public IP, the egress traffic is sourced from their private IP.
+					natGatewaysIPs = append(natGatewaysIPs, *addr.PrivateIp)
+				}
 			}
 			if updateTags {
 				// Make sure tags are up to date.
@@ -197,6 +204,70 @@ func (s *Service) deleteNatGateways() error {
 	return kerrors.NewAggregate(errs)
 }
 
+// deleteStaleNatGateways removes the managed NAT gateways left behind in public subnets
+// that are no longer part of the cluster spec. It runs after the route tables have been
+// reconciled so that private subnets are switched over to the replacement NAT gateways
+// before the previous ones are deleted, and it leaves any NAT gateway that is still
+// targeted by a route alone.
+func (s *Service) deleteStaleNatGateways() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping stale NAT gateway deletion in unmanaged mode")
+		return nil
+	}
+
+	existing, err := s.describeNatGatewaysBySubnet()
+	if err != nil {
+		return err
+	}
+
+	publicSubnets := make(map[string]struct{})
+	for _, sn := range s.scope.Subnets().FilterPublic() {
+		if sn.GetResourceID() != "" {
+			publicSubnets[sn.GetResourceID()] = struct{}{}
+		}
+	}
+
+	var stale []*ec2.NatGateway
+	for subnetID, ngw := range existing {
+		if _, ok := publicSubnets[subnetID]; ok {
+			continue
+		}
+		if converters.TagsToMap(ngw.Tags)[infrav1.ClusterTagKey(s.scope.Name())] != string(infrav1.ResourceLifecycleOwned) {
+			continue
+		}
+		stale = append(stale, ngw)
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+
+	routeTables, err := s.describeVpcRouteTables()
+	if err != nil {
+		return err
+	}
+	routed := make(map[string]struct{})
+	for _, rt := range routeTables {
+		for _, route := range rt.Routes {
+			if route.NatGatewayId != nil {
+				routed[*route.NatGatewayId] = struct{}{}
+			}
+		}
+	}
+
+	errs := []error{}
+	for _, ngw := range stale {
+		if _, ok := routed[*ngw.NatGatewayId]; ok {
+			s.scope.Debug("Stale NAT gateway is still targeted by a route, skipping deletion", "nat-gateway-id", *ngw.NatGatewayId, "subnet-id", aws.StringValue(ngw.SubnetId))
+			continue
+		}
+		if err := s.deleteNatGateway(*ngw.NatGatewayId); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
 func (s *Service) describeNatGatewaysBySubnet() (map[string]*ec2.NatGateway, error) {
 	describeNatGatewayInput := &ec2.DescribeNatGatewaysInput{
 		Filter: []*ec2.Filter{
@@ -236,9 +307,13 @@ func (s *Service) getNatGatewayTagParams(id string) infrav1.BuildParams {
 }
 
 func (s *Service) createNatGateways(subnetIDs []string) (natgateways []*ec2.NatGateway, err error) {
-	eips, err := s.getOrAllocateAddresses(len(subnetIDs), infrav1.CommonRoleTagValue, s.scope.VPC().GetElasticIPPool())
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to create one or more IP addresses for NAT gateways")
+	// Private NAT gateways don't use Elastic IP addresses.
+	eips := make([]string, len(subnetIDs))
+	if !s.scope.VPC().IsPrivateNatGateway() {
+		eips, err = s.getOrAllocateAddresses(len(subnetIDs), infrav1.CommonRoleTagValue, s.scope.VPC().GetElasticIPPool())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create one or more IP addresses for NAT gateways")
+		}
 	}
 	type ngwCreation struct {
 		natGateway *ec2.NatGateway
@@ -267,12 +342,18 @@ func (s *Service) createNatGateway(subnetID, ip string) (*ec2.NatGateway, error)
 	var out *ec2.CreateNatGatewayOutput
 	var err error
 
+	input := &ec2.CreateNatGatewayInput{
+		SubnetId:          aws.String(subnetID),
+		TagSpecifications: []*ec2.TagSpecification{tags.BuildParamsToTagSpecification(ec2.ResourceTypeNatgateway, s.getNatGatewayTagParams(services.TemporaryResourceID))},
+	}
+	if s.scope.VPC().IsPrivateNatGateway() {
+		input.ConnectivityType = aws.String(ec2.ConnectivityTypePrivate)
+	} else {
+		input.AllocationId = aws.String(ip)
+	}
+
 	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-		if out, err = s.EC2Client.CreateNatGatewayWithContext(context.TODO(), &ec2.CreateNatGatewayInput{
-			SubnetId:          aws.String(subnetID),
-			AllocationId:      aws.String(ip),
-			TagSpecifications: []*ec2.TagSpecification{tags.BuildParamsToTagSpecification(ec2.ResourceTypeNatgateway, s.getNatGatewayTagParams(services.TemporaryResourceID))},
-		}); err != nil {
+		if out, err = s.EC2Client.CreateNatGatewayWithContext(context.TODO(), input); err != nil {
 			return false, err
 		}
 		return true, nil
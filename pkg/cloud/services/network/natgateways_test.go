@@ -46,9 +46,10 @@ func TestReconcileNatGateways(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	testCases := []struct {
-		name   string
-		input  []infrav1.SubnetSpec
-		expect func(m *mocks.MockEC2APIMockRecorder)
+		name       string
+		input      []infrav1.SubnetSpec
+		natGateway *infrav1.NatGatewaySpec
+		expect     func(m *mocks.MockEC2APIMockRecorder)
 	}{
 		{
 			name: "single private subnet exists, should create no NAT gateway",
@@ -174,6 +175,66 @@ func TestReconcileNatGateways(t *testing.T) {
 				}).Return(nil)
 			},
 		},
+		{
+			name: "public & private subnet exists with private connectivity, should create 1 private NAT gateway without EIP",
+			input: []infrav1.SubnetSpec{
+				{
+					ID:               "subnet-1",
+					AvailabilityZone: "us-east-1a",
+					CidrBlock:        "10.0.10.0/24",
+					IsPublic:         true,
+				},
+				{
+					ID:               "subnet-2",
+					AvailabilityZone: "us-east-1a",
+					CidrBlock:        "10.0.12.0/24",
+					IsPublic:         false,
+				},
+			},
+			natGateway: &infrav1.NatGatewaySpec{
+				ConnectivityType: infrav1.NatGatewayConnectivityTypePrivate,
+				TransitGatewayID: aws.String("tgw-central"),
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNatGatewaysPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).Return(nil)
+
+				m.DescribeAddressesWithContext(context.TODO(), gomock.Any()).Times(0)
+				m.AllocateAddressWithContext(context.TODO(), gomock.Any()).Times(0)
+
+				m.CreateNatGatewayWithContext(context.TODO(), &ec2.CreateNatGatewayInput{
+					ConnectivityType: aws.String("private"),
+					SubnetId:         aws.String("subnet-1"),
+					TagSpecifications: []*ec2.TagSpecification{
+						{
+							ResourceType: aws.String("natgateway"),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("Name"),
+									Value: aws.String("test-cluster-nat"),
+								},
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+									Value: aws.String("owned"),
+								},
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+									Value: aws.String("common"),
+								},
+							},
+						},
+					},
+				}).Return(&ec2.CreateNatGatewayOutput{
+					NatGateway: &ec2.NatGateway{
+						NatGatewayId: aws.String("natgateway"),
+						SubnetId:     aws.String("subnet-1"),
+					},
+				}, nil)
+
+				m.WaitUntilNatGatewayAvailableWithContext(context.TODO(), &ec2.DescribeNatGatewaysInput{
+					NatGatewayIds: []*string{aws.String("natgateway")},
+				}).Return(nil)
+			},
+		},
 		{
 			name: "two public & 1 private subnet, and one NAT gateway exists",
 			input: []infrav1.SubnetSpec{
@@ -378,6 +439,7 @@ func TestReconcileNatGateways(t *testing.T) {
 							Tags: infrav1.Tags{
 								infrav1.ClusterTagKey("test-cluster"): "owned",
 							},
+							NatGateway: tc.natGateway,
 						},
 						Subnets: tc.input,
 					},
@@ -722,6 +784,205 @@ func TestDeleteNatGateways(t *testing.T) {
 	}
 }
 
+func TestDeleteStaleNatGateways(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	subnets := []infrav1.SubnetSpec{
+		{
+			ID:               "subnet-public-new",
+			AvailabilityZone: "us-east-1a",
+			CidrBlock:        "10.0.11.0/24",
+			IsPublic:         true,
+		},
+		{
+			ID:               "subnet-private",
+			AvailabilityZone: "us-east-1a",
+			CidrBlock:        "10.0.12.0/24",
+			IsPublic:         false,
+		},
+	}
+	ownedTags := []*ec2.Tag{
+		{
+			Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+			Value: aws.String("owned"),
+		},
+	}
+	describeNatGateways := func(gateways ...*ec2.NatGateway) func(ctx context.Context, _, y interface{}, requestOptions ...request.Option) {
+		return func(ctx context.Context, _, y interface{}, requestOptions ...request.Option) {
+			funct := y.(func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool)
+			funct(&ec2.DescribeNatGatewaysOutput{NatGateways: gateways}, true)
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		isUnmanagedVPC bool
+		expect         func(m *mocks.MockEC2APIMockRecorder)
+		wantErr        bool
+	}{
+		{
+			name:           "Should skip deletion if vpc is unmanaged",
+			isUnmanagedVPC: true,
+		},
+		{
+			name: "Should not delete NAT gateways in the public subnets of the spec",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNatGatewaysPagesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeNatGatewaysInput{}), gomock.Any()).
+					Do(describeNatGateways(&ec2.NatGateway{
+						NatGatewayId: aws.String("nat-new"),
+						SubnetId:     aws.String("subnet-public-new"),
+						Tags:         ownedTags,
+					})).Return(nil)
+			},
+		},
+		{
+			name: "Should not delete NAT gateways that are not owned by the cluster",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNatGatewaysPagesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeNatGatewaysInput{}), gomock.Any()).
+					Do(describeNatGateways(&ec2.NatGateway{
+						NatGatewayId: aws.String("nat-foreign"),
+						SubnetId:     aws.String("subnet-foreign"),
+					})).Return(nil)
+			},
+		},
+		{
+			name: "Should not delete a stale NAT gateway that is still the target of a route",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNatGatewaysPagesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeNatGatewaysInput{}), gomock.Any()).
+					Do(describeNatGateways(&ec2.NatGateway{
+						NatGatewayId: aws.String("nat-old"),
+						SubnetId:     aws.String("subnet-public-old"),
+						Tags:         ownedTags,
+					})).Return(nil)
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{
+							{
+								RouteTableId: aws.String("rtb-private"),
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										NatGatewayId:         aws.String("nat-old"),
+									},
+								},
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name: "Should delete a stale NAT gateway once the routes point to its replacement",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNatGatewaysPagesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeNatGatewaysInput{}), gomock.Any()).
+					Do(describeNatGateways(
+						&ec2.NatGateway{
+							NatGatewayId: aws.String("nat-new"),
+							SubnetId:     aws.String("subnet-public-new"),
+							Tags:         ownedTags,
+						},
+						&ec2.NatGateway{
+							NatGatewayId: aws.String("nat-old"),
+							SubnetId:     aws.String("subnet-public-old"),
+							Tags:         ownedTags,
+						},
+					)).Return(nil)
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{
+							{
+								RouteTableId: aws.String("rtb-private"),
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										NatGatewayId:         aws.String("nat-new"),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.DeleteNatGatewayWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNatGatewayInput{
+					NatGatewayId: aws.String("nat-old"),
+				})).Return(&ec2.DeleteNatGatewayOutput{}, nil)
+				m.DescribeNatGatewaysWithContext(context.TODO(), gomock.Eq(&ec2.DescribeNatGatewaysInput{
+					NatGatewayIds: []*string{aws.String("nat-old")},
+				})).Return(&ec2.DescribeNatGatewaysOutput{
+					NatGateways: []*ec2.NatGateway{
+						{
+							State: aws.String("deleted"),
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Should return error if delete natgateway fails",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNatGatewaysPagesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeNatGatewaysInput{}), gomock.Any()).
+					Do(describeNatGateways(&ec2.NatGateway{
+						NatGatewayId: aws.String("nat-old"),
+						SubnetId:     aws.String("subnet-public-old"),
+						Tags:         ownedTags,
+					})).Return(nil)
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{}, nil)
+				m.DeleteNatGatewayWithContext(context.TODO(), gomock.Eq(&ec2.DeleteNatGatewayInput{
+					NatGatewayId: aws.String("nat-old"),
+				})).Return(nil, awserrors.NewFailedDependency("failed dependency"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{
+							ID: "managed-vpc",
+							Tags: infrav1.Tags{
+								infrav1.ClusterTagKey("test-cluster"): "owned",
+							},
+						},
+						Subnets: subnets,
+					},
+				},
+			}
+			if tc.isUnmanagedVPC {
+				awsCluster.Spec.NetworkSpec.VPC.Tags = infrav1.Tags{}
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: awsCluster,
+				Client:     client,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			err = s.deleteStaleNatGateways()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
 var mockDescribeNatGatewaysOutput = func(ctx context.Context, _, y interface{}, requestOptions ...request.Option) {
 	funct := y.(func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool)
 	funct(&ec2.DescribeNatGatewaysOutput{NatGateways: []*ec2.NatGateway{{
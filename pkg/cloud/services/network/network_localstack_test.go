@@ -0,0 +1,99 @@
+//go:build localstack
+// +build localstack
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/helpers/localstack"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestReconcileAndDeleteNetworkLocalStack(t *testing.T) {
+	g := NewWithT(t)
+	endpoints := localstack.Setup(t)
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "localstack", Namespace: metav1.NamespaceDefault},
+		Spec: infrav1.AWSClusterSpec{
+			Region: localstack.Region,
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					CidrBlock:                  "10.0.0.0/16",
+					AvailabilityZoneUsageLimit: ptr.To(2),
+					AvailabilityZoneSelection:  &infrav1.AZSelectionSchemeOrdered,
+				},
+			},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).WithStatusSubresource(awsCluster).Build()
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "localstack", Namespace: metav1.NamespaceDefault},
+		},
+		AWSCluster: awsCluster,
+		Client:     client,
+		Endpoints:  endpoints,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	s := NewService(clusterScope)
+
+	g.Expect(s.ReconcileNetwork()).To(Succeed())
+	vpcID := clusterScope.VPC().ID
+	g.Expect(vpcID).To(HavePrefix("vpc-"))
+	g.Expect(clusterScope.VPC().InternetGatewayID).NotTo(BeNil())
+	subnets := clusterScope.Subnets()
+	g.Expect(subnets).To(HaveLen(4), "expected a public and a private subnet in each of the 2 availability zones")
+	g.Expect(subnets.FilterPublic()).To(HaveLen(2))
+	for _, subnet := range subnets {
+		g.Expect(subnet.GetResourceID()).To(HavePrefix("subnet-"))
+		g.Expect(subnet.RouteTableID).NotTo(BeNil(), "subnet %s has no route table", subnet.GetResourceID())
+	}
+	for _, subnet := range subnets.FilterPublic() {
+		g.Expect(subnet.NatGatewayID).NotTo(BeNil(), "public subnet %s has no NAT gateway", subnet.GetResourceID())
+	}
+
+	// Reconciling the network again does not create any resource.
+	g.Expect(s.ReconcileNetwork()).To(Succeed())
+	g.Expect(clusterScope.VPC().ID).To(Equal(vpcID))
+	g.Expect(clusterScope.Subnets()).To(HaveLen(4))
+
+	g.Expect(s.DeleteNetwork()).To(Succeed())
+	out, err := s.EC2Client.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{vpcID})})
+	if err == nil {
+		g.Expect(out.Vpcs).To(BeEmpty())
+	} else {
+		code, _ := awserrors.Code(err)
+		g.Expect(code).To(Equal("InvalidVpcID.NotFound"))
+	}
+}
@@ -65,6 +65,8 @@ func (s *Service) reconcileRouteTables() error {
 			record.Warnf(s.scope.InfraCluster(), "FailedRouteTableRoutes", "Failed to get routes for managed RouteTable for subnet %s: %v", sn.ID, err)
 			return errors.Wrapf(err, "failed to discover routes on route table %s", sn.ID)
 		}
+		additionalRoutes := s.getAdditionalRoutesForSubnet(sn)
+		routes = append(routes, additionalRoutes...)
 
 		if rt, ok := subnetRouteMap[sn.GetResourceID()]; ok {
 			s.scope.Debug("Subnet is already associated with route table", "subnet-id", sn.GetResourceID(), "route-table-id", *rt.RouteTableId)
@@ -83,6 +85,27 @@ func (s *Service) reconcileRouteTables() error {
 				}
 			}
 
+			// Additional routes can be declared after the route table has been created, make sure they exist.
+			for _, route := range additionalRoutes {
+				if routeTableHasDestination(rt, route) {
+					continue
+				}
+				if err := s.createRoute(rt.RouteTableId, route); err != nil {
+					return err
+				}
+			}
+
+			// Delete the additional routes removed from the spec. Only the routes created by CAPA are deleted, the
+			// routes added to the route table outside of CAPA are kept.
+			for _, destination := range s.removedAdditionalRouteDestinations(sn, routes) {
+				if !routeTableHasDestination(rt, &ec2.CreateRouteInput{DestinationCidrBlock: aws.String(destination)}) {
+					continue
+				}
+				if err := s.deleteRoute(rt.RouteTableId, destination); err != nil {
+					return err
+				}
+			}
+
 			// Make sure tags are up-to-date.
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				buildParams := s.getRouteTableTagParams(*rt.RouteTableId, sn.IsPublic, sn.AvailabilityZone)
@@ -121,6 +144,9 @@ func (s *Service) reconcileRouteTables() error {
 		s.scope.Debug("Subnet has been associated with route table", "subnet-id", sn.GetResourceID(), "route-table-id", rt.ID)
 		sn.RouteTableID = aws.String(rt.ID)
 	}
+	network := s.scope.Network()
+	network.AdditionalPublicRouteDestinations = routeDestinations(s.scope.AdditionalPublicRoutes())
+	network.AdditionalPrivateRouteDestinations = routeDestinations(s.scope.AdditionalPrivateRoutes())
 	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition)
 	return nil
 }
@@ -130,27 +156,30 @@ func (s *Service) fixMismatchedRouting(specRoute *ec2.CreateRouteInput, currentR
 	if specRoute.DestinationCidrBlock != nil {
 		if (currentRoute.DestinationCidrBlock != nil &&
 			*currentRoute.DestinationCidrBlock == *specRoute.DestinationCidrBlock) &&
-			((currentRoute.GatewayId != nil && *currentRoute.GatewayId != *specRoute.GatewayId) ||
-				(currentRoute.NatGatewayId != nil && *currentRoute.NatGatewayId != *specRoute.NatGatewayId)) {
+			routeTargetChanged(specRoute, currentRoute) {
 			input = &ec2.ReplaceRouteInput{
-				RouteTableId:         rt.RouteTableId,
-				DestinationCidrBlock: specRoute.DestinationCidrBlock,
-				GatewayId:            specRoute.GatewayId,
-				NatGatewayId:         specRoute.NatGatewayId,
+				RouteTableId:           rt.RouteTableId,
+				DestinationCidrBlock:   specRoute.DestinationCidrBlock,
+				GatewayId:              specRoute.GatewayId,
+				NatGatewayId:           specRoute.NatGatewayId,
+				TransitGatewayId:       specRoute.TransitGatewayId,
+				VpcPeeringConnectionId: specRoute.VpcPeeringConnectionId,
+				InstanceId:             specRoute.InstanceId,
+				NetworkInterfaceId:     specRoute.NetworkInterfaceId,
 			}
 		}
 	}
 	if specRoute.DestinationIpv6CidrBlock != nil {
 		if (currentRoute.DestinationIpv6CidrBlock != nil &&
 			*currentRoute.DestinationIpv6CidrBlock == *specRoute.DestinationIpv6CidrBlock) &&
-			((currentRoute.GatewayId != nil && *currentRoute.GatewayId != *specRoute.GatewayId) ||
-				(currentRoute.NatGatewayId != nil && *currentRoute.NatGatewayId != *specRoute.NatGatewayId)) {
+			routeTargetChanged(specRoute, currentRoute) {
 			input = &ec2.ReplaceRouteInput{
 				RouteTableId:                rt.RouteTableId,
 				DestinationIpv6CidrBlock:    specRoute.DestinationIpv6CidrBlock,
 				DestinationPrefixListId:     specRoute.DestinationPrefixListId,
 				GatewayId:                   specRoute.GatewayId,
 				NatGatewayId:                specRoute.NatGatewayId,
+				TransitGatewayId:            specRoute.TransitGatewayId,
 				EgressOnlyInternetGatewayId: specRoute.EgressOnlyInternetGatewayId,
 			}
 		}
@@ -169,6 +198,31 @@ func (s *Service) fixMismatchedRouting(specRoute *ec2.CreateRouteInput, currentR
 	return nil
 }
 
+// routeTargetChanged returns true when the current route points to a different target than the spec route.
+func routeTargetChanged(specRoute *ec2.CreateRouteInput, currentRoute *ec2.Route) bool {
+	return (currentRoute.GatewayId != nil && *currentRoute.GatewayId != aws.StringValue(specRoute.GatewayId)) ||
+		(currentRoute.NatGatewayId != nil && *currentRoute.NatGatewayId != aws.StringValue(specRoute.NatGatewayId)) ||
+		(currentRoute.TransitGatewayId != nil && *currentRoute.TransitGatewayId != aws.StringValue(specRoute.TransitGatewayId)) ||
+		(currentRoute.VpcPeeringConnectionId != nil && *currentRoute.VpcPeeringConnectionId != aws.StringValue(specRoute.VpcPeeringConnectionId)) ||
+		// AWS reports both the instance and its network interface for routes targeting an instance,
+		// only compare the one that has been requested.
+		(specRoute.NetworkInterfaceId == nil && currentRoute.InstanceId != nil && *currentRoute.InstanceId != aws.StringValue(specRoute.InstanceId)) ||
+		(specRoute.InstanceId == nil && currentRoute.NetworkInterfaceId != nil && *currentRoute.NetworkInterfaceId != aws.StringValue(specRoute.NetworkInterfaceId))
+}
+
+// routeTableHasDestination returns true when the route table already has a route for the spec route destination.
+func routeTableHasDestination(rt *ec2.RouteTable, specRoute *ec2.CreateRouteInput) bool {
+	for _, currentRoute := range rt.Routes {
+		if specRoute.DestinationCidrBlock != nil && aws.StringValue(currentRoute.DestinationCidrBlock) == *specRoute.DestinationCidrBlock {
+			return true
+		}
+		if specRoute.DestinationIpv6CidrBlock != nil && aws.StringValue(currentRoute.DestinationIpv6CidrBlock) == *specRoute.DestinationIpv6CidrBlock {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Service) describeVpcRouteTablesBySubnet() (map[string]*ec2.RouteTable, error) {
 	rts, err := s.describeVpcRouteTables()
 	if err != nil {
@@ -274,22 +328,13 @@ func (s *Service) createRouteTableWithRoutes(routes []*ec2.CreateRouteInput, isP
 	s.scope.Info("Created route table", "route-table-id", *out.RouteTable.RouteTableId)
 
 	for i := range routes {
-		route := routes[i]
-		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-			route.RouteTableId = out.RouteTable.RouteTableId
-			if _, err := s.EC2Client.CreateRouteWithContext(context.TODO(), route); err != nil {
-				return false, err
-			}
-			return true, nil
-		}, awserrors.RouteTableNotFound, awserrors.NATGatewayNotFound, awserrors.GatewayNotFound); err != nil {
-			record.Warnf(s.scope.InfraCluster(), "FailedCreateRoute", "Failed to create route %s for RouteTable %q: %v", route.GoString(), *out.RouteTable.RouteTableId, err)
+		if err := s.createRoute(out.RouteTable.RouteTableId, routes[i]); err != nil {
 			errDel := s.deleteRouteTable(out.RouteTable)
 			if errDel != nil {
 				record.Warnf(s.scope.InfraCluster(), "FailedDeleteRouteTable", "Failed to delete managed RouteTable %q: %v", *out.RouteTable.RouteTableId, errDel)
 			}
-			return nil, errors.Wrapf(err, "failed to create route in route table %q: %s", *out.RouteTable.RouteTableId, route.GoString())
+			return nil, err
 		}
-		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateRoute", "Created route %s for RouteTable %q", route.GoString(), *out.RouteTable.RouteTableId)
 	}
 
 	return &infrav1.RouteTable{
@@ -297,6 +342,33 @@ func (s *Service) createRouteTableWithRoutes(routes []*ec2.CreateRouteInput, isP
 	}, nil
 }
 
+func (s *Service) createRoute(routeTableID *string, route *ec2.CreateRouteInput) error {
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		route.RouteTableId = routeTableID
+		if _, err := s.EC2Client.CreateRouteWithContext(context.TODO(), route); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, awserrors.RouteTableNotFound, awserrors.NATGatewayNotFound, awserrors.GatewayNotFound); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateRoute", "Failed to create route %s for RouteTable %q: %v", route.GoString(), *routeTableID, err)
+		return errors.Wrapf(err, "failed to create route in route table %q: %s", *routeTableID, route.GoString())
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateRoute", "Created route %s for RouteTable %q", route.GoString(), *routeTableID)
+	return nil
+}
+
+func (s *Service) deleteRoute(routeTableID *string, destinationCidrBlock string) error {
+	if _, err := s.EC2Client.DeleteRouteWithContext(context.TODO(), &ec2.DeleteRouteInput{
+		RouteTableId:         routeTableID,
+		DestinationCidrBlock: aws.String(destinationCidrBlock),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteRoute", "Failed to delete route to %s from RouteTable %q: %v", destinationCidrBlock, *routeTableID, err)
+		return errors.Wrapf(err, "failed to delete route to %s from route table %q", destinationCidrBlock, *routeTableID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteRoute", "Deleted route to %s from RouteTable %q", destinationCidrBlock, *routeTableID)
+	return nil
+}
+
 func (s *Service) associateRouteTable(rt *infrav1.RouteTable, subnetID string) error {
 	_, err := s.EC2Client.AssociateRouteTableWithContext(context.TODO(), &ec2.AssociateRouteTableInput{
 		RouteTableId: aws.String(rt.ID),
@@ -319,6 +391,13 @@ func (s *Service) getNatGatewayPrivateRoute(natGatewayID string) *ec2.CreateRout
 	}
 }
 
+func (s *Service) getNatGatewayNAT64Route(natGatewayID string) *ec2.CreateRouteInput {
+	return &ec2.CreateRouteInput{
+		NatGatewayId:             aws.String(natGatewayID),
+		DestinationIpv6CidrBlock: aws.String(services.NAT64CidrBlock),
+	}
+}
+
 func (s *Service) getEgressOnlyInternetGateway() *ec2.CreateRouteInput {
 	return &ec2.CreateRouteInput{
 		DestinationIpv6CidrBlock:    aws.String(services.AnyIPv6CidrBlock),
@@ -340,6 +419,13 @@ func (s *Service) getGatewayPublicIPv6Route() *ec2.CreateRouteInput {
 	}
 }
 
+func (s *Service) getTransitGatewayRoute() *ec2.CreateRouteInput {
+	return &ec2.CreateRouteInput{
+		DestinationCidrBlock: aws.String(services.AnyIPv4CidrBlock),
+		TransitGatewayId:     s.scope.VPC().GetTransitGatewayID(),
+	}
+}
+
 func (s *Service) getCarrierGatewayPublicIPv4Route() *ec2.CreateRouteInput {
 	return &ec2.CreateRouteInput{
 		DestinationCidrBlock: aws.String(services.AnyIPv4CidrBlock),
@@ -380,6 +466,10 @@ func (s *Service) getRoutesToPublicSubnet(sn *infrav1.SubnetSpec) ([]*ec2.Create
 		return nil, errors.Errorf("can't determine routes for unsupported ipv6 subnet in zone type %q", sn.ZoneType)
 	}
 
+	if sn.IPv6Only {
+		return nil, errors.Errorf("can't determine routes for public IPv6-only subnet %q", sn.GetResourceID())
+	}
+
 	if sn.IsEdgeWavelength() {
 		if s.scope.VPC().CarrierGatewayID == nil {
 			return routes, errors.Errorf("failed to create carrier routing table: carrier gateway for VPC %q is not present", s.scope.VPC().ID)
@@ -388,6 +478,15 @@ func (s *Service) getRoutesToPublicSubnet(sn *infrav1.SubnetSpec) ([]*ec2.Create
 		return routes, nil
 	}
 
+	// Public subnets host the private NAT gateways, their egress traffic goes to the transit gateway.
+	if s.scope.VPC().IsPrivateNatGateway() {
+		if s.scope.VPC().GetTransitGatewayID() == nil {
+			return routes, errors.Errorf("failed to create routing tables: transit gateway for private NAT gateways in VPC %q is not set", s.scope.VPC().ID)
+		}
+		routes = append(routes, s.getTransitGatewayRoute())
+		return routes, nil
+	}
+
 	if s.scope.VPC().InternetGatewayID == nil {
 		return routes, errors.Errorf("failed to create routing tables: internet gateway for VPC %q is not present", s.scope.VPC().ID)
 	}
@@ -411,8 +510,14 @@ func (s *Service) getRoutesToPrivateSubnet(sn *infrav1.SubnetSpec) (routes []*ec
 		return routes, err
 	}
 
-	routes = append(routes, s.getNatGatewayPrivateRoute(natGatewayID))
-	if sn.IsIPv6 {
+	// IPv6-only subnets reach IPv4-only destinations through the NAT64 of the NAT gateway, to which the
+	// DNS64 of the subnet directs them.
+	if sn.IPv6Only {
+		routes = append(routes, s.getNatGatewayNAT64Route(natGatewayID))
+	} else {
+		routes = append(routes, s.getNatGatewayPrivateRoute(natGatewayID))
+	}
+	if sn.IsIPv6 || sn.IPv6Only {
 		if !s.scope.VPC().IsIPv6Enabled() {
 			// Safety net because EgressOnlyInternetGateway needs the ID from the ipv6 block.
 			// if, for whatever reason by this point that is not available, we don't want to
@@ -425,6 +530,59 @@ func (s *Service) getRoutesToPrivateSubnet(sn *infrav1.SubnetSpec) (routes []*ec
 	return routes, nil
 }
 
+// getAdditionalRoutesForSubnet returns the user-defined routes for the subnet tier.
+func (s *Service) getAdditionalRoutesForSubnet(sn *infrav1.SubnetSpec) []*ec2.CreateRouteInput {
+	additionalRoutes := s.scope.AdditionalPrivateRoutes()
+	if sn.IsPublic {
+		additionalRoutes = s.scope.AdditionalPublicRoutes()
+	}
+
+	routes := make([]*ec2.CreateRouteInput, 0, len(additionalRoutes))
+	for _, route := range additionalRoutes {
+		routes = append(routes, &ec2.CreateRouteInput{
+			DestinationCidrBlock:   aws.String(route.DestinationCidrBlock),
+			TransitGatewayId:       route.TransitGatewayID,
+			VpcPeeringConnectionId: route.VPCPeeringConnectionID,
+			InstanceId:             route.InstanceID,
+			NetworkInterfaceId:     route.NetworkInterfaceID,
+		})
+	}
+	return routes
+}
+
+// removedAdditionalRouteDestinations returns the destinations of the additional routes created for the subnet tier
+// which are no longer wanted in its route table.
+func (s *Service) removedAdditionalRouteDestinations(sn *infrav1.SubnetSpec, routes []*ec2.CreateRouteInput) []string {
+	previous := s.scope.Network().AdditionalPrivateRouteDestinations
+	if sn.IsPublic {
+		previous = s.scope.Network().AdditionalPublicRouteDestinations
+	}
+
+	var removed []string
+	for _, destination := range previous {
+		wanted := false
+		for _, route := range routes {
+			if aws.StringValue(route.DestinationCidrBlock) == destination {
+				wanted = true
+				break
+			}
+		}
+		if !wanted {
+			removed = append(removed, destination)
+		}
+	}
+	return removed
+}
+
+// routeDestinations returns the destination CIDR blocks of the routes.
+func routeDestinations(routes []infrav1.Route) []string {
+	destinations := make([]string, 0, len(routes))
+	for _, route := range routes {
+		destinations = append(destinations, route.DestinationCidrBlock)
+	}
+	return destinations
+}
+
 func (s *Service) getRoutesForSubnet(sn *infrav1.SubnetSpec) ([]*ec2.CreateRouteInput, error) {
 	if sn.IsPublic {
 		return s.getRoutesToPublicSubnet(sn)
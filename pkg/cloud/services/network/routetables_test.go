@@ -45,10 +45,12 @@ func TestReconcileRouteTables(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	testCases := []struct {
-		name   string
-		input  *infrav1.NetworkSpec
-		expect func(m *mocks.MockEC2APIMockRecorder)
-		err    error
+		name                         string
+		input                        *infrav1.NetworkSpec
+		status                       infrav1.NetworkStatus
+		expect                       func(m *mocks.MockEC2APIMockRecorder)
+		wantPrivateRouteDestinations []string
+		err                          error
 	}{
 		{
 			name: "no routes existing, single private and single public, same AZ",
@@ -521,6 +523,256 @@ func TestReconcileRouteTables(t *testing.T) {
 					}, nil)
 			},
 		},
+		{
+			name: "additional routes are missing or outdated, creates and replaces them",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					InternetGatewayID: aws.String("igw-01"),
+					ID:                "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-private",
+						IsPublic:         false,
+						AvailabilityZone: "us-east-1a",
+					},
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-public",
+						IsPublic:         true,
+						NatGatewayID:     aws.String("nat-01"),
+						AvailabilityZone: "us-east-1a",
+					},
+				},
+				AdditionalPrivateRoutes: []infrav1.Route{
+					{
+						DestinationCidrBlock: "10.100.0.0/16",
+						TransitGatewayID:     aws.String("tgw-01"),
+					},
+					{
+						DestinationCidrBlock:   "192.168.0.0/16",
+						VPCPeeringConnectionID: aws.String("pcx-01"),
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{
+							{
+								RouteTableId: aws.String("route-table-private"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-private"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										NatGatewayId:         aws.String("nat-01"),
+									},
+									{
+										DestinationCidrBlock: aws.String("10.100.0.0/16"),
+										TransitGatewayId:     aws.String("tgw-outdated"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-private-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+							{
+								RouteTableId: aws.String("route-table-public"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-public"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										GatewayId:            aws.String("igw-01"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-public-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+						},
+					}, nil)
+
+				m.ReplaceRouteWithContext(context.TODO(), gomock.Eq(
+					&ec2.ReplaceRouteInput{
+						DestinationCidrBlock: aws.String("10.100.0.0/16"),
+						RouteTableId:         aws.String("route-table-private"),
+						TransitGatewayId:     aws.String("tgw-01"),
+					},
+				)).
+					Return(nil, nil)
+
+				m.CreateRouteWithContext(context.TODO(), gomock.Eq(&ec2.CreateRouteInput{
+					DestinationCidrBlock:   aws.String("192.168.0.0/16"),
+					VpcPeeringConnectionId: aws.String("pcx-01"),
+					RouteTableId:           aws.String("route-table-private"),
+				})).
+					Return(&ec2.CreateRouteOutput{}, nil)
+			},
+		},
+		{
+			name: "additional routes are removed from the spec, deletes the routes created by CAPA only",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					InternetGatewayID: aws.String("igw-01"),
+					ID:                "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-private",
+						IsPublic:         false,
+						AvailabilityZone: "us-east-1a",
+					},
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-public",
+						IsPublic:         true,
+						NatGatewayID:     aws.String("nat-01"),
+						AvailabilityZone: "us-east-1a",
+					},
+				},
+				AdditionalPrivateRoutes: []infrav1.Route{
+					{
+						DestinationCidrBlock: "10.100.0.0/16",
+						TransitGatewayID:     aws.String("tgw-01"),
+					},
+				},
+			},
+			status: infrav1.NetworkStatus{
+				AdditionalPrivateRouteDestinations: []string{"10.100.0.0/16", "192.168.0.0/16", "10.200.0.0/16"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{
+							{
+								RouteTableId: aws.String("route-table-private"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-private"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										NatGatewayId:         aws.String("nat-01"),
+									},
+									{
+										DestinationCidrBlock: aws.String("10.100.0.0/16"),
+										TransitGatewayId:     aws.String("tgw-01"),
+									},
+									{
+										DestinationCidrBlock:   aws.String("192.168.0.0/16"),
+										VpcPeeringConnectionId: aws.String("pcx-01"),
+									},
+									{
+										DestinationCidrBlock:   aws.String("172.16.0.0/12"),
+										VpcPeeringConnectionId: aws.String("pcx-manual"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-private-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+							{
+								RouteTableId: aws.String("route-table-public"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-public"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										GatewayId:            aws.String("igw-01"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-public-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+						},
+					}, nil)
+
+				m.DeleteRouteWithContext(context.TODO(), gomock.Eq(&ec2.DeleteRouteInput{
+					DestinationCidrBlock: aws.String("192.168.0.0/16"),
+					RouteTableId:         aws.String("route-table-private"),
+				})).
+					Return(&ec2.DeleteRouteOutput{}, nil)
+			},
+			wantPrivateRouteDestinations: []string{"10.100.0.0/16"},
+		},
 		{
 			name: "failed to create route, delete route table and fail",
 			input: &infrav1.NetworkSpec{
@@ -578,6 +830,9 @@ func TestReconcileRouteTables(t *testing.T) {
 					Spec: infrav1.AWSClusterSpec{
 						NetworkSpec: *tc.input,
 					},
+					Status: infrav1.AWSClusterStatus{
+						Network: tc.status,
+					},
 				},
 			})
 			if err != nil {
@@ -596,6 +851,11 @@ func TestReconcileRouteTables(t *testing.T) {
 			} else if err != nil {
 				t.Fatalf("got an unexpected error: %v", err)
 			}
+			if tc.wantPrivateRouteDestinations != nil {
+				if diff := cmp.Diff(tc.wantPrivateRouteDestinations, scope.Network().AdditionalPrivateRouteDestinations); diff != "" {
+					t.Fatalf("unexpected additional private route destinations (-want +got):\n%s", diff)
+				}
+			}
 		})
 	}
 }
@@ -851,6 +1111,100 @@ func TestDeleteRouteTable(t *testing.T) {
 	}
 }
 
+func TestFixMismatchedRouting(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name         string
+		specRoute    *ec2.CreateRouteInput
+		currentRoute *ec2.Route
+		expect       func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name: "Should replace an IPv4 route to an outdated transit gateway",
+			specRoute: &ec2.CreateRouteInput{
+				DestinationCidrBlock: aws.String("10.100.0.0/16"),
+				TransitGatewayId:     aws.String("tgw-01"),
+			},
+			currentRoute: &ec2.Route{
+				DestinationCidrBlock: aws.String("10.100.0.0/16"),
+				TransitGatewayId:     aws.String("tgw-outdated"),
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.ReplaceRouteWithContext(context.TODO(), gomock.Eq(&ec2.ReplaceRouteInput{
+					RouteTableId:         aws.String("route-table-private"),
+					DestinationCidrBlock: aws.String("10.100.0.0/16"),
+					TransitGatewayId:     aws.String("tgw-01"),
+				})).Return(&ec2.ReplaceRouteOutput{}, nil)
+			},
+		},
+		{
+			name: "Should replace an IPv6 route to an outdated transit gateway",
+			specRoute: &ec2.CreateRouteInput{
+				DestinationIpv6CidrBlock: aws.String("2001:db8::/56"),
+				TransitGatewayId:         aws.String("tgw-01"),
+			},
+			currentRoute: &ec2.Route{
+				DestinationIpv6CidrBlock: aws.String("2001:db8::/56"),
+				TransitGatewayId:         aws.String("tgw-outdated"),
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.ReplaceRouteWithContext(context.TODO(), gomock.Eq(&ec2.ReplaceRouteInput{
+					RouteTableId:             aws.String("route-table-private"),
+					DestinationIpv6CidrBlock: aws.String("2001:db8::/56"),
+					TransitGatewayId:         aws.String("tgw-01"),
+				})).Return(&ec2.ReplaceRouteOutput{}, nil)
+			},
+		},
+		{
+			name: "Should not replace an IPv6 route to the expected transit gateway",
+			specRoute: &ec2.CreateRouteInput{
+				DestinationIpv6CidrBlock: aws.String("2001:db8::/56"),
+				TransitGatewayId:         aws.String("tgw-01"),
+			},
+			currentRoute: &ec2.Route{
+				DestinationIpv6CidrBlock: aws.String("2001:db8::/56"),
+				TransitGatewayId:         aws.String("tgw-01"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec:       infrav1.AWSClusterSpec{},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			rt := &ec2.RouteTable{
+				RouteTableId: aws.String("route-table-private"),
+				Routes:       []*ec2.Route{tc.currentRoute},
+			}
+			g.Expect(s.fixMismatchedRouting(tc.specRoute, tc.currentRoute, rt)).To(Succeed())
+		})
+	}
+}
+
 type routeTableInputMatcher struct {
 	routeTableInput *ec2.CreateRouteTableInput
 }
@@ -1219,6 +1573,37 @@ func TestService_getRoutesForSubnet(t *testing.T) {
 			},
 			wantErrMessage: `ipv6 block missing for ipv6 enabled subnet, can't create route for egress only internet gateway`,
 		},
+		{
+			name: "private ipv6-only subnet, availability zone, must have nat64 route to nat gateway and ipv6 default route to egress only gateway",
+			inputSubnet: &infrav1.SubnetSpec{
+				ResourceID:       "subnet-az-1a-private",
+				AvailabilityZone: "us-east-1a",
+				IsIPv6:           true,
+				IPv6Only:         true,
+				IsPublic:         false,
+			},
+			want: []*ec2.CreateRouteInput{
+				{
+					DestinationIpv6CidrBlock: aws.String("64:ff9b::/96"),
+					NatGatewayId:             aws.String("nat-gw-fromZone-us-east-1a"),
+				},
+				{
+					DestinationIpv6CidrBlock:    aws.String("::/0"),
+					EgressOnlyInternetGatewayId: aws.String("vpc-eigw"),
+				},
+			},
+		},
+		{
+			name: "public ipv6-only subnet, availability zone, must return error",
+			inputSubnet: &infrav1.SubnetSpec{
+				ResourceID:       "subnet-az-1a-public",
+				AvailabilityZone: "us-east-1a",
+				IsIPv6:           true,
+				IPv6Only:         true,
+				IsPublic:         true,
+			},
+			wantErrMessage: `can't determine routes for public IPv6-only subnet "subnet-az-1a-public"`,
+		},
 		// private subnet ipv6, unsupported
 		{
 			name: "private ipv6 subnet, local zone, must return unsupported",
@@ -1302,6 +1687,45 @@ func TestService_getRoutesForSubnet(t *testing.T) {
 			},
 			wantErrMessage: `can't determine routes for unsupported ipv6 subnet in zone type "wavelength-zone"`,
 		},
+		{
+			name: "public subnet with private nat gateways should have the default route to the transit gateway",
+			specOverrideNet: func() *infrav1.NetworkSpec {
+				net := defaultNetwork.DeepCopy()
+				net.VPC.InternetGatewayID = nil
+				net.VPC.NatGateway = &infrav1.NatGatewaySpec{
+					ConnectivityType: infrav1.NatGatewayConnectivityTypePrivate,
+					TransitGatewayID: aws.String("tgw-central"),
+				}
+				return net
+			}(),
+			inputSubnet: &infrav1.SubnetSpec{
+				ResourceID:       "subnet-az-1a-public",
+				AvailabilityZone: "us-east-1a",
+				IsPublic:         true,
+			},
+			want: []*ec2.CreateRouteInput{
+				{
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					TransitGatewayId:     aws.String("tgw-central"),
+				},
+			},
+		},
+		{
+			name: "public subnet with private nat gateways and no transit gateway should fail",
+			specOverrideNet: func() *infrav1.NetworkSpec {
+				net := defaultNetwork.DeepCopy()
+				net.VPC.NatGateway = &infrav1.NatGatewaySpec{
+					ConnectivityType: infrav1.NatGatewayConnectivityTypePrivate,
+				}
+				return net
+			}(),
+			inputSubnet: &infrav1.SubnetSpec{
+				ResourceID:       "subnet-az-1a-public",
+				AvailabilityZone: "us-east-1a",
+				IsPublic:         true,
+			},
+			wantErrMessage: `failed to create routing tables: transit gateway for private NAT gateways in VPC "vpc-test-for-routes" is not set`,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -27,6 +27,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
@@ -60,22 +61,41 @@ func (s *Service) reconcileSubnets() error {
 
 	unmanagedVPC := s.scope.VPC().IsUnmanaged(s.scope.Name())
 
+	if len(subnets) == 0 && unmanagedVPC {
+		// If we have a unmanaged VPC then subnets must be specified
+		errMsg := "no subnets specified, you must specify the subnets when using an umanaged vpc"
+		record.Warnf(s.scope.InfraCluster(), "FailedNoSubnets", errMsg)
+		return errors.New(errMsg)
+	}
+
+	// Describe subnets in the vpc.
+	if existing, err = s.describeVpcSubnets(); err != nil {
+		return err
+	}
+
 	if len(subnets) == 0 {
-		if unmanagedVPC {
-			// If we have a unmanaged VPC then subnets must be specified
-			errMsg := "no subnets specified, you must specify the subnets when using an umanaged vpc"
-			record.Warnf(s.scope.InfraCluster(), "FailedNoSubnets", errMsg)
-			return errors.New(errMsg)
+		// A managed VPC without subnets may still have the subnets created for the cluster, lost from the spec
+		// e.g. when the AWSCluster was restored from a backup. Use the subnets tagged as owned by the cluster
+		// rather than creating new subnets, which would conflict with them.
+		for _, sn := range existing {
+			if sn.Tags.HasOwned(s.scope.Name()) {
+				subnets = append(subnets, *sn.DeepCopy())
+			}
 		}
 
-		// If we a managed VPC and have no subnets then create subnets. There will be 1 public and 1 private subnet
-		// for each az in a region up to a maximum of 3 azs
-		s.scope.Info("no subnets specified, setting defaults")
+		if len(subnets) > 0 {
+			s.scope.Info("no subnets specified, using the subnets owned by the cluster", "subnets", len(subnets))
+			record.Eventf(s.scope.InfraCluster(), "DiscoveredSubnets", "Discovered %d managed subnets by their ownership tags", len(subnets))
+		} else {
+			// If we a managed VPC and have no subnets then create subnets. There will be 1 public and 1 private subnet
+			// for each az in a region up to a maximum of 3 azs
+			s.scope.Info("no subnets specified, setting defaults")
 
-		subnets, err = s.getDefaultSubnets()
-		if err != nil {
-			record.Warnf(s.scope.InfraCluster(), "FailedDefaultSubnets", "Failed getting default subnets: %v", err)
-			return errors.Wrap(err, "failed getting default subnets")
+			subnets, err = s.getDefaultSubnets()
+			if err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedDefaultSubnets", "Failed getting default subnets: %v", err)
+				return errors.Wrap(err, "failed getting default subnets")
+			}
 		}
 
 		// Persist the new default subnets to AWSCluster
@@ -85,41 +105,38 @@ func (s *Service) reconcileSubnets() error {
 		}
 	}
 
-	// Describe subnets in the vpc.
-	if existing, err = s.describeVpcSubnets(); err != nil {
-		return err
-	}
-
 	if s.scope.SecondaryCidrBlock() != nil {
-		subnetCIDRs, err := cidr.SplitIntoSubnetsIPv4(*s.scope.SecondaryCidrBlock(), *s.scope.VPC().AvailabilityZoneUsageLimit)
+		secondarySubnets, err := s.getSecondarySubnets()
 		if err != nil {
 			return err
 		}
 
-		zones, err := s.getAvailableZones()
-		if err != nil {
-			return err
-		}
-
-		for i, sub := range subnetCIDRs {
-			secondarySub := infrav1.SubnetSpec{
-				ID:               fmt.Sprintf("%s-subnet-%s-%s", s.scope.Name(), infrav1.SecondarySubnetTagValue, zones[i]),
-				CidrBlock:        sub.String(),
-				AvailabilityZone: zones[i],
-				IsPublic:         false,
-				Tags: infrav1.Tags{
-					infrav1.NameAWSSubnetAssociation: infrav1.SecondarySubnetTagValue,
-				},
-			}
-			existingSubnet := existing.FindEqual(&secondarySub)
+		for i := range secondarySubnets {
+			existingSubnet := existing.FindEqual(&secondarySubnets[i])
 			if existingSubnet == nil {
-				subnets = append(subnets, secondarySub)
+				subnets = append(subnets, secondarySubnets[i])
 			}
 		}
 	}
 
+	if !unmanagedVPC {
+		// The subnets of the secondary CIDR blocks are created like the other subnets of a managed VPC.
+		cidrBlockSubnets, err := s.getSecondaryCidrBlockSubnets(subnets)
+		if err != nil {
+			return err
+		}
+		subnets = append(subnets, cidrBlockSubnets...)
+	}
+
 	for i := range subnets {
 		sub := &subnets[i]
+		if sub.Tags[infrav1.NameAWSSubnetAssociation] == infrav1.SecondarySubnetTagValue {
+			// The pod subnets also carry the tags the CNI discovers them with, including the ones created before
+			// its pod networking was configured.
+			for k, v := range s.scope.PodSubnetTags() {
+				sub.Tags[k] = v
+			}
+		}
 		existingSubnet := existing.FindEqual(sub)
 		if existingSubnet != nil {
 			if len(sub.ID) > 0 {
@@ -236,6 +253,151 @@ func (s *Service) reconcileZoneInfo(subnets infrav1.Subnets) error {
 	return nil
 }
 
+// getSecondarySubnets returns the subnets dedicated to pods in the secondary CIDR block, either one per availability
+// zone dividing the CIDR block evenly, or as configured by the pod tier of the subnet plan.
+func (s *Service) getSecondarySubnets() (infrav1.Subnets, error) {
+	var (
+		subnetCIDRs    []*net.IPNet
+		zones          []string
+		subnetsPerZone = 1
+		err            error
+	)
+	if plan := s.scope.VPC().SubnetPlan; plan != nil && plan.Pod != nil {
+		zones, err = s.getAvailableZones()
+		if err != nil {
+			return nil, err
+		}
+		if len(zones) > *s.scope.VPC().AvailabilityZoneUsageLimit {
+			zones = zones[:*s.scope.VPC().AvailabilityZoneUsageLimit]
+		}
+
+		subnetsPerZone = plan.Pod.GetSubnetsPerZone()
+		prefixLengths := make([]int, 0, len(zones)*subnetsPerZone)
+		for range zones {
+			for range subnetsPerZone {
+				prefixLengths = append(prefixLengths, plan.Pod.PrefixLength)
+			}
+		}
+		subnetCIDRs, err = cidr.AllocateSubnetsIPv4(*s.scope.SecondaryCidrBlock(), prefixLengths)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed allocating pod subnets in secondary CIDR %q", *s.scope.SecondaryCidrBlock())
+		}
+	} else {
+		subnetCIDRs, err = cidr.SplitIntoSubnetsIPv4(*s.scope.SecondaryCidrBlock(), *s.scope.VPC().AvailabilityZoneUsageLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		zones, err = s.getAvailableZones()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	subnets := make(infrav1.Subnets, 0, len(subnetCIDRs))
+	for i, sub := range subnetCIDRs {
+		zone := zones[i/subnetsPerZone]
+		subnets = append(subnets, infrav1.SubnetSpec{
+			ID:               s.defaultSubnetID(infrav1.SecondarySubnetTagValue, zone, i%subnetsPerZone, subnetsPerZone),
+			CidrBlock:        sub.String(),
+			AvailabilityZone: zone,
+			IsPublic:         false,
+			Tags: infrav1.Tags{
+				infrav1.NameAWSSubnetAssociation: infrav1.SecondarySubnetTagValue,
+			},
+		})
+	}
+	return subnets, nil
+}
+
+// getSecondaryCidrBlockSubnets returns the subnets missing in the secondary CIDR blocks of the VPC with createSubnets
+// set, one in each availability zone of the given subnets of the cluster. Each CIDR block is divided evenly between
+// the availability zones the cluster can use.
+func (s *Service) getSecondaryCidrBlockSubnets(subnets infrav1.Subnets) (infrav1.Subnets, error) {
+	var cidrBlocks []string
+	for _, cidrBlock := range s.scope.SecondaryCidrBlocks() {
+		if cidrBlock.CreateSubnets {
+			cidrBlocks = append(cidrBlocks, cidrBlock.IPv4CidrBlock)
+		}
+	}
+	if len(cidrBlocks) == 0 {
+		return nil, nil
+	}
+
+	var clusterSubnets infrav1.Subnets
+	for _, sn := range subnets.FilterNonCni() {
+		if !sn.IsEdge() {
+			clusterSubnets = append(clusterSubnets, sn)
+		}
+	}
+	zones := clusterSubnets.GetUniqueZones()
+	sort.Strings(zones)
+
+	maxZones := defaultMaxNumAZs
+	if s.scope.VPC().AvailabilityZoneUsageLimit != nil {
+		maxZones = *s.scope.VPC().AvailabilityZoneUsageLimit
+	}
+	maxZones = max(maxZones, len(zones))
+
+	var missing infrav1.Subnets
+	for i, cidrBlock := range cidrBlocks {
+		_, blockNet, err := net.ParseCIDR(cidrBlock)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed parsing secondary CIDR %q", cidrBlock)
+		}
+		subnetCIDRs, err := cidr.SplitIntoSubnetsIPv4(cidrBlock, maxZones)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed splitting secondary CIDR %q into subnets", cidrBlock)
+		}
+
+		// The subnets already created in the CIDR block are kept, so that the availability zones added later on
+		// get the CIDR blocks left.
+		used := sets.New[string]()
+		covered := sets.New[string]()
+		for _, sn := range subnets {
+			if _, snNet, err := net.ParseCIDR(sn.CidrBlock); err == nil && blockNet.Contains(snNet.IP) {
+				used.Insert(snNet.String())
+				covered.Insert(sn.AvailabilityZone)
+			}
+		}
+		var free []string
+		for _, subnetCIDR := range subnetCIDRs {
+			if !used.Has(subnetCIDR.String()) {
+				free = append(free, subnetCIDR.String())
+			}
+		}
+
+		for _, zone := range zones {
+			if covered.Has(zone) {
+				continue
+			}
+			if len(free) == 0 {
+				return nil, errors.Errorf("no CIDR block left in secondary CIDR %q for a subnet in availability zone %q", cidrBlock, zone)
+			}
+			missing = append(missing, infrav1.SubnetSpec{
+				ID:               s.defaultSubnetID(infrav1.SecondarySubnetTagValue, zone, i, len(cidrBlocks)),
+				CidrBlock:        free[0],
+				AvailabilityZone: zone,
+				IsPublic:         false,
+				Tags: infrav1.Tags{
+					infrav1.NameAWSSubnetAssociation: infrav1.SecondarySubnetTagValue,
+				},
+			})
+			free = free[1:]
+		}
+	}
+	return missing, nil
+}
+
+// defaultSubnetID returns the ID of a subnet created by default, suffixed by its index in the availability zone when
+// more than one subnet of the same role is created in each availability zone.
+func (s *Service) defaultSubnetID(role, zone string, index, subnetsPerZone int) string {
+	if subnetsPerZone > 1 {
+		return fmt.Sprintf("%s-subnet-%s-%s-%d", s.scope.Name(), role, zone, index)
+	}
+	return fmt.Sprintf("%s-subnet-%s-%s", s.scope.Name(), role, zone)
+}
+
 func (s *Service) getDefaultSubnets() (infrav1.Subnets, error) {
 	zones, err := s.getAvailableZones()
 	if err != nil {
@@ -265,6 +427,10 @@ func (s *Service) getDefaultSubnets() (infrav1.Subnets, error) {
 		s.scope.Debug("zones selected", "region", s.scope.Region(), "zones", zones)
 	}
 
+	if plan := s.scope.VPC().SubnetPlan; plan != nil {
+		return s.getPlannedSubnets(zones, plan)
+	}
+
 	// 1 private subnet for each AZ plus 1 other subnet that will be further sub-divided for the public subnets or vice versa if
 	// the subnet schema is set to prefer public subnets.
 	// All subnets will have an ipv4 address for now as well. We aren't supporting ipv6-only yet.
@@ -344,6 +510,10 @@ func (s *Service) getDefaultSubnets() (infrav1.Subnets, error) {
 			publicSubnet.IsIPv6 = true
 			privateSubnet.IPv6CidrBlock = privateIPv6SubnetCIDRs[i].String()
 			privateSubnet.IsIPv6 = true
+			if s.scope.VPC().IPv6.PrivateSubnetsIPv6Only {
+				privateSubnet.CidrBlock = ""
+				privateSubnet.IPv6Only = true
+			}
 		}
 
 		subnets = append(subnets, publicSubnet, privateSubnet)
@@ -352,6 +522,59 @@ func (s *Service) getDefaultSubnets() (infrav1.Subnets, error) {
 	return subnets, nil
 }
 
+// getPlannedSubnets returns the public and private subnets of each availability zone configured by the subnet plan,
+// allocated from the VPC CIDR block.
+func (s *Service) getPlannedSubnets(zones []string, plan *infrav1.SubnetPlan) (infrav1.Subnets, error) {
+	tiers := []struct {
+		role     string
+		isPublic bool
+		plan     infrav1.SubnetTierPlan
+	}{
+		{role: infrav1.PublicRoleTagValue, isPublic: true, plan: plan.Public},
+		{role: infrav1.PrivateRoleTagValue, isPublic: false, plan: plan.Private},
+	}
+
+	subnets := infrav1.Subnets{}
+	prefixLengths := []int{}
+	for _, zone := range zones {
+		for _, tier := range tiers {
+			for i := range tier.plan.GetSubnetsPerZone() {
+				subnets = append(subnets, infrav1.SubnetSpec{
+					ID:               s.defaultSubnetID(tier.role, zone, i, tier.plan.GetSubnetsPerZone()),
+					AvailabilityZone: zone,
+					IsPublic:         tier.isPublic,
+				})
+				prefixLengths = append(prefixLengths, tier.plan.PrefixLength)
+			}
+		}
+	}
+
+	subnetCIDRs, err := cidr.AllocateSubnetsIPv4(s.scope.VPC().CidrBlock, prefixLengths)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed allocating subnets in VPC CIDR %q", s.scope.VPC().CidrBlock)
+	}
+	for i := range subnets {
+		subnets[i].CidrBlock = subnetCIDRs[i].String()
+	}
+
+	if s.scope.VPC().IsIPv6Enabled() {
+		ipv6SubnetCIDRs, err := cidr.SplitIntoSubnetsIPv6(s.scope.VPC().IPv6.CidrBlock, len(subnets))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed splitting IPv6 VPC CIDR %q into subnets", s.scope.VPC().IPv6.CidrBlock)
+		}
+		for i := range subnets {
+			subnets[i].IPv6CidrBlock = ipv6SubnetCIDRs[i].String()
+			subnets[i].IsIPv6 = true
+			if s.scope.VPC().IPv6.PrivateSubnetsIPv6Only && !subnets[i].IsPublic {
+				subnets[i].CidrBlock = ""
+				subnets[i].IPv6Only = true
+			}
+		}
+	}
+
+	return subnets, nil
+}
+
 func (s *Service) deleteSubnets() error {
 	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
 		s.scope.Trace("Skipping subnets deletion in unmanaged mode")
@@ -407,6 +630,8 @@ func (s *Service) describeVpcSubnets() (infrav1.Subnets, error) {
 				spec.IsIPv6 = true
 			}
 		}
+		spec.IPv6Only = aws.BoolValue(ec2sn.Ipv6Native)
+		spec.OutpostArn = ec2sn.OutpostArn
 		// A subnet is public if it's tagged as such...
 		if spec.Tags.GetRole() == infrav1.PublicRoleTagValue {
 			spec.IsPublic = true
@@ -487,12 +712,19 @@ func (s *Service) createSubnet(sn *infrav1.SubnetSpec) (*infrav1.SubnetSpec, err
 	// https://docs.aws.amazon.com/local-zones/latest/ug/how-local-zones-work.html#considerations
 	// Wavelength Zones is currently not supporting IPv6 subnets.
 	// https://docs.aws.amazon.com/wavelength/latest/developerguide/wavelength-quotas.html#vpc-considerations
-	if sn.IsIPv6 && sn.IsEdge() {
+	if (sn.IsIPv6 || sn.IPv6Only) && sn.IsEdge() {
 		err := fmt.Errorf("failed to create subnet: IPv6 is not supported with zone type %q", sn.ZoneType)
 		record.Warnf(s.scope.InfraCluster(), "FailedCreateSubnet", "Failed creating managed Subnet for edge zones: %v", err)
 		return nil, err
 	}
 
+	// IPv6-only subnets can neither host NAT gateways nor public load balancers.
+	if sn.IPv6Only && (sn.IsPublic || !s.scope.VPC().IsIPv6Enabled()) {
+		err := fmt.Errorf("failed to create subnet: IPv6-only subnets must be private subnets of a VPC with IPv6 enabled")
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateSubnet", "Failed creating managed IPv6-only Subnet: %v", err)
+		return nil, err
+	}
+
 	// Build the subnet creation request.
 	input := &ec2.CreateSubnetInput{
 		VpcId:            aws.String(s.scope.VPC().ID),
@@ -509,6 +741,10 @@ func (s *Service) createSubnet(sn *infrav1.SubnetSpec) (*infrav1.SubnetSpec, err
 		input.Ipv6CidrBlock = aws.String(sn.IPv6CidrBlock)
 		sn.IsIPv6 = true
 	}
+	if sn.IPv6Only {
+		input.CidrBlock = nil
+		input.Ipv6Native = aws.Bool(true)
+	}
 	out, err := s.EC2Client.CreateSubnetWithContext(context.TODO(), input)
 	if err != nil {
 		record.Warnf(s.scope.InfraCluster(), "FailedCreateSubnet", "Failed creating new managed Subnet %v", err)
@@ -545,6 +781,14 @@ func (s *Service) createSubnet(sn *infrav1.SubnetSpec) (*infrav1.SubnetSpec, err
 		record.Eventf(s.scope.InfraCluster(), "SuccessfulModifySubnetAttributes", "Modified managed Subnet %q attributes", *out.Subnet.SubnetId)
 	}
 
+	if sn.IPv6Only {
+		if err := s.modifyIPv6OnlySubnetAttributes(out.Subnet.SubnetId); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedModifySubnetAttributes", "Failed modifying managed Subnet %q attributes: %v", *out.Subnet.SubnetId, err)
+			return nil, err
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulModifySubnetAttributes", "Modified managed Subnet %q attributes", *out.Subnet.SubnetId)
+	}
+
 	// AWS Wavelength Zone's public subnets does not support to map Carrier IP address on launch, and
 	// MapPublicIpOnLaunch option[1] set to the subnet will fail, instead set the EC2 instance's network
 	// interface to associate Carrier IP Address on launch[2].
@@ -568,7 +812,7 @@ func (s *Service) createSubnet(sn *infrav1.SubnetSpec) (*infrav1.SubnetSpec, err
 		record.Eventf(s.scope.InfraCluster(), "SuccessfulModifySubnetAttributes", "Modified managed Subnet %q attributes", *out.Subnet.SubnetId)
 	}
 
-	if s.scope.VPC().PrivateDNSHostnameTypeOnLaunch != nil {
+	if s.scope.VPC().PrivateDNSHostnameTypeOnLaunch != nil && !sn.IPv6Only {
 		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 			if _, err := s.EC2Client.ModifySubnetAttributeWithContext(context.TODO(), &ec2.ModifySubnetAttributeInput{
 				SubnetId:                       out.Subnet.SubnetId,
@@ -589,8 +833,9 @@ func (s *Service) createSubnet(sn *infrav1.SubnetSpec) (*infrav1.SubnetSpec, err
 		ID:               sn.ID,
 		ResourceID:       *out.Subnet.SubnetId,
 		AvailabilityZone: *out.Subnet.AvailabilityZone,
-		CidrBlock:        *out.Subnet.CidrBlock, // TODO: this will panic in case of IPv6 only subnets...
+		CidrBlock:        aws.StringValue(out.Subnet.CidrBlock),
 		IsPublic:         sn.IsPublic,
+		IPv6Only:         aws.BoolValue(out.Subnet.Ipv6Native),
 		Tags:             sn.Tags,
 	}
 	for _, set := range out.Subnet.Ipv6CidrBlockAssociationSet {
@@ -603,13 +848,45 @@ func (s *Service) createSubnet(sn *infrav1.SubnetSpec) (*infrav1.SubnetSpec, err
 	s.scope.Debug("Created new subnet in VPC with cidr and availability zone ",
 		"subnet-id", *out.Subnet.SubnetId,
 		"vpc-id", *out.Subnet.VpcId,
-		"cidr-block", *out.Subnet.CidrBlock,
+		"cidr-block", aws.StringValue(out.Subnet.CidrBlock),
 		"ipv6-cidr-block", subnet.IPv6CidrBlock,
 		"availability-zone", *out.Subnet.AvailabilityZone)
 
 	return subnet, nil
 }
 
+// modifyIPv6OnlySubnetAttributes enables DNS64 on an IPv6-only subnet, so that the instances can resolve the
+// addresses of IPv4-only destinations into the NAT64 prefix, and names its instances after their ID, since
+// their hostname cannot be based on an IPv4 address.
+// The attributes have to be modified separately, because only one subnet attribute can be modified at a time.
+func (s *Service) modifyIPv6OnlySubnetAttributes(subnetID *string) error {
+	attributes := []*ec2.ModifySubnetAttributeInput{
+		{
+			SubnetId:    subnetID,
+			EnableDns64: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+		},
+		{
+			SubnetId:                       subnetID,
+			PrivateDnsHostnameTypeOnLaunch: aws.String(ec2.HostnameTypeResourceName),
+		},
+		{
+			SubnetId:                                subnetID,
+			EnableResourceNameDnsAAAARecordOnLaunch: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+		},
+	}
+	for _, input := range attributes {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if _, err := s.EC2Client.ModifySubnetAttributeWithContext(context.TODO(), input); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.SubnetNotFound); err != nil {
+			return errors.Wrapf(err, "failed to set IPv6-only subnet %q attributes", aws.StringValue(subnetID))
+		}
+	}
+	return nil
+}
+
 func (s *Service) deleteSubnet(id string) error {
 	_, err := s.EC2Client.DeleteSubnetWithContext(context.TODO(), &ec2.DeleteSubnetInput{
 		SubnetId: aws.String(id),
@@ -2749,6 +2749,117 @@ func TestReconcileSubnets(t *testing.T) {
 					}, nil).AnyTimes()
 			},
 		},
+		{
+			name: "Managed VPC, no subnets in spec, existing subnets owned by the cluster, expect the owned subnets to be adopted",
+			input: NewClusterScope().WithNetwork(&infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: subnetsVPCID,
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+			}),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSubnetsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeSubnetsInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("state"),
+							Values: []*string{aws.String("pending"), aws.String("available")},
+						},
+						{
+							Name:   aws.String("vpc-id"),
+							Values: []*string{aws.String(subnetsVPCID)},
+						},
+					},
+				})).
+					Return(&ec2.DescribeSubnetsOutput{
+						Subnets: []*ec2.Subnet{
+							{
+								VpcId:            aws.String(subnetsVPCID),
+								SubnetId:         aws.String("subnet-public"),
+								AvailabilityZone: aws.String("us-east-1a"),
+								CidrBlock:        aws.String("10.0.0.0/17"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("public"),
+									},
+								},
+							},
+							{
+								VpcId:            aws.String(subnetsVPCID),
+								SubnetId:         aws.String("subnet-private"),
+								AvailabilityZone: aws.String("us-east-1a"),
+								CidrBlock:        aws.String("10.0.128.0/18"),
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("private"),
+									},
+								},
+							},
+							{
+								VpcId:            aws.String(subnetsVPCID),
+								SubnetId:         aws.String("subnet-other"),
+								AvailabilityZone: aws.String("us-east-1a"),
+								CidrBlock:        aws.String("10.0.192.0/18"),
+							},
+						},
+					}, nil)
+
+				m.DescribeRouteTablesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{}, nil)
+
+				m.DescribeNatGatewaysPagesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeNatGatewaysInput{}), gomock.Any()).
+					Return(nil)
+
+				m.CreateTagsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateTagsInput{})).
+					Return(nil, nil).Times(2)
+
+				m.DescribeAvailabilityZonesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeAvailabilityZonesOutput{
+						AvailabilityZones: []*ec2.AvailabilityZone{
+							{
+								ZoneName: aws.String("us-east-1a"),
+								ZoneType: aws.String("availability-zone"),
+							},
+						},
+					}, nil).AnyTimes()
+			},
+			optionalExpectSubnets: infrav1.Subnets{
+				{
+					ID:               "subnet-public",
+					ResourceID:       "subnet-public",
+					CidrBlock:        "10.0.0.0/17",
+					AvailabilityZone: "us-east-1a",
+					IsPublic:         true,
+					Tags: infrav1.Tags{
+						"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": "owned",
+						"sigs.k8s.io/cluster-api-provider-aws/role":                 "public",
+					},
+					ZoneType: ptr.To[infrav1.ZoneType]("availability-zone"),
+				},
+				{
+					ID:               "subnet-private",
+					ResourceID:       "subnet-private",
+					CidrBlock:        "10.0.128.0/18",
+					AvailabilityZone: "us-east-1a",
+					Tags: infrav1.Tags{
+						"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": "owned",
+						"sigs.k8s.io/cluster-api-provider-aws/role":                 "private",
+					},
+					ZoneType: ptr.To[infrav1.ZoneType]("availability-zone"),
+				},
+			},
+		},
 		{
 			name: "With ManagedControlPlaneScope, Managed VPC, no existing subnets exist, two az's, expect two private and two public from default, created with tag including eksClusterName not a name of Cluster resource",
 			input: NewManagedControlPlaneScope().
@@ -4876,3 +4987,229 @@ func stubMockDescribeAvailabilityZonesWithContextCustomZones(m *mocks.MockEC2API
 			AvailabilityZones: zones,
 		}, nil).AnyTimes()
 }
+
+func TestGetDefaultSubnetsWithSubnetPlan(t *testing.T) {
+	describeZones := func(m *mocks.MockEC2APIMockRecorder) {
+		m.DescribeAvailabilityZonesWithContext(context.TODO(), gomock.Any()).
+			Return(&ec2.DescribeAvailabilityZonesOutput{
+				AvailabilityZones: []*ec2.AvailabilityZone{
+					{ZoneName: aws.String("us-east-1a")},
+					{ZoneName: aws.String("us-east-1b")},
+					{ZoneName: aws.String("us-east-1c")},
+				},
+			}, nil)
+	}
+
+	testCases := []struct {
+		name      string
+		vpc       infrav1.VPCSpec
+		expect    infrav1.Subnets
+		expectErr bool
+	}{
+		{
+			name: "subnets of each tier are allocated with their prefix length",
+			vpc: infrav1.VPCSpec{
+				CidrBlock:                  "10.0.0.0/16",
+				AvailabilityZoneUsageLimit: ptr.To(2),
+				SubnetPlan: &infrav1.SubnetPlan{
+					Public:  infrav1.SubnetTierPlan{PrefixLength: 24},
+					Private: infrav1.SubnetTierPlan{PrefixLength: 20},
+				},
+			},
+			expect: infrav1.Subnets{
+				{ID: "test-cluster-subnet-public-us-east-1a", CidrBlock: "10.0.32.0/24", AvailabilityZone: "us-east-1a", IsPublic: true},
+				{ID: "test-cluster-subnet-private-us-east-1a", CidrBlock: "10.0.0.0/20", AvailabilityZone: "us-east-1a"},
+				{ID: "test-cluster-subnet-public-us-east-1b", CidrBlock: "10.0.33.0/24", AvailabilityZone: "us-east-1b", IsPublic: true},
+				{ID: "test-cluster-subnet-private-us-east-1b", CidrBlock: "10.0.16.0/20", AvailabilityZone: "us-east-1b"},
+			},
+		},
+		{
+			name: "several subnets of a tier are created in each zone",
+			vpc: infrav1.VPCSpec{
+				CidrBlock:                  "10.0.0.0/16",
+				AvailabilityZoneUsageLimit: ptr.To(1),
+				SubnetPlan: &infrav1.SubnetPlan{
+					Public:  infrav1.SubnetTierPlan{PrefixLength: 26},
+					Private: infrav1.SubnetTierPlan{PrefixLength: 22, SubnetsPerZone: 2},
+				},
+			},
+			expect: infrav1.Subnets{
+				{ID: "test-cluster-subnet-public-us-east-1a", CidrBlock: "10.0.8.0/26", AvailabilityZone: "us-east-1a", IsPublic: true},
+				{ID: "test-cluster-subnet-private-us-east-1a-0", CidrBlock: "10.0.0.0/22", AvailabilityZone: "us-east-1a"},
+				{ID: "test-cluster-subnet-private-us-east-1a-1", CidrBlock: "10.0.4.0/22", AvailabilityZone: "us-east-1a"},
+			},
+		},
+		{
+			name: "private subnets are ipv6-only",
+			vpc: infrav1.VPCSpec{
+				CidrBlock:                  "10.0.0.0/16",
+				AvailabilityZoneUsageLimit: ptr.To(1),
+				IPv6: &infrav1.IPv6{
+					CidrBlock:              "2001:db8:1234:1a00::/56",
+					PrivateSubnetsIPv6Only: true,
+				},
+				SubnetPlan: &infrav1.SubnetPlan{
+					Public:  infrav1.SubnetTierPlan{PrefixLength: 24},
+					Private: infrav1.SubnetTierPlan{PrefixLength: 20},
+				},
+			},
+			expect: infrav1.Subnets{
+				{ID: "test-cluster-subnet-public-us-east-1a", CidrBlock: "10.0.16.0/24", IPv6CidrBlock: "2001:db8:1234:1a01::/64", IsIPv6: true, AvailabilityZone: "us-east-1a", IsPublic: true},
+				{ID: "test-cluster-subnet-private-us-east-1a", IPv6CidrBlock: "2001:db8:1234:1a02::/64", IsIPv6: true, IPv6Only: true, AvailabilityZone: "us-east-1a"},
+			},
+		},
+		{
+			name: "subnets exceeding the VPC CIDR block",
+			vpc: infrav1.VPCSpec{
+				CidrBlock:                  "10.0.0.0/20",
+				AvailabilityZoneUsageLimit: ptr.To(3),
+				SubnetPlan: &infrav1.SubnetPlan{
+					Public:  infrav1.SubnetTierPlan{PrefixLength: 24},
+					Private: infrav1.SubnetTierPlan{PrefixLength: 21},
+				},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			describeZones(ec2Mock.EXPECT())
+
+			scope, err := getClusterScope(&tc.vpc, nil)
+			g.Expect(err).NotTo(HaveOccurred())
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			subnets, err := s.getDefaultSubnets()
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(subnets).To(Equal(tc.expect))
+		})
+	}
+}
+
+func TestGetSecondarySubnetsWithSubnetPlan(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().DescribeAvailabilityZonesWithContext(context.TODO(), gomock.Any()).
+		Return(&ec2.DescribeAvailabilityZonesOutput{
+			AvailabilityZones: []*ec2.AvailabilityZone{
+				{ZoneName: aws.String("us-east-1a")},
+				{ZoneName: aws.String("us-east-1b")},
+				{ZoneName: aws.String("us-east-1c")},
+			},
+		}, nil)
+
+	scheme, err := setupScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+	scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client:  fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"}},
+		ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+			Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				SecondaryCidrBlock: ptr.To("100.64.0.0/16"),
+				NetworkSpec: infrav1.NetworkSpec{
+					VPC: infrav1.VPCSpec{
+						AvailabilityZoneUsageLimit: ptr.To(2),
+						SubnetPlan: &infrav1.SubnetPlan{
+							Public:  infrav1.SubnetTierPlan{PrefixLength: 24},
+							Private: infrav1.SubnetTierPlan{PrefixLength: 20},
+							Pod:     &infrav1.SubnetTierPlan{PrefixLength: 19, SubnetsPerZone: 2},
+						},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	subnets, err := s.getSecondarySubnets()
+	g.Expect(err).NotTo(HaveOccurred())
+	tags := infrav1.Tags{infrav1.NameAWSSubnetAssociation: infrav1.SecondarySubnetTagValue}
+	g.Expect(subnets).To(Equal(infrav1.Subnets{
+		{ID: "test-cluster-subnet-secondary-us-east-1a-0", CidrBlock: "100.64.0.0/19", AvailabilityZone: "us-east-1a", Tags: tags},
+		{ID: "test-cluster-subnet-secondary-us-east-1a-1", CidrBlock: "100.64.32.0/19", AvailabilityZone: "us-east-1a", Tags: tags},
+		{ID: "test-cluster-subnet-secondary-us-east-1b-0", CidrBlock: "100.64.64.0/19", AvailabilityZone: "us-east-1b", Tags: tags},
+		{ID: "test-cluster-subnet-secondary-us-east-1b-1", CidrBlock: "100.64.96.0/19", AvailabilityZone: "us-east-1b", Tags: tags},
+	}))
+}
+
+func TestGetSecondaryCidrBlockSubnets(t *testing.T) {
+	tags := infrav1.Tags{infrav1.NameAWSSubnetAssociation: infrav1.SecondarySubnetTagValue}
+	clusterSubnets := infrav1.Subnets{
+		{ID: "subnet-public-1b", CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-east-1b", IsPublic: true},
+		{ID: "subnet-private-1a", CidrBlock: "10.0.16.0/20", AvailabilityZone: "us-east-1a"},
+		{ID: "subnet-private-1b", CidrBlock: "10.0.32.0/20", AvailabilityZone: "us-east-1b"},
+		{ID: "subnet-private-lz", CidrBlock: "10.0.48.0/24", AvailabilityZone: "us-east-1-nyc-1a", ZoneType: ptr.To(infrav1.ZoneTypeLocalZone)},
+	}
+	tests := []struct {
+		name                string
+		secondaryCidrBlocks []infrav1.VpcCidrBlock
+		subnets             infrav1.Subnets
+		expect              infrav1.Subnets
+	}{
+		{
+			name:                "does not create subnets in CIDR blocks without createSubnets",
+			secondaryCidrBlocks: []infrav1.VpcCidrBlock{{IPv4CidrBlock: "100.64.0.0/16"}},
+			subnets:             clusterSubnets,
+		},
+		{
+			name:                "creates a subnet in each availability zone of the cluster",
+			secondaryCidrBlocks: []infrav1.VpcCidrBlock{{IPv4CidrBlock: "100.64.0.0/16", CreateSubnets: true}},
+			subnets:             clusterSubnets,
+			expect: infrav1.Subnets{
+				{ID: "test-cluster-subnet-secondary-us-east-1a", CidrBlock: "100.64.0.0/18", AvailabilityZone: "us-east-1a", Tags: tags},
+				{ID: "test-cluster-subnet-secondary-us-east-1b", CidrBlock: "100.64.64.0/18", AvailabilityZone: "us-east-1b", Tags: tags},
+			},
+		},
+		{
+			name: "creates the subnets of each CIDR block",
+			secondaryCidrBlocks: []infrav1.VpcCidrBlock{
+				{IPv4CidrBlock: "100.64.0.0/16", CreateSubnets: true},
+				{IPv4CidrBlock: "100.65.0.0/16"},
+				{IPv4CidrBlock: "100.66.0.0/16", CreateSubnets: true},
+			},
+			subnets: clusterSubnets[:2],
+			expect: infrav1.Subnets{
+				{ID: "test-cluster-subnet-secondary-us-east-1a-0", CidrBlock: "100.64.0.0/18", AvailabilityZone: "us-east-1a", Tags: tags},
+				{ID: "test-cluster-subnet-secondary-us-east-1b-0", CidrBlock: "100.64.64.0/18", AvailabilityZone: "us-east-1b", Tags: tags},
+				{ID: "test-cluster-subnet-secondary-us-east-1a-1", CidrBlock: "100.66.0.0/18", AvailabilityZone: "us-east-1a", Tags: tags},
+				{ID: "test-cluster-subnet-secondary-us-east-1b-1", CidrBlock: "100.66.64.0/18", AvailabilityZone: "us-east-1b", Tags: tags},
+			},
+		},
+		{
+			name:                "only creates the subnets of the availability zones added to the cluster",
+			secondaryCidrBlocks: []infrav1.VpcCidrBlock{{IPv4CidrBlock: "100.64.0.0/16", CreateSubnets: true}},
+			subnets: append(clusterSubnets.DeepCopy(),
+				infrav1.SubnetSpec{ID: "subnet-secondary-1b", CidrBlock: "100.64.0.0/18", AvailabilityZone: "us-east-1b", Tags: tags},
+			),
+			expect: infrav1.Subnets{
+				{ID: "test-cluster-subnet-secondary-us-east-1a", CidrBlock: "100.64.64.0/18", AvailabilityZone: "us-east-1a", Tags: tags},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterScope, err := getClusterScope(&infrav1.VPCSpec{
+				CidrBlock:                  "10.0.0.0/16",
+				SecondaryCidrBlocks:        tt.secondaryCidrBlocks,
+				AvailabilityZoneUsageLimit: ptr.To(3),
+			}, nil)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			subnets, err := NewService(clusterScope).getSecondaryCidrBlockSubnets(tt.subnets)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(subnets).To(Equal(tt.expect))
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
@@ -62,13 +63,15 @@ func (s *Service) reconcileVPC() error {
 			s.scope.VPC().Tags = vpc.Tags
 		}
 
-		// If VPC is unmanaged, return early.
+		// If VPC is unmanaged, only change the attributes set in the spec and return early.
 		if vpc.IsUnmanaged(s.scope.Name()) {
 			s.scope.Debug("Working on unmanaged VPC", "vpc-id", vpc.ID)
 			if err := s.scope.PatchObject(); err != nil {
 				return errors.Wrap(err, "failed to patch unmanaged VPC fields")
 			}
-			record.Eventf(s.scope.InfraCluster(), "SuccessfulSetVPCAttributes", "Set managed VPC attributes for %q", vpc.ID)
+			if err := s.ensureVPCAttributes(vpc, false); err != nil {
+				return errors.Wrapf(err, "failed to set vpc attributes for %q", vpc.ID)
+			}
 			return nil
 		}
 
@@ -92,7 +95,7 @@ func (s *Service) reconcileVPC() error {
 
 		// if the VPC is managed, make managed sure attributes are configured.
 		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-			if err := s.ensureManagedVPCAttributes(vpc); err != nil {
+			if err := s.ensureVPCAttributes(vpc, true); err != nil {
 				return false, err
 			}
 			return true, nil
@@ -107,6 +110,14 @@ func (s *Service) reconcileVPC() error {
 	// with the desired name exists, or if not, create a new managed VPC.
 
 	vpc, err := s.describeVPCByName()
+	if awserrors.IsNotFound(err) {
+		// The Name tag of the VPC may have been changed. Look the VPC up by its ownership tags before creating a new
+		// one, so that a cluster whose VPC ID was lost, e.g. when restored from a backup, adopts its VPC again.
+		vpc, err = s.describeVPCByOwner()
+		if err == nil {
+			record.Eventf(s.scope.InfraCluster(), "DiscoveredVPC", "Discovered managed VPC %q by its ownership tags", vpc.ID)
+		}
+	}
 	if err == nil {
 		// An VPC already exists with the desired name
 
@@ -144,7 +155,7 @@ func (s *Service) reconcileVPC() error {
 
 	// Make sure attributes are configured
 	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-		if err := s.ensureManagedVPCAttributes(vpc); err != nil {
+		if err := s.ensureVPCAttributes(vpc, true); err != nil {
 			return false, err
 		}
 		return true, nil
@@ -304,66 +315,99 @@ func (s *Service) deleteVPCEndpoints() error {
 	return nil
 }
 
-func (s *Service) ensureManagedVPCAttributes(vpc *infrav1.VPCSpec) error {
+// vpcAttribute is a boolean attribute of a VPC. The attributes of a VPC can only be described and modified one at a time.
+type vpcAttribute struct {
+	name    string
+	desired *bool
+	get     func(*ec2.DescribeVpcAttributeOutput) *ec2.AttributeBooleanValue
+	set     func(*ec2.ModifyVpcAttributeInput, *ec2.AttributeBooleanValue)
+}
+
+// desiredVPCAttributes returns the attributes of the VPC in the order they can be modified in. The DNS attributes
+// of managed VPCs are enabled unless disabled in the spec, while the attributes which are not set are left untouched.
+func (s *Service) desiredVPCAttributes(managed bool) []vpcAttribute {
+	spec := s.scope.VPC()
+	dnsSupport, dnsHostnames := spec.EnableDNSSupport, spec.EnableDNSHostnames
+	if managed {
+		dnsSupport = ptr.To(ptr.Deref(dnsSupport, true))
+		dnsHostnames = ptr.To(ptr.Deref(dnsHostnames, true))
+	}
+
+	support := vpcAttribute{
+		name:    ec2.VpcAttributeNameEnableDnsSupport,
+		desired: dnsSupport,
+		get:     func(out *ec2.DescribeVpcAttributeOutput) *ec2.AttributeBooleanValue { return out.EnableDnsSupport },
+		set:     func(in *ec2.ModifyVpcAttributeInput, v *ec2.AttributeBooleanValue) { in.EnableDnsSupport = v },
+	}
+	hostnames := vpcAttribute{
+		name:    ec2.VpcAttributeNameEnableDnsHostnames,
+		desired: dnsHostnames,
+		get:     func(out *ec2.DescribeVpcAttributeOutput) *ec2.AttributeBooleanValue { return out.EnableDnsHostnames },
+		set:     func(in *ec2.ModifyVpcAttributeInput, v *ec2.AttributeBooleanValue) { in.EnableDnsHostnames = v },
+	}
+	metrics := vpcAttribute{
+		name:    ec2.VpcAttributeNameEnableNetworkAddressUsageMetrics,
+		desired: spec.EnableNetworkAddressUsageMetrics,
+		get: func(out *ec2.DescribeVpcAttributeOutput) *ec2.AttributeBooleanValue {
+			return out.EnableNetworkAddressUsageMetrics
+		},
+		set: func(in *ec2.ModifyVpcAttributeInput, v *ec2.AttributeBooleanValue) {
+			in.EnableNetworkAddressUsageMetrics = v
+		},
+	}
+
+	// DNS hostnames can only be enabled when DNS support is, and DNS support can only be disabled when DNS hostnames are.
+	if ptr.Deref(dnsSupport, true) {
+		return []vpcAttribute{support, hostnames, metrics}
+	}
+	return []vpcAttribute{hostnames, support, metrics}
+}
+
+// ensureVPCAttributes makes sure the attributes of the VPC match the spec.
+func (s *Service) ensureVPCAttributes(vpc *infrav1.VPCSpec, managed bool) error {
 	var (
 		errs    []error
 		updated bool
 	)
 
-	// Cannot get or set both attributes at the same time.
-	descAttrInput := &ec2.DescribeVpcAttributeInput{
-		VpcId:     aws.String(vpc.ID),
-		Attribute: aws.String("enableDnsHostnames"),
-	}
-	vpcAttr, err := s.EC2Client.DescribeVpcAttributeWithContext(context.TODO(), descAttrInput)
-	if err != nil {
-		// If the returned error is a 'NotFound' error it should trigger retry
-		if code, ok := awserrors.Code(errors.Cause(err)); ok && code == awserrors.VPCNotFound {
-			return err
-		}
-		errs = append(errs, errors.Wrap(err, "failed to describe enableDnsHostnames vpc attribute"))
-	} else if !aws.BoolValue(vpcAttr.EnableDnsHostnames.Value) {
-		attrInput := &ec2.ModifyVpcAttributeInput{
-			VpcId:              aws.String(vpc.ID),
-			EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
-		}
-		if _, err := s.EC2Client.ModifyVpcAttributeWithContext(context.TODO(), attrInput); err != nil {
-			errs = append(errs, errors.Wrap(err, "failed to set enableDnsHostnames vpc attribute"))
-		} else {
-			updated = true
+	for _, attr := range s.desiredVPCAttributes(managed) {
+		if attr.desired == nil {
+			continue
 		}
-	}
 
-	descAttrInput = &ec2.DescribeVpcAttributeInput{
-		VpcId:     aws.String(vpc.ID),
-		Attribute: aws.String("enableDnsSupport"),
-	}
-	vpcAttr, err = s.EC2Client.DescribeVpcAttributeWithContext(context.TODO(), descAttrInput)
-	if err != nil {
-		// If the returned error is a 'NotFound' error it should trigger retry
-		if code, ok := awserrors.Code(errors.Cause(err)); ok && code == awserrors.VPCNotFound {
-			return err
+		vpcAttr, err := s.EC2Client.DescribeVpcAttributeWithContext(context.TODO(), &ec2.DescribeVpcAttributeInput{
+			VpcId:     aws.String(vpc.ID),
+			Attribute: aws.String(attr.name),
+		})
+		if err != nil {
+			// If the returned error is a 'NotFound' error it should trigger retry
+			if code, ok := awserrors.Code(errors.Cause(err)); ok && code == awserrors.VPCNotFound {
+				return err
+			}
+			errs = append(errs, errors.Wrapf(err, "failed to describe %s vpc attribute", attr.name))
+			continue
 		}
-		errs = append(errs, errors.Wrap(err, "failed to describe enableDnsSupport vpc attribute"))
-	} else if !aws.BoolValue(vpcAttr.EnableDnsSupport.Value) {
-		attrInput := &ec2.ModifyVpcAttributeInput{
-			VpcId:            aws.String(vpc.ID),
-			EnableDnsSupport: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+		if current := attr.get(vpcAttr); current != nil && aws.BoolValue(current.Value) == *attr.desired {
+			continue
 		}
+
+		attrInput := &ec2.ModifyVpcAttributeInput{VpcId: aws.String(vpc.ID)}
+		attr.set(attrInput, &ec2.AttributeBooleanValue{Value: attr.desired})
 		if _, err := s.EC2Client.ModifyVpcAttributeWithContext(context.TODO(), attrInput); err != nil {
-			errs = append(errs, errors.Wrap(err, "failed to set enableDnsSupport vpc attribute"))
+			errs = append(errs, errors.Wrapf(err, "failed to set %s vpc attribute", attr.name))
 		} else {
 			updated = true
 		}
 	}
 
 	if len(errs) > 0 {
-		record.Warnf(s.scope.InfraCluster(), "FailedSetVPCAttributes", "Failed to set managed VPC attributes for %q: %v", vpc.ID, err)
-		return kerrors.NewAggregate(errs)
+		err := kerrors.NewAggregate(errs)
+		record.Warnf(s.scope.InfraCluster(), "FailedSetVPCAttributes", "Failed to set VPC attributes for %q: %v", vpc.ID, err)
+		return err
 	}
 
 	if updated {
-		record.Eventf(s.scope.InfraCluster(), "SuccessfulSetVPCAttributes", "Set managed VPC attributes for %q", vpc.ID)
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulSetVPCAttributes", "Set VPC attributes for %q", vpc.ID)
 	}
 
 	return nil
@@ -398,6 +442,69 @@ func (s *Service) getIPAMPoolID() (*string, error) {
 	}
 }
 
+// allocateVPCIPAMPoolCidr allocates the CIDR block of the VPC from its IPAM pool and records the allocation in the
+// network status, so that the same CIDR block is used if the VPC fails to be created, and released when the VPC is
+// deleted.
+func (s *Service) allocateVPCIPAMPoolCidr() (*infrav1.IPAMPoolAllocation, error) {
+	if allocation := s.scope.Network().VPCIPAMPoolAllocation; allocation != nil {
+		return allocation, nil
+	}
+
+	ipamPoolID, err := s.getIPAMPoolID()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get IPAM Pool ID")
+	}
+
+	if s.scope.VPC().IPAMPool.NetmaskLength == 0 {
+		s.scope.VPC().IPAMPool.NetmaskLength = defaultIpamV4NetmaskLength
+	}
+
+	out, err := s.EC2Client.AllocateIpamPoolCidrWithContext(context.TODO(), &ec2.AllocateIpamPoolCidrInput{
+		IpamPoolId:    ipamPoolID,
+		NetmaskLength: aws.Int64(s.scope.VPC().IPAMPool.NetmaskLength),
+		Description:   aws.String(fmt.Sprintf("VPC of cluster %s", s.scope.Name())),
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAllocateIPAMPoolCidr", "Failed to allocate CIDR block from IPAM pool %q: %v", aws.StringValue(ipamPoolID), err)
+		return nil, errors.Wrapf(err, "failed to allocate CIDR block from IPAM pool %q", aws.StringValue(ipamPoolID))
+	}
+
+	allocation := &infrav1.IPAMPoolAllocation{
+		ID:        aws.StringValue(out.IpamPoolAllocation.IpamPoolAllocationId),
+		PoolID:    aws.StringValue(ipamPoolID),
+		CidrBlock: aws.StringValue(out.IpamPoolAllocation.Cidr),
+	}
+	s.scope.Network().VPCIPAMPoolAllocation = allocation
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAllocateIPAMPoolCidr", "Allocated CIDR block %q from IPAM pool %q", allocation.CidrBlock, allocation.PoolID)
+
+	if err := s.scope.PatchObject(); err != nil {
+		return nil, errors.Wrap(err, "failed to patch IPAM pool allocation")
+	}
+
+	return allocation, nil
+}
+
+// releaseVPCIPAMPoolCidr releases the CIDR block allocated to the VPC from its IPAM pool, if any.
+func (s *Service) releaseVPCIPAMPoolCidr() error {
+	allocation := s.scope.Network().VPCIPAMPoolAllocation
+	if allocation == nil {
+		return nil
+	}
+
+	if _, err := s.EC2Client.ReleaseIpamPoolAllocationWithContext(context.TODO(), &ec2.ReleaseIpamPoolAllocationInput{
+		IpamPoolId:           aws.String(allocation.PoolID),
+		IpamPoolAllocationId: aws.String(allocation.ID),
+		Cidr:                 aws.String(allocation.CidrBlock),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedReleaseIPAMPoolCidr", "Failed to release CIDR block %q to IPAM pool %q: %v", allocation.CidrBlock, allocation.PoolID, err)
+		return errors.Wrapf(err, "failed to release CIDR block %q to IPAM pool %q", allocation.CidrBlock, allocation.PoolID)
+	}
+
+	s.scope.Network().VPCIPAMPoolAllocation = nil
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulReleaseIPAMPoolCidr", "Released CIDR block %q to IPAM pool %q", allocation.CidrBlock, allocation.PoolID)
+	return nil
+}
+
 func (s *Service) createVPC() (*infrav1.VPCSpec, error) {
 	input := &ec2.CreateVpcInput{
 		TagSpecifications: []*ec2.TagSpecification{
@@ -431,17 +538,13 @@ func (s *Service) createVPC() (*infrav1.VPCSpec, error) {
 
 	// IPv4-specific configuration
 	if s.scope.VPC().IPAMPool != nil {
-		ipamPoolID, err := s.getIPAMPoolID()
+		allocation, err := s.allocateVPCIPAMPoolCidr()
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to get IPAM Pool ID")
-		}
-
-		if s.scope.VPC().IPAMPool.NetmaskLength == 0 {
-			s.scope.VPC().IPAMPool.NetmaskLength = defaultIpamV4NetmaskLength
+			return nil, err
 		}
 
-		input.Ipv4IpamPoolId = ipamPoolID
-		input.Ipv4NetmaskLength = aws.Int64(s.scope.VPC().IPAMPool.NetmaskLength)
+		// The CIDR block is already allocated from the pool, so the VPC must not allocate another one.
+		input.CidrBlock = aws.String(allocation.CidrBlock)
 	} else {
 		if s.scope.VPC().CidrBlock == "" {
 			s.scope.VPC().CidrBlock = defaultVPCCidr
@@ -525,13 +628,13 @@ func (s *Service) deleteVPC() error {
 		// Ignore if it's already deleted
 		if code, ok := awserrors.Code(err); ok && code == awserrors.VPCNotFound {
 			s.scope.Trace("Skipping VPC deletion, VPC not found")
-			return nil
+			return s.releaseVPCIPAMPoolCidr()
 		}
 
 		// Ignore if VPC ID is not present,
 		if code, ok := awserrors.Code(err); ok && code == awserrors.VPCMissingParameter {
 			s.scope.Trace("Skipping VPC deletion, VPC ID not present")
-			return nil
+			return s.releaseVPCIPAMPoolCidr()
 		}
 
 		record.Warnf(s.scope.InfraCluster(), "FailedDeleteVPC", "Failed to delete managed VPC %q: %v", vpc.ID, err)
@@ -540,7 +643,7 @@ func (s *Service) deleteVPC() error {
 
 	s.scope.Info("Deleted VPC", "vpc-id", vpc.ID)
 	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteVPC", "Deleted managed VPC %q", vpc.ID)
-	return nil
+	return s.releaseVPCIPAMPoolCidr()
 }
 
 func (s *Service) describeVPCByID() (*infrav1.VPCSpec, error) {
@@ -642,6 +745,45 @@ func (s *Service) describeVPCByName() (*infrav1.VPCSpec, error) {
 	return vpc, nil
 }
 
+// describeVPCByOwner finds the VPC by the tags of the VPCs created by CAPA for the cluster. Use this if the VPC
+// cannot be found by `Name` tag.
+func (s *Service) describeVPCByOwner() (*infrav1.VPCSpec, error) {
+	input := &ec2.DescribeVpcsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPCStates(ec2.VpcStatePending, ec2.VpcStateAvailable),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.ProviderRole(infrav1.CommonRoleTagValue),
+		},
+	}
+
+	out, err := s.EC2Client.DescribeVpcsWithContext(context.TODO(), input)
+	if (err != nil && awserrors.IsNotFound(err)) || (out != nil && len(out.Vpcs) == 0) {
+		return nil, awserrors.NewNotFound(fmt.Sprintf("could not find VPC owned by cluster %q", s.scope.Name()))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query ec2 for VPCs owned by cluster %q", s.scope.Name())
+	}
+	if len(out.Vpcs) > 1 {
+		return nil, awserrors.NewConflict(fmt.Sprintf("found %v VPCs owned by cluster %q. Only one VPC per cluster name is supported. Ensure duplicate VPCs are deleted for this AWS account and there are no conflicting instances of Cluster API Provider AWS. Filtered VPCs: %v", len(out.Vpcs), s.scope.Name(), out.GoString()))
+	}
+
+	vpc := &infrav1.VPCSpec{
+		ID:        *out.Vpcs[0].VpcId,
+		CidrBlock: *out.Vpcs[0].CidrBlock,
+		Tags:      converters.TagsToMap(out.Vpcs[0].Tags),
+	}
+	for _, set := range out.Vpcs[0].Ipv6CidrBlockAssociationSet {
+		if *set.Ipv6CidrBlockState.State == ec2.SubnetCidrBlockStateCodeAssociated {
+			vpc.IPv6 = &infrav1.IPv6{
+				CidrBlock: aws.StringValue(set.Ipv6CidrBlock),
+				PoolID:    aws.StringValue(set.Ipv6Pool),
+			}
+			break
+		}
+	}
+	return vpc, nil
+}
+
 func (s *Service) getVPCTagParams(id string) infrav1.BuildParams {
 	name := fmt.Sprintf("%s-vpc", s.scope.Name())
 
@@ -47,6 +47,8 @@ func describeVpcAttributeTrue(_ context.Context, input *ec2.DescribeVpcAttribute
 		result.EnableDnsHostnames = &ec2.AttributeBooleanValue{Value: aws.Bool(true)}
 	case "enableDnsSupport":
 		result.EnableDnsSupport = &ec2.AttributeBooleanValue{Value: aws.Bool(true)}
+	case "enableNetworkAddressUsageMetrics":
+		result.EnableNetworkAddressUsageMetrics = &ec2.AttributeBooleanValue{Value: aws.Bool(true)}
 	}
 	return result, nil
 }
@@ -60,6 +62,8 @@ func describeVpcAttributeFalse(_ context.Context, input *ec2.DescribeVpcAttribut
 		result.EnableDnsHostnames = &ec2.AttributeBooleanValue{Value: aws.Bool(false)}
 	case "enableDnsSupport":
 		result.EnableDnsSupport = &ec2.AttributeBooleanValue{Value: aws.Bool(false)}
+	case "enableNetworkAddressUsageMetrics":
+		result.EnableNetworkAddressUsageMetrics = &ec2.AttributeBooleanValue{Value: aws.Bool(false)}
 	}
 	return result, nil
 }
@@ -220,7 +224,23 @@ func TestReconcileVPC(t *testing.T) {
 						},
 					},
 				})).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{}}, nil)
-				m.CreateVpcWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcInput{})).After(describeVPCByNameCall).Return(&ec2.CreateVpcOutput{
+				describeVPCByOwnerCall := m.DescribeVpcsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeVpcsInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("state"),
+							Values: aws.StringSlice([]string{ec2.VpcStatePending, ec2.VpcStateAvailable}),
+						},
+						{
+							Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+							Values: aws.StringSlice([]string{"owned"}),
+						},
+						{
+							Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"),
+							Values: aws.StringSlice([]string{"common"}),
+						},
+					},
+				})).After(describeVPCByNameCall).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{}}, nil)
+				m.CreateVpcWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcInput{})).After(describeVPCByOwnerCall).Return(&ec2.CreateVpcOutput{
 					Vpc: &ec2.Vpc{
 						State:     aws.String("available"),
 						VpcId:     aws.String("vpc-new"),
@@ -288,6 +308,75 @@ func TestReconcileVPC(t *testing.T) {
 				m.ModifyVpcAttributeWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.ModifyVpcAttributeInput{})).Return(&ec2.ModifyVpcAttributeOutput{}, nil).Times(2)
 			},
 		},
+		{
+			name: "Should allocate the CIDR block of a new VPC from its IPAM pool",
+			input: &infrav1.VPCSpec{
+				AvailabilityZoneUsageLimit: &usageLimit,
+				AvailabilityZoneSelection:  &selection,
+				IPAMPool: &infrav1.IPAMPool{
+					Name:          "my-pool",
+					NetmaskLength: 20,
+				},
+			},
+			wantErrContaining: nil,
+			want: &infrav1.VPCSpec{
+				ID:        "vpc-new",
+				CidrBlock: "10.2.16.0/20",
+				IPAMPool: &infrav1.IPAMPool{
+					Name:          "my-pool",
+					NetmaskLength: 20,
+				},
+				Tags: map[string]string{
+					"sigs.k8s.io/cluster-api-provider-aws/role": "common",
+					"Name": "test-cluster-vpc",
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": "owned",
+				},
+				AvailabilityZoneUsageLimit: &usageLimit,
+				AvailabilityZoneSelection:  &selection,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcsInput{})).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{}}, nil).Times(2)
+				m.DescribeIpamPools(gomock.Eq(&ec2.DescribeIpamPoolsInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("tag:Name"),
+							Values: aws.StringSlice([]string{"my-pool"}),
+						},
+					},
+				})).Return(&ec2.DescribeIpamPoolsOutput{
+					IpamPools: []*ec2.IpamPool{{IpamPoolId: aws.String("ipam-pool-1")}},
+				}, nil)
+				allocateCall := m.AllocateIpamPoolCidrWithContext(context.TODO(), gomock.Eq(&ec2.AllocateIpamPoolCidrInput{
+					IpamPoolId:    aws.String("ipam-pool-1"),
+					NetmaskLength: aws.Int64(20),
+					Description:   aws.String("VPC of cluster test-cluster"),
+				})).Return(&ec2.AllocateIpamPoolCidrOutput{
+					IpamPoolAllocation: &ec2.IpamPoolAllocation{
+						IpamPoolAllocationId: aws.String("ipam-pool-alloc-1"),
+						Cidr:                 aws.String("10.2.16.0/20"),
+					},
+				}, nil)
+				m.CreateVpcWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcInput{})).After(allocateCall).DoAndReturn(func(_ context.Context, input *ec2.CreateVpcInput, _ ...request.Option) (*ec2.CreateVpcOutput, error) {
+					// The VPC must not allocate another CIDR block from the pool.
+					if aws.StringValue(input.CidrBlock) != "10.2.16.0/20" || input.Ipv4IpamPoolId != nil {
+						return nil, errors.New("unexpected CIDR block")
+					}
+					return &ec2.CreateVpcOutput{
+						Vpc: &ec2.Vpc{
+							State:     aws.String("available"),
+							VpcId:     aws.String("vpc-new"),
+							CidrBlock: aws.String("10.2.16.0/20"),
+							Tags:      managedVPCTags,
+						},
+					}, nil
+				})
+
+				m.DescribeVpcAttributeWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcAttributeInput{})).
+					DoAndReturn(describeVpcAttributeFalse).MinTimes(1)
+
+				m.ModifyVpcAttributeWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.ModifyVpcAttributeInput{})).Return(&ec2.ModifyVpcAttributeOutput{}, nil).Times(2)
+			},
+		},
 		{
 			name: "Should create a new IPv6 VPC with BYOIP set up if managed IPv6 vpc does not exist",
 			input: &infrav1.VPCSpec{
@@ -323,11 +412,27 @@ func TestReconcileVPC(t *testing.T) {
 						},
 					},
 				})).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{}}, nil)
+				describeVPCByOwnerCall := m.DescribeVpcsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeVpcsInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("state"),
+							Values: aws.StringSlice([]string{ec2.VpcStatePending, ec2.VpcStateAvailable}),
+						},
+						{
+							Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+							Values: aws.StringSlice([]string{"owned"}),
+						},
+						{
+							Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"),
+							Values: aws.StringSlice([]string{"common"}),
+						},
+					},
+				})).After(describeVPCByNameCall).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{}}, nil)
 				m.CreateVpcWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcInput{
 					AmazonProvidedIpv6CidrBlock: aws.Bool(false),
 					Ipv6Pool:                    aws.String("my-pool"),
 					Ipv6CidrBlock:               aws.String("2001:db8:1234:1a03::/56"),
-				})).After(describeVPCByNameCall).Return(&ec2.CreateVpcOutput{
+				})).After(describeVPCByOwnerCall).Return(&ec2.CreateVpcOutput{
 					Vpc: &ec2.Vpc{
 						State:     aws.String("available"),
 						VpcId:     aws.String("vpc-new"),
@@ -438,6 +543,42 @@ func TestReconcileVPC(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			name: "Should only set the requested attributes, if unmanaged vpc exists",
+			input: &infrav1.VPCSpec{
+				ID:                               "unmanaged-vpc-exists",
+				AvailabilityZoneUsageLimit:       &usageLimit,
+				AvailabilityZoneSelection:        &selection,
+				EnableNetworkAddressUsageMetrics: aws.Bool(true),
+			},
+			want: &infrav1.VPCSpec{
+				ID:                               "unmanaged-vpc-exists",
+				CidrBlock:                        "10.0.0.0/8",
+				Tags:                             nil,
+				AvailabilityZoneUsageLimit:       &usageLimit,
+				AvailabilityZoneSelection:        &selection,
+				EnableNetworkAddressUsageMetrics: aws.Bool(true),
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcsWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcsInput{})).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							State:     aws.String("available"),
+							VpcId:     aws.String("unmanaged-vpc-exists"),
+							CidrBlock: aws.String("10.0.0.0/8"),
+						},
+					},
+				}, nil)
+				m.DescribeVpcAttributeWithContext(context.TODO(), gomock.Eq(&ec2.DescribeVpcAttributeInput{
+					VpcId:     aws.String("unmanaged-vpc-exists"),
+					Attribute: aws.String("enableNetworkAddressUsageMetrics"),
+				})).DoAndReturn(describeVpcAttributeFalse)
+				m.ModifyVpcAttributeWithContext(context.TODO(), gomock.Eq(&ec2.ModifyVpcAttributeInput{
+					VpcId:                            aws.String("unmanaged-vpc-exists"),
+					EnableNetworkAddressUsageMetrics: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+				})).Return(&ec2.ModifyVpcAttributeOutput{}, nil)
+			},
+		},
 		{
 			name:  "Should retry if vpc not found error occurs during attributes configuration for managed vpc",
 			input: &infrav1.VPCSpec{ID: "managed-vpc-exists", AvailabilityZoneUsageLimit: &usageLimit, AvailabilityZoneSelection: &selection},
@@ -496,6 +637,71 @@ func TestReconcileVPC(t *testing.T) {
 				m.DescribeVpcAttributeWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcAttributeInput{})).AnyTimes().Return(nil, awserrors.NewFailedDependency("failed dependency"))
 			},
 		},
+		{
+			name:  "Should adopt the managed VPC found by its ownership tags if it cannot be found by name",
+			input: &infrav1.VPCSpec{AvailabilityZoneUsageLimit: &usageLimit, AvailabilityZoneSelection: &selection},
+			want: &infrav1.VPCSpec{
+				ID:        "vpc-renamed",
+				CidrBlock: "10.0.0.0/8",
+				Tags: map[string]string{
+					"sigs.k8s.io/cluster-api-provider-aws/role": "common",
+					"Name": "renamed-vpc",
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": "owned",
+				},
+				AvailabilityZoneUsageLimit: &usageLimit,
+				AvailabilityZoneSelection:  &selection,
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeVPCByNameCall := m.DescribeVpcsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeVpcsInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("tag:Name"),
+							Values: aws.StringSlice([]string{"test-cluster-vpc"}),
+						},
+					},
+				})).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{}}, nil)
+				m.DescribeVpcsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeVpcsInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("state"),
+							Values: aws.StringSlice([]string{ec2.VpcStatePending, ec2.VpcStateAvailable}),
+						},
+						{
+							Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+							Values: aws.StringSlice([]string{"owned"}),
+						},
+						{
+							Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"),
+							Values: aws.StringSlice([]string{"common"}),
+						},
+					},
+				})).After(describeVPCByNameCall).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							State:     aws.String("available"),
+							VpcId:     aws.String("vpc-renamed"),
+							CidrBlock: aws.String("10.0.0.0/8"),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+									Value: aws.String("common"),
+								},
+								{
+									Key:   aws.String("Name"),
+									Value: aws.String("renamed-vpc"),
+								},
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+									Value: aws.String("owned"),
+								},
+							},
+						},
+					},
+				}, nil)
+				m.DescribeVpcAttributeWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcAttributeInput{})).
+					DoAndReturn(describeVpcAttributeTrue).AnyTimes()
+			},
+		},
 		{
 			name:              "Should return error if failed to create vpc",
 			input:             &infrav1.VPCSpec{AvailabilityZoneUsageLimit: &usageLimit, AvailabilityZoneSelection: &selection},
@@ -509,7 +715,23 @@ func TestReconcileVPC(t *testing.T) {
 						},
 					},
 				})).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{}}, nil)
-				m.CreateVpcWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcInput{})).After(describeVPCByNameCall).Return(nil, awserrors.NewFailedDependency("failed dependency"))
+				describeVPCByOwnerCall := m.DescribeVpcsWithContext(context.TODO(), gomock.Eq(&ec2.DescribeVpcsInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("state"),
+							Values: aws.StringSlice([]string{ec2.VpcStatePending, ec2.VpcStateAvailable}),
+						},
+						{
+							Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+							Values: aws.StringSlice([]string{"owned"}),
+						},
+						{
+							Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"),
+							Values: aws.StringSlice([]string{"common"}),
+						},
+					},
+				})).After(describeVPCByNameCall).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{}}, nil)
+				m.CreateVpcWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcInput{})).After(describeVPCByOwnerCall).Return(nil, awserrors.NewFailedDependency("failed dependency"))
 			},
 		},
 		{
@@ -591,6 +813,7 @@ func TestDeleteVPC(t *testing.T) {
 	testCases := []struct {
 		name           string
 		input          *infrav1.VPCSpec
+		allocation     *infrav1.IPAMPoolAllocation
 		additionalTags map[string]string
 		wantErr        bool
 		expect         func(m *mocks.MockEC2APIMockRecorder)
@@ -625,6 +848,29 @@ func TestDeleteVPC(t *testing.T) {
 				})).Return(&ec2.DeleteVpcOutput{}, nil)
 			},
 		},
+		{
+			name: "Should release the IPAM pool allocation of the vpc once deleted",
+			input: &infrav1.VPCSpec{
+				ID:   "managed-vpc",
+				Tags: tags,
+			},
+			allocation: &infrav1.IPAMPoolAllocation{
+				ID:        "ipam-pool-alloc-1",
+				PoolID:    "ipam-pool-1",
+				CidrBlock: "10.2.16.0/20",
+			},
+			wantErr: false,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				deleteCall := m.DeleteVpcWithContext(context.TODO(), gomock.Eq(&ec2.DeleteVpcInput{
+					VpcId: aws.String("managed-vpc"),
+				})).Return(&ec2.DeleteVpcOutput{}, nil)
+				m.ReleaseIpamPoolAllocationWithContext(context.TODO(), gomock.Eq(&ec2.ReleaseIpamPoolAllocationInput{
+					IpamPoolId:           aws.String("ipam-pool-1"),
+					IpamPoolAllocationId: aws.String("ipam-pool-alloc-1"),
+					Cidr:                 aws.String("10.2.16.0/20"),
+				})).After(deleteCall).Return(&ec2.ReleaseIpamPoolAllocationOutput{Success: aws.Bool(true)}, nil)
+			},
+		},
 		{
 			name: "Should not delete vpc if vpc not found",
 			input: &infrav1.VPCSpec{
@@ -645,6 +891,7 @@ func TestDeleteVPC(t *testing.T) {
 			ec2Mock := mocks.NewMockEC2API(mockCtrl)
 			clusterScope, err := getClusterScope(tc.input, tc.additionalTags)
 			g.Expect(err).NotTo(HaveOccurred())
+			clusterScope.Network().VPCIPAMPoolAllocation = tc.allocation
 			if tc.expect != nil {
 				tc.expect(ec2Mock.EXPECT())
 			}
@@ -657,6 +904,7 @@ func TestDeleteVPC(t *testing.T) {
 				return
 			}
 			g.Expect(err).To(BeNil())
+			g.Expect(clusterScope.Network().VPCIPAMPoolAllocation).To(BeNil())
 		})
 	}
 }
@@ -0,0 +1,314 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/smithy-go"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	ec2service "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/quota"
+)
+
+const (
+	// errCodeNoSuchEntity is the error code returned by IAM when a role does not exist.
+	errCodeNoSuchEntity = "NoSuchEntity"
+
+	// errCodeAccessDenied is the error code returned by IAM when the caller is not allowed to call an operation.
+	errCodeAccessDenied = "AccessDenied"
+
+	// errCodeOptInRequired is the error code returned by EC2 when the account is not subscribed to the
+	// AWS Marketplace product of an AMI.
+	errCodeOptInRequired = "OptInRequired"
+
+	// errCodeDryRunOperation is the error code returned by EC2 when a dry run request would have succeeded.
+	errCodeDryRunOperation = "DryRunOperation"
+
+	// defaultAvailabilityZoneUsageLimit is the number of availability zones the subnets of a managed VPC are
+	// created in when the limit is not set.
+	defaultAvailabilityZoneUsageLimit = 3
+)
+
+// serviceLinkedRole is a role the AWS services create the resources of the cluster with.
+type serviceLinkedRole struct {
+	name    string
+	service string
+}
+
+var (
+	elasticLoadBalancingServiceLinkedRole = serviceLinkedRole{name: "AWSServiceRoleForElasticLoadBalancing", service: "elasticloadbalancing.amazonaws.com"}
+	autoScalingServiceLinkedRole          = serviceLinkedRole{name: "AWSServiceRoleForAutoScaling", service: "autoscaling.amazonaws.com"}
+	spotServiceLinkedRole                 = serviceLinkedRole{name: "AWSServiceRoleForEC2Spot", service: "spot.amazonaws.com"}
+)
+
+// Image is an AMI which instances of the cluster are launched from.
+type Image struct {
+	// ID is the ID of the AMI.
+	ID string
+	// InstanceType is the type of the instances launched from the AMI.
+	InstanceType string
+}
+
+// RunChecks validates that the account is ready to host the cluster: that its region exists and is enabled for the
+// account, that the service-linked roles the AWS
+// services need exist, that the quotas of the account leave room for the network of the cluster, that the KMS
+// keys of the cluster can be used and that the account is subscribed to the AWS Marketplace products of the
+// given AMIs. It returns a message for each failed check, sorted, and an error when a check could not be run.
+func (s *Service) RunChecks(ctx context.Context, images []Image) ([]string, error) {
+	var failures []string
+	var errs []error
+	for _, check := range []func() ([]string, error){
+		func() ([]string, error) { return s.checkRegion(ctx) },
+		func() ([]string, error) { return s.checkServiceLinkedRoles(ctx) },
+		s.checkNetworkQuotas,
+		s.checkKMSKeys,
+		func() ([]string, error) { return s.checkImageSubscriptions(images) },
+	} {
+		checkFailures, err := check()
+		failures = append(failures, checkFailures...)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	sort.Strings(failures)
+	return failures, kerrors.NewAggregate(errs)
+}
+
+// checkRegion ensures that the region of the cluster exists and is enabled for the account. The regions are described
+// with their opt-in status so that regions launched after a release are validated as well.
+func (s *Service) checkRegion(ctx context.Context) ([]string, error) {
+	name := s.scope.Region()
+	if name == "" {
+		return nil, nil
+	}
+
+	reason, err := ec2service.CheckRegion(ctx, s.EC2Client, name)
+	if err != nil {
+		if awserrors.IsPermissionsError(errors.Cause(err)) {
+			s.scope.Debug("Not allowed to describe regions, skipping check")
+			return nil, nil
+		}
+		return nil, err
+	}
+	if reason != "" {
+		return []string{reason}, nil
+	}
+	return nil, nil
+}
+
+// checkServiceLinkedRoles ensures that the service-linked roles used by the AWS services to create the resources of
+// the cluster exist. A missing role is created as the AWS service would on its first use, so that the check only
+// fails when the controller is not allowed to create it.
+func (s *Service) checkServiceLinkedRoles(ctx context.Context) ([]string, error) {
+	roles := []serviceLinkedRole{spotServiceLinkedRole}
+	if lb := s.scope.ControlPlaneLoadBalancer(); lb == nil || lb.LoadBalancerType != infrav1.LoadBalancerTypeDisabled {
+		roles = append(roles, elasticLoadBalancingServiceLinkedRole)
+	}
+	if feature.Gates.Enabled(feature.MachinePool) {
+		roles = append(roles, autoScalingServiceLinkedRole)
+	}
+
+	var failures []string
+	for _, role := range roles {
+		_, err := s.IAMClient.GetRole(ctx, &iam.GetRoleInput{RoleName: awsv2.String(role.name)})
+		if err == nil {
+			continue
+		}
+		if code := apiErrorCode(err); code != errCodeNoSuchEntity {
+			if code == errCodeAccessDenied {
+				s.scope.Debug("Not allowed to get service-linked role, skipping check", "role", role.name)
+				continue
+			}
+			return failures, errors.Wrapf(err, "failed to get service-linked role %q", role.name)
+		}
+
+		s.scope.Info("Creating missing service-linked role", "role", role.name)
+		if _, err := s.IAMClient.CreateServiceLinkedRole(ctx, &iam.CreateServiceLinkedRoleInput{AWSServiceName: awsv2.String(role.service)}); err != nil {
+			if apiErrorCode(err) == errCodeAccessDenied {
+				failures = append(failures, fmt.Sprintf("service-linked role %s does not exist and the controller is not allowed to create it, create it with: aws iam create-service-linked-role --aws-service-name %s", role.name, role.service))
+				continue
+			}
+			return failures, errors.Wrapf(err, "failed to create service-linked role %q", role.name)
+		}
+	}
+	return failures, nil
+}
+
+// checkNetworkQuotas ensures that the VPC and Elastic IP quotas of the account leave room for the VPC of the cluster
+// and the NAT gateways of its private subnets, when the VPC is yet to be created.
+func (s *Service) checkNetworkQuotas() ([]string, error) {
+	vpc := s.scope.VPC()
+	if vpc.ID != "" {
+		return nil, nil
+	}
+
+	var failures []string
+	for _, check := range []func() error{
+		func() error { return s.QuotaService.CheckVPCQuota(1) },
+		func() error { return s.QuotaService.CheckElasticIPQuota(s.natGatewayCount()) },
+	} {
+		err := check()
+		if exceededErr, ok := quota.AsResourceQuotaExceeded(err); ok {
+			failures = append(failures, exceededErr.Error())
+			continue
+		}
+		if err != nil {
+			return failures, err
+		}
+	}
+	return failures, nil
+}
+
+// natGatewayCount returns the number of NAT gateways, each with an Elastic IP address, created for the network of the
+// cluster: one per public subnet when there are private subnets, the subnets being created in as many availability
+// zones as allowed when they are not set.
+func (s *Service) natGatewayCount() int64 {
+	subnets := s.scope.Subnets()
+	if len(subnets) == 0 {
+		if limit := s.scope.VPC().AvailabilityZoneUsageLimit; limit != nil {
+			return int64(*limit)
+		}
+		return defaultAvailabilityZoneUsageLimit
+	}
+	if len(subnets.FilterPrivate().FilterNonCni()) == 0 {
+		return 0
+	}
+	return int64(len(subnets.FilterPublic().FilterNonCni()))
+}
+
+// checkKMSKeys ensures that the customer managed KMS keys set on the cluster exist and are enabled.
+func (s *Service) checkKMSKeys() ([]string, error) {
+	spec := s.scope.AWSCluster.Spec
+	var keyIDs []string
+	if spec.S3Bucket != nil && spec.S3Bucket.KMSKeyID != "" {
+		keyIDs = append(keyIDs, spec.S3Bucket.KMSKeyID)
+	}
+	if spec.SessionManager != nil && spec.SessionManager.KMSKeyID != "" {
+		keyIDs = append(keyIDs, spec.SessionManager.KMSKeyID)
+	}
+	if spec.MachineDefaults != nil && spec.MachineDefaults.RootVolumeEncryption != nil && spec.MachineDefaults.RootVolumeEncryption.EncryptionKey != "" {
+		keyIDs = append(keyIDs, spec.MachineDefaults.RootVolumeEncryption.EncryptionKey)
+	}
+
+	err := s.KMSService.ValidateKeys(keyIDs)
+	if err == nil {
+		return nil, nil
+	}
+	var failures []string
+	var errs []error
+	for _, err := range flatten(err) {
+		if notUsableErr, ok := kms.AsKeyNotUsable(err); ok {
+			failures = append(failures, notUsableErr.Error())
+			continue
+		}
+		errs = append(errs, err)
+	}
+	return failures, kerrors.NewAggregate(errs)
+}
+
+// checkImageSubscriptions ensures that the account is subscribed to the AWS Marketplace products of the AMIs, by
+// launching their instances in dry run mode. Images whose launch fails for any other reason are not validated.
+func (s *Service) checkImageSubscriptions(images []Image) ([]string, error) {
+	instanceTypes := map[string]string{}
+	for _, image := range images {
+		if image.ID != "" {
+			instanceTypes[image.ID] = image.InstanceType
+		}
+	}
+	if len(instanceTypes) == 0 {
+		return nil, nil
+	}
+
+	out, err := s.EC2Client.DescribeImagesWithContext(context.TODO(), &ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice(sets.List(sets.KeySet(instanceTypes))),
+	})
+	if err != nil {
+		if awserrors.IsPermissionsError(err) {
+			s.scope.Debug("Not allowed to describe images, skipping check")
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to describe images")
+	}
+
+	var failures []string
+	for _, image := range out.Images {
+		if !isMarketplaceImage(image) {
+			continue
+		}
+		imageID := aws.StringValue(image.ImageId)
+		input := &ec2.RunInstancesInput{
+			DryRun:   aws.Bool(true),
+			ImageId:  image.ImageId,
+			MinCount: aws.Int64(1),
+			MaxCount: aws.Int64(1),
+		}
+		if instanceType := instanceTypes[imageID]; instanceType != "" {
+			input.InstanceType = aws.String(instanceType)
+		}
+		_, err := s.EC2Client.RunInstancesWithContext(context.TODO(), input)
+		switch code, _ := awserrors.Code(err); code {
+		case errCodeOptInRequired:
+			failures = append(failures, fmt.Sprintf("AMI %s is an AWS Marketplace product the account is not subscribed to, subscribe to it in AWS Marketplace", imageID))
+		case errCodeDryRunOperation:
+		default:
+			s.scope.Debug("Could not launch the AMI in dry run mode, skipping check", "ami", imageID, "reason", code)
+		}
+	}
+	return failures, nil
+}
+
+// isMarketplaceImage returns whether an AMI is an AWS Marketplace product.
+func isMarketplaceImage(image *ec2.Image) bool {
+	for _, productCode := range image.ProductCodes {
+		if aws.StringValue(productCode.ProductCodeType) == ec2.ProductCodeValuesMarketplace {
+			return true
+		}
+	}
+	return false
+}
+
+// apiErrorCode returns the error code of an error returned by an AWS SDK v2 client.
+func apiErrorCode(err error) string {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+	return ""
+}
+
+// flatten returns the errors of an aggregate, or the error itself.
+func flatten(err error) []error {
+	var agg kerrors.Aggregate
+	if errors.As(err, &agg) {
+		return kerrors.Flatten(agg).Errors()
+	}
+	return []error{err}
+}
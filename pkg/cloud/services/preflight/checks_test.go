@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"testing"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/smithy-go"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/preflight/mock_iamiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/quota"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestRunChecks(t *testing.T) {
+	notFound := &iamtypes.NoSuchEntityException{}
+	accessDenied := &smithy.GenericAPIError{Code: "AccessDenied"}
+	marketplaceImage := &ec2.Image{
+		ImageId:      aws.String("ami-marketplace"),
+		ProductCodes: []*ec2.ProductCode{{ProductCodeId: aws.String("abc"), ProductCodeType: aws.String(ec2.ProductCodeValuesMarketplace)}},
+	}
+
+	tests := []struct {
+		name         string
+		awsCluster   infrav1.AWSClusterSpec
+		images       []Image
+		expect       func(iamMock *mock_iamiface.MockIAMAPIMockRecorder, ec2Mock *mocks.MockEC2APIMockRecorder, quotaMock *mock_services.MockQuotaInterfaceMockRecorder, kmsMock *mock_services.MockKMSInterfaceMockRecorder)
+		wantFailures []string
+		wantErr      bool
+	}{
+		{
+			name: "all checks pass",
+			awsCluster: infrav1.AWSClusterSpec{
+				Region:   "us-east-1",
+				S3Bucket: &infrav1.S3Bucket{Name: "bucket", KMSKeyID: "alias/bucket"},
+			},
+			images: []Image{{ID: "ami-marketplace", InstanceType: "m5.large"}},
+			expect: func(iamMock *mock_iamiface.MockIAMAPIMockRecorder, ec2Mock *mocks.MockEC2APIMockRecorder, quotaMock *mock_services.MockQuotaInterfaceMockRecorder, kmsMock *mock_services.MockKMSInterfaceMockRecorder) {
+				ec2Mock.DescribeRegionsWithContext(gomock.Any(), gomock.Any()).
+					Return(&ec2.DescribeRegionsOutput{Regions: []*ec2.Region{{RegionName: aws.String("us-east-1"), OptInStatus: aws.String("opt-in-not-required")}}}, nil)
+				iamMock.GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{}, nil).Times(3)
+				quotaMock.CheckVPCQuota(int64(1)).Return(nil)
+				quotaMock.CheckElasticIPQuota(int64(3)).Return(nil)
+				kmsMock.ValidateKeys([]string{"alias/bucket"}).Return(nil)
+				ec2Mock.DescribeImagesWithContext(gomock.Any(), &ec2.DescribeImagesInput{ImageIds: aws.StringSlice([]string{"ami-marketplace"})}).
+					Return(&ec2.DescribeImagesOutput{Images: []*ec2.Image{marketplaceImage}}, nil)
+				ec2Mock.RunInstancesWithContext(gomock.Any(), &ec2.RunInstancesInput{
+					DryRun:       aws.Bool(true),
+					ImageId:      aws.String("ami-marketplace"),
+					InstanceType: aws.String("m5.large"),
+					MinCount:     aws.Int64(1),
+					MaxCount:     aws.Int64(1),
+				}).Return(nil, awserr.New("DryRunOperation", "would have succeeded", nil))
+			},
+		},
+		{
+			name: "missing service-linked roles are created",
+			awsCluster: infrav1.AWSClusterSpec{
+				ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{LoadBalancerType: infrav1.LoadBalancerTypeDisabled},
+				NetworkSpec:              infrav1.NetworkSpec{VPC: infrav1.VPCSpec{ID: "vpc-1"}},
+			},
+			expect: func(iamMock *mock_iamiface.MockIAMAPIMockRecorder, _ *mocks.MockEC2APIMockRecorder, _ *mock_services.MockQuotaInterfaceMockRecorder, kmsMock *mock_services.MockKMSInterfaceMockRecorder) {
+				iamMock.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: awsv2.String("AWSServiceRoleForEC2Spot")}).Return(nil, notFound)
+				iamMock.CreateServiceLinkedRole(gomock.Any(), &iam.CreateServiceLinkedRoleInput{AWSServiceName: awsv2.String("spot.amazonaws.com")}).Return(&iam.CreateServiceLinkedRoleOutput{}, nil)
+				iamMock.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: awsv2.String("AWSServiceRoleForAutoScaling")}).Return(&iam.GetRoleOutput{}, nil)
+				kmsMock.ValidateKeys(nil).Return(nil)
+			},
+		},
+		{
+			name: "failures are listed",
+			awsCluster: infrav1.AWSClusterSpec{
+				Region: "ap-southeast-7",
+				NetworkSpec: infrav1.NetworkSpec{Subnets: infrav1.Subnets{
+					{ID: "subnet-public-a", IsPublic: true},
+					{ID: "subnet-private-a"},
+				}},
+				SessionManager: &infrav1.SessionManager{KMSKeyID: "alias/sessions"},
+			},
+			images: []Image{{ID: "ami-marketplace"}, {ID: "ami-public"}},
+			expect: func(iamMock *mock_iamiface.MockIAMAPIMockRecorder, ec2Mock *mocks.MockEC2APIMockRecorder, quotaMock *mock_services.MockQuotaInterfaceMockRecorder, kmsMock *mock_services.MockKMSInterfaceMockRecorder) {
+				ec2Mock.DescribeRegionsWithContext(gomock.Any(), gomock.Any()).
+					Return(&ec2.DescribeRegionsOutput{Regions: []*ec2.Region{{RegionName: aws.String("ap-southeast-7"), OptInStatus: aws.String("not-opted-in")}}}, nil)
+				iamMock.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: awsv2.String("AWSServiceRoleForElasticLoadBalancing")}).Return(nil, notFound)
+				iamMock.CreateServiceLinkedRole(gomock.Any(), gomock.Any()).Return(nil, accessDenied)
+				iamMock.GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{}, nil).Times(2)
+				quotaMock.CheckVPCQuota(int64(1)).Return(&quota.ResourceQuotaExceededError{QuotaCode: "L-F678F1CE", QuotaName: "VPCs per Region", Resource: "VPCs", Required: 1})
+				quotaMock.CheckElasticIPQuota(int64(1)).Return(nil)
+				kmsMock.ValidateKeys([]string{"alias/sessions"}).Return(&kms.KeyNotUsableError{KeyID: "alias/sessions", Principal: "capa", Reason: "the key is Disabled"})
+				ec2Mock.DescribeImagesWithContext(gomock.Any(), &ec2.DescribeImagesInput{ImageIds: aws.StringSlice([]string{"ami-marketplace", "ami-public"})}).
+					Return(&ec2.DescribeImagesOutput{Images: []*ec2.Image{marketplaceImage, {ImageId: aws.String("ami-public")}}}, nil)
+				ec2Mock.RunInstancesWithContext(gomock.Any(), gomock.Any()).Return(nil, awserr.New("OptInRequired", "not subscribed", nil))
+			},
+			wantFailures: []string{
+				"AMI ami-marketplace is an AWS Marketplace product the account is not subscribed to, subscribe to it in AWS Marketplace",
+				`KMS key "alias/sessions" cannot be used by capa: the key is Disabled`,
+				"creating 1 VPCs would exceed quota L-F678F1CE (VPCs per Region) as only 0 are available, request a quota increase",
+				"region ap-southeast-7 is an opt-in region not enabled for the account, enable it with: aws account enable-region --region-name ap-southeast-7",
+				"service-linked role AWSServiceRoleForElasticLoadBalancing does not exist and the controller is not allowed to create it, create it with: aws iam create-service-linked-role --aws-service-name elasticloadbalancing.amazonaws.com",
+			},
+		},
+		{
+			name: "errors do not prevent the other checks",
+			awsCluster: infrav1.AWSClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{VPC: infrav1.VPCSpec{ID: "vpc-1"}},
+			},
+			expect: func(iamMock *mock_iamiface.MockIAMAPIMockRecorder, _ *mocks.MockEC2APIMockRecorder, _ *mock_services.MockQuotaInterfaceMockRecorder, kmsMock *mock_services.MockKMSInterfaceMockRecorder) {
+				iamMock.GetRole(gomock.Any(), gomock.Any()).Return(nil, &smithy.GenericAPIError{Code: "ServiceFailure"})
+				kmsMock.ValidateKeys(nil).Return(&kms.KeyNotUsableError{KeyID: "alias/default", Principal: "capa", Reason: "the key does not exist"})
+			},
+			wantFailures: []string{`KMS key "alias/default" cannot be used by capa: the key does not exist`},
+			wantErr:      true,
+		},
+		{
+			name: "unknown region",
+			awsCluster: infrav1.AWSClusterSpec{
+				Region:      "us-south-9",
+				NetworkSpec: infrav1.NetworkSpec{VPC: infrav1.VPCSpec{ID: "vpc-1"}},
+			},
+			expect: func(iamMock *mock_iamiface.MockIAMAPIMockRecorder, ec2Mock *mocks.MockEC2APIMockRecorder, _ *mock_services.MockQuotaInterfaceMockRecorder, kmsMock *mock_services.MockKMSInterfaceMockRecorder) {
+				ec2Mock.DescribeRegionsWithContext(gomock.Any(), gomock.Any()).Return(&ec2.DescribeRegionsOutput{}, nil)
+				iamMock.GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{}, nil).Times(3)
+				kmsMock.ValidateKeys(nil).Return(nil)
+			},
+			wantFailures: []string{"region us-south-9 does not exist"},
+		},
+		{
+			name: "region reached while not enabled",
+			awsCluster: infrav1.AWSClusterSpec{
+				Region:      "ap-southeast-7",
+				NetworkSpec: infrav1.NetworkSpec{VPC: infrav1.VPCSpec{ID: "vpc-1"}},
+			},
+			expect: func(iamMock *mock_iamiface.MockIAMAPIMockRecorder, ec2Mock *mocks.MockEC2APIMockRecorder, _ *mock_services.MockQuotaInterfaceMockRecorder, kmsMock *mock_services.MockKMSInterfaceMockRecorder) {
+				ec2Mock.DescribeRegionsWithContext(gomock.Any(), gomock.Any()).Return(nil, awserr.New("OptInRequired", "region not enabled", nil))
+				iamMock.GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{}, nil).Times(3)
+				kmsMock.ValidateKeys(nil).Return(nil)
+			},
+			wantFailures: []string{"region ap-southeast-7 is an opt-in region not enabled for the account, enable it with: aws account enable-region --region-name ap-southeast-7"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)
+			mockCtrl := gomock.NewController(t)
+			iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			quotaMock := mock_services.NewMockQuotaInterface(mockCtrl)
+			kmsMock := mock_services.NewMockKMSInterface(mockCtrl)
+			tt.expect(iamMock.EXPECT(), ec2Mock.EXPECT(), quotaMock.EXPECT(), kmsMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{Spec: tt.awsCluster},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := &Service{
+				scope:        clusterScope,
+				IAMClient:    iamMock,
+				EC2Client:    ec2Mock,
+				QuotaService: quotaMock,
+				KMSService:   kmsMock,
+			}
+
+			failures, err := s.RunChecks(context.TODO(), tt.images)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(failures).To(Equal(tt.wantFailures))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package preflight provides a service validating that an account is ready to host a cluster before its
+// resources are created.
+package preflight
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/quota"
+)
+
+// Service runs the preflight checks of a cluster.
+type Service struct {
+	scope        *scope.ClusterScope
+	IAMClient    IAMAPI
+	EC2Client    ec2iface.EC2API
+	QuotaService services.QuotaInterface
+	KMSService   services.KMSInterface
+}
+
+// IAMAPI is the subset of the AWS IAM API that is used to check the service-linked roles of the account.
+type IAMAPI interface {
+	GetRole(ctx context.Context, params *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error)
+	CreateServiceLinkedRole(ctx context.Context, params *iam.CreateServiceLinkedRoleInput, optFns ...func(*iam.Options)) (*iam.CreateServiceLinkedRoleOutput, error)
+}
+
+// NewService returns a new service given the api clients.
+func NewService(clusterScope *scope.ClusterScope) *Service {
+	return &Service{
+		scope:        clusterScope,
+		IAMClient:    scope.NewIAMClient(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
+		EC2Client:    scope.NewEC2Client(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
+		QuotaService: quota.NewService(clusterScope),
+		KMSService:   kms.NewService(clusterScope),
+	}
+}
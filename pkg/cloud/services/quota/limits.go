@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+)
+
+// InstanceType is an instance type the instances of a cluster are launched with.
+type InstanceType struct {
+	// Name is the name of the instance type, e.g. "m5.large".
+	Name string
+	// Spot is whether the instances are Spot Instances.
+	Spot bool
+}
+
+// ResourceLimits returns the usage of the Elastic IP addresses quota of the account in the region, of the vCPU
+// quotas applying to the given instance types, and the network interface limits of the instance types. The quotas
+// the controller is not allowed to read, or whose usage is not available, are omitted.
+func (s *Service) ResourceLimits(instanceTypes []InstanceType) (*infrav1.ResourceLimits, error) {
+	limits := &infrav1.ResourceLimits{}
+
+	elasticIPs, err := s.elasticIPsQuotaUsage()
+	if err != nil {
+		return nil, err
+	}
+	limits.ElasticIPs = elasticIPs
+
+	limits.VCPUs, err = s.vcpuQuotaUsages(instanceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	limits.InstanceTypes, err = s.instanceTypeNetworkLimits(instanceTypes)
+	if err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+// elasticIPsQuotaUsage returns the usage of the Elastic IP addresses quota, or nil if it is not available.
+func (s *Service) elasticIPsQuotaUsage() (*infrav1.QuotaUsage, error) {
+	quota, err := s.getQuota(ec2ServiceCode, elasticIPsQuotaCode)
+	if err != nil || quota == nil {
+		return nil, err
+	}
+
+	used, err := s.elasticIPsUsage()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the usage of quota %q", elasticIPsQuotaCode)
+	}
+	return newQuotaUsage(quota, used), nil
+}
+
+// vcpuQuotaUsages returns the usages of the vCPU quotas applying to the instance types, sorted by quota code.
+func (s *Service) vcpuQuotaUsages(instanceTypes []InstanceType) ([]infrav1.QuotaUsage, error) {
+	quotaInstanceTypes := map[string]sets.Set[string]{}
+	for _, instanceType := range instanceTypes {
+		quotaCode := vcpuQuotaCode(instanceType.Name, instanceType.Spot)
+		if quotaCode == "" {
+			continue
+		}
+		if quotaInstanceTypes[quotaCode] == nil {
+			quotaInstanceTypes[quotaCode] = sets.New[string]()
+		}
+		quotaInstanceTypes[quotaCode].Insert(instanceType.Name)
+	}
+
+	var usages []infrav1.QuotaUsage
+	for _, quotaCode := range sets.List(sets.KeySet(quotaInstanceTypes)) {
+		quota, err := s.getQuota(ec2ServiceCode, quotaCode)
+		if err != nil {
+			return nil, err
+		}
+		if quota == nil || quota.UsageMetric == nil {
+			continue
+		}
+
+		used, ok, err := s.quotaUsage(quota.UsageMetric)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the usage of quota %q", quotaCode)
+		}
+		if !ok {
+			s.scope.Debug("Usage of the vCPU quota is not available, not reporting it", "quota-code", quotaCode)
+			continue
+		}
+
+		usage := newQuotaUsage(quota, int64(math.Ceil(used)))
+		usage.InstanceTypes = sets.List(quotaInstanceTypes[quotaCode])
+		usages = append(usages, *usage)
+	}
+	return usages, nil
+}
+
+// instanceTypeNetworkLimits returns the network interface limits of the instance types, sorted by name.
+func (s *Service) instanceTypeNetworkLimits(instanceTypes []InstanceType) ([]infrav1.InstanceTypeNetworkLimits, error) {
+	names := sets.New[string]()
+	for _, instanceType := range instanceTypes {
+		names.Insert(instanceType.Name)
+	}
+	if names.Len() == 0 {
+		return nil, nil
+	}
+
+	var limits []infrav1.InstanceTypeNetworkLimits
+	if err := s.EC2Client.DescribeInstanceTypesPagesWithContext(context.TODO(), &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice(sets.List(names)),
+	}, func(out *ec2.DescribeInstanceTypesOutput, _ bool) bool {
+		for _, info := range out.InstanceTypes {
+			if info.NetworkInfo == nil {
+				continue
+			}
+			limits = append(limits, infrav1.InstanceTypeNetworkLimits{
+				InstanceType:              aws.StringValue(info.InstanceType),
+				MaximumNetworkInterfaces:  aws.Int64Value(info.NetworkInfo.MaximumNetworkInterfaces),
+				IPv4AddressesPerInterface: aws.Int64Value(info.NetworkInfo.Ipv4AddressesPerInterface),
+				IPv6AddressesPerInterface: aws.Int64Value(info.NetworkInfo.Ipv6AddressesPerInterface),
+			})
+		}
+		return true
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to describe instance types")
+	}
+	sort.Slice(limits, func(i, j int) bool {
+		return limits[i].InstanceType < limits[j].InstanceType
+	})
+	return limits, nil
+}
+
+// getQuota returns a quota of the account, or nil if the controller is not allowed to read it or if it does not
+// exist in the region.
+func (s *Service) getQuota(serviceCode, quotaCode string) (*servicequotas.ServiceQuota, error) {
+	out, err := s.ServiceQuotasClient.GetServiceQuotaWithContext(context.TODO(), &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		switch code, _ := awserrors.Code(err); code {
+		case servicequotas.ErrCodeAccessDeniedException, servicequotas.ErrCodeNoSuchResourceException:
+			s.scope.Debug("Quota is not available, not reporting it", "quota-code", quotaCode, "reason", code)
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get quota %q", quotaCode)
+	}
+	if out.Quota == nil || out.Quota.Value == nil {
+		return nil, nil
+	}
+	return out.Quota, nil
+}
+
+// newQuotaUsage returns the usage of a quota given the amount used.
+func newQuotaUsage(quota *servicequotas.ServiceQuota, used int64) *infrav1.QuotaUsage {
+	limit := int64(math.Floor(aws.Float64Value(quota.Value)))
+	return &infrav1.QuotaUsage{
+		QuotaCode: aws.StringValue(quota.QuotaCode),
+		QuotaName: aws.StringValue(quota.QuotaName),
+		Limit:     limit,
+		Used:      used,
+		Available: max(limit-used, 0),
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/cloudwatch/mock_cloudwatchiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/quota/mock_servicequotasiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestResourceLimits(t *testing.T) {
+	getQuota := func(m *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, quotaCode, quotaName string, value float64, usageMetric *servicequotas.MetricInfo) {
+		m.GetServiceQuotaWithContext(context.TODO(), gomock.Eq(&servicequotas.GetServiceQuotaInput{
+			ServiceCode: aws.String("ec2"),
+			QuotaCode:   aws.String(quotaCode),
+		})).Return(&servicequotas.GetServiceQuotaOutput{
+			Quota: &servicequotas.ServiceQuota{
+				QuotaCode:   aws.String(quotaCode),
+				QuotaName:   aws.String(quotaName),
+				Value:       aws.Float64(value),
+				UsageMetric: usageMetric,
+			},
+		}, nil)
+	}
+	usageMetric := &servicequotas.MetricInfo{
+		MetricNamespace:               aws.String("AWS/Usage"),
+		MetricName:                    aws.String("ResourceCount"),
+		MetricStatisticRecommendation: aws.String("Maximum"),
+	}
+	getUsage := func(m *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, usage float64) {
+		m.GetMetricStatisticsWithContext(context.TODO(), gomock.Any()).Return(&cloudwatch.GetMetricStatisticsOutput{
+			Datapoints: []*cloudwatch.Datapoint{{Timestamp: aws.Time(time.Now()), Maximum: aws.Float64(usage)}},
+		}, nil)
+	}
+	describeInstanceTypes := func(m *mocks.MockEC2APIMockRecorder, instanceTypes ...*ec2.InstanceTypeInfo) {
+		m.DescribeInstanceTypesPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *ec2.DescribeInstanceTypesInput, fn func(*ec2.DescribeInstanceTypesOutput, bool) bool, _ ...request.Option) error {
+				fn(&ec2.DescribeInstanceTypesOutput{InstanceTypes: instanceTypes}, true)
+				return nil
+			})
+	}
+	networkInfo := func(instanceType string, interfaces, ipv4, ipv6 int64) *ec2.InstanceTypeInfo {
+		return &ec2.InstanceTypeInfo{
+			InstanceType: aws.String(instanceType),
+			NetworkInfo: &ec2.NetworkInfo{
+				MaximumNetworkInterfaces:  aws.Int64(interfaces),
+				Ipv4AddressesPerInterface: aws.Int64(ipv4),
+				Ipv6AddressesPerInterface: aws.Int64(ipv6),
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		instanceTypes []InstanceType
+		expect        func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, cw *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, m *mocks.MockEC2APIMockRecorder)
+		want          *infrav1.ResourceLimits
+		wantErr       bool
+	}{
+		{
+			name: "reports the quotas and network limits of the instance types",
+			instanceTypes: []InstanceType{
+				{Name: "m5.xlarge"},
+				{Name: "c5.large"},
+				{Name: "m5.xlarge", Spot: true},
+				{Name: "m5.xlarge"},
+			},
+			expect: func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, cw *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, m *mocks.MockEC2APIMockRecorder) {
+				getQuota(sq, "L-0263D0A3", "EC2-VPC Elastic IPs", 5, nil)
+				m.DescribeAddressesWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeAddressesOutput{Addresses: make([]*ec2.Address, 3)}, nil)
+				getQuota(sq, "L-1216C47A", "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances", 64, usageMetric)
+				getUsage(cw, 60.5)
+				getQuota(sq, "L-34B43A08", "All Standard (A, C, D, H, I, M, R, T, Z) Spot Instance Requests", 32, usageMetric)
+				getUsage(cw, 40)
+				describeInstanceTypes(m, networkInfo("m5.xlarge", 4, 15, 15), networkInfo("c5.large", 3, 10, 10))
+			},
+			want: &infrav1.ResourceLimits{
+				ElasticIPs: &infrav1.QuotaUsage{QuotaCode: "L-0263D0A3", QuotaName: "EC2-VPC Elastic IPs", Limit: 5, Used: 3, Available: 2},
+				VCPUs: []infrav1.QuotaUsage{
+					{
+						QuotaCode: "L-1216C47A", QuotaName: "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances",
+						Limit: 64, Used: 61, Available: 3, InstanceTypes: []string{"c5.large", "m5.xlarge"},
+					},
+					{
+						QuotaCode: "L-34B43A08", QuotaName: "All Standard (A, C, D, H, I, M, R, T, Z) Spot Instance Requests",
+						Limit: 32, Used: 40, Available: 0, InstanceTypes: []string{"m5.xlarge"},
+					},
+				},
+				InstanceTypes: []infrav1.InstanceTypeNetworkLimits{
+					{InstanceType: "c5.large", MaximumNetworkInterfaces: 3, IPv4AddressesPerInterface: 10, IPv6AddressesPerInterface: 10},
+					{InstanceType: "m5.xlarge", MaximumNetworkInterfaces: 4, IPv4AddressesPerInterface: 15, IPv6AddressesPerInterface: 15},
+				},
+			},
+		},
+		{
+			name:          "quotas and usages which cannot be read are omitted",
+			instanceTypes: []InstanceType{{Name: "m5.xlarge"}, {Name: "mac1.metal"}},
+			expect: func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, cw *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, m *mocks.MockEC2APIMockRecorder) {
+				sq.GetServiceQuotaWithContext(context.TODO(), gomock.Any()).Return(nil, awserr.New(servicequotas.ErrCodeAccessDeniedException, "denied", nil))
+				getQuota(sq, "L-1216C47A", "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances", 64, usageMetric)
+				cw.GetMetricStatisticsWithContext(context.TODO(), gomock.Any()).Return(nil, awserr.New("AccessDenied", "denied", nil))
+				describeInstanceTypes(m, networkInfo("m5.xlarge", 4, 15, 15), networkInfo("mac1.metal", 8, 30, 30))
+			},
+			want: &infrav1.ResourceLimits{
+				InstanceTypes: []infrav1.InstanceTypeNetworkLimits{
+					{InstanceType: "m5.xlarge", MaximumNetworkInterfaces: 4, IPv4AddressesPerInterface: 15, IPv6AddressesPerInterface: 15},
+					{InstanceType: "mac1.metal", MaximumNetworkInterfaces: 8, IPv4AddressesPerInterface: 30, IPv6AddressesPerInterface: 30},
+				},
+			},
+		},
+		{
+			name:          "errors are returned",
+			instanceTypes: []InstanceType{{Name: "m5.xlarge"}},
+			expect: func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, _ *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, _ *mocks.MockEC2APIMockRecorder) {
+				sq.GetServiceQuotaWithContext(context.TODO(), gomock.Any()).Return(nil, awserr.New("ServiceException", "error", nil))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			serviceQuotasMock := mock_servicequotasiface.NewMockServiceQuotasAPI(mockCtrl)
+			cloudWatchMock := mock_cloudwatchiface.NewMockCloudWatchAPI(mockCtrl)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tt.expect(serviceQuotasMock.EXPECT(), cloudWatchMock.EXPECT(), ec2Mock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{Spec: infrav1.AWSClusterSpec{Region: "us-east-1"}},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.ServiceQuotasClient = serviceQuotasMock
+			s.CloudWatchClient = cloudWatchMock
+			s.EC2Client = ec2Mock
+
+			limits, err := s.ResourceLimits(tt.instanceTypes)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(limits).To(Equal(tt.want))
+		})
+	}
+}
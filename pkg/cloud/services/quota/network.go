@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+)
+
+const (
+	// vpcServiceCode is the code of Amazon VPC in Service Quotas.
+	vpcServiceCode = "vpc"
+
+	// vpcsQuotaCode is the code of the quota limiting the number of VPCs per region.
+	vpcsQuotaCode = "L-F678F1CE"
+
+	// elasticIPsQuotaCode is the code of the quota limiting the number of Elastic IP addresses per region.
+	elasticIPsQuotaCode = "L-0263D0A3"
+)
+
+// ResourceQuotaExceededError is returned when creating resources would exceed a quota of the account.
+type ResourceQuotaExceededError struct {
+	// QuotaCode is the code of the exceeded quota in Service Quotas.
+	QuotaCode string
+	// QuotaName is the name of the exceeded quota.
+	QuotaName string
+	// Resource is the kind of the resources to create.
+	Resource string
+	// Required is the number of resources to create.
+	Required int64
+	// Available is the number of resources left under the quota.
+	Available int64
+}
+
+func (e *ResourceQuotaExceededError) Error() string {
+	return fmt.Sprintf("creating %d %s would exceed quota %s (%s) as only %d are available, request a quota increase",
+		e.Required, e.Resource, e.QuotaCode, e.QuotaName, e.Available)
+}
+
+// AsResourceQuotaExceeded returns the ResourceQuotaExceededError wrapped by err, if any.
+func AsResourceQuotaExceeded(err error) (*ResourceQuotaExceededError, bool) {
+	var exceededErr *ResourceQuotaExceededError
+	if errors.As(err, &exceededErr) {
+		return exceededErr, true
+	}
+	return nil, false
+}
+
+// CheckVPCQuota ensures that creating the given number of VPCs does not exceed the VPC quota of the account in
+// the region. It returns a ResourceQuotaExceededError otherwise.
+func (s *Service) CheckVPCQuota(vpcs int64) error {
+	return s.checkResourceQuota(vpcServiceCode, vpcsQuotaCode, "VPCs", vpcs, s.vpcsUsage)
+}
+
+// CheckElasticIPQuota ensures that allocating the given number of Elastic IP addresses does not exceed the
+// Elastic IP quota of the account in the region. It returns a ResourceQuotaExceededError otherwise.
+func (s *Service) CheckElasticIPQuota(addresses int64) error {
+	return s.checkResourceQuota(ec2ServiceCode, elasticIPsQuotaCode, "Elastic IP addresses", addresses, s.elasticIPsUsage)
+}
+
+// checkResourceQuota compares the number of resources to create to the value of a quota minus its usage. The quota
+// is not checked when the controller is not allowed to read it.
+func (s *Service) checkResourceQuota(serviceCode, quotaCode, resource string, required int64, usage func() (int64, error)) error {
+	if required <= 0 {
+		return nil
+	}
+
+	out, err := s.ServiceQuotasClient.GetServiceQuotaWithContext(context.TODO(), &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		switch code, _ := awserrors.Code(err); code {
+		case servicequotas.ErrCodeAccessDeniedException, servicequotas.ErrCodeNoSuchResourceException:
+			s.scope.Debug("Quota is not available, skipping quota check", "quota-code", quotaCode, "reason", code)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get quota %q", quotaCode)
+	}
+	if out.Quota == nil || out.Quota.Value == nil {
+		return nil
+	}
+
+	used, err := usage()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the usage of quota %q", quotaCode)
+	}
+
+	available := int64(math.Floor(aws.Float64Value(out.Quota.Value))) - used
+	if required > available {
+		return &ResourceQuotaExceededError{
+			QuotaCode: quotaCode,
+			QuotaName: aws.StringValue(out.Quota.QuotaName),
+			Resource:  resource,
+			Required:  required,
+			Available: max(available, 0),
+		}
+	}
+	return nil
+}
+
+// vpcsUsage returns the number of VPCs of the account in the region.
+func (s *Service) vpcsUsage() (int64, error) {
+	var vpcs int64
+	err := s.EC2Client.DescribeVpcsPagesWithContext(context.TODO(), &ec2.DescribeVpcsInput{}, func(page *ec2.DescribeVpcsOutput, _ bool) bool {
+		vpcs += int64(len(page.Vpcs))
+		return true
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to describe VPCs")
+	}
+	return vpcs, nil
+}
+
+// elasticIPsUsage returns the number of Elastic IP addresses allocated by the account in the region.
+func (s *Service) elasticIPsUsage() (int64, error) {
+	out, err := s.EC2Client.DescribeAddressesWithContext(context.TODO(), &ec2.DescribeAddressesInput{})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to describe Elastic IP addresses")
+	}
+	return int64(len(out.Addresses)), nil
+}
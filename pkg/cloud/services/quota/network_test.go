@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/quota/mock_servicequotasiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestCheckNetworkQuotas(t *testing.T) {
+	getQuota := func(m *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, serviceCode, quotaCode, quotaName string, value float64) {
+		m.GetServiceQuotaWithContext(context.TODO(), gomock.Eq(&servicequotas.GetServiceQuotaInput{
+			ServiceCode: aws.String(serviceCode),
+			QuotaCode:   aws.String(quotaCode),
+		})).Return(&servicequotas.GetServiceQuotaOutput{
+			Quota: &servicequotas.ServiceQuota{
+				QuotaCode: aws.String(quotaCode),
+				QuotaName: aws.String(quotaName),
+				Value:     aws.Float64(value),
+			},
+		}, nil)
+	}
+	describeVpcs := func(m *mocks.MockEC2APIMockRecorder, vpcs int) {
+		m.DescribeVpcsPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *ec2.DescribeVpcsInput, fn func(*ec2.DescribeVpcsOutput, bool) bool, _ ...request.Option) error {
+				fn(&ec2.DescribeVpcsOutput{Vpcs: make([]*ec2.Vpc, vpcs)}, true)
+				return nil
+			})
+	}
+	describeAddresses := func(m *mocks.MockEC2APIMockRecorder, addresses int) {
+		m.DescribeAddressesWithContext(context.TODO(), gomock.Any()).Return(&ec2.DescribeAddressesOutput{Addresses: make([]*ec2.Address, addresses)}, nil)
+	}
+
+	tests := []struct {
+		name         string
+		vpcs         int64
+		addresses    int64
+		expect       func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, m *mocks.MockEC2APIMockRecorder)
+		wantExceeded []*ResourceQuotaExceededError
+		wantErr      bool
+	}{
+		{
+			name:      "quotas leave room for the network",
+			vpcs:      1,
+			addresses: 3,
+			expect: func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, m *mocks.MockEC2APIMockRecorder) {
+				getQuota(sq, "vpc", "L-F678F1CE", "VPCs per Region", 5)
+				describeVpcs(m, 4)
+				getQuota(sq, "ec2", "L-0263D0A3", "EC2-VPC Elastic IPs", 5)
+				describeAddresses(m, 2)
+			},
+			wantExceeded: []*ResourceQuotaExceededError{nil, nil},
+		},
+		{
+			name:      "quotas are exceeded",
+			vpcs:      1,
+			addresses: 3,
+			expect: func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, m *mocks.MockEC2APIMockRecorder) {
+				getQuota(sq, "vpc", "L-F678F1CE", "VPCs per Region", 5)
+				describeVpcs(m, 5)
+				getQuota(sq, "ec2", "L-0263D0A3", "EC2-VPC Elastic IPs", 5)
+				describeAddresses(m, 4)
+			},
+			wantExceeded: []*ResourceQuotaExceededError{
+				{QuotaCode: "L-F678F1CE", QuotaName: "VPCs per Region", Resource: "VPCs", Required: 1, Available: 0},
+				{QuotaCode: "L-0263D0A3", QuotaName: "EC2-VPC Elastic IPs", Resource: "Elastic IP addresses", Required: 3, Available: 1},
+			},
+		},
+		{
+			name:      "nothing to create",
+			vpcs:      0,
+			addresses: 0,
+			expect:    func(_ *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, _ *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:      "quotas which cannot be read are skipped",
+			vpcs:      1,
+			addresses: 3,
+			expect: func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, _ *mocks.MockEC2APIMockRecorder) {
+				sq.GetServiceQuotaWithContext(context.TODO(), gomock.Any()).Return(nil, awserr.New(servicequotas.ErrCodeAccessDeniedException, "denied", nil)).Times(2)
+			},
+			wantExceeded: []*ResourceQuotaExceededError{nil, nil},
+		},
+		{
+			name:      "usage errors are returned",
+			vpcs:      1,
+			addresses: 0,
+			expect: func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, m *mocks.MockEC2APIMockRecorder) {
+				getQuota(sq, "vpc", "L-F678F1CE", "VPCs per Region", 5)
+				m.DescribeVpcsPagesWithContext(context.TODO(), gomock.Any(), gomock.Any()).Return(awserr.New("InternalError", "error", nil))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			serviceQuotasMock := mock_servicequotasiface.NewMockServiceQuotasAPI(mockCtrl)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tt.expect(serviceQuotasMock.EXPECT(), ec2Mock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{Spec: infrav1.AWSClusterSpec{Region: "us-east-1"}},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.ServiceQuotasClient = serviceQuotasMock
+			s.EC2Client = ec2Mock
+
+			vpcErr := s.CheckVPCQuota(tt.vpcs)
+			if tt.wantErr {
+				g.Expect(vpcErr).To(HaveOccurred())
+				return
+			}
+			addressesErr := s.CheckElasticIPQuota(tt.addresses)
+
+			for i, err := range []error{vpcErr, addressesErr} {
+				if len(tt.wantExceeded) == 0 || tt.wantExceeded[i] == nil {
+					g.Expect(err).NotTo(HaveOccurred())
+					continue
+				}
+				exceededErr, ok := AsResourceQuotaExceeded(err)
+				g.Expect(ok).To(BeTrue())
+				g.Expect(exceededErr).To(Equal(tt.wantExceeded[i]))
+			}
+		})
+	}
+}
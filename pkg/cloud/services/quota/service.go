@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package quota provides a way to check the AWS service quotas of the account before launching instances.
+package quota
+
+import (
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+// Service holds a collection of interfaces.
+// The interfaces are broken down like this to group functions together.
+// One alternative is to have a large list of functions from the service quotas client.
+type Service struct {
+	scope               cloud.ClusterScoper
+	ServiceQuotasClient servicequotasiface.ServiceQuotasAPI
+	CloudWatchClient    cloudwatchiface.CloudWatchAPI
+	EC2Client           ec2iface.EC2API
+}
+
+// NewService returns a new service given the api clients.
+func NewService(quotaScope cloud.ClusterScoper) *Service {
+	return &Service{
+		scope:               quotaScope,
+		ServiceQuotasClient: scope.NewServiceQuotasClient(quotaScope, quotaScope, quotaScope, quotaScope.InfraCluster()),
+		CloudWatchClient:    scope.NewCloudWatchClient(quotaScope, quotaScope, quotaScope, quotaScope.InfraCluster()),
+		EC2Client:           scope.NewEC2Client(quotaScope, quotaScope, quotaScope, quotaScope.InfraCluster()),
+	}
+}
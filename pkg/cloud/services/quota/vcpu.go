@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+)
+
+const (
+	// ec2ServiceCode is the code of Amazon EC2 in Service Quotas.
+	ec2ServiceCode = "ec2"
+
+	// errCodeCloudWatchAccessDenied is the error code returned by CloudWatch when the caller is not allowed to call an operation.
+	errCodeCloudWatchAccessDenied = "AccessDenied"
+
+	// usagePeriod is the period over which the usage of a quota is read from its CloudWatch usage metric.
+	usagePeriod = 5 * time.Minute
+)
+
+// vcpuQuota holds the codes of the quotas limiting the vCPUs of the running On-Demand instances and of the
+// Spot Instance requests of some instance families.
+type vcpuQuota struct {
+	families []string
+	onDemand string
+	spot     string
+}
+
+// vcpuQuotas are the EC2 vCPU quotas, by instance family.
+var vcpuQuotas = []vcpuQuota{
+	{families: []string{"a", "c", "d", "h", "i", "m", "r", "t", "z"}, onDemand: "L-1216C47A", spot: "L-34B43A08"},
+	{families: []string{"g", "vt"}, onDemand: "L-DB2E81BA", spot: "L-3819A6DF"},
+	{families: []string{"p"}, onDemand: "L-417A185B", spot: "L-7212CCBC"},
+	{families: []string{"x"}, onDemand: "L-7295265B", spot: "L-E3A00192"},
+	{families: []string{"f"}, onDemand: "L-74FC7D96", spot: "L-88CF9481"},
+	{families: []string{"inf"}, onDemand: "L-1945791B", spot: "L-B5D1601B"},
+	{families: []string{"trn"}, onDemand: "L-2C3B7624", spot: "L-6B0D517C"},
+}
+
+// VCPUQuotaExceededError is returned when launching instances would exceed the vCPU quota of the account.
+type VCPUQuotaExceededError struct {
+	// QuotaCode is the code of the exceeded quota in Service Quotas.
+	QuotaCode string
+	// QuotaName is the name of the exceeded quota.
+	QuotaName string
+	// Required is the number of vCPUs of the instances to launch.
+	Required int64
+	// Available is the number of vCPUs left under the quota.
+	Available int64
+}
+
+func (e *VCPUQuotaExceededError) Error() string {
+	return fmt.Sprintf("launching the instances requires %d vCPUs but only %d are available under quota %s (%s), request a quota increase",
+		e.Required, e.Available, e.QuotaCode, e.QuotaName)
+}
+
+// AsVCPUQuotaExceeded returns the VCPUQuotaExceededError wrapped by err, if any.
+func AsVCPUQuotaExceeded(err error) (*VCPUQuotaExceededError, bool) {
+	var exceededErr *VCPUQuotaExceededError
+	if errors.As(err, &exceededErr) {
+		return exceededErr, true
+	}
+	return nil, false
+}
+
+// CheckVCPUQuota ensures that launching the given number of On-Demand or Spot instances of the given type
+// does not exceed the vCPU quota of the account in the region, based on the current usage of the quota.
+// It returns a VCPUQuotaExceededError otherwise. The quota is not checked for the instance families without
+// a vCPU quota, nor when the controller is not allowed to read the quota or its usage.
+func (s *Service) CheckVCPUQuota(instanceType string, spot bool, instances int32) error {
+	if instances <= 0 {
+		return nil
+	}
+	quotaCode := vcpuQuotaCode(instanceType, spot)
+	if quotaCode == "" {
+		s.scope.Debug("No vCPU quota applies to the instance type, skipping quota check", "instance-type", instanceType)
+		return nil
+	}
+
+	out, err := s.ServiceQuotasClient.GetServiceQuotaWithContext(context.TODO(), &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(ec2ServiceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		switch code, _ := awserrors.Code(err); code {
+		case servicequotas.ErrCodeAccessDeniedException, servicequotas.ErrCodeNoSuchResourceException:
+			s.scope.Debug("vCPU quota is not available, skipping quota check", "quota-code", quotaCode, "reason", code)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get quota %q", quotaCode)
+	}
+	if out.Quota == nil || out.Quota.Value == nil || out.Quota.UsageMetric == nil {
+		return nil
+	}
+
+	usage, ok, err := s.quotaUsage(out.Quota.UsageMetric)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the usage of quota %q", quotaCode)
+	}
+	if !ok {
+		s.scope.Debug("Usage of the vCPU quota is not available, skipping quota check", "quota-code", quotaCode)
+		return nil
+	}
+
+	vcpus, err := s.instanceTypeVCPUs(instanceType)
+	if err != nil {
+		return err
+	}
+
+	required := vcpus * int64(instances)
+	available := int64(math.Floor(aws.Float64Value(out.Quota.Value) - usage))
+	if required > available {
+		return &VCPUQuotaExceededError{
+			QuotaCode: quotaCode,
+			QuotaName: aws.StringValue(out.Quota.QuotaName),
+			Required:  required,
+			Available: max(available, 0),
+		}
+	}
+	return nil
+}
+
+// quotaUsage returns the latest value of the usage metric of a quota. It returns false if the controller is not
+// allowed to read the metric.
+func (s *Service) quotaUsage(metric *servicequotas.MetricInfo) (float64, bool, error) {
+	statistic := aws.StringValue(metric.MetricStatisticRecommendation)
+	if statistic == "" {
+		statistic = cloudwatch.StatisticMaximum
+	}
+	var dimensions []*cloudwatch.Dimension
+	for name, value := range metric.MetricDimensions {
+		dimensions = append(dimensions, &cloudwatch.Dimension{Name: aws.String(name), Value: value})
+	}
+	sort.Slice(dimensions, func(i, j int) bool {
+		return aws.StringValue(dimensions[i].Name) < aws.StringValue(dimensions[j].Name)
+	})
+
+	now := time.Now()
+	out, err := s.CloudWatchClient.GetMetricStatisticsWithContext(context.TODO(), &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  metric.MetricNamespace,
+		MetricName: metric.MetricName,
+		Dimensions: dimensions,
+		StartTime:  aws.Time(now.Add(-usagePeriod)),
+		EndTime:    aws.Time(now),
+		Period:     aws.Int64(int64(time.Minute.Seconds())),
+		Statistics: aws.StringSlice([]string{statistic}),
+	})
+	if err != nil {
+		if code, _ := awserrors.Code(err); code == errCodeCloudWatchAccessDenied {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	// No datapoints are reported when no instance counts against the quota.
+	var latest *cloudwatch.Datapoint
+	for _, datapoint := range out.Datapoints {
+		if latest == nil || aws.TimeValue(datapoint.Timestamp).After(aws.TimeValue(latest.Timestamp)) {
+			latest = datapoint
+		}
+	}
+	if latest == nil {
+		return 0, true, nil
+	}
+	switch statistic {
+	case cloudwatch.StatisticAverage:
+		return aws.Float64Value(latest.Average), true, nil
+	case cloudwatch.StatisticSum:
+		return aws.Float64Value(latest.Sum), true, nil
+	case cloudwatch.StatisticMinimum:
+		return aws.Float64Value(latest.Minimum), true, nil
+	default:
+		return aws.Float64Value(latest.Maximum), true, nil
+	}
+}
+
+// instanceTypeVCPUs returns the default number of vCPUs of an instance type.
+func (s *Service) instanceTypeVCPUs(instanceType string) (int64, error) {
+	out, err := s.EC2Client.DescribeInstanceTypesWithContext(context.TODO(), &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: []*string{aws.String(instanceType)},
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to describe instance type %q", instanceType)
+	}
+	if len(out.InstanceTypes) == 0 || out.InstanceTypes[0].VCpuInfo == nil {
+		return 0, errors.Errorf("instance type result empty for type %q", instanceType)
+	}
+	return aws.Int64Value(out.InstanceTypes[0].VCpuInfo.DefaultVCpus), nil
+}
+
+// vcpuQuotaCode returns the code of the vCPU quota applying to the On-Demand or Spot instances of an instance
+// type, or an empty string if no vCPU quota applies to its instance family.
+func vcpuQuotaCode(instanceType string, spot bool) string {
+	family := strings.ToLower(instanceType)
+	if i := strings.IndexFunc(family, func(r rune) bool { return !unicode.IsLetter(r) }); i >= 0 {
+		family = family[:i]
+	}
+	for _, quota := range vcpuQuotas {
+		if !slices.Contains(quota.families, family) {
+			continue
+		}
+		if spot {
+			return quota.spot
+		}
+		return quota.onDemand
+	}
+	return ""
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/cloudwatch/mock_cloudwatchiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/quota/mock_servicequotasiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestCheckVCPUQuota(t *testing.T) {
+	usageMetric := &servicequotas.MetricInfo{
+		MetricNamespace: aws.String("AWS/Usage"),
+		MetricName:      aws.String("ResourceCount"),
+		MetricDimensions: map[string]*string{
+			"Service":  aws.String("EC2"),
+			"Type":     aws.String("Resource"),
+			"Resource": aws.String("vCPU"),
+			"Class":    aws.String("Standard/OnDemand"),
+		},
+		MetricStatisticRecommendation: aws.String("Maximum"),
+	}
+	getQuota := func(m *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, quotaCode string, value float64) {
+		m.GetServiceQuotaWithContext(context.TODO(), gomock.Eq(&servicequotas.GetServiceQuotaInput{
+			ServiceCode: aws.String("ec2"),
+			QuotaCode:   aws.String(quotaCode),
+		})).Return(&servicequotas.GetServiceQuotaOutput{
+			Quota: &servicequotas.ServiceQuota{
+				QuotaCode:   aws.String(quotaCode),
+				QuotaName:   aws.String("Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances"),
+				Value:       aws.Float64(value),
+				UsageMetric: usageMetric,
+			},
+		}, nil)
+	}
+	getUsage := func(m *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, usage ...float64) {
+		var datapoints []*cloudwatch.Datapoint
+		for i, value := range usage {
+			datapoints = append(datapoints, &cloudwatch.Datapoint{
+				Timestamp: aws.Time(time.Now().Add(time.Duration(i-len(usage)) * time.Minute)),
+				Maximum:   aws.Float64(value),
+			})
+		}
+		m.GetMetricStatisticsWithContext(context.TODO(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, input *cloudwatch.GetMetricStatisticsInput, _ ...interface{}) (*cloudwatch.GetMetricStatisticsOutput, error) {
+				if aws.StringValue(input.Namespace) != "AWS/Usage" || len(input.Dimensions) != 4 {
+					t.Fatalf("unexpected usage metric: %v", input)
+				}
+				return &cloudwatch.GetMetricStatisticsOutput{Datapoints: datapoints}, nil
+			})
+	}
+	describeInstanceType := func(m *mocks.MockEC2APIMockRecorder, instanceType string, vcpus int64) {
+		m.DescribeInstanceTypesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+			InstanceTypes: []*string{aws.String(instanceType)},
+		})).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []*ec2.InstanceTypeInfo{
+				{InstanceType: aws.String(instanceType), VCpuInfo: &ec2.VCpuInfo{DefaultVCpus: aws.Int64(vcpus)}},
+			},
+		}, nil)
+	}
+
+	tests := []struct {
+		name         string
+		instanceType string
+		spot         bool
+		instances    int32
+		expect       func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, cw *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, m *mocks.MockEC2APIMockRecorder)
+		wantExceeded *VCPUQuotaExceededError
+		wantErr      bool
+	}{
+		{
+			name:         "enough headroom",
+			instanceType: "m5.xlarge",
+			instances:    2,
+			expect: func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, cw *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, m *mocks.MockEC2APIMockRecorder) {
+				getQuota(sq, "L-1216C47A", 64)
+				getUsage(cw, 48, 56)
+				describeInstanceType(m, "m5.xlarge", 4)
+			},
+		},
+		{
+			name:         "quota exceeded",
+			instanceType: "m5.xlarge",
+			instances:    3,
+			expect: func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, cw *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, m *mocks.MockEC2APIMockRecorder) {
+				getQuota(sq, "L-1216C47A", 64)
+				getUsage(cw, 60, 56)
+				describeInstanceType(m, "m5.xlarge", 4)
+			},
+			wantExceeded: &VCPUQuotaExceededError{
+				QuotaCode: "L-1216C47A",
+				QuotaName: "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances",
+				Required:  12,
+				Available: 8,
+			},
+		},
+		{
+			name:         "no usage reported",
+			instanceType: "p4d.24xlarge",
+			spot:         true,
+			instances:    1,
+			expect: func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, cw *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, m *mocks.MockEC2APIMockRecorder) {
+				getQuota(sq, "L-7212CCBC", 96)
+				getUsage(cw)
+				describeInstanceType(m, "p4d.24xlarge", 96)
+			},
+		},
+		{
+			name:         "instance family without vCPU quota",
+			instanceType: "mac2.metal",
+			instances:    1,
+			expect: func(_ *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, _ *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, _ *mocks.MockEC2APIMockRecorder) {
+			},
+		},
+		{
+			name:         "not allowed to get the quota",
+			instanceType: "m5.xlarge",
+			instances:    1,
+			expect: func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, _ *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, _ *mocks.MockEC2APIMockRecorder) {
+				sq.GetServiceQuotaWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New(servicequotas.ErrCodeAccessDeniedException, "access denied", nil))
+			},
+		},
+		{
+			name:         "not allowed to get the usage of the quota",
+			instanceType: "m5.xlarge",
+			instances:    1,
+			expect: func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, cw *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, _ *mocks.MockEC2APIMockRecorder) {
+				getQuota(sq, "L-1216C47A", 64)
+				cw.GetMetricStatisticsWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New(errCodeCloudWatchAccessDenied, "access denied", nil))
+			},
+		},
+		{
+			name:         "get quota fails",
+			instanceType: "m5.xlarge",
+			instances:    1,
+			expect: func(sq *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, _ *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, _ *mocks.MockEC2APIMockRecorder) {
+				sq.GetServiceQuotaWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New("ServiceException", "internal error", nil))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			serviceQuotasMock := mock_servicequotasiface.NewMockServiceQuotasAPI(mockCtrl)
+			cloudWatchMock := mock_cloudwatchiface.NewMockCloudWatchAPI(mockCtrl)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tt.expect(serviceQuotasMock.EXPECT(), cloudWatchMock.EXPECT(), ec2Mock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{Spec: infrav1.AWSClusterSpec{Region: "us-east-1"}},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.ServiceQuotasClient = serviceQuotasMock
+			s.CloudWatchClient = cloudWatchMock
+			s.EC2Client = ec2Mock
+
+			err = s.CheckVCPUQuota(tt.instanceType, tt.spot, tt.instances)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			if tt.wantExceeded != nil {
+				exceededErr, ok := AsVCPUQuotaExceeded(err)
+				g.Expect(ok).To(BeTrue())
+				g.Expect(exceededErr).To(Equal(tt.wantExceeded))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestVCPUQuotaCode(t *testing.T) {
+	tests := []struct {
+		instanceType string
+		spot         bool
+		want         string
+	}{
+		{instanceType: "m5.large", want: "L-1216C47A"},
+		{instanceType: "c7gn.16xlarge", spot: true, want: "L-34B43A08"},
+		{instanceType: "g5.xlarge", want: "L-DB2E81BA"},
+		{instanceType: "vt1.3xlarge", want: "L-DB2E81BA"},
+		{instanceType: "p5.48xlarge", want: "L-417A185B"},
+		{instanceType: "x2idn.16xlarge", want: "L-7295265B"},
+		{instanceType: "inf2.xlarge", want: "L-1945791B"},
+		{instanceType: "trn1.32xlarge", spot: true, want: "L-6B0D517C"},
+		{instanceType: "mac2.metal", want: ""},
+		{instanceType: "hpc7g.16xlarge", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.instanceType, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(vcpuQuotaCode(tt.instanceType, tt.spot)).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+const (
+	// recordTTL is the TTL of the private DNS record, kept short so that a replaced load balancer is picked up quickly.
+	recordTTL = 60
+
+	hostedZoneIDPrefix = "/hostedzone/"
+)
+
+// ReconcilePrivateDNS ensures that the private DNS record of the API server endpoint points to the control plane
+// load balancer, creating the private hosted zone of its parent domain if the VPC has none.
+func (s *Service) ReconcilePrivateDNS() error {
+	privateDNS := s.scope.PrivateDNS()
+	if privateDNS == nil {
+		return nil
+	}
+
+	target := s.scope.Network().APIServerELB.DNSName
+	if target == "" {
+		return errors.New("control plane load balancer has no DNS name yet")
+	}
+
+	zoneID, err := s.reconcileHostedZone(privateDNS)
+	if err != nil {
+		return err
+	}
+
+	recordSet, err := s.getRecordSet(zoneID, privateDNS.Name)
+	if err != nil {
+		return err
+	}
+	if recordSet != nil && len(recordSet.ResourceRecords) == 1 && aws.StringValue(recordSet.ResourceRecords[0].Value) == target {
+		return nil
+	}
+
+	if err := s.changeRecordSet(zoneID, route53.ChangeActionUpsert, &route53.ResourceRecordSet{
+		Name:            aws.String(privateDNS.Name),
+		Type:            aws.String(route53.RRTypeCname),
+		TTL:             aws.Int64(recordTTL),
+		ResourceRecords: []*route53.ResourceRecord{{Value: aws.String(target)}},
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedUpsertPrivateDNSRecord", "Failed to point private DNS record %q to %q: %v", privateDNS.Name, target, err)
+		return errors.Wrapf(err, "failed to upsert private DNS record %q", privateDNS.Name)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulUpsertPrivateDNSRecord", "Pointed private DNS record %q to %q", privateDNS.Name, target)
+	s.scope.Info("Pointed private DNS record to the control plane load balancer", "name", privateDNS.Name, "target", target, "hosted-zone-id", zoneID)
+	return nil
+}
+
+// DeletePrivateDNS deletes the private DNS record of the API server endpoint, and the private hosted zone if it was
+// created with the cluster.
+func (s *Service) DeletePrivateDNS() error {
+	privateDNS := s.scope.PrivateDNS()
+	if privateDNS == nil {
+		return nil
+	}
+
+	zoneID := privateDNS.HostedZoneID
+	if zoneID == "" {
+		zoneID = s.scope.PrivateHostedZoneID()
+	}
+	if zoneID == "" && s.scope.VPC().ID != "" {
+		found, owned, err := s.findHostedZone(parentDomain(privateDNS.Name))
+		if err != nil {
+			return err
+		}
+		if owned {
+			s.scope.SetPrivateHostedZoneID(found)
+		}
+		zoneID = found
+	}
+	if zoneID == "" {
+		s.scope.Trace("Private hosted zone does not exist, skipping deletion of the private DNS record")
+		return nil
+	}
+
+	recordSet, err := s.getRecordSet(zoneID, privateDNS.Name)
+	if err != nil && !isNoSuchHostedZone(err) {
+		return err
+	}
+	if recordSet != nil {
+		if err := s.changeRecordSet(zoneID, route53.ChangeActionDelete, recordSet); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeletePrivateDNSRecord", "Failed to delete private DNS record %q: %v", privateDNS.Name, err)
+			return errors.Wrapf(err, "failed to delete private DNS record %q", privateDNS.Name)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeletePrivateDNSRecord", "Deleted private DNS record %q", privateDNS.Name)
+	}
+
+	if zoneID != s.scope.PrivateHostedZoneID() {
+		return nil
+	}
+	if _, err := s.Route53Client.DeleteHostedZoneWithContext(context.TODO(), &route53.DeleteHostedZoneInput{
+		Id: aws.String(zoneID),
+	}); err != nil && !isNoSuchHostedZone(err) {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeletePrivateHostedZone", "Failed to delete private hosted zone %q: %v", zoneID, err)
+		return errors.Wrapf(err, "failed to delete private hosted zone %q", zoneID)
+	}
+	s.scope.SetPrivateHostedZoneID("")
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeletePrivateHostedZone", "Deleted private hosted zone %q", zoneID)
+	s.scope.Info("Deleted private hosted zone", "hosted-zone-id", zoneID)
+	return nil
+}
+
+// reconcileHostedZone returns the ID of the private hosted zone of the record: the configured one, the one created
+// with the cluster, or the one of its parent domain associated with the VPC, which is created if there is none.
+func (s *Service) reconcileHostedZone(privateDNS *infrav1.PrivateDNS) (string, error) {
+	if privateDNS.HostedZoneID != "" {
+		return privateDNS.HostedZoneID, nil
+	}
+	if zoneID := s.scope.PrivateHostedZoneID(); zoneID != "" {
+		return zoneID, nil
+	}
+
+	zoneName := parentDomain(privateDNS.Name)
+	zoneID, owned, err := s.findHostedZone(zoneName)
+	if err != nil {
+		return "", err
+	}
+	if zoneID != "" {
+		// A zone created with the cluster is found again if its ID could not be recorded in the status.
+		if owned {
+			s.scope.SetPrivateHostedZoneID(zoneID)
+		}
+		return zoneID, nil
+	}
+
+	out, err := s.Route53Client.CreateHostedZoneWithContext(context.TODO(), &route53.CreateHostedZoneInput{
+		Name:            aws.String(zoneName),
+		CallerReference: aws.String(fmt.Sprintf("%s-%d", s.scope.Name(), time.Now().UnixNano())),
+		VPC: &route53.VPC{
+			VPCId:     aws.String(s.scope.VPC().ID),
+			VPCRegion: aws.String(s.scope.Region()),
+		},
+		HostedZoneConfig: &route53.HostedZoneConfig{
+			PrivateZone: aws.Bool(true),
+			Comment:     aws.String(fmt.Sprintf("Private DNS of the API server endpoint of cluster %s", s.scope.Name())),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreatePrivateHostedZone", "Failed to create private hosted zone %q: %v", zoneName, err)
+		return "", errors.Wrapf(err, "failed to create private hosted zone %q", zoneName)
+	}
+	zoneID = strings.TrimPrefix(aws.StringValue(out.HostedZone.Id), hostedZoneIDPrefix)
+	s.scope.SetPrivateHostedZoneID(zoneID)
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreatePrivateHostedZone", "Created private hosted zone %q", zoneID)
+	s.scope.Info("Created private hosted zone", "name", zoneName, "hosted-zone-id", zoneID)
+
+	if _, err := s.Route53Client.ChangeTagsForResourceWithContext(context.TODO(), &route53.ChangeTagsForResourceInput{
+		ResourceType: aws.String(route53.TagResourceTypeHostedzone),
+		ResourceId:   aws.String(zoneID),
+		AddTags:      route53Tags(infrav1.Build(s.getHostedZoneTagParams())),
+	}); err != nil {
+		return "", errors.Wrapf(err, "failed to tag private hosted zone %q", zoneID)
+	}
+
+	return zoneID, nil
+}
+
+// findHostedZone returns the ID of the private hosted zone with the given name associated with the VPC, if any, and
+// whether it is owned by the cluster.
+func (s *Service) findHostedZone(zoneName string) (string, bool, error) {
+	input := &route53.ListHostedZonesByVPCInput{
+		VPCId:     aws.String(s.scope.VPC().ID),
+		VPCRegion: aws.String(s.scope.Region()),
+	}
+	for {
+		out, err := s.Route53Client.ListHostedZonesByVPCWithContext(context.TODO(), input)
+		if err != nil {
+			return "", false, errors.Wrapf(err, "failed to list the private hosted zones of VPC %q", s.scope.VPC().ID)
+		}
+		for _, zone := range out.HostedZoneSummaries {
+			if strings.TrimSuffix(aws.StringValue(zone.Name), ".") != zoneName {
+				continue
+			}
+			zoneID := aws.StringValue(zone.HostedZoneId)
+			owned, err := s.isOwned(zoneID)
+			if err != nil {
+				return "", false, err
+			}
+			return zoneID, owned, nil
+		}
+		if aws.StringValue(out.NextToken) == "" {
+			return "", false, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+// isOwned returns whether the hosted zone was created with the cluster.
+func (s *Service) isOwned(zoneID string) (bool, error) {
+	out, err := s.Route53Client.ListTagsForResourceWithContext(context.TODO(), &route53.ListTagsForResourceInput{
+		ResourceType: aws.String(route53.TagResourceTypeHostedzone),
+		ResourceId:   aws.String(zoneID),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list the tags of private hosted zone %q", zoneID)
+	}
+	if out.ResourceTagSet == nil {
+		return false, nil
+	}
+	for _, tag := range out.ResourceTagSet.Tags {
+		if aws.StringValue(tag.Key) == infrav1.ClusterTagKey(s.scope.Name()) {
+			return aws.StringValue(tag.Value) == string(infrav1.ResourceLifecycleOwned), nil
+		}
+	}
+	return false, nil
+}
+
+// getRecordSet returns the CNAME record set with the given name in the hosted zone, if any.
+func (s *Service) getRecordSet(zoneID, name string) (*route53.ResourceRecordSet, error) {
+	out, err := s.Route53Client.ListResourceRecordSetsWithContext(context.TODO(), &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(zoneID),
+		StartRecordName: aws.String(name),
+		StartRecordType: aws.String(route53.RRTypeCname),
+		MaxItems:        aws.String("1"),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list the records of private hosted zone %q", zoneID)
+	}
+	for _, recordSet := range out.ResourceRecordSets {
+		if strings.TrimSuffix(aws.StringValue(recordSet.Name), ".") == name && aws.StringValue(recordSet.Type) == route53.RRTypeCname {
+			return recordSet, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *Service) changeRecordSet(zoneID, action string, recordSet *route53.ResourceRecordSet) error {
+	_, err := s.Route53Client.ChangeResourceRecordSetsWithContext(context.TODO(), &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{{Action: aws.String(action), ResourceRecordSet: recordSet}},
+		},
+	})
+	return err
+}
+
+func (s *Service) getHostedZoneTagParams() infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-private-dns", s.scope.Name())),
+		Role:        aws.String(infrav1.APIServerRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+func route53Tags(tags infrav1.Tags) []*route53.Tag {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	res := make([]*route53.Tag, 0, len(tags))
+	for _, key := range keys {
+		res = append(res, &route53.Tag{Key: aws.String(key), Value: aws.String(tags[key])})
+	}
+	return res
+}
+
+// parentDomain returns the domain of the private hosted zone of the given name, which is its parent domain.
+func parentDomain(name string) string {
+	_, parent, _ := strings.Cut(name, ".")
+	return parent
+}
+
+func isNoSuchHostedZone(err error) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && aerr.Code() == route53.ErrCodeNoSuchHostedZone
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/route53/mock_route53iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	recordName = "api.test-cluster.example.internal"
+	zoneName   = "test-cluster.example.internal"
+	lbDNSName  = "test-cluster-apiserver.elb.us-east-1.amazonaws.com"
+)
+
+func TestReconcilePrivateDNS(t *testing.T) {
+	listRecords := func(m *mock_route53iface.MockRoute53APIMockRecorder, zoneID string, recordSets ...*route53.ResourceRecordSet) {
+		m.ListResourceRecordSetsWithContext(context.TODO(), gomock.Eq(&route53.ListResourceRecordSetsInput{
+			HostedZoneId:    aws.String(zoneID),
+			StartRecordName: aws.String(recordName),
+			StartRecordType: aws.String(route53.RRTypeCname),
+			MaxItems:        aws.String("1"),
+		})).Return(&route53.ListResourceRecordSetsOutput{ResourceRecordSets: recordSets}, nil)
+	}
+	upsertRecord := func(m *mock_route53iface.MockRoute53APIMockRecorder, zoneID string) {
+		m.ChangeResourceRecordSetsWithContext(context.TODO(), gomock.Eq(&route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(zoneID),
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: []*route53.Change{{
+					Action: aws.String(route53.ChangeActionUpsert),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name:            aws.String(recordName),
+						Type:            aws.String(route53.RRTypeCname),
+						TTL:             aws.Int64(recordTTL),
+						ResourceRecords: []*route53.ResourceRecord{{Value: aws.String(lbDNSName)}},
+					},
+				}},
+			},
+		})).Return(&route53.ChangeResourceRecordSetsOutput{}, nil)
+	}
+	listZones := func(m *mock_route53iface.MockRoute53APIMockRecorder, summaries ...*route53.HostedZoneSummary) {
+		m.ListHostedZonesByVPCWithContext(context.TODO(), gomock.Eq(&route53.ListHostedZonesByVPCInput{
+			VPCId:     aws.String("vpc-1"),
+			VPCRegion: aws.String("us-east-1"),
+		})).Return(&route53.ListHostedZonesByVPCOutput{HostedZoneSummaries: summaries}, nil)
+	}
+
+	tests := []struct {
+		name       string
+		privateDNS *infrav1.PrivateDNS
+		zoneID     string
+		expect     func(m *mock_route53iface.MockRoute53APIMockRecorder)
+		wantZoneID string
+	}{
+		{
+			name:       "creates the record in the configured hosted zone",
+			privateDNS: &infrav1.PrivateDNS{Name: recordName, HostedZoneID: "Z-SHARED"},
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				listRecords(m, "Z-SHARED")
+				upsertRecord(m, "Z-SHARED")
+			},
+		},
+		{
+			name:       "does not change an up to date record",
+			privateDNS: &infrav1.PrivateDNS{Name: recordName},
+			zoneID:     "Z-OWNED",
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				listRecords(m, "Z-OWNED", &route53.ResourceRecordSet{
+					Name:            aws.String(recordName + "."),
+					Type:            aws.String(route53.RRTypeCname),
+					ResourceRecords: []*route53.ResourceRecord{{Value: aws.String(lbDNSName)}},
+				})
+			},
+			wantZoneID: "Z-OWNED",
+		},
+		{
+			name:       "joins the existing private hosted zone of the VPC",
+			privateDNS: &infrav1.PrivateDNS{Name: recordName},
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				listZones(m,
+					&route53.HostedZoneSummary{HostedZoneId: aws.String("Z-OTHER"), Name: aws.String("example.internal.")},
+					&route53.HostedZoneSummary{HostedZoneId: aws.String("Z-EXISTING"), Name: aws.String(zoneName + ".")},
+				)
+				m.ListTagsForResourceWithContext(context.TODO(), gomock.Any()).Return(&route53.ListTagsForResourceOutput{
+					ResourceTagSet: &route53.ResourceTagSet{},
+				}, nil)
+				listRecords(m, "Z-EXISTING")
+				upsertRecord(m, "Z-EXISTING")
+			},
+		},
+		{
+			name:       "creates the private hosted zone if the VPC has none",
+			privateDNS: &infrav1.PrivateDNS{Name: recordName},
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				listZones(m)
+				m.CreateHostedZoneWithContext(context.TODO(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, input *route53.CreateHostedZoneInput, _ ...interface{}) (*route53.CreateHostedZoneOutput, error) {
+						if aws.StringValue(input.Name) != zoneName || aws.StringValue(input.VPC.VPCId) != "vpc-1" || !aws.BoolValue(input.HostedZoneConfig.PrivateZone) {
+							t.Fatalf("unexpected hosted zone: %v", input)
+						}
+						return &route53.CreateHostedZoneOutput{HostedZone: &route53.HostedZone{Id: aws.String("/hostedzone/Z-NEW")}}, nil
+					})
+				m.ChangeTagsForResourceWithContext(context.TODO(), gomock.Eq(&route53.ChangeTagsForResourceInput{
+					ResourceType: aws.String(route53.TagResourceTypeHostedzone),
+					ResourceId:   aws.String("Z-NEW"),
+					AddTags: []*route53.Tag{
+						{Key: aws.String("Name"), Value: aws.String("test-cluster-private-dns")},
+						{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+						{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/role"), Value: aws.String("apiserver")},
+					},
+				})).Return(&route53.ChangeTagsForResourceOutput{}, nil)
+				listRecords(m, "Z-NEW")
+				upsertRecord(m, "Z-NEW")
+			},
+			wantZoneID: "Z-NEW",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			route53Mock := mock_route53iface.NewMockRoute53API(mockCtrl)
+			tt.expect(route53Mock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						Region:      "us-east-1",
+						NetworkSpec: infrav1.NetworkSpec{VPC: infrav1.VPCSpec{ID: "vpc-1"}},
+						PrivateDNS:  tt.privateDNS,
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network:             infrav1.NetworkStatus{APIServerELB: infrav1.LoadBalancer{DNSName: lbDNSName}},
+						PrivateHostedZoneID: tt.zoneID,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.Route53Client = route53Mock
+
+			g.Expect(s.ReconcilePrivateDNS()).To(Succeed())
+			g.Expect(clusterScope.PrivateHostedZoneID()).To(Equal(tt.wantZoneID))
+		})
+	}
+}
+
+func TestDeletePrivateDNS(t *testing.T) {
+	recordSet := &route53.ResourceRecordSet{
+		Name:            aws.String(recordName + "."),
+		Type:            aws.String(route53.RRTypeCname),
+		TTL:             aws.Int64(recordTTL),
+		ResourceRecords: []*route53.ResourceRecord{{Value: aws.String(lbDNSName)}},
+	}
+	deleteRecord := func(m *mock_route53iface.MockRoute53APIMockRecorder, zoneID string) {
+		m.ListResourceRecordSetsWithContext(context.TODO(), gomock.Any()).
+			Return(&route53.ListResourceRecordSetsOutput{ResourceRecordSets: []*route53.ResourceRecordSet{recordSet}}, nil)
+		m.ChangeResourceRecordSetsWithContext(context.TODO(), gomock.Eq(&route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(zoneID),
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: []*route53.Change{{Action: aws.String(route53.ChangeActionDelete), ResourceRecordSet: recordSet}},
+			},
+		})).Return(&route53.ChangeResourceRecordSetsOutput{}, nil)
+	}
+
+	tests := []struct {
+		name       string
+		privateDNS *infrav1.PrivateDNS
+		zoneID     string
+		expect     func(m *mock_route53iface.MockRoute53APIMockRecorder)
+	}{
+		{
+			name:       "deletes the record and the hosted zone created with the cluster",
+			privateDNS: &infrav1.PrivateDNS{Name: recordName},
+			zoneID:     "Z-OWNED",
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				deleteRecord(m, "Z-OWNED")
+				m.DeleteHostedZoneWithContext(context.TODO(), gomock.Eq(&route53.DeleteHostedZoneInput{Id: aws.String("Z-OWNED")})).
+					Return(&route53.DeleteHostedZoneOutput{}, nil)
+			},
+		},
+		{
+			name:       "only deletes the record of a configured hosted zone",
+			privateDNS: &infrav1.PrivateDNS{Name: recordName, HostedZoneID: "Z-SHARED"},
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				deleteRecord(m, "Z-SHARED")
+			},
+		},
+		{
+			name:       "does nothing if the record is already deleted",
+			privateDNS: &infrav1.PrivateDNS{Name: recordName, HostedZoneID: "Z-SHARED"},
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSetsWithContext(context.TODO(), gomock.Any()).Return(&route53.ListResourceRecordSetsOutput{}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			route53Mock := mock_route53iface.NewMockRoute53API(mockCtrl)
+			tt.expect(route53Mock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						Region:      "us-east-1",
+						NetworkSpec: infrav1.NetworkSpec{VPC: infrav1.VPCSpec{ID: "vpc-1"}},
+						PrivateDNS:  tt.privateDNS,
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network:             infrav1.NetworkStatus{APIServerELB: infrav1.LoadBalancer{DNSName: lbDNSName}},
+						PrivateHostedZoneID: tt.zoneID,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.Route53Client = route53Mock
+
+			g.Expect(s.DeletePrivateDNS()).To(Succeed())
+			g.Expect(clusterScope.PrivateHostedZoneID()).To(BeEmpty())
+		})
+	}
+}
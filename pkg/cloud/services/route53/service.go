@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package route53 provides a way to interact with Amazon Route 53.
+package route53
+
+import (
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+// Service holds a collection of interfaces.
+// The interfaces are broken down like this to group functions together.
+// One alternative is to have a large list of functions from the route53 client.
+type Service struct {
+	scope         scope.PrivateDNSScope
+	Route53Client route53iface.Route53API
+}
+
+// NewService returns a new service given the api clients.
+func NewService(privateDNSScope scope.PrivateDNSScope) *Service {
+	return &Service{
+		scope:         privateDNSScope,
+		Route53Client: scope.NewRoute53Client(privateDNSScope, privateDNSScope, privateDNSScope, privateDNSScope.InfraCluster()),
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"net/url"
 	"path"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
@@ -60,7 +61,9 @@ type Service struct {
 type S3API interface {
 	CreateBucket(ctx context.Context, params *s3.CreateBucketInput, optFns ...func(*s3.Options)) (*s3.CreateBucketOutput, error)
 	DeleteBucket(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error)
+	DeleteBucketPolicy(ctx context.Context, params *s3.DeleteBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketPolicyOutput, error)
 	DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	GetBucketPolicy(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)
 	HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
 	ListObjectsV2(ctx context.Context, input *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)
 	PutBucketPolicy(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error)
@@ -93,6 +96,14 @@ func (s *Service) ReconcileBucket(ctx context.Context) error {
 
 	bucketName := s.bucketName()
 
+	// A shared bucket is created and configured by its owner, only the access to the objects of the cluster is managed.
+	if s.bucketShared() {
+		if err := s.ensureBucketPolicy(ctx, bucketName); err != nil {
+			return errors.Wrap(err, "ensuring bucket policy")
+		}
+		return nil
+	}
+
 	if err := s.createBucketIfNotExist(ctx, bucketName); err != nil {
 		return errors.Wrap(err, "ensuring bucket exists")
 	}
@@ -122,6 +133,10 @@ func (s *Service) DeleteBucket(ctx context.Context) error {
 
 	log := s.scope.WithValues("name", bucketName)
 
+	if s.bucketShared() {
+		return s.deleteSharedBucketObjects(ctx, bucketName)
+	}
+
 	log.Info("Deleting S3 Bucket")
 
 	if feature.Gates.Enabled(feature.MachinePool) {
@@ -207,6 +222,7 @@ func (s *Service) Create(ctx context.Context, m *scope.MachineScope, data []byte
 		Bucket:               aws.String(bucket),
 		Key:                  aws.String(key),
 		ServerSideEncryption: types.ServerSideEncryptionAwsKms,
+		SSEKMSKeyId:          s.kmsKeyID(),
 	}); err != nil {
 		return "", errors.Wrap(err, "putting object")
 	}
@@ -258,6 +274,7 @@ func (s *Service) CreateForMachinePool(ctx context.Context, scope scope.LaunchTe
 		Bucket:               aws.String(bucket),
 		Key:                  aws.String(key),
 		ServerSideEncryption: types.ServerSideEncryptionAwsKms,
+		SSEKMSKeyId:          s.kmsKeyID(),
 	}); err != nil {
 		return "", errors.Wrap(err, "putting object for machine pool")
 	}
@@ -417,8 +434,78 @@ func (s *Service) createBucketIfNotExist(ctx context.Context, bucketName string)
 	}
 }
 
+// deleteSharedBucketObjects deletes the objects of the cluster from a shared bucket, and removes the statements
+// granting access to them from the policy of the bucket. The bucket itself is kept for the other clusters.
+func (s *Service) deleteSharedBucketObjects(ctx context.Context, bucketName string) error {
+	prefix := s.objectPrefix() + "/"
+	log := s.scope.WithValues("name", bucketName, "prefix", prefix)
+
+	for {
+		log.Info("Listing S3 objects of the cluster in shared bucket")
+
+		out, err := s.S3Client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+			Bucket: aws.String(bucketName),
+			Prefix: aws.String(prefix),
+		})
+		if err != nil {
+			smithyErr := awserrors.ParseSmithyError(err)
+			switch smithyErr.ErrorCode() {
+			case (&types.NoSuchBucket{}).ErrorCode():
+				log.Info("Shared bucket does not exist")
+				return nil
+			default:
+				return errors.Wrap(err, "listing S3 bucket")
+			}
+		}
+
+		// Stop on last page of results
+		if len(out.Contents) == 0 {
+			break
+		}
+
+		log.Info("Deleting S3 objects of the cluster in shared bucket", "count", len(out.Contents))
+		for _, obj := range out.Contents {
+			if _, err := s.S3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+				Bucket: aws.String(bucketName),
+				Key:    obj.Key,
+			}); err != nil {
+				return errors.Wrap(err, "deleting S3 object")
+			}
+		}
+	}
+
+	statements, err := s.otherBucketPolicyStatements(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+
+	if len(statements) == 0 {
+		if _, err := s.S3Client.DeleteBucketPolicy(ctx, &s3.DeleteBucketPolicyInput{
+			Bucket: aws.String(bucketName),
+		}); err != nil {
+			return errors.Wrap(err, "deleting S3 bucket policy")
+		}
+		return nil
+	}
+
+	policy, err := json.Marshal(rawPolicyDocument{Version: "2012-10-17", Statement: statements})
+	if err != nil {
+		return errors.Wrap(err, "building bucket policy")
+	}
+	if _, err := s.S3Client.PutBucketPolicy(ctx, &s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucketName),
+		Policy: aws.String(string(policy)),
+	}); err != nil {
+		return errors.Wrap(err, "updating S3 bucket policy")
+	}
+
+	log.Info("Removed the statements of the cluster from the shared bucket policy")
+
+	return nil
+}
+
 func (s *Service) ensureBucketPolicy(ctx context.Context, bucketName string) error {
-	bucketPolicy, err := s.bucketPolicy(bucketName)
+	bucketPolicy, err := s.bucketPolicy(ctx, bucketName)
 	if err != nil {
 		return errors.Wrap(err, "generating Bucket policy")
 	}
@@ -511,10 +598,98 @@ func (s *Service) tagBucket(ctx context.Context, bucketName string) error {
 	return nil
 }
 
-func (s *Service) bucketPolicy(bucketName string) (string, error) {
+// rawPolicyDocument is a policy document whose statements are kept as is, so that the statements of a shared bucket
+// policy which are not managed by the cluster are written back untouched.
+type rawPolicyDocument struct {
+	Version   string
+	Statement []json.RawMessage
+}
+
+func (s *Service) bucketPolicy(ctx context.Context, bucketName string) (string, error) {
+	statements, err := s.bucketPolicyStatements(bucketName)
+	if err != nil {
+		return "", err
+	}
+
+	if !s.bucketShared() {
+		policyRaw, err := json.Marshal(iam.PolicyDocument{
+			Version:   "2012-10-17",
+			Statement: statements,
+		})
+		if err != nil {
+			return "", errors.Wrap(err, "building bucket policy")
+		}
+		return string(policyRaw), nil
+	}
+
+	// The statements of the other clusters sharing the bucket are kept, and the ones of the cluster replaced.
+	policy := rawPolicyDocument{Version: "2012-10-17"}
+	policy.Statement, err = s.otherBucketPolicyStatements(ctx, bucketName)
+	if err != nil {
+		return "", err
+	}
+	for _, statement := range statements {
+		raw, err := json.Marshal(statement)
+		if err != nil {
+			return "", errors.Wrap(err, "building bucket policy")
+		}
+		policy.Statement = append(policy.Statement, raw)
+	}
+
+	policyRaw, err := json.Marshal(policy)
+	if err != nil {
+		return "", errors.Wrap(err, "building bucket policy")
+	}
+	return string(policyRaw), nil
+}
+
+// otherBucketPolicyStatements returns the statements of the policy of a shared bucket which do not grant access to
+// the objects of the cluster, i.e. the statements of the other clusters and of the owner of the bucket.
+func (s *Service) otherBucketPolicyStatements(ctx context.Context, bucketName string) ([]json.RawMessage, error) {
+	out, err := s.S3Client.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		if awserrors.ParseSmithyError(err).ErrorCode() == "NoSuchBucketPolicy" {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "getting S3 bucket policy")
+	}
+
+	policy := rawPolicyDocument{}
+	if err := json.Unmarshal([]byte(aws.StringValue(out.Policy)), &policy); err != nil {
+		return nil, errors.Wrap(err, "parsing S3 bucket policy")
+	}
+
+	objectsARN := s.objectARN(bucketName, "")
+	statements := make([]json.RawMessage, 0, len(policy.Statement))
+	for _, raw := range policy.Statement {
+		statement := iam.StatementEntry{}
+		if err := json.Unmarshal(raw, &statement); err == nil && statementOnlyGrantsAccessTo(statement, objectsARN+"/") {
+			continue
+		}
+		statements = append(statements, raw)
+	}
+	return statements, nil
+}
+
+// statementOnlyGrantsAccessTo returns true if all the resources of the statement are under the given prefix.
+func statementOnlyGrantsAccessTo(statement iam.StatementEntry, prefix string) bool {
+	if len(statement.Resource) == 0 {
+		return false
+	}
+	for _, resource := range statement.Resource {
+		if !strings.HasPrefix(resource, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Service) bucketPolicyStatements(bucketName string) ([]iam.StatementEntry, error) {
 	accountID, err := s.STSClient.GetCallerIdentity(&sts.GetCallerIdentityInput{})
 	if err != nil {
-		return "", errors.Wrap(err, "getting account ID")
+		return nil, errors.Wrap(err, "getting account ID")
 	}
 
 	bucket := s.scope.Bucket()
@@ -528,7 +703,7 @@ func (s *Service) bucketPolicy(bucketName string) (string, error) {
 				iam.PrincipalAWS: []string{"*"},
 			},
 			Action:   []string{"s3:*"},
-			Resource: []string{fmt.Sprintf("arn:%s:s3:::%s/*", partition, bucketName)},
+			Resource: []string{s.objectARN(bucketName, "*")},
 			Condition: iam.Conditions{
 				"Bool": map[string]interface{}{
 					"aws:SecureTransport": false,
@@ -546,7 +721,7 @@ func (s *Service) bucketPolicy(bucketName string) (string, error) {
 					iam.PrincipalAWS: []string{fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, *accountID.Account, bucket.ControlPlaneIAMInstanceProfile)},
 				},
 				Action:   []string{"s3:GetObject"},
-				Resource: []string{fmt.Sprintf("arn:%s:s3:::%s/control-plane/*", partition, bucketName)},
+				Resource: []string{s.objectARN(bucketName, "control-plane/*")},
 			})
 		}
 
@@ -560,7 +735,7 @@ func (s *Service) bucketPolicy(bucketName string) (string, error) {
 						iam.PrincipalAWS: []string{fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, *accountID.Account, iamInstanceProfile)},
 					},
 					Action:   []string{"s3:GetObject"},
-					Resource: []string{fmt.Sprintf("arn:%s:s3:::%s/node/*", partition, bucketName)},
+					Resource: []string{s.objectARN(bucketName, "node/*")},
 				},
 				iam.StatementEntry{
 					Sid:    iamInstanceProfile,
@@ -569,37 +744,54 @@ func (s *Service) bucketPolicy(bucketName string) (string, error) {
 						iam.PrincipalAWS: []string{fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, *accountID.Account, iamInstanceProfile)},
 					},
 					Action:   []string{"s3:GetObject"},
-					Resource: []string{fmt.Sprintf("arn:%s:s3:::%s/machine-pool/*", partition, bucketName)},
+					Resource: []string{s.objectARN(bucketName, "machine-pool/*")},
 				})
 		}
 	}
 
-	policy := iam.PolicyDocument{
-		Version:   "2012-10-17",
-		Statement: statements,
-	}
-
-	policyRaw, err := json.Marshal(policy)
-	if err != nil {
-		return "", errors.Wrap(err, "building bucket policy")
-	}
-
-	return string(policyRaw), nil
+	return statements, nil
 }
 
 func (s *Service) bucketManagementEnabled() bool {
 	return s.scope.Bucket() != nil
 }
 
+// bucketShared returns true if the bucket is shared with other clusters.
+func (s *Service) bucketShared() bool {
+	return s.scope.Bucket().Shared
+}
+
+// objectPrefix returns the prefix of the objects of the cluster in a shared bucket, or an empty prefix for a bucket
+// dedicated to the cluster.
+func (s *Service) objectPrefix() string {
+	if !s.bucketShared() {
+		return ""
+	}
+	return path.Join(s.scope.Namespace(), s.scope.Name())
+}
+
+// objectARN returns the ARN of the objects of the cluster matching the given key.
+func (s *Service) objectARN(bucketName, key string) string {
+	return fmt.Sprintf("arn:%s:s3:::%s", system.GetPartitionFromRegion(s.scope.Region()), path.Join(bucketName, s.objectPrefix(), key))
+}
+
 func (s *Service) bucketName() string {
 	return s.scope.Bucket().Name
 }
 
+// kmsKeyID returns the customer managed KMS key encrypting the objects, or nil for the AWS managed key of S3.
+func (s *Service) kmsKeyID() *string {
+	if s.scope.Bucket().KMSKeyID == "" {
+		return nil
+	}
+	return aws.String(s.scope.Bucket().KMSKeyID)
+}
+
 func (s *Service) bootstrapDataKey(m *scope.MachineScope) string {
 	// Use machine name as object key.
-	return path.Join(m.Role(), m.Name())
+	return path.Join(s.objectPrefix(), m.Role(), m.Name())
 }
 
 func (s *Service) bootstrapDataKeyForMachinePool(scope scope.LaunchTemplateScope, dataHash string) string {
-	return path.Join("machine-pool", scope.LaunchTemplateName(), dataHash)
+	return path.Join(s.objectPrefix(), "machine-pool", scope.LaunchTemplateName(), dataHash)
 }
@@ -30,6 +30,7 @@ import (
 	s3svc "github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/smithy-go"
 	"github.com/golang/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -223,6 +224,67 @@ func TestReconcileBucket(t *testing.T) {
 		}
 	})
 
+	t.Run("only_manages_policy_statements_of_the_cluster_when_bucket_is_shared", func(t *testing.T) {
+		utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)
+
+		bucketName := "shared"
+
+		svc, s3Mock := testService(t, &testServiceInput{
+			Bucket: &infrav1.S3Bucket{
+				Name:                           bucketName,
+				Shared:                         true,
+				ControlPlaneIAMInstanceProfile: fmt.Sprintf("control-plane%s", iamv1.DefaultNameSuffix),
+				NodesIAMInstanceProfiles: []string{
+					fmt.Sprintf("nodes%s", iamv1.DefaultNameSuffix),
+				},
+			},
+		})
+
+		otherStatement := `{"Sid":"other-cluster","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::foo:role/other"]},"NotAction":["s3:DeleteObject"],"Resource":["arn:aws:s3:::shared/test-namespace/other-cluster/*"]}`
+		staleStatement := fmt.Sprintf(`{"Sid":"stale","Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::shared/%s/%s/stale/*"]}`, testClusterNamespace, testClusterName)
+
+		s3Mock.EXPECT().GetBucketPolicy(gomock.Any(), &s3svc.GetBucketPolicyInput{Bucket: aws.String(bucketName)}).Return(&s3svc.GetBucketPolicyOutput{
+			Policy: aws.String(fmt.Sprintf(`{"Version":"2012-10-17","Statement":[%s,%s]}`, otherStatement, staleStatement)),
+		}, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, input *s3svc.PutBucketPolicyInput, optFns ...func(*s3svc.Options)) {
+			policy := *input.Policy
+
+			if !strings.Contains(policy, otherStatement) {
+				t.Errorf("Expected statements of other clusters to be kept untouched, got: %v", policy)
+			}
+
+			if strings.Contains(policy, "stale") {
+				t.Errorf("Expected previous statements of the cluster to be replaced, got: %v", policy)
+			}
+
+			prefix := fmt.Sprintf("%s/%s/%s", bucketName, testClusterNamespace, testClusterName)
+			for _, key := range []string{"*", "control-plane/*", "node/*", "machine-pool/*"} {
+				if !strings.Contains(policy, fmt.Sprintf("arn:aws:s3:::%s/%s", prefix, key)) {
+					t.Errorf("Expected a statement for objects %q under the prefix of the cluster, got: %v", key, policy)
+				}
+			}
+
+			if strings.Contains(policy, fmt.Sprintf("arn:aws:s3:::%s/*", bucketName)) {
+				t.Errorf("Expected no statement for all objects of the shared bucket, got: %v", policy)
+			}
+		}).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileBucket(context.TODO()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("creates_policy_when_shared_bucket_has_none", func(t *testing.T) {
+		svc, s3Mock := testService(t, &testServiceInput{Bucket: &infrav1.S3Bucket{Name: "shared", Shared: true}})
+
+		s3Mock.EXPECT().GetBucketPolicy(gomock.Any(), gomock.Any()).Return(nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileBucket(context.TODO()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
 	t.Run("is_idempotent", func(t *testing.T) {
 		utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)
 
@@ -425,6 +487,55 @@ func TestDeleteBucket(t *testing.T) {
 		}
 	})
 
+	t.Run("deletes_objects_and_policy_statements_of_the_cluster_when_bucket_is_shared", func(t *testing.T) {
+		utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)
+
+		svc, s3Mock := testService(t, &testServiceInput{Bucket: &infrav1.S3Bucket{Name: bucketName, Shared: true}})
+
+		prefix := fmt.Sprintf("%s/%s/", testClusterNamespace, testClusterName)
+		otherStatement := `{"Sid":"other-cluster","Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::foo/test-namespace/other-cluster/*"]}`
+		clusterStatement := fmt.Sprintf(`{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::foo/%snode/*"]}`, prefix)
+
+		gomock.InOrder(
+			s3Mock.EXPECT().ListObjectsV2(gomock.Any(), &s3svc.ListObjectsV2Input{
+				Bucket: aws.String(bucketName),
+				Prefix: aws.String(prefix),
+			}).Return(&s3svc.ListObjectsV2Output{Contents: []types.Object{{Key: aws.String(prefix + "node/machine")}}}, nil),
+			s3Mock.EXPECT().DeleteObject(gomock.Any(), &s3svc.DeleteObjectInput{
+				Bucket: aws.String(bucketName),
+				Key:    aws.String(prefix + "node/machine"),
+			}).Return(nil, nil),
+			s3Mock.EXPECT().ListObjectsV2(gomock.Any(), gomock.Any()).Return(&s3svc.ListObjectsV2Output{}, nil),
+		)
+		s3Mock.EXPECT().GetBucketPolicy(gomock.Any(), gomock.Any()).Return(&s3svc.GetBucketPolicyOutput{
+			Policy: aws.String(fmt.Sprintf(`{"Version":"2012-10-17","Statement":[%s,%s]}`, otherStatement, clusterStatement)),
+		}, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any(), &s3svc.PutBucketPolicyInput{
+			Bucket: aws.String(bucketName),
+			Policy: aws.String(fmt.Sprintf(`{"Version":"2012-10-17","Statement":[%s]}`, otherStatement)),
+		}).Return(nil, nil).Times(1)
+
+		if err := svc.DeleteBucket(context.TODO()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("deletes_policy_of_shared_bucket_without_statements_of_other_clusters", func(t *testing.T) {
+		svc, s3Mock := testService(t, &testServiceInput{Bucket: &infrav1.S3Bucket{Name: bucketName, Shared: true}})
+
+		clusterStatement := fmt.Sprintf(`{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::foo/%s/%s/node/*"]}`, testClusterNamespace, testClusterName)
+
+		s3Mock.EXPECT().ListObjectsV2(gomock.Any(), gomock.Any()).Return(&s3svc.ListObjectsV2Output{}, nil).Times(1)
+		s3Mock.EXPECT().GetBucketPolicy(gomock.Any(), gomock.Any()).Return(&s3svc.GetBucketPolicyOutput{
+			Policy: aws.String(fmt.Sprintf(`{"Version":"2012-10-17","Statement":[%s]}`, clusterStatement)),
+		}, nil).Times(1)
+		s3Mock.EXPECT().DeleteBucketPolicy(gomock.Any(), &s3svc.DeleteBucketPolicyInput{Bucket: aws.String(bucketName)}).Return(nil, nil).Times(1)
+
+		if err := svc.DeleteBucket(context.TODO()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
 	t.Run("skips_bucket_removal_when_bucket_is_not_empty", func(t *testing.T) {
 		utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)
 
@@ -533,6 +644,74 @@ func TestCreateObject(t *testing.T) {
 		})
 	})
 
+	t.Run("prefixes_key_with_cluster_namespace_and_name_when_bucket_is_shared", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &testServiceInput{
+			Bucket: &infrav1.S3Bucket{
+				Name:   bucketName,
+				Shared: true,
+			},
+		})
+
+		machineScope := &scope.MachineScope{
+			Machine: &clusterv1.Machine{},
+			AWSMachine: &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+			},
+		}
+
+		expectedKey := fmt.Sprintf("%s/%s/node/%s", testClusterNamespace, testClusterName, nodeName)
+
+		s3Mock.EXPECT().PutObject(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, putObjectInput *s3svc.PutObjectInput, optFns ...func(*s3svc.Options)) {
+			if *putObjectInput.Key != expectedKey {
+				t.Errorf("Expected key %q, got: %q", expectedKey, *putObjectInput.Key)
+			}
+		}).Return(nil, nil).Times(1)
+
+		bootstrapDataURL, err := svc.Create(context.TODO(), machineScope, []byte("foobar"))
+		if err != nil {
+			t.Fatalf("Unexpected error, got: %v", err)
+		}
+
+		if !strings.HasSuffix(bootstrapDataURL, expectedKey) {
+			t.Errorf("URL should end with key %q, got: %q", expectedKey, bootstrapDataURL)
+		}
+	})
+
+	t.Run("encrypts_object_with_configured_kms_key", func(t *testing.T) {
+		t.Parallel()
+
+		const kmsKeyID = "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+		svc, s3Mock := testService(t, &testServiceInput{
+			Bucket: &infrav1.S3Bucket{
+				Name:     bucketName,
+				KMSKeyID: kmsKeyID,
+			},
+		})
+
+		machineScope := &scope.MachineScope{
+			Machine: &clusterv1.Machine{},
+			AWSMachine: &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+			},
+		}
+
+		s3Mock.EXPECT().PutObject(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, putObjectInput *s3svc.PutObjectInput, optFns ...func(*s3svc.Options)) {
+			if putObjectInput.SSEKMSKeyId == nil || *putObjectInput.SSEKMSKeyId != kmsKeyID {
+				t.Errorf("Expected object to be encrypted with KMS key %q, got %v", kmsKeyID, putObjectInput.SSEKMSKeyId)
+			}
+		}).Return(nil, nil).Times(1)
+
+		if _, err := svc.Create(context.TODO(), machineScope, []byte("foobar")); err != nil {
+			t.Fatalf("Unexpected error, got: %v", err)
+		}
+	})
+
 	t.Run("is_idempotent", func(t *testing.T) {
 		t.Parallel()
 
@@ -19,7 +19,9 @@ package securitygroup
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -145,6 +147,8 @@ func (s *Service) ReconcileSecurityGroups() error {
 
 	// Second iteration creates or updates all permissions on the security group to match
 	// the specified ingress rules.
+	// Revoking rules may disrupt the traffic of the cluster, so rules are only replaced while the maintenance window is open.
+	canRevoke := s.scope.MaintenanceWindow().IsOpen(time.Now())
 	for role := range s.scope.SecurityGroups() {
 		sg := s.scope.SecurityGroups()[role]
 		s.scope.Debug("second pass security group reconciliation", "group-id", sg.ID, "name", sg.Name, "role", role)
@@ -154,49 +158,226 @@ func (s *Service) ReconcileSecurityGroups() error {
 			continue
 		}
 
-		if sg.Tags.HasAWSCloudProviderOwned(s.scope.Name()) || s.isEKSOwned(sg) {
-			// skip rule reconciliation, as we expect the in-cluster cloud integration to manage them
-			continue
+		deferred, err := s.reconcileSecurityGroupRules(role, sg, canRevoke)
+		if err != nil {
+			return err
 		}
-		current := sg.IngressRules
+		if len(deferred) > 0 {
+			s.scope.SetPendingDisruptiveAction(infrav1.DisruptiveActionSecurityGroupRuleReplacement, sg.ID, fmt.Sprintf("%s rules to revoke", strings.Join(deferred, " and ")))
+		} else {
+			s.scope.ClearPendingDisruptiveAction(infrav1.DisruptiveActionSecurityGroupRuleReplacement, sg.ID)
+		}
+	}
+	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.ClusterSecurityGroupsReadyCondition)
+	return nil
+}
 
-		specRules, err := s.getSecurityGroupIngressRules(role)
+// reconcileSecurityGroupRules makes the egress and ingress rules of the security group match its role.
+// When rules must be revoked and canRevoke is false, their revocation is deferred, as well as the authorization
+// of the rules replacing them. It returns the directions whose rules were not fully updated.
+func (s *Service) reconcileSecurityGroupRules(role infrav1.SecurityGroupRole, sg infrav1.SecurityGroup, canRevoke bool) ([]string, error) {
+	var deferred []string
+
+	// Egress rules are not managed by the in-cluster cloud integration, so they are reconciled
+	// on the load balancer security group as well.
+	if !s.isEKSOwned(sg) {
+		egressDeferred, err := s.reconcileSecurityGroupEgressRules(role, sg.ID, canRevoke)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if egressDeferred {
+			deferred = append(deferred, "egress")
 		}
-		// Duplicate rules with multiple cidr blocks/source security groups so that we are comparing similar sets.
-		want := expandIngressRules(specRules)
+	}
 
-		toRevoke := current.Difference(want)
-		if len(toRevoke) > 0 {
-			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-				if err := s.revokeSecurityGroupIngressRules(sg.ID, toRevoke); err != nil {
-					return false, err
-				}
-				return true, nil
-			}, awserrors.GroupNotFound); err != nil {
-				return errors.Wrapf(err, "failed to revoke security group ingress rules for %q", sg.ID)
+	if sg.Tags.HasAWSCloudProviderOwned(s.scope.Name()) || s.isEKSOwned(sg) {
+		// skip rule reconciliation, as we expect the in-cluster cloud integration to manage them
+		return deferred, nil
+	}
+	current := sg.IngressRules
+
+	specRules, err := s.getSecurityGroupIngressRules(role)
+	if err != nil {
+		return nil, err
+	}
+	// Duplicate rules with multiple cidr blocks/source security groups so that we are comparing similar sets.
+	want := expandIngressRules(specRules)
+
+	ingressDeferred, err := s.replaceSecurityGroupRules(sg.ID, "ingress", current, want, canRevoke, s.authorizeSecurityGroupIngressRules, s.updateSecurityGroupIngressRuleDescriptions, s.revokeSecurityGroupIngressRules)
+	if err != nil {
+		return nil, err
+	}
+	if ingressDeferred {
+		deferred = append(deferred, "ingress")
+	}
+	return deferred, nil
+}
+
+// reconcileSecurityGroupEgressRules makes the egress rules of the security group match the egress
+// configuration of its role. Security groups without an egress configuration are left untouched.
+// It returns true if the rules were not fully updated because some rules must be revoked and canRevoke is false.
+func (s *Service) reconcileSecurityGroupEgressRules(role infrav1.SecurityGroupRole, id string, canRevoke bool) (bool, error) {
+	egress, ok := s.scope.SecurityGroupEgress()[role]
+	if !ok {
+		return false, nil
+	}
+
+	current, err := s.describeSecurityGroupEgressRules(id)
+	if err != nil {
+		return false, err
+	}
+
+	// Duplicate rules with multiple cidr blocks/destination security groups so that we are comparing similar sets.
+	want := expandIngressRules(s.getSecurityGroupEgressRules(egress))
+
+	return s.replaceSecurityGroupRules(id, "egress", current, want, canRevoke, s.authorizeSecurityGroupEgressRules, s.updateSecurityGroupEgressRuleDescriptions, s.revokeSecurityGroupEgressRules)
+}
+
+// replaceSecurityGroupRules replaces the current rules of a direction of the security group with the wanted ones
+// without interrupting the traffic they allow: the new rules are authorized first, then the rules which are no
+// longer wanted are revoked in a single request. Only the rules whose permission is no longer wanted are revoked:
+// a rule with the same permission as a wanted rule, e.g. only changing its description, has its description updated
+// in place, as revoking it would stop the traffic it allows.
+// When canRevoke is false, the rules are authorized and updated but not revoked, and it returns true if their
+// revocation was deferred.
+func (s *Service) replaceSecurityGroupRules(id, direction string, current, want infrav1.IngressRules, canRevoke bool, authorize, updateDescriptions, revoke func(string, infrav1.IngressRules) error) (bool, error) {
+	toRevoke := withoutSamePermission(current.Difference(want), want)
+	changed := want.Difference(current)
+	toAuthorize := withoutSamePermission(changed, current)
+	toUpdate := changed.Difference(toAuthorize)
+
+	for _, step := range []struct {
+		action string
+		rules  infrav1.IngressRules
+		call   func(string, infrav1.IngressRules) error
+	}{
+		{action: "Authorized", rules: toAuthorize, call: authorize},
+		{action: "Updated the description of", rules: toUpdate, call: updateDescriptions},
+	} {
+		if len(step.rules) == 0 {
+			continue
+		}
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if err := step.call(id, step.rules); err != nil {
+				return false, err
 			}
+			return true, nil
+		}, awserrors.GroupNotFound); err != nil {
+			return false, err
+		}
+
+		s.scope.Debug(fmt.Sprintf("%s %s rules in security group", step.action, direction), "rules", step.rules, "security-group-id", id)
+	}
 
-			s.scope.Debug("Revoked ingress rules from security group", "revoked-ingress-rules", toRevoke, "security-group-id", sg.ID)
+	if len(toRevoke) == 0 {
+		return false, nil
+	}
+	if !canRevoke {
+		s.scope.Info(fmt.Sprintf("Deferring the revocation of the %s rules of the security group until the maintenance window opens", direction), "security-group-id", id, "rules-to-revoke", toRevoke)
+		return true, nil
+	}
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if err := revoke(id, toRevoke); err != nil {
+			return false, err
 		}
+		return true, nil
+	}, awserrors.GroupNotFound); err != nil {
+		return false, errors.Wrapf(err, "failed to revoke security group %s rules for %q", direction, id)
+	}
 
-		toAuthorize := want.Difference(current)
-		if len(toAuthorize) > 0 {
-			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-				if err := s.authorizeSecurityGroupIngressRules(sg.ID, toAuthorize); err != nil {
-					return false, err
-				}
-				return true, nil
-			}, awserrors.GroupNotFound); err != nil {
-				return err
+	s.scope.Debug(fmt.Sprintf("Revoked %s rules from security group", direction), "revoked-rules", toRevoke, "security-group-id", id)
+	return false, nil
+}
+
+// withoutSamePermission returns the rules which don't have the same permission as any of the other rules.
+func withoutSamePermission(rules, others infrav1.IngressRules) infrav1.IngressRules {
+	var out infrav1.IngressRules
+	for _, rule := range rules {
+		found := false
+		for _, other := range others {
+			if samePermission(rule, other) {
+				found = true
+				break
 			}
+		}
+		if !found {
+			out = append(out, rule)
+		}
+	}
+	return out
+}
 
-			s.scope.Debug("Authorized ingress rules in security group", "authorized-ingress-rules", toAuthorize, "security-group-id", sg.ID)
+// samePermission returns true if two rules allow the same traffic, regardless of their description.
+func samePermission(x, y infrav1.IngressRule) bool {
+	x.Description = y.Description
+	return x.Equals(&y)
+}
+
+// getSecurityGroupEgressRules returns the egress rules of the given configuration, using the
+// source fields of the ingress rule type to hold their destinations.
+func (s *Service) getSecurityGroupEgressRules(egress infrav1.SecurityGroupEgressSpec) infrav1.IngressRules {
+	rules := infrav1.IngressRules{}
+	if !egress.RemoveDefaultEgressRule {
+		rules = append(rules, s.defaultEgressRules()...)
+	}
+
+	for _, egressRule := range egress.EgressRules {
+		rule := infrav1.IngressRule{
+			Description:    egressRule.Description,
+			Protocol:       egressRule.Protocol,
+			FromPort:       egressRule.FromPort,
+			ToPort:         egressRule.ToPort,
+			CidrBlocks:     egressRule.CidrBlocks,
+			IPv6CidrBlocks: egressRule.IPv6CidrBlocks,
 		}
+
+		securityGroupIDs := sets.New(egressRule.DestinationSecurityGroupIDs...)
+		for _, destinationSGRole := range egressRule.DestinationSecurityGroupRoles {
+			securityGroupIDs.Insert(s.scope.SecurityGroups()[destinationSGRole].ID)
+		}
+		if securityGroupIDs.Len() > 0 {
+			rule.SourceSecurityGroupIDs = sets.List(securityGroupIDs)
+		}
+
+		rules = append(rules, rule)
 	}
-	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.ClusterSecurityGroupsReadyCondition)
-	return nil
+
+	return rules
+}
+
+// defaultEgressRules returns the allow-all egress rules that AWS adds to every new security group.
+func (s *Service) defaultEgressRules() infrav1.IngressRules {
+	rules := infrav1.IngressRules{
+		{
+			Protocol:   infrav1.SecurityGroupProtocolAll,
+			CidrBlocks: []string{services.AnyIPv4CidrBlock},
+		},
+	}
+	if s.scope.VPC().IsIPv6Enabled() {
+		rules = append(rules, infrav1.IngressRule{
+			Protocol:       infrav1.SecurityGroupProtocolAll,
+			IPv6CidrBlocks: []string{services.AnyIPv6CidrBlock},
+		})
+	}
+	return rules
+}
+
+func (s *Service) describeSecurityGroupEgressRules(id string) (infrav1.IngressRules, error) {
+	out, err := s.EC2Client.DescribeSecurityGroupsWithContext(context.TODO(), &ec2.DescribeSecurityGroupsInput{
+		GroupIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe security group %q", id)
+	}
+
+	rules := infrav1.IngressRules{}
+	for _, ec2sg := range out.SecurityGroups {
+		for _, ec2rule := range ec2sg.IpPermissionsEgress {
+			rules = append(rules, ingressRulesFromSDKType(ec2rule)...)
+		}
+	}
+	return rules, nil
 }
 
 // expandIngressRules expand the given ingress rules so that it's compatible with the list generated by
@@ -523,6 +704,37 @@ func (s *Service) revokeSecurityGroupIngressRules(id string, rules infrav1.Ingre
 	return nil
 }
 
+func (s *Service) updateSecurityGroupIngressRuleDescriptions(id string, rules infrav1.IngressRules) error {
+	input := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{GroupId: aws.String(id)}
+	for i := range rules {
+		rule := rules[i]
+		input.IpPermissions = append(input.IpPermissions, ingressRuleToSDKType(s.scope, &rule))
+	}
+
+	if _, err := s.EC2Client.UpdateSecurityGroupRuleDescriptionsIngressWithContext(context.TODO(), input); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedUpdateSecurityGroupIngressRuleDescriptions", "Failed to update the description of security group ingress rules %v for SecurityGroup %q: %v", rules, id, err)
+		return errors.Wrapf(err, "failed to update the description of security group %q ingress rules: %v", id, rules)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulUpdateSecurityGroupIngressRuleDescriptions", "Updated the description of security group ingress rules %v for SecurityGroup %q", rules, id)
+	return nil
+}
+
+func (s *Service) authorizeSecurityGroupEgressRules(id string, rules infrav1.IngressRules) error {
+	input := &ec2.AuthorizeSecurityGroupEgressInput{GroupId: aws.String(id)}
+	for i := range rules {
+		rule := rules[i]
+		input.IpPermissions = append(input.IpPermissions, ingressRuleToSDKType(s.scope, &rule))
+	}
+	if _, err := s.EC2Client.AuthorizeSecurityGroupEgressWithContext(context.TODO(), input); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAuthorizeSecurityGroupEgressRules", "Failed to authorize security group egress rules %v for SecurityGroup %q: %v", rules, id, err)
+		return errors.Wrapf(err, "failed to authorize security group %q egress rules: %v", id, rules)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAuthorizeSecurityGroupEgressRules", "Authorized security group egress rules %v for SecurityGroup %q", rules, id)
+	return nil
+}
+
 func (s *Service) revokeSecurityGroupEgressRules(id string, rules infrav1.IngressRules) error {
 	input := &ec2.RevokeSecurityGroupEgressInput{GroupId: aws.String(id)}
 	for i := range rules {
@@ -539,6 +751,22 @@ func (s *Service) revokeSecurityGroupEgressRules(id string, rules infrav1.Ingres
 	return nil
 }
 
+func (s *Service) updateSecurityGroupEgressRuleDescriptions(id string, rules infrav1.IngressRules) error {
+	input := &ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{GroupId: aws.String(id)}
+	for i := range rules {
+		rule := rules[i]
+		input.IpPermissions = append(input.IpPermissions, ingressRuleToSDKType(s.scope, &rule))
+	}
+
+	if _, err := s.EC2Client.UpdateSecurityGroupRuleDescriptionsEgressWithContext(context.TODO(), input); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedUpdateSecurityGroupEgressRuleDescriptions", "Failed to update the description of security group egress rules %v for SecurityGroup %q: %v", rules, id, err)
+		return errors.Wrapf(err, "failed to update the description of security group %q egress rules: %v", id, rules)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulUpdateSecurityGroupEgressRuleDescriptions", "Updated the description of security group egress rules %v for SecurityGroup %q", rules, id)
+	return nil
+}
+
 func (s *Service) revokeAllSecurityGroupIngressRules(id string) error {
 	describeInput := &ec2.DescribeSecurityGroupsInput{GroupIds: []*string{aws.String(id)}}
 
@@ -564,6 +792,36 @@ func (s *Service) revokeAllSecurityGroupIngressRules(id string) error {
 	return nil
 }
 
+// apiServerLBSecurityGroupIDs returns the IDs of the security groups of the control plane load balancers:
+// the existing security groups of the load balancers that set them, and the API server load balancer
+// security group managed by CAPA for the others.
+func (s *Service) apiServerLBSecurityGroupIDs() []string {
+	lbs := s.scope.ControlPlaneLoadBalancers()
+	// The primary control plane load balancer defaults to a load balancer using the managed security group.
+	managed := len(lbs) == 0 || lbs[0] == nil
+
+	var ids []string
+	for _, lb := range lbs {
+		if lb == nil {
+			continue
+		}
+		if len(lb.SecurityGroupIDs) == 0 {
+			managed = true
+			continue
+		}
+		for _, id := range lb.SecurityGroupIDs {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	if managed {
+		ids = append([]string{s.scope.SecurityGroups()[infrav1.SecurityGroupAPIServerLB].ID}, ids...)
+	}
+	return ids
+}
+
 func (s *Service) defaultSSHIngressRule(sourceSecurityGroupID string) infrav1.IngressRule {
 	return infrav1.IngressRule{
 		Description:            "SSH",
@@ -574,6 +832,11 @@ func (s *Service) defaultSSHIngressRule(sourceSecurityGroupID string) infrav1.In
 	}
 }
 
+// instanceConnectEndpointEnabled returns true if an EC2 Instance Connect Endpoint is requested for the cluster.
+func (s *Service) instanceConnectEndpointEnabled() bool {
+	return s.scope.InstanceConnectEndpoint() != nil && s.scope.InstanceConnectEndpoint().Enabled
+}
+
 func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (infrav1.IngressRules, error) {
 	// Set source of CNI ingress rules to be control plane and node security groups
 	s.scope.Debug("getting security group ingress rules", "role", role)
@@ -602,6 +865,22 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 				CidrBlocks:  s.scope.Bastion().AllowedCIDRBlocks,
 			},
 		}, nil
+	case infrav1.SecurityGroupInstanceConnectEndpoint:
+		// The endpoint only opens connections towards the instances, it doesn't accept any.
+		return infrav1.IngressRules{}, nil
+	case infrav1.SecurityGroupGuardDutyEndpoint:
+		// The GuardDuty security agent reports to the endpoint from any instance of the VPC.
+		rule := infrav1.IngressRule{
+			Description: "GuardDuty Runtime Monitoring",
+			Protocol:    infrav1.SecurityGroupProtocolTCP,
+			FromPort:    443,
+			ToPort:      443,
+			CidrBlocks:  []string{s.scope.VPC().CidrBlock},
+		}
+		if s.scope.VPC().IsIPv6Enabled() {
+			rule.IPv6CidrBlocks = []string{s.scope.VPC().IPv6.CidrBlock}
+		}
+		return infrav1.IngressRules{rule}, nil
 	case infrav1.SecurityGroupControlPlane:
 		rules := infrav1.IngressRules{
 			{
@@ -609,11 +888,10 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 				Protocol:    infrav1.SecurityGroupProtocolTCP,
 				FromPort:    infrav1.DefaultAPIServerPort,
 				ToPort:      infrav1.DefaultAPIServerPort,
-				SourceSecurityGroupIDs: []string{
-					s.scope.SecurityGroups()[infrav1.SecurityGroupAPIServerLB].ID,
+				SourceSecurityGroupIDs: append(s.apiServerLBSecurityGroupIDs(),
 					s.scope.SecurityGroups()[infrav1.SecurityGroupControlPlane].ID,
 					s.scope.SecurityGroups()[infrav1.SecurityGroupNode].ID,
-				},
+				),
 			},
 			{
 				Description:            "etcd",
@@ -633,6 +911,9 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 		if s.scope.Bastion().Enabled {
 			rules = append(rules, s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupBastion].ID))
 		}
+		if s.instanceConnectEndpointEnabled() {
+			rules = append(rules, s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupInstanceConnectEndpoint].ID))
+		}
 
 		additionalIngressRules, err := s.processIngressRulesSGs(s.scope.AdditionalControlPlaneIngressRules())
 		if err != nil {
@@ -671,6 +952,9 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 		if s.scope.Bastion().Enabled {
 			rules = append(rules, s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupBastion].ID))
 		}
+		if s.instanceConnectEndpointEnabled() {
+			rules = append(rules, s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupInstanceConnectEndpoint].ID))
+		}
 		if s.scope.VPC().IsIPv6Enabled() {
 			rules = append(rules, infrav1.IngressRule{
 				Description:    "Node Port Services IPv6",
@@ -976,20 +1260,10 @@ func (s *Service) getControlPlaneLBIngressRules() infrav1.IngressRules {
 	return s.getIngressRuleToAllowAnyIPInTheAPIServer()
 }
 
+// getIngressRuleToAllowAnyIPInTheAPIServer returns the ingress rules allowing any IP address to access the API server.
+// The load balancers of IPv6 enabled VPCs are dual-stack, so both IPv4 and IPv6 addresses are allowed.
 func (s *Service) getIngressRuleToAllowAnyIPInTheAPIServer() infrav1.IngressRules {
-	if s.scope.VPC().IsIPv6Enabled() {
-		return infrav1.IngressRules{
-			{
-				Description:    "Kubernetes API IPv6",
-				Protocol:       infrav1.SecurityGroupProtocolTCP,
-				FromPort:       int64(s.scope.APIServerPort()),
-				ToPort:         int64(s.scope.APIServerPort()),
-				IPv6CidrBlocks: []string{services.AnyIPv6CidrBlock},
-			},
-		}
-	}
-
-	return infrav1.IngressRules{
+	ingressRules := infrav1.IngressRules{
 		{
 			Description: "Kubernetes API",
 			Protocol:    infrav1.SecurityGroupProtocolTCP,
@@ -998,30 +1272,45 @@ func (s *Service) getIngressRuleToAllowAnyIPInTheAPIServer() infrav1.IngressRule
 			CidrBlocks:  []string{services.AnyIPv4CidrBlock},
 		},
 	}
-}
 
-func (s *Service) getIngressRuleToAllowVPCCidrInTheAPIServer() infrav1.IngressRules {
 	if s.scope.VPC().IsIPv6Enabled() {
-		return infrav1.IngressRules{
-			{
-				Description:    "Kubernetes API IPv6",
-				Protocol:       infrav1.SecurityGroupProtocolTCP,
-				FromPort:       int64(s.scope.APIServerPort()),
-				ToPort:         int64(s.scope.APIServerPort()),
-				IPv6CidrBlocks: []string{s.scope.VPC().IPv6.CidrBlock},
-			},
-		}
+		ingressRules = append(ingressRules, infrav1.IngressRule{
+			Description:    "Kubernetes API IPv6",
+			Protocol:       infrav1.SecurityGroupProtocolTCP,
+			FromPort:       int64(s.scope.APIServerPort()),
+			ToPort:         int64(s.scope.APIServerPort()),
+			IPv6CidrBlocks: []string{services.AnyIPv6CidrBlock},
+		})
 	}
 
-	return infrav1.IngressRules{
-		{
+	return ingressRules
+}
+
+// getIngressRuleToAllowVPCCidrInTheAPIServer returns the ingress rules allowing the VPC to access the API server.
+// Instances of dual-stack subnets can reach the load balancers over both IPv4 and IPv6.
+func (s *Service) getIngressRuleToAllowVPCCidrInTheAPIServer() infrav1.IngressRules {
+	ingressRules := infrav1.IngressRules{}
+	if !s.scope.VPC().IsIPv6Enabled() || s.scope.VPC().CidrBlock != "" {
+		ingressRules = append(ingressRules, infrav1.IngressRule{
 			Description: "Kubernetes API",
 			Protocol:    infrav1.SecurityGroupProtocolTCP,
 			FromPort:    int64(s.scope.APIServerPort()),
 			ToPort:      int64(s.scope.APIServerPort()),
 			CidrBlocks:  []string{s.scope.VPC().CidrBlock},
-		},
+		})
 	}
+
+	if s.scope.VPC().IsIPv6Enabled() {
+		ingressRules = append(ingressRules, infrav1.IngressRule{
+			Description:    "Kubernetes API IPv6",
+			Protocol:       infrav1.SecurityGroupProtocolTCP,
+			FromPort:       int64(s.scope.APIServerPort()),
+			ToPort:         int64(s.scope.APIServerPort()),
+			IPv6CidrBlocks: []string{s.scope.VPC().IPv6.CidrBlock},
+		})
+	}
+
+	return ingressRules
 }
 
 func (s *Service) processIngressRulesSGs(ingressRules []infrav1.IngressRule) (infrav1.IngressRules, error) {
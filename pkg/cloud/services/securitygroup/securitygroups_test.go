@@ -1161,6 +1161,412 @@ func TestReconcileSecurityGroups(t *testing.T) {
 	}
 }
 
+func TestReconcileSecurityGroupEgressRules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name         string
+		role         infrav1.SecurityGroupRole
+		egress       map[infrav1.SecurityGroupRole]infrav1.SecurityGroupEgressSpec
+		cannotRevoke bool
+		wantDeferred bool
+		expect       func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name: "no egress configuration for the role, egress rules are left untouched",
+			role: infrav1.SecurityGroupNode,
+			egress: map[infrav1.SecurityGroupRole]infrav1.SecurityGroupEgressSpec{
+				infrav1.SecurityGroupControlPlane: {RemoveDefaultEgressRule: true},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "default egress rule is kept and additional rules are authorized",
+			role: infrav1.SecurityGroupNode,
+			egress: map[infrav1.SecurityGroupRole]infrav1.SecurityGroupEgressSpec{
+				infrav1.SecurityGroupNode: {
+					EgressRules: []infrav1.EgressRule{
+						{
+							Description:                   "Kubernetes API",
+							Protocol:                      infrav1.SecurityGroupProtocolTCP,
+							FromPort:                      6443,
+							ToPort:                        6443,
+							DestinationSecurityGroupRoles: []infrav1.SecurityGroupRole{infrav1.SecurityGroupControlPlane},
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroupsWithContext(context.TODO(), &ec2.DescribeSecurityGroupsInput{
+					GroupIds: []*string{aws.String("sg-node")},
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
+						{
+							GroupId: aws.String("sg-node"),
+							IpPermissionsEgress: []*ec2.IpPermission{
+								{
+									IpProtocol: aws.String("-1"),
+									IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+								},
+							},
+						},
+					},
+				}, nil)
+
+				m.AuthorizeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-node"),
+					IpPermissions: []*ec2.IpPermission{
+						{
+							IpProtocol: aws.String("tcp"),
+							FromPort:   aws.Int64(6443),
+							ToPort:     aws.Int64(6443),
+							UserIdGroupPairs: []*ec2.UserIdGroupPair{
+								{
+									GroupId:     aws.String("sg-control"),
+									Description: aws.String("Kubernetes API"),
+								},
+							},
+						},
+					},
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+		{
+			name: "default egress rule is removed and undeclared rules are revoked",
+			role: infrav1.SecurityGroupLB,
+			egress: map[infrav1.SecurityGroupRole]infrav1.SecurityGroupEgressSpec{
+				infrav1.SecurityGroupLB: {
+					RemoveDefaultEgressRule: true,
+					EgressRules: []infrav1.EgressRule{
+						{
+							Description: "NodePort services",
+							Protocol:    infrav1.SecurityGroupProtocolTCP,
+							FromPort:    30000,
+							ToPort:      32767,
+							CidrBlocks:  []string{"10.0.0.0/16"},
+						},
+					},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroupsWithContext(context.TODO(), &ec2.DescribeSecurityGroupsInput{
+					GroupIds: []*string{aws.String("sg-lb")},
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
+						{
+							GroupId: aws.String("sg-lb"),
+							IpPermissionsEgress: []*ec2.IpPermission{
+								{
+									IpProtocol: aws.String("-1"),
+									IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+								},
+								{
+									IpProtocol: aws.String("tcp"),
+									FromPort:   aws.Int64(30000),
+									ToPort:     aws.Int64(32767),
+									IpRanges: []*ec2.IpRange{
+										{
+											CidrIp:      aws.String("10.0.0.0/16"),
+											Description: aws.String("NodePort services"),
+										},
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+
+				m.RevokeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-lb"),
+					IpPermissions: []*ec2.IpPermission{
+						{
+							IpProtocol: aws.String("-1"),
+							IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+						},
+					},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+		{
+			name: "new egress rules are authorized but the default egress rule is not removed outside of the maintenance window",
+			role: infrav1.SecurityGroupLB,
+			egress: map[infrav1.SecurityGroupRole]infrav1.SecurityGroupEgressSpec{
+				infrav1.SecurityGroupLB: {
+					RemoveDefaultEgressRule: true,
+					EgressRules: []infrav1.EgressRule{
+						{
+							Description: "NodePort services",
+							Protocol:    infrav1.SecurityGroupProtocolTCP,
+							FromPort:    30000,
+							ToPort:      32767,
+							CidrBlocks:  []string{"10.0.0.0/16"},
+						},
+					},
+				},
+			},
+			cannotRevoke: true,
+			wantDeferred: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroupsWithContext(context.TODO(), &ec2.DescribeSecurityGroupsInput{
+					GroupIds: []*string{aws.String("sg-lb")},
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
+						{
+							GroupId: aws.String("sg-lb"),
+							IpPermissionsEgress: []*ec2.IpPermission{
+								{
+									IpProtocol: aws.String("-1"),
+									IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+								},
+							},
+						},
+					},
+				}, nil)
+
+				m.AuthorizeSecurityGroupEgressWithContext(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-lb"),
+					IpPermissions: []*ec2.IpPermission{
+						{
+							IpProtocol: aws.String("tcp"),
+							FromPort:   aws.Int64(30000),
+							ToPort:     aws.Int64(32767),
+							IpRanges: []*ec2.IpRange{
+								{
+									CidrIp:      aws.String("10.0.0.0/16"),
+									Description: aws.String("NodePort services"),
+								},
+							},
+						},
+					},
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							SecurityGroupEgress: tc.egress,
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+								infrav1.SecurityGroupControlPlane: {ID: "sg-control"},
+								infrav1.SecurityGroupNode:         {ID: "sg-node"},
+								infrav1.SecurityGroupLB:           {ID: "sg-lb"},
+							},
+						},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			s.EC2Client = ec2Mock
+
+			deferred, err := s.reconcileSecurityGroupEgressRules(tc.role, cs.SecurityGroups()[tc.role].ID, !tc.cannotRevoke)
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if deferred != tc.wantDeferred {
+				t.Fatalf("expected the update of the egress rules to be deferred: %t, got %t", tc.wantDeferred, deferred)
+			}
+		})
+	}
+}
+
+func TestReconcileSecurityGroupRulesMaintenanceWindow(t *testing.T) {
+	staleRule := infrav1.IngressRule{
+		Description: "stale",
+		Protocol:    infrav1.SecurityGroupProtocolTCP,
+		FromPort:    1234,
+		ToPort:      1234,
+		CidrBlocks:  []string{"10.0.0.0/16"},
+	}
+
+	testCases := []struct {
+		name         string
+		canRevoke    bool
+		wantDeferred []string
+		expect       func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name:         "new ingress rules are authorized and stale rules are kept when rules cannot be revoked",
+			canRevoke:    false,
+			wantDeferred: []string{"ingress"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.AuthorizeSecurityGroupIngressWithContext(context.TODO(), gomock.Any()).Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil)
+			},
+		},
+		{
+			name:      "new ingress rules are authorized before stale rules are revoked when rules can be revoked",
+			canRevoke: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					m.AuthorizeSecurityGroupIngressWithContext(context.TODO(), gomock.Any()).Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil),
+					m.RevokeSecurityGroupIngressWithContext(context.TODO(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, input *ec2.RevokeSecurityGroupIngressInput, _ ...request.Option) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+							if len(input.IpPermissions) != 1 || aws.Int64Value(input.IpPermissions[0].FromPort) != 1234 {
+								t.Fatalf("expected only the stale rule to be revoked, got %v", input.IpPermissions)
+							}
+							return &ec2.RevokeSecurityGroupIngressOutput{}, nil
+						}),
+				)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			s.EC2Client = ec2Mock
+
+			sg := infrav1.SecurityGroup{ID: "sg-node", IngressRules: infrav1.IngressRules{staleRule}}
+			deferred, err := s.reconcileSecurityGroupRules(infrav1.SecurityGroupNode, sg, tc.canRevoke)
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(deferred, tc.wantDeferred) {
+				t.Fatalf("expected the update of the %v rules to be deferred, got %v", tc.wantDeferred, deferred)
+			}
+		})
+	}
+}
+
+func TestReplaceSecurityGroupRules(t *testing.T) {
+	rule := func(description, cidr string) infrav1.IngressRule {
+		return infrav1.IngressRule{
+			Description: description,
+			Protocol:    infrav1.SecurityGroupProtocolTCP,
+			FromPort:    6443,
+			ToPort:      6443,
+			CidrBlocks:  []string{cidr},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		current      infrav1.IngressRules
+		want         infrav1.IngressRules
+		canRevoke    bool
+		wantDeferred bool
+		wantCalls    []string
+	}{
+		{
+			name:      "new rules are authorized before the replaced rules are revoked",
+			current:   infrav1.IngressRules{rule("Kubernetes API", "10.0.0.0/16"), rule("Kubernetes API", "10.1.0.0/16")},
+			want:      infrav1.IngressRules{rule("Kubernetes API", "10.2.0.0/16"), rule("Kubernetes API", "10.1.0.0/16")},
+			canRevoke: true,
+			wantCalls: []string{"authorize 10.2.0.0/16", "revoke 10.0.0.0/16"},
+		},
+		{
+			name:      "rules with the same permission as a wanted rule have their description updated instead of being revoked",
+			current:   infrav1.IngressRules{rule("Kubernetes API", "10.0.0.0/16"), rule("Kubernetes API", "10.1.0.0/16")},
+			want:      infrav1.IngressRules{rule("API server", "10.0.0.0/16"), rule("Kubernetes API", "10.3.0.0/16")},
+			canRevoke: true,
+			wantCalls: []string{"authorize 10.3.0.0/16", "update 10.0.0.0/16", "revoke 10.1.0.0/16"},
+		},
+		{
+			name:         "rules are authorized and updated but not revoked when rules cannot be revoked",
+			current:      infrav1.IngressRules{rule("Kubernetes API", "10.0.0.0/16"), rule("Kubernetes API", "10.1.0.0/16")},
+			want:         infrav1.IngressRules{rule("API server", "10.0.0.0/16"), rule("Kubernetes API", "10.3.0.0/16")},
+			wantDeferred: true,
+			wantCalls:    []string{"authorize 10.3.0.0/16", "update 10.0.0.0/16"},
+		},
+		{
+			name:      "only changing the description of a rule does not revoke it",
+			current:   infrav1.IngressRules{rule("Kubernetes API", "10.0.0.0/16")},
+			want:      infrav1.IngressRules{rule("API server", "10.0.0.0/16")},
+			wantCalls: []string{"update 10.0.0.0/16"},
+		},
+		{
+			name:    "nothing is done when the rules are up to date",
+			current: infrav1.IngressRules{rule("Kubernetes API", "10.0.0.0/16")},
+			want:    infrav1.IngressRules{rule("Kubernetes API", "10.0.0.0/16")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+			s := NewService(cs, testSecurityGroupRoles)
+
+			var calls []string
+			track := func(action string) func(string, infrav1.IngressRules) error {
+				return func(_ string, rules infrav1.IngressRules) error {
+					var cidrs []string
+					for _, rule := range rules {
+						cidrs = append(cidrs, rule.CidrBlocks...)
+					}
+					calls = append(calls, action+" "+strings.Join(cidrs, ","))
+					return nil
+				}
+			}
+
+			deferred, err := s.replaceSecurityGroupRules("sg-control", "ingress", tc.current, tc.want, tc.canRevoke, track("authorize"), track("update"), track("revoke"))
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if deferred != tc.wantDeferred {
+				t.Fatalf("expected the update of the rules to be deferred: %t, got %t", tc.wantDeferred, deferred)
+			}
+			if !reflect.DeepEqual(calls, tc.wantCalls) {
+				t.Fatalf("expected calls %v, got %v", tc.wantCalls, calls)
+			}
+		})
+	}
+}
+
 func TestControlPlaneSecurityGroupNotOpenToAnyCIDR(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = infrav1.AddToScheme(scheme)
@@ -1189,6 +1595,81 @@ func TestControlPlaneSecurityGroupNotOpenToAnyCIDR(t *testing.T) {
 	}
 }
 
+func TestControlPlaneSecurityGroupAPIServerLBSources(t *testing.T) {
+	tests := []struct {
+		name       string
+		awsCluster *infrav1.AWSCluster
+		want       []string
+	}{
+		{
+			name:       "should allow the managed load balancer security group by default",
+			awsCluster: &infrav1.AWSCluster{},
+			want:       []string{"sg-apiserver-lb", "sg-control-plane", "sg-node"},
+		},
+		{
+			name: "should allow the existing security groups of the load balancer",
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+						SecurityGroupIDs: []string{"sg-existing-1", "sg-existing-2"},
+					},
+				},
+			},
+			want: []string{"sg-existing-1", "sg-existing-2", "sg-control-plane", "sg-node"},
+		},
+		{
+			name: "should allow the managed security group of the load balancers without existing security groups",
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+						SecurityGroupIDs: []string{"sg-existing-1"},
+					},
+					SecondaryControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+					},
+				},
+			},
+			want: []string{"sg-apiserver-lb", "sg-existing-1", "sg-control-plane", "sg-node"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			tt.awsCluster.Status.Network.SecurityGroups = map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+				infrav1.SecurityGroupAPIServerLB:  {ID: "sg-apiserver-lb"},
+				infrav1.SecurityGroupControlPlane: {ID: "sg-control-plane"},
+				infrav1.SecurityGroupNode:         {ID: "sg-node"},
+			}
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: tt.awsCluster,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			rules, err := s.getSecurityGroupIngressRules(infrav1.SecurityGroupControlPlane)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			var found bool
+			for _, r := range rules {
+				if r.Description == "Kubernetes API" {
+					found = true
+					g.Expect(r.SourceSecurityGroupIDs).To(Equal(tt.want))
+				}
+			}
+			g.Expect(found).To(BeTrue())
+		})
+	}
+}
+
 func TestAdditionalControlPlaneSecurityGroup(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = infrav1.AddToScheme(scheme)
@@ -1615,6 +2096,13 @@ func TestControlPlaneLoadBalancerIngressRules(t *testing.T) {
 				Status: infrav1.AWSClusterStatus{},
 			},
 			expectedIngresRules: infrav1.IngressRules{
+				infrav1.IngressRule{
+					Description: "Kubernetes API",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    6443,
+					ToPort:      6443,
+					CidrBlocks:  []string{services.AnyIPv4CidrBlock},
+				},
 				infrav1.IngressRule{
 					Description:    "Kubernetes API IPv6",
 					Protocol:       infrav1.SecurityGroupProtocolTCP,
@@ -1757,6 +2245,61 @@ func TestControlPlaneLoadBalancerIngressRules(t *testing.T) {
 					ToPort:         6443,
 					IPv6CidrBlocks: []string{"10.0.0.0/16"},
 				},
+				infrav1.IngressRule{
+					Description: "Kubernetes API",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    6443,
+					ToPort:      6443,
+					CidrBlocks:  []string{services.AnyIPv4CidrBlock},
+				},
+				infrav1.IngressRule{
+					Description:    "Kubernetes API IPv6",
+					Protocol:       infrav1.SecurityGroupProtocolTCP,
+					FromPort:       6443,
+					ToPort:         6443,
+					IPv6CidrBlocks: []string{services.AnyIPv6CidrBlock},
+				},
+			},
+		},
+		{
+			name: "when no ingress rules are passed while using internal LB in a dual-stack VPC",
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						Scheme: &infrav1.ELBSchemeInternal,
+					},
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{
+							CidrBlock: "10.0.0.0/16",
+							IPv6: &infrav1.IPv6{
+								CidrBlock: "2001:db8:1234:1a00::/56",
+							},
+						},
+					},
+				},
+			},
+			expectedIngresRules: infrav1.IngressRules{
+				infrav1.IngressRule{
+					Description: "Kubernetes API",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    6443,
+					ToPort:      6443,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+				infrav1.IngressRule{
+					Description:    "Kubernetes API IPv6",
+					Protocol:       infrav1.SecurityGroupProtocolTCP,
+					FromPort:       6443,
+					ToPort:         6443,
+					IPv6CidrBlocks: []string{"2001:db8:1234:1a00::/56"},
+				},
+				infrav1.IngressRule{
+					Description: "Kubernetes API",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    6443,
+					ToPort:      6443,
+					CidrBlocks:  []string{services.AnyIPv4CidrBlock},
+				},
 				infrav1.IngressRule{
 					Description:    "Kubernetes API IPv6",
 					Protocol:       infrav1.SecurityGroupProtocolTCP,
@@ -2438,3 +2981,162 @@ func TestNodePortServicesIngressRules(t *testing.T) {
 		})
 	}
 }
+
+func TestInstanceConnectEndpointIngressRules(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	sshFromEndpoint := infrav1.IngressRule{
+		Description:            "SSH",
+		Protocol:               infrav1.SecurityGroupProtocolTCP,
+		FromPort:               22,
+		ToPort:                 22,
+		SourceSecurityGroupIDs: []string{"sg-instance-connect-endpoint"},
+	}
+
+	testCases := []struct {
+		name                    string
+		instanceConnectEndpoint *infrav1.InstanceConnectEndpoint
+		role                    infrav1.SecurityGroupRole
+		expectSSHFromEndpoint   bool
+	}{
+		{
+			name:                    "node security group allows SSH from the endpoint when it is enabled",
+			instanceConnectEndpoint: &infrav1.InstanceConnectEndpoint{Enabled: true},
+			role:                    infrav1.SecurityGroupNode,
+			expectSSHFromEndpoint:   true,
+		},
+		{
+			name:                    "control plane security group allows SSH from the endpoint when it is enabled",
+			instanceConnectEndpoint: &infrav1.InstanceConnectEndpoint{Enabled: true},
+			role:                    infrav1.SecurityGroupControlPlane,
+			expectSSHFromEndpoint:   true,
+		},
+		{
+			name:                    "node security group doesn't allow SSH from the endpoint when it is disabled",
+			instanceConnectEndpoint: &infrav1.InstanceConnectEndpoint{Enabled: false},
+			role:                    infrav1.SecurityGroupNode,
+		},
+		{
+			name: "control plane security group doesn't allow SSH from the endpoint when it is not configured",
+			role: infrav1.SecurityGroupControlPlane,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{},
+						InstanceConnectEndpoint:  tc.instanceConnectEndpoint,
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{
+								CidrBlock: "10.0.0.0/16",
+							},
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+								infrav1.SecurityGroupControlPlane:            {ID: "sg-control-plane"},
+								infrav1.SecurityGroupNode:                    {ID: "sg-node"},
+								infrav1.SecurityGroupAPIServerLB:             {ID: "sg-apiserver-lb"},
+								infrav1.SecurityGroupInstanceConnectEndpoint: {ID: "sg-instance-connect-endpoint"},
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			rules, err := s.getSecurityGroupIngressRules(tc.role)
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expectSSHFromEndpoint {
+				g.Expect(rules).To(ContainElement(sshFromEndpoint))
+			} else {
+				g.Expect(rules).NotTo(ContainElement(sshFromEndpoint))
+			}
+
+			endpointRules, err := s.getSecurityGroupIngressRules(infrav1.SecurityGroupInstanceConnectEndpoint)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(endpointRules).To(BeEmpty())
+		})
+	}
+}
+
+func TestGuardDutyEndpointIngressRules(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	testCases := []struct {
+		name      string
+		ipv6      *infrav1.IPv6
+		wantRules infrav1.IngressRules
+	}{
+		{
+			name: "endpoint security group allows HTTPS from the VPC",
+			wantRules: infrav1.IngressRules{
+				{
+					Description: "GuardDuty Runtime Monitoring",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    443,
+					ToPort:      443,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+			},
+		},
+		{
+			name: "endpoint security group allows HTTPS from the IPv6 block of the VPC",
+			ipv6: &infrav1.IPv6{CidrBlock: "2001:db8::/56"},
+			wantRules: infrav1.IngressRules{
+				{
+					Description:    "GuardDuty Runtime Monitoring",
+					Protocol:       infrav1.SecurityGroupProtocolTCP,
+					FromPort:       443,
+					ToPort:         443,
+					CidrBlocks:     []string{"10.0.0.0/16"},
+					IPv6CidrBlocks: []string{"2001:db8::/56"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						ControlPlaneLoadBalancer:   &infrav1.AWSLoadBalancerSpec{},
+						GuardDutyRuntimeMonitoring: &infrav1.GuardDutyRuntimeMonitoring{Enabled: true},
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{
+								CidrBlock: "10.0.0.0/16",
+								IPv6:      tc.ipv6,
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			rules, err := s.getSecurityGroupIngressRules(infrav1.SecurityGroupGuardDutyEndpoint)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(rules).To(Equal(tc.wantRules))
+		})
+	}
+}
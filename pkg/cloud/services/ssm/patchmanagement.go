@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+// ReconcilePatchManagement registers the instance with the maintenance window and creates the
+// State Manager associations of the AWSMachine, recording them in its status.
+// The patch group of the instance is set with the tags of the AWSMachine.
+func (s *Service) ReconcilePatchManagement(m *scope.MachineScope, instanceID string) error {
+	patchManagement := m.AWSMachine.Spec.PatchManagement
+	if patchManagement == nil {
+		return nil
+	}
+
+	status := m.AWSMachine.Status.PatchManagement
+	if status == nil {
+		status = &infrav1.PatchManagementStatus{}
+		m.AWSMachine.Status.PatchManagement = status
+	}
+
+	targets := []*ssm.Target{
+		{
+			Key:    aws.String("InstanceIds"),
+			Values: aws.StringSlice([]string{instanceID}),
+		},
+	}
+
+	if patchManagement.MaintenanceWindowID != "" && status.MaintenanceWindowTargetID == "" {
+		windowTargetID, err := s.registerMaintenanceWindowTarget(m, patchManagement.MaintenanceWindowID, instanceID, targets)
+		if err != nil {
+			return err
+		}
+		status.MaintenanceWindowTargetID = windowTargetID
+	}
+
+	var existing map[string]string
+	for _, association := range patchManagement.Associations {
+		if slices.ContainsFunc(status.Associations, func(a infrav1.SSMAssociationStatus) bool { return a.Name == association.Name }) {
+			continue
+		}
+
+		// Associations created before the status of the AWSMachine could be patched are adopted.
+		if existing == nil {
+			var err error
+			if existing, err = s.listAssociations(instanceID); err != nil {
+				return err
+			}
+		}
+
+		associationName := ssmAssociationName(m, association)
+		associationID, ok := existing[associationName]
+		if !ok {
+			var err error
+			if associationID, err = s.createAssociation(associationName, association, targets); err != nil {
+				return err
+			}
+			m.Info("Created State Manager association", "association", association.Name, "association-id", associationID)
+		}
+		status.Associations = append(status.Associations, infrav1.SSMAssociationStatus{
+			Name:          association.Name,
+			AssociationID: associationID,
+		})
+	}
+
+	return nil
+}
+
+// DeletePatchManagement deletes the State Manager associations of the AWSMachine and deregisters
+// its instance from the maintenance window.
+func (s *Service) DeletePatchManagement(m *scope.MachineScope) error {
+	status := m.AWSMachine.Status.PatchManagement
+	if status == nil {
+		return nil
+	}
+
+	var errs []error
+	remaining := make([]infrav1.SSMAssociationStatus, 0, len(status.Associations))
+	for _, association := range status.Associations {
+		_, err := s.SSMClient.DeleteAssociationWithContext(context.TODO(), &ssm.DeleteAssociationInput{
+			AssociationId: aws.String(association.AssociationID),
+		})
+		if err != nil && !isNotFound(err) {
+			errs = append(errs, errors.Wrapf(err, "failed to delete State Manager association %q", association.AssociationID))
+			remaining = append(remaining, association)
+			continue
+		}
+		m.Info("Deleted State Manager association", "association", association.Name, "association-id", association.AssociationID)
+	}
+	status.Associations = remaining
+
+	if status.MaintenanceWindowTargetID != "" && m.AWSMachine.Spec.PatchManagement != nil {
+		_, err := s.SSMClient.DeregisterTargetFromMaintenanceWindowWithContext(context.TODO(), &ssm.DeregisterTargetFromMaintenanceWindowInput{
+			WindowId:       aws.String(m.AWSMachine.Spec.PatchManagement.MaintenanceWindowID),
+			WindowTargetId: aws.String(status.MaintenanceWindowTargetID),
+		})
+		if err != nil && !isNotFound(err) {
+			errs = append(errs, errors.Wrapf(err, "failed to deregister target %q from maintenance window", status.MaintenanceWindowTargetID))
+		} else {
+			status.MaintenanceWindowTargetID = ""
+		}
+	}
+
+	if len(errs) > 0 {
+		return kerrors.NewAggregate(errs)
+	}
+	m.AWSMachine.Status.PatchManagement = nil
+	return nil
+}
+
+func (s *Service) registerMaintenanceWindowTarget(m *scope.MachineScope, windowID, instanceID string, targets []*ssm.Target) (string, error) {
+	// The instance ID is used as the owner information of the target, to find a target registered
+	// before the status of the AWSMachine could be patched.
+	out, err := s.SSMClient.DescribeMaintenanceWindowTargetsWithContext(context.TODO(), &ssm.DescribeMaintenanceWindowTargetsInput{
+		WindowId: aws.String(windowID),
+		Filters: []*ssm.MaintenanceWindowFilter{
+			{
+				Key:    aws.String("OwnerInformation"),
+				Values: aws.StringSlice([]string{instanceID}),
+			},
+		},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe targets of maintenance window %q", windowID)
+	}
+	if len(out.Targets) > 0 {
+		return aws.StringValue(out.Targets[0].WindowTargetId), nil
+	}
+
+	registered, err := s.SSMClient.RegisterTargetWithMaintenanceWindowWithContext(context.TODO(), &ssm.RegisterTargetWithMaintenanceWindowInput{
+		WindowId:         aws.String(windowID),
+		ResourceType:     aws.String(ssm.MaintenanceWindowResourceTypeInstance),
+		Targets:          targets,
+		Name:             aws.String(m.Name()),
+		OwnerInformation: aws.String(instanceID),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to register instance %q with maintenance window %q", instanceID, windowID)
+	}
+	m.Info("Registered instance with maintenance window", "maintenance-window-id", windowID, "window-target-id", aws.StringValue(registered.WindowTargetId))
+	return aws.StringValue(registered.WindowTargetId), nil
+}
+
+// listAssociations returns the IDs of the State Manager associations of the instance, keyed by name.
+func (s *Service) listAssociations(instanceID string) (map[string]string, error) {
+	associations := map[string]string{}
+	err := s.SSMClient.ListAssociationsPagesWithContext(context.TODO(), &ssm.ListAssociationsInput{
+		AssociationFilterList: []*ssm.AssociationFilter{
+			{
+				Key:   aws.String(ssm.AssociationFilterKeyInstanceId),
+				Value: aws.String(instanceID),
+			},
+		},
+	}, func(page *ssm.ListAssociationsOutput, lastPage bool) bool {
+		for _, association := range page.Associations {
+			if name := aws.StringValue(association.AssociationName); name != "" {
+				associations[name] = aws.StringValue(association.AssociationId)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list State Manager associations of instance %q", instanceID)
+	}
+	return associations, nil
+}
+
+func (s *Service) createAssociation(name string, association infrav1.SSMAssociation, targets []*ssm.Target) (string, error) {
+	input := &ssm.CreateAssociationInput{
+		AssociationName: aws.String(name),
+		Name:            aws.String(association.DocumentName),
+		Targets:         targets,
+	}
+	if association.DocumentVersion != "" {
+		input.DocumentVersion = aws.String(association.DocumentVersion)
+	}
+	if association.ScheduleExpression != "" {
+		input.ScheduleExpression = aws.String(association.ScheduleExpression)
+	}
+	if len(association.Parameters) > 0 {
+		input.Parameters = make(map[string][]*string, len(association.Parameters))
+		for key, values := range association.Parameters {
+			input.Parameters[key] = aws.StringSlice(values)
+		}
+	}
+
+	out, err := s.SSMClient.CreateAssociationWithContext(context.TODO(), input)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create State Manager association %q with document %q", name, association.DocumentName)
+	}
+	return aws.StringValue(out.AssociationDescription.AssociationId), nil
+}
+
+// ssmAssociationName returns the name of the State Manager association of an AWSMachine.
+func ssmAssociationName(m *scope.MachineScope, association infrav1.SSMAssociation) string {
+	return fmt.Sprintf("%s-%s", m.Name(), association.Name)
+}
+
+func isNotFound(err error) bool {
+	if code, ok := awserrors.Code(err); ok {
+		return code == ssm.ErrCodeAssociationDoesNotExist || code == ssm.ErrCodeDoesNotExistException
+	}
+	return false
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ssm/mock_ssmiface"
+)
+
+func TestServiceReconcilePatchManagement(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	targets := []*ssm.Target{{Key: aws.String("InstanceIds"), Values: aws.StringSlice([]string{"i-1"})}}
+	association := infrav1.SSMAssociation{
+		Name:               "patch",
+		DocumentName:       "AWS-RunPatchBaseline",
+		Parameters:         map[string][]string{"Operation": {"Install"}},
+		ScheduleExpression: "cron(0 2 ? * SUN *)",
+	}
+	listAssociations := func(m *mock_ssmiface.MockSSMAPIMockRecorder, associations ...*ssm.Association) {
+		m.ListAssociationsPagesWithContext(context.TODO(), gomock.Eq(&ssm.ListAssociationsInput{
+			AssociationFilterList: []*ssm.AssociationFilter{{Key: aws.String(ssm.AssociationFilterKeyInstanceId), Value: aws.String("i-1")}},
+		}), gomock.Any()).DoAndReturn(func(_ context.Context, _ *ssm.ListAssociationsInput, fn func(*ssm.ListAssociationsOutput, bool) bool, _ ...interface{}) error {
+			fn(&ssm.ListAssociationsOutput{Associations: associations}, true)
+			return nil
+		})
+	}
+
+	tests := []struct {
+		name            string
+		patchManagement *infrav1.PatchManagement
+		status          *infrav1.PatchManagementStatus
+		expect          func(m *mock_ssmiface.MockSSMAPIMockRecorder)
+		want            *infrav1.PatchManagementStatus
+		wantErr         bool
+	}{
+		{
+			name: "should not call AWS without patch management",
+		},
+		{
+			name:            "should not call AWS with only a patch group",
+			patchManagement: &infrav1.PatchManagement{PatchGroup: "production"},
+			want:            &infrav1.PatchManagementStatus{},
+		},
+		{
+			name: "should register the instance with the maintenance window and create the associations",
+			patchManagement: &infrav1.PatchManagement{
+				MaintenanceWindowID: "mw-0123456789abcdef0",
+				Associations:        []infrav1.SSMAssociation{association},
+			},
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.DescribeMaintenanceWindowTargetsWithContext(context.TODO(), gomock.Any()).Return(&ssm.DescribeMaintenanceWindowTargetsOutput{}, nil)
+				m.RegisterTargetWithMaintenanceWindowWithContext(context.TODO(), gomock.Eq(&ssm.RegisterTargetWithMaintenanceWindowInput{
+					WindowId:         aws.String("mw-0123456789abcdef0"),
+					ResourceType:     aws.String(ssm.MaintenanceWindowResourceTypeInstance),
+					Targets:          targets,
+					Name:             aws.String("infra-cluster"),
+					OwnerInformation: aws.String("i-1"),
+				})).Return(&ssm.RegisterTargetWithMaintenanceWindowOutput{WindowTargetId: aws.String("target-1")}, nil)
+				listAssociations(m)
+				m.CreateAssociationWithContext(context.TODO(), gomock.Eq(&ssm.CreateAssociationInput{
+					AssociationName:    aws.String("infra-cluster-patch"),
+					Name:               aws.String("AWS-RunPatchBaseline"),
+					Targets:            targets,
+					Parameters:         map[string][]*string{"Operation": aws.StringSlice([]string{"Install"})},
+					ScheduleExpression: aws.String("cron(0 2 ? * SUN *)"),
+				})).Return(&ssm.CreateAssociationOutput{
+					AssociationDescription: &ssm.AssociationDescription{AssociationId: aws.String("association-1")},
+				}, nil)
+			},
+			want: &infrav1.PatchManagementStatus{
+				MaintenanceWindowTargetID: "target-1",
+				Associations:              []infrav1.SSMAssociationStatus{{Name: "patch", AssociationID: "association-1"}},
+			},
+		},
+		{
+			name: "should adopt the existing maintenance window target and associations",
+			patchManagement: &infrav1.PatchManagement{
+				MaintenanceWindowID: "mw-0123456789abcdef0",
+				Associations:        []infrav1.SSMAssociation{association},
+			},
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.DescribeMaintenanceWindowTargetsWithContext(context.TODO(), gomock.Any()).Return(&ssm.DescribeMaintenanceWindowTargetsOutput{
+					Targets: []*ssm.MaintenanceWindowTarget{{WindowTargetId: aws.String("target-1")}},
+				}, nil)
+				listAssociations(m, &ssm.Association{AssociationName: aws.String("infra-cluster-patch"), AssociationId: aws.String("association-1")})
+			},
+			want: &infrav1.PatchManagementStatus{
+				MaintenanceWindowTargetID: "target-1",
+				Associations:              []infrav1.SSMAssociationStatus{{Name: "patch", AssociationID: "association-1"}},
+			},
+		},
+		{
+			name: "should not call AWS when the resources are recorded in the status",
+			patchManagement: &infrav1.PatchManagement{
+				MaintenanceWindowID: "mw-0123456789abcdef0",
+				Associations:        []infrav1.SSMAssociation{association},
+			},
+			status: &infrav1.PatchManagementStatus{
+				MaintenanceWindowTargetID: "target-1",
+				Associations:              []infrav1.SSMAssociationStatus{{Name: "patch", AssociationID: "association-1"}},
+			},
+			want: &infrav1.PatchManagementStatus{
+				MaintenanceWindowTargetID: "target-1",
+				Associations:              []infrav1.SSMAssociationStatus{{Name: "patch", AssociationID: "association-1"}},
+			},
+		},
+		{
+			name: "should return an error when the association cannot be created",
+			patchManagement: &infrav1.PatchManagement{
+				Associations: []infrav1.SSMAssociation{association},
+			},
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				listAssociations(m)
+				m.CreateAssociationWithContext(context.TODO(), gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeInvalidDocument, "invalid document", nil))
+			},
+			want:    &infrav1.PatchManagementStatus{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			clusterScope, err := getClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			ssmClientMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(ssmClientMock.EXPECT())
+			}
+			s := NewService(clusterScope)
+			s.SSMClient = ssmClientMock
+
+			ms, err := getMachineScope(client, clusterScope)
+			g.Expect(err).NotTo(HaveOccurred())
+			ms.AWSMachine.Spec.PatchManagement = tt.patchManagement
+			ms.AWSMachine.Status.PatchManagement = tt.status
+
+			err = s.ReconcilePatchManagement(ms, "i-1")
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(ms.AWSMachine.Status.PatchManagement).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestServiceDeletePatchManagement(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	status := func() *infrav1.PatchManagementStatus {
+		return &infrav1.PatchManagementStatus{
+			MaintenanceWindowTargetID: "target-1",
+			Associations: []infrav1.SSMAssociationStatus{
+				{Name: "patch", AssociationID: "association-1"},
+				{Name: "inventory", AssociationID: "association-2"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		status  *infrav1.PatchManagementStatus
+		expect  func(m *mock_ssmiface.MockSSMAPIMockRecorder)
+		want    *infrav1.PatchManagementStatus
+		wantErr bool
+	}{
+		{
+			name: "should not call AWS without patch management status",
+		},
+		{
+			name:   "should delete the associations and deregister the maintenance window target",
+			status: status(),
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.DeleteAssociationWithContext(context.TODO(), gomock.Eq(&ssm.DeleteAssociationInput{AssociationId: aws.String("association-1")})).Return(&ssm.DeleteAssociationOutput{}, nil)
+				m.DeleteAssociationWithContext(context.TODO(), gomock.Eq(&ssm.DeleteAssociationInput{AssociationId: aws.String("association-2")})).
+					Return(nil, awserr.New(ssm.ErrCodeAssociationDoesNotExist, "not found", nil))
+				m.DeregisterTargetFromMaintenanceWindowWithContext(context.TODO(), gomock.Eq(&ssm.DeregisterTargetFromMaintenanceWindowInput{
+					WindowId:       aws.String("mw-0123456789abcdef0"),
+					WindowTargetId: aws.String("target-1"),
+				})).Return(&ssm.DeregisterTargetFromMaintenanceWindowOutput{}, nil)
+			},
+		},
+		{
+			name:   "should keep the resources that could not be deleted",
+			status: status(),
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.DeleteAssociationWithContext(context.TODO(), gomock.Eq(&ssm.DeleteAssociationInput{AssociationId: aws.String("association-1")})).
+					Return(nil, awserr.New(ssm.ErrCodeInternalServerError, "internal error", nil))
+				m.DeleteAssociationWithContext(context.TODO(), gomock.Eq(&ssm.DeleteAssociationInput{AssociationId: aws.String("association-2")})).Return(&ssm.DeleteAssociationOutput{}, nil)
+				m.DeregisterTargetFromMaintenanceWindowWithContext(context.TODO(), gomock.Any()).Return(&ssm.DeregisterTargetFromMaintenanceWindowOutput{}, nil)
+			},
+			want: &infrav1.PatchManagementStatus{
+				Associations: []infrav1.SSMAssociationStatus{{Name: "patch", AssociationID: "association-1"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			clusterScope, err := getClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			ssmClientMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(ssmClientMock.EXPECT())
+			}
+			s := NewService(clusterScope)
+			s.SSMClient = ssmClientMock
+
+			ms, err := getMachineScope(client, clusterScope)
+			g.Expect(err).NotTo(HaveOccurred())
+			ms.AWSMachine.Spec.PatchManagement = &infrav1.PatchManagement{MaintenanceWindowID: "mw-0123456789abcdef0"}
+			ms.AWSMachine.Status.PatchManagement = tt.status
+
+			err = s.DeletePatchManagement(ms)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(ms.AWSMachine.Status.PatchManagement).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// sessionPreferences is the content of a Session Manager preferences document.
+type sessionPreferences struct {
+	SchemaVersion string                   `json:"schemaVersion"`
+	Description   string                   `json:"description"`
+	SessionType   string                   `json:"sessionType"`
+	Inputs        sessionPreferencesInputs `json:"inputs"`
+}
+
+type sessionPreferencesInputs struct {
+	S3BucketName                string `json:"s3BucketName"`
+	S3KeyPrefix                 string `json:"s3KeyPrefix"`
+	S3EncryptionEnabled         bool   `json:"s3EncryptionEnabled"`
+	CloudWatchLogGroupName      string `json:"cloudWatchLogGroupName"`
+	CloudWatchEncryptionEnabled bool   `json:"cloudWatchEncryptionEnabled"`
+	CloudWatchStreamingEnabled  bool   `json:"cloudWatchStreamingEnabled"`
+	KMSKeyID                    string `json:"kmsKeyId"`
+	IdleSessionTimeout          string `json:"idleSessionTimeout,omitempty"`
+}
+
+// SessionManagerDocumentName returns the name of the Session Manager preferences document of the given preferences.
+func SessionManagerDocumentName(preferences *infrav1.SessionManager) string {
+	if preferences.DocumentName == "" {
+		return infrav1.SessionManagerDefaultDocumentName
+	}
+	return preferences.DocumentName
+}
+
+// ReconcileSessionManagerPreferences ensures that the Session Manager preferences document matches the given
+// preferences, creating it if it does not exist.
+func (s *Service) ReconcileSessionManagerPreferences(preferences *infrav1.SessionManager) error {
+	name := SessionManagerDocumentName(preferences)
+	desired := s.sessionPreferences(preferences)
+	content, err := json.Marshal(desired)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal Session Manager preferences")
+	}
+
+	out, err := s.SSMClient.GetDocumentWithContext(context.TODO(), &ssm.GetDocumentInput{
+		Name: aws.String(name),
+	})
+	if isDocumentNotFound(err) {
+		_, err := s.SSMClient.CreateDocumentWithContext(context.TODO(), &ssm.CreateDocumentInput{
+			Name:           aws.String(name),
+			Content:        aws.String(string(content)),
+			DocumentType:   aws.String(ssm.DocumentTypeSession),
+			DocumentFormat: aws.String(ssm.DocumentFormatJson),
+			Tags:           converters.MapToSSMTags(infrav1.Build(s.getSessionManagerTagParams(name))),
+		})
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateSessionManagerPreferences", "Failed to create Session Manager preferences document %q: %v", name, err)
+			return errors.Wrapf(err, "failed to create Session Manager preferences document %q", name)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateSessionManagerPreferences", "Created Session Manager preferences document %q", name)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get Session Manager preferences document %q", name)
+	}
+
+	var current sessionPreferences
+	if err := json.Unmarshal([]byte(aws.StringValue(out.Content)), &current); err == nil && current == desired {
+		return nil
+	}
+
+	updated, err := s.SSMClient.UpdateDocumentWithContext(context.TODO(), &ssm.UpdateDocumentInput{
+		Name:            aws.String(name),
+		Content:         aws.String(string(content)),
+		DocumentFormat:  aws.String(ssm.DocumentFormatJson),
+		DocumentVersion: aws.String("$LATEST"),
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedUpdateSessionManagerPreferences", "Failed to update Session Manager preferences document %q: %v", name, err)
+		return errors.Wrapf(err, "failed to update Session Manager preferences document %q", name)
+	}
+	_, err = s.SSMClient.UpdateDocumentDefaultVersionWithContext(context.TODO(), &ssm.UpdateDocumentDefaultVersionInput{
+		Name:            aws.String(name),
+		DocumentVersion: updated.DocumentDescription.DocumentVersion,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to set the default version of Session Manager preferences document %q", name)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulUpdateSessionManagerPreferences", "Updated Session Manager preferences document %q", name)
+	return nil
+}
+
+// DeleteSessionManagerPreferences deletes the Session Manager preferences document with the given name, if it was
+// created for the cluster. The documents which existed before are left as they are.
+func (s *Service) DeleteSessionManagerPreferences(name string) error {
+	out, err := s.SSMClient.ListTagsForResourceWithContext(context.TODO(), &ssm.ListTagsForResourceInput{
+		ResourceType: aws.String(ssm.ResourceTypeForTaggingDocument),
+		ResourceId:   aws.String(name),
+	})
+	if isDocumentNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to list the tags of Session Manager preferences document %q", name)
+	}
+	if converters.SSMTagsToMap(out.TagList)[infrav1.ClusterTagKey(s.scope.Name())] != string(infrav1.ResourceLifecycleOwned) {
+		s.scope.Debug("Session Manager preferences document is not owned by the cluster, leaving it as is", "document", name)
+		return nil
+	}
+
+	_, err = s.SSMClient.DeleteDocumentWithContext(context.TODO(), &ssm.DeleteDocumentInput{
+		Name: aws.String(name),
+	})
+	if err != nil && !isDocumentNotFound(err) {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteSessionManagerPreferences", "Failed to delete Session Manager preferences document %q: %v", name, err)
+		return errors.Wrapf(err, "failed to delete Session Manager preferences document %q", name)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteSessionManagerPreferences", "Deleted Session Manager preferences document %q", name)
+	return nil
+}
+
+func (s *Service) sessionPreferences(preferences *infrav1.SessionManager) sessionPreferences {
+	inputs := sessionPreferencesInputs{
+		KMSKeyID: preferences.KMSKeyID,
+	}
+	if preferences.S3Logs != nil {
+		inputs.S3BucketName = preferences.S3Logs.BucketName
+		inputs.S3KeyPrefix = preferences.S3Logs.KeyPrefix
+		inputs.S3EncryptionEnabled = preferences.S3Logs.EncryptionEnabled
+	}
+	if preferences.CloudWatchLogs != nil {
+		inputs.CloudWatchLogGroupName = preferences.CloudWatchLogs.LogGroupName
+		inputs.CloudWatchEncryptionEnabled = preferences.CloudWatchLogs.EncryptionEnabled
+		inputs.CloudWatchStreamingEnabled = preferences.CloudWatchLogs.Streaming
+	}
+	if preferences.IdleSessionTimeoutMinutes != 0 {
+		inputs.IdleSessionTimeout = strconv.Itoa(int(preferences.IdleSessionTimeoutMinutes))
+	}
+
+	return sessionPreferences{
+		SchemaVersion: "1.0",
+		Description:   "Session Manager preferences of cluster " + s.scope.Name(),
+		SessionType:   "Standard_Stream",
+		Inputs:        inputs,
+	}
+}
+
+func (s *Service) getSessionManagerTagParams(name string) infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+func isDocumentNotFound(err error) bool {
+	if code, ok := awserrors.Code(err); ok {
+		return code == ssm.ErrCodeInvalidDocument || code == ssm.ErrCodeInvalidResourceId
+	}
+	return false
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ssm/mock_ssmiface"
+)
+
+func TestServiceReconcileSessionManagerPreferences(t *testing.T) {
+	preferences := &infrav1.SessionManager{
+		KMSKeyID: "alias/sessions",
+		S3Logs: &infrav1.SessionManagerS3Logs{
+			BucketName:        "session-logs",
+			KeyPrefix:         "test",
+			EncryptionEnabled: true,
+		},
+		CloudWatchLogs: &infrav1.SessionManagerCloudWatchLogs{
+			LogGroupName: "/aws/ssm/sessions",
+		},
+		IdleSessionTimeoutMinutes: 15,
+	}
+	wantContent := sessionPreferences{
+		SchemaVersion: "1.0",
+		Description:   "Session Manager preferences of cluster test",
+		SessionType:   "Standard_Stream",
+		Inputs: sessionPreferencesInputs{
+			S3BucketName:           "session-logs",
+			S3KeyPrefix:            "test",
+			S3EncryptionEnabled:    true,
+			CloudWatchLogGroupName: "/aws/ssm/sessions",
+			KMSKeyID:               "alias/sessions",
+			IdleSessionTimeout:     "15",
+		},
+	}
+	marshal := func(content sessionPreferences) string {
+		out, _ := json.Marshal(content)
+		return string(out)
+	}
+	getDocument := func(m *mock_ssmiface.MockSSMAPIMockRecorder) *gomock.Call {
+		return m.GetDocumentWithContext(context.TODO(), gomock.Eq(&ssm.GetDocumentInput{
+			Name: aws.String(infrav1.SessionManagerDefaultDocumentName),
+		}))
+	}
+
+	tests := []struct {
+		name   string
+		expect func(m *mock_ssmiface.MockSSMAPIMockRecorder)
+	}{
+		{
+			name: "creates the preferences document",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				getDocument(m).Return(nil, awserr.New(ssm.ErrCodeInvalidDocument, "not found", nil))
+				m.CreateDocumentWithContext(context.TODO(), gomock.Eq(&ssm.CreateDocumentInput{
+					Name:           aws.String(infrav1.SessionManagerDefaultDocumentName),
+					Content:        aws.String(marshal(wantContent)),
+					DocumentType:   aws.String(ssm.DocumentTypeSession),
+					DocumentFormat: aws.String(ssm.DocumentFormatJson),
+					Tags: []*ssm.Tag{
+						{Key: aws.String("Name"), Value: aws.String(infrav1.SessionManagerDefaultDocumentName)},
+						{Key: aws.String(infrav1.ClusterTagKey("test")), Value: aws.String(string(infrav1.ResourceLifecycleOwned))},
+					},
+				})).Return(&ssm.CreateDocumentOutput{}, nil)
+			},
+		},
+		{
+			name: "does not change an up to date document",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				getDocument(m).Return(&ssm.GetDocumentOutput{Content: aws.String(marshal(wantContent))}, nil)
+			},
+		},
+		{
+			name: "updates an outdated document and makes the new version the default",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				getDocument(m).Return(&ssm.GetDocumentOutput{Content: aws.String(`{"schemaVersion":"1.0","sessionType":"Standard_Stream","inputs":{"s3BucketName":""}}`)}, nil)
+				m.UpdateDocumentWithContext(context.TODO(), gomock.Eq(&ssm.UpdateDocumentInput{
+					Name:            aws.String(infrav1.SessionManagerDefaultDocumentName),
+					Content:         aws.String(marshal(wantContent)),
+					DocumentFormat:  aws.String(ssm.DocumentFormatJson),
+					DocumentVersion: aws.String("$LATEST"),
+				})).Return(&ssm.UpdateDocumentOutput{DocumentDescription: &ssm.DocumentDescription{DocumentVersion: aws.String("3")}}, nil)
+				m.UpdateDocumentDefaultVersionWithContext(context.TODO(), gomock.Eq(&ssm.UpdateDocumentDefaultVersionInput{
+					Name:            aws.String(infrav1.SessionManagerDefaultDocumentName),
+					DocumentVersion: aws.String("3"),
+				})).Return(&ssm.UpdateDocumentDefaultVersionOutput{}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+			tt.expect(ssmMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := getClusterScope(fake.NewClientBuilder().WithScheme(scheme).Build())
+			g.Expect(err).NotTo(HaveOccurred())
+			s := NewService(clusterScope)
+			s.SSMClient = ssmMock
+
+			g.Expect(s.ReconcileSessionManagerPreferences(preferences)).To(Succeed())
+		})
+	}
+}
+
+func TestServiceDeleteSessionManagerPreferences(t *testing.T) {
+	listTags := func(m *mock_ssmiface.MockSSMAPIMockRecorder) *gomock.Call {
+		return m.ListTagsForResourceWithContext(context.TODO(), gomock.Eq(&ssm.ListTagsForResourceInput{
+			ResourceType: aws.String(ssm.ResourceTypeForTaggingDocument),
+			ResourceId:   aws.String("preferences"),
+		}))
+	}
+
+	tests := []struct {
+		name   string
+		expect func(m *mock_ssmiface.MockSSMAPIMockRecorder)
+	}{
+		{
+			name: "deletes an owned document",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				listTags(m).Return(&ssm.ListTagsForResourceOutput{TagList: []*ssm.Tag{
+					{Key: aws.String(infrav1.ClusterTagKey("test")), Value: aws.String(string(infrav1.ResourceLifecycleOwned))},
+				}}, nil)
+				m.DeleteDocumentWithContext(context.TODO(), gomock.Eq(&ssm.DeleteDocumentInput{Name: aws.String("preferences")})).
+					Return(&ssm.DeleteDocumentOutput{}, nil)
+			},
+		},
+		{
+			name: "leaves a document which existed before as it is",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				listTags(m).Return(&ssm.ListTagsForResourceOutput{}, nil)
+			},
+		},
+		{
+			name: "does nothing if the document is already deleted",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				listTags(m).Return(nil, awserr.New(ssm.ErrCodeInvalidResourceId, "not found", nil))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+			tt.expect(ssmMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := getClusterScope(fake.NewClientBuilder().WithScheme(scheme).Build())
+			g.Expect(err).NotTo(HaveOccurred())
+			s := NewService(clusterScope)
+			s.SSMClient = ssmMock
+
+			g.Expect(s.DeleteSessionManagerPreferences("preferences")).To(Succeed())
+		})
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+const (
+	acceleratorBootScript = `#!/bin/bash
+# Prepares the accelerators of the instance before the node is bootstrapped.
+# As a boothook, this script runs on every boot.
+set -o errexit
+set -o nounset
+set -o pipefail
+{{- if .HugePages }}
+
+# Reserve 2Mi huge pages.
+echo "vm.nr_hugepages = {{ .HugePages }}" > /etc/sysctl.d/90-capa-hugepages.conf
+sysctl -w vm.nr_hugepages={{ .HugePages }}
+{{- end }}
+{{- if .EFA }}
+
+# Load the EFA driver, and lift the locked memory limit required by EFA workloads.
+modprobe efa
+mkdir -p /etc/systemd/system/containerd.service.d
+cat > /etc/systemd/system/containerd.service.d/90-capa-memlock.conf <<EOF
+[Service]
+LimitMEMLOCK=infinity
+EOF
+systemctl daemon-reload
+systemctl try-restart containerd.service
+{{- end }}
+
+# Load the Neuron driver used by the Neuron device plugin on Inferentia and Trainium instances.
+if modinfo neuron > /dev/null 2>&1; then
+  modprobe neuron
+fi
+`
+)
+
+// AcceleratorInput defines the context to generate the boot script of an accelerated instance.
+type AcceleratorInput struct {
+	EFA       bool
+	HugePages int32
+}
+
+// NewAccelerator returns the boot script preparing the accelerators of an instance.
+func NewAccelerator(input *AcceleratorInput) (string, error) {
+	return generate("accelerator", acceleratorBootScript, input)
+}
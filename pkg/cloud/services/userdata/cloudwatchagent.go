@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+const (
+	cloudWatchAgentBootScript = `#!/bin/bash
+# Ships the kubelet, containerd and cloud-init logs of the instance to CloudWatch Logs.
+# As a boothook, this script runs on every boot. The CloudWatch agent is installed in the
+# background, so that it does not delay the bootstrap of the node.
+set -o errexit
+set -o nounset
+set -o pipefail
+
+mkdir -p /etc/capa /var/log/capa
+
+# The CloudWatch agent does not read the journal: export the journal of the kubelet and
+# containerd units to files, rotated by logrotate.
+cat > /etc/systemd/system/capa-journal-export@.service <<'EOF'
+[Unit]
+Description=Export the journal of the %i unit to /var/log/capa/%i.log
+After=systemd-journald.service
+
+[Service]
+ExecStart=/bin/sh -c 'exec journalctl --boot --follow --lines=all --unit=%i.service --output=short-iso >> /var/log/capa/%i.log'
+Restart=always
+RestartSec=5
+EOF
+cat > /etc/logrotate.d/capa-journal-export <<'EOF'
+/var/log/capa/*.log {
+  daily
+  rotate 1
+  maxsize 100M
+  missingok
+  notifempty
+  copytruncate
+}
+EOF
+
+cat > /etc/capa/cloudwatch-agent.json <<'EOF'
+{
+  "agent": {
+    "run_as_user": "root"
+  },
+  "logs": {
+    "logs_collected": {
+      "files": {
+        "collect_list": [
+          {"file_path": "/var/log/capa/kubelet.log", "log_group_name": "{{ .LogGroup }}", "log_stream_name": "{instance_id}/kubelet"},
+          {"file_path": "/var/log/capa/containerd.log", "log_group_name": "{{ .LogGroup }}", "log_stream_name": "{instance_id}/containerd"},
+          {"file_path": "/var/log/cloud-init.log", "log_group_name": "{{ .LogGroup }}", "log_stream_name": "{instance_id}/cloud-init"},
+          {"file_path": "/var/log/cloud-init-output.log", "log_group_name": "{{ .LogGroup }}", "log_stream_name": "{instance_id}/cloud-init-output"}
+        ]
+      }
+    }
+  }
+}
+EOF
+
+cat > /usr/local/bin/capa-cloudwatch-agent <<'EOF'
+#!/bin/bash
+set -o errexit
+set -o nounset
+set -o pipefail
+
+ctl=/opt/aws/amazon-cloudwatch-agent/bin/amazon-cloudwatch-agent-ctl
+if [ ! -x "${ctl}" ]; then
+  case "$(uname -m)" in
+    aarch64) arch=arm64 ;;
+    *) arch=amd64 ;;
+  esac
+  base=https://amazoncloudwatch-agent-{{ .Region }}.s3.{{ .Region }}.amazonaws.com
+  if command -v dpkg > /dev/null 2>&1; then
+    curl --fail --silent --show-error --location --retry 5 --output /tmp/amazon-cloudwatch-agent.deb "${base}/ubuntu/${arch}/latest/amazon-cloudwatch-agent.deb"
+    dpkg --install /tmp/amazon-cloudwatch-agent.deb
+    rm -f /tmp/amazon-cloudwatch-agent.deb
+  else
+    curl --fail --silent --show-error --location --retry 5 --output /tmp/amazon-cloudwatch-agent.rpm "${base}/amazon_linux/${arch}/latest/amazon-cloudwatch-agent.rpm"
+    rpm --upgrade --replacepkgs /tmp/amazon-cloudwatch-agent.rpm
+    rm -f /tmp/amazon-cloudwatch-agent.rpm
+  fi
+fi
+"${ctl}" -a fetch-config -m ec2 -s -c file:/etc/capa/cloudwatch-agent.json
+EOF
+chmod 0755 /usr/local/bin/capa-cloudwatch-agent
+cat > /etc/systemd/system/capa-cloudwatch-agent.service <<'EOF'
+[Unit]
+Description=Install and configure the Amazon CloudWatch agent
+Wants=network-online.target
+After=network-online.target
+
+[Service]
+Type=oneshot
+ExecStart=/usr/local/bin/capa-cloudwatch-agent
+Restart=on-failure
+RestartSec=30
+EOF
+
+systemctl daemon-reload
+systemctl start --no-block capa-journal-export@kubelet.service capa-journal-export@containerd.service capa-cloudwatch-agent.service
+`
+)
+
+// CloudWatchAgentInput defines the context to generate the boot script shipping the logs of an instance to CloudWatch Logs.
+type CloudWatchAgentInput struct {
+	LogGroup string
+	Region   string
+}
+
+// NewCloudWatchAgent returns the boot script installing the CloudWatch agent, and shipping the logs
+// of the instance to the given log group.
+func NewCloudWatchAgent(input *CloudWatchAgentInput) (string, error) {
+	return generate("cloudwatchagent", cloudWatchAgentBootScript, input)
+}
@@ -22,6 +22,8 @@ import (
 	"text/template"
 
 	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/mime"
 )
 
 const (
@@ -71,3 +73,13 @@ func generate(kind, tpl string, data interface{}) (string, error) {
 
 	return out.String(), nil
 }
+
+// PrependBoothooks returns the given cloud-config or shell script user data, preceded by the given
+// boot scripts, such as the ones returned by NewAccelerator and NewCloudWatchAgent.
+func PrependBoothooks(userData []byte, scripts ...string) ([]byte, error) {
+	boothooks := make([][]byte, 0, len(scripts))
+	for _, script := range scripts {
+		boothooks = append(boothooks, []byte(script))
+	}
+	return mime.PrependBoothooks(boothooks, userData)
+}
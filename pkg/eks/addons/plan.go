@@ -51,9 +51,9 @@ type plan struct {
 func (a *plan) Create(_ context.Context) ([]planner.Procedure, error) {
 	procedures := []planner.Procedure{}
 
-	// Handle create and update
-	for i := range a.desiredAddons {
-		desired := a.desiredAddons[i]
+	// Handle create and update, the dependencies of an addon first so that
+	// they are active when the addon is created or updated
+	for _, desired := range a.orderedDesiredAddons() {
 		installed := a.getInstalled(*desired.Name)
 		if installed == nil {
 			// Need to add the addon
@@ -95,6 +95,33 @@ func (a *plan) Create(_ context.Context) ([]planner.Procedure, error) {
 	return procedures, nil
 }
 
+// orderedDesiredAddons returns the desired addons, each one after the addons it depends on
+// and otherwise in the order they are desired in. Dependencies on addons that are not desired
+// and dependency cycles are ignored.
+func (a *plan) orderedDesiredAddons() []*EKSAddon {
+	ordered := make([]*EKSAddon, 0, len(a.desiredAddons))
+	visited := map[string]bool{}
+
+	var visit func(desired *EKSAddon)
+	visit = func(desired *EKSAddon) {
+		if visited[*desired.Name] {
+			return
+		}
+		visited[*desired.Name] = true
+		for _, dependency := range desired.DependsOn {
+			if dependencyAddon := a.getDesired(dependency); dependencyAddon != nil {
+				visit(dependencyAddon)
+			}
+		}
+		ordered = append(ordered, desired)
+	}
+	for i := range a.desiredAddons {
+		visit(a.desiredAddons[i])
+	}
+
+	return ordered
+}
+
 func (a *plan) getInstalled(name string) *EKSAddon {
 	for i := range a.installedAddons {
 		installed := a.installedAddons[i]
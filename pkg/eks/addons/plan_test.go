@@ -406,6 +406,44 @@ func TestEKSAddonPlan(t *testing.T) {
 	}
 }
 
+func TestEKSAddonPlanDependencyOrder(t *testing.T) {
+	coredns := createDesiredAddon("coredns", "v1.11.1-eksbuild.4")
+	coredns.DependsOn = []string{"vpc-cni", "kube-proxy"}
+	kubeProxy := createDesiredAddon("kube-proxy", "v1.29.0-eksbuild.1")
+	vpcCni := createDesiredAddon("vpc-cni", "v1.18.0-eksbuild.1")
+	vpcCni.DependsOn = []string{"not-desired"}
+
+	testCases := []struct {
+		name          string
+		desiredAddons []*EKSAddon
+		expect        []string
+	}{
+		{
+			name:          "no dependencies keeps the desired order",
+			desiredAddons: []*EKSAddon{kubeProxy, createDesiredAddon("addon1", "1.0.0")},
+			expect:        []string{"kube-proxy", "addon1"},
+		},
+		{
+			name:          "dependencies first",
+			desiredAddons: []*EKSAddon{coredns, kubeProxy, vpcCni},
+			expect:        []string{"vpc-cni", "kube-proxy", "coredns"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			p := &plan{desiredAddons: tc.desiredAddons}
+			names := []string{}
+			for _, addon := range p.orderedDesiredAddons() {
+				names = append(names, *addon.Name)
+			}
+			g.Expect(names).To(Equal(tc.expect))
+		})
+	}
+}
+
 func createTags() infrav1.Tags {
 	tags := infrav1.Tags{}
 	tags["tag1"] = "val1"
@@ -32,6 +32,8 @@ type EKSAddon struct {
 	ResolveConflict       *string
 	ARN                   *string
 	Status                *string
+	// DependsOn is the names of the addons to create or update before this one.
+	DependsOn []string
 }
 
 // IsEqual determines if 2 EKSAddon are equal.
@@ -97,6 +97,70 @@ var (
 	block = "2001:db8:1234:1a00::/56"
 )
 
+func TestAllocateSubnetsIPv4(t *testing.T) {
+	tests := []struct {
+		name          string
+		cidrblock     string
+		prefixLengths []int
+		expected      []string
+		expectErr     bool
+	}{
+		{
+			name:          "subnets of the same size are allocated in order",
+			cidrblock:     "10.0.0.0/16",
+			prefixLengths: []int{24, 24, 24},
+			expected:      []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"},
+		},
+		{
+			name:          "largest subnets are allocated first",
+			cidrblock:     "10.0.0.0/16",
+			prefixLengths: []int{24, 20, 24, 20, 21},
+			expected:      []string{"10.0.40.0/24", "10.0.0.0/20", "10.0.41.0/24", "10.0.16.0/20", "10.0.32.0/21"},
+		},
+		{
+			name:          "subnets filling the whole cidr block",
+			cidrblock:     "10.0.0.0/24",
+			prefixLengths: []int{25, 26, 26},
+			expected:      []string{"10.0.0.0/25", "10.0.0.128/26", "10.0.0.192/26"},
+		},
+		{
+			name:          "subnets exceeding the cidr block",
+			cidrblock:     "10.0.0.0/24",
+			prefixLengths: []int{25, 25, 26},
+			expectErr:     true,
+		},
+		{
+			name:          "subnet larger than the cidr block",
+			cidrblock:     "10.0.0.0/24",
+			prefixLengths: []int{23},
+			expectErr:     true,
+		},
+		{
+			name:          "invalid cidr block",
+			cidrblock:     "10.0.0.0",
+			prefixLengths: []int{24},
+			expectErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			output, err := AllocateSubnetsIPv4(tc.cidrblock, tc.prefixLengths)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			subnets := make([]string, 0, len(output))
+			for _, subnet := range output {
+				subnets = append(subnets, subnet.String())
+			}
+			g.Expect(subnets).To(Equal(tc.expected))
+		})
+	}
+}
+
 func TestParseIPv4CIDR(t *testing.T) {
 	RegisterTestingT(t)
 
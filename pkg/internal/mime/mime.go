@@ -19,6 +19,7 @@ package mime
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"html/template"
 	"mime/multipart"
@@ -39,6 +40,14 @@ var (
 		"content-type": {"text/cloud-boothook"},
 	}
 
+	cloudConfigType = textproto.MIMEHeader{
+		"content-type": {"text/cloud-config"},
+	}
+
+	shellScriptType = textproto.MIMEHeader{
+		"content-type": {"text/x-shellscript"},
+	}
+
 	multipartHeader = strings.Join([]string{
 		"MIME-Version: 1.0",
 		"Content-Type: multipart/mixed; boundary=\"%s\"",
@@ -99,3 +108,52 @@ func GenerateInitDocument(secretPrefix string, chunks int32, region string, endp
 
 	return buf.Bytes(), nil
 }
+
+// PrependBoothook returns a multipart MIME document running the given boothook
+// script before the given cloud-config or shell script user data.
+func PrependBoothook(boothook []byte, userData []byte) ([]byte, error) {
+	return PrependBoothooks([][]byte{boothook}, userData)
+}
+
+// PrependBoothooks returns a multipart MIME document running the given boothook
+// scripts, in order, before the given cloud-config or shell script user data.
+func PrependBoothooks(boothooks [][]byte, userData []byte) ([]byte, error) {
+	var userDataType textproto.MIMEHeader
+	switch {
+	case bytes.HasPrefix(userData, []byte("#cloud-config")):
+		userDataType = cloudConfigType
+	case bytes.HasPrefix(userData, []byte("#!")):
+		userDataType = shellScriptType
+	default:
+		return []byte{}, errors.New("only cloud-config and shell script user data can be prepended with a boothook")
+	}
+
+	var buf bytes.Buffer
+	mpWriter := multipart.NewWriter(&buf)
+	buf.WriteString(fmt.Sprintf(multipartHeader, mpWriter.Boundary()))
+
+	type part struct {
+		header  textproto.MIMEHeader
+		content []byte
+	}
+	parts := make([]part, 0, len(boothooks)+1)
+	for _, boothook := range boothooks {
+		parts = append(parts, part{header: boothookType, content: boothook})
+	}
+	parts = append(parts, part{header: userDataType, content: userData})
+	for _, part := range parts {
+		partWriter, err := mpWriter.CreatePart(part.header)
+		if err != nil {
+			return []byte{}, err
+		}
+		if _, err := partWriter.Write(part.content); err != nil {
+			return []byte{}, err
+		}
+	}
+
+	if err := mpWriter.Close(); err != nil {
+		return []byte{}, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -18,6 +18,9 @@ package mime
 
 import (
 	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
 	"net/mail"
 	"testing"
 )
@@ -30,3 +33,116 @@ func TestGenerateInitDocument(t *testing.T) {
 		t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
 	}
 }
+
+func TestPrependBoothook(t *testing.T) {
+	testCases := []struct {
+		name            string
+		userData        string
+		wantContentType string
+		wantErr         bool
+	}{
+		{
+			name:            "cloud-config user data",
+			userData:        "#cloud-config\nruncmd: []\n",
+			wantContentType: "text/cloud-config",
+		},
+		{
+			name:            "shell script user data",
+			userData:        "#!/bin/bash\necho hello\n",
+			wantContentType: "text/x-shellscript",
+		},
+		{
+			name:     "unsupported user data",
+			userData: "{\"ignition\":{}}",
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := PrependBoothook([]byte("#!/bin/bash\nmodprobe efa\n"), []byte(tc.userData))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			msg, err := mail.ReadMessage(bytes.NewBuffer(doc))
+			if err != nil {
+				t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
+			}
+			_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+			if err != nil {
+				t.Fatalf("Cannot parse MIME doc content type: %v", err)
+			}
+
+			reader := multipart.NewReader(msg.Body, params["boundary"])
+			boothook, err := reader.NextPart()
+			if err != nil {
+				t.Fatalf("Cannot read boothook part: %v", err)
+			}
+			if contentType := boothook.Header.Get("Content-Type"); contentType != "text/cloud-boothook" {
+				t.Fatalf("expected the first part to be a boothook, got %q", contentType)
+			}
+			userData, err := reader.NextPart()
+			if err != nil {
+				t.Fatalf("Cannot read user data part: %v", err)
+			}
+			if contentType := userData.Header.Get("Content-Type"); contentType != tc.wantContentType {
+				t.Fatalf("expected the user data content type to be %q, got %q", tc.wantContentType, contentType)
+			}
+			content, err := io.ReadAll(userData)
+			if err != nil {
+				t.Fatalf("Cannot read user data content: %v", err)
+			}
+			if string(content) != tc.userData {
+				t.Fatalf("expected the user data to be preserved, got %q", string(content))
+			}
+		})
+	}
+}
+
+func TestPrependBoothooks(t *testing.T) {
+	boothooks := []string{"#!/bin/bash\nmodprobe efa\n", "#!/bin/bash\nsystemctl start --no-block capa-cloudwatch-agent.service\n"}
+	userData := "#cloud-config\nruncmd: []\n"
+
+	doc, err := PrependBoothooks([][]byte{[]byte(boothooks[0]), []byte(boothooks[1])}, []byte(userData))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewBuffer(doc))
+	if err != nil {
+		t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
+	}
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("Cannot parse MIME doc content type: %v", err)
+	}
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	for i, want := range append(boothooks, userData) {
+		part, err := reader.NextPart()
+		if err != nil {
+			t.Fatalf("Cannot read part %d: %v", i, err)
+		}
+		wantContentType := "text/cloud-boothook"
+		if i == len(boothooks) {
+			wantContentType = "text/cloud-config"
+		}
+		if contentType := part.Header.Get("Content-Type"); contentType != wantContentType {
+			t.Fatalf("expected part %d to be %q, got %q", i, wantContentType, contentType)
+		}
+		content, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("Cannot read part %d content: %v", i, err)
+		}
+		if string(content) != want {
+			t.Fatalf("expected part %d to be %q, got %q", i, want, string(content))
+		}
+	}
+}
@@ -0,0 +1,49 @@
+#!/bin/bash
+
+# Copyright 2026 The Kubernetes Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Runs the integration tests of the cloud services against LocalStack. LocalStack is started with Docker, unless it
+# already listens on LOCALSTACK_ENDPOINT.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+REPO_ROOT=$(dirname "${BASH_SOURCE[0]}")/..
+LOCALSTACK_IMAGE=${LOCALSTACK_IMAGE:-localstack/localstack:3.8}
+LOCALSTACK_ENDPOINT=${LOCALSTACK_ENDPOINT:-http://localhost:4566}
+LOCALSTACK_TEST_PACKAGES=${LOCALSTACK_TEST_PACKAGES:-./pkg/cloud/services/...}
+export LOCALSTACK_ENDPOINT
+
+if ! curl -fsS "${LOCALSTACK_ENDPOINT}/_localstack/health" >/dev/null 2>&1; then
+  port=${LOCALSTACK_ENDPOINT##*:}
+  container=$(docker run -d --rm -p "${port}:4566" "${LOCALSTACK_IMAGE}")
+  trap 'docker stop "${container}" >/dev/null' EXIT
+
+  echo "Waiting for LocalStack to be ready"
+  for _ in $(seq 1 60); do
+    if curl -fsS "${LOCALSTACK_ENDPOINT}/_localstack/health" >/dev/null 2>&1; then
+      break
+    fi
+    sleep 2
+  done
+fi
+
+# LocalStack accepts any credentials, they must not be real ones.
+export AWS_ACCESS_KEY_ID=test
+export AWS_SECRET_ACCESS_KEY=test
+unset AWS_SESSION_TOKEN AWS_PROFILE
+
+cd "${REPO_ROOT}" && go test -tags localstack -run LocalStack -v ${LOCALSTACK_TEST_PACKAGES}
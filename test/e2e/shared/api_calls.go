@@ -0,0 +1,238 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// apiCallsReportEntry is the name of the report entry summarizing the AWS API calls of a spec.
+	apiCallsReportEntry = "aws-api-calls"
+	// capaMetricsPort is the port the CAPA controller serves its metrics on, over HTTP as the e2e config sets
+	// CAPA_INSECURE_DIAGNOSTICS.
+	capaMetricsPort = "8443"
+)
+
+// apiRequestMetrics are the metrics counting the AWS API requests of the CAPA controller, for the AWS SDK v1 and v2
+// clients.
+var apiRequestMetrics = []string{"aws_api_requests_total", "aws_api_requests_total_v2"}
+
+// throttlingErrorCodes are the error codes the AWS APIs return when they throttle a request.
+var throttlingErrorCodes = map[string]bool{
+	"Throttling":                             true,
+	"ThrottlingException":                    true,
+	"ThrottledException":                     true,
+	"RequestLimitExceeded":                   true,
+	"RequestThrottled":                       true,
+	"RequestThrottledException":              true,
+	"TooManyRequestsException":               true,
+	"ProvisionedThroughputExceededException": true,
+	"SlowDown":                               true,
+}
+
+// APICallCount is the number of AWS API calls of an operation, and how many of them were throttled.
+type APICallCount struct {
+	Service   string `json:"service"`
+	Operation string `json:"operation"`
+	Calls     int    `json:"calls"`
+	Throttled int    `json:"throttled,omitempty"`
+}
+
+// APICallReport is the AWS API calls made by the CAPA controller during a spec.
+type APICallReport struct {
+	Spec       string         `json:"spec"`
+	Calls      int            `json:"calls"`
+	Throttled  int            `json:"throttled"`
+	Operations []APICallCount `json:"operations"`
+}
+
+// apiCallCounters are the API call counters of the CAPA controller, keyed by service and operation.
+type apiCallCounters map[string]*APICallCount
+
+// apiCallSnapshots holds the counters of the CAPA controller when the spec of each namespace started.
+var apiCallSnapshots = struct {
+	sync.Mutex
+	byNamespace map[string]apiCallCounters
+}{byNamespace: map[string]apiCallCounters{}}
+
+// startAPICallTracking records the API call counters of the CAPA controller when the spec of the namespace starts.
+func startAPICallTracking(ctx context.Context, e2eCtx *E2EContext, namespace *corev1.Namespace) {
+	counters, err := scrapeAPICalls(ctx, e2eCtx)
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't scrape the AWS API calls of the CAPA controller: err=%s\n", err)
+		return
+	}
+	apiCallSnapshots.Lock()
+	defer apiCallSnapshots.Unlock()
+	apiCallSnapshots.byNamespace[namespace.Name] = counters
+}
+
+// reportAPICalls reports the AWS API calls made by the CAPA controller since the spec of the namespace started, as a
+// report entry of the spec and in aws-api-calls/<namespace>.yaml in the artifacts folder. As the specs of the ginkgo
+// nodes run concurrently against the same controller, the report includes the calls made for the other specs running
+// at the same time.
+func reportAPICalls(ctx context.Context, e2eCtx *E2EContext, namespace *corev1.Namespace) {
+	apiCallSnapshots.Lock()
+	start, ok := apiCallSnapshots.byNamespace[namespace.Name]
+	delete(apiCallSnapshots.byNamespace, namespace.Name)
+	apiCallSnapshots.Unlock()
+	if !ok {
+		return
+	}
+
+	end, err := scrapeAPICalls(ctx, e2eCtx)
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't scrape the AWS API calls of the CAPA controller: err=%s\n", err)
+		return
+	}
+
+	report := APICallReport{Spec: CurrentSpecReport().FullText()}
+	report.Calls, report.Throttled, report.Operations = end.since(start)
+
+	AddReportEntry(apiCallsReportEntry, fmt.Sprintf("%d AWS API calls, %d throttled", report.Calls, report.Throttled))
+
+	data, err := yaml.Marshal(report)
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't marshal the AWS API calls report: err=%s\n", err)
+		return
+	}
+	reportPath := filepath.Join(e2eCtx.Settings.ArtifactFolder, apiCallsReportEntry, namespace.Name+".yaml")
+	if err := os.MkdirAll(filepath.Dir(reportPath), 0o750); err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't create directory: path=%q, err=%s\n", reportPath, err)
+		return
+	}
+	if err := os.WriteFile(reportPath, data, 0o600); err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't write file: path=%q, err=%s\n", reportPath, err)
+	}
+}
+
+// scrapeAPICalls returns the API call counters of the CAPA controller pods, summed by service and operation.
+func scrapeAPICalls(ctx context.Context, e2eCtx *E2EContext) (apiCallCounters, error) {
+	podMetrics, err := scrapeControllerMetrics(ctx, e2eCtx)
+	if err != nil {
+		return nil, err
+	}
+	return apiCallCountersOf(podMetrics), nil
+}
+
+// apiCallCountersOf returns the API call counters of the metrics of the CAPA controller pods.
+func apiCallCountersOf(podMetrics []map[string]*dto.MetricFamily) apiCallCounters {
+	counters := apiCallCounters{}
+	for _, families := range podMetrics {
+		for _, name := range apiRequestMetrics {
+			family, ok := families[name]
+			if !ok {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				counters.add(metric)
+			}
+		}
+	}
+	return counters
+}
+
+// scrapeControllerMetrics returns the metric families of each CAPA controller pod.
+func scrapeControllerMetrics(ctx context.Context, e2eCtx *E2EContext) ([]map[string]*dto.MetricFamily, error) {
+	clientSet := e2eCtx.Environment.BootstrapClusterProxy.GetClientSet()
+	pods, err := clientSet.CoreV1().Pods(capaNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "control-plane=capa-controller-manager",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing the CAPA controller pods: %w", err)
+	}
+
+	podMetrics := make([]map[string]*dto.MetricFamily, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		data, err := clientSet.CoreV1().Pods(capaNamespace).ProxyGet("http", pod.Name, capaMetricsPort, "/metrics", nil).DoRaw(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting the metrics of pod %s: %w", pod.Name, err)
+		}
+		families, err := new(expfmt.TextParser).TextToMetricFamilies(strings.NewReader(string(data)))
+		if err != nil {
+			return nil, fmt.Errorf("parsing the metrics of pod %s: %w", pod.Name, err)
+		}
+		podMetrics = append(podMetrics, families)
+	}
+	return podMetrics, nil
+}
+
+// since returns the total number of calls and of throttled calls made since the start counters, and the calls of each
+// operation sorted by decreasing number of calls.
+func (c apiCallCounters) since(start apiCallCounters) (int, int, []APICallCount) {
+	var calls, throttled int
+	var operations []APICallCount
+	for key, count := range c {
+		delta := *count
+		if before, ok := start[key]; ok {
+			delta.Calls -= before.Calls
+			delta.Throttled -= before.Throttled
+		}
+		// The counters restart from zero when the controller restarts in between.
+		if delta.Calls < 0 {
+			delta = *count
+		}
+		if delta.Calls == 0 {
+			continue
+		}
+		calls += delta.Calls
+		throttled += delta.Throttled
+		operations = append(operations, delta)
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		if operations[i].Calls != operations[j].Calls {
+			return operations[i].Calls > operations[j].Calls
+		}
+		return operations[i].Service+operations[i].Operation < operations[j].Service+operations[j].Operation
+	})
+	return calls, throttled, operations
+}
+
+func (c apiCallCounters) add(metric *dto.Metric) {
+	labels := map[string]string{}
+	for _, label := range metric.GetLabel() {
+		labels[label.GetName()] = label.GetValue()
+	}
+	key := labels["service"] + "/" + labels["operation"]
+	count, ok := c[key]
+	if !ok {
+		count = &APICallCount{Service: labels["service"], Operation: labels["operation"]}
+		c[key] = count
+	}
+	calls := int(metric.GetCounter().GetValue())
+	count.Calls += calls
+	if throttlingErrorCodes[labels["error_code"]] {
+		count.Throttled += calls
+	}
+}
@@ -0,0 +1,105 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/cluster-api/test/framework"
+)
+
+const controlPlaneNodeRoleLabel = "node-role.kubernetes.io/control-plane"
+
+// AWSMixedArchitectureSpecInput is the input for AWSMixedArchitectureSpec.
+type AWSMixedArchitectureSpecInput struct {
+	BootstrapClusterProxy framework.ClusterProxy
+	NamespaceName         string
+	ClusterName           string
+	// ControlPlaneArchitecture is the Kubernetes architecture of the control plane nodes, e.g. amd64.
+	ControlPlaneArchitecture string
+	// WorkerArchitecture is the Kubernetes architecture of the worker nodes, e.g. arm64.
+	WorkerArchitecture string
+}
+
+// AWSMixedArchitectureSpec implements a test that verifies that the control plane and worker nodes of a cluster, e.g.
+// an "arm64"-flavored CAPA cluster, run on instances of the expected architectures, launched from AMIs of the same
+// architectures.
+func AWSMixedArchitectureSpec(ctx context.Context, e2eCtx *E2EContext, input AWSMixedArchitectureSpecInput) {
+	specName := "aws-mixed-architecture"
+
+	Expect(input.NamespaceName).NotTo(BeEmpty(), "Invalid argument. input.NamespaceName can't be empty when calling %s spec", specName)
+	Expect(input.ClusterName).NotTo(BeEmpty(), "Invalid argument. input.ClusterName can't be empty when calling %s spec", specName)
+	Expect(input.ControlPlaneArchitecture).NotTo(BeEmpty(), "Invalid argument. input.ControlPlaneArchitecture can't be empty when calling %s spec", specName)
+	Expect(input.WorkerArchitecture).NotTo(BeEmpty(), "Invalid argument. input.WorkerArchitecture can't be empty when calling %s spec", specName)
+
+	ginkgo.By("creating a Kubernetes client to the workload cluster")
+	clusterProxy := input.BootstrapClusterProxy.GetWorkloadCluster(ctx, input.NamespaceName, input.ClusterName)
+	Expect(clusterProxy).NotTo(BeNil())
+	clientset := clusterProxy.GetClientSet()
+	Expect(clientset).NotTo(BeNil())
+
+	nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(nodes.Items).NotTo(BeEmpty())
+
+	ec2Svc := ec2.New(e2eCtx.AWSSession)
+	for _, node := range nodes.Items {
+		expected := input.WorkerArchitecture
+		if _, ok := node.Labels[controlPlaneNodeRoleLabel]; ok {
+			expected = input.ControlPlaneArchitecture
+		}
+
+		ginkgo.By(fmt.Sprintf("checking that node %s runs on %s", node.Name, expected))
+		Expect(node.Status.NodeInfo.Architecture).To(Equal(expected), "unexpected architecture for node %s", node.Name)
+		Expect(node.Labels[corev1.LabelArchStable]).To(Equal(expected), "unexpected architecture label for node %s", node.Name)
+
+		Expect(node.Spec.ProviderID).NotTo(BeEmpty(), "node %s has no provider ID", node.Name)
+		providerID := strings.Split(node.Spec.ProviderID, "/")
+		instanceID := providerID[len(providerID)-1]
+		instances, err := ec2Svc.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{InstanceIds: aws.StringSlice([]string{instanceID})})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instances.Reservations).To(HaveLen(1))
+		Expect(instances.Reservations[0].Instances).To(HaveLen(1))
+		instance := instances.Reservations[0].Instances[0]
+		Expect(aws.StringValue(instance.Architecture)).To(Equal(ec2Architecture(expected)), "unexpected architecture for instance %s of node %s", instanceID, node.Name)
+
+		images, err := ec2Svc.DescribeImagesWithContext(ctx, &ec2.DescribeImagesInput{ImageIds: []*string{instance.ImageId}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(images.Images).To(HaveLen(1))
+		Expect(aws.StringValue(images.Images[0].Architecture)).To(Equal(ec2Architecture(expected)), "unexpected architecture for AMI %s of node %s", aws.StringValue(instance.ImageId), node.Name)
+	}
+}
+
+// ec2Architecture returns the EC2 architecture matching a Kubernetes architecture.
+func ec2Architecture(architecture string) string {
+	if architecture == "amd64" {
+		return ec2.ArchitectureValuesX8664
+	}
+	return architecture
+}
@@ -369,9 +369,13 @@ func (i *AWSInfrastructure) DeleteInfrastructure() {
 }
 
 func NewAWSSession() client.ConfigProvider {
-	By("Getting an AWS IAM session - from environment")
 	region, err := credentials.ResolveRegion("")
 	Expect(err).NotTo(HaveOccurred())
+	return NewAWSSessionInRegion(region)
+}
+
+func NewAWSSessionInRegion(region string) client.ConfigProvider {
+	By("Getting an AWS IAM session - from environment")
 	config := aws.NewConfig().WithCredentialsChainVerboseErrors(true).WithRegion(region)
 	sess, err := session.NewSessionWithOptions(session.Options{
 		SharedConfigState: session.SharedConfigEnable,
@@ -383,11 +387,11 @@ func NewAWSSession() client.ConfigProvider {
 	return sess
 }
 
-func NewAWSSessionRepoWithKey(accessKey *iamtypes.AccessKey) client.ConfigProvider {
+func NewAWSSessionRepoWithKey(accessKey *iamtypes.AccessKey, region string) client.ConfigProvider {
 	By("Getting an AWS IAM session - from access key")
 	Expect(accessKey.AccessKeyId).NotTo(BeNil())
 	Expect(accessKey.SecretAccessKey).NotTo(BeNil())
-	config := aws.NewConfig().WithCredentialsChainVerboseErrors(true).WithRegion("us-east-1")
+	config := aws.NewConfig().WithCredentialsChainVerboseErrors(true).WithRegion(region)
 	config.Credentials = awscreds.NewStaticCredentials(*accessKey.AccessKeyId, *accessKey.SecretAccessKey, "")
 
 	sess, err := session.NewSessionWithOptions(session.Options{
@@ -400,11 +404,15 @@ func NewAWSSessionRepoWithKey(accessKey *iamtypes.AccessKey) client.ConfigProvid
 }
 
 func NewAWSSessionWithKey(accessKey *iamtypes.AccessKey) client.ConfigProvider {
+	region, err := credentials.ResolveRegion("")
+	Expect(err).NotTo(HaveOccurred())
+	return NewAWSSessionWithKeyInRegion(accessKey, region)
+}
+
+func NewAWSSessionWithKeyInRegion(accessKey *iamtypes.AccessKey, region string) client.ConfigProvider {
 	By("Getting an AWS IAM session - from access key")
 	Expect(accessKey.AccessKeyId).NotTo(BeNil())
 	Expect(accessKey.SecretAccessKey).NotTo(BeNil())
-	region, err := credentials.ResolveRegion("")
-	Expect(err).NotTo(HaveOccurred())
 	config := aws.NewConfig().WithCredentialsChainVerboseErrors(true).WithRegion(region)
 	config.Credentials = awscreds.NewStaticCredentials(*accessKey.AccessKeyId, *accessKey.SecretAccessKey, "")
 
@@ -431,10 +439,8 @@ func NewAWSSessionV2() *awsv2.Config {
 	return &cfg
 }
 
-func NewAWSSessionRepoWithKeyV2(accessKey *iamtypes.AccessKey) *awsv2.Config {
+func NewAWSSessionRepoWithKeyV2(accessKey *iamtypes.AccessKey, region string) *awsv2.Config {
 	By("Getting an AWS IAM session - from access key")
-	region, err := credentials.ResolveRegion("us-east-1")
-	Expect(err).NotTo(HaveOccurred())
 	staticCredProvider := awscredsv2.NewStaticCredentialsProvider(awsv2.ToString(accessKey.AccessKeyId), awsv2.ToString(accessKey.SecretAccessKey), "")
 	optFns := []func(*config.LoadOptions) error{
 		config.WithRegion(region),
@@ -771,7 +777,11 @@ func deleteCloudFormationStack(prov client.ConfigProvider, t *cfn_bootstrap.Temp
 }
 
 func ensureTestImageUploaded(e2eCtx *E2EContext) error {
-	sessionForRepo := NewAWSSessionRepoWithKey(e2eCtx.Environment.BootstrapAccessKey)
+	if e2eCtx.Partition.ECRPublicRegion == "" {
+		By(fmt.Sprintf("Skipping the upload of the test image, ECR Public is not available in partition %q", e2eCtx.Partition.Name))
+		return nil
+	}
+	sessionForRepo := NewAWSSessionRepoWithKey(e2eCtx.Environment.BootstrapAccessKey, e2eCtx.Partition.ECRPublicRegion)
 
 	ecrSvc := ecrpublic.New(sessionForRepo)
 	repoName := ""
@@ -973,19 +983,23 @@ func DumpCloudTrailEvents(e2eCtx *E2EContext) {
 // Kubernetes version in the e2econfig.
 func conformanceImageID(e2eCtx *E2EContext) string {
 	ver := e2eCtx.E2EConfig.MustGetVariable("CONFORMANCE_CI_ARTIFACTS_KUBERNETES_VERSION")
-	amiName := AMIPrefix + ver + "*"
+	amiName := e2eCtx.Settings.AMINamePattern(ver)
 
-	By(fmt.Sprintf("Searching for AMI: name=%s", amiName))
+	By(fmt.Sprintf("Searching for AMI: name=%s architecture=%s", amiName, e2eCtx.Settings.AMIArchitecture))
 	ec2Svc := ec2.New(e2eCtx.AWSSession)
 	filters := []*ec2.Filter{
 		{
 			Name:   aws.String("name"),
 			Values: []*string{aws.String(amiName)},
 		},
+		{
+			Name:   aws.String("architecture"),
+			Values: []*string{aws.String(e2eCtx.Settings.AMIArchitecture)},
+		},
 	}
 	filters = append(filters, &ec2.Filter{
 		Name:   aws.String("owner-id"),
-		Values: []*string{aws.String(DefaultImageLookupOrg)},
+		Values: []*string{aws.String(e2eCtx.Partition.ImageLookupOrg)},
 	})
 	resp, err := ec2Svc.DescribeImages(&ec2.DescribeImagesInput{
 		Filters: filters,
@@ -1013,29 +1027,86 @@ type ServiceQuota struct {
 	RequestStatus       string
 }
 
-func EnsureServiceQuotas(sess client.ConfigProvider) (map[string]*ServiceQuota, map[string]*servicequotas.ServiceQuota) {
+// Service quota increase modes.
+const (
+	// ServiceQuotaIncreaseNone does not request the increase of the service quotas below their desired minimum value.
+	ServiceQuotaIncreaseNone = "none"
+	// ServiceQuotaIncreaseRequest requests the increase of the service quotas below their desired minimum value.
+	ServiceQuotaIncreaseRequest = "request"
+	// ServiceQuotaIncreaseWait requests the increase of the service quotas below their desired minimum value and waits
+	// for the requests to be resolved.
+	ServiceQuotaIncreaseWait = "wait"
+)
+
+// EnsureServiceQuotas returns the service quotas limiting the resources used by the tests. Depending on the increase
+// mode, it requests the increase of the quotas below their desired minimum value and waits up to the timeout for the
+// requests to be resolved.
+func EnsureServiceQuotas(sess client.ConfigProvider, increase string, timeout time.Duration) (map[string]*ServiceQuota, map[string]*servicequotas.ServiceQuota) {
+	Expect(increase).To(BeElementOf(ServiceQuotaIncreaseNone, ServiceQuotaIncreaseRequest, ServiceQuotaIncreaseWait), "Invalid service quota increase mode")
 	limitedResources := getLimitedResources()
 	serviceQuotasClient := servicequotas.New(sess)
 
 	originalQuotas := map[string]*servicequotas.ServiceQuota{}
 
 	for k, v := range limitedResources {
-		out, err := serviceQuotasClient.GetServiceQuota(&servicequotas.GetServiceQuotaInput{
-			QuotaCode:   aws.String(v.QuotaCode),
-			ServiceCode: aws.String(v.ServiceCode),
-		})
-		Expect(err).NotTo(HaveOccurred())
-		originalQuotas[k] = out.Quota
-		v.Value = int(aws.Float64Value(out.Quota.Value))
-		limitedResources[k] = v
-		if v.Value < v.DesiredMinimumValue {
+		originalQuotas[k] = v.updateValue(serviceQuotasClient)
+		if v.Value < v.DesiredMinimumValue && increase != ServiceQuotaIncreaseNone {
 			v.attemptRaiseServiceQuotaRequest(serviceQuotasClient)
 		}
 	}
 
+	if increase == ServiceQuotaIncreaseWait {
+		waitForServiceQuotaRequests(serviceQuotasClient, limitedResources, timeout)
+	}
+
 	return limitedResources, originalQuotas
 }
 
+func (s *ServiceQuota) updateValue(serviceQuotasClient *servicequotas.ServiceQuotas) *servicequotas.ServiceQuota {
+	out, err := serviceQuotasClient.GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		QuotaCode:   aws.String(s.QuotaCode),
+		ServiceCode: aws.String(s.ServiceCode),
+	})
+	Expect(err).NotTo(HaveOccurred())
+	s.Value = int(aws.Float64Value(out.Quota.Value))
+	return out.Quota
+}
+
+// isPending returns whether an increase of the service quota was requested and is not resolved yet.
+func (s *ServiceQuota) isPending() bool {
+	return s.RequestStatus == servicequotas.RequestStatusPending || s.RequestStatus == servicequotas.RequestStatusCaseOpened
+}
+
+// waitForServiceQuotaRequests waits up to the timeout for the pending service quota increase requests to be resolved,
+// and updates the value of the quotas whose increase was approved.
+func waitForServiceQuotaRequests(serviceQuotasClient *servicequotas.ServiceQuotas, quotas map[string]*ServiceQuota, timeout time.Duration) {
+	timeoutAfter := time.Now().Add(timeout)
+	for {
+		pending := []string{}
+		for _, v := range quotas {
+			if v.Value >= v.DesiredMinimumValue || !v.isPending() {
+				continue
+			}
+			v.updateServiceQuotaRequestStatus(serviceQuotasClient)
+			switch {
+			case v.isPending():
+				pending = append(pending, fmt.Sprintf("%s/%s", v.ServiceCode, v.QuotaName))
+			case v.RequestStatus == servicequotas.RequestStatusApproved || v.RequestStatus == servicequotas.RequestStatusCaseClosed:
+				v.updateValue(serviceQuotasClient)
+			}
+		}
+		if len(pending) == 0 {
+			return
+		}
+		if time.Now().After(timeoutAfter) {
+			By(fmt.Sprintf("Timed out waiting for the service quota increase requests of %s, the tests requiring them will be skipped", strings.Join(pending, ", ")))
+			return
+		}
+		By(fmt.Sprintf("Waiting for the service quota increase requests of %s", strings.Join(pending, ", ")))
+		time.Sleep(time.Minute)
+	}
+}
+
 func (s *ServiceQuota) attemptRaiseServiceQuotaRequest(serviceQuotasClient *servicequotas.ServiceQuotas) {
 	s.updateServiceQuotaRequestStatus(serviceQuotasClient)
 	if s.RequestStatus == "" {
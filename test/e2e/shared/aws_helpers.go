@@ -121,6 +121,36 @@ func GetLoadBalancerARNs(input GetLoadBalancerARNsInput) ([]string, error) {
 	return matchingARNs, nil
 }
 
+// GetTargetGroupARNs returns the ARNs of the target groups the cloud provider created for the service, e.g. for the
+// listeners of its NLB. The Type of the input is ignored.
+func GetTargetGroupARNs(input GetLoadBalancerARNsInput) ([]string, error) {
+	By(fmt.Sprintf("Getting AWS target group ARNs for service %s/%s", input.ServiceNamespace, input.ServiceName))
+
+	descOutput, err := DescribeResourcesByTags(DescribeResourcesByTagsInput{
+		AWSSession: input.AWSSession,
+		Tags: map[string][]string{
+			"kubernetes.io/service-name":                             {fmt.Sprintf("%s/%s", input.ServiceNamespace, input.ServiceName)},
+			infrav1.ClusterAWSCloudProviderTagKey(input.ClusterName): {string(infrav1.ResourceLifecycleOwned)},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describing resource tags: %w", err)
+	}
+
+	matchingARNs := []string{}
+	for _, resARN := range descOutput.ARNs {
+		parsedArn, err := arn.Parse(resARN)
+		if err != nil {
+			return nil, fmt.Errorf("parsing resource arn %q: %w", resARN, err)
+		}
+		if parsedArn.Service == "elasticloadbalancing" && strings.HasPrefix(parsedArn.Resource, "targetgroup/") {
+			matchingARNs = append(matchingARNs, resARN)
+		}
+	}
+
+	return matchingARNs, nil
+}
+
 type DescribeResourcesByTagsInput struct {
 	AWSSession client.ConfigProvider
 	Tags       map[string][]string
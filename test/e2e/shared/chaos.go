@@ -0,0 +1,140 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo/v2"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// AvailabilityZoneDisruption is a simulated outage of an availability zone, during which the subnets of the zone are
+// associated to a network ACL denying all traffic, so that the instances, NAT gateways and load balancer nodes of the
+// zone can neither send nor receive any packet.
+type AvailabilityZoneDisruption struct {
+	AvailabilityZone string
+
+	ec2Svc       *ec2.EC2
+	networkACLID string
+	// originalNetworkACLs are the IDs of the network ACLs associated to the disrupted subnets before the disruption,
+	// keyed by the ID of the association to the deny-all network ACL.
+	originalNetworkACLs map[string]string
+}
+
+// DisruptAvailabilityZone simulates an outage of the availability zone for the subnets of the cluster VPC. The network
+// ACL created for it is tagged as owned by the cluster so that it is reported as leaked if it is not restored.
+func DisruptAvailabilityZone(e2eCtx *E2EContext, clusterName, vpcID, availabilityZone string) (*AvailabilityZoneDisruption, error) {
+	By(fmt.Sprintf("Disrupting availability zone %s of VPC %s", availabilityZone, vpcID))
+	ec2Svc := ec2.New(e2eCtx.AWSSession)
+
+	subnets, err := ec2Svc.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{vpcID})},
+			{Name: aws.String("availability-zone"), Values: aws.StringSlice([]string{availabilityZone})},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describing the subnets of availability zone %s: %w", availabilityZone, err)
+	}
+	if len(subnets.Subnets) == 0 {
+		return nil, fmt.Errorf("VPC %s has no subnet in availability zone %s", vpcID, availabilityZone)
+	}
+	subnetIDs := make([]*string, 0, len(subnets.Subnets))
+	for _, subnet := range subnets.Subnets {
+		subnetIDs = append(subnetIDs, subnet.SubnetId)
+	}
+
+	acls, err := ec2Svc.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
+		Filters: []*ec2.Filter{{Name: aws.String("association.subnet-id"), Values: subnetIDs}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describing the network ACLs of availability zone %s: %w", availabilityZone, err)
+	}
+
+	// A network ACL without any rule but the default ones denies all inbound and outbound traffic.
+	acl, err := ec2Svc.CreateNetworkAcl(&ec2.CreateNetworkAclInput{
+		VpcId: aws.String(vpcID),
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeNetworkAcl),
+				Tags: []*ec2.Tag{
+					{Key: aws.String("Name"), Value: aws.String(fmt.Sprintf("%s-chaos-%s", clusterName, availabilityZone))},
+					{Key: aws.String(infrav1.ClusterTagKey(clusterName)), Value: aws.String(string(infrav1.ResourceLifecycleOwned))},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating the deny-all network ACL: %w", err)
+	}
+
+	disruption := &AvailabilityZoneDisruption{
+		AvailabilityZone:    availabilityZone,
+		ec2Svc:              ec2Svc,
+		networkACLID:        aws.StringValue(acl.NetworkAcl.NetworkAclId),
+		originalNetworkACLs: map[string]string{},
+	}
+	for _, original := range acls.NetworkAcls {
+		for _, association := range original.Associations {
+			out, err := ec2Svc.ReplaceNetworkAclAssociation(&ec2.ReplaceNetworkAclAssociationInput{
+				AssociationId: association.NetworkAclAssociationId,
+				NetworkAclId:  acl.NetworkAcl.NetworkAclId,
+			})
+			if err != nil {
+				return disruption, kerrors.NewAggregate([]error{
+					fmt.Errorf("associating subnet %s to the deny-all network ACL: %w", aws.StringValue(association.SubnetId), err),
+					disruption.Restore(),
+				})
+			}
+			disruption.originalNetworkACLs[aws.StringValue(out.NewAssociationId)] = aws.StringValue(original.NetworkAclId)
+		}
+	}
+	return disruption, nil
+}
+
+// Restore ends the outage of the availability zone by associating its subnets to their original network ACLs again,
+// and deletes the deny-all network ACL.
+func (d *AvailabilityZoneDisruption) Restore() error {
+	By(fmt.Sprintf("Restoring availability zone %s", d.AvailabilityZone))
+	var errs []error
+	for associationID, networkACLID := range d.originalNetworkACLs {
+		if _, err := d.ec2Svc.ReplaceNetworkAclAssociation(&ec2.ReplaceNetworkAclAssociationInput{
+			AssociationId: aws.String(associationID),
+			NetworkAclId:  aws.String(networkACLID),
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("restoring network ACL %s: %w", networkACLID, err))
+			continue
+		}
+		delete(d.originalNetworkACLs, associationID)
+	}
+	if len(errs) > 0 {
+		return kerrors.NewAggregate(errs)
+	}
+
+	if _, err := d.ec2Svc.DeleteNetworkAcl(&ec2.DeleteNetworkAclInput{NetworkAclId: aws.String(d.networkACLID)}); err != nil {
+		return fmt.Errorf("deleting the deny-all network ACL %s: %w", d.networkACLID, err)
+	}
+	return nil
+}
@@ -50,6 +50,7 @@ func SetupNamespace(ctx context.Context, specName string, e2eCtx *E2EContext) *c
 }
 
 func SetupSpecNamespace(ctx context.Context, specName string, e2eCtx *E2EContext) *corev1.Namespace {
+	useSpecRegion(e2eCtx)
 	By(fmt.Sprintf("Creating a namespace for hosting the %q test spec", specName))
 	namespace, cancelWatches := framework.CreateNamespaceAndWatchEvents(ctx, framework.CreateNamespaceAndWatchEventsInput{
 		Creator:   e2eCtx.Environment.BootstrapClusterProxy.GetClient(),
@@ -61,6 +62,7 @@ func SetupSpecNamespace(ctx context.Context, specName string, e2eCtx *E2EContext
 	e2eCtx.Environment.Namespaces[namespace] = cancelWatches
 	Expect(e2eCtx.E2EConfig).ToNot(BeNil(), "Invalid argument. e2eConfig can't be nil")
 	Expect(e2eCtx.E2EConfig.Variables).To(HaveKey(KubernetesVersion))
+	startAPICallTracking(ctx, e2eCtx, namespace)
 
 	return namespace
 }
@@ -72,6 +74,11 @@ func DumpSpecResourcesAndCleanup(ctx context.Context, specName string, namespace
 	DumpSpecResources(ctx, e2eCtx, namespace)
 	By(fmt.Sprintf("Dumping all EC2 instances in the %q namespace", namespace.Name))
 	DumpMachines(ctx, e2eCtx, namespace)
+	if CurrentSpecReport().Failed() {
+		By(fmt.Sprintf("Dumping the console output, status checks and cloud-init logs of the instances in the %q namespace", namespace.Name))
+		DumpFailedSpecMachines(ctx, e2eCtx, namespace)
+	}
+	recordClusterNames(ctx, e2eCtx, namespace)
 	if !e2eCtx.Settings.SkipCleanup {
 		intervals := e2eCtx.E2EConfig.GetIntervals(specName, "wait-delete-cluster")
 		By(fmt.Sprintf("Deleting all clusters in the %q namespace with intervals %q", namespace.Name, intervals))
@@ -88,6 +95,7 @@ func DumpSpecResourcesAndCleanup(ctx context.Context, specName string, namespace
 			Name:    namespace.Name,
 		})
 	}
+	reportAPICalls(ctx, e2eCtx, namespace)
 	if cancelWatches != nil {
 		cancelWatches()
 	}
@@ -158,12 +166,7 @@ func MachinesForSpec(ctx context.Context, clusterProxy framework.ClusterProxy, n
 }
 
 func DumpMachine(ctx context.Context, e2eCtx *E2EContext, machine infrav1.AWSMachine, instanceID string, cluster *string) {
-	logPath := filepath.Join(e2eCtx.Settings.ArtifactFolder, "clusters", e2eCtx.Environment.BootstrapClusterProxy.GetName())
-	if cluster != nil {
-		logPath = filepath.Join(e2eCtx.Settings.ArtifactFolder, "clusters", *cluster)
-	}
-	machineLogBase := path.Join(logPath, "instances", machine.Namespace, machine.Name)
-	metaLog := path.Join(machineLogBase, "instance.log")
+	metaLog := path.Join(machineLogDir(e2eCtx, machine, cluster), "instance.log")
 	if err := os.MkdirAll(filepath.Dir(metaLog), 0o750); err != nil {
 		fmt.Fprintf(GinkgoWriter, "Couldn't create directory for file: path=%q, err=%s\n", metaLog, err)
 	}
@@ -0,0 +1,175 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/cluster-api/test/framework"
+	"sigs.k8s.io/cluster-api/test/framework/kubetest"
+)
+
+const (
+	// ConformanceRunnerKubetest runs the conformance suite with the kubetest image of the cluster-api test framework.
+	ConformanceRunnerKubetest = "kubetest"
+	// ConformanceRunnerKubetest2 runs the conformance suite with the ginkgo tester of kubetest2.
+	ConformanceRunnerKubetest2 = "kubetest2"
+	// ConformanceRunnerHydrophone runs the conformance suite with hydrophone, inside the workload cluster.
+	ConformanceRunnerHydrophone = "hydrophone"
+
+	conformanceImageRepository   = "registry.k8s.io/conformance"
+	conformanceCIImageRepository = "gcr.io/k8s-staging-ci-images/conformance"
+)
+
+// RunConformanceInput is the input for RunConformance.
+type RunConformanceInput struct {
+	// ClusterProxy is the proxy of the workload cluster to run the conformance suite against.
+	ClusterProxy framework.ClusterProxy
+	// ClusterName is the name of the workload cluster.
+	ClusterName string
+	// NumberOfNodes is the number of worker nodes of the workload cluster.
+	NumberOfNodes int
+	// ConfigFilePath is the path to the kubetest configuration file, from which the focus and skip regular
+	// expressions are read by the kubetest2 and hydrophone runners.
+	ConfigFilePath string
+	// KubernetesVersion is the version of the conformance suite to run.
+	KubernetesVersion string
+}
+
+// RunConformance runs the Kubernetes conformance suite against a workload cluster, using the runner selected by the
+// conformance-runner flag, and stores its results in the artifacts folder.
+func RunConformance(ctx context.Context, e2eCtx *E2EContext, input RunConformanceInput) error {
+	artifactsDir := filepath.Join(e2eCtx.Settings.ArtifactFolder, "conformance", input.ClusterName)
+	if err := os.MkdirAll(artifactsDir, 0o750); err != nil {
+		return errors.Wrapf(err, "failed to create the conformance artifacts directory %s", artifactsDir)
+	}
+
+	switch e2eCtx.Settings.ConformanceRunner {
+	case ConformanceRunnerKubetest:
+		return kubetest.Run(ctx, kubetest.RunInput{
+			ClusterProxy:            input.ClusterProxy,
+			ClusterName:             input.ClusterName,
+			NumberOfNodes:           input.NumberOfNodes,
+			ArtifactsDirectory:      artifactsDir,
+			ConfigFilePath:          input.ConfigFilePath,
+			GinkgoNodes:             e2eCtx.Settings.GinkgoNodes,
+			GinkgoSlowSpecThreshold: e2eCtx.Settings.GinkgoSlowSpecThreshold,
+		})
+	case ConformanceRunnerKubetest2:
+		focus, skip, err := conformanceFocusAndSkip(input.ConfigFilePath)
+		if err != nil {
+			return err
+		}
+		args := []string{
+			"noop",
+			"--kubeconfig=" + input.ClusterProxy.GetKubeconfigPath(),
+			"--artifacts=" + artifactsDir,
+			"--test=ginkgo",
+			"--",
+			"--focus-regex=" + focus,
+			"--skip-regex=" + skip,
+			"--parallel=" + strconv.Itoa(e2eCtx.Settings.GinkgoNodes),
+			"--test-package-version=" + input.KubernetesVersion,
+		}
+		if e2eCtx.Settings.UseCIArtifacts {
+			args = append(args, "--test-package-dir=ci")
+		}
+		return runConformanceCommand(ctx, artifactsDir, "kubetest2", args...)
+	case ConformanceRunnerHydrophone:
+		focus, skip, err := conformanceFocusAndSkip(input.ConfigFilePath)
+		if err != nil {
+			return err
+		}
+		return runConformanceCommand(ctx, artifactsDir, "hydrophone",
+			"--kubeconfig="+input.ClusterProxy.GetKubeconfigPath(),
+			"--output-dir="+artifactsDir,
+			"--conformance-image="+conformanceImage(input.KubernetesVersion, e2eCtx.Settings.UseCIArtifacts),
+			"--focus="+focus,
+			"--skip="+skip,
+			"--parallel="+strconv.Itoa(e2eCtx.Settings.GinkgoNodes),
+		)
+	default:
+		return errors.Errorf("unknown conformance runner %q, expected %s, %s or %s", e2eCtx.Settings.ConformanceRunner,
+			ConformanceRunnerKubetest, ConformanceRunnerKubetest2, ConformanceRunnerHydrophone)
+	}
+}
+
+// conformanceFocusAndSkip returns the focus and skip regular expressions of a kubetest configuration file.
+func conformanceFocusAndSkip(configFilePath string) (string, string, error) {
+	data, err := os.ReadFile(configFilePath) //nolint:gosec
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to read the kubetest configuration file %s", configFilePath)
+	}
+	config := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return "", "", errors.Wrapf(err, "failed to parse the kubetest configuration file %s", configFilePath)
+	}
+	var focus, skip string
+	if value, ok := config["ginkgo.focus"]; ok {
+		focus = fmt.Sprint(value)
+	}
+	if value, ok := config["ginkgo.skip"]; ok {
+		skip = fmt.Sprint(value)
+	}
+	return focus, skip, nil
+}
+
+// conformanceImage returns the conformance image of a Kubernetes version, released or built by the CI.
+func conformanceImage(kubernetesVersion string, ciArtifacts bool) string {
+	if ciArtifacts {
+		// The CI images are tagged with the build version, whose "+" is not a valid tag character.
+		return fmt.Sprintf("%s:%s", conformanceCIImageRepository, strings.ReplaceAll(kubernetesVersion, "+", "_"))
+	}
+	return fmt.Sprintf("%s:%s", conformanceImageRepository, kubernetesVersion)
+}
+
+// runConformanceCommand runs a conformance runner binary found in the PATH, streaming its output to the Ginkgo
+// writer and to a log file of the artifacts directory.
+func runConformanceCommand(ctx context.Context, artifactsDir, name string, args ...string) error {
+	binary, err := exec.LookPath(name)
+	if err != nil {
+		return errors.Wrapf(err, "the %s conformance runner must be installed in the PATH", name)
+	}
+	logFile, err := os.Create(filepath.Join(artifactsDir, name+".log")) //nolint:gosec
+	if err != nil {
+		return errors.Wrapf(err, "failed to create the %s log file", name)
+	}
+	defer logFile.Close()
+
+	ginkgo.By(fmt.Sprintf("Running the conformance suite with %s", name))
+	cmd := exec.CommandContext(ctx, binary, args...)
+	cmd.Stdout = io.MultiWriter(ginkgo.GinkgoWriter, logFile)
+	cmd.Stderr = cmd.Stdout
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "the %s conformance run failed, see %s", name, logFile.Name())
+	}
+	return nil
+}
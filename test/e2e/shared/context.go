@@ -27,7 +27,6 @@ import (
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/awslabs/goformation/v4/cloudformation"
-	"github.com/gofrs/flock"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -76,6 +75,8 @@ type E2EContext struct {
 	// CloudFormationTemplate is the rendered template created for the test.
 	CloudFormationTemplate *cloudformation.Template
 	StartOfSuite           time.Time
+	// Partition holds the settings of the AWS partition the tests run against.
+	Partition PartitionSettings
 }
 
 // Settings represents the test settings.
@@ -102,14 +103,47 @@ type Settings struct {
 	GinkgoSlowSpecThreshold int
 	// kubetestConfigFilePath is the path to the kubetest configuration file.
 	KubetestConfigFilePath string
+	// ConformanceRunner is the tool running the conformance suite, either kubetest, kubetest2 or hydrophone.
+	ConformanceRunner string
 	// useCIArtifacts specifies whether or not to use the latest build from the main branch of the Kubernetes repository.
 	UseCIArtifacts bool
 	// SourceTemplate specifies which source template to use.
 	SourceTemplate string
-	// FileLock is the lock to be used to read the resource quotas file.
-	FileLock *flock.Flock
 	// InstanceVcpu is the number of vCPUs needed for the AWS instance type used for workers and control plane.
 	InstanceVCPU int
+	// Partition is the AWS partition the tests run against, e.g. aws or aws-us-gov.
+	Partition string
+	// ImageLookupOrg overrides the AWS account owning the AMIs used by the tests.
+	ImageLookupOrg string
+	// MachineType overrides the instance type of the control plane and worker machines.
+	MachineType string
+	// AMINamePrefix is the prefix of the names of the AMIs used by the tests.
+	AMINamePrefix string
+	// AMIBaseOS is the operating system and version of the AMIs used by the tests, as it appears in their names.
+	AMIBaseOS string
+	// AMIArchitecture is the architecture of the AMIs used by the tests.
+	AMIArchitecture string
+	// ResourceStore is the backend storing the resources available to the tests, either file, dynamodb or s3.
+	ResourceStore string
+	// ResourceStoreName is the name of the DynamoDB table or S3 bucket storing the resources available to the tests.
+	ResourceStoreName string
+	// ResourceStoreKey is the key of the DynamoDB item or S3 object storing the resources available to the tests.
+	ResourceStoreKey string
+	// ServiceQuotaIncrease is whether to request the increase of the service quotas below their desired minimum
+	// value, either none, request or wait.
+	ServiceQuotaIncrease string
+	// ServiceQuotaIncreaseTimeout is how long to wait for the service quota increase requests to be resolved.
+	ServiceQuotaIncreaseTimeout time.Duration
+	// DeleteLeakedResources enables the deletion of the AWS resources still tagged for the test clusters once the
+	// test suite finished.
+	DeleteLeakedResources bool
+	// CostRatesFile is the path to a file overriding the hourly costs of the resources used by the tests.
+	CostRatesFile string
+	// Regions are the regions the specs are distributed to, the region of the environment if empty.
+	Regions []string
+	// ReuseNetwork makes the BYO infrastructure specs create their clusters in a long-lived network shared by the
+	// test runs, instead of creating a network for each spec.
+	ReuseNetwork bool
 }
 
 // RuntimeEnvironment represents the runtime environment of the test.
@@ -138,6 +172,12 @@ type RuntimeEnvironment struct {
 	ClusterctlConfigPath string
 	// Scheme is the GVK scheme to use for the tests.
 	Scheme *runtime.Scheme
+	// ResourceStore stores the resources available to the tests in each region.
+	ResourceStore *RegionalResourceStore
+	// ServiceQuotas holds the service quotas limiting the resources used by the tests, by region.
+	ServiceQuotas map[string]map[string]*ServiceQuota
+	// CreatedMultitenancyRoles are the multi-tenancy roles created by the test suite, which are deleted once it finished.
+	CreatedMultitenancyRoles []MultitenancyRole
 }
 
 // InitSchemeFunc is a function that will create a scheme.
@@ -0,0 +1,240 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+	. "github.com/onsi/ginkgo/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	resourceUsageFileName = "resource-usage.yaml"
+	costEstimateFileName  = "cost-estimate.yaml"
+)
+
+// CostRates are the hourly costs, in USD, of the resources used by the tests.
+type CostRates struct {
+	// EC2NormalVCPU is the cost of a vCPU of a standard instance.
+	EC2NormalVCPU float64 `json:"ec2-normal"`
+	// EC2GPUVCPU is the cost of a vCPU of a GPU instance.
+	EC2GPUVCPU float64 `json:"ec2-GPU"`
+	// NGW is the cost of a NAT gateway, excluding the processed data.
+	NGW float64 `json:"ngw"`
+	// ClassicLB is the cost of a load balancer, excluding the processed data.
+	ClassicLB float64 `json:"classiclb"`
+	// EIP is the cost of a public IPv4 address.
+	EIP float64 `json:"eip"`
+	// VolumeGP2 is the cost of a TiB of gp2 volumes.
+	VolumeGP2 float64 `json:"volume-GP2"`
+}
+
+// DefaultCostRates are the on-demand prices of us-east-1, based on the t3.large and g4dn.xlarge instances used by the
+// tests. VPCs, internet gateways and EventBridge rules are free.
+var DefaultCostRates = CostRates{
+	EC2NormalVCPU: 0.0416,
+	EC2GPUVCPU:    0.1315,
+	NGW:           0.045,
+	ClassicLB:     0.025,
+	EIP:           0.005,
+	VolumeGP2:     0.14,
+}
+
+// hourlyCost returns the hourly cost of the resources.
+func (c CostRates) hourlyCost(r TestResource) float64 {
+	return float64(r.EC2Normal)*c.EC2NormalVCPU +
+		float64(r.EC2GPU)*c.EC2GPUVCPU +
+		float64(r.NGW)*c.NGW +
+		float64(r.ClassicLB)*c.ClassicLB +
+		float64(r.EIP)*c.EIP +
+		float64(r.VolumeGP2)*c.VolumeGP2
+}
+
+// ResourceUsage records the resources a spec held and for how long.
+type ResourceUsage struct {
+	Spec      string       `json:"spec"`
+	Resources TestResource `json:"resources"`
+	Start     time.Time    `json:"start"`
+	End       time.Time    `json:"end"`
+}
+
+// SpecCost is the estimated cost of the resources used by a spec.
+type SpecCost struct {
+	Spec  string  `json:"spec"`
+	Hours float64 `json:"hours"`
+	Cost  float64 `json:"cost"`
+}
+
+// CostEstimate is the estimated cost of the resources used by a test run, by spec.
+type CostEstimate struct {
+	Rates CostRates  `json:"rates"`
+	Specs []SpecCost `json:"specs"`
+	Total float64    `json:"total"`
+}
+
+// pendingUsage is the usage of the resources requested by a spec, until they are released.
+type pendingUsage struct {
+	spec           string
+	artifactFolder string
+	start          time.Time
+}
+
+// pendingUsages holds the usage of the resources requested by the specs of the ginkgo node, by request.
+var pendingUsages = struct {
+	sync.Mutex
+	usages map[*TestResource]*pendingUsage
+}{usages: map[*TestResource]*pendingUsage{}}
+
+// trackUsage starts tracking the usage of the resources requested by the spec.
+func (r *TestResource) trackUsage(spec, artifactFolder string) {
+	pendingUsages.Lock()
+	defer pendingUsages.Unlock()
+	pendingUsages.usages[r] = &pendingUsage{spec: spec, artifactFolder: artifactFolder}
+}
+
+// recordAcquisition records that the resources requested by a tracked spec were acquired.
+func (r *TestResource) recordAcquisition() {
+	pendingUsages.Lock()
+	defer pendingUsages.Unlock()
+	if usage, ok := pendingUsages.usages[r]; ok {
+		usage.start = time.Now()
+	}
+}
+
+// recordRelease appends the usage of the resources requested by a tracked spec, once released, to the resource usage
+// file of the artifacts folder.
+func (r *TestResource) recordRelease() {
+	pendingUsages.Lock()
+	usage, ok := pendingUsages.usages[r]
+	delete(pendingUsages.usages, r)
+	pendingUsages.Unlock()
+	if !ok || usage.start.IsZero() {
+		return
+	}
+
+	usagePath := filepath.Join(usage.artifactFolder, resourceUsageFileName)
+	fileLock := flock.New(usagePath + ".lock")
+	if err := fileLock.Lock(); err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't lock file: file=%q err=%s\n", usagePath, err)
+		return
+	}
+	defer func() {
+		_ = fileLock.Unlock()
+	}()
+
+	usages, err := readResourceUsages(usagePath)
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't read the resource usage: file=%q err=%s\n", usagePath, err)
+		return
+	}
+	usages = append(usages, ResourceUsage{Spec: usage.spec, Resources: *r, Start: usage.start, End: time.Now()})
+	data, err := yaml.Marshal(usages)
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Failed to marshal the resource usage: err=%v\n", err)
+		return
+	}
+	if err := os.WriteFile(usagePath, data, 0o600); err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't write the resource usage: file=%q err=%s\n", usagePath, err)
+	}
+}
+
+func readResourceUsages(usagePath string) ([]ResourceUsage, error) {
+	data, err := os.ReadFile(filepath.Clean(usagePath))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	usages := []ResourceUsage{}
+	if err := yaml.Unmarshal(data, &usages); err != nil {
+		return nil, err
+	}
+	return usages, nil
+}
+
+// EstimateCost returns the estimated cost of the resources used by the specs, from the most to the least expensive.
+func EstimateCost(usages []ResourceUsage, rates CostRates) CostEstimate {
+	costs := map[string]*SpecCost{}
+	for _, usage := range usages {
+		cost, ok := costs[usage.Spec]
+		if !ok {
+			cost = &SpecCost{Spec: usage.Spec}
+			costs[usage.Spec] = cost
+		}
+		hours := usage.End.Sub(usage.Start).Hours()
+		cost.Hours += hours
+		cost.Cost += hours * rates.hourlyCost(usage.Resources)
+	}
+
+	estimate := CostEstimate{Rates: rates, Specs: []SpecCost{}}
+	for _, cost := range costs {
+		estimate.Specs = append(estimate.Specs, *cost)
+		estimate.Total += cost.Cost
+	}
+	sort.Slice(estimate.Specs, func(i, j int) bool {
+		if estimate.Specs[i].Cost != estimate.Specs[j].Cost {
+			return estimate.Specs[i].Cost > estimate.Specs[j].Cost
+		}
+		return estimate.Specs[i].Spec < estimate.Specs[j].Spec
+	})
+	return estimate
+}
+
+// WriteCostEstimate writes the estimated cost of the resources used by the specs of the test run to the artifacts
+// folder. The cost rates can be overridden with the file given by the cost-rates-file flag.
+func WriteCostEstimate(e2eCtx *E2EContext) {
+	usages, err := readResourceUsages(filepath.Join(e2eCtx.Settings.ArtifactFolder, resourceUsageFileName))
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't read the resource usage: err=%s\n", err)
+		return
+	}
+
+	rates := DefaultCostRates
+	if e2eCtx.Settings.CostRatesFile != "" {
+		data, err := os.ReadFile(e2eCtx.Settings.CostRatesFile)
+		if err == nil {
+			err = yaml.UnmarshalStrict(data, &rates)
+		}
+		if err != nil {
+			fmt.Fprintf(GinkgoWriter, "Couldn't read the cost rates: file=%q err=%s\n", e2eCtx.Settings.CostRatesFile, err)
+			return
+		}
+	}
+
+	estimate := EstimateCost(usages, rates)
+	By(fmt.Sprintf("Estimated cost of the AWS resources used by the tests: $%.2f", estimate.Total))
+	estimatePath := filepath.Join(e2eCtx.Settings.ArtifactFolder, costEstimateFileName)
+	data, err := yaml.Marshal(estimate)
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Failed to marshal the cost estimate: err=%v\n", err)
+		return
+	}
+	if err := os.WriteFile(estimatePath, data, 0o600); err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't write the cost estimate: file=%q err=%s\n", estimatePath, err)
+	}
+}
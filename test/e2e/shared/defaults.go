@@ -22,7 +22,9 @@ package shared
 import (
 	"context"
 	"flag"
+	"os"
 	"strings"
+	"time"
 
 	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -31,13 +33,16 @@ import (
 	cgscheme "k8s.io/client-go/kubernetes/scheme"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	bootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/api/bootstrap/v1beta1"
 	"sigs.k8s.io/cluster-api/test/framework"
 )
 
 // Constants.
 const (
 	DefaultSSHKeyPairName                = "cluster-api-provider-aws-sigs-k8s-io"
-	AMIPrefix                            = "capa-ami-ubuntu-24.04-"
+	DefaultAMINamePrefix                 = "capa-ami-"
+	DefaultAMIBaseOS                     = "ubuntu-24.04"
+	DefaultAMIArchitecture               = "x86_64"
 	DefaultImageLookupOrg                = "819546954734"
 	KubernetesVersion                    = "KUBERNETES_VERSION"
 	KubernetesVersionManagement          = "KUBERNETES_VERSION_MANAGEMENT"
@@ -47,11 +52,13 @@ const (
 	GcWorkloadPath                       = "GC_WORKLOAD"
 	KubeproxyAddonVersion                = "KUBE_PROXY_ADDON_VERSION"
 	AwsNodeMachineType                   = "AWS_NODE_MACHINE_TYPE"
+	AwsArm64NodeMachineType              = "AWS_ARM64_NODE_MACHINE_TYPE"
 	AwsAvailabilityZone1                 = "AWS_AVAILABILITY_ZONE_1"
 	AwsAvailabilityZone2                 = "AWS_AVAILABILITY_ZONE_2"
 	MultiAzFlavor                        = "multi-az"
 	LimitAzFlavor                        = "limit-az"
 	SpotInstancesFlavor                  = "spot-instances"
+	SpotInterruptionsFlavor              = "spot-interruptions"
 	SSMFlavor                            = "ssm"
 	TopologyFlavor                       = "topology"
 	SelfHostedClusterClassFlavor         = "self-hosted-clusterclass"
@@ -62,8 +69,13 @@ const (
 	NestedMultitenancyClusterClassFlavor = "nested-multitenancy-clusterclass"
 	KCPScaleInFlavor                     = "kcp-scale-in"
 	IgnitionFlavor                       = "ignition"
+	IgnitionKMSFlavor                    = "ignition-kms"
 	StorageClassOutTreeZoneLabel         = "topology.ebs.csi.aws.com/zone"
 	GPUFlavor                            = "gpu"
+	GovCloudFlavor                       = "govcloud"
+	IPv6Flavor                           = "ipv6"
+	DualStackFlavor                      = "dual-stack"
+	Arm64Flavor                          = "arm64"
 	InstanceVcpu                         = "AWS_MACHINE_TYPE_VCPU_USAGE"
 	EFSSupport                           = "efs-support"
 	IntreeCloudProvider                  = "intree-cloud-provider"
@@ -212,6 +224,13 @@ func getLimitedResources() map[string]*ServiceQuota {
 		DesiredMinimumValue: 500,
 	}
 
+	serviceQuotas["eks-cluster"] = &ServiceQuota{
+		ServiceCode:         "eks",
+		QuotaName:           "Clusters",
+		QuotaCode:           "L-1194D53C",
+		DesiredMinimumValue: 20,
+	}
+
 	return serviceQuotas
 }
 
@@ -231,6 +250,7 @@ func CreateDefaultFlags(ctx *E2EContext) {
 	flag.BoolVar(&ctx.Settings.UseCIArtifacts, "kubetest.use-ci-artifacts", false, "use the latest build from the main branch of the Kubernetes repository")
 	flag.StringVar(&ctx.Settings.KubetestConfigFilePath, "kubetest.config-file", "", "path to the kubetest configuration file")
 	flag.IntVar(&ctx.Settings.GinkgoNodes, "kubetest.ginkgo-nodes", 1, "number of ginkgo nodes to use")
+	flag.StringVar(&ctx.Settings.ConformanceRunner, "conformance-runner", ConformanceRunnerKubetest, "tool running the conformance suite, either kubetest, kubetest2 or hydrophone")
 	flag.IntVar(&ctx.Settings.GinkgoSlowSpecThreshold, "kubetest.ginkgo-slowSpecThreshold", 120, "time in s before spec is marked as slow")
 	flag.BoolVar(&ctx.Settings.UseExistingCluster, "use-existing-cluster", false, "if true, the test uses the current cluster instead of creating a new one (default discovery rules apply)")
 	flag.BoolVar(&ctx.Settings.SkipCleanup, "skip-cleanup", false, "if true, the resource cleanup after tests will be skipped")
@@ -239,4 +259,35 @@ func CreateDefaultFlags(ctx *E2EContext) {
 	flag.BoolVar(&ctx.Settings.SkipQuotas, "skip-quotas", false, "if true, the requesting of quotas for aws services will be skipped")
 	flag.StringVar(&ctx.Settings.DataFolder, "data-folder", "", "path to the data folder")
 	flag.StringVar(&ctx.Settings.SourceTemplate, "source-template", "infrastructure-aws/withoutclusterclass/generated/cluster-template.yaml", "path to the data folder")
+	flag.StringVar(&ctx.Settings.Partition, "partition", bootstrapv1.DefaultPartitionName, "AWS partition the tests run against, either aws or aws-us-gov")
+	flag.StringVar(&ctx.Settings.ImageLookupOrg, "image-lookup-org", os.Getenv("E2E_IMAGE_LOOKUP_ORG"), "AWS account owning the AMIs used by the tests, defaults to the account publishing the CAPA AMIs of the partition")
+	flag.StringVar(&ctx.Settings.AMINamePrefix, "ami-name-prefix", getEnvOrDefault("E2E_AMI_NAME_PREFIX", DefaultAMINamePrefix), "prefix of the names of the AMIs used by the tests")
+	flag.StringVar(&ctx.Settings.AMIBaseOS, "ami-base-os", getEnvOrDefault("E2E_AMI_BASE_OS", DefaultAMIBaseOS), "operating system and version of the AMIs used by the tests, as it appears in their names")
+	flag.StringVar(&ctx.Settings.AMIArchitecture, "ami-architecture", getEnvOrDefault("E2E_AMI_ARCHITECTURE", DefaultAMIArchitecture), "architecture of the AMIs used by the tests, either x86_64 or arm64")
+	flag.StringVar(&ctx.Settings.ResourceStore, "resource-store", FileResourceStore, "backend storing the resources available to the tests, either file, dynamodb or s3. The dynamodb and s3 backends allow test runs on several hosts to share an AWS account")
+	flag.StringVar(&ctx.Settings.ResourceStoreName, "resource-store-name", "", "name of the DynamoDB table or S3 bucket storing the resources available to the tests")
+	flag.StringVar(&ctx.Settings.ResourceStoreKey, "resource-store-key", DefaultResourceStoreKey, "key of the DynamoDB item or S3 object storing the resources available to the tests")
+	flag.StringVar(&ctx.Settings.ServiceQuotaIncrease, "service-quota-increase", ServiceQuotaIncreaseRequest, "whether to request the increase of the service quotas below the minimum required by the tests: none, request or wait for the requests to be resolved")
+	flag.DurationVar(&ctx.Settings.ServiceQuotaIncreaseTimeout, "service-quota-increase-timeout", 30*time.Minute, "how long to wait for the service quota increase requests to be resolved when service-quota-increase is wait")
+	flag.BoolVar(&ctx.Settings.DeleteLeakedResources, "delete-leaked-resources", false, "if true, the AWS resources still tagged for the test clusters once the test suite finished are deleted")
+	flag.StringVar(&ctx.Settings.CostRatesFile, "cost-rates-file", "", "path to a YAML file overriding the hourly costs, in USD, of the resources used by the tests in the cost estimate")
+	flag.Func("regions", "comma separated list of the regions the specs are distributed to, defaults to the region of the environment", func(value string) error {
+		ctx.Settings.Regions = parseRegions(value)
+		return nil
+	})
+	flag.BoolVar(&ctx.Settings.ReuseNetwork, "reuse-network", false, "if true, the specs using BYO infrastructure flavors create their clusters in a long-lived VPC shared by the test runs, created by the first run")
+	flag.StringVar(&ctx.Settings.MachineType, "machine-type", "", "instance type of the control plane and worker machines, defaults to the instance types of the e2e config or of the partition")
+}
+
+// getEnvOrDefault returns the value of the environment variable, or the default value if it is not set.
+func getEnvOrDefault(key, defaultValue string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// AMINamePattern returns the pattern matching the names of the AMIs used by the tests for the Kubernetes version.
+func (s Settings) AMINamePattern(kubernetesVersion string) string {
+	return s.AMINamePrefix + s.AMIBaseOS + "-" + kubernetesVersion + "*"
 }
@@ -0,0 +1,256 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api/test/framework"
+)
+
+const (
+	ipFamilyServerName  = "ip-family-server"
+	ipFamilyServerPort  = 8080
+	ipFamilyServerImage = "registry.k8s.io/e2e-test-images/agnhost:2.47"
+)
+
+// AWSIPFamilySpecInput is the input for AWSIPFamilySpec.
+type AWSIPFamilySpecInput struct {
+	BootstrapClusterProxy framework.ClusterProxy
+	NamespaceName         string
+	ClusterName           string
+	// IPFamilies are the IP families the workload cluster is expected to support, the first one being the primary one.
+	IPFamilies []corev1.IPFamily
+}
+
+// AWSIPFamilySpec implements a test that verifies that the nodes and pods of an "ipv6" or "dual-stack"-flavored CAPA
+// cluster get addresses of the expected IP families, that pods can reach each other over each of these families and
+// that a LoadBalancer Service exposing them is reachable.
+func AWSIPFamilySpec(ctx context.Context, e2eCtx *E2EContext, input AWSIPFamilySpecInput) {
+	specName := "aws-ip-family"
+
+	Expect(input.NamespaceName).NotTo(BeEmpty(), "Invalid argument. input.NamespaceName can't be empty when calling %s spec", specName)
+	Expect(input.ClusterName).NotTo(BeEmpty(), "Invalid argument. input.ClusterName can't be empty when calling %s spec", specName)
+	Expect(input.IPFamilies).NotTo(BeEmpty(), "Invalid argument. input.IPFamilies can't be empty when calling %s spec", specName)
+
+	ginkgo.By("creating a Kubernetes client to the workload cluster")
+	clusterProxy := input.BootstrapClusterProxy.GetWorkloadCluster(ctx, input.NamespaceName, input.ClusterName)
+	Expect(clusterProxy).NotTo(BeNil())
+	clientset := clusterProxy.GetClientSet()
+	Expect(clientset).NotTo(BeNil())
+
+	ginkgo.By("checking that the nodes have internal addresses of each IP family")
+	nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(nodes.Items).NotTo(BeEmpty())
+	for _, node := range nodes.Items {
+		var addresses []string
+		for _, address := range node.Status.Addresses {
+			if address.Type == corev1.NodeInternalIP {
+				addresses = append(addresses, address.Address)
+			}
+		}
+		for _, family := range input.IPFamilies {
+			Expect(addressesOfFamily(addresses, family)).NotTo(BeEmpty(), "node %s has no %s internal address: %v", node.Name, family, addresses)
+		}
+	}
+
+	ginkgo.By("deploying a server on the workload cluster")
+	labels := map[string]string{"app": ipFamilyServerName}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ipFamilyServerName,
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](2),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  ipFamilyServerName,
+							Image: ipFamilyServerImage,
+							Args:  []string{"netexec", fmt.Sprintf("--http-port=%d", ipFamilyServerPort)},
+							Ports: []corev1.ContainerPort{{ContainerPort: ipFamilyServerPort}},
+						},
+					},
+				},
+			},
+		},
+	}
+	_, err = clientset.AppsV1().Deployments(corev1.NamespaceDefault).Create(ctx, deployment, metav1.CreateOptions{})
+	Expect(err).NotTo(HaveOccurred())
+	WaitForDeploymentsAvailable(ctx, WaitForDeploymentsAvailableInput{
+		Getter:    clusterProxy.GetClient(),
+		Name:      ipFamilyServerName,
+		Namespace: corev1.NamespaceDefault,
+	}, e2eCtx.E2EConfig.GetIntervals(specName, "wait-deployment-ready")...)
+
+	pods, err := clientset.CoreV1().Pods(corev1.NamespaceDefault).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(&metav1.LabelSelector{MatchLabels: labels}),
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(pods.Items).NotTo(BeEmpty())
+
+	jobsClient := clientset.BatchV1().Jobs(corev1.NamespaceDefault)
+	for _, family := range input.IPFamilies {
+		for i, pod := range pods.Items {
+			var podIPs []string
+			for _, podIP := range pod.Status.PodIPs {
+				podIPs = append(podIPs, podIP.IP)
+			}
+			familyIPs := addressesOfFamily(podIPs, family)
+			Expect(familyIPs).NotTo(BeEmpty(), "pod %s has no %s address: %v", pod.Name, family, podIPs)
+
+			ginkgo.By(fmt.Sprintf("connecting to pod %s over %s", pod.Name, family))
+			jobName := fmt.Sprintf("ip-family-client-%s-%d", strings.ToLower(string(family)), i)
+			job := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      jobName,
+					Namespace: corev1.NamespaceDefault,
+				},
+				Spec: batchv1.JobSpec{
+					BackoffLimit: ptr.To[int32](5),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyNever,
+							Containers: []corev1.Container{
+								{
+									Name:  jobName,
+									Image: ipFamilyServerImage,
+									Args:  []string{"connect", net.JoinHostPort(familyIPs[0], fmt.Sprint(ipFamilyServerPort)), "--timeout=5s"},
+								},
+							},
+						},
+					},
+				},
+			}
+			_, err := jobsClient.Create(ctx, job, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			WaitForJobComplete(ctx, WaitForJobCompleteInput{
+				Getter:    jobsClientAdapter{client: jobsClient},
+				Job:       job,
+				Clientset: clientset,
+			}, e2eCtx.E2EConfig.GetIntervals(specName, "wait-job")...)
+		}
+	}
+
+	ginkgo.By("exposing the server through a LoadBalancer Service")
+	ipFamilyPolicy := corev1.IPFamilyPolicySingleStack
+	if len(input.IPFamilies) > 1 {
+		ipFamilyPolicy = corev1.IPFamilyPolicyRequireDualStack
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ipFamilyServerName,
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:           corev1.ServiceTypeLoadBalancer,
+			Selector:       labels,
+			IPFamilies:     input.IPFamilies,
+			IPFamilyPolicy: &ipFamilyPolicy,
+			Ports: []corev1.ServicePort{
+				{
+					Port:       80,
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromInt32(ipFamilyServerPort),
+				},
+			},
+		},
+	}
+	_, err = clientset.CoreV1().Services(corev1.NamespaceDefault).Create(ctx, service, metav1.CreateOptions{})
+	Expect(err).NotTo(HaveOccurred())
+
+	var hostname string
+	Eventually(func() string {
+		svc, err := clientset.CoreV1().Services(corev1.NamespaceDefault).Get(ctx, ipFamilyServerName, metav1.GetOptions{})
+		if err != nil || len(svc.Status.LoadBalancer.Ingress) == 0 {
+			return ""
+		}
+		hostname = svc.Status.LoadBalancer.Ingress[0].Hostname
+		return hostname
+	}, e2eCtx.E2EConfig.GetIntervals(specName, "wait-loadbalancer-ready")...).ShouldNot(BeEmpty(), "load balancer of service %s has no hostname", ipFamilyServerName)
+
+	ginkgo.By(fmt.Sprintf("checking that the load balancer %s is reachable", hostname))
+	Eventually(func() error {
+		resp, err := http.Get(fmt.Sprintf("http://%s/hostname", hostname))
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		}
+		return nil
+	}, e2eCtx.E2EConfig.GetIntervals(specName, "wait-classic-elb-health-check-long")...).Should(Succeed())
+}
+
+// SkipUnlessUnmanagedIPv6Supported skips the current spec unless the management cluster accepts AWSClusters with
+// IPv6 enabled, so that the IPv6 and dual-stack specs of unmanaged clusters run as soon as it is supported.
+func SkipUnlessUnmanagedIPv6Supported(ctx context.Context, e2eCtx *E2EContext) {
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "ipv6-support-",
+			Namespace:    corev1.NamespaceDefault,
+		},
+		Spec: infrav1.AWSClusterSpec{
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{IPv6: &infrav1.IPv6{}},
+			},
+		},
+	}
+	err := e2eCtx.Environment.BootstrapClusterProxy.GetClient().Create(ctx, awsCluster, crclient.DryRunAll)
+	if err != nil {
+		ginkgo.Skip(fmt.Sprintf("IPv6 is not supported by unmanaged clusters: %v", err))
+	}
+}
+
+// addressesOfFamily returns the addresses of the given IP family.
+func addressesOfFamily(addresses []string, family corev1.IPFamily) []string {
+	var familyAddresses []string
+	for _, address := range addresses {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			continue
+		}
+		if isIPv4 := ip.To4() != nil; isIPv4 == (family == corev1.IPv4Protocol) {
+			familyAddresses = append(familyAddresses, address)
+		}
+	}
+	return familyAddresses
+}
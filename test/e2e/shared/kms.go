@@ -0,0 +1,99 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/sts"
+	. "github.com/onsi/ginkgo/v2"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/converters"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+)
+
+// kmsKeyDeletionWindowDays is the shortest waiting period before the deletion of a KMS key.
+const kmsKeyDeletionWindowDays = 7
+
+// CreateS3BucketKMSKey creates a customer managed KMS key for the bootstrap data bucket of the cluster, and returns its
+// ARN. As the AWS managed key of S3, the key can be used by the principals of the account through S3 only, i.e. by the
+// controller writing the objects and by the instances reading them, with their instance profile or a presigned URL.
+func CreateS3BucketKMSKey(ctx context.Context, e2eCtx *E2EContext, clusterName string) (string, error) {
+	By(fmt.Sprintf("Creating the KMS key of the bootstrap data bucket of cluster %s", clusterName))
+	identity, err := sts.New(e2eCtx.AWSSession).GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get the AWS account: %w", err)
+	}
+	kmsSvc := kms.New(e2eCtx.AWSSession)
+	account := aws.StringValue(identity.Account)
+	policy, err := converters.IAMPolicyDocumentToJSON(iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: []iamv1.StatementEntry{
+			{
+				Sid:       "AccountAdministration",
+				Effect:    iamv1.EffectAllow,
+				Principal: iamv1.Principals{iamv1.PrincipalAWS: iamv1.PrincipalID{fmt.Sprintf("arn:%s:iam::%s:root", e2eCtx.Partition.Name, account)}},
+				Action:    iamv1.Actions{"kms:*"},
+				Resource:  iamv1.Resources{iamv1.Any},
+			},
+			{
+				Sid:       "UseThroughS3",
+				Effect:    iamv1.EffectAllow,
+				Principal: iamv1.Principals{iamv1.PrincipalAWS: iamv1.PrincipalID{iamv1.Any}},
+				Action:    iamv1.Actions{"kms:Encrypt", "kms:Decrypt", "kms:ReEncrypt*", "kms:GenerateDataKey*", "kms:DescribeKey"},
+				Resource:  iamv1.Resources{iamv1.Any},
+				Condition: iamv1.Conditions{
+					"StringEquals": map[string]string{
+						"kms:CallerAccount": account,
+						"kms:ViaService":    fmt.Sprintf("s3.%s.amazonaws.com", aws.StringValue(kmsSvc.Config.Region)),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	out, err := kmsSvc.CreateKeyWithContext(ctx, &kms.CreateKeyInput{
+		Description: aws.String(fmt.Sprintf("Bootstrap data bucket of cluster %s", clusterName)),
+		Policy:      aws.String(policy),
+		Tags:        []*kms.Tag{{TagKey: aws.String(e2eTagKey), TagValue: aws.String("true")}},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create the KMS key: %w", err)
+	}
+	return aws.StringValue(out.KeyMetadata.Arn), nil
+}
+
+// DeleteKMSKey schedules the deletion of the KMS key after the shortest waiting period.
+func DeleteKMSKey(ctx context.Context, e2eCtx *E2EContext, keyID string) error {
+	By(fmt.Sprintf("Scheduling the deletion of KMS key %s", keyID))
+	if _, err := kms.New(e2eCtx.AWSSession).ScheduleKeyDeletionWithContext(ctx, &kms.ScheduleKeyDeletionInput{
+		KeyId:               aws.String(keyID),
+		PendingWindowInDays: aws.Int64(kmsKeyDeletionWindowDays),
+	}); err != nil {
+		return fmt.Errorf("failed to schedule the deletion of KMS key %s: %w", keyID, err)
+	}
+	return nil
+}
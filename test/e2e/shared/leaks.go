@@ -0,0 +1,291 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/gofrs/flock"
+	. "github.com/onsi/ginkgo/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	// e2eTagKey is the key of the tag set on the resources created for the test suite itself.
+	e2eTagKey = "capa-e2e-test"
+
+	clusterNamesFileName = "cluster-names.txt"
+	leakReportFileName   = "aws-resource-leaks.json"
+)
+
+// leakedResourceDeletionOrder is the order in which the leaked resources are deleted, so that the resources are
+// deleted before the resources they depend on. Leaked resources of other types are only reported.
+var leakedResourceDeletionOrder = []string{
+	"ec2:instance",
+	"elasticloadbalancing:loadbalancer",
+	"elasticloadbalancing:targetgroup",
+	"ec2:natgateway",
+	"ec2:elastic-ip",
+	"ec2:volume",
+	"ec2:internet-gateway",
+	"ec2:egress-only-internet-gateway",
+	"ec2:subnet",
+	"ec2:route-table",
+	"ec2:security-group",
+	"ec2:vpc",
+}
+
+// LeakedResource is an AWS resource which still exists after the test suite finished.
+type LeakedResource struct {
+	ARN          string            `json:"arn"`
+	ResourceType string            `json:"resourceType"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	Deleted      bool              `json:"deleted,omitempty"`
+	DeletionErr  string            `json:"deletionError,omitempty"`
+}
+
+// recordClusterNames appends the names of the clusters of the namespace to the file listing the clusters created by
+// the test suite, so that the resources they leak can be found once the suite finished.
+func recordClusterNames(ctx context.Context, e2eCtx *E2EContext, namespace *corev1.Namespace) {
+	clusters := &clusterv1.ClusterList{}
+	if err := e2eCtx.Environment.BootstrapClusterProxy.GetClient().List(ctx, clusters, crclient.InNamespace(namespace.Name)); err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't list the clusters of namespace %q: err=%s\n", namespace.Name, err)
+		return
+	}
+	if len(clusters.Items) == 0 {
+		return
+	}
+
+	clusterNamesPath := filepath.Join(e2eCtx.Settings.ArtifactFolder, clusterNamesFileName)
+	fileLock := flock.New(clusterNamesPath + ".lock")
+	if err := fileLock.Lock(); err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't lock file: file=%q err=%s\n", clusterNamesPath, err)
+		return
+	}
+	defer func() {
+		_ = fileLock.Unlock()
+	}()
+
+	f, err := os.OpenFile(filepath.Clean(clusterNamesPath), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't open file: file=%q err=%s\n", clusterNamesPath, err)
+		return
+	}
+	defer f.Close()
+	for _, cluster := range clusters.Items {
+		if _, err := fmt.Fprintln(f, cluster.Name); err != nil {
+			fmt.Fprintf(GinkgoWriter, "Couldn't write file: file=%q err=%s\n", clusterNamesPath, err)
+			return
+		}
+	}
+}
+
+// DetectLeakedResources looks for the resources of the regions of the specs still tagged with the names of the clusters created by
+// the test suite or, if the CloudFormation stack of the suite was deleted, with the e2e tag. It writes them to a
+// report in the artifacts folder and, if enabled, attempts to delete them.
+func DetectLeakedResources(e2eCtx *E2EContext) {
+	By("Looking for leaked AWS resources")
+	tagKeys := sets.New[string]()
+	data, err := os.ReadFile(filepath.Join(e2eCtx.Settings.ArtifactFolder, clusterNamesFileName))
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Fprintf(GinkgoWriter, "Couldn't read the names of the clusters created by the tests: err=%s\n", err)
+		return
+	}
+	for _, name := range strings.Fields(string(data)) {
+		tagKeys.Insert(infrav1.ClusterTagKey(name), infrav1.ClusterAWSCloudProviderTagKey(name))
+	}
+	if !e2eCtx.Settings.SkipCloudFormationDeletion {
+		tagKeys.Insert(e2eTagKey)
+	}
+
+	leaks := []*LeakedResource{}
+	for _, region := range e2eCtx.Regions() {
+		sess := NewAWSSessionInRegion(region)
+		regionLeaks, err := findResourcesTaggedWith(sess, sets.List(tagKeys))
+		if err != nil {
+			fmt.Fprintf(GinkgoWriter, "Couldn't look for leaked AWS resources: region=%s err=%s\n", region, err)
+			continue
+		}
+		if len(regionLeaks) == 0 {
+			By(fmt.Sprintf("No leaked AWS resources found in region %s", region))
+			continue
+		}
+		By(fmt.Sprintf("Found %d leaked AWS resources in region %s", len(regionLeaks), region))
+		if e2eCtx.Settings.DeleteLeakedResources {
+			deleteLeakedResources(sess, regionLeaks)
+		}
+		leaks = append(leaks, regionLeaks...)
+	}
+
+	reportPath := filepath.Join(e2eCtx.Settings.ArtifactFolder, leakReportFileName)
+	report, err := json.MarshalIndent(leaks, "", "  ")
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Failed to marshal the leaked AWS resources: err=%v\n", err)
+		return
+	}
+	if err := os.WriteFile(reportPath, report, 0o600); err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't write the leaked AWS resources to file: file=%q err=%s\n", reportPath, err)
+	}
+}
+
+// findResourcesTaggedWith returns the resources of the region which have one of the tag keys.
+func findResourcesTaggedWith(sess client.ConfigProvider, tagKeys []string) ([]*LeakedResource, error) {
+	taggingClient := resourcegroupstaggingapi.New(sess)
+	leaks := []*LeakedResource{}
+	seen := sets.New[string]()
+	for _, key := range tagKeys {
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			TagFilters: []*resourcegroupstaggingapi.TagFilter{{Key: aws.String(key)}},
+		}
+		err := taggingClient.GetResourcesPages(input, func(out *resourcegroupstaggingapi.GetResourcesOutput, _ bool) bool {
+			for _, mapping := range out.ResourceTagMappingList {
+				resourceARN := aws.StringValue(mapping.ResourceARN)
+				if seen.Has(resourceARN) {
+					continue
+				}
+				seen.Insert(resourceARN)
+				leak := &LeakedResource{ARN: resourceARN, Tags: map[string]string{}}
+				if parsed, err := arn.Parse(resourceARN); err == nil {
+					leak.ResourceType = parsed.Service + ":" + strings.Split(parsed.Resource, "/")[0]
+				}
+				for _, tag := range mapping.Tags {
+					leak.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+				}
+				leaks = append(leaks, leak)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(leaks, func(i, j int) bool { return leaks[i].ARN < leaks[j].ARN })
+	return leaks, nil
+}
+
+// deleteLeakedResources attempts to delete the leaked resources of the supported types, in dependency order.
+// The deletion of a resource may fail if the resources depending on it are still being deleted.
+func deleteLeakedResources(sess client.ConfigProvider, leaks []*LeakedResource) {
+	ec2Client := ec2.New(sess)
+	elbClient := elb.New(sess)
+	elbv2Client := elbv2.New(sess)
+	for _, resourceType := range leakedResourceDeletionOrder {
+		for _, leak := range leaks {
+			if leak.ResourceType != resourceType {
+				continue
+			}
+			By(fmt.Sprintf("Deleting leaked AWS resource %s", leak.ARN))
+			if err := deleteLeakedResource(ec2Client, elbClient, elbv2Client, leak); err != nil {
+				leak.DeletionErr = err.Error()
+				fmt.Fprintf(GinkgoWriter, "Couldn't delete leaked AWS resource %s: err=%s\n", leak.ARN, err)
+				continue
+			}
+			leak.Deleted = true
+		}
+	}
+}
+
+func deleteLeakedResource(ec2Client *ec2.EC2, elbClient *elb.ELB, elbv2Client *elbv2.ELBV2, leak *LeakedResource) error {
+	parsed, err := arn.Parse(leak.ARN)
+	if err != nil {
+		return err
+	}
+	parts := strings.Split(parsed.Resource, "/")
+	id := aws.String(parts[len(parts)-1])
+
+	switch leak.ResourceType {
+	case "ec2:instance":
+		if _, err := ec2Client.TerminateInstances(&ec2.TerminateInstancesInput{InstanceIds: []*string{id}}); err != nil {
+			return err
+		}
+		return ec2Client.WaitUntilInstanceTerminated(&ec2.DescribeInstancesInput{InstanceIds: []*string{id}})
+	case "elasticloadbalancing:loadbalancer":
+		// The ARNs of classic load balancers are loadbalancer/<name>, the ARNs of the others
+		// loadbalancer/<type>/<name>/<id>.
+		if len(parts) == 2 {
+			_, err = elbClient.DeleteLoadBalancer(&elb.DeleteLoadBalancerInput{LoadBalancerName: id})
+			return err
+		}
+		_, err = elbv2Client.DeleteLoadBalancer(&elbv2.DeleteLoadBalancerInput{LoadBalancerArn: aws.String(leak.ARN)})
+		return err
+	case "elasticloadbalancing:targetgroup":
+		_, err = elbv2Client.DeleteTargetGroup(&elbv2.DeleteTargetGroupInput{TargetGroupArn: aws.String(leak.ARN)})
+		return err
+	case "ec2:natgateway":
+		if _, err := ec2Client.DeleteNatGateway(&ec2.DeleteNatGatewayInput{NatGatewayId: id}); err != nil {
+			return err
+		}
+		return ec2Client.WaitUntilNatGatewayDeleted(&ec2.DescribeNatGatewaysInput{NatGatewayIds: []*string{id}})
+	case "ec2:elastic-ip":
+		_, err = ec2Client.ReleaseAddress(&ec2.ReleaseAddressInput{AllocationId: id})
+		return err
+	case "ec2:volume":
+		_, err = ec2Client.DeleteVolume(&ec2.DeleteVolumeInput{VolumeId: id})
+		return err
+	case "ec2:internet-gateway":
+		out, err := ec2Client.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{InternetGatewayIds: []*string{id}})
+		if err != nil {
+			return err
+		}
+		for _, igw := range out.InternetGateways {
+			for _, attachment := range igw.Attachments {
+				if _, err := ec2Client.DetachInternetGateway(&ec2.DetachInternetGatewayInput{InternetGatewayId: id, VpcId: attachment.VpcId}); err != nil {
+					return err
+				}
+			}
+		}
+		_, err = ec2Client.DeleteInternetGateway(&ec2.DeleteInternetGatewayInput{InternetGatewayId: id})
+		return err
+	case "ec2:egress-only-internet-gateway":
+		_, err = ec2Client.DeleteEgressOnlyInternetGateway(&ec2.DeleteEgressOnlyInternetGatewayInput{EgressOnlyInternetGatewayId: id})
+		return err
+	case "ec2:subnet":
+		_, err = ec2Client.DeleteSubnet(&ec2.DeleteSubnetInput{SubnetId: id})
+		return err
+	case "ec2:route-table":
+		_, err = ec2Client.DeleteRouteTable(&ec2.DeleteRouteTableInput{RouteTableId: id})
+		return err
+	case "ec2:security-group":
+		_, err = ec2Client.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{GroupId: id})
+		return err
+	case "ec2:vpc":
+		_, err = ec2Client.DeleteVpc(&ec2.DeleteVpcInput{VpcId: id})
+		return err
+	}
+	return fmt.Errorf("deletion of %s resources is not supported", leak.ResourceType)
+}
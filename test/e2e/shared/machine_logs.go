@@ -0,0 +1,138 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo/v2"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// DumpFailedSpecMachines collects the logs helping to understand why the machines of a failed spec did not join
+// their cluster, for every AWS machine of the namespace having an instance: the EC2 console output, the instance
+// status checks and, through SSM, the cloud-init logs. They are written next to the logs written by DumpMachine.
+func DumpFailedSpecMachines(ctx context.Context, e2eCtx *E2EContext, namespace *corev1.Namespace) {
+	machines := MachinesForSpec(ctx, e2eCtx.Environment.BootstrapClusterProxy, namespace)
+	if machines == nil {
+		return
+	}
+	ec2Svc := ec2.New(e2eCtx.AWSSession)
+	for _, machine := range machines.Items {
+		instanceID := aws.StringValue(machine.Spec.InstanceID)
+		if instanceID == "" {
+			continue
+		}
+		logDir := machineLogDir(e2eCtx, machine, nil)
+		if err := os.MkdirAll(logDir, 0o750); err != nil {
+			fmt.Fprintf(GinkgoWriter, "Couldn't create directory: path=%q, err=%s\n", logDir, err)
+			continue
+		}
+		dumpConsoleOutput(ctx, ec2Svc, instanceID, filepath.Join(logDir, "console-output.log"))
+		dumpInstanceStatus(ctx, ec2Svc, instanceID, filepath.Join(logDir, "instance-status.yaml"))
+		dumpCloudInitLogs(ctx, e2eCtx, instanceID, filepath.Join(logDir, "instance.log"))
+	}
+}
+
+// dumpConsoleOutput writes the latest console output of the instance, which is available even when the instance
+// cannot be reached through SSM, e.g. because it failed to boot.
+func dumpConsoleOutput(ctx context.Context, ec2Svc *ec2.EC2, instanceID, logFile string) {
+	out, err := ec2Svc.GetConsoleOutputWithContext(ctx, &ec2.GetConsoleOutputInput{
+		InstanceId: aws.String(instanceID),
+		Latest:     aws.Bool(true),
+	})
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't get the console output of instance %s: err=%s\n", instanceID, err)
+		return
+	}
+	output, err := base64.StdEncoding.DecodeString(aws.StringValue(out.Output))
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't decode the console output of instance %s: err=%s\n", instanceID, err)
+		return
+	}
+	if err := os.WriteFile(logFile, output, 0o600); err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't write file: path=%q, err=%s\n", logFile, err)
+	}
+}
+
+// dumpInstanceStatus writes the state and the system and instance status checks of the instance.
+func dumpInstanceStatus(ctx context.Context, ec2Svc *ec2.EC2, instanceID, logFile string) {
+	out, err := ec2Svc.DescribeInstanceStatusWithContext(ctx, &ec2.DescribeInstanceStatusInput{
+		InstanceIds:         aws.StringSlice([]string{instanceID}),
+		IncludeAllInstances: aws.Bool(true),
+	})
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't get the status of instance %s: err=%s\n", instanceID, err)
+		return
+	}
+	data, err := yaml.Marshal(out.InstanceStatuses)
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't marshal the status of instance %s: err=%s\n", instanceID, err)
+		return
+	}
+	if err := os.WriteFile(logFile, data, 0o600); err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't write file: path=%q, err=%s\n", logFile, err)
+	}
+}
+
+// dumpCloudInitLogs writes the cloud-init logs of the instance, fetched through SSM, next to the log file recording
+// the SSM session errors.
+func dumpCloudInitLogs(ctx context.Context, e2eCtx *E2EContext, instanceID, logFile string) {
+	f, err := os.OpenFile(logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644) //nolint:gosec
+	if err != nil {
+		fmt.Fprintf(GinkgoWriter, "Couldn't open file: path=%q, err=%s\n", logFile, err)
+		return
+	}
+	defer f.Close()
+	commandsForMachine(
+		ctx,
+		e2eCtx,
+		f,
+		instanceID,
+		[]command{
+			{
+				title: "cloud-init",
+				cmd:   "cat /var/log/cloud-init.log",
+			},
+			{
+				title: "cloud-init-output",
+				cmd:   "cat /var/log/cloud-init-output.log",
+			},
+		},
+	)
+}
+
+// machineLogDir returns the directory the logs of the machine are written to.
+func machineLogDir(e2eCtx *E2EContext, machine infrav1.AWSMachine, cluster *string) string {
+	logPath := filepath.Join(e2eCtx.Settings.ArtifactFolder, "clusters", e2eCtx.Environment.BootstrapClusterProxy.GetName())
+	if cluster != nil {
+		logPath = filepath.Join(e2eCtx.Settings.ArtifactFolder, "clusters", *cluster)
+	}
+	return filepath.Join(logPath, "instances", machine.Namespace, machine.Name)
+}
@@ -0,0 +1,154 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go/service/sts"
+	. "github.com/onsi/ginkgo/v2"
+
+	cfn_bootstrap "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/cloudformation/bootstrap"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/converters"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+)
+
+// EnsureMultitenancyRoles creates the multi-tenancy roles missing in the account, e.g. when the CloudFormation stack
+// creation is skipped against a stack created before the roles were added to it. The roles are created as in the
+// stack: the simple and nested roles get the controllers policy, and the jump role may only assume the nested role.
+// The created roles are recorded so that DeleteMultitenancyRoles deletes them once the tests finished.
+func EnsureMultitenancyRoles(ctx context.Context, e2eCtx *E2EContext) error {
+	iamSvc := iam.NewFromConfig(*e2eCtx.AWSSessionV2)
+
+	var missing []MultitenancyRole
+	for _, role := range MultiTenancyRoles {
+		_, err := iamSvc.GetRole(ctx, &iam.GetRoleInput{RoleName: awsv2.String(role.RoleName())})
+		var noSuchEntityErr *iamtypes.NoSuchEntityException
+		switch {
+		case errors.As(err, &noSuchEntityErr):
+			missing = append(missing, role)
+		case err != nil:
+			return fmt.Errorf("failed to get role %s: %w", role.RoleName(), err)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	identity, err := sts.New(e2eCtx.AWSSession).GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return fmt.Errorf("failed to get the AWS account: %w", err)
+	}
+	accountARN := fmt.Sprintf("arn:%s:iam::%s:root", e2eCtx.Partition.Name, awsv2.ToString(identity.Account))
+	trustPolicy, err := converters.IAMPolicyDocumentToJSON(*cfn_bootstrap.AssumeRolePolicy(iamv1.PrincipalAWS, []string{accountARN}))
+	if err != nil {
+		return err
+	}
+	controllersPolicyName := getBootstrapTemplate(e2eCtx).NewManagedName("controllers")
+	controllersPolicyARN := GetPolicyArn(ctx, *e2eCtx.AWSSessionV2, controllersPolicyName)
+	if controllersPolicyARN == "" {
+		return fmt.Errorf("policy %s not found, the CloudFormation stack must be created first", controllersPolicyName)
+	}
+
+	for _, role := range missing {
+		By(fmt.Sprintf("Creating missing multi-tenancy role %s", role.RoleName()))
+		out, err := iamSvc.CreateRole(ctx, &iam.CreateRoleInput{
+			RoleName:                 awsv2.String(role.RoleName()),
+			AssumeRolePolicyDocument: awsv2.String(trustPolicy),
+			Tags:                     []iamtypes.Tag{{Key: awsv2.String(e2eTagKey), Value: awsv2.String("true")}},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create role %s: %w", role.RoleName(), err)
+		}
+		e2eCtx.Environment.CreatedMultitenancyRoles = append(e2eCtx.Environment.CreatedMultitenancyRoles, role)
+		roleLookupCache[role.RoleName()] = awsv2.ToString(out.Role.Arn)
+	}
+
+	for _, role := range missing {
+		if role == MultiTenancyJumpRole {
+			if err := putJumpRolePolicy(ctx, e2eCtx.AWSSessionV2); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := iamSvc.AttachRolePolicy(ctx, &iam.AttachRolePolicyInput{
+			RoleName:  awsv2.String(role.RoleName()),
+			PolicyArn: awsv2.String(controllersPolicyARN),
+		}); err != nil {
+			return fmt.Errorf("failed to attach policy %s to role %s: %w", controllersPolicyName, role.RoleName(), err)
+		}
+	}
+	return nil
+}
+
+// putJumpRolePolicy allows the jump role to assume the nested role, as the jump policy of the CloudFormation stack.
+func putJumpRolePolicy(ctx context.Context, cfg *awsv2.Config) error {
+	nestedRoleARN, err := MultiTenancyNestedRole.RoleARN(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	policy, err := converters.IAMPolicyDocumentToJSON(iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: []iamv1.StatementEntry{
+			{
+				Effect:   iamv1.EffectAllow,
+				Resource: iamv1.Resources{nestedRoleARN},
+				Action:   iamv1.Actions{"sts:AssumeRole"},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := iam.NewFromConfig(*cfg).PutRolePolicy(ctx, &iam.PutRolePolicyInput{
+		RoleName:       awsv2.String(MultiTenancyJumpRole.RoleName()),
+		PolicyName:     awsv2.String(MultiTenancyJumpPolicy),
+		PolicyDocument: awsv2.String(policy),
+	}); err != nil {
+		return fmt.Errorf("failed to put policy %s on role %s: %w", MultiTenancyJumpPolicy, MultiTenancyJumpRole.RoleName(), err)
+	}
+	return nil
+}
+
+// DeleteMultitenancyRoles deletes the multi-tenancy roles created by EnsureMultitenancyRoles.
+func DeleteMultitenancyRoles(ctx context.Context, e2eCtx *E2EContext) {
+	iamSvc := iam.NewFromConfig(*e2eCtx.AWSSessionV2)
+	for _, role := range e2eCtx.Environment.CreatedMultitenancyRoles {
+		By(fmt.Sprintf("Deleting multi-tenancy role %s", role.RoleName()))
+		if role == MultiTenancyJumpRole {
+			if _, err := iamSvc.DeleteRolePolicy(ctx, &iam.DeleteRolePolicyInput{
+				RoleName:   awsv2.String(role.RoleName()),
+				PolicyName: awsv2.String(MultiTenancyJumpPolicy),
+			}); err != nil {
+				By(fmt.Sprintf("failed to delete policy %s of role %s: %s", MultiTenancyJumpPolicy, role.RoleName(), err))
+			}
+		}
+		if err := DeleteRole(ctx, e2eCtx.AWSSessionV2, role.RoleName()); err != nil {
+			By(fmt.Sprintf("failed to delete role %s: %s", role.RoleName(), err))
+		}
+		delete(roleLookupCache, role.RoleName())
+	}
+	e2eCtx.Environment.CreatedMultitenancyRoles = nil
+}
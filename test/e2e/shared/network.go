@@ -0,0 +1,129 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api/util"
+)
+
+const (
+	// SharedNetworkName is the name of the long-lived network the BYO infrastructure specs create their clusters in
+	// with the reuse-network flag.
+	SharedNetworkName = "capa-e2e-shared-network"
+
+	// The variables giving the network to the BYO infrastructure flavors.
+	byoVPCID            = "BYO_VPC_ID"
+	byoPublicSubnetID   = "BYO_PUBLIC_SUBNET_ID"
+	byoPrivateSubnetID  = "BYO_PRIVATE_SUBNET_ID"
+	byoAvailabilityZone = "BYO_AVAILABILITY_ZONE"
+)
+
+// EnsureSharedNetwork creates the shared network in each region the specs are distributed to, unless a previous run
+// already created it. It is never deleted, so that the following runs reuse it.
+func EnsureSharedNetwork(e2eCtx *E2EContext) {
+	for _, region := range e2eCtx.Regions() {
+		regionCtx := *e2eCtx
+		regionCtx.AWSSession = NewAWSSessionInRegion(region)
+		if vpc, err := GetVPCByName(&regionCtx, SharedNetworkName+"-vpc"); err == nil {
+			By(fmt.Sprintf("Reusing shared network %s in region %s", aws.StringValue(vpc.VpcId), region))
+			continue
+		}
+
+		By(fmt.Sprintf("Creating shared network in region %s", region))
+		azs := GetAvailabilityZones(regionCtx.AWSSession)
+		infra := new(AWSInfrastructure)
+		infra.New(AWSInfrastructureSpec{
+			ClusterName:       SharedNetworkName,
+			VpcCidr:           "10.0.0.0/23",
+			PublicSubnetCidr:  "10.0.0.0/24",
+			PrivateSubnetCidr: "10.0.1.0/24",
+			AvailabilityZone:  aws.StringValue(azs[0].ZoneName),
+		}, &regionCtx)
+		infra.CreateInfrastructure()
+		Expect(infra.State.PublicRouteTableID).NotTo(BeNil(), "Failed to create the shared network in region %s", region)
+		Expect(infra.State.PrivateRouteTableID).NotTo(BeNil(), "Failed to create the shared network in region %s", region)
+		Expect(infra.NatGateway).NotTo(BeNil(), "Failed to create the shared network in region %s", region)
+	}
+}
+
+// SpecNetworkResources returns the resources the network of a BYO infrastructure spec requires, none when the spec
+// reuses the shared network.
+func SpecNetworkResources(e2eCtx *E2EContext) TestResource {
+	if e2eCtx.Settings.ReuseNetwork {
+		return TestResource{}
+	}
+	return TestResource{IGW: 1, NGW: 1, VPC: 1, EIP: 1}
+}
+
+// SetupSpecNetwork sets the variables giving the network of a BYO infrastructure spec to its flavor, in the region
+// of the spec. With the reuse-network flag, the spec uses the shared network. Otherwise, a network is created for the
+// spec and deleted by the returned function, which must be called once the clusters of the spec are deleted.
+func SetupSpecNetwork(e2eCtx *E2EContext, specName string) func() {
+	if e2eCtx.Settings.ReuseNetwork {
+		vpc, err := GetVPCByName(e2eCtx, SharedNetworkName+"-vpc")
+		Expect(err).NotTo(HaveOccurred(), "Failed to get the shared network")
+		public, err := GetSubnetByName(e2eCtx, SharedNetworkName+"-subnet-public")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(public).NotTo(BeNil(), "Failed to get the public subnet of the shared network")
+		private, err := GetSubnetByName(e2eCtx, SharedNetworkName+"-subnet-private")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(private).NotTo(BeNil(), "Failed to get the private subnet of the shared network")
+
+		By(fmt.Sprintf("Using shared network %s", aws.StringValue(vpc.VpcId)))
+		setSpecNetworkVariables(aws.StringValue(vpc.VpcId), aws.StringValue(public.SubnetId), aws.StringValue(private.SubnetId), aws.StringValue(public.AvailabilityZone))
+		return func() {}
+	}
+
+	By("Creating the network of the spec")
+	infra := new(AWSInfrastructure)
+	infra.New(AWSInfrastructureSpec{
+		ClusterName:       specName + "-" + util.RandomString(6),
+		VpcCidr:           "10.0.0.0/23",
+		PublicSubnetCidr:  "10.0.0.0/24",
+		PrivateSubnetCidr: "10.0.1.0/24",
+		AvailabilityZone:  aws.StringValue(GetAvailabilityZones(e2eCtx.AWSSession)[0].ZoneName),
+	}, e2eCtx)
+	cleanup := func() {
+		if !e2eCtx.Settings.SkipCleanup {
+			By("Deleting the network of the spec")
+			infra.DeleteInfrastructure()
+		}
+	}
+	infra.CreateInfrastructure()
+	if infra.State.PublicSubnetID == nil || infra.State.PrivateSubnetID == nil {
+		cleanup()
+		Fail("Failed to create the network of the spec")
+	}
+	setSpecNetworkVariables(aws.StringValue(infra.VPC.VpcId), aws.StringValue(infra.State.PublicSubnetID), aws.StringValue(infra.State.PrivateSubnetID), infra.Spec.AvailabilityZone)
+	return cleanup
+}
+
+func setSpecNetworkVariables(vpcID, publicSubnetID, privateSubnetID, availabilityZone string) {
+	SetEnvVar(byoVPCID, vpcID, false)
+	SetEnvVar(byoPublicSubnetID, publicSubnetID, false)
+	SetEnvVar(byoPrivateSubnetID, privateSubnetID, false)
+	SetEnvVar(byoAvailabilityZone, availabilityZone, false)
+}
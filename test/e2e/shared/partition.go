@@ -0,0 +1,85 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/api/bootstrap/v1beta1"
+)
+
+// PartitionSettings holds the settings of the AWS partition the tests run against.
+type PartitionSettings struct {
+	// Name is the name of the partition, e.g. aws or aws-us-gov.
+	Name string
+	// ECRPublicRegion is the region of the ECR Public registry the test image is uploaded to.
+	// It is empty if ECR Public is not available in the partition.
+	ECRPublicRegion string
+	// ImageLookupOrg is the AWS account owning the AMIs used by the tests.
+	ImageLookupOrg string
+	// MachineType is the instance type of the control plane and worker machines. The instance types of the e2e
+	// config are used if it is empty.
+	MachineType string
+}
+
+// partitions holds the default settings of the partitions the tests can run against.
+var partitions = map[string]PartitionSettings{
+	bootstrapv1.DefaultPartitionName: {
+		Name:            bootstrapv1.DefaultPartitionName,
+		ECRPublicRegion: "us-east-1",
+		ImageLookupOrg:  DefaultImageLookupOrg,
+	},
+	// The CAPA AMIs are not published in GovCloud, the AMIs must be built in the account running the tests.
+	// Burstable instances are not available in every GovCloud availability zone.
+	bootstrapv1.PartitionNameUSGov: {
+		Name:        bootstrapv1.PartitionNameUSGov,
+		MachineType: "m5.large",
+	},
+}
+
+// ResolvePartitionSettings returns the settings of the partition selected by the partition flag, overridden
+// by the image-lookup-org and machine-type flags.
+func ResolvePartitionSettings(settings Settings) (PartitionSettings, error) {
+	partition, ok := partitions[settings.Partition]
+	if !ok {
+		return PartitionSettings{}, fmt.Errorf("unsupported partition %q", settings.Partition)
+	}
+	if settings.ImageLookupOrg != "" {
+		partition.ImageLookupOrg = settings.ImageLookupOrg
+	}
+	if settings.MachineType != "" {
+		partition.MachineType = settings.MachineType
+	}
+	if partition.ImageLookupOrg == "" {
+		return PartitionSettings{}, fmt.Errorf("the image-lookup-org flag is required to run the tests in partition %q", partition.Name)
+	}
+	return partition, nil
+}
+
+// setPartitionVariables sets the variables of the e2e config which depend on the partition.
+func setPartitionVariables(e2eCtx *E2EContext) {
+	partition := e2eCtx.Partition
+	e2eCtx.E2EConfig.Variables["AWS_PARTITION"] = partition.Name
+	e2eCtx.E2EConfig.Variables["IMAGE_LOOKUP_ORG"] = partition.ImageLookupOrg
+	if partition.MachineType != "" {
+		e2eCtx.E2EConfig.Variables["AWS_CONTROL_PLANE_MACHINE_TYPE"] = partition.MachineType
+		e2eCtx.E2EConfig.Variables[AwsNodeMachineType] = partition.MachineType
+	}
+}
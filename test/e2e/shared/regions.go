@@ -0,0 +1,153 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/credentials"
+)
+
+// RegionalResourceStore stores the resources available to the tests in each of the regions the specs are
+// distributed to.
+type RegionalResourceStore struct {
+	regions []string
+	stores  map[string]ResourceStore
+}
+
+// NewRegionalResourceStore returns the stores of the resources available in each region the specs are distributed
+// to. The resources of a single region are stored under the file path or key given by the flags, the resources of
+// several regions under the file path or key suffixed with the region.
+func NewRegionalResourceStore(e2eCtx *E2EContext) (*RegionalResourceStore, error) {
+	regions := e2eCtx.Regions()
+	stores := &RegionalResourceStore{regions: regions, stores: map[string]ResourceStore{}}
+	for _, region := range regions {
+		suffix := ""
+		if len(regions) > 1 {
+			suffix = "-" + region
+		}
+		store, err := newResourceStore(e2eCtx, suffix)
+		if err != nil {
+			return nil, err
+		}
+		stores.stores[region] = store
+	}
+	return stores, nil
+}
+
+// Region returns the store of the resources available in the region.
+func (s *RegionalResourceStore) Region(region string) ResourceStore {
+	return s.stores[region]
+}
+
+// regionsFrom returns the regions, starting from the one assigned to the ginkgo node so that the nodes spread
+// their specs across the regions.
+func (s *RegionalResourceStore) regionsFrom(nodeNum int) []string {
+	start := nodeNum % len(s.regions)
+	return append(append([]string{}, s.regions[start:]...), s.regions[:start]...)
+}
+
+// Regions returns the regions the specs are distributed to, the region of the environment by default.
+func (c *E2EContext) Regions() []string {
+	if len(c.Settings.Regions) > 0 {
+		return c.Settings.Regions
+	}
+	region, err := credentials.ResolveRegion("")
+	Expect(err).NotTo(HaveOccurred())
+	return []string{region}
+}
+
+// specRegions tracks the region of the current spec of the ginkgo node. The specs of a node run sequentially.
+var specRegions = struct {
+	sync.Mutex
+	// acquired is the region where the resources of the current spec were acquired.
+	acquired string
+	// count is the number of specs whose region was picked without acquiring resources.
+	count int
+}{}
+
+func setAcquiredRegion(region string) {
+	specRegions.Lock()
+	defer specRegions.Unlock()
+	specRegions.acquired = region
+}
+
+// pickSpecRegion returns the region of the current spec: the region where its resources were acquired, or the
+// next region in turn if it did not acquire any.
+func pickSpecRegion(regions []string) string {
+	specRegions.Lock()
+	defer specRegions.Unlock()
+	if specRegions.acquired != "" {
+		region := specRegions.acquired
+		specRegions.acquired = ""
+		return region
+	}
+	region := regions[(GinkgoParallelProcess()+specRegions.count)%len(regions)]
+	specRegions.count++
+	return region
+}
+
+// useSpecRegion points the environment variables used by the cluster templates and the AWS sessions of the context
+// to the region of the current spec, if the specs are distributed to several regions.
+func useSpecRegion(e2eCtx *E2EContext) {
+	regions := e2eCtx.Regions()
+	if len(regions) < 2 {
+		return
+	}
+	region := pickSpecRegion(regions)
+	By(fmt.Sprintf("Running the spec in region %s", region))
+	SetEnvVar("AWS_REGION", region, false)
+	e2eCtx.AWSSession = NewAWSSession()
+	e2eCtx.AWSSessionV2 = NewAWSSessionV2()
+	if e2eCtx.Environment.BootstrapAccessKey != nil {
+		e2eCtx.BootstrapUserAWSSession = NewAWSSessionWithKey(e2eCtx.Environment.BootstrapAccessKey)
+		e2eCtx.BootstrapUserAWSSessionV2 = NewAWSSessionWithKeyV2(e2eCtx.Environment.BootstrapAccessKey)
+	}
+	azs := GetAvailabilityZones(e2eCtx.AWSSession)
+	SetEnvVar(AwsAvailabilityZone1, *azs[0].ZoneName, false)
+	SetEnvVar(AwsAvailabilityZone2, *azs[1].ZoneName, false)
+}
+
+// restoreHomeRegion points the environment variables and the AWS sessions of the context back to the region of the
+// bootstrap CloudFormation stack, once the specs are done.
+func restoreHomeRegion(e2eCtx *E2EContext) {
+	if len(e2eCtx.Regions()) < 2 {
+		return
+	}
+	SetEnvVar("AWS_REGION", getBootstrapTemplate(e2eCtx).Spec.Region, false)
+	e2eCtx.AWSSession = NewAWSSession()
+	e2eCtx.AWSSessionV2 = NewAWSSessionV2()
+}
+
+// parseRegions parses a comma separated list of regions.
+func parseRegions(value string) []string {
+	regions := []string{}
+	for _, region := range strings.Split(value, ",") {
+		if region = strings.TrimSpace(region); region != "" {
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
@@ -25,6 +25,8 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/servicequotas"
@@ -44,15 +46,15 @@ type TestResource struct {
 	EC2GPU           int `json:"ec2-GPU"`
 	VolumeGP2        int `json:"volume-GP2"`
 	EventBridgeRules int `json:"eventBridge-rules"`
-}
+	EKSCluster       int `json:"eks-cluster"`
 
-func WriteResourceQuotesToFile(logPath string, serviceQuotas map[string]*ServiceQuota) {
-	if _, err := os.Stat(logPath); err == nil {
-		// If resource-quotas file exists, remove it. Should not fail on error, another ginkgo node might have already deleted it.
-		os.Remove(logPath)
-	}
+	// region is the region where the resources were acquired.
+	region string
+}
 
-	resources := TestResource{
+// NewTestResource returns the resources allowed by the service quotas.
+func NewTestResource(serviceQuotas map[string]*ServiceQuota) *TestResource {
+	return &TestResource{
 		EC2Normal:        serviceQuotas["ec2-normal"].Value,
 		VPC:              serviceQuotas["vpc"].Value,
 		EIP:              serviceQuotas["eip"].Value,
@@ -62,8 +64,17 @@ func WriteResourceQuotesToFile(logPath string, serviceQuotas map[string]*Service
 		EC2GPU:           serviceQuotas["ec2-GPU"].Value,
 		VolumeGP2:        serviceQuotas["volume-GP2"].Value,
 		EventBridgeRules: serviceQuotas["eventBridge-rules"].Value,
+		EKSCluster:       serviceQuotas["eks-cluster"].Value,
+	}
+}
+
+func WriteResourceQuotesToFile(logPath string, serviceQuotas map[string]*ServiceQuota) {
+	if _, err := os.Stat(logPath); err == nil {
+		// If resource-quotas file exists, remove it. Should not fail on error, another ginkgo node might have already deleted it.
+		os.Remove(logPath)
 	}
-	data, err := yaml.Marshal(resources)
+
+	data, err := yaml.Marshal(NewTestResource(serviceQuotas))
 	Expect(err).NotTo(HaveOccurred())
 
 	err = os.WriteFile(logPath, data, 0644) //nolint:gosec
@@ -84,7 +95,7 @@ func WriteAWSResourceQuotesToFile(logPath string, serviceQuotas map[string]*serv
 }
 
 func (r *TestResource) String() string {
-	return fmt.Sprintf("{ec2-normal:%v, vpc:%v, eip:%v, ngw:%v, igw:%v, classiclb:%v, ec2-GPU:%v, volume-gp2:%v, eventBridge-rules:%v}", r.EC2Normal, r.VPC, r.EIP, r.NGW, r.IGW, r.ClassicLB, r.EC2GPU, r.VolumeGP2, r.EventBridgeRules)
+	return fmt.Sprintf("{ec2-normal:%v, vpc:%v, eip:%v, ngw:%v, igw:%v, classiclb:%v, ec2-GPU:%v, volume-gp2:%v, eventBridge-rules:%v, eks-cluster:%v}", r.EC2Normal, r.VPC, r.EIP, r.NGW, r.IGW, r.ClassicLB, r.EC2GPU, r.VolumeGP2, r.EventBridgeRules, r.EKSCluster)
 }
 
 func (r *TestResource) WriteRequestedResources(e2eCtx *E2EContext, testName string) {
@@ -124,6 +135,58 @@ func (r *TestResource) WriteRequestedResources(e2eCtx *E2EContext, testName stri
 	str, err := yaml.Marshal(resources)
 	Expect(err).NotTo(HaveOccurred())
 	Expect(os.WriteFile(requestedResourceFilePath, str, 0644)).To(Succeed()) //nolint:gosec
+	r.trackUsage(testName, e2eCtx.Settings.ArtifactFolder)
+}
+
+// amounts returns the amount of each resource, keyed by the name of the service quota limiting it.
+func (r *TestResource) amounts() map[string]int {
+	return map[string]int{
+		"ec2-normal":        r.EC2Normal,
+		"vpc":               r.VPC,
+		"eip":               r.EIP,
+		"igw":               r.IGW,
+		"ngw":               r.NGW,
+		"classiclb":         r.ClassicLB,
+		"ec2-GPU":           r.EC2GPU,
+		"volume-GP2":        r.VolumeGP2,
+		"eventBridge-rules": r.EventBridgeRules,
+		"eks-cluster":       r.EKSCluster,
+	}
+}
+
+// SkipIfServiceQuotasInsufficient skips the spec if the service quotas of the account are too low for the requested
+// resources in every region, as they could never be acquired.
+func SkipIfServiceQuotasInsufficient(e2eCtx *E2EContext, request *TestResource) {
+	if len(e2eCtx.Environment.ServiceQuotas) == 0 {
+		return
+	}
+	var insufficient []string
+	for region, quotas := range e2eCtx.Environment.ServiceQuotas {
+		regionInsufficient := insufficientServiceQuotas(quotas, request)
+		if len(regionInsufficient) == 0 {
+			return
+		}
+		insufficient = append(insufficient, fmt.Sprintf("in %s, %s", region, strings.Join(regionInsufficient, "; ")))
+	}
+	sort.Strings(insufficient)
+	Skip(fmt.Sprintf("Insufficient service quotas: %s", strings.Join(insufficient, "; ")))
+}
+
+func insufficientServiceQuotas(quotas map[string]*ServiceQuota, request *TestResource) []string {
+	var insufficient []string
+	for name, amount := range request.amounts() {
+		quota, ok := quotas[name]
+		if !ok || amount <= quota.Value {
+			continue
+		}
+		status := quota.RequestStatus
+		if status == "" {
+			status = "not requested"
+		}
+		insufficient = append(insufficient, fmt.Sprintf("%s/%s is %d but %d are required (increase request: %s)", quota.ServiceCode, quota.QuotaName, quota.Value, amount, status))
+	}
+	sort.Strings(insufficient)
+	return insufficient
 }
 
 func (r *TestResource) doesSatisfy(request *TestResource) bool {
@@ -154,6 +217,9 @@ func (r *TestResource) doesSatisfy(request *TestResource) bool {
 	if request.EventBridgeRules != 0 && r.EventBridgeRules < request.EventBridgeRules {
 		return false
 	}
+	if request.EKSCluster != 0 && r.EKSCluster < request.EKSCluster {
+		return false
+	}
 	return true
 }
 
@@ -167,6 +233,7 @@ func (r *TestResource) acquire(request *TestResource) {
 	r.EC2GPU -= request.EC2GPU
 	r.VolumeGP2 -= request.VolumeGP2
 	r.EventBridgeRules -= request.EventBridgeRules
+	r.EKSCluster -= request.EKSCluster
 }
 
 func (r *TestResource) release(request *TestResource) {
@@ -179,17 +246,11 @@ func (r *TestResource) release(request *TestResource) {
 	r.EC2GPU += request.EC2GPU
 	r.VolumeGP2 += request.VolumeGP2
 	r.EventBridgeRules += request.EventBridgeRules
+	r.EKSCluster += request.EKSCluster
 }
 
-func AcquireResources(request *TestResource, nodeNum int, fileLock *flock.Flock) error {
+func AcquireResources(request *TestResource, nodeNum int, stores *RegionalResourceStore) error {
 	timeoutAfter := time.Now().Add(time.Hour * 6)
-	defer func() {
-		if err := fileLock.Unlock(); err != nil {
-			time.Sleep(1 * time.Second)
-			err = fileLock.Unlock()
-			Expect(err).NotTo(HaveOccurred())
-		}
-	}()
 
 	By(fmt.Sprintf("Node %d acquiring resources: %s", nodeNum, request.String()))
 	for range time.Tick(time.Second) {
@@ -197,36 +258,29 @@ func AcquireResources(request *TestResource, nodeNum int, fileLock *flock.Flock)
 			By(fmt.Sprintf("Timeout reached for node %d", nodeNum))
 			break
 		}
-		err := fileLock.Lock()
-		if err != nil {
-			continue
-		}
-		resourceText, err := os.ReadFile(ResourceQuotaFilePath)
-		if err != nil {
-			return err
-		}
-
-		resources := &TestResource{}
-		if err = yaml.Unmarshal(resourceText, resources); err != nil {
-			return err
-		}
-
-		if resources.doesSatisfy(request) {
-			resources.acquire(request)
-			data, err := yaml.Marshal(resources)
+		for _, region := range stores.regionsFrom(nodeNum) {
+			acquired, err := stores.Region(region).Update(func(resources *TestResource) bool {
+				if !resources.doesSatisfy(request) {
+					return false
+				}
+				resources.acquire(request)
+				return true
+			})
+			if errors.Is(err, errResourceStoreConflict) {
+				continue
+			}
 			if err != nil {
 				return err
 			}
-			if err := os.WriteFile(ResourceQuotaFilePath, data, 0644); err != nil { //nolint:gosec
-				return err
+			if acquired {
+				request.region = region
+				setAcquiredRegion(region)
+				request.recordAcquisition()
+				By(fmt.Sprintf("Node %d acquired resources in region %s: %s", nodeNum, region, request.String()))
+				return nil
 			}
-			By(fmt.Sprintf("Node %d acquired resources: %s", nodeNum, request.String()))
-			return nil
 		}
 		e2eDebugBy("Insufficient resources, retrying")
-		if err := fileLock.Unlock(); err != nil {
-			return err
-		}
 	}
 	return errors.New("giving up on acquiring resource due to timeout")
 }
@@ -237,16 +291,12 @@ func e2eDebugBy(msg string) {
 	}
 }
 
-func ReleaseResources(request *TestResource, nodeNum int, fileLock *flock.Flock) error {
+func ReleaseResources(request *TestResource, nodeNum int, stores *RegionalResourceStore) error {
 	timeoutInSec := 20
-
-	defer func() {
-		if err := fileLock.Unlock(); err != nil {
-			time.Sleep(1 * time.Second)
-			err = fileLock.Unlock()
-			Expect(err).NotTo(HaveOccurred())
-		}
-	}()
+	store := stores.Region(request.region)
+	if store == nil {
+		return fmt.Errorf("no resources were acquired for node %d", nodeNum)
+	}
 
 	var tryCount = 0
 	for range time.Tick(1 * time.Second) {
@@ -254,26 +304,18 @@ func ReleaseResources(request *TestResource, nodeNum int, fileLock *flock.Flock)
 		if tryCount > timeoutInSec {
 			break
 		}
-		if err := fileLock.Lock(); err != nil {
+		_, err := store.Update(func(resources *TestResource) bool {
+			resources.release(request)
+			return true
+		})
+		if errors.Is(err, errResourceStoreConflict) {
 			continue
 		}
-		resourceText, err := os.ReadFile(ResourceQuotaFilePath)
-		if err != nil {
-			return err
-		}
-		resources := &TestResource{}
-		if err := yaml.Unmarshal(resourceText, resources); err != nil {
-			return err
-		}
-		resources.release(request)
-		data, err := yaml.Marshal(resources)
 		if err != nil {
 			return err
 		}
-		if err := os.WriteFile(ResourceQuotaFilePath, data, 0644); err != nil { //nolint:gosec
-			return err
-		}
-		By(fmt.Sprintf("Node %d released resources: %s", nodeNum, request.String()))
+		request.recordRelease()
+		By(fmt.Sprintf("Node %d released resources in region %s: %s", nodeNum, request.region, request.String()))
 		return nil
 	}
 	return errors.New("giving up on releasing resource due to timeout")
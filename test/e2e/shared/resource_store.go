@@ -0,0 +1,285 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/gofrs/flock"
+	"sigs.k8s.io/yaml"
+)
+
+// Resource store backends.
+const (
+	// FileResourceStore stores the available resources in a file locked by the ginkgo nodes of a single host.
+	FileResourceStore = "file"
+	// DynamoDBResourceStore stores the available resources in an item of a DynamoDB table, updated with conditional writes.
+	DynamoDBResourceStore = "dynamodb"
+	// S3ResourceStore stores the available resources in an object of an S3 bucket, updated with conditional writes.
+	S3ResourceStore = "s3"
+
+	// DefaultResourceStoreKey is the default key of the DynamoDB item or S3 object storing the available resources.
+	DefaultResourceStoreKey = "capa-e2e-resource-usage"
+)
+
+// errResourceStoreConflict is returned when the available resources were updated by another test since they were read.
+var errResourceStoreConflict = errors.New("the available resources were updated concurrently")
+
+// ResourceStore stores the resources available to the tests, shared by the ginkgo nodes and, depending on the
+// backend, by the test runs using the same AWS account.
+type ResourceStore interface {
+	// Init stores the resources available to the tests. Distributed stores keep the resources stored by another
+	// test run, so that the resources it acquired are accounted for.
+	Init(resources *TestResource) error
+	// Update reads the available resources and stores them back if update returns true. It returns whether they
+	// were stored back, or errResourceStoreConflict if they were updated by another test in the meantime.
+	Update(update func(resources *TestResource) bool) (bool, error)
+}
+
+// newResourceStore returns the resource store selected by the resource-store flag, storing the resources under
+// the suffixed file path or key.
+func newResourceStore(e2eCtx *E2EContext, suffix string) (ResourceStore, error) {
+	settings := e2eCtx.Settings
+	switch settings.ResourceStore {
+	case FileResourceStore:
+		return &fileResourceStore{path: ResourceQuotaFilePath + suffix}, nil
+	case DynamoDBResourceStore, S3ResourceStore:
+		if settings.ResourceStoreName == "" {
+			return nil, fmt.Errorf("the resource-store-name flag is required by the %s resource store", settings.ResourceStore)
+		}
+		if settings.ResourceStore == DynamoDBResourceStore {
+			return &dynamoDBResourceStore{client: dynamodb.New(e2eCtx.AWSSession), table: settings.ResourceStoreName, key: settings.ResourceStoreKey + suffix}, nil
+		}
+		return &s3ResourceStore{client: s3.New(e2eCtx.AWSSession), bucket: settings.ResourceStoreName, key: settings.ResourceStoreKey + suffix}, nil
+	default:
+		return nil, fmt.Errorf("unsupported resource store %q", settings.ResourceStore)
+	}
+}
+
+// fileResourceStore stores the available resources in a file, whose updates are serialised with a file lock.
+type fileResourceStore struct {
+	path string
+}
+
+func (s *fileResourceStore) Init(resources *TestResource) error {
+	data, err := yaml.Marshal(resources)
+	if err != nil {
+		return err
+	}
+	// If the file exists, it was written by a previous run. It should not fail on error, another ginkgo node
+	// might have already deleted it.
+	_ = os.Remove(s.path)
+	return os.WriteFile(s.path, data, 0644) //nolint:gosec
+}
+
+func (s *fileResourceStore) Update(update func(resources *TestResource) bool) (bool, error) {
+	fileLock := flock.New(s.path)
+	if err := fileLock.Lock(); err != nil {
+		return false, errResourceStoreConflict
+	}
+	defer func() {
+		_ = fileLock.Unlock()
+	}()
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return false, err
+	}
+	resources := &TestResource{}
+	if err := yaml.Unmarshal(data, resources); err != nil {
+		return false, err
+	}
+	if !update(resources) {
+		return false, nil
+	}
+	if data, err = yaml.Marshal(resources); err != nil {
+		return false, err
+	}
+	if err := os.WriteFile(s.path, data, 0644); err != nil { //nolint:gosec
+		return false, err
+	}
+	return true, nil
+}
+
+// dynamoDBResourceStore stores the available resources in an item of a DynamoDB table, whose partition key is the
+// name string attribute. The item is versioned so that it is only updated if it did not change since it was read.
+type dynamoDBResourceStore struct {
+	client *dynamodb.DynamoDB
+	table  string
+	key    string
+}
+
+func (s *dynamoDBResourceStore) Init(resources *TestResource) error {
+	data, err := yaml.Marshal(resources)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String(s.table),
+		Item: map[string]*dynamodb.AttributeValue{
+			"name":      {S: aws.String(s.key)},
+			"resources": {S: aws.String(string(data))},
+			"version":   {N: aws.String("0")},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(#name)"),
+		ExpressionAttributeNames: map[string]*string{
+			"#name": aws.String("name"),
+		},
+	})
+	if isAWSErrorCode(err, dynamodb.ErrCodeConditionalCheckFailedException) {
+		return nil
+	}
+	return err
+}
+
+func (s *dynamoDBResourceStore) Update(update func(resources *TestResource) bool) (bool, error) {
+	out, err := s.client.GetItem(&dynamodb.GetItemInput{
+		TableName:      aws.String(s.table),
+		Key:            map[string]*dynamodb.AttributeValue{"name": {S: aws.String(s.key)}},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return false, err
+	}
+	if out.Item == nil || out.Item["resources"] == nil || out.Item["version"] == nil {
+		return false, fmt.Errorf("item %q of DynamoDB table %q does not hold the available resources", s.key, s.table)
+	}
+	version, err := strconv.ParseInt(aws.StringValue(out.Item["version"].N), 10, 64)
+	if err != nil {
+		return false, err
+	}
+	resources := &TestResource{}
+	if err := yaml.Unmarshal([]byte(aws.StringValue(out.Item["resources"].S)), resources); err != nil {
+		return false, err
+	}
+	if !update(resources) {
+		return false, nil
+	}
+	data, err := yaml.Marshal(resources)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = s.client.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String(s.table),
+		Item: map[string]*dynamodb.AttributeValue{
+			"name":      {S: aws.String(s.key)},
+			"resources": {S: aws.String(string(data))},
+			"version":   {N: aws.String(strconv.FormatInt(version+1, 10))},
+		},
+		ConditionExpression: aws.String("version = :version"),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":version": {N: out.Item["version"].N},
+		},
+	})
+	if isAWSErrorCode(err, dynamodb.ErrCodeConditionalCheckFailedException) {
+		return false, errResourceStoreConflict
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// s3ResourceStore stores the available resources in an object of an S3 bucket, which is only overwritten if its
+// ETag did not change since it was read.
+type s3ResourceStore struct {
+	client *s3.S3
+	bucket string
+	key    string
+}
+
+func (s *s3ResourceStore) Init(resources *TestResource) error {
+	data, err := yaml.Marshal(resources)
+	if err != nil {
+		return err
+	}
+	err = s.putObject(data, withHeader("If-None-Match", "*"))
+	if errors.Is(err, errResourceStoreConflict) {
+		return nil
+	}
+	return err
+}
+
+func (s *s3ResourceStore) Update(update func(resources *TestResource) bool) (bool, error) {
+	out, err := s.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key),
+	})
+	if err != nil {
+		return false, err
+	}
+	defer out.Body.Close()
+	data, err := io.ReadAll(out.Body)
+	if err != nil {
+		return false, err
+	}
+	resources := &TestResource{}
+	if err := yaml.Unmarshal(data, resources); err != nil {
+		return false, err
+	}
+	if !update(resources) {
+		return false, nil
+	}
+	if data, err = yaml.Marshal(resources); err != nil {
+		return false, err
+	}
+	if err := s.putObject(data, withHeader("If-Match", aws.StringValue(out.ETag))); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// putObject writes the object with a conditional write. The SDK does not support the conditional write headers
+// of PutObject yet, they are set on the HTTP request.
+func (s *s3ResourceStore) putObject(data []byte, opts ...request.Option) error {
+	_, err := s.client.PutObjectWithContext(aws.BackgroundContext(), &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key),
+		Body:   bytes.NewReader(data),
+	}, opts...)
+	var reqErr awserr.RequestFailure
+	if errors.As(err, &reqErr) && (reqErr.StatusCode() == http.StatusPreconditionFailed || reqErr.StatusCode() == http.StatusConflict) {
+		return errResourceStoreConflict
+	}
+	return err
+}
+
+func withHeader(name, value string) request.Option {
+	return func(r *request.Request) {
+		r.HTTPRequest.Header.Set(name, value)
+	}
+}
+
+func isAWSErrorCode(err error, code string) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == code
+}
@@ -0,0 +1,310 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// scaleReportEntry is the name of the report entry and of the artifact summarizing a scale spec.
+	scaleReportEntry = "scale-report"
+	// scaleSampleInterval is how often the memory of the CAPA controller is sampled during a scale spec.
+	scaleSampleInterval = 30 * time.Second
+
+	reconcileTimeMetric  = "controller_runtime_reconcile_time_seconds"
+	residentMemoryMetric = "process_resident_memory_bytes"
+	heapInuseMetric      = "go_memstats_heap_inuse_bytes"
+)
+
+// ScaleReport is the performance of the CAPA controller during a scale spec.
+type ScaleReport struct {
+	Spec                    string             `json:"spec"`
+	Clusters                int                `json:"clusters"`
+	MachinesPerCluster      int                `json:"machinesPerCluster"`
+	DurationSeconds         float64            `json:"durationSeconds"`
+	ClusterProvisioning     LatencySummary     `json:"clusterProvisioning"`
+	ClusterDeletionSeconds  float64            `json:"clusterDeletionSeconds"`
+	Reconciles              []ReconcileLatency `json:"reconciles"`
+	APICalls                int                `json:"apiCalls"`
+	ThrottledAPICalls       int                `json:"throttledAPICalls"`
+	Operations              []APICallCount     `json:"operations"`
+	PeakResidentMemoryBytes int64              `json:"peakResidentMemoryBytes"`
+	PeakHeapInuseBytes      int64              `json:"peakHeapInuseBytes"`
+}
+
+// LatencySummary summarizes durations, in seconds.
+type LatencySummary struct {
+	Count       int     `json:"count"`
+	MeanSeconds float64 `json:"meanSeconds"`
+	MaxSeconds  float64 `json:"maxSeconds"`
+}
+
+// ReconcileLatency is the number of reconciles of a controller and their latency, in seconds. The 99th percentile is
+// the upper bound of the histogram bucket it falls in, at most the upper bound of the last bucket.
+type ReconcileLatency struct {
+	Controller  string  `json:"controller"`
+	Reconciles  int     `json:"reconciles"`
+	MeanSeconds float64 `json:"meanSeconds"`
+	P99Seconds  float64 `json:"p99Seconds"`
+}
+
+// reconcileHistogram is the reconcile time histogram of a controller, with the cumulative count of each bucket keyed
+// by its upper bound.
+type reconcileHistogram struct {
+	count   uint64
+	sum     float64
+	buckets map[float64]uint64
+}
+
+// ScaleMonitor measures the performance of the CAPA controller during a scale spec.
+type ScaleMonitor struct {
+	e2eCtx          *E2EContext
+	started         time.Time
+	startAPICalls   apiCallCounters
+	startReconciles map[string]*reconcileHistogram
+	cancel          context.CancelFunc
+	done            chan struct{}
+	stopOnce        sync.Once
+
+	mu           sync.Mutex
+	peakRSS      float64
+	peakHeap     float64
+	provisioning []time.Duration
+	deletion     time.Duration
+}
+
+// StartScaleMonitor records the metrics of the CAPA controller when a scale spec starts, and samples its memory until
+// the monitor reports.
+func StartScaleMonitor(ctx context.Context, e2eCtx *E2EContext) *ScaleMonitor {
+	podMetrics, err := scrapeControllerMetrics(ctx, e2eCtx)
+	Expect(err).NotTo(HaveOccurred(), "Failed to scrape the metrics of the CAPA controller")
+
+	sampleCtx, cancel := context.WithCancel(ctx)
+	m := &ScaleMonitor{
+		e2eCtx:          e2eCtx,
+		started:         time.Now(),
+		startAPICalls:   apiCallCountersOf(podMetrics),
+		startReconciles: reconcileHistogramsOf(podMetrics),
+		cancel:          cancel,
+		done:            make(chan struct{}),
+	}
+	m.sampleMemory(podMetrics)
+
+	go func() {
+		defer GinkgoRecover()
+		defer close(m.done)
+		ticker := time.NewTicker(scaleSampleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-sampleCtx.Done():
+				return
+			case <-ticker.C:
+				podMetrics, err := scrapeControllerMetrics(sampleCtx, e2eCtx)
+				if err != nil {
+					fmt.Fprintf(GinkgoWriter, "Couldn't scrape the metrics of the CAPA controller: err=%s\n", err)
+					continue
+				}
+				m.sampleMemory(podMetrics)
+			}
+		}
+	}()
+	return m
+}
+
+// ObserveClusterProvisioned records how long a cluster of the spec took to be provisioned.
+func (m *ScaleMonitor) ObserveClusterProvisioned(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.provisioning = append(m.provisioning, d)
+}
+
+// ObserveClustersDeleted records how long the clusters of the spec took to be deleted.
+func (m *ScaleMonitor) ObserveClustersDeleted(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deletion = d
+}
+
+// Report stops the monitor and reports the performance of the CAPA controller since it started, as a report entry of
+// the spec and in scale-report/<spec>.yaml in the artifacts folder.
+func (m *ScaleMonitor) Report(ctx context.Context, specName string, clusters, machinesPerCluster int) ScaleReport {
+	m.Stop()
+
+	podMetrics, err := scrapeControllerMetrics(ctx, m.e2eCtx)
+	Expect(err).NotTo(HaveOccurred(), "Failed to scrape the metrics of the CAPA controller")
+	m.sampleMemory(podMetrics)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	report := ScaleReport{
+		Spec:                    CurrentSpecReport().FullText(),
+		Clusters:                clusters,
+		MachinesPerCluster:      machinesPerCluster,
+		DurationSeconds:         time.Since(m.started).Seconds(),
+		ClusterProvisioning:     summarizeLatencies(m.provisioning),
+		ClusterDeletionSeconds:  m.deletion.Seconds(),
+		Reconciles:              reconcileLatencies(m.startReconciles, reconcileHistogramsOf(podMetrics)),
+		PeakResidentMemoryBytes: int64(m.peakRSS),
+		PeakHeapInuseBytes:      int64(m.peakHeap),
+	}
+	report.APICalls, report.ThrottledAPICalls, report.Operations = apiCallCountersOf(podMetrics).since(m.startAPICalls)
+
+	AddReportEntry(scaleReportEntry, fmt.Sprintf("%d clusters provisioned in %.0fs on average, %d AWS API calls, %d throttled, %d MiB peak memory",
+		report.ClusterProvisioning.Count, report.ClusterProvisioning.MeanSeconds, report.APICalls, report.ThrottledAPICalls, report.PeakResidentMemoryBytes>>20))
+
+	data, err := yaml.Marshal(report)
+	Expect(err).NotTo(HaveOccurred())
+	reportPath := filepath.Join(m.e2eCtx.Settings.ArtifactFolder, scaleReportEntry, specName+".yaml")
+	Expect(os.MkdirAll(filepath.Dir(reportPath), 0o750)).To(Succeed())
+	Expect(os.WriteFile(reportPath, data, 0o600)).To(Succeed())
+	return report
+}
+
+// Stop stops sampling the memory of the CAPA controller. It is called by Report, and can be deferred in case the spec
+// fails before reporting.
+func (m *ScaleMonitor) Stop() {
+	m.stopOnce.Do(func() {
+		m.cancel()
+		<-m.done
+	})
+}
+
+// sampleMemory records the peak memory of the CAPA controller pods, summed across the pods.
+func (m *ScaleMonitor) sampleMemory(podMetrics []map[string]*dto.MetricFamily) {
+	var rss, heap float64
+	for _, families := range podMetrics {
+		rss += gaugeValue(families[residentMemoryMetric])
+		heap += gaugeValue(families[heapInuseMetric])
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.peakRSS = math.Max(m.peakRSS, rss)
+	m.peakHeap = math.Max(m.peakHeap, heap)
+}
+
+func gaugeValue(family *dto.MetricFamily) float64 {
+	var value float64
+	for _, metric := range family.GetMetric() {
+		value += metric.GetGauge().GetValue()
+	}
+	return value
+}
+
+// reconcileHistogramsOf returns the reconcile time histograms of the controllers, summed across the CAPA controller
+// pods.
+func reconcileHistogramsOf(podMetrics []map[string]*dto.MetricFamily) map[string]*reconcileHistogram {
+	histograms := map[string]*reconcileHistogram{}
+	for _, families := range podMetrics {
+		for _, metric := range families[reconcileTimeMetric].GetMetric() {
+			controller := ""
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "controller" {
+					controller = label.GetValue()
+				}
+			}
+			h, ok := histograms[controller]
+			if !ok {
+				h = &reconcileHistogram{buckets: map[float64]uint64{}}
+				histograms[controller] = h
+			}
+			h.count += metric.GetHistogram().GetSampleCount()
+			h.sum += metric.GetHistogram().GetSampleSum()
+			for _, bucket := range metric.GetHistogram().GetBucket() {
+				h.buckets[bucket.GetUpperBound()] += bucket.GetCumulativeCount()
+			}
+		}
+	}
+	return histograms
+}
+
+// reconcileLatencies returns the latency of the reconciles of each controller between the start and end histograms,
+// sorted by controller.
+func reconcileLatencies(start, end map[string]*reconcileHistogram) []ReconcileLatency {
+	latencies := []ReconcileLatency{}
+	for controller, h := range end {
+		count, sum := h.count, h.sum
+		buckets := map[float64]uint64{}
+		for bound, cumulative := range h.buckets {
+			buckets[bound] = cumulative
+		}
+		// The histograms restart from zero when the controller restarts in between.
+		if before, ok := start[controller]; ok && before.count <= count {
+			count -= before.count
+			sum -= before.sum
+			for bound, cumulative := range before.buckets {
+				buckets[bound] -= cumulative
+			}
+		}
+		if count == 0 {
+			continue
+		}
+
+		bounds := make([]float64, 0, len(buckets))
+		for bound := range buckets {
+			bounds = append(bounds, bound)
+		}
+		sort.Float64s(bounds)
+		// Past the last bucket, the percentile is reported as its upper bound.
+		var p99 float64
+		for _, bound := range bounds {
+			p99 = bound
+			if float64(buckets[bound]) >= 0.99*float64(count) {
+				break
+			}
+		}
+
+		latencies = append(latencies, ReconcileLatency{
+			Controller:  controller,
+			Reconciles:  int(count),
+			MeanSeconds: sum / float64(count),
+			P99Seconds:  p99,
+		})
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i].Controller < latencies[j].Controller })
+	return latencies
+}
+
+func summarizeLatencies(durations []time.Duration) LatencySummary {
+	summary := LatencySummary{Count: len(durations)}
+	if len(durations) == 0 {
+		return summary
+	}
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+		summary.MaxSeconds = math.Max(summary.MaxSeconds, d.Seconds())
+	}
+	summary.MeanSeconds = total.Seconds() / float64(len(durations))
+	return summary
+}
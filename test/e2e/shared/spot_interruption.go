@@ -0,0 +1,205 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fis"
+	"github.com/aws/aws-sdk-go/service/sts"
+	. "github.com/onsi/ginkgo/v2"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	cfn_bootstrap "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/cloudformation/bootstrap"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/converters"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+)
+
+const (
+	// spotInterruptionNotice is how long before the interruption of the Spot instance its interruption notice is sent.
+	spotInterruptionNotice = "PT2M"
+	// fisRolePolicyName is the name of the inline policy allowing the FIS role to interrupt Spot instances.
+	fisRolePolicyName = "send-spot-instance-interruptions"
+)
+
+// SpotInterruption is the interruption of a Spot instance by an AWS Fault Injection Service (FIS) experiment, which
+// sends the interruption notice of the instance and stops or terminates it two minutes later, as EC2 does when it
+// reclaims the capacity.
+type SpotInterruption struct {
+	InstanceID string
+
+	iamCfg       *awsv2.Config
+	iamSvc       *iam.Client
+	fisSvc       *fis.FIS
+	roleName     string
+	roleCreated  bool
+	templateID   string
+	experimentID string
+}
+
+// InterruptSpotInstance starts a FIS experiment interrupting the Spot instance. The experiment runs with a role
+// created for it, which Cleanup deletes with the experiment template once the experiment completed.
+func InterruptSpotInstance(ctx context.Context, e2eCtx *E2EContext, clusterName, instanceID string) (*SpotInterruption, error) {
+	By(fmt.Sprintf("Interrupting Spot instance %s", instanceID))
+	interruption := &SpotInterruption{
+		InstanceID: instanceID,
+		iamCfg:     e2eCtx.AWSSessionV2,
+		iamSvc:     iam.NewFromConfig(*e2eCtx.AWSSessionV2),
+		fisSvc:     fis.New(e2eCtx.AWSSession),
+		roleName:   clusterName + "-fis",
+	}
+
+	identity, err := sts.New(e2eCtx.AWSSession).GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the AWS account: %w", err)
+	}
+	roleARN, err := interruption.createRole(ctx)
+	if err != nil {
+		return interruption, err
+	}
+
+	template, err := interruption.fisSvc.CreateExperimentTemplateWithContext(ctx, &fis.CreateExperimentTemplateInput{
+		Description: aws.String(fmt.Sprintf("Interrupt Spot instance %s of cluster %s", instanceID, clusterName)),
+		RoleArn:     aws.String(roleARN),
+		Actions: map[string]*fis.CreateExperimentTemplateActionInput{
+			"interrupt": {
+				ActionId:   aws.String("aws:ec2:send-spot-instance-interruptions"),
+				Parameters: aws.StringMap(map[string]string{"durationBeforeInterruption": spotInterruptionNotice}),
+				Targets:    aws.StringMap(map[string]string{"SpotInstances": "instance"}),
+			},
+		},
+		Targets: map[string]*fis.CreateExperimentTemplateTargetInput{
+			"instance": {
+				ResourceType: aws.String("aws:ec2:spot-instance"),
+				ResourceArns: aws.StringSlice([]string{fmt.Sprintf("arn:%s:ec2:%s:%s:instance/%s",
+					e2eCtx.Partition.Name, aws.StringValue(interruption.fisSvc.Config.Region), aws.StringValue(identity.Account), instanceID)}),
+				SelectionMode: aws.String("ALL"),
+			},
+		},
+		StopConditions: []*fis.CreateExperimentTemplateStopConditionInput{{Source: aws.String("none")}},
+		Tags:           aws.StringMap(map[string]string{e2eTagKey: "true"}),
+	})
+	if err != nil {
+		return interruption, fmt.Errorf("failed to create the FIS experiment template: %w", err)
+	}
+	interruption.templateID = aws.StringValue(template.ExperimentTemplate.Id)
+
+	// The role can take a few seconds to be assumable by FIS once created.
+	for attempt := 0; ; attempt++ {
+		experiment, err := interruption.fisSvc.StartExperimentWithContext(ctx, &fis.StartExperimentInput{
+			ExperimentTemplateId: aws.String(interruption.templateID),
+			Tags:                 aws.StringMap(map[string]string{e2eTagKey: "true"}),
+		})
+		if err == nil {
+			interruption.experimentID = aws.StringValue(experiment.Experiment.Id)
+			return interruption, nil
+		}
+		if attempt == 5 {
+			return interruption, fmt.Errorf("failed to start the FIS experiment: %w", err)
+		}
+		time.Sleep(10 * time.Second)
+	}
+}
+
+// createRole creates the role the FIS experiment runs with, and returns its ARN.
+func (i *SpotInterruption) createRole(ctx context.Context) (string, error) {
+	trustPolicy, err := converters.IAMPolicyDocumentToJSON(*cfn_bootstrap.AssumeRolePolicy(iamv1.PrincipalService, []string{"fis.amazonaws.com"}))
+	if err != nil {
+		return "", err
+	}
+	policy, err := converters.IAMPolicyDocumentToJSON(iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: []iamv1.StatementEntry{
+			{
+				Effect:   iamv1.EffectAllow,
+				Resource: iamv1.Resources{iamv1.Any},
+				Action:   iamv1.Actions{"ec2:SendSpotInstanceInterruptions", "ec2:DescribeInstances"},
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	role, err := i.iamSvc.CreateRole(ctx, &iam.CreateRoleInput{
+		RoleName:                 awsv2.String(i.roleName),
+		AssumeRolePolicyDocument: awsv2.String(trustPolicy),
+		Tags:                     []iamtypes.Tag{{Key: awsv2.String(e2eTagKey), Value: awsv2.String("true")}},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create role %s: %w", i.roleName, err)
+	}
+	i.roleCreated = true
+	if _, err := i.iamSvc.PutRolePolicy(ctx, &iam.PutRolePolicyInput{
+		RoleName:       awsv2.String(i.roleName),
+		PolicyName:     awsv2.String(fisRolePolicyName),
+		PolicyDocument: awsv2.String(policy),
+	}); err != nil {
+		return "", fmt.Errorf("failed to put policy %s on role %s: %w", fisRolePolicyName, i.roleName, err)
+	}
+	return awsv2.ToString(role.Role.Arn), nil
+}
+
+// Completed returns whether the FIS experiment completed, and an error if it failed or was stopped.
+func (i *SpotInterruption) Completed(ctx context.Context) (bool, error) {
+	out, err := i.fisSvc.GetExperimentWithContext(ctx, &fis.GetExperimentInput{Id: aws.String(i.experimentID)})
+	if err != nil {
+		return false, fmt.Errorf("failed to get FIS experiment %s: %w", i.experimentID, err)
+	}
+	switch state := out.Experiment.State; aws.StringValue(state.Status) {
+	case fis.ExperimentStatusCompleted:
+		return true, nil
+	case fis.ExperimentStatusFailed, fis.ExperimentStatusStopped:
+		return false, fmt.Errorf("FIS experiment %s is %s: %s", i.experimentID, aws.StringValue(state.Status), aws.StringValue(state.Reason))
+	default:
+		return false, nil
+	}
+}
+
+// Cleanup deletes the FIS experiment template and its role.
+func (i *SpotInterruption) Cleanup(ctx context.Context) error {
+	var errs []error
+	if i.templateID != "" {
+		if _, err := i.fisSvc.DeleteExperimentTemplateWithContext(ctx, &fis.DeleteExperimentTemplateInput{Id: aws.String(i.templateID)}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete FIS experiment template %s: %w", i.templateID, err))
+		}
+	}
+	if !i.roleCreated {
+		return kerrors.NewAggregate(errs)
+	}
+	var noSuchEntityErr *iamtypes.NoSuchEntityException
+	if _, err := i.iamSvc.DeleteRolePolicy(ctx, &iam.DeleteRolePolicyInput{
+		RoleName:   awsv2.String(i.roleName),
+		PolicyName: awsv2.String(fisRolePolicyName),
+	}); err != nil && !errors.As(err, &noSuchEntityErr) {
+		errs = append(errs, fmt.Errorf("failed to delete policy %s of role %s: %w", fisRolePolicyName, i.roleName, err))
+	}
+	if err := DeleteRole(ctx, i.iamCfg, i.roleName); err != nil {
+		errs = append(errs, err)
+	}
+	return kerrors.NewAggregate(errs)
+}
@@ -32,7 +32,6 @@ import (
 	"time"
 
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
-	"github.com/gofrs/flock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"sigs.k8s.io/yaml"
@@ -43,18 +42,19 @@ import (
 )
 
 type synchronizedBeforeTestSuiteConfig struct {
-	ArtifactFolder           string               `json:"artifactFolder,omitempty"`
-	ConfigPath               string               `json:"configPath,omitempty"`
-	ClusterctlConfigPath     string               `json:"clusterctlConfigPath,omitempty"`
-	KubeconfigPath           string               `json:"kubeconfigPath,omitempty"`
-	Region                   string               `json:"region,omitempty"`
-	E2EConfig                clusterctl.E2EConfig `json:"e2eConfig,omitempty"`
-	BootstrapAccessKey       *iamtypes.AccessKey  `json:"bootstrapAccessKey,omitempty"`
-	KubetestConfigFilePath   string               `json:"kubetestConfigFilePath,omitempty"`
-	UseCIArtifacts           bool                 `json:"useCIArtifacts,omitempty"`
-	GinkgoNodes              int                  `json:"ginkgoNodes,omitempty"`
-	GinkgoSlowSpecThreshold  int                  `json:"ginkgoSlowSpecThreshold,omitempty"`
-	Base64EncodedCredentials string               `json:"base64EncodedCredentials,omitempty"`
+	ArtifactFolder           string                              `json:"artifactFolder,omitempty"`
+	ConfigPath               string                              `json:"configPath,omitempty"`
+	ClusterctlConfigPath     string                              `json:"clusterctlConfigPath,omitempty"`
+	KubeconfigPath           string                              `json:"kubeconfigPath,omitempty"`
+	Region                   string                              `json:"region,omitempty"`
+	E2EConfig                clusterctl.E2EConfig                `json:"e2eConfig,omitempty"`
+	BootstrapAccessKey       *iamtypes.AccessKey                 `json:"bootstrapAccessKey,omitempty"`
+	KubetestConfigFilePath   string                              `json:"kubetestConfigFilePath,omitempty"`
+	UseCIArtifacts           bool                                `json:"useCIArtifacts,omitempty"`
+	GinkgoNodes              int                                 `json:"ginkgoNodes,omitempty"`
+	GinkgoSlowSpecThreshold  int                                 `json:"ginkgoSlowSpecThreshold,omitempty"`
+	Base64EncodedCredentials string                              `json:"base64EncodedCredentials,omitempty"`
+	ServiceQuotas            map[string]map[string]*ServiceQuota `json:"serviceQuotas,omitempty"`
 }
 
 // Node1BeforeSuite is the common setup down on the first ginkgo node before the test suite runs.
@@ -64,6 +64,10 @@ func Node1BeforeSuite(e2eCtx *E2EContext) []byte {
 	Expect(os.MkdirAll(e2eCtx.Settings.ArtifactFolder, 0o750)).To(Succeed(), "Invalid test suite argument. Can't create artifacts-folder %q", e2eCtx.Settings.ArtifactFolder)
 	By(fmt.Sprintf("Loading the e2e test configuration from %q", e2eCtx.Settings.ConfigPath))
 	e2eCtx.E2EConfig = LoadE2EConfig(e2eCtx.Settings.ConfigPath)
+	partition, err := ResolvePartitionSettings(e2eCtx.Settings)
+	Expect(err).NotTo(HaveOccurred(), "Invalid test suite argument")
+	e2eCtx.Partition = partition
+	setPartitionVariables(e2eCtx)
 	sourceTemplate, err := os.ReadFile(filepath.Join(e2eCtx.Settings.DataFolder, e2eCtx.Settings.SourceTemplate))
 	Expect(err).NotTo(HaveOccurred())
 	e2eCtx.StartOfSuite = time.Now()
@@ -134,7 +138,7 @@ func Node1BeforeSuite(e2eCtx *E2EContext) []byte {
 	logAccountDetails(e2eCtx.AWSSession)
 
 	bootstrapTemplate := getBootstrapTemplate(e2eCtx)
-	bootstrapTags := map[string]string{"capa-e2e-test": "true"}
+	bootstrapTags := map[string]string{e2eTagKey: "true"}
 	e2eCtx.CloudFormationTemplate = renderCustomCloudFormation(bootstrapTemplate)
 
 	if !e2eCtx.Settings.SkipCloudFormationCreation {
@@ -154,11 +158,17 @@ func Node1BeforeSuite(e2eCtx *E2EContext) []byte {
 
 	ensureStackTags(e2eCtx.AWSSession, bootstrapTemplate.Spec.StackName, bootstrapTags)
 	ensureNoServiceLinkedRoles(context.TODO(), e2eCtx.AWSSessionV2)
-	ensureSSHKeyPair(e2eCtx.AWSSession, DefaultSSHKeyPairName)
+	Expect(EnsureMultitenancyRoles(context.TODO(), e2eCtx)).To(Succeed())
 	e2eCtx.Environment.BootstrapAccessKey = newUserAccessKey(context.TODO(), e2eCtx.AWSSessionV2, bootstrapTemplate.Spec.BootstrapUser.UserName)
+	for _, region := range e2eCtx.Regions() {
+		ensureSSHKeyPair(NewAWSSessionWithKeyInRegion(e2eCtx.Environment.BootstrapAccessKey, region), DefaultSSHKeyPairName)
+	}
 	e2eCtx.BootstrapUserAWSSession = NewAWSSessionWithKey(e2eCtx.Environment.BootstrapAccessKey)
 	e2eCtx.BootstrapUserAWSSessionV2 = NewAWSSessionWithKeyV2(e2eCtx.Environment.BootstrapAccessKey)
 	Expect(ensureTestImageUploaded(e2eCtx)).NotTo(HaveOccurred())
+	if e2eCtx.Settings.ReuseNetwork {
+		EnsureSharedNetwork(e2eCtx)
+	}
 
 	// Image ID is needed when using a CI Kubernetes version. This is used in conformance test and upgrade to main test.
 	if !e2eCtx.IsManaged {
@@ -175,11 +185,24 @@ func Node1BeforeSuite(e2eCtx *E2EContext) []byte {
 	SetEnvVar("AWS_B64ENCODED_CREDENTIALS", base64EncodedCredentials, true)
 
 	if !e2eCtx.Settings.SkipQuotas {
-		By("Writing AWS service quotas to a file for parallel tests")
-		quotas, originalQuotas := EnsureServiceQuotas(e2eCtx.BootstrapUserAWSSession)
-		WriteResourceQuotesToFile(ResourceQuotaFilePath, quotas)
-		WriteResourceQuotesToFile(path.Join(e2eCtx.Settings.ArtifactFolder, "initial-resource-quotas.yaml"), quotas)
-		WriteAWSResourceQuotesToFile(path.Join(e2eCtx.Settings.ArtifactFolder, "initial-aws-resource-quotas.yaml"), originalQuotas)
+		By("Storing AWS service quotas for parallel tests")
+		e2eCtx.Environment.ResourceStore, err = NewRegionalResourceStore(e2eCtx)
+		Expect(err).NotTo(HaveOccurred(), "Invalid test suite argument")
+		e2eCtx.Environment.ServiceQuotas = map[string]map[string]*ServiceQuota{}
+		regions := e2eCtx.Regions()
+		for _, region := range regions {
+			sess := NewAWSSessionWithKeyInRegion(e2eCtx.Environment.BootstrapAccessKey, region)
+			quotas, originalQuotas := EnsureServiceQuotas(sess, e2eCtx.Settings.ServiceQuotaIncrease, e2eCtx.Settings.ServiceQuotaIncreaseTimeout)
+			e2eCtx.Environment.ServiceQuotas[region] = quotas
+			Expect(e2eCtx.Environment.ResourceStore.Region(region).Init(NewTestResource(quotas))).To(Succeed())
+
+			suffix := ""
+			if len(regions) > 1 {
+				suffix = "-" + region
+			}
+			WriteResourceQuotesToFile(path.Join(e2eCtx.Settings.ArtifactFolder, "initial-resource-quotas"+suffix+".yaml"), quotas)
+			WriteAWSResourceQuotesToFile(path.Join(e2eCtx.Settings.ArtifactFolder, "initial-aws-resource-quotas"+suffix+".yaml"), originalQuotas)
+		}
 	}
 
 	e2eCtx.Settings.InstanceVCPU, err = strconv.Atoi(e2eCtx.E2EConfig.MustGetVariable(InstanceVcpu))
@@ -208,6 +231,7 @@ func Node1BeforeSuite(e2eCtx *E2EContext) []byte {
 		GinkgoNodes:              e2eCtx.Settings.GinkgoNodes,
 		GinkgoSlowSpecThreshold:  e2eCtx.Settings.GinkgoSlowSpecThreshold,
 		Base64EncodedCredentials: base64EncodedCredentials,
+		ServiceQuotas:            e2eCtx.Environment.ServiceQuotas,
 	}
 
 	data, err := yaml.Marshal(conf)
@@ -225,15 +249,20 @@ func AllNodesBeforeSuite(e2eCtx *E2EContext, data []byte) {
 	e2eCtx.Environment.ClusterctlConfigPath = conf.ClusterctlConfigPath
 	e2eCtx.Environment.BootstrapClusterProxy = framework.NewClusterProxy("bootstrap", conf.KubeconfigPath, e2eCtx.Environment.Scheme)
 	e2eCtx.E2EConfig = &conf.E2EConfig
+	e2eCtx.Partition, err = ResolvePartitionSettings(e2eCtx.Settings)
+	Expect(err).NotTo(HaveOccurred())
 	e2eCtx.BootstrapUserAWSSession = NewAWSSessionWithKey(conf.BootstrapAccessKey)
 	e2eCtx.BootstrapUserAWSSessionV2 = NewAWSSessionWithKeyV2(conf.BootstrapAccessKey)
-	e2eCtx.Settings.FileLock = flock.New(ResourceQuotaFilePath)
 	e2eCtx.Settings.KubetestConfigFilePath = conf.KubetestConfigFilePath
 	e2eCtx.Settings.UseCIArtifacts = conf.UseCIArtifacts
 	e2eCtx.Settings.GinkgoNodes = conf.GinkgoNodes
 	e2eCtx.Settings.GinkgoSlowSpecThreshold = conf.GinkgoSlowSpecThreshold
+	e2eCtx.Environment.ServiceQuotas = conf.ServiceQuotas
 	e2eCtx.AWSSession = NewAWSSession()
 	e2eCtx.AWSSessionV2 = NewAWSSessionV2()
+	e2eCtx.Environment.ResourceStore, err = NewRegionalResourceStore(e2eCtx)
+	Expect(err).NotTo(HaveOccurred())
+	e2eCtx.Environment.BootstrapAccessKey = conf.BootstrapAccessKey
 	azs := GetAvailabilityZones(e2eCtx.AWSSession)
 	SetEnvVar(AwsAvailabilityZone1, *azs[0].ZoneName, false)
 	SetEnvVar(AwsAvailabilityZone2, *azs[1].ZoneName, false)
@@ -282,9 +311,11 @@ func AllNodesBeforeSuite(e2eCtx *E2EContext, data []byte) {
 
 // Node1AfterSuite is cleanup that runs on the first ginkgo node after the test suite finishes.
 func Node1AfterSuite(e2eCtx *E2EContext) {
+	restoreHomeRegion(e2eCtx)
 	ctx, cancel := context.WithTimeout(context.TODO(), 15*time.Minute)
 	DumpEKSClusters(ctx, e2eCtx)
 	DumpCloudTrailEvents(e2eCtx)
+	WriteCostEstimate(e2eCtx)
 
 	if e2eCtx.IsManaged {
 		By("Deleting AWS static credentials")
@@ -295,9 +326,11 @@ func Node1AfterSuite(e2eCtx *E2EContext) {
 	By("Tearing down the management cluster")
 	if !e2eCtx.Settings.SkipCleanup {
 		tearDown(e2eCtx.Environment.BootstrapClusterProvider, e2eCtx.Environment.BootstrapClusterProxy)
+		DeleteMultitenancyRoles(ctx, e2eCtx)
 		if !e2eCtx.Settings.SkipCloudFormationDeletion {
 			deleteCloudFormationStack(e2eCtx.AWSSession, getBootstrapTemplate(e2eCtx))
 		}
+		DetectLeakedResources(e2eCtx)
 	}
 }
 
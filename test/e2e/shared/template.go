@@ -96,9 +96,9 @@ func newBootstrapTemplate(e2eCtx *E2EContext) *cfn_bootstrap.Template {
 		{
 			Effect: iamv1.EffectAllow,
 			Resource: iamv1.Resources{
-				"arn:*:iam::*:role/aws-service-role/servicequotas.amazonaws.com/AWSServiceRoleForServiceQuotas",
-				"arn:*:iam::*:role/aws-service-role/support.amazonaws.com/AWSServiceRoleForSupport",
-				"arn:*:iam::*:role/aws-service-role/trustedadvisor.amazonaws.com/AWSServiceRoleForTrustedAdvisor",
+				"arn:" + e2eCtx.Settings.Partition + ":iam::*:role/aws-service-role/servicequotas.amazonaws.com/AWSServiceRoleForServiceQuotas",
+				"arn:" + e2eCtx.Settings.Partition + ":iam::*:role/aws-service-role/support.amazonaws.com/AWSServiceRoleForSupport",
+				"arn:" + e2eCtx.Settings.Partition + ":iam::*:role/aws-service-role/trustedadvisor.amazonaws.com/AWSServiceRoleForTrustedAdvisor",
 			},
 			Action: iamv1.Actions{
 				"iam:CreateServiceLinkedRole",
@@ -116,6 +116,7 @@ func newBootstrapTemplate(e2eCtx *E2EContext) *cfn_bootstrap.Template {
 	region, err := credentials.ResolveRegion("")
 	Expect(err).NotTo(HaveOccurred())
 	t.Spec.Region = region
+	t.Spec.Partition = e2eCtx.Settings.Partition
 	t.Spec.EKS.Disable = false
 	t.Spec.EKS.AllowIAMRoleCreation = false
 	t.Spec.EKS.DefaultControlPlaneRole.Disable = false
@@ -0,0 +1,240 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/e2e/shared"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/test/framework"
+	"sigs.k8s.io/cluster-api/test/framework/clusterctl"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+var _ = ginkgo.Describe("[unmanaged] [chaos] tests", func() {
+	var (
+		ctx               context.Context
+		result            *clusterctl.ApplyClusterTemplateAndWaitResult
+		requiredResources *shared.TestResource
+	)
+
+	ginkgo.BeforeEach(func() {
+		ctx = context.TODO()
+		result = &clusterctl.ApplyClusterTemplateAndWaitResult{}
+	})
+
+	ginkgo.Describe("Availability zone outage", func() {
+		ginkgo.It("should keep the control plane available and replace the machines of the failed zone", func() {
+			specName := "chaos-az-outage"
+			if !e2eCtx.Settings.SkipQuotas {
+				requiredResources = &shared.TestResource{EC2Normal: 5 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 2, VPC: 1, ClassicLB: 1, EIP: 3, EventBridgeRules: 50}
+				requiredResources.WriteRequestedResources(e2eCtx, specName)
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
+			}
+			namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
+			defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
+
+			ginkgo.By("Creating a cluster spanning two availability zones")
+			clusterName := fmt.Sprintf("%s-%s", specName, util.RandomString(6))
+			clusterctl.ApplyClusterTemplateAndWait(ctx, clusterctl.ApplyClusterTemplateAndWaitInput{
+				ClusterProxy: e2eCtx.Environment.BootstrapClusterProxy,
+				ConfigCluster: clusterctl.ConfigClusterInput{
+					LogFolder:                filepath.Join(e2eCtx.Settings.ArtifactFolder, "clusters", e2eCtx.Environment.BootstrapClusterProxy.GetName()),
+					ClusterctlConfigPath:     e2eCtx.Environment.ClusterctlConfigPath,
+					KubeconfigPath:           e2eCtx.Environment.BootstrapClusterProxy.GetKubeconfigPath(),
+					InfrastructureProvider:   clusterctl.DefaultInfrastructureProvider,
+					Flavor:                   shared.MultiAzFlavor,
+					Namespace:                namespace.Name,
+					ClusterName:              clusterName,
+					KubernetesVersion:        e2eCtx.E2EConfig.MustGetVariable(shared.KubernetesVersion),
+					ControlPlaneMachineCount: ptr.To[int64](3),
+					WorkerMachineCount:       ptr.To[int64](2),
+				},
+				WaitForClusterIntervals:      e2eCtx.E2EConfig.GetIntervals("", "wait-cluster"),
+				WaitForControlPlaneIntervals: e2eCtx.E2EConfig.GetIntervals("", "wait-control-plane"),
+				WaitForMachineDeployments:    e2eCtx.E2EConfig.GetIntervals("", "wait-worker-nodes"),
+			}, result)
+
+			mgmtClient := e2eCtx.Environment.BootstrapClusterProxy.GetClient()
+			machines := clusterMachines(ctx, mgmtClient, namespace.Name, clusterName)
+			availabilityZone := minorityControlPlaneZone(machines)
+			Expect(availabilityZone).NotTo(BeEmpty(), "no availability zone hosts a minority of the control plane machines")
+
+			failedMachines := sets.New[string]()
+			for _, machine := range machines {
+				if machineAvailabilityZone(machine) == availabilityZone {
+					failedMachines.Insert(machine.Name)
+				}
+			}
+			shared.Byf("Machines %v are in availability zone %s", sets.List(failedMachines), availabilityZone)
+
+			ginkgo.By("Creating a MachineHealthCheck for the machines of the cluster")
+			Expect(mgmtClient.Create(ctx, &clusterv1.MachineHealthCheck{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      clusterName,
+					Namespace: namespace.Name,
+				},
+				Spec: clusterv1.MachineHealthCheckSpec{
+					ClusterName: clusterName,
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{clusterv1.ClusterNameLabel: clusterName},
+					},
+					UnhealthyConditions: []clusterv1.UnhealthyCondition{
+						{Type: corev1.NodeReady, Status: corev1.ConditionUnknown, Timeout: metav1.Duration{Duration: 5 * time.Minute}},
+						{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Timeout: metav1.Duration{Duration: 5 * time.Minute}},
+					},
+				},
+			})).To(Succeed())
+
+			awsCluster := &infrav1.AWSCluster{}
+			Expect(mgmtClient.Get(ctx, crclient.ObjectKey{Namespace: namespace.Name, Name: result.Cluster.Spec.InfrastructureRef.Name}, awsCluster)).To(Succeed())
+			disruption, err := shared.DisruptAvailabilityZone(e2eCtx, clusterName, awsCluster.Spec.NetworkSpec.VPC.ID, availabilityZone)
+			restored := false
+			defer func() {
+				if !restored && disruption != nil {
+					Expect(disruption.Restore()).To(Succeed())
+				}
+			}()
+			Expect(err).NotTo(HaveOccurred())
+
+			ginkgo.By("Checking that the control plane stays available during the outage")
+			workloadClientset := e2eCtx.Environment.BootstrapClusterProxy.GetWorkloadCluster(ctx, namespace.Name, clusterName).GetClientSet()
+			Consistently(func() error {
+				return apiServerAvailable(workloadClientset.Discovery().ServerVersion)
+			}, e2eCtx.E2EConfig.GetIntervals(specName, "wait-chaos-disruption")...).Should(Succeed())
+
+			ginkgo.By("Waiting for the machines of the failed availability zone to be remediated")
+			Eventually(func() []string {
+				var pending []string
+				for _, name := range sets.List(failedMachines) {
+					machine := &clusterv1.Machine{}
+					err := mgmtClient.Get(ctx, crclient.ObjectKey{Namespace: namespace.Name, Name: name}, machine)
+					switch {
+					case apierrors.IsNotFound(err):
+					case err != nil:
+						pending = append(pending, name)
+					case !machine.DeletionTimestamp.IsZero():
+					case conditions.IsFalse(machine, clusterv1.MachineHealthCheckSucceededCondition):
+					default:
+						pending = append(pending, name)
+					}
+				}
+				return pending
+			}, e2eCtx.E2EConfig.GetIntervals(specName, "wait-machine-remediation")...).Should(BeEmpty())
+
+			Expect(disruption.Restore()).To(Succeed())
+			restored = true
+
+			ginkgo.By("Waiting for the cluster to recover all its machines")
+			framework.WaitForControlPlaneAndMachinesReady(ctx, framework.WaitForControlPlaneAndMachinesReadyInput{
+				GetLister:    mgmtClient,
+				Cluster:      result.Cluster,
+				ControlPlane: result.ControlPlane,
+			}, e2eCtx.E2EConfig.GetIntervals("", "wait-control-plane")...)
+			Eventually(func() error {
+				machines := clusterMachines(ctx, mgmtClient, namespace.Name, clusterName)
+				if len(machines) != 5 {
+					return fmt.Errorf("cluster has %d machines, expected 5", len(machines))
+				}
+				for _, machine := range machines {
+					if machine.Status.GetTypedPhase() != clusterv1.MachinePhaseRunning || machine.Status.NodeRef == nil {
+						return fmt.Errorf("machine %s is %s", machine.Name, machine.Status.Phase)
+					}
+				}
+				return nil
+			}, e2eCtx.E2EConfig.GetIntervals("", "wait-worker-nodes")...).Should(Succeed())
+		})
+	})
+})
+
+// clusterMachines returns the Machines of the cluster.
+func clusterMachines(ctx context.Context, c crclient.Client, namespace, clusterName string) []clusterv1.Machine {
+	machines := &clusterv1.MachineList{}
+	Expect(c.List(ctx, machines, crclient.InNamespace(namespace), crclient.MatchingLabels{clusterv1.ClusterNameLabel: clusterName})).To(Succeed())
+	return machines.Items
+}
+
+// machineAvailabilityZone returns the availability zone of the instance of the Machine, from its aws:///<zone>/<id>
+// provider ID.
+func machineAvailabilityZone(machine clusterv1.Machine) string {
+	if machine.Spec.ProviderID == nil {
+		return ""
+	}
+	parts := strings.Split(strings.TrimPrefix(*machine.Spec.ProviderID, "aws:///"), "/")
+	if len(parts) != 2 {
+		return ""
+	}
+	return parts[0]
+}
+
+// minorityControlPlaneZone returns the availability zone hosting the fewest control plane machines, provided that
+// losing them keeps the quorum of etcd.
+func minorityControlPlaneZone(machines []clusterv1.Machine) string {
+	counts := map[string]int{}
+	total := 0
+	for _, machine := range machines {
+		if _, ok := machine.Labels[clusterv1.MachineControlPlaneLabel]; !ok {
+			continue
+		}
+		total++
+		if zone := machineAvailabilityZone(machine); zone != "" {
+			counts[zone]++
+		}
+	}
+
+	zone := ""
+	for z, count := range counts {
+		if 2*count < total && (zone == "" || count < counts[zone]) {
+			zone = z
+		}
+	}
+	return zone
+}
+
+// apiServerAvailable returns an error if the API server cannot be reached after a few attempts, which tolerates the
+// load balancer nodes of the failed availability zone until they are removed from its DNS record.
+func apiServerAvailable[T any](call func() (T, error)) error {
+	var err error
+	for attempt := 0; attempt < 3; attempt++ {
+		if _, err = call(); err == nil {
+			return nil
+		}
+		time.Sleep(5 * time.Second)
+	}
+	return err
+}
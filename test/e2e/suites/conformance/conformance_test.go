@@ -37,7 +37,6 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/e2e/shared"
 	"sigs.k8s.io/cluster-api/test/framework/clusterctl"
 	"sigs.k8s.io/cluster-api/test/framework/kubernetesversions"
-	"sigs.k8s.io/cluster-api/test/framework/kubetest"
 	"sigs.k8s.io/cluster-api/util"
 )
 
@@ -112,13 +111,14 @@ var _ = ginkgo.Describe("[unmanaged] [conformance] tests", func() {
 
 			workloadProxy := e2eCtx.Environment.BootstrapClusterProxy.GetWorkloadCluster(ctx, namespace.Name, name)
 			experiment.MeasureDuration("conformance suite", func() {
-				kubetest.Run(ctx,
-					kubetest.RunInput{
-						ClusterProxy:   workloadProxy,
-						NumberOfNodes:  int(workerMachineCount),
-						ConfigFilePath: kubetestConfigFilePath,
-					},
-				)
+				err := shared.RunConformance(ctx, e2eCtx, shared.RunConformanceInput{
+					ClusterProxy:      workloadProxy,
+					ClusterName:       name,
+					NumberOfNodes:     int(workerMachineCount),
+					ConfigFilePath:    kubetestConfigFilePath,
+					KubernetesVersion: kubernetesVersion,
+				})
+				Expect(err).NotTo(HaveOccurred(), "conformance suite failed")
 			})
 
 		}, gmeasure.SamplingConfig{N: 1, Duration: time.Minute})
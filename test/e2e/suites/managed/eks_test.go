@@ -112,6 +112,22 @@ var _ = ginkgo.Describe("[managed] [general] EKS cluster tests", func() {
 			}
 		})
 
+		ginkgo.By("should create a MachineDeployment of Bottlerocket nodes")
+		MachineDeploymentSpec(ctx, func() MachineDeploymentSpecInput {
+			return MachineDeploymentSpecInput{
+				E2EConfig:             e2eCtx.E2EConfig,
+				ConfigClusterFn:       defaultConfigCluster,
+				BootstrapClusterProxy: e2eCtx.Environment.BootstrapClusterProxy,
+				AWSSession:            e2eCtx.BootstrapUserAWSSession,
+				Namespace:             namespace,
+				ClusterName:           clusterName,
+				Replicas:              1,
+				Cleanup:               true,
+				Flavor:                EKSBottlerocketMachineDeployOnlyFlavor,
+				OSImage:               "Bottlerocket",
+			}
+		})
+
 		ginkgo.By("should create a managed node pool and scale")
 		MachinePoolSpec(ctx, func() MachinePoolSpecInput {
 			return MachinePoolSpecInput{
@@ -165,6 +181,19 @@ var _ = ginkgo.Describe("[managed] [general] EKS cluster tests", func() {
 			}
 		})
 
+		ginkgo.By("should run a workload on a Windows managed node pool")
+		WindowsWorkloadSpec(ctx, func() WindowsWorkloadSpecInput {
+			return WindowsWorkloadSpecInput{
+				E2EConfig:             e2eCtx.E2EConfig,
+				ConfigClusterFn:       defaultConfigCluster,
+				BootstrapClusterProxy: e2eCtx.Environment.BootstrapClusterProxy,
+				AWSSession:            e2eCtx.BootstrapUserAWSSession,
+				AWSSessionV2:          e2eCtx.BootstrapUserAWSSessionV2,
+				Namespace:             namespace,
+				ClusterName:           clusterName,
+			}
+		})
+
 		ginkgo.By(fmt.Sprintf("getting cluster with name %s", clusterName))
 		cluster := framework.GetClusterByName(ctx, framework.GetClusterByNameInput{
 			Getter:    e2eCtx.Environment.BootstrapClusterProxy.GetClient(),
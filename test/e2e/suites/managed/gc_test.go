@@ -126,6 +126,16 @@ var _ = ginkgo.Describe("[managed] [gc] EKS Cluster external resource GC tests",
 			Type:             infrav1.LoadBalancerTypeELB,
 		}, e2eCtx.E2EConfig.GetIntervals("", "wait-loadbalancer-ready")...)
 
+		ginkgo.By("Checking we have the target groups of the NLB in AWS")
+		targetGroups, err := shared.GetTargetGroupARNs(shared.GetLoadBalancerARNsInput{
+			AWSSession:       e2eCtx.BootstrapUserAWSSession,
+			ServiceName:      "podinfo-nlb",
+			ServiceNamespace: "default",
+			ClusterName:      cp.Spec.EKSClusterName,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targetGroups).NotTo(BeEmpty(), "there are no target groups for the nlb service")
+
 		ginkgo.By(fmt.Sprintf("Deleting workload/tenant cluster %s", clusterName))
 		framework.DeleteCluster(ctx, framework.DeleteClusterInput{
 			Deleter: e2eCtx.Environment.BootstrapClusterProxy.GetClient(),
@@ -148,6 +158,14 @@ var _ = ginkgo.Describe("[managed] [gc] EKS Cluster external resource GC tests",
 		})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(arns).To(BeEmpty(), "there are %d service load balancers (nlb) still", len(arns))
+		targetGroups, err = shared.GetTargetGroupARNs(shared.GetLoadBalancerARNsInput{
+			AWSSession:       e2eCtx.BootstrapUserAWSSession,
+			ServiceName:      "podinfo-nlb",
+			ServiceNamespace: "default",
+			ClusterName:      cp.Spec.EKSClusterName,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targetGroups).To(BeEmpty(), "there are %d target groups (nlb) still", len(targetGroups))
 		arns, err = shared.GetLoadBalancerARNs(shared.GetLoadBalancerARNsInput{
 			AWSSession:       e2eCtx.BootstrapUserAWSSession,
 			ServiceName:      "podinfo-elb",
@@ -250,6 +268,16 @@ var _ = ginkgo.Describe("[managed] [gc] EKS Cluster external resource GC tests",
 			Type:             infrav1.LoadBalancerTypeELB,
 		}, e2eCtx.E2EConfig.GetIntervals("", "wait-loadbalancer-ready")...)
 
+		ginkgo.By("Checking we have the target groups of the NLB in AWS")
+		targetGroups, err := shared.GetTargetGroupARNs(shared.GetLoadBalancerARNsInput{
+			AWSSession:       e2eCtx.BootstrapUserAWSSession,
+			ServiceName:      "podinfo-nlb",
+			ServiceNamespace: "default",
+			ClusterName:      cp.Spec.EKSClusterName,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targetGroups).NotTo(BeEmpty(), "there are no target groups for the nlb service")
+
 		ginkgo.By(fmt.Sprintf("Deleting workload/tenant cluster %s", clusterName))
 		framework.DeleteCluster(ctx, framework.DeleteClusterInput{
 			Deleter: e2eCtx.Environment.BootstrapClusterProxy.GetClient(),
@@ -272,6 +300,14 @@ var _ = ginkgo.Describe("[managed] [gc] EKS Cluster external resource GC tests",
 		})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(arns).To(BeEmpty(), "there are %d service load balancers (nlb) still", len(arns))
+		targetGroups, err = shared.GetTargetGroupARNs(shared.GetLoadBalancerARNsInput{
+			AWSSession:       e2eCtx.BootstrapUserAWSSession,
+			ServiceName:      "podinfo-nlb",
+			ServiceNamespace: "default",
+			ClusterName:      cp.Spec.EKSClusterName,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targetGroups).To(BeEmpty(), "there are %d target groups (nlb) still", len(targetGroups))
 		arns, err = shared.GetLoadBalancerARNs(shared.GetLoadBalancerARNsInput{
 			AWSSession:       e2eCtx.BootstrapUserAWSSession,
 			ServiceName:      "podinfo-elb",
@@ -44,8 +44,10 @@ const (
 	EKSControlPlaneOnlyFlavor                         = "eks-control-plane-only"
 	EKSControlPlaneOnlyWithAddonFlavor                = "eks-control-plane-only-withaddon"
 	EKSMachineDeployOnlyFlavor                        = "eks-machine-deployment-only"
+	EKSBottlerocketMachineDeployOnlyFlavor            = "eks-bottlerocket-machine-deployment-only"
 	EKSManagedMachinePoolOnlyFlavor                   = "eks-managed-machinepool-only"
 	EKSManagedMachinePoolWithLaunchTemplateOnlyFlavor = "eks-managed-machinepool-with-launch-template-only"
+	EKSWindowsManagedMachinePoolOnlyFlavor            = "eks-windows-managed-machinepool-only"
 	EKSMachinePoolOnlyFlavor                          = "eks-machinepool-only"
 	EKSIPv6ClusterFlavor                              = "eks-ipv6-cluster"
 	EKSControlPlaneOnlyLegacyFlavor                   = "eks-control-plane-only-legacy"
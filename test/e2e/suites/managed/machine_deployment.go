@@ -28,6 +28,7 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/e2e/shared"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -45,6 +46,10 @@ type MachineDeploymentSpecInput struct {
 	Replicas              int64
 	ClusterName           string
 	Cleanup               bool
+	// Flavor is the flavor of the MachineDeployment, defaults to EKSMachineDeployOnlyFlavor.
+	Flavor string
+	// OSImage, if set, is a substring of the OS image reported by the nodes of the MachineDeployment.
+	OSImage string
 }
 
 // MachineDeploymentSpec implements a test for creating a machine deployment for use with CAPA.
@@ -65,9 +70,13 @@ func MachineDeploymentSpec(ctx context.Context, inputGetter func() MachineDeploy
 	})
 	Expect(cluster).NotTo(BeNil(), "couldn't find CAPI cluster")
 
-	By(fmt.Sprintf("creating an applying the %s template", EKSMachineDeployOnlyFlavor))
+	flavor := input.Flavor
+	if flavor == "" {
+		flavor = EKSMachineDeployOnlyFlavor
+	}
+	By(fmt.Sprintf("creating an applying the %s template", flavor))
 	configCluster := input.ConfigClusterFn(input.ClusterName, input.Namespace.Name)
-	configCluster.Flavor = EKSMachineDeployOnlyFlavor
+	configCluster.Flavor = flavor
 	configCluster.WorkerMachineCount = ptr.To[int64](input.Replicas)
 	err := shared.ApplyTemplate(ctx, configCluster, input.BootstrapClusterProxy)
 	Expect(err).ShouldNot(HaveOccurred())
@@ -95,6 +104,19 @@ func MachineDeploymentSpec(ctx context.Context, inputGetter func() MachineDeploy
 	}
 	framework.WaitForMachineStatusCheck(ctx, machineStatusInput, input.E2EConfig.GetIntervals("", "wait-machine-status")...)
 
+	if input.OSImage != "" {
+		By(fmt.Sprintf("Checking the worker node runs %s", input.OSImage))
+		Eventually(func(g Gomega) {
+			machine := &clusterv1.Machine{}
+			g.Expect(input.BootstrapClusterProxy.GetClient().Get(ctx, crclient.ObjectKeyFromObject(&workerMachines[0]), machine)).To(Succeed())
+			g.Expect(machine.Status.NodeRef).NotTo(BeNil())
+			node := &corev1.Node{}
+			workloadClient := input.BootstrapClusterProxy.GetWorkloadCluster(ctx, input.Namespace.Name, input.ClusterName).GetClient()
+			g.Expect(workloadClient.Get(ctx, crclient.ObjectKey{Name: machine.Status.NodeRef.Name}, node)).To(Succeed())
+			g.Expect(node.Status.NodeInfo.OSImage).To(ContainSubstring(input.OSImage))
+		}, input.E2EConfig.GetIntervals("", "wait-worker-nodes")...).Should(Succeed())
+	}
+
 	if input.Cleanup {
 		deleteMachineDeployment(ctx, deleteMachineDeploymentInput{
 			Deleter:           input.BootstrapClusterProxy.GetClient(),
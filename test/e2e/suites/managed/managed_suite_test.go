@@ -37,10 +37,11 @@ import (
 )
 
 var (
-	e2eCtx           *shared.E2EContext
-	skipUpgradeTests bool
-	skipGeneralTests bool
-	skipLegacyTests  bool
+	e2eCtx                *shared.E2EContext
+	skipUpgradeTests      bool
+	skipGeneralTests      bool
+	skipLegacyTests       bool
+	enableConformanceTest bool
 )
 
 func init() {
@@ -50,6 +51,7 @@ func init() {
 	flag.BoolVar(&skipGeneralTests, "skip-eks-general-tests", false, "if true, the general EKS tests will be skipped")
 	flag.BoolVar(&skipUpgradeTests, "skip-eks-upgrade-tests", false, "if true, the EKS upgrade tests will be skipped")
 	flag.BoolVar(&skipLegacyTests, "skip-eks-legacy-tests", false, "if true, the EKS legacy tests will be skipped")
+	flag.BoolVar(&enableConformanceTest, "run-eks-conformance-tests", false, "if true, the conformance suite is run against an EKS cluster")
 }
 
 func TestE2E(t *testing.T) {
@@ -85,6 +87,10 @@ func runLegacyTests() bool {
 	return !skipLegacyTests
 }
 
+func runConformanceTests() bool {
+	return enableConformanceTest
+}
+
 func initScheme() *runtime.Scheme {
 	sc := shared.DefaultScheme()
 	_ = expinfrav1.AddToScheme(sc)
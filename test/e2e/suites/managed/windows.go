@@ -0,0 +1,136 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"sigs.k8s.io/cluster-api/test/framework"
+	"sigs.k8s.io/cluster-api/test/framework/clusterctl"
+)
+
+const (
+	windowsWorkloadName  = "windows-smoke-test"
+	windowsWorkloadImage = "registry.k8s.io/e2e-test-images/agnhost:2.52"
+)
+
+// WindowsWorkloadSpecInput is the input for WindowsWorkloadSpec.
+type WindowsWorkloadSpecInput struct {
+	E2EConfig             *clusterctl.E2EConfig
+	ConfigClusterFn       DefaultConfigClusterFn
+	BootstrapClusterProxy framework.ClusterProxy
+	AWSSession            client.ConfigProvider
+	AWSSessionV2          *aws.Config
+	Namespace             *corev1.Namespace
+	ClusterName           string
+}
+
+// WindowsWorkloadSpec implements a test for running a workload on a Windows managed node group.
+func WindowsWorkloadSpec(ctx context.Context, inputGetter func() WindowsWorkloadSpecInput) {
+	input := inputGetter()
+	Expect(input.E2EConfig).ToNot(BeNil(), "Invalid argument. input.E2EConfig can't be nil")
+	Expect(input.ConfigClusterFn).ToNot(BeNil(), "Invalid argument. input.ConfigClusterFn can't be nil")
+	Expect(input.BootstrapClusterProxy).ToNot(BeNil(), "Invalid argument. input.BootstrapClusterProxy can't be nil")
+	Expect(input.AWSSession).ToNot(BeNil(), "Invalid argument. input.AWSSession can't be nil")
+	Expect(input.AWSSessionV2).ToNot(BeNil(), "Invalid argument. input.AWSSessionV2 can't be nil")
+	Expect(input.Namespace).NotTo(BeNil(), "Invalid argument. input.Namespace can't be nil")
+	Expect(input.ClusterName).ShouldNot(BeEmpty(), "Invalid argument. input.ClusterName can't be empty")
+
+	workloadClient := input.BootstrapClusterProxy.GetWorkloadCluster(ctx, input.Namespace.Name, input.ClusterName).GetClient()
+
+	By("Enabling Windows support in the VPC CNI")
+	Eventually(func() error {
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "amazon-vpc-cni"}}
+		_, err := controllerutil.CreateOrUpdate(ctx, workloadClient, configMap, func() error {
+			if configMap.Data == nil {
+				configMap.Data = map[string]string{}
+			}
+			configMap.Data["enable-windows-ipam"] = "true"
+			return nil
+		})
+		return err
+	}, input.E2EConfig.GetIntervals("", "wait-client-request")...).Should(Succeed(), "eventually failed trying to enable Windows IPAM")
+
+	MachinePoolSpec(ctx, func() MachinePoolSpecInput {
+		return MachinePoolSpecInput{
+			E2EConfig:             input.E2EConfig,
+			ConfigClusterFn:       input.ConfigClusterFn,
+			BootstrapClusterProxy: input.BootstrapClusterProxy,
+			AWSSession:            input.AWSSession,
+			AWSSessionV2:          input.AWSSessionV2,
+			Namespace:             input.Namespace,
+			ClusterName:           input.ClusterName,
+			ManagedMachinePool:    true,
+			Flavor:                EKSWindowsManagedMachinePoolOnlyFlavor,
+		}
+	})
+
+	By("Running a workload on the Windows nodes")
+	labels := map[string]string{"app": windowsWorkloadName}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: windowsWorkloadName},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					NodeSelector: map[string]string{corev1.LabelOSStable: "windows"},
+					Containers: []corev1.Container{{
+						Name:  windowsWorkloadName,
+						Image: windowsWorkloadImage,
+						Args:  []string{"pause"},
+					}},
+				},
+			},
+		},
+	}
+	Expect(workloadClient.Create(ctx, deployment)).To(Succeed())
+	framework.WaitForDeploymentsAvailable(ctx, framework.WaitForDeploymentsAvailableInput{
+		Getter:     workloadClient,
+		Deployment: deployment,
+	}, input.E2EConfig.GetIntervals("", "wait-windows-workload")...)
+	Expect(workloadClient.Delete(ctx, deployment)).To(Succeed())
+
+	mp := framework.GetMachinePoolsByCluster(ctx, framework.GetMachinePoolsByClusterInput{
+		Lister:      input.BootstrapClusterProxy.GetClient(),
+		ClusterName: input.ClusterName,
+		Namespace:   input.Namespace.Name,
+	})
+	Expect(mp).To(HaveLen(1))
+	deleteMachinePool(ctx, deleteMachinePoolInput{
+		Deleter:     input.BootstrapClusterProxy.GetClient(),
+		MachinePool: mp[0],
+	})
+	waitForMachinePoolDeleted(ctx, waitForMachinePoolDeletedInput{
+		Getter:      input.BootstrapClusterProxy.GetClient(),
+		MachinePool: mp[0],
+	}, input.E2EConfig.GetIntervals("", "wait-delete-machine-pool")...)
+}
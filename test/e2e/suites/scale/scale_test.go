@@ -0,0 +1,118 @@
+//go:build e2e
+// +build e2e
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scale
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/e2e/shared"
+	"sigs.k8s.io/cluster-api/test/framework"
+	"sigs.k8s.io/cluster-api/test/framework/clusterctl"
+	"sigs.k8s.io/cluster-api/util"
+)
+
+var _ = ginkgo.Describe("[unmanaged] [scale] tests", func() {
+	var (
+		ctx               context.Context
+		requiredResources *shared.TestResource
+	)
+
+	ginkgo.BeforeEach(func() {
+		ctx = context.TODO()
+	})
+
+	ginkgo.It("should create and delete many clusters and report the performance of the controller", func() {
+		specName := "scale"
+		Expect(clusterCount).To(BeNumerically(">", 0), "Invalid argument. scale.clusters must be positive")
+		Expect(workerMachineCount).To(BeNumerically(">=", 0), "Invalid argument. scale.worker-machines can't be negative")
+		if !e2eCtx.Settings.SkipQuotas {
+			requiredResources = &shared.TestResource{
+				EC2Normal:        clusterCount * (1 + workerMachineCount) * e2eCtx.Settings.InstanceVCPU,
+				IGW:              clusterCount,
+				NGW:              clusterCount,
+				VPC:              clusterCount,
+				ClassicLB:        clusterCount,
+				EIP:              clusterCount,
+				EventBridgeRules: 50,
+			}
+			requiredResources.WriteRequestedResources(e2eCtx, specName)
+			shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+			Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+			defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
+		}
+		namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
+		defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
+
+		monitor := shared.StartScaleMonitor(ctx, e2eCtx)
+		defer monitor.Stop()
+
+		shared.Byf("Creating %d clusters with %d worker machines each", clusterCount, workerMachineCount)
+		var wg sync.WaitGroup
+		for i := 0; i < clusterCount; i++ {
+			wg.Add(1)
+			go func() {
+				defer ginkgo.GinkgoRecover()
+				defer wg.Done()
+				start := time.Now()
+				clusterctl.ApplyClusterTemplateAndWait(ctx, clusterctl.ApplyClusterTemplateAndWaitInput{
+					ClusterProxy: e2eCtx.Environment.BootstrapClusterProxy,
+					ConfigCluster: clusterctl.ConfigClusterInput{
+						LogFolder:                filepath.Join(e2eCtx.Settings.ArtifactFolder, "clusters", e2eCtx.Environment.BootstrapClusterProxy.GetName()),
+						ClusterctlConfigPath:     e2eCtx.Environment.ClusterctlConfigPath,
+						KubeconfigPath:           e2eCtx.Environment.BootstrapClusterProxy.GetKubeconfigPath(),
+						InfrastructureProvider:   clusterctl.DefaultInfrastructureProvider,
+						Flavor:                   shared.LimitAzFlavor,
+						Namespace:                namespace.Name,
+						ClusterName:              fmt.Sprintf("%s-%s", specName, util.RandomString(6)),
+						KubernetesVersion:        e2eCtx.E2EConfig.MustGetVariable(shared.KubernetesVersion),
+						ControlPlaneMachineCount: ptr.To[int64](1),
+						WorkerMachineCount:       ptr.To(int64(workerMachineCount)),
+					},
+					WaitForClusterIntervals:      e2eCtx.E2EConfig.GetIntervals(specName, "wait-cluster"),
+					WaitForControlPlaneIntervals: e2eCtx.E2EConfig.GetIntervals(specName, "wait-control-plane"),
+					WaitForMachineDeployments:    e2eCtx.E2EConfig.GetIntervals(specName, "wait-worker-nodes"),
+				}, &clusterctl.ApplyClusterTemplateAndWaitResult{})
+				monitor.ObserveClusterProvisioned(time.Since(start))
+			}()
+		}
+		wg.Wait()
+
+		ginkgo.By("Deleting the clusters")
+		start := time.Now()
+		framework.DeleteAllClustersAndWait(ctx, framework.DeleteAllClustersAndWaitInput{
+			ClusterProxy:         e2eCtx.Environment.BootstrapClusterProxy,
+			ClusterctlConfigPath: e2eCtx.Environment.ClusterctlConfigPath,
+			Namespace:            namespace.Name,
+			ArtifactFolder:       e2eCtx.Settings.ArtifactFolder,
+		}, e2eCtx.E2EConfig.GetIntervals(specName, "wait-delete-cluster")...)
+		monitor.ObserveClustersDeleted(time.Since(start))
+
+		report := monitor.Report(ctx, specName, clusterCount, workerMachineCount)
+		Expect(report.ClusterProvisioning.Count).To(Equal(clusterCount), "Not all the clusters were provisioned")
+	})
+})
@@ -24,7 +24,6 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/gofrs/flock"
 	"github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/utils/ptr"
@@ -56,8 +55,9 @@ var _ = ginkgo.Context("[unmanaged] [gc]", func() {
 
 		requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 1, EventBridgeRules: 50}
 		requiredResources.WriteRequestedResources(e2eCtx, specName)
-		Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-		defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+		shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+		Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+		defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 		namespace := shared.SetupNamespace(ctx, specName, e2eCtx)
 		defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
 		ginkgo.By("Creating cluster with single control plane")
@@ -105,6 +105,16 @@ var _ = ginkgo.Context("[unmanaged] [gc]", func() {
 			Type:             infrav1.LoadBalancerTypeELB,
 		}, e2eCtx.E2EConfig.GetIntervals("", "wait-loadbalancer-ready")...)
 
+		ginkgo.By("Checking we have the target groups of the NLB in AWS")
+		targetGroups, err := shared.GetTargetGroupARNs(shared.GetLoadBalancerARNsInput{
+			AWSSession:       e2eCtx.BootstrapUserAWSSession,
+			ServiceName:      "podinfo-nlb",
+			ServiceNamespace: "default",
+			ClusterName:      clusterName,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targetGroups).NotTo(BeEmpty(), "there are no target groups for the nlb service")
+
 		ginkgo.By(fmt.Sprintf("Deleting workload/tenant cluster %s", clusterName))
 		framework.DeleteCluster(ctx, framework.DeleteClusterInput{
 			Deleter: e2eCtx.Environment.BootstrapClusterProxy.GetClient(),
@@ -127,6 +137,14 @@ var _ = ginkgo.Context("[unmanaged] [gc]", func() {
 		})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(arns).To(BeEmpty(), "there are %d service load balancers (nlb) still", len(arns))
+		targetGroups, err = shared.GetTargetGroupARNs(shared.GetLoadBalancerARNsInput{
+			AWSSession:       e2eCtx.BootstrapUserAWSSession,
+			ServiceName:      "podinfo-nlb",
+			ServiceNamespace: "default",
+			ClusterName:      clusterName,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targetGroups).To(BeEmpty(), "there are %d target groups (nlb) still", len(targetGroups))
 		arns, err = shared.GetLoadBalancerARNs(shared.GetLoadBalancerARNsInput{
 			AWSSession:       e2eCtx.BootstrapUserAWSSession,
 			ServiceName:      "podinfo-elb",
@@ -159,8 +177,9 @@ var _ = ginkgo.Context("[unmanaged] [gc]", func() {
 
 		requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 1, EventBridgeRules: 50}
 		requiredResources.WriteRequestedResources(e2eCtx, specName)
-		Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-		defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+		shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+		Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+		defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 		namespace := shared.SetupNamespace(ctx, specName, e2eCtx)
 		defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
 		ginkgo.By("Creating cluster with single control plane")
@@ -211,6 +230,16 @@ var _ = ginkgo.Context("[unmanaged] [gc]", func() {
 			Type:             infrav1.LoadBalancerTypeELB,
 		}, e2eCtx.E2EConfig.GetIntervals("", "wait-loadbalancer-ready")...)
 
+		ginkgo.By("Checking we have the target groups of the NLB in AWS")
+		targetGroups, err := shared.GetTargetGroupARNs(shared.GetLoadBalancerARNsInput{
+			AWSSession:       e2eCtx.BootstrapUserAWSSession,
+			ServiceName:      "podinfo-nlb",
+			ServiceNamespace: "default",
+			ClusterName:      clusterName,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targetGroups).NotTo(BeEmpty(), "there are no target groups for the nlb service")
+
 		ginkgo.By(fmt.Sprintf("Deleting workload/tenant cluster %s", clusterName))
 		framework.DeleteCluster(ctx, framework.DeleteClusterInput{
 			Deleter: e2eCtx.Environment.BootstrapClusterProxy.GetClient(),
@@ -233,6 +262,14 @@ var _ = ginkgo.Context("[unmanaged] [gc]", func() {
 		})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(arns).To(BeEmpty(), "there are %d service load balancers (nlb) still", len(arns))
+		targetGroups, err = shared.GetTargetGroupARNs(shared.GetLoadBalancerARNsInput{
+			AWSSession:       e2eCtx.BootstrapUserAWSSession,
+			ServiceName:      "podinfo-nlb",
+			ServiceNamespace: "default",
+			ClusterName:      clusterName,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targetGroups).To(BeEmpty(), "there are %d target groups (nlb) still", len(targetGroups))
 		arns, err = shared.GetLoadBalancerARNs(shared.GetLoadBalancerARNsInput{
 			AWSSession:       e2eCtx.BootstrapUserAWSSession,
 			ServiceName:      "podinfo-elb",
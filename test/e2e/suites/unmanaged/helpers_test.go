@@ -22,6 +22,7 @@ package unmanaged
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -34,6 +35,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/blang/semver"
 	"github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -332,6 +334,22 @@ func assertSpotInstanceType(instanceID string) {
 	Expect(len(result.Reservations[0].Instances)).To(Equal(1))
 }
 
+func assertOnDemandInstanceType(instanceID string) {
+	ginkgo.By(fmt.Sprintf("Finding EC2 on-demand instance with ID: %s", instanceID))
+	ec2Client := ec2.New(e2eCtx.AWSSession)
+	input := &ec2.DescribeInstancesInput{
+		InstanceIds: []*string{
+			aws.String(instanceID[strings.LastIndex(instanceID, "/")+1:]),
+		},
+	}
+
+	result, err := ec2Client.DescribeInstances(input)
+	Expect(err).To(BeNil())
+	Expect(len(result.Reservations)).To(Equal(1))
+	Expect(len(result.Reservations[0].Instances)).To(Equal(1))
+	Expect(result.Reservations[0].Instances[0].InstanceLifecycle).To(BeNil())
+}
+
 func assertInstanceMetadataOptions(instanceID string, expected infrav1.InstanceMetadataOptions) {
 	ginkgo.By(fmt.Sprintf("Finding EC2 instance with ID: %s", instanceID))
 	ec2Client := ec2.New(e2eCtx.AWSSession)
@@ -371,6 +389,74 @@ func assertUnencryptedUserDataIgnition(instanceID string, expected string) {
 	Expect(string(userData)).To(HaveValue(MatchJSON(expected)), "expected userdata to match")
 }
 
+// getIgnitionConfigSource returns the URL of the Ignition config the user data of the instance references.
+func getIgnitionConfigSource(instanceID string) string {
+	ec2Client := ec2.New(e2eCtx.AWSSession)
+	result, err := ec2Client.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
+		Attribute:  aws.String(ec2.InstanceAttributeNameUserData),
+		InstanceId: aws.String(instanceID[strings.LastIndex(instanceID, "/")+1:]),
+	})
+	Expect(err).ToNot(HaveOccurred(), "expected DescribeInstanceAttribute call to succeed")
+	userData, err := base64.StdEncoding.DecodeString(*result.UserData.Value)
+	Expect(err).ToNot(HaveOccurred(), "expected ec2 instance user data to be base64 decodable")
+
+	// The config is appended with Ignition v2, and merged with Ignition v3.
+	config := struct {
+		Ignition struct {
+			Config struct {
+				Append []struct{ Source string } `json:"append"`
+				Merge  []struct{ Source string } `json:"merge"`
+			} `json:"config"`
+		} `json:"ignition"`
+	}{}
+	Expect(json.Unmarshal(userData, &config)).To(Succeed(), "expected ec2 instance user data to be an Ignition config")
+	sources := append(config.Ignition.Config.Append, config.Ignition.Config.Merge...)
+	Expect(sources).To(HaveLen(1), "expected the Ignition config to reference the bootstrap data")
+	return sources[0].Source
+}
+
+// watchBootstrapDataKMSKeys polls the node bootstrap data objects of the bucket until the returned function is called,
+// which returns the KMS keys the objects seen in between were encrypted with. The objects are polled since they are
+// deleted once the instances are running.
+func watchBootstrapDataKMSKeys(ctx context.Context, bucket string) func() map[string]string {
+	s3Client := s3.New(e2eCtx.AWSSession)
+	keys := map[string]string{}
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer ginkgo.GinkgoRecover()
+		defer close(done)
+		for {
+			out, err := s3Client.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+				Bucket: aws.String(bucket),
+				Prefix: aws.String("node/"),
+			})
+			if err == nil {
+				for _, obj := range out.Contents {
+					if _, ok := keys[*obj.Key]; ok {
+						continue
+					}
+					head, err := s3Client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{Bucket: aws.String(bucket), Key: obj.Key})
+					if err != nil {
+						continue
+					}
+					keys[*obj.Key] = aws.StringValue(head.SSEKMSKeyId)
+				}
+			}
+			select {
+			case <-stop:
+				return
+			case <-time.After(5 * time.Second):
+			}
+		}
+	}()
+	return func() map[string]string {
+		close(stop)
+		<-done
+		return keys
+	}
+}
+
 func terminateInstance(instanceID string) {
 	ginkgo.By(fmt.Sprintf("Terminating EC2 instance with ID: %s", instanceID))
 	ec2Client := ec2.New(e2eCtx.AWSSession)
@@ -22,7 +22,6 @@ package unmanaged
 import (
 	"context"
 
-	"github.com/gofrs/flock"
 	"github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/utils/ptr"
@@ -46,7 +45,8 @@ var _ = ginkgo.Context("[unmanaged] [Cluster API Framework] [ClusterClass]", fun
 			// As the resources cannot be defined by the It() clause in CAPI tests, using the largest values required for all It() tests in this CAPI test.
 			requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 1, EventBridgeRules: 50}
 			requiredResources.WriteRequestedResources(e2eCtx, "capi-clusterctl-self-hosted-test-clusterclass")
-			Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
+			shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+			Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
 		})
 
 		capi_e2e.SelfHostedSpec(ctx, func() capi_e2e.SelfHostedSpecInput {
@@ -61,7 +61,7 @@ var _ = ginkgo.Context("[unmanaged] [Cluster API Framework] [ClusterClass]", fun
 		})
 
 		ginkgo.AfterEach(func() {
-			shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+			shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 		})
 	})
 
@@ -71,7 +71,8 @@ var _ = ginkgo.Context("[unmanaged] [Cluster API Framework] [ClusterClass]", fun
 				// As the resources cannot be defined by the It() clause in CAPI tests, using the largest values required for all It() tests in this CAPI test.
 				requiredResources = &shared.TestResource{EC2Normal: 5 * e2eCtx.Settings.InstanceVCPU, IGW: 2, NGW: 2, VPC: 2, ClassicLB: 2, EIP: 2, EventBridgeRules: 50}
 				requiredResources.WriteRequestedResources(e2eCtx, "capi-cluster-upgrade-clusterclass-test")
-				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
 			}
 		})
 
@@ -90,7 +91,7 @@ var _ = ginkgo.Context("[unmanaged] [Cluster API Framework] [ClusterClass]", fun
 
 		ginkgo.AfterEach(func() {
 			if !e2eCtx.Settings.SkipQuotas {
-				shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+				shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 			}
 		})
 	})
@@ -101,7 +102,8 @@ var _ = ginkgo.Context("[unmanaged] [Cluster API Framework] [ClusterClass]", fun
 				// As the resources cannot be defined by the It() clause in CAPI tests, using the largest values required for all It() tests in this CAPI test.
 				requiredResources = &shared.TestResource{EC2Normal: 5 * e2eCtx.Settings.InstanceVCPU, IGW: 2, NGW: 2, VPC: 2, ClassicLB: 2, EIP: 2, EventBridgeRules: 50}
 				requiredResources.WriteRequestedResources(e2eCtx, "capi-cluster-ssa-clusterclass-test")
-				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
 			}
 		})
 
@@ -140,7 +142,7 @@ var _ = ginkgo.Context("[unmanaged] [Cluster API Framework] [ClusterClass]", fun
 
 		ginkgo.AfterEach(func() {
 			if !e2eCtx.Settings.SkipQuotas {
-				shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+				shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 			}
 		})
 	})
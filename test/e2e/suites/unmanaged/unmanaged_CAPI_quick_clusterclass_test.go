@@ -22,7 +22,6 @@ package unmanaged
 import (
 	"context"
 
-	"github.com/gofrs/flock"
 	"github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -51,7 +50,8 @@ var _ = ginkgo.Context("[unmanaged] [Cluster API Framework] [smoke] [PR-Blocking
 			// As the resources cannot be defined by the It() clause in CAPI tests, using the largest values required for all It() tests in this CAPI test.
 			requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 3, EventBridgeRules: 50}
 			requiredResources.WriteRequestedResources(e2eCtx, "capi-quick-start-clusterclass-test")
-			Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
+			shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+			Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
 		})
 		capi_e2e.QuickStartSpec(context.TODO(), func() capi_e2e.QuickStartSpecInput {
 			return capi_e2e.QuickStartSpecInput{
@@ -64,7 +64,7 @@ var _ = ginkgo.Context("[unmanaged] [Cluster API Framework] [smoke] [PR-Blocking
 			}
 		})
 		ginkgo.AfterEach(func() {
-			_ = shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+			_ = shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 		})
 	})
 	ginkgo.AfterEach(func() {
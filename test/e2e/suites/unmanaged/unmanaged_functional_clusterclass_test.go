@@ -25,7 +25,6 @@ import (
 	"path/filepath"
 	"time"
 
-	"github.com/gofrs/flock"
 	"github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -56,8 +55,9 @@ var _ = ginkgo.Context("[unmanaged] [functional] [ClusterClass]", func() {
 			specName := "functional-multitenancy-nested-clusterclass"
 			requiredResources = &shared.TestResource{EC2Normal: 1 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 1, EventBridgeRules: 50}
 			requiredResources.WriteRequestedResources(e2eCtx, specName)
-			Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-			defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+			shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+			Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+			defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 			namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
 			defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
 			Expect(shared.SetMultitenancyEnvVars(ctx, e2eCtx.AWSSessionV2)).To(Succeed())
@@ -110,8 +110,9 @@ var _ = ginkgo.Context("[unmanaged] [functional] [ClusterClass]", func() {
 			specName := "functional-test-ssm-parameter-store-clusterclass"
 			requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 3, EventBridgeRules: 50}
 			requiredResources.WriteRequestedResources(e2eCtx, specName)
-			Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-			defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+			shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+			Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+			defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 			namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
 			defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
 
@@ -140,55 +141,38 @@ var _ = ginkgo.Context("[unmanaged] [functional] [ClusterClass]", func() {
 	})
 
 	// This test creates a workload cluster using an externally managed VPC and subnets. CAPA is still handling security group
-	// creation for the cluster. All applicable resources are restricted to us-west-2a for simplicity.
+	// creation for the cluster. All applicable resources are restricted to a single availability zone for simplicity.
+	// With the reuse-network flag, the cluster is created in the shared network of the test runs.
 	ginkgo.Describe("Workload cluster with external infrastructure [ClusterClass]", func() {
 		var namespace *corev1.Namespace
 		var requiredResources *shared.TestResource
+		var deleteNetwork func()
 		specName := "functional-test-extinfra-cc"
 		mgmtClusterName := fmt.Sprintf("%s-%s", specName, util.RandomString(6))
-		mgmtClusterInfra := new(shared.AWSInfrastructure)
 
 		// Some infrastructure creation was moved to a setup node to better organize the test.
 		ginkgo.JustBeforeEach(func() {
-			requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 2, NGW: 2, VPC: 2, ClassicLB: 2, EIP: 5, EventBridgeRules: 50}
+			networkResources := shared.SpecNetworkResources(e2eCtx)
+			requiredResources = &networkResources
+			requiredResources.EC2Normal = 2 * e2eCtx.Settings.InstanceVCPU
+			requiredResources.ClassicLB = 1
+			requiredResources.EventBridgeRules = 50
 			requiredResources.WriteRequestedResources(e2eCtx, specName)
-			Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
+			shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+			Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
 			namespace = shared.SetupSpecNamespace(ctx, specName, e2eCtx)
-			ginkgo.By("Creating the management cluster infrastructure")
-			mgmtClusterInfra.New(shared.AWSInfrastructureSpec{
-				ClusterName:       mgmtClusterName,
-				VpcCidr:           "10.0.0.0/23",
-				PublicSubnetCidr:  "10.0.0.0/24",
-				PrivateSubnetCidr: "10.0.1.0/24",
-				AvailabilityZone:  "us-west-2a",
-			}, e2eCtx)
-			mgmtClusterInfra.CreateInfrastructure()
+			ginkgo.By("Setting up the management cluster infrastructure")
+			deleteNetwork = shared.SetupSpecNetwork(e2eCtx, mgmtClusterName)
 		})
 
 		// Infrastructure cleanup is done in setup node so it is not bypassed if there is a test failure in the subject node.
 		ginkgo.JustAfterEach(func() {
-			shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+			shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 			shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
-			if !e2eCtx.Settings.SkipCleanup {
-				ginkgo.By("Deleting the management cluster infrastructure")
-				mgmtClusterInfra.DeleteInfrastructure()
-			}
+			deleteNetwork()
 		})
 
 		ginkgo.It("should create workload cluster in external VPC", func() {
-			ginkgo.By("Validating management infrastructure")
-			Expect(mgmtClusterInfra.VPC).NotTo(BeNil())
-			Expect(*mgmtClusterInfra.State.VpcState).To(Equal("available"))
-			Expect(len(mgmtClusterInfra.Subnets)).To(Equal(2))
-			Expect(mgmtClusterInfra.InternetGateway).NotTo(BeNil())
-			Expect(mgmtClusterInfra.ElasticIP).NotTo(BeNil())
-			Expect(mgmtClusterInfra.NatGateway).NotTo(BeNil())
-			Expect(len(mgmtClusterInfra.RouteTables)).To(Equal(2))
-
-			shared.SetEnvVar("BYO_VPC_ID", *mgmtClusterInfra.VPC.VpcId, false)
-			shared.SetEnvVar("BYO_PUBLIC_SUBNET_ID", *mgmtClusterInfra.State.PublicSubnetID, false)
-			shared.SetEnvVar("BYO_PRIVATE_SUBNET_ID", *mgmtClusterInfra.State.PrivateSubnetID, false)
-
 			ginkgo.By("Creating a management cluster in a peered VPC")
 			mgmtConfigCluster := defaultConfigCluster(mgmtClusterName, namespace.Name)
 			mgmtConfigCluster.WorkerMachineCount = ptr.To[int64](1)
@@ -22,14 +22,17 @@ package unmanaged
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/blang/semver"
-	"github.com/gofrs/flock"
 	"github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -39,6 +42,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	bootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/api/bootstrap/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/exp/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/e2e/shared"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -68,9 +72,10 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 			if !e2eCtx.Settings.SkipQuotas {
 				requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 1, EventBridgeRules: 50}
 				requiredResources.WriteRequestedResources(e2eCtx, "efs-support-test")
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
 
-				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 			}
 
 			Expect(e2eCtx.E2EConfig).ToNot(BeNil(), "Invalid argument. e2eConfig can't be nil when calling %s spec", specName)
@@ -126,9 +131,10 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 				// Change the multiplier for EC2GPU if GPU type is changed. g4dn.xlarge uses 2 vCPU
 				requiredResources = &shared.TestResource{EC2GPU: 2 * 2, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 1, EventBridgeRules: 50}
 				requiredResources.WriteRequestedResources(e2eCtx, "gpu-test")
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
 
-				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 			}
 
 			ginkgo.By("Creating cluster with a single worker")
@@ -177,8 +183,9 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 			if !e2eCtx.Settings.SkipQuotas {
 				requiredResources = &shared.TestResource{EC2Normal: 1 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 1, EventBridgeRules: 50}
 				requiredResources.WriteRequestedResources(e2eCtx, specName)
-				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 			}
 			namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
 			defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
@@ -269,8 +276,9 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 				if !e2eCtx.Settings.SkipQuotas {
 					requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 3, VPC: 1, ClassicLB: 1, EIP: 3, EventBridgeRules: 50}
 					requiredResources.WriteRequestedResources(e2eCtx, "upgrade-to-master-test")
-					Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-					defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+					shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+					Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+					defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 				}
 				defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
 				ginkgo.By("Creating first cluster with single control plane")
@@ -325,8 +333,9 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 			if !e2eCtx.Settings.SkipQuotas {
 				requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 3, EventBridgeRules: 50}
 				requiredResources.WriteRequestedResources(e2eCtx, specName)
-				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 			}
 			namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
 			defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
@@ -355,14 +364,130 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 		})
 	})
 
+	ginkgo.Describe("Workload cluster in the AWS GovCloud partition", func() {
+		ginkgo.It("should be creatable and deletable", func() {
+			if e2eCtx.Settings.Partition != bootstrapv1.PartitionNameUSGov {
+				ginkgo.Skip(fmt.Sprintf("the tests run against partition %q", e2eCtx.Settings.Partition))
+			}
+			specName := "functional-test-govcloud"
+			if !e2eCtx.Settings.SkipQuotas {
+				requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 3, EventBridgeRules: 50}
+				requiredResources.WriteRequestedResources(e2eCtx, specName)
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
+			}
+			namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
+			defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
+
+			ginkgo.By("Creating a cluster")
+			clusterName := fmt.Sprintf("%s-%s", specName, util.RandomString(6))
+			configCluster := defaultConfigCluster(clusterName, namespace.Name)
+			configCluster.ControlPlaneMachineCount = ptr.To[int64](1)
+			configCluster.WorkerMachineCount = ptr.To[int64](1)
+			configCluster.Flavor = shared.GovCloudFlavor
+			cluster, md, _ := createCluster(ctx, configCluster, result)
+
+			workerMachines := framework.GetMachinesByMachineDeployments(ctx, framework.GetMachinesByMachineDeploymentsInput{
+				Lister:            e2eCtx.Environment.BootstrapClusterProxy.GetClient(),
+				ClusterName:       clusterName,
+				Namespace:         namespace.Name,
+				MachineDeployment: *md[0],
+			})
+			controlPlaneMachines := framework.GetControlPlaneMachinesByCluster(ctx, framework.GetControlPlaneMachinesByClusterInput{
+				Lister:      e2eCtx.Environment.BootstrapClusterProxy.GetClient(),
+				ClusterName: clusterName,
+				Namespace:   namespace.Name,
+			})
+			Expect(len(workerMachines)).To(Equal(1))
+			Expect(len(controlPlaneMachines)).To(Equal(1))
+
+			ginkgo.By("Deleting the cluster")
+			deleteCluster(ctx, cluster)
+		})
+	})
+
+	ginkgo.Describe("Workload cluster with IPv6 networking", func() {
+		ginkgo.DescribeTable("should reach pods and load balancers over each IP family",
+			func(flavor string, ipFamilies []corev1.IPFamily) {
+				shared.SkipUnlessUnmanagedIPv6Supported(ctx, e2eCtx)
+				specName := "functional-test-" + flavor
+				if !e2eCtx.Settings.SkipQuotas {
+					requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 2, EIP: 3, EventBridgeRules: 50}
+					requiredResources.WriteRequestedResources(e2eCtx, specName)
+					shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+					Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+					defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
+				}
+				namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
+				defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
+
+				ginkgo.By("Creating a cluster")
+				clusterName := fmt.Sprintf("%s-%s", specName, util.RandomString(6))
+				configCluster := defaultConfigCluster(clusterName, namespace.Name)
+				configCluster.ControlPlaneMachineCount = ptr.To[int64](1)
+				configCluster.WorkerMachineCount = ptr.To[int64](1)
+				configCluster.Flavor = flavor
+				cluster, _, _ := createCluster(ctx, configCluster, result)
+
+				shared.AWSIPFamilySpec(ctx, e2eCtx, shared.AWSIPFamilySpecInput{
+					BootstrapClusterProxy: e2eCtx.Environment.BootstrapClusterProxy,
+					NamespaceName:         namespace.Name,
+					ClusterName:           clusterName,
+					IPFamilies:            ipFamilies,
+				})
+
+				ginkgo.By("Deleting the cluster")
+				deleteCluster(ctx, cluster)
+			},
+			ginkgo.Entry("IPv6", shared.IPv6Flavor, []corev1.IPFamily{corev1.IPv6Protocol}),
+			ginkgo.Entry("dual-stack", shared.DualStackFlavor, []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}),
+		)
+	})
+
+	ginkgo.Describe("Workload cluster with Graviton workers", func() {
+		ginkgo.It("should run amd64 control plane nodes and arm64 worker nodes", func() {
+			specName := "functional-test-arm64"
+			if !e2eCtx.Settings.SkipQuotas {
+				requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 3, EventBridgeRules: 50}
+				requiredResources.WriteRequestedResources(e2eCtx, specName)
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
+			}
+			namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
+			defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
+
+			ginkgo.By("Creating a cluster")
+			clusterName := fmt.Sprintf("%s-%s", specName, util.RandomString(6))
+			configCluster := defaultConfigCluster(clusterName, namespace.Name)
+			configCluster.ControlPlaneMachineCount = ptr.To[int64](1)
+			configCluster.WorkerMachineCount = ptr.To[int64](1)
+			configCluster.Flavor = shared.Arm64Flavor
+			cluster, _, _ := createCluster(ctx, configCluster, result)
+
+			shared.AWSMixedArchitectureSpec(ctx, e2eCtx, shared.AWSMixedArchitectureSpecInput{
+				BootstrapClusterProxy:    e2eCtx.Environment.BootstrapClusterProxy,
+				NamespaceName:            namespace.Name,
+				ClusterName:              clusterName,
+				ControlPlaneArchitecture: "amd64",
+				WorkerArchitecture:       "arm64",
+			})
+
+			ginkgo.By("Deleting the cluster")
+			deleteCluster(ctx, cluster)
+		})
+	})
+
 	ginkgo.Describe("MachineDeployment misconfigurations", func() {
 		ginkgo.It("MachineDeployment misconfigurations", func() {
 			specName := "functional-test-md-misconfigurations"
 			if !e2eCtx.Settings.SkipQuotas {
 				requiredResources = &shared.TestResource{EC2Normal: 1 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 3, EventBridgeRules: 50}
 				requiredResources.WriteRequestedResources(e2eCtx, specName)
-				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 			}
 			namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
 			defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
@@ -414,8 +539,9 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 			if !e2eCtx.Settings.SkipQuotas {
 				requiredResources = &shared.TestResource{EC2Normal: 3 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 3}
 				requiredResources.WriteRequestedResources(e2eCtx, specName)
-				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 			}
 			namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
 			defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
@@ -471,8 +597,9 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 				if !e2eCtx.Settings.SkipQuotas {
 					requiredResources = &shared.TestResource{EC2Normal: 4 * e2eCtx.Settings.InstanceVCPU, IGW: 2, NGW: 2, VPC: 2, ClassicLB: 2, EIP: 6, EventBridgeRules: 50}
 					requiredResources.WriteRequestedResources(e2eCtx, specName)
-					Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-					defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+					shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+					Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+					defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 				}
 
 				ginkgo.By("Creating first cluster with single control plane")
@@ -556,8 +683,9 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 				if !e2eCtx.Settings.SkipQuotas {
 					requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 2, NGW: 2, VPC: 2, ClassicLB: 2, EIP: 6, EventBridgeRules: 50}
 					requiredResources.WriteRequestedResources(e2eCtx, specName)
-					Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-					defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+					shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+					Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+					defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 				}
 				namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
 				defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
@@ -586,8 +714,9 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 			if !e2eCtx.Settings.SkipQuotas {
 				requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 3, EventBridgeRules: 50}
 				requiredResources.WriteRequestedResources(e2eCtx, specName)
-				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
-				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 			}
 			namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
 			defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
@@ -615,6 +744,87 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 		})
 	})
 
+	ginkgo.Describe("Workload cluster with interrupted spot instances", func() {
+		ginkgo.It("should replace the interrupted machines with on-demand instances", func() {
+			specName := "functional-test-spot-interruptions"
+			if !e2eCtx.Settings.SkipQuotas {
+				requiredResources = &shared.TestResource{EC2Normal: 3 * e2eCtx.Settings.InstanceVCPU, IGW: 1, NGW: 1, VPC: 1, ClassicLB: 1, EIP: 3, EventBridgeRules: 50}
+				requiredResources.WriteRequestedResources(e2eCtx, specName)
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
+				defer shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
+			}
+			namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
+			defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
+			ginkgo.By("Creating a cluster with spot worker machines and a MachineHealthCheck")
+			clusterName := fmt.Sprintf("%s-%s", specName, util.RandomString(6))
+			configCluster := defaultConfigCluster(clusterName, namespace.Name)
+			configCluster.WorkerMachineCount = ptr.To[int64](1)
+			configCluster.Flavor = shared.SpotInterruptionsFlavor
+			_, md, _ := createCluster(ctx, configCluster, result)
+
+			awsMachines := getAWSMachinesForDeployment(namespace.Name, *md[0])
+			Expect(awsMachines.Items).To(HaveLen(1))
+			interrupted := awsMachines.Items[0]
+			Expect(interrupted.Spec.ProviderID).NotTo(BeNil())
+			Expect(interrupted.Spec.InstanceID).NotTo(BeNil())
+			assertSpotInstanceType(*interrupted.Spec.ProviderID)
+
+			interruption, err := shared.InterruptSpotInstance(ctx, e2eCtx, clusterName, *interrupted.Spec.InstanceID)
+			defer func() {
+				if interruption != nil {
+					Expect(interruption.Cleanup(ctx)).To(Succeed())
+				}
+			}()
+			Expect(err).NotTo(HaveOccurred())
+
+			k8sClient := e2eCtx.Environment.BootstrapClusterProxy.GetClient()
+			ginkgo.By("Waiting for the interruption notice to be recorded in the AWSMachine timeline")
+			Eventually(func(g Gomega) {
+				awsMachine := &infrav1.AWSMachine{}
+				g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(&interrupted), awsMachine)).To(Succeed())
+				g.Expect(awsMachine.Status.Timeline).To(ContainElement(And(
+					HaveField("Type", infrav1.TimelineEventTypeInterruptionNotice),
+					HaveField("InstanceID", *interrupted.Spec.InstanceID),
+				)))
+			}, e2eCtx.E2EConfig.GetIntervals("", "wait-machine-status")...).Should(Succeed())
+
+			Eventually(func() (bool, error) {
+				return interruption.Completed(ctx)
+			}, e2eCtx.E2EConfig.GetIntervals("", "wait-machine-status")...).Should(BeTrue())
+
+			ginkgo.By("Waiting for the interrupted machine to be drained and replaced")
+			Eventually(func(g Gomega) {
+				awsMachine := &infrav1.AWSMachine{}
+				err := k8sClient.Get(ctx, client.ObjectKeyFromObject(&interrupted), awsMachine)
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue(), "AWSMachine %s is not deleted", interrupted.Name)
+
+				machines := framework.GetMachinesByMachineDeployments(ctx, framework.GetMachinesByMachineDeploymentsInput{
+					Lister:            k8sClient,
+					ClusterName:       clusterName,
+					Namespace:         namespace.Name,
+					MachineDeployment: *md[0],
+				})
+				g.Expect(machines).To(HaveLen(1))
+				g.Expect(machines[0].Status.GetTypedPhase()).To(Equal(clusterv1.MachinePhaseRunning))
+				g.Expect(machines[0].Status.NodeRef).NotTo(BeNil())
+			}, e2eCtx.E2EConfig.GetIntervals("", "wait-machine-remediation")...).Should(Succeed())
+
+			ginkgo.By("Checking that the interruption triggered the fallback to on-demand instances")
+			template := &infrav1.AWSMachineTemplate{}
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: md[0].Spec.Template.Spec.InfrastructureRef.Name}, template)).To(Succeed())
+			Expect(template.Status.SpotInterruptions).To(BeNumerically(">=", 1))
+
+			awsMachines = getAWSMachinesForDeployment(namespace.Name, *md[0])
+			Expect(awsMachines.Items).To(HaveLen(1))
+			replacement := awsMachines.Items[0]
+			Expect(replacement.Status.SpotFallback).NotTo(BeNil())
+			Expect(replacement.Status.SpotFallback.OnDemand).To(BeTrue())
+			Expect(replacement.Status.SpotFallback.Reason).To(Equal(infrav1.SpotFallbackReasonInterrupted))
+			assertOnDemandInstanceType(*replacement.Spec.ProviderID)
+		})
+	})
+
 	// This test builds a management cluster using an externally managed VPC and subnets. CAPA is still handling security group
 	// creation for the management cluster. The workload cluster is created in a peered VPC with a single externally managed security group.
 	// A private and public subnet is created in this VPC to allow for egress traffic but the workload AWSCluster is configured with
@@ -637,7 +847,8 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 			if !e2eCtx.Settings.SkipQuotas {
 				requiredResources = &shared.TestResource{EC2Normal: 2 * e2eCtx.Settings.InstanceVCPU, IGW: 2, NGW: 2, VPC: 2, ClassicLB: 2, EIP: 5, EventBridgeRules: 50}
 				requiredResources.WriteRequestedResources(e2eCtx, specName)
-				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))).To(Succeed())
+				shared.SkipIfServiceQuotasInsufficient(e2eCtx, requiredResources)
+				Expect(shared.AcquireResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)).To(Succeed())
 			}
 			namespace = shared.SetupSpecNamespace(ctx, specName, e2eCtx)
 			ginkgo.By("Creating the management cluster infrastructure")
@@ -667,7 +878,7 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 		// Infrastructure cleanup is done in setup node so it is not bypassed if there is a test failure in the subject node.
 		ginkgo.JustAfterEach(func() {
 			if !e2eCtx.Settings.SkipQuotas {
-				shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), flock.New(shared.ResourceQuotaFilePath))
+				shared.ReleaseResources(requiredResources, ginkgo.GinkgoParallelProcess(), e2eCtx.Environment.ResourceStore)
 			}
 			shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
 			if !e2eCtx.Settings.SkipCleanup {
@@ -875,6 +1086,69 @@ var _ = ginkgo.Context("[unmanaged] [functional]", func() {
 		})
 	})
 
+	ginkgo.Describe("Workload cluster with AWS S3 encrypted with a customer managed KMS key and Ignition parameter", func() {
+		ginkgo.It("It should encrypt the bootstrap data with the key and expire its presigned URLs", func() {
+			specName := "functional-test-ignition-kms"
+			namespace := shared.SetupSpecNamespace(ctx, specName, e2eCtx)
+			clusterName := fmt.Sprintf("%s-%s", specName, util.RandomString(6))
+			keyARN, err := shared.CreateS3BucketKMSKey(ctx, e2eCtx, clusterName)
+			Expect(err).NotTo(HaveOccurred())
+			defer func() {
+				Expect(shared.DeleteKMSKey(ctx, e2eCtx, keyARN)).To(Succeed())
+			}()
+			presignedURLDuration := 5 * time.Minute
+			shared.SetEnvVar("S3_BUCKET_KMS_KEY_ID", keyARN, false)
+			shared.SetEnvVar("S3_PRESIGNED_URL_DURATION", presignedURLDuration.String(), false)
+
+			ginkgo.By("Creating a cluster")
+			configCluster := defaultConfigCluster(clusterName, namespace.Name)
+			configCluster.ControlPlaneMachineCount = ptr.To[int64](1)
+			configCluster.WorkerMachineCount = ptr.To[int64](1)
+			configCluster.Flavor = shared.IgnitionKMSFlavor
+			cluster, md, _ := createCluster(ctx, configCluster, result)
+			defer shared.DumpSpecResourcesAndCleanup(ctx, "", namespace, e2eCtx)
+
+			workerMachines := framework.GetMachinesByMachineDeployments(ctx, framework.GetMachinesByMachineDeploymentsInput{
+				Lister:            e2eCtx.Environment.BootstrapClusterProxy.GetClient(),
+				ClusterName:       clusterName,
+				Namespace:         namespace.Name,
+				MachineDeployment: *md[0],
+			})
+			Expect(len(workerMachines)).To(Equal(1))
+
+			ginkgo.By("Validating the presigned URL of the bootstrap data expires after the configured duration")
+			source := getIgnitionConfigSource(*workerMachines[0].Spec.ProviderID)
+			sourceURL, err := url.Parse(source)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sourceURL.Query().Get("X-Amz-Expires")).To(Equal(strconv.Itoa(int(presignedURLDuration.Seconds()))))
+			signedAt, err := time.Parse("20060102T150405Z", sourceURL.Query().Get("X-Amz-Date"))
+			Expect(err).NotTo(HaveOccurred())
+			time.Sleep(time.Until(signedAt.Add(presignedURLDuration + 30*time.Second)))
+			resp, err := http.Get(source) //nolint:gosec,noctx // The URL is generated by the controller.
+			Expect(err).NotTo(HaveOccurred())
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(resp.StatusCode).To(Equal(http.StatusForbidden), "expected the expired presigned URL to be rejected")
+			Expect(string(body)).To(ContainSubstring("Request has expired"))
+
+			ginkgo.By("Scaling the MachineDeployment while watching the encryption of the bootstrap data")
+			stopWatching := watchBootstrapDataKMSKeys(ctx, "cluster-api-provider-aws-"+clusterName+"a")
+			framework.ScaleAndWaitMachineDeployment(ctx, framework.ScaleAndWaitMachineDeploymentInput{
+				ClusterProxy:              e2eCtx.Environment.BootstrapClusterProxy,
+				Cluster:                   cluster,
+				MachineDeployment:         md[0],
+				Replicas:                  2,
+				WaitForMachineDeployments: e2eCtx.E2EConfig.GetIntervals(specName, "wait-worker-nodes"),
+			})
+			keys := stopWatching()
+			Expect(keys).NotTo(BeEmpty(), "expected the bootstrap data of the new machine to be stored in the bucket")
+			for key, kmsKeyID := range keys {
+				Expect(kmsKeyID).To(Equal(keyARN), "expected object %s to be encrypted with the customer managed KMS key", key)
+			}
+		})
+	})
+
 	ginkgo.Describe("Workload cluster with AWS S3 and Ignition parameter", func() {
 		ginkgo.It("It should be creatable and deletable", func() {
 			specName := "functional-test-ignition"
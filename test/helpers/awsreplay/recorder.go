@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package awsreplay records the requests sent by the AWS SDK and their responses during real runs, and replays them
+// without an AWS account, so that the cloud services can be tested against the actual behaviour of the AWS APIs.
+package awsreplay
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// Mode is the mode of a Recorder.
+type Mode string
+
+const (
+	// ModeReplay serves the recorded responses, without sending any request to AWS.
+	ModeReplay Mode = "replay"
+	// ModeRecord sends the requests to AWS and records them with their responses.
+	ModeRecord Mode = "record"
+
+	// ModeEnvVar is the environment variable selecting the mode of the recorders created by NewSession, which
+	// replay by default.
+	ModeEnvVar = "AWS_REPLAY_MODE"
+	// RegionEnvVar is the environment variable selecting the region the requests are recorded in, us-east-1 by
+	// default.
+	RegionEnvVar = "AWS_REPLAY_REGION"
+
+	defaultRegion = "us-east-1"
+)
+
+// volatileParameters are the request parameters which differ on each run, and are ignored to match the requests.
+var volatileParameters = []string{"ClientToken", "IdempotencyToken"}
+
+// Cassette is a recording of the requests sent to AWS and of their responses.
+type Cassette struct {
+	// Region is the region the requests were sent to.
+	Region string `json:"region"`
+	// Interactions are the requests and responses, in the order they were sent.
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a request sent to AWS and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request. It does not contain any header, so that the credentials are not recorded.
+type Request struct {
+	// Operation is the name of the API operation, e.g. DescribeVpcs.
+	Operation string `json:"operation,omitempty"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	// Body is the body of the request, without the volatile parameters of query protocol requests.
+	Body string `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode  int    `json:"statusCode"`
+	ContentType string `json:"contentType,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording the requests sent to AWS, or replaying them.
+type Recorder struct {
+	path string
+	mode Mode
+	// transport sends the recorded requests.
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	next     int
+}
+
+// New returns a Recorder recording the requests in the cassette file at path, or replaying the requests recorded
+// in it. The region is only used when recording, the replayed requests are sent to the recorded region.
+func New(path string, mode Mode, region string) (*Recorder, error) {
+	r := &Recorder{
+		path:     path,
+		mode:     mode,
+		cassette: Cassette{Region: region},
+	}
+
+	switch mode {
+	case ModeRecord:
+	case ModeReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read cassette %q, record it with %s=%s", path, ModeEnvVar, ModeRecord)
+		}
+		r.cassette = Cassette{}
+		if err := yaml.Unmarshal(data, &r.cassette); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse cassette %q", path)
+		}
+	default:
+		return nil, errors.Errorf("unknown mode %q", mode)
+	}
+	return r, nil
+}
+
+// Region returns the region the requests are sent to.
+func (r *Recorder) Region() string {
+	return r.cassette.Region
+}
+
+// Session returns an AWS session whose requests go through the recorder, with the given configurations applied. When
+// replaying, it uses static credentials, so that no AWS credentials are needed.
+func (r *Recorder) Session(cfgs ...*aws.Config) (*session.Session, error) {
+	cfg := aws.NewConfig().
+		WithRegion(r.cassette.Region).
+		WithHTTPClient(&http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()}).
+		WithMaxRetries(0)
+	if r.mode == ModeReplay {
+		cfg = cfg.WithCredentials(credentials.NewStaticCredentials("replay", "replay", ""))
+	}
+	cfg.MergeIn(cfgs...)
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *cfg,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AWS session")
+	}
+	// The transport is only wrapped once the session is created, as the SDK configures the custom CA bundle, if any,
+	// on the transport of the HTTP client.
+	client := *sess.Config.HTTPClient
+	r.transport = client.Transport
+	client.Transport = r
+	sess.Config.HTTPClient = &client
+	return sess, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+	return r.record(req, recorded)
+}
+
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	if r.next >= len(r.cassette.Interactions) {
+		return nil, errors.Errorf("unexpected %s request to %s, all the %d interactions of cassette %q were replayed",
+			recorded.Operation, recorded.Path, len(r.cassette.Interactions), r.path)
+	}
+	interaction := r.cassette.Interactions[r.next]
+	if interaction.Request != recorded {
+		return nil, errors.Errorf("request %d does not match cassette %q:\nrecorded: %+v\nsent: %+v", r.next, r.path, interaction.Request, recorded)
+	}
+	r.next++
+
+	resp := &http.Response{
+		Status:        http.StatusText(interaction.Response.StatusCode),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       req,
+	}
+	if interaction.Response.ContentType != "" {
+		resp.Header.Set("Content-Type", interaction.Response.ContentType)
+	}
+	return resp, nil
+}
+
+func (r *Recorder) record(req *http.Request, recorded Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the response body")
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        string(body),
+		},
+	})
+	return resp, nil
+}
+
+// Stop writes the cassette when recording. When replaying, it returns an error if some of the recorded interactions
+// were not replayed, as the code under test no longer sends all the recorded requests.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == ModeReplay {
+		if r.next < len(r.cassette.Interactions) {
+			return errors.Errorf("only %d of the %d interactions of cassette %q were replayed", r.next, len(r.cassette.Interactions), r.path)
+		}
+		return nil
+	}
+
+	data, err := yaml.Marshal(r.cassette)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal the cassette")
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o750); err != nil {
+		return errors.Wrapf(err, "failed to create the directory of cassette %q", r.path)
+	}
+	if err := os.WriteFile(r.path, data, 0o600); err != nil {
+		return errors.Wrapf(err, "failed to write cassette %q", r.path)
+	}
+	return nil
+}
+
+// NewSession returns an AWS session whose requests go through a Recorder using the testdata/<name>.yaml cassette,
+// in the mode given by the AWS_REPLAY_MODE environment variable. The cassette is written, or checked to be fully
+// replayed, when the test ends.
+func NewSession(t testing.TB, name string) *session.Session {
+	t.Helper()
+
+	mode := ModeReplay
+	if m := os.Getenv(ModeEnvVar); m != "" {
+		mode = Mode(m)
+	}
+	region := defaultRegion
+	if r := os.Getenv(RegionEnvVar); r != "" {
+		region = r
+	}
+
+	r, err := New(filepath.Join("testdata", name+".yaml"), mode, region)
+	if err != nil {
+		t.Fatalf("Failed to create AWS recorder: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := r.Stop(); err != nil {
+			t.Errorf("Failed to stop AWS recorder: %v", err)
+		}
+	})
+
+	sess, err := r.Session()
+	if err != nil {
+		t.Fatalf("Failed to create AWS session: %v", err)
+	}
+	return sess
+}
+
+// newRequest returns the recorded form of the request, consuming and restoring its body.
+func newRequest(req *http.Request) (Request, error) {
+	recorded := Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+	}
+	if req.URL.RawQuery != "" {
+		recorded.Path += "?" + req.URL.RawQuery
+	}
+	if target := req.Header.Get("X-Amz-Target"); target != "" {
+		recorded.Operation = target[strings.LastIndex(target, ".")+1:]
+	}
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return recorded, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return Request{}, errors.Wrap(err, "failed to read the request body")
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	recorded.Body = string(body)
+
+	// The query protocol used by EC2 and ELB sends the operation and its parameters as a form.
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return Request{}, errors.Wrap(err, "failed to parse the request form")
+		}
+		recorded.Operation = form.Get("Action")
+		for key := range form {
+			for _, volatile := range volatileParameters {
+				if key == volatile || strings.HasSuffix(key, "."+volatile) {
+					form.Del(key)
+				}
+			}
+		}
+		recorded.Body = form.Encode()
+	}
+	return recorded, nil
+}
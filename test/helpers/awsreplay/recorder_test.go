@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsreplay
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	describeVpcsResponse = `<DescribeVpcsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+<vpcSet><item><vpcId>vpc-0123456789</vpcId><cidrBlock>10.0.0.0/16</cidrBlock></item></vpcSet>
+</DescribeVpcsResponse>`
+	runInstancesResponse = `<RunInstancesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+<reservationId>r-0123456789</reservationId>
+<instancesSet><item><instanceId>i-0123456789</instanceId></item></instancesSet>
+</RunInstancesResponse>`
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	g := NewWithT(t)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		g.Expect(r.ParseForm()).To(Succeed())
+		w.Header().Set("Content-Type", "text/xml;charset=UTF-8")
+		switch action := r.Form.Get("Action"); action {
+		case "DescribeVpcs":
+			fmt.Fprint(w, describeVpcsResponse)
+		case "RunInstances":
+			fmt.Fprint(w, runInstancesResponse)
+		default:
+			t.Errorf("unexpected action %q", action)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.yaml")
+
+	recorder, err := New(path, ModeRecord, "us-west-2")
+	g.Expect(err).NotTo(HaveOccurred())
+	sess, err := recorder.Session(aws.NewConfig().
+		WithEndpoint(server.URL).
+		WithCredentials(credentials.NewStaticCredentials("id", "secret", "")))
+	g.Expect(err).NotTo(HaveOccurred())
+	exercise(g, ec2.New(sess))
+	g.Expect(recorder.Stop()).To(Succeed())
+	g.Expect(requests).To(Equal(2))
+
+	// The replayed requests are matched although the SDK generates a new client token for RunInstances.
+	recorder, err = New(path, ModeReplay, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(recorder.Region()).To(Equal("us-west-2"))
+	sess, err = recorder.Session()
+	g.Expect(err).NotTo(HaveOccurred())
+	exercise(g, ec2.New(sess))
+	g.Expect(recorder.Stop()).To(Succeed())
+	g.Expect(requests).To(Equal(2))
+}
+
+func TestReplayMismatch(t *testing.T) {
+	g := NewWithT(t)
+
+	recorder, err := New(filepath.Join("testdata", "describe-vpcs.yaml"), ModeReplay, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	sess, err := recorder.Session()
+	g.Expect(err).NotTo(HaveOccurred())
+	client := ec2.New(sess)
+
+	_, err = client.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{"vpc-other"})})
+	g.Expect(err).To(MatchError(ContainSubstring("does not match cassette")))
+	g.Expect(recorder.Stop()).To(MatchError(ContainSubstring("only 0 of the 1 interactions")))
+
+	recorder, err = New(filepath.Join("testdata", "describe-vpcs.yaml"), ModeReplay, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	sess, err = recorder.Session()
+	g.Expect(err).NotTo(HaveOccurred())
+	client = ec2.New(sess)
+
+	out, err := client.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{"vpc-0123456789"})})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out.Vpcs).To(HaveLen(1))
+	_, err = client.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{"vpc-0123456789"})})
+	g.Expect(err).To(MatchError(ContainSubstring("all the 1 interactions")))
+	g.Expect(recorder.Stop()).To(Succeed())
+}
+
+func TestNewSession(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(ModeEnvVar, "")
+
+	out, err := ec2.New(NewSession(t, "describe-vpcs")).DescribeVpcs(&ec2.DescribeVpcsInput{
+		VpcIds: aws.StringSlice([]string{"vpc-0123456789"}),
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(aws.StringValue(out.Vpcs[0].CidrBlock)).To(Equal("10.0.0.0/16"))
+}
+
+func exercise(g *WithT, client *ec2.EC2) {
+	vpcs, err := client.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{"vpc-0123456789"})})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(vpcs.Vpcs).To(HaveLen(1))
+	g.Expect(aws.StringValue(vpcs.Vpcs[0].VpcId)).To(Equal("vpc-0123456789"))
+
+	reservation, err := client.RunInstances(&ec2.RunInstancesInput{
+		ImageId:  aws.String("ami-0123456789"),
+		MinCount: aws.Int64(1),
+		MaxCount: aws.Int64(1),
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(aws.StringValue(reservation.Instances[0].InstanceId)).To(Equal("i-0123456789"))
+}
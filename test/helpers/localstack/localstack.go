@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package localstack provides helpers to run the integration tests of the cloud services against LocalStack, so that
+// they can be run without AWS credentials.
+package localstack
+
+import (
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/sts"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+const (
+	// EndpointEnvVar is the environment variable giving the endpoint of LocalStack.
+	EndpointEnvVar = "LOCALSTACK_ENDPOINT"
+	// DefaultEndpoint is the endpoint LocalStack listens on by default.
+	DefaultEndpoint = "http://localhost:4566"
+	// Region is the region the tests create resources in.
+	Region = "us-east-1"
+)
+
+// services are the AWS services whose requests are sent to LocalStack.
+var services = []string{
+	ec2.EndpointsID,
+	elb.EndpointsID,
+	resourcegroupstaggingapi.EndpointsID,
+	sts.EndpointsID,
+}
+
+// Endpoint returns the endpoint of LocalStack.
+func Endpoint() string {
+	if endpoint := os.Getenv(EndpointEnvVar); endpoint != "" {
+		return endpoint
+	}
+	return DefaultEndpoint
+}
+
+// ServiceEndpoints returns the custom service endpoints sending the requests of the AWS SDK clients of the scopes to
+// LocalStack.
+func ServiceEndpoints() []scope.ServiceEndpoint {
+	endpoints := make([]scope.ServiceEndpoint, 0, len(services))
+	for _, service := range services {
+		endpoints = append(endpoints, scope.ServiceEndpoint{
+			ServiceID:     service,
+			URL:           Endpoint(),
+			SigningRegion: Region,
+		})
+	}
+	return endpoints
+}
+
+// Setup fails the test if LocalStack is not reachable, sets dummy AWS credentials unless credentials are already set,
+// and returns the service endpoints to pass to the scopes.
+func Setup(t testing.TB) []scope.ServiceEndpoint {
+	t.Helper()
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Get(Endpoint() + "/_localstack/health")
+	if err != nil {
+		t.Fatalf("LocalStack is not reachable at %s, start it or set %s: %v", Endpoint(), EndpointEnvVar, err)
+	}
+	resp.Body.Close()
+
+	// LocalStack accepts any credentials.
+	if os.Getenv("AWS_ACCESS_KEY_ID") == "" {
+		t.Setenv("AWS_ACCESS_KEY_ID", "test")
+		t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+	}
+	return ServiceEndpoints()
+}
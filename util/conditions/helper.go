@@ -31,3 +31,25 @@ func ErrorConditionAfterInit(getter conditions.Getter) clusterv1.ConditionSeveri
 	}
 	return clusterv1.ConditionSeverityWarning
 }
+
+// SetSummaryCondition sets the given condition to the summary of the conditions of an object, computed
+// with the same options as conditions.SetSummary, which can only summarize into the Ready condition.
+// The Ready condition of the object is left untouched.
+func SetSummaryCondition(to conditions.Setter, targetCondition clusterv1.ConditionType, options ...conditions.MergeOption) {
+	ready := conditions.Get(to, clusterv1.ReadyCondition)
+	conditions.Delete(to, clusterv1.ReadyCondition)
+
+	conditions.SetSummary(to, options...)
+	summary := conditions.Get(to, clusterv1.ReadyCondition)
+	conditions.Delete(to, clusterv1.ReadyCondition)
+
+	if ready != nil {
+		conditions.Set(to, ready)
+	}
+	if summary == nil {
+		conditions.Delete(to, targetCondition)
+		return
+	}
+	summary.Type = targetCondition
+	conditions.Set(to, summary)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestSetSummaryCondition(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions clusterv1.Conditions
+		wantStatus corev1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name: "should be true when all the conditions are true",
+			conditions: clusterv1.Conditions{
+				*conditions.TrueCondition(infrav1.VpcReadyCondition),
+				*conditions.TrueCondition(infrav1.SubnetsReadyCondition),
+				*conditions.FalseCondition(clusterv1.ReadyCondition, "ControlPlaneNotReady", clusterv1.ConditionSeverityInfo, ""),
+			},
+			wantStatus: corev1.ConditionTrue,
+		},
+		{
+			name: "should report the reason of a false condition",
+			conditions: clusterv1.Conditions{
+				*conditions.TrueCondition(infrav1.VpcReadyCondition),
+				*conditions.FalseCondition(infrav1.SubnetsReadyCondition, infrav1.SubnetsReconciliationFailedReason, clusterv1.ConditionSeverityError, ""),
+				*conditions.TrueCondition(clusterv1.ReadyCondition),
+			},
+			wantStatus: corev1.ConditionFalse,
+			wantReason: infrav1.SubnetsReconciliationFailedReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			awsCluster := &infrav1.AWSCluster{}
+			for i := range tt.conditions {
+				conditions.Set(awsCluster, &tt.conditions[i])
+			}
+			ready := conditions.Get(awsCluster, clusterv1.ReadyCondition)
+
+			SetSummaryCondition(awsCluster, infrav1.InfrastructureReadyCondition,
+				conditions.WithConditions(infrav1.VpcReadyCondition, infrav1.SubnetsReadyCondition),
+			)
+
+			g.Expect(conditions.Get(awsCluster, infrav1.InfrastructureReadyCondition).Status).To(Equal(tt.wantStatus))
+			g.Expect(conditions.GetReason(awsCluster, infrav1.InfrastructureReadyCondition)).To(Equal(tt.wantReason))
+			g.Expect(conditions.Get(awsCluster, clusterv1.ReadyCondition)).To(Equal(ready))
+		})
+	}
+}